@@ -8,8 +8,10 @@ import (
 	"github.com/dev-hyunsang/my-own-library-backend/internal/cache"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/db"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/handler"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/infrastructure/fcm"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/infrastructure/metadata"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/infrastructure/scheduler"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/middleware"
 	repository "github.com/dev-hyunsang/my-own-library-backend/internal/repository/mysql"
@@ -88,30 +90,105 @@ func main() {
 	userHandler := handler.NewUserHandler(userUseCase, authUseCase, emailVerificationRepo)
 	authHandler := handler.NewAuthHandler(authUseCase)
 
+	// 도서 정보 제공자 초기화 (설정된 우선순위 순서로 대체 검색)
+	metadataProvider, err := metadata.NewProviderChainFromConfig(cfg.Book)
+	if err != nil {
+		log.Fatalf("Book metadata provider error: %v", err)
+	}
+
+	logger.Sugar().Infof("도서 정보 제공자가 초기화되었습니다: %s", metadataProvider.Name())
+
+	// 도서 정보 검색 결과는 Redis에 캐시하여 외부 API 호출 한도를 아낍니다.
+	cachedMetadataProvider := metadata.NewCachedProvider(metadataProvider, redisClient, cfg.Book.CacheTTL, cfg.Book.NegativeCacheTTL)
+
 	// 책 관련 의존성 주입
 	bookRepo := repository.NewBookRepository(dbConn)
-	bookUseCase := usecase.NewBookUseCase(bookRepo)
-	bookHandler := handler.NewBookHandler(bookUseCase, authUseCase)
+	bookUseCase := usecase.NewBookUseCase(bookRepo, cachedMetadataProvider)
+	metadataUseCase := usecase.NewBookMetadataUseCase(cachedMetadataProvider)
+	bookHandler := handler.NewBookHandler(bookUseCase, metadataUseCase, authUseCase)
+
+	// 독서 세션 관련 의존성 주입
+	readingSessionRepo := repository.NewReadingSessionRepository(dbConn)
+	readingSessionUseCase := usecase.NewReadingSessionUseCase(readingSessionRepo, bookRepo, userRepo)
+	readingSessionHandler := handler.NewReadingSessionHandler(readingSessionUseCase, authUseCase)
+
+	// 책장 관련 의존성 주입
+	shelfRepo := repository.NewShelfRepository(dbConn)
+	shelfUseCase := usecase.NewShelfUseCase(shelfRepo, bookRepo)
+	shelfHandler := handler.NewShelfHandler(shelfUseCase, authUseCase)
+
+	// 메모/하이라이트 관련 의존성 주입
+	noteRepo := repository.NewBookNoteRepository(dbConn)
+	noteUseCase := usecase.NewBookNoteUseCase(noteRepo, bookRepo)
+	noteHandler := handler.NewBookNoteHandler(noteUseCase, authUseCase)
+
+	// 대여 기록 관련 의존성 주입
+	loanRepo := repository.NewLoanRepository(dbConn)
+	loanUseCase := usecase.NewLoanUseCase(loanRepo, bookRepo)
+	loanHandler := handler.NewLoanHandler(loanUseCase, authUseCase)
+
+	// 사용자 간 대여 요청 관련 의존성 주입
+	// FCM 초기화에 실패하면 알림 없이 동작합니다. (nil 포인터를 인터페이스에 담지 않도록 분기)
+	var pushNotifier domain.PushNotifier
+	if fcmService != nil {
+		pushNotifier = fcmService
+	}
+	borrowRequestRepo := repository.NewBorrowRequestRepository(dbConn)
+	borrowRequestUseCase := usecase.NewBorrowRequestUseCase(borrowRequestRepo, bookRepo, userRepo, loanUseCase, pushNotifier)
+	borrowRequestHandler := handler.NewBorrowRequestHandler(borrowRequestUseCase, authUseCase)
+
+	// 공유 서재 관련 의존성 주입
+	libraryRepo := repository.NewLibraryRepository(dbConn)
+	libraryUseCase := usecase.NewLibraryUseCase(libraryRepo, bookRepo, userRepo, pushNotifier)
+	libraryHandler := handler.NewLibraryHandler(libraryUseCase, bookUseCase, authUseCase)
+
+	// 공개 프로필 관련 의존성 주입
+	profileRepo := repository.NewProfileRepository(dbConn)
+	profileUseCase := usecase.NewProfileUseCase(profileRepo, userRepo)
+	profileHandler := handler.NewProfileHandler(profileUseCase, authUseCase)
+
+	// 위시리스트 관련 의존성 주입
+	wishlistRepo := repository.NewWishlistRepository(dbConn)
+	wishlistUseCase := usecase.NewWishlistUseCase(wishlistRepo, bookUseCase, cachedMetadataProvider)
+	wishlistHandler := handler.NewWishlistHandler(wishlistUseCase, authUseCase)
 
 	// 리뷰 관련 의존성 주입
 	reviewRepo := repository.NewReviewRepository(dbConn)
 	reviewUseCase := usecase.NewReviewUseCase(reviewRepo)
 	reviewHandler := handler.NewReviewHandler(reviewUseCase, authUseCase, bookUseCase)
 
+	// 서재 가져오기 관련 의존성 주입
+	importJobRepo := redisRepository.NewImportJobRepository(redisClient)
+	importUseCase := usecase.NewBookImportUseCase(importJobRepo, bookUseCase, reviewUseCase)
+	importHandler := handler.NewBookImportHandler(importUseCase, authUseCase)
+
 	// 읽기 리마인더 관련 의존성 주입
 	reminderRepo := repository.NewReadingReminderRepository(dbConn)
 	reminderUseCase := usecase.NewReadingReminderUseCase(reminderRepo)
 	reminderHandler := handler.NewReadingReminderHandler(reminderUseCase, authUseCase)
 
+	// 독서 목표 관련 의존성 주입
+	goalRepo := repository.NewReadingGoalRepository(dbConn)
+	goalUseCase := usecase.NewReadingGoalUseCase(goalRepo, userRepo)
+	goalHandler := handler.NewReadingGoalHandler(goalUseCase, authUseCase)
+
+	// 서재 내보내기 관련 의존성 주입
+	exportUseCase := usecase.NewLibraryExportUseCase(bookRepo, reviewRepo, reminderRepo)
+	exportHandler := handler.NewLibraryExportHandler(exportUseCase, authUseCase)
+
+	// 휴지통 관련 의존성 주입
+	trashUseCase := usecase.NewTrashUseCase(bookRepo, reviewRepo, cfg.Trash.Retention)
+	trashHandler := handler.NewTrashHandler(trashUseCase, authUseCase)
+
 	// 관리자 API Key 관련 의존성 주입
 	apiKeyRepo := repository.NewAdminAPIKeyRepository(dbConn)
 	apiKeyUseCase := usecase.NewAdminAPIKeyUseCase(apiKeyRepo)
 
 	// 관리자 핸들러 초기화
-	adminHandler := handler.NewAdminHandler(userRepo, apiKeyUseCase)
+	adminHandler := handler.NewAdminHandler(userRepo, apiKeyUseCase, cachedMetadataProvider)
 
 	// 리마인더 스케줄러 시작
-	reminderScheduler, err := scheduler.NewReminderScheduler(reminderRepo, userRepo, fcmService)
+	reminderScheduler, err := scheduler.NewReminderScheduler(reminderRepo, userRepo, loanRepo, goalUseCase, fcmService)
 	if err != nil {
 		logger.Sugar().Warnf("리마인더 스케줄러 초기화 실패: %v", err)
 	} else {
@@ -122,6 +199,18 @@ func main() {
 		}
 	}
 
+	// 휴지통 정리 스케줄러 시작
+	trashScheduler, err := scheduler.NewTrashScheduler(bookRepo, reviewRepo, cfg.Trash.Retention)
+	if err != nil {
+		logger.Sugar().Warnf("휴지통 정리 스케줄러 초기화 실패: %v", err)
+	} else {
+		if err := trashScheduler.Start(); err != nil {
+			logger.Sugar().Warnf("휴지통 정리 스케줄러 시작 실패: %v", err)
+		} else {
+			defer trashScheduler.Stop()
+		}
+	}
+
 	api := app.Group("/api")
 	user := api.Group("/users")
 	user.Post("/signup", userHandler.UserSignUpHandler)
@@ -134,6 +223,8 @@ func main() {
 	user.Put("/change-password", middleware.JWTAuthMiddleware(authUseCase), userHandler.UserChangePasswordHandler)
 	user.Put("/change-nickname", middleware.JWTAuthMiddleware(authUseCase), userHandler.UserChangeNicknameHandler)
 	user.Post("/me", middleware.JWTAuthMiddleware(authUseCase), userHandler.UserVerifyHandler)
+	user.Get("/me/public-profile", middleware.JWTAuthMiddleware(authUseCase), profileHandler.GetPreviewHandler)
+	user.Get("/me/public-profile/books", middleware.JWTAuthMiddleware(authUseCase), profileHandler.GetPreviewBooksHandler)
 	user.Get("/:id", middleware.JWTAuthMiddleware(authUseCase), userHandler.UserGetByIdHandler)
 	user.Put("/update/:id", middleware.JWTAuthMiddleware(authUseCase), userHandler.UserEditHandler)
 	user.Delete("/:id", middleware.JWTAuthMiddleware(authUseCase), userHandler.UserDeleteHandler)
@@ -142,13 +233,136 @@ func main() {
 
 	books := api.Group("/books")
 	books.Post("/add", middleware.JWTAuthMiddleware(authUseCase), bookHandler.SaveBookHandler)
+	books.Post("/isbn/batch", middleware.JWTAuthMiddleware(authUseCase), bookHandler.SaveBooksByISBNBatchHandler)
+	books.Post("/isbn/:isbn", middleware.JWTAuthMiddleware(authUseCase), bookHandler.SaveBookByISBNHandler)
 	books.Get("/get", middleware.JWTAuthMiddleware(authUseCase), bookHandler.GetBooksHandler)
 	books.Get("/get/:user_id/:book_id", middleware.JWTAuthMiddleware(authUseCase), bookHandler.GetBookHandler)
 	books.Put("/update/:id", middleware.JWTAuthMiddleware(authUseCase), bookHandler.UpdateBookHandler)
+	books.Patch("/:id", middleware.JWTAuthMiddleware(authUseCase), bookHandler.PatchBookHandler)
+	books.Put("/:id/status", middleware.JWTAuthMiddleware(authUseCase), bookHandler.UpdateBookStatusHandler)
+	books.Get("/:id/status-history", middleware.JWTAuthMiddleware(authUseCase), bookHandler.GetBookStatusHistoryHandler)
 	books.Delete("/delete/:id", middleware.JWTAuthMiddleware(authUseCase), bookHandler.BookDeleteHandler)
-	books.Get("/:name", middleware.JWTAuthMiddleware(authUseCase), bookHandler.GetBooksByUserNameHandler)
+	books.Get("/duplicates", middleware.JWTAuthMiddleware(authUseCase), bookHandler.GetDuplicateBooksHandler)
+	books.Get("/locations/summary", middleware.JWTAuthMiddleware(authUseCase), bookHandler.GetLocationSummaryHandler)
+	books.Put("/:id/copy", middleware.JWTAuthMiddleware(authUseCase), bookHandler.UpdateBookCopyHandler)
+	books.Get("/series", middleware.JWTAuthMiddleware(authUseCase), bookHandler.GetSeriesHandler)
+	books.Get("/series/missing", middleware.JWTAuthMiddleware(authUseCase), bookHandler.GetMissingVolumesHandler)
+	books.Get("/series/detail", middleware.JWTAuthMiddleware(authUseCase), bookHandler.GetSeriesDetailHandler)
+	books.Put("/:id/series", middleware.JWTAuthMiddleware(authUseCase), bookHandler.UpdateBookSeriesHandler)
+	books.Put("/:id/library", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.MoveBookHandler)
+	books.Put("/:id/visibility", middleware.JWTAuthMiddleware(authUseCase), bookHandler.UpdateBookVisibilityHandler)
+	books.Post("/:id/merge", middleware.JWTAuthMiddleware(authUseCase), bookHandler.MergeBooksHandler)
+	books.Get("/:name", middleware.JWTAuthMiddleware(authUseCase), profileHandler.GetProfileBooksHandler)
 	books.Post("/search", middleware.JWTAuthMiddleware(authUseCase), bookHandler.SearchBookIsbnHandler)
 
+	// 독서 세션 및 진행 상황 API
+	books.Post("/:id/sessions/start", middleware.JWTAuthMiddleware(authUseCase), readingSessionHandler.StartSessionHandler)
+	books.Post("/:id/sessions/stop", middleware.JWTAuthMiddleware(authUseCase), readingSessionHandler.StopSessionHandler)
+	books.Get("/:id/sessions", middleware.JWTAuthMiddleware(authUseCase), readingSessionHandler.GetBookSessionsHandler)
+	books.Get("/:id/progress", middleware.JWTAuthMiddleware(authUseCase), readingSessionHandler.GetProgressHandler)
+	books.Put("/:id/progress", middleware.JWTAuthMiddleware(authUseCase), readingSessionHandler.UpdateProgressHandler)
+	api.Get("/reading-sessions", middleware.JWTAuthMiddleware(authUseCase), readingSessionHandler.GetRecentSessionsHandler)
+
+	// 책장 API
+	shelves := api.Group("/shelves")
+	shelves.Post("/", middleware.JWTAuthMiddleware(authUseCase), shelfHandler.CreateShelfHandler)
+	shelves.Get("/", middleware.JWTAuthMiddleware(authUseCase), shelfHandler.GetMyShelvesHandler)
+	shelves.Get("/user/:name", middleware.JWTAuthMiddleware(authUseCase), profileHandler.GetProfileShelvesHandler)
+	shelves.Get("/:id", middleware.JWTAuthMiddleware(authUseCase), shelfHandler.GetShelfHandler)
+	shelves.Put("/:id", middleware.JWTAuthMiddleware(authUseCase), shelfHandler.UpdateShelfHandler)
+	shelves.Delete("/:id", middleware.JWTAuthMiddleware(authUseCase), shelfHandler.DeleteShelfHandler)
+	shelves.Post("/:id/books", middleware.JWTAuthMiddleware(authUseCase), shelfHandler.AddShelfBookHandler)
+	shelves.Put("/:id/books/order", middleware.JWTAuthMiddleware(authUseCase), shelfHandler.ReorderShelfBooksHandler)
+	shelves.Delete("/:id/books/:book_id", middleware.JWTAuthMiddleware(authUseCase), shelfHandler.RemoveShelfBookHandler)
+
+	// 메모/하이라이트 API
+	books.Post("/:id/notes", middleware.JWTAuthMiddleware(authUseCase), noteHandler.CreateNoteHandler)
+	books.Get("/:id/notes", middleware.JWTAuthMiddleware(authUseCase), noteHandler.GetBookNotesHandler)
+	notes := api.Group("/notes")
+	notes.Get("/", middleware.JWTAuthMiddleware(authUseCase), noteHandler.SearchNotesHandler)
+	notes.Get("/:id", middleware.JWTAuthMiddleware(authUseCase), noteHandler.GetNoteHandler)
+	notes.Put("/:id", middleware.JWTAuthMiddleware(authUseCase), noteHandler.UpdateNoteHandler)
+	notes.Delete("/:id", middleware.JWTAuthMiddleware(authUseCase), noteHandler.DeleteNoteHandler)
+
+	// 대여 기록 API
+	books.Post("/:id/loans", middleware.JWTAuthMiddleware(authUseCase), loanHandler.LendBookHandler)
+	books.Get("/:id/loans", middleware.JWTAuthMiddleware(authUseCase), loanHandler.GetBookLoansHandler)
+	loans := api.Group("/loans")
+	loans.Get("/", middleware.JWTAuthMiddleware(authUseCase), loanHandler.GetLoansHandler)
+	loans.Get("/:id", middleware.JWTAuthMiddleware(authUseCase), loanHandler.GetLoanHandler)
+	loans.Put("/:id", middleware.JWTAuthMiddleware(authUseCase), loanHandler.UpdateLoanHandler)
+	loans.Delete("/:id", middleware.JWTAuthMiddleware(authUseCase), loanHandler.DeleteLoanHandler)
+	loans.Post("/:id/return", middleware.JWTAuthMiddleware(authUseCase), loanHandler.ReturnLoanHandler)
+
+	// 사용자 간 대여 요청 API
+	borrowRequests := api.Group("/borrow-requests")
+	borrowRequests.Post("/", middleware.JWTAuthMiddleware(authUseCase), borrowRequestHandler.CreateRequestHandler)
+	borrowRequests.Get("/", middleware.JWTAuthMiddleware(authUseCase), borrowRequestHandler.GetRequestsHandler)
+	borrowRequests.Get("/:id", middleware.JWTAuthMiddleware(authUseCase), borrowRequestHandler.GetRequestHandler)
+	borrowRequests.Post("/:id/accept", middleware.JWTAuthMiddleware(authUseCase), borrowRequestHandler.AcceptRequestHandler)
+	borrowRequests.Post("/:id/decline", middleware.JWTAuthMiddleware(authUseCase), borrowRequestHandler.DeclineRequestHandler)
+	borrowRequests.Post("/:id/cancel", middleware.JWTAuthMiddleware(authUseCase), borrowRequestHandler.CancelRequestHandler)
+	borrowRequests.Post("/:id/hand-over", middleware.JWTAuthMiddleware(authUseCase), borrowRequestHandler.HandOverHandler)
+	borrowRequests.Post("/:id/return", middleware.JWTAuthMiddleware(authUseCase), borrowRequestHandler.MarkReturnedHandler)
+
+	// 공유 서재 API (받은 초대 API는 /:id보다 먼저 등록)
+	libraries := api.Group("/libraries")
+	libraries.Post("/", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.CreateLibraryHandler)
+	libraries.Get("/", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.GetLibrariesHandler)
+	libraries.Get("/invitations", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.GetMyInvitationsHandler)
+	libraries.Post("/invitations/:id/accept", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.AcceptInvitationHandler)
+	libraries.Post("/invitations/:id/decline", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.DeclineInvitationHandler)
+	libraries.Get("/:id", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.GetLibraryHandler)
+	libraries.Put("/:id", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.UpdateLibraryHandler)
+	libraries.Delete("/:id", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.DeleteLibraryHandler)
+	libraries.Get("/:id/books", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.GetLibraryBooksHandler)
+	libraries.Put("/:id/members/:user_id", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.UpdateMemberRoleHandler)
+	libraries.Delete("/:id/members/:user_id", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.RemoveMemberHandler)
+	libraries.Post("/:id/invitations", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.InviteHandler)
+	libraries.Get("/:id/invitations", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.GetLibraryInvitationsHandler)
+	libraries.Delete("/:id/invitations/:invitation_id", middleware.JWTAuthMiddleware(authUseCase), libraryHandler.CancelInvitationHandler)
+
+	// 공개 프로필 API
+	profiles := api.Group("/profiles")
+	profiles.Get("/:name", middleware.JWTAuthMiddleware(authUseCase), profileHandler.GetProfileHandler)
+	profiles.Get("/:name/books", middleware.JWTAuthMiddleware(authUseCase), profileHandler.GetProfileBooksHandler)
+	profiles.Get("/:name/shelves/:id", middleware.JWTAuthMiddleware(authUseCase), profileHandler.GetProfileShelfHandler)
+
+	// 위시리스트 API (공개 링크 API는 로그인 없이 사용)
+	wishlist := api.Group("/wishlist")
+	wishlist.Get("/shared/:token", wishlistHandler.GetSharedWishlistHandler)
+	wishlist.Post("/shared/:token/items/:id/claim", wishlistHandler.ClaimItemHandler)
+	wishlist.Delete("/shared/:token/items/:id/claim", wishlistHandler.UnclaimItemHandler)
+	wishlist.Get("/share", middleware.JWTAuthMiddleware(authUseCase), wishlistHandler.GetShareHandler)
+	wishlist.Post("/share", middleware.JWTAuthMiddleware(authUseCase), wishlistHandler.EnableShareHandler)
+	wishlist.Delete("/share", middleware.JWTAuthMiddleware(authUseCase), wishlistHandler.DisableShareHandler)
+	wishlist.Post("/", middleware.JWTAuthMiddleware(authUseCase), wishlistHandler.AddItemHandler)
+	wishlist.Get("/", middleware.JWTAuthMiddleware(authUseCase), wishlistHandler.GetItemsHandler)
+	wishlist.Get("/:id", middleware.JWTAuthMiddleware(authUseCase), wishlistHandler.GetItemHandler)
+	wishlist.Put("/:id", middleware.JWTAuthMiddleware(authUseCase), wishlistHandler.UpdateItemHandler)
+	wishlist.Delete("/:id", middleware.JWTAuthMiddleware(authUseCase), wishlistHandler.DeleteItemHandler)
+	wishlist.Post("/:id/move", middleware.JWTAuthMiddleware(authUseCase), wishlistHandler.MoveToLibraryHandler)
+
+	// 서재 가져오기 API
+	books.Post("/import", middleware.JWTAuthMiddleware(authUseCase), importHandler.StartImportHandler)
+	books.Get("/import/:id", middleware.JWTAuthMiddleware(authUseCase), importHandler.GetImportJobHandler)
+
+	// 서재 내보내기 API
+	api.Get("/export", middleware.JWTAuthMiddleware(authUseCase), exportHandler.ExportLibraryHandler)
+
+	// 휴지통 API
+	trash := api.Group("/trash")
+	trash.Get("/", middleware.JWTAuthMiddleware(authUseCase), trashHandler.GetTrashHandler)
+	trash.Post("/books/:id/restore", middleware.JWTAuthMiddleware(authUseCase), trashHandler.RestoreBookHandler)
+	trash.Delete("/books/:id", middleware.JWTAuthMiddleware(authUseCase), trashHandler.PurgeBookHandler)
+	trash.Post("/reviews/:id/restore", middleware.JWTAuthMiddleware(authUseCase), trashHandler.RestoreReviewHandler)
+	trash.Delete("/reviews/:id", middleware.JWTAuthMiddleware(authUseCase), trashHandler.PurgeReviewHandler)
+
+	// 태그 API
+	tags := api.Group("/tags")
+	tags.Get("/", middleware.JWTAuthMiddleware(authUseCase), bookHandler.SuggestTagsHandler)
+	tags.Post("/bulk", middleware.JWTAuthMiddleware(authUseCase), bookHandler.BulkTagBooksHandler)
+
 	// ISBN 기반 리뷰 API
 	reviewsAPI := api.Group("/reviews")
 	reviewsAPI.Get("/me", middleware.JWTAuthMiddleware(authUseCase), reviewHandler.GetMyReviewsHandler)
@@ -166,10 +380,19 @@ func main() {
 	reminders := api.Group("/reminders")
 	reminders.Post("/", middleware.JWTAuthMiddleware(authUseCase), reminderHandler.CreateReminderHandler)
 	reminders.Get("/", middleware.JWTAuthMiddleware(authUseCase), reminderHandler.GetRemindersHandler)
+	reminders.Get("/:id", middleware.JWTAuthMiddleware(authUseCase), reminderHandler.GetReminderHandler)
 	reminders.Put("/:id", middleware.JWTAuthMiddleware(authUseCase), reminderHandler.UpdateReminderHandler)
 	reminders.Patch("/:id/toggle", middleware.JWTAuthMiddleware(authUseCase), reminderHandler.ToggleReminderHandler)
 	reminders.Delete("/:id", middleware.JWTAuthMiddleware(authUseCase), reminderHandler.DeleteReminderHandler)
 
+	// 독서 목표 API
+	goals := api.Group("/goals")
+	goals.Post("/", middleware.JWTAuthMiddleware(authUseCase), goalHandler.CreateGoalHandler)
+	goals.Get("/", middleware.JWTAuthMiddleware(authUseCase), goalHandler.GetGoalsHandler)
+	goals.Get("/:id", middleware.JWTAuthMiddleware(authUseCase), goalHandler.GetGoalHandler)
+	goals.Patch("/:id", middleware.JWTAuthMiddleware(authUseCase), goalHandler.UpdateGoalHandler)
+	goals.Delete("/:id", middleware.JWTAuthMiddleware(authUseCase), goalHandler.DeleteGoalHandler)
+
 	auth := api.Group("/auth")
 	auth.Post("/refresh", authHandler.RefreshTokenHandler)
 	auth.Post("/revoke-all", middleware.JWTAuthMiddleware(authUseCase), authHandler.RevokeAllTokensHandler)
@@ -188,6 +411,7 @@ func main() {
 	admin.Post("/api-keys", adminHandler.CreateAPIKeyHandler)
 	admin.Patch("/api-keys/:id/deactivate", adminHandler.DeactivateAPIKeyHandler)
 	admin.Delete("/api-keys/:id", adminHandler.DeleteAPIKeyHandler)
+	admin.Delete("/book-metadata/cache", adminHandler.PurgeBookMetadataCacheHandler)
 
 	if err := app.Listen(":3000"); err != nil {
 		logger.Sugar().Fatalf("서버를 시작하는 도중 오류가 발생했습니다: %v", err)
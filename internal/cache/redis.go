@@ -47,6 +47,19 @@ func (r *RedisClient) SetNX(key string, value interface{}, expiration time.Durat
 	return r.client.SetNX(r.ctx, key, value, expiration).Result()
 }
 
+// 키의 값이 기대한 값일 때만 삭제합니다. 만료 후 다른 요청이 다시 잡은 락을 지우지 않기 위해 사용합니다.
+var deleteIfEqualScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// DeleteIfEqual 키의 값이 value와 같을 때만 삭제하고, 삭제했는지 여부를 반환합니다.
+func (r *RedisClient) DeleteIfEqual(key string, value string) (bool, error) {
+	deleted, err := deleteIfEqualScript.Run(r.ctx, r.client, []string{key}, value).Int()
+	return deleted > 0, err
+}
+
 func (r *RedisClient) Incr(key string) (int64, error) {
 	return r.client.Incr(r.ctx, key).Result()
 }
@@ -55,6 +68,22 @@ func (r *RedisClient) Expire(key string, expiration time.Duration) error {
 	return r.client.Expire(r.ctx, key, expiration).Err()
 }
 
+// DeleteByPattern SCAN으로 패턴과 일치하는 키를 찾아 삭제하고, 삭제한 키의 수를 반환합니다.
+func (r *RedisClient) DeleteByPattern(pattern string) (int64, error) {
+	var deleted int64
+
+	iter := r.client.Scan(r.ctx, 0, pattern, 100).Iterator()
+	for iter.Next(r.ctx) {
+		n, err := r.client.Del(r.ctx, iter.Val()).Result()
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+
+	return deleted, iter.Err()
+}
+
 func (r *RedisClient) Close() error {
 	return r.client.Close()
 }
\ No newline at end of file
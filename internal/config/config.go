@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dev-hyunsang/my-own-library-backend/logger"
 	"github.com/joho/godotenv"
@@ -16,6 +18,26 @@ type Config struct {
 	JWT   JWTConfig   `json:"jwt"`
 	FCM   FCMConfig   `json:"fcm"`
 	Admin AdminConfig `json:"admin"`
+	Book  BookConfig  `json:"book"`
+	Trash TrashConfig `json:"trash"`
+}
+
+// TrashConfig 휴지통 설정
+// Retention이 지난 책과 리뷰는 스케줄러가 영구 삭제합니다.
+type TrashConfig struct {
+	Retention time.Duration `json:"retention"`
+}
+
+// BookConfig 도서 정보 제공자 설정
+// Providers의 순서가 곧 우선순위이며, 앞선 제공자가 실패하거나 결과가 없으면 다음 제공자로 넘어갑니다.
+type BookConfig struct {
+	Providers         []string      `json:"providers"`
+	Timeout           time.Duration `json:"timeout"`
+	CacheTTL          time.Duration `json:"cache_ttl"`
+	NegativeCacheTTL  time.Duration `json:"negative_cache_ttl"`
+	NaverClientID     string        `json:"naver_client_id"`
+	NaverClientSecret string        `json:"naver_client_secret"`
+	KakaoRESTAPIKey   string        `json:"kakao_rest_api_key"`
 }
 
 type AdminConfig struct {
@@ -99,6 +121,31 @@ func LoadConfig(env string) (*Config, error) {
 		return nil, fmt.Errorf("invalid REDIS_DB: %w", err)
 	}
 
+	// 도서 정보 제공자 설정
+	bookProviderTimeout, err := time.ParseDuration(getEnvOrDefault("BOOK_PROVIDER_TIMEOUT", "5s"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid BOOK_PROVIDER_TIMEOUT: %w", err)
+	}
+
+	bookCacheTTL, err := time.ParseDuration(getEnvOrDefault("BOOK_CACHE_TTL", "24h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid BOOK_CACHE_TTL: %w", err)
+	}
+
+	bookNegativeCacheTTL, err := time.ParseDuration(getEnvOrDefault("BOOK_CACHE_NEGATIVE_TTL", "10m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid BOOK_CACHE_NEGATIVE_TTL: %w", err)
+	}
+
+	// 휴지통 설정
+	trashRetention, err := time.ParseDuration(getEnvOrDefault("TRASH_RETENTION", "720h"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TRASH_RETENTION: %w", err)
+	}
+	if trashRetention <= 0 {
+		return nil, fmt.Errorf("invalid TRASH_RETENTION: must be positive")
+	}
+
 	config := &Config{
 		App: AppConfig{
 			Env:   getEnvOrDefault("APP_ENV", "development"),
@@ -138,6 +185,18 @@ func LoadConfig(env string) (*Config, error) {
 		Admin: AdminConfig{
 			BootstrapKey: getEnvOrDefault("ADMIN_BOOTSTRAP_KEY", ""),
 		},
+		Book: BookConfig{
+			Providers:         splitEnvList(getEnvOrDefault("BOOK_PROVIDERS", "naver,kakao,openlibrary")),
+			Timeout:           bookProviderTimeout,
+			CacheTTL:          bookCacheTTL,
+			NegativeCacheTTL:  bookNegativeCacheTTL,
+			NaverClientID:     getEnvOrDefault("NAVER_API_CLIENT_ID", ""),
+			NaverClientSecret: getEnvOrDefault("NAVER_API_CLIENT_SECRET", ""),
+			KakaoRESTAPIKey:   getEnvOrDefault("KAKAO_REST_API_KEY", ""),
+		},
+		Trash: TrashConfig{
+			Retention: trashRetention,
+		},
 	}
 
 	// 필수 값 검증
@@ -155,6 +214,17 @@ func getEnvOrDefault(key, defaultValue string) string {
 	return defaultValue
 }
 
+// 쉼표로 구분된 환경 변수 값을 공백을 제거한 목록으로 변환합니다.
+func splitEnvList(value string) []string {
+	var result []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, strings.ToLower(v))
+		}
+	}
+	return result
+}
+
 func validateConfig(config *Config) error {
 	if config.DB.MySQL.DBName == "" {
 		return fmt.Errorf("MYSQL_DBNAME is required")
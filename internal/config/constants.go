@@ -27,3 +27,115 @@ const (
 	VerificationCodeExpiry = 5 * time.Minute
 	TempPasswordLength     = 15
 )
+
+// Pagination configuration
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Book registration configuration
+const (
+	MaxISBNBatchSize = 50
+	UnknownAuthor    = "저자 미상"
+	MaxMergeBooks    = 20
+)
+
+// Book copy configuration
+const (
+	MaxLocationLength     = 50
+	MaxAcquiredFromLength = 100
+	MaxBookPrice          = 9999999999.99 // decimal(12,2)
+	DefaultCurrency       = "KRW"
+)
+
+// Shelf configuration
+const (
+	MaxShelfNameLength        = 50
+	MaxShelfDescriptionLength = 300
+)
+
+// Tag configuration
+const (
+	MaxTagLength         = 30
+	MaxTagsPerRequest    = 20
+	MaxBulkTagBooks      = 100
+	DefaultTagSuggestion = 10
+)
+
+// Book note configuration
+const (
+	MaxNoteTextLength     = 5000
+	MaxNoteLocationLength = 100
+	MaxNoteLabelLength    = 30
+)
+
+// Book import configuration
+const (
+	MaxImportFileSize     = 3 * 1024 * 1024 // 3MB
+	MaxImportRows         = 5000
+	ImportJobTTL          = 7 * 24 * time.Hour
+	ImportLockTTL         = 30 * time.Minute
+	ImportProgressEvery   = 20
+	ImportedReviewContent = "(가져온 별점)"
+)
+
+// Library export configuration
+const (
+	ExportPageSize = 200
+)
+
+// Book loan configuration
+const (
+	MaxBorrowerNameLength    = 50
+	MaxBorrowerContactLength = 100
+	MaxLoanNotesLength       = 1000
+	// LoanReminderLead 반납 예정일 알림을 보내는 시점 (예정일 기준)
+	LoanReminderLead = 24 * time.Hour
+)
+
+// Borrow request configuration
+const (
+	MaxBorrowMessageLength = 500
+)
+
+// Wishlist configuration
+const (
+	MaxWishlistNoteLength      = 1000
+	MaxWishlistClaimNameLength = 30
+	WishlistShareTokenBytes    = 16
+	WishlistClaimCodeBytes     = 8
+)
+
+// Book series configuration
+const (
+	MaxSeriesNameLength = 100
+	MaxSeriesVolume     = 999
+)
+
+// Book edit configuration
+const (
+	MaxBookTitleLength     = 255 // varchar(255)
+	MaxBookAuthorLength    = 255
+	MaxBookPublisherLength = 255
+	MaxPublishedDateLength = 30
+	MaxThumbnailURLLength  = 255
+)
+
+// Library configuration
+const (
+	MaxLibraryNameLength = 50
+)
+
+// Reading goal configuration
+const (
+	MinReadingGoalYear   = 2000
+	MaxReadingGoalYear   = 2100
+	MaxReadingGoalTarget = 1000000
+	// ReadingGoalReminderInterval 같은 목표에 속도 알림을 다시 보내기까지의 최소 간격
+	ReadingGoalReminderInterval = 7 * 24 * time.Hour
+	// ReadingGoalReminderMinDays 연초에는 한두 권 차이로도 뒤처지므로 이 일수가 지난 뒤부터 알림을 보냅니다.
+	ReadingGoalReminderMinDays = 14
+	// ReadingGoalReminderHour 속도 알림을 보내는 시각(사용자 타임존 기준 시)
+	ReadingGoalReminderHour = 19
+)
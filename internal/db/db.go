@@ -43,11 +43,17 @@ func NewDBConnection(config *config.Config) (*ent.Client, error) {
 	// sql.open을 ent.Client으로 변환함.
 	drv := entsql.OpenDB(config.DB.MySQL.Driver, db)
 
-	if err := CreateTable(ent.NewClient(ent.Driver(drv))); err != nil {
+	client := ent.NewClient(ent.Driver(drv))
+
+	if err := CreateTable(client); err != nil {
 		return nil, fmt.Errorf("failed to create user table: %w", err)
 	}
 
-	return ent.NewClient(ent.Driver(drv)), nil
+	if err := RunDataMigrations(client, db); err != nil {
+		return nil, fmt.Errorf("failed to run data migrations: %w", err)
+	}
+
+	return client, nil
 }
 
 func CreateTable(client *ent.Client) error {
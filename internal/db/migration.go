@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/datamigration"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+)
+
+// dataMigration 스키마 자동 마이그레이션으로 처리할 수 없는 기존 데이터 변환 작업입니다.
+// 적용된 작업은 data_migrations 테이블에 이름으로 기록되어 한 번만 실행됩니다.
+// ent 스키마에서 제거된 레거시 컬럼은 db를 통해 직접 SQL로 다룹니다.
+type dataMigration struct {
+	name string
+	run  func(ctx context.Context, client *ent.Client, db *sql.DB) error
+}
+
+// 등록 순서대로 실행되므로 새 작업은 항상 목록 끝에 추가합니다.
+var dataMigrations = []dataMigration{
+	{name: "20261016_normalize_isbn", run: normalizeISBNs},
+	{name: "20261016_book_catalog", run: migrateBookCatalog},
+	{name: "20261016_book_status", run: migrateBookStatus},
+	{name: "20261017_live_books", run: migrateLiveBooks},
+}
+
+// 데이터 마이그레이션 배치 크기
+const migrationBatchSize = 500
+
+// RunDataMigrations 아직 적용되지 않은 데이터 마이그레이션을 순서대로 실행합니다.
+func RunDataMigrations(client *ent.Client, db *sql.DB) error {
+	ctx := context.Background()
+
+	for _, m := range dataMigrations {
+		applied, err := client.DataMigration.Query().
+			Where(datamigration.Name(m.name)).
+			Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("데이터 마이그레이션 적용 여부 확인 실패(%s): %w", m.name, err)
+		}
+		if applied {
+			continue
+		}
+
+		logger.Sugar().Infof("데이터 마이그레이션을 시작합니다: %s", m.name)
+
+		if err := m.run(ctx, client, db); err != nil {
+			return fmt.Errorf("데이터 마이그레이션 실행 실패(%s): %w", m.name, err)
+		}
+
+		if _, err := client.DataMigration.Create().SetName(m.name).Save(ctx); err != nil {
+			return fmt.Errorf("데이터 마이그레이션 기록 실패(%s): %w", m.name, err)
+		}
+
+		logger.Sugar().Infof("데이터 마이그레이션이 완료되었습니다: %s", m.name)
+	}
+
+	return nil
+}
+
+// 테이블에 컬럼이 남아 있는지 확인합니다. 레거시 컬럼이 없는 새 데이터베이스에서는 변환을 건너뜁니다.
+func columnExists(ctx context.Context, db *sql.DB, table, column string) (bool, error) {
+	var count int
+	err := db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?",
+		table, column,
+	).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("컬럼 존재 여부 확인 실패(%s.%s): %w", table, column, err)
+	}
+
+	return count > 0, nil
+}
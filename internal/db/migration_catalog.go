@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/isbn"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/google/uuid"
+)
+
+// 카탈로그로 옮긴 뒤 books 테이블에서 제거하는 레거시 서지 정보 컬럼
+var legacyBookColumns = []string{"book_title", "author", "book_isbn", "thumbnail_url"}
+
+type legacyBook struct {
+	id           string
+	title        string
+	author       string
+	isbn         string
+	thumbnailURL string
+}
+
+// migrateBookCatalog 책마다 중복 저장되던 서지 정보를 공유 카탈로그로 옮깁니다.
+// 같은 ISBN의 책은 하나의 카탈로그 항목을 공유하고, ISBN이 없는 책은 책마다 개인 항목을 만듭니다.
+// 리뷰는 book_isbn이 같은 카탈로그 항목에 연결되며, 옮긴 뒤에는 레거시 컬럼을 삭제합니다.
+func migrateBookCatalog(ctx context.Context, client *ent.Client, db *sql.DB) error {
+	exists, err := columnExists(ctx, db, "books", "book_title")
+	if err != nil || !exists {
+		return err
+	}
+
+	catalogs := make(map[string]uuid.UUID)
+	var (
+		lastID string
+		linked int
+	)
+
+	for {
+		batch, err := loadLegacyBooks(ctx, db, lastID)
+		if err != nil {
+			return err
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, b := range batch {
+			catalogID, err := legacyCatalogID(ctx, client, catalogs, b)
+			if err != nil {
+				return fmt.Errorf("카탈로그 항목 생성 실패(%s): %w", b.id, err)
+			}
+
+			// SQL로 직접 연결하므로 updated_at은 변경되지 않습니다.
+			if _, err := db.ExecContext(ctx,
+				"UPDATE books SET book_catalog_copies = ? WHERE id = ?",
+				catalogID.String(), b.id,
+			); err != nil {
+				return fmt.Errorf("책과 카탈로그 항목 연결 실패(%s): %w", b.id, err)
+			}
+			linked++
+		}
+
+		lastID = batch[len(batch)-1].id
+	}
+
+	logger.Sugar().Infof("책 카탈로그 이전 완료: 책 %d건, ISBN 카탈로그 항목 %d건", linked, len(catalogs))
+
+	if _, err := db.ExecContext(ctx,
+		`UPDATE reviews r
+		JOIN book_catalogs c ON c.isbn = r.book_isbn
+		SET r.book_catalog_reviews = c.id
+		WHERE r.book_catalog_reviews IS NULL`,
+	); err != nil {
+		return fmt.Errorf("리뷰와 카탈로그 항목 연결 실패: %w", err)
+	}
+
+	for _, column := range legacyBookColumns {
+		exists, err := columnExists(ctx, db, "books", column)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+
+		if _, err := db.ExecContext(ctx, fmt.Sprintf("ALTER TABLE books DROP COLUMN %s", column)); err != nil {
+			return fmt.Errorf("레거시 컬럼 삭제 실패(books.%s): %w", column, err)
+		}
+	}
+
+	return nil
+}
+
+// 아직 카탈로그에 연결되지 않은 책을 ID 순서로 한 배치씩 읽습니다.
+func loadLegacyBooks(ctx context.Context, db *sql.DB, afterID string) ([]legacyBook, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT id, book_title, author, COALESCE(book_isbn, ''), COALESCE(thumbnail_url, '')
+		FROM books
+		WHERE id > ? AND book_catalog_copies IS NULL
+		ORDER BY id
+		LIMIT ?`,
+		afterID, migrationBatchSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("레거시 책 목록 조회 실패: %w", err)
+	}
+	defer rows.Close()
+
+	var batch []legacyBook
+	for rows.Next() {
+		var b legacyBook
+		if err := rows.Scan(&b.id, &b.title, &b.author, &b.isbn, &b.thumbnailURL); err != nil {
+			return nil, fmt.Errorf("레거시 책 목록 조회 실패: %w", err)
+		}
+		batch = append(batch, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("레거시 책 목록 조회 실패: %w", err)
+	}
+
+	return batch, nil
+}
+
+// 레거시 책에 해당하는 카탈로그 항목 ID를 반환합니다. 같은 ISBN은 처음 만난 책의 서지 정보로 한 번만 만듭니다.
+func legacyCatalogID(ctx context.Context, client *ent.Client, catalogs map[string]uuid.UUID, b legacyBook) (uuid.UUID, error) {
+	// 정규화할 수 없는 ISBN은 값을 잃지 않도록 원래 문자열 그대로 카탈로그 키로 사용합니다.
+	key := b.isbn
+	if normalized, err := isbn.Normalize(b.isbn); err == nil {
+		key = normalized
+	}
+
+	if key == "" {
+		created, err := client.BookCatalog.Create().
+			SetTitle(b.title).
+			SetAuthor(b.author).
+			SetThumbnailURL(b.thumbnailURL).
+			Save(ctx)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		return created.ID, nil
+	}
+
+	if id, ok := catalogs[key]; ok {
+		return id, nil
+	}
+
+	// 이전 실행이 중간에 실패한 경우 이미 만들어진 항목을 재사용합니다.
+	id, err := client.BookCatalog.Query().
+		Where(bookcatalog.Isbn(key)).
+		OnlyID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return uuid.Nil, err
+	}
+
+	if ent.IsNotFound(err) {
+		created, err := client.BookCatalog.Create().
+			SetIsbn(key).
+			SetTitle(b.title).
+			SetAuthor(b.author).
+			SetThumbnailURL(b.thumbnailURL).
+			Save(ctx)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		id = created.ID
+	}
+
+	catalogs[key] = id
+	return id, nil
+}
@@ -0,0 +1,141 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/isbn"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/google/uuid"
+)
+
+// normalizeISBNs 책과 리뷰에 저장된 book_isbn을 하이픈 없는 ISBN-13으로 통일합니다.
+// 체크섬이 맞지 않는 값은 임의로 바꾸지 않고 경고만 남깁니다.
+func normalizeISBNs(ctx context.Context, client *ent.Client, db *sql.DB) error {
+	if err := normalizeLegacyBookISBNs(ctx, db); err != nil {
+		return err
+	}
+
+	var (
+		lastID  uuid.UUID
+		updated int
+	)
+
+	for {
+		reviews, err := client.Review.Query().
+			Where(review.IDGT(lastID)).
+			Order(ent.Asc(review.FieldID)).
+			Limit(migrationBatchSize).
+			All(ctx)
+		if err != nil {
+			return fmt.Errorf("리뷰 목록 조회 실패: %w", err)
+		}
+		if len(reviews) == 0 {
+			break
+		}
+
+		for _, r := range reviews {
+			normalized, ok := migratedISBN(r.BookIsbn)
+			if !ok {
+				continue
+			}
+
+			// updated_at이 갱신되지 않도록 기존 값을 그대로 지정합니다.
+			if err := client.Review.UpdateOneID(r.ID).
+				SetBookIsbn(normalized).
+				SetUpdatedAt(r.UpdatedAt).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("리뷰 ISBN 정규화 실패(%s): %w", r.ID, err)
+			}
+			updated++
+		}
+
+		lastID = reviews[len(reviews)-1].ID
+	}
+
+	logger.Sugar().Infof("리뷰 ISBN 정규화 완료: %d건", updated)
+
+	return nil
+}
+
+// books.book_isbn은 카탈로그 도입 이후 레거시 컬럼이므로 SQL로 직접 정규화합니다.
+func normalizeLegacyBookISBNs(ctx context.Context, db *sql.DB) error {
+	exists, err := columnExists(ctx, db, "books", "book_isbn")
+	if err != nil || !exists {
+		return err
+	}
+
+	type legacyISBN struct {
+		id   string
+		isbn string
+	}
+
+	var (
+		lastID  string
+		updated int
+	)
+
+	for {
+		rows, err := db.QueryContext(ctx,
+			"SELECT id, book_isbn FROM books WHERE id > ? AND book_isbn IS NOT NULL AND book_isbn <> '' ORDER BY id LIMIT ?",
+			lastID, migrationBatchSize,
+		)
+		if err != nil {
+			return fmt.Errorf("책 목록 조회 실패: %w", err)
+		}
+
+		var batch []legacyISBN
+		for rows.Next() {
+			var row legacyISBN
+			if err := rows.Scan(&row.id, &row.isbn); err != nil {
+				rows.Close()
+				return fmt.Errorf("책 목록 조회 실패: %w", err)
+			}
+			batch = append(batch, row)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("책 목록 조회 실패: %w", err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, row := range batch {
+			normalized, ok := migratedISBN(row.isbn)
+			if !ok {
+				continue
+			}
+
+			// SQL로 직접 수정하므로 updated_at은 변경되지 않습니다.
+			if _, err := db.ExecContext(ctx, "UPDATE books SET book_isbn = ? WHERE id = ?", normalized, row.id); err != nil {
+				return fmt.Errorf("책 ISBN 정규화 실패(%s): %w", row.id, err)
+			}
+			updated++
+		}
+
+		lastID = batch[len(batch)-1].id
+	}
+
+	logger.Sugar().Infof("책 ISBN 정규화 완료: %d건", updated)
+
+	return nil
+}
+
+// 변경이 필요한 경우에만 정규화된 값과 true를 반환합니다.
+func migratedISBN(raw string) (string, bool) {
+	if raw == "" {
+		return "", false
+	}
+
+	normalized, err := isbn.Normalize(raw)
+	if err != nil {
+		logger.Sugar().Warnf("유효하지 않은 ISBN은 정규화하지 않습니다: %q", raw)
+		return "", false
+	}
+
+	return normalized, normalized != raw
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+)
+
+// migrateLiveBooks 서재에 있는 책의 live 컬럼을 채워 사용자당 같은 카탈로그 항목의 책이 하나만 등록되도록 합니다.
+// 중복 등록을 막기 전에 저장된 책이 여러 권이면 먼저 등록한 책만 표시하고, 나머지는 병합 API로 정리할 수 있게 남겨 둡니다.
+// MySQL은 UPDATE 대상 테이블을 서브쿼리에서 다시 읽지 못하므로(오류 1093) 표시할 책 ID를 먼저 조회한 뒤 ID로 수정합니다.
+func migrateLiveBooks(ctx context.Context, client *ent.Client, db *sql.DB) error {
+	ids, err := firstLiveBookIDs(ctx, db)
+	if err != nil {
+		return err
+	}
+
+	var marked int64
+	for start := 0; start < len(ids); start += migrationBatchSize {
+		batch := ids[start:min(start+migrationBatchSize, len(ids))]
+
+		args := make([]any, len(batch))
+		for i, id := range batch {
+			args[i] = id
+		}
+
+		result, err := db.ExecContext(ctx,
+			"UPDATE books SET live = TRUE WHERE id IN (?"+strings.Repeat(", ?", len(batch)-1)+")",
+			args...,
+		)
+		if err != nil {
+			return fmt.Errorf("서재에 있는 책 표시 실패: %w", err)
+		}
+		n, _ := result.RowsAffected()
+		marked += n
+	}
+
+	logger.Sugar().Infof("서재에 있는 책 표시 완료: 책 %d건", marked)
+
+	return nil
+}
+
+// 사용자와 카탈로그 항목마다 휴지통에 없는 책 중 가장 먼저 등록한 책의 ID를 조회합니다.
+func firstLiveBookIDs(ctx context.Context, db *sql.DB) ([]string, error) {
+	rows, err := db.QueryContext(ctx,
+		`SELECT b.id FROM books b
+		WHERE b.deleted_at IS NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM books o
+			WHERE o.deleted_at IS NULL
+			AND o.user_books = b.user_books
+			AND o.book_catalog_copies = b.book_catalog_copies
+			AND (o.created_at < b.created_at OR (o.created_at = b.created_at AND o.id < b.id))
+		)`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("서재에 있는 책 조회 실패: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("서재에 있는 책 조회 실패: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("서재에 있는 책 조회 실패: %w", err)
+	}
+
+	return ids, nil
+}
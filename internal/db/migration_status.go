@@ -0,0 +1,49 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+)
+
+// migrateBookStatus 정수로 저장되던 읽기 상태(0: 읽지 않음, 1: 읽는 중, 2: 읽음)를 reading_status로 옮깁니다.
+// 정확한 시작/완료 시간은 알 수 없으므로 마지막 수정 시간을 사용하고, 현재 상태를 첫 변경 기록으로 남긴 뒤
+// 레거시 status 컬럼을 삭제합니다.
+func migrateBookStatus(ctx context.Context, client *ent.Client, db *sql.DB) error {
+	exists, err := columnExists(ctx, db, "books", "status")
+	if err != nil || !exists {
+		return err
+	}
+
+	// SQL로 직접 변환하므로 updated_at은 변경되지 않습니다.
+	result, err := db.ExecContext(ctx,
+		`UPDATE books SET
+			reading_status = CASE status WHEN 1 THEN 'reading' WHEN 2 THEN 'finished' ELSE 'unread' END,
+			started_at = CASE WHEN status IN (1, 2) THEN updated_at END,
+			finished_at = CASE WHEN status = 2 THEN updated_at END`,
+	)
+	if err != nil {
+		return fmt.Errorf("읽기 상태 변환 실패: %w", err)
+	}
+	converted, _ := result.RowsAffected()
+
+	if _, err := db.ExecContext(ctx,
+		`INSERT INTO book_status_histories (id, from_status, to_status, changed_at, book_status_histories)
+		SELECT UUID(), NULL, b.reading_status, b.updated_at, b.id
+		FROM books b
+		WHERE NOT EXISTS (SELECT 1 FROM book_status_histories h WHERE h.book_status_histories = b.id)`,
+	); err != nil {
+		return fmt.Errorf("읽기 상태 변경 기록 생성 실패: %w", err)
+	}
+
+	if _, err := db.ExecContext(ctx, "ALTER TABLE books DROP COLUMN status"); err != nil {
+		return fmt.Errorf("레거시 컬럼 삭제 실패(books.status): %w", err)
+	}
+
+	logger.Sugar().Infof("읽기 상태 이전 완료: 책 %d건", converted)
+
+	return nil
+}
@@ -6,16 +6,73 @@ import (
 	"github.com/google/uuid"
 )
 
+// Book 사용자가 소유한 책 한 권(사본)입니다.
+// 제목, 저자, ISBN 등 서지 정보는 공유 카탈로그 항목에서 채워집니다.
 type Book struct {
-	ID           uuid.UUID `json:"id"`
-	OwnerID      uuid.UUID `json:"user_id"`
-	Title        string    `json:"title"`
-	Author       string    `json:"author"`
-	BookISBN     string    `json:"book_isbn"`
-	ThumbnailURL string    `json:"thumbnail_url"`
-	Status       int       `json:"status"`
-	CreatedAt    time.Time `json:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at"`
+	ID            uuid.UUID      `json:"id"`
+	OwnerID       uuid.UUID      `json:"user_id"`
+	CatalogID     uuid.UUID      `json:"catalog_id"`
+	Title         string         `json:"title"`
+	Author        string         `json:"author"`
+	BookISBN      string         `json:"book_isbn"`
+	ThumbnailURL  string         `json:"thumbnail_url"`
+	Publisher     string         `json:"publisher,omitempty"`
+	PublishedDate string         `json:"published_date,omitempty"`
+	Status        BookStatus     `json:"status"`
+	StartedAt     *time.Time     `json:"started_at,omitempty"`
+	FinishedAt    *time.Time     `json:"finished_at,omitempty"`
+	Tags          []string       `json:"tags,omitempty"`
+	CurrentPage   int            `json:"current_page"`
+	TotalPages    int            `json:"total_pages"`
+	IsLent        bool           `json:"is_lent"` // 빌려주고 아직 돌려받지 못한 책
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     *time.Time     `json:"deleted_at,omitempty"` // 휴지통으로 옮긴 시간
+	Version       int            `json:"version"`              // 수정할 때마다 1씩 증가하며 ETag로 사용합니다.
+	LibraryID     *uuid.UUID     `json:"library_id,omitempty"` // 책을 함께 쓰는 공유 서재 (없으면 개인 서재)
+	Visibility    BookVisibility `json:"visibility"`           // 공개 프로필에서의 공개 범위
+	BookCopyDetails
+	BookSeries
+	// MetadataSource 카탈로그 항목을 새로 만들 때 기록할 도서 정보 출처입니다.
+	MetadataSource string `json:"-"`
+}
+
+// BookCatalog 같은 ISBN의 책이 함께 쓰는 공유 서지 정보 항목입니다.
+type BookCatalog struct {
+	ID            uuid.UUID `json:"id"`
+	ISBN          string    `json:"isbn"`
+	Title         string    `json:"title"`
+	Author        string    `json:"author"`
+	ThumbnailURL  string    `json:"thumbnail_url"`
+	Publisher     string    `json:"publisher,omitempty"`
+	PublishedDate string    `json:"published_date,omitempty"`
+}
+
+// BookStatusHistory 책의 읽기 상태가 바뀐 기록입니다. 책을 처음 등록할 때는 FromStatus가 nil입니다.
+type BookStatusHistory struct {
+	ID         uuid.UUID   `json:"id"`
+	BookID     uuid.UUID   `json:"book_id"`
+	FromStatus *BookStatus `json:"from_status"`
+	ToStatus   BookStatus  `json:"to_status"`
+	ChangedAt  time.Time   `json:"changed_at"`
+}
+
+// UpdateBookStatusRequest 책의 읽기 상태만 변경합니다.
+type UpdateBookStatusRequest struct {
+	Status BookStatus `json:"status"`
+}
+
+// PatchBookRequest 보낸 항목만 수정합니다. 생략한 항목(nil)은 현재 값을 유지하며,
+// ISBN, 표지, 출판사, 출간일은 빈 문자열을 보내면 지워집니다.
+// 이미 등록된 ISBN으로 바꾸면 서지 정보는 해당 카탈로그 항목을 따르므로, 함께 보낸 서지 정보가 이와 다르면 거부합니다.
+type PatchBookRequest struct {
+	Title         *string     `json:"title,omitempty"`
+	Author        *string     `json:"author,omitempty"`
+	BookISBN      *string     `json:"book_isbn,omitempty"`
+	ThumbnailURL  *string     `json:"thumbnail_url,omitempty"`
+	Publisher     *string     `json:"publisher,omitempty"`
+	PublishedDate *string     `json:"published_date,omitempty"`
+	Status        *BookStatus `json:"status,omitempty"`
 }
 
 type Bookmark struct {
@@ -25,15 +82,115 @@ type Bookmark struct {
 	CreatedAt time.Time `json:"created_at"`
 }
 
+// BookSortField 책 목록 정렬 기준
+type BookSortField string
+
+const (
+	BookSortByCreatedAt BookSortField = "created_at"
+	BookSortByTitle     BookSortField = "title"
+	BookSortByAuthor    BookSortField = "author"
+)
+
+// BookListFilter 책 목록 조회 시 사용하는 필터, 정렬 및 커서 조건
+type BookListFilter struct {
+	Status        *BookStatus
+	ShelfID       *uuid.UUID
+	Location      BookLocation
+	Lent          *bool // true면 대여 중인 책만, false면 대여 중이 아닌 책만
+	Tags          []string
+	TagMatch      TagMatch
+	Author        string
+	ISBNPrefix    string
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	SortBy        BookSortField
+	Order         SortOrder
+	Cursor        string
+	Limit         int
+}
+
+// BookPage 커서 기반으로 조회한 책 목록의 한 페이지
+type BookPage struct {
+	Items []*Book `json:"items"`
+	PageInfo
+}
+
+// BookISBNResult ISBN 일괄 등록 시 항목별 처리 결과
+type BookISBNResult struct {
+	ISBN    string `json:"isbn"`
+	Success bool   `json:"success"`
+	Book    *Book  `json:"book,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// DuplicateBookError 같은 ISBN의 책이 이미 서재에 있을 때 반환하며 기존 책의 ID를 담습니다.
+// errors.Is(err, ErrDuplicateBook)로 구분할 수 있습니다.
+type DuplicateBookError struct {
+	BookID uuid.UUID
+}
+
+func (e *DuplicateBookError) Error() string {
+	return ErrDuplicateBook.Error()
+}
+
+func (e *DuplicateBookError) Unwrap() error {
+	return ErrDuplicateBook
+}
+
+// DuplicateBookGroup 같은 ISBN으로 두 번 이상 등록된 책 묶음입니다. 책은 먼저 등록한 순서입니다.
+type DuplicateBookGroup struct {
+	ISBN  string  `json:"isbn"`
+	Books []*Book `json:"books"`
+}
+
+// MergeBooksRequest 중복된 책들을 대상 책 하나로 합칩니다.
+type MergeBooksRequest struct {
+	SourceIDs []uuid.UUID `json:"source_ids"`
+}
+
 type BookRepository interface {
 	SaveByBookID(id uuid.UUID, book *Book) (*Book, error)
 	GetBookByID(userID, id uuid.UUID) (*Book, error)
+	// GetBookAccess 책 소유자이거나 책이 있는 공유 서재의 구성원이면 책과 사용자의 역할을 반환합니다.
+	GetBookAccess(userID, id uuid.UUID) (*Book, LibraryRole, error)
 	GetBookByISBN(userID uuid.UUID, isbn string) (*Book, error)
 	GetAnyBookByISBN(isbn string) (*Book, error)
+	// GetVisibleBookByID 다른 사람에게 보이는 책만 조회합니다. 숨긴 책은 ErrNotFound를 반환합니다.
+	GetVisibleBookByID(id uuid.UUID) (*Book, error)
 	GetBooksByUserID(id uuid.UUID) ([]*Book, error)
-	Edit(id uuid.UUID, book *Book) error
-	DeleteByID(userID, id uuid.UUID) error
-	GetBooksByUserName(name string) ([]*Book, error)
+	Edit(id uuid.UUID, book *Book, expected ExpectedVersions) error
+	GetCatalogByISBN(isbn string) (*BookCatalog, error)
+	UpdateProgress(id uuid.UUID, currentPage, totalPages int) error
+	UpdateStatus(id uuid.UUID, book *Book, expected ExpectedVersions) error
+	GetStatusHistory(bookID uuid.UUID) ([]*BookStatusHistory, error)
+	// Book Tag
+	AddTags(userID uuid.UUID, bookIDs []uuid.UUID, names []string) error
+	RemoveTags(userID uuid.UUID, bookIDs []uuid.UUID, names []string) error
+	GetTagsByUserID(userID uuid.UUID, prefix string) ([]*Tag, error)
+	// Book Duplicate
+	GetDuplicateBooks(userID uuid.UUID) ([]*DuplicateBookGroup, error)
+	// MergeBooks 원본 책들의 북마크, 리뷰, 메모, 독서 세션, 상태 기록, 태그, 책장을 대상 책으로 옮기고 원본을 삭제합니다.
+	MergeBooks(targetID uuid.UUID, sourceIDs []uuid.UUID) error
+	// Book Copy
+	UpdateCopyDetails(id uuid.UUID, details *BookCopyDetails, expected ExpectedVersions) error
+	GetLocationSummary(userID uuid.UUID, level LocationSummaryLevel) ([]*BookLocationSummary, error)
+	// Book Series
+	UpdateSeries(id uuid.UUID, series *BookSeries, expected ExpectedVersions) error
+	GetSeriesBooks(userID uuid.UUID) ([]*Book, error)
+	// Book Visibility
+	UpdateVisibility(id uuid.UUID, visibility BookVisibility, expected ExpectedVersions) error
+	// DeleteByID 책을 휴지통으로 옮깁니다.
+	DeleteByID(userID, id uuid.UUID, expected ExpectedVersions) error
+	ListBooksByUserID(userID uuid.UUID, filter *BookListFilter) (*BookPage, error)
+	// Book Trash
+	GetDeletedBooks(userID uuid.UUID) ([]*Book, error)
+	GetDeletedBookByID(userID, id uuid.UUID) (*Book, error)
+	RestoreByID(id uuid.UUID) error
+	// PurgeByID 휴지통의 책을 영구 삭제합니다. 리뷰는 책과의 연결만 끊고 남겨 둡니다.
+	PurgeByID(id uuid.UUID) error
+	PurgeDeletedBefore(before time.Time) (int, error)
 	// Book Bookmark
 	AddBookmarkByBookID(userID, bookID uuid.UUID) (*Bookmark, error)
 	GetBookmarksByUserID(userID uuid.UUID) ([]*Bookmark, error)
@@ -42,13 +199,35 @@ type BookRepository interface {
 
 type BookUseCase interface {
 	SaveByBookID(userID uuid.UUID, book *Book) (*Book, error)
+	SaveByISBN(userID uuid.UUID, isbn string, status BookStatus) (*Book, error)
+	SaveByISBNBatch(userID uuid.UUID, isbns []string, status BookStatus) ([]*BookISBNResult, error)
 	GetBookByID(userID, id uuid.UUID) (*Book, error)
 	GetBookByISBN(userID uuid.UUID, isbn string) (*Book, error)
 	GetAnyBookByISBN(isbn string) (*Book, error)
 	GetBooksByUserID(userID uuid.UUID) ([]*Book, error)
-	Edit(id uuid.UUID, book *Book) error
-	DeleteByID(userID, id uuid.UUID) error
-	GetBooksByUserName(name string) ([]*Book, error)
+	Edit(userID, id uuid.UUID, book *Book, expected ExpectedVersions) error
+	Patch(userID, id uuid.UUID, req *PatchBookRequest, expected ExpectedVersions) (*Book, error)
+	DeleteByID(userID, id uuid.UUID, expected ExpectedVersions) error
+	ListBooksByUserID(userID uuid.UUID, filter *BookListFilter) (*BookPage, error)
+	// Book Status
+	ChangeStatus(userID, id uuid.UUID, status BookStatus, expected ExpectedVersions) (*Book, error)
+	GetStatusHistory(userID, id uuid.UUID) ([]*BookStatusHistory, error)
+	// Book Tag
+	BulkTagBooks(userID uuid.UUID, req *BulkTagRequest) error
+	SuggestTags(userID uuid.UUID, prefix string, limit int) ([]*Tag, error)
+	// Book Duplicate
+	GetDuplicateBooks(userID uuid.UUID) ([]*DuplicateBookGroup, error)
+	MergeBooks(userID, targetID uuid.UUID, req *MergeBooksRequest) (*Book, error)
+	// Book Copy
+	UpdateCopyDetails(userID, id uuid.UUID, details *BookCopyDetails, expected ExpectedVersions) (*Book, error)
+	GetLocationSummary(userID uuid.UUID, level LocationSummaryLevel) ([]*BookLocationSummary, error)
+	// Book Series
+	UpdateSeries(userID, id uuid.UUID, series *BookSeries, expected ExpectedVersions) (*Book, error)
+	GetSeries(userID uuid.UUID) ([]*Series, error)
+	GetSeriesByName(userID uuid.UUID, name string) (*Series, error)
+	GetMissingVolumes(userID uuid.UUID) ([]*Series, error)
+	// Book Visibility
+	UpdateVisibility(userID, id uuid.UUID, visibility BookVisibility, expected ExpectedVersions) (*Book, error)
 	// Book Bookmark
 	AddBookmarkByBookID(userID, bookID uuid.UUID) (*Bookmark, error)
 	GetBookmarksByUserID(userID uuid.UUID) ([]*Bookmark, error)
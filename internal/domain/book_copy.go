@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"time"
+)
+
+// BookCondition 실물 책의 상태
+type BookCondition string
+
+const (
+	BookConditionNew     BookCondition = "new"
+	BookConditionLikeNew BookCondition = "like_new"
+	BookConditionGood    BookCondition = "good"
+	BookConditionFair    BookCondition = "fair"
+	BookConditionPoor    BookCondition = "poor"
+)
+
+func (c BookCondition) IsValid() bool {
+	switch c {
+	case BookConditionNew, BookConditionLikeNew, BookConditionGood, BookConditionFair, BookConditionPoor:
+		return true
+	default:
+		return false
+	}
+}
+
+// BookFormat 책의 형태
+type BookFormat string
+
+const (
+	BookFormatHardcover BookFormat = "hardcover"
+	BookFormatPaperback BookFormat = "paperback"
+	BookFormatEbook     BookFormat = "ebook"
+	BookFormatAudiobook BookFormat = "audiobook"
+)
+
+func (f BookFormat) IsValid() bool {
+	switch f {
+	case BookFormatHardcover, BookFormatPaperback, BookFormatEbook, BookFormatAudiobook:
+		return true
+	default:
+		return false
+	}
+}
+
+// BookLocation 집 안에서 책이 꽂혀 있는 위치입니다. 방 > 책장 > 칸 순서로 좁아집니다.
+type BookLocation struct {
+	Room     string `json:"location_room,omitempty"`
+	Bookcase string `json:"location_bookcase,omitempty"`
+	Shelf    string `json:"location_shelf,omitempty"`
+}
+
+// BookCopyDetails 사용자가 가진 책 한 권(사본)의 보관 위치, 상태, 구입 정보입니다.
+// 서지 정보와 달리 같은 ISBN이라도 사본마다 다릅니다.
+type BookCopyDetails struct {
+	BookLocation
+	Condition    BookCondition `json:"condition,omitempty"`
+	Format       BookFormat    `json:"format,omitempty"`
+	PurchasedAt  *time.Time    `json:"purchased_at,omitempty"`
+	Price        *float64      `json:"price,omitempty"`
+	Currency     string        `json:"currency,omitempty"`
+	AcquiredFrom string        `json:"acquired_from,omitempty"`
+}
+
+// LocationSummaryLevel 위치별 요약을 묶는 단위
+type LocationSummaryLevel string
+
+const (
+	LocationLevelRoom     LocationSummaryLevel = "room"
+	LocationLevelBookcase LocationSummaryLevel = "bookcase"
+	LocationLevelShelf    LocationSummaryLevel = "shelf"
+)
+
+// BookLocationSummary 위치별 책 수와 가치 합계입니다. 통화가 다른 가격은 더할 수 없으므로 통화별로 합산합니다.
+// 위치를 지정하지 않은 책은 빈 위치로 묶입니다.
+type BookLocationSummary struct {
+	BookLocation
+	BookCount  int                `json:"book_count"`
+	TotalValue map[string]float64 `json:"total_value"`
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ImportFormat 가져오기 파일 형식
+type ImportFormat string
+
+const (
+	// ImportFormatGoodreads Goodreads의 "Export Library" CSV
+	ImportFormatGoodreads ImportFormat = "goodreads"
+	// ImportFormatCSV 열 이름을 직접 지정하는 일반 CSV
+	ImportFormatCSV ImportFormat = "csv"
+)
+
+// ImportJobStatus 가져오기 작업 상태
+type ImportJobStatus string
+
+const (
+	ImportJobPending   ImportJobStatus = "pending"
+	ImportJobRunning   ImportJobStatus = "running"
+	ImportJobCompleted ImportJobStatus = "completed"
+	ImportJobFailed    ImportJobStatus = "failed"
+)
+
+// ImportColumnMapping 일반 CSV에서 각 항목으로 읽을 열 이름입니다. 비어 있으면 기본 열 이름을 사용합니다.
+type ImportColumnMapping struct {
+	Title      string `json:"title"`
+	Author     string `json:"author"`
+	ISBN       string `json:"isbn"`
+	Status     string `json:"status"`
+	Rating     string `json:"rating"`
+	Review     string `json:"review"`
+	FinishedAt string `json:"finished_at"`
+}
+
+// ImportRowResult 중복이거나 실패한 행의 처리 결과
+type ImportRowResult struct {
+	Line   int        `json:"line"`
+	Title  string     `json:"title"`
+	ISBN   string     `json:"isbn,omitempty"`
+	BookID *uuid.UUID `json:"book_id,omitempty"`
+	Error  string     `json:"error,omitempty"`
+}
+
+// ImportJob 백그라운드에서 실행되는 가져오기 작업의 진행 상황과 결과 보고서입니다.
+type ImportJob struct {
+	ID             uuid.UUID          `json:"id"`
+	UserID         uuid.UUID          `json:"user_id"`
+	Format         ImportFormat       `json:"format"`
+	Status         ImportJobStatus    `json:"status"`
+	TotalRows      int                `json:"total_rows"`
+	ProcessedRows  int                `json:"processed_rows"`
+	CreatedCount   int                `json:"created_count"`
+	DuplicateCount int                `json:"duplicate_count"`
+	FailedCount    int                `json:"failed_count"`
+	ReviewCount    int                `json:"review_count"`
+	Duplicates     []*ImportRowResult `json:"duplicates"`
+	Failures       []*ImportRowResult `json:"failures"`
+	Error          string             `json:"error,omitempty"`
+	CreatedAt      time.Time          `json:"created_at"`
+	FinishedAt     *time.Time         `json:"finished_at,omitempty"`
+}
+
+type ImportJobRepository interface {
+	Save(job *ImportJob) error
+	GetByID(id uuid.UUID) (*ImportJob, error)
+	// AcquireLock 사용자당 하나의 가져오기 작업만 실행되도록 잠금을 얻습니다.
+	AcquireLock(userID uuid.UUID) (bool, error)
+	ReleaseLock(userID uuid.UUID) error
+}
+
+type BookImportUseCase interface {
+	StartImport(userID uuid.UUID, format ImportFormat, mapping *ImportColumnMapping, data []byte) (*ImportJob, error)
+	GetImportJob(userID, id uuid.UUID) (*ImportJob, error)
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "context"
+
+// BookMetadata 외부 도서 정보 제공자의 검색 결과를 하나의 형식으로 정규화한 구조체
+type BookMetadata struct {
+	ISBN          string `json:"isbn"`
+	Title         string `json:"title"`
+	Author        string `json:"author"`
+	Publisher     string `json:"publisher"`
+	PublishedDate string `json:"published_date"`
+	Description   string `json:"description"`
+	ThumbnailURL  string `json:"thumbnail_url"`
+	Link          string `json:"link"`
+	Source        string `json:"source"`
+	SeriesName    string `json:"series_name,omitempty"`
+	SeriesVolume  int    `json:"series_volume,omitempty"` // 0이면 권 번호를 알 수 없음
+}
+
+// BookMetadataProvider 외부 도서 정보 API(네이버, 카카오, Open Library 등)를 추상화합니다.
+// 결과가 없으면 ErrBookMetadataNotFound를 반환해야 합니다.
+type BookMetadataProvider interface {
+	Name() string
+	SearchByISBN(ctx context.Context, isbn string) (*BookMetadata, error)
+	Search(ctx context.Context, query string) ([]*BookMetadata, error)
+}
+
+type BookMetadataUseCase interface {
+	SearchByISBN(isbn string) (*BookMetadata, error)
+	Search(query string) ([]*BookMetadata, error)
+}
+
+// BookMetadataCache 외부 도서 정보 검색 결과 캐시를 관리합니다.
+type BookMetadataCache interface {
+	PurgeISBN(isbn string) error
+	PurgeQuery(query string) error
+	PurgeAll() (int64, error)
+}
@@ -0,0 +1,86 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NoteColor 하이라이트 색상
+type NoteColor string
+
+const (
+	NoteColorYellow NoteColor = "yellow"
+	NoteColorGreen  NoteColor = "green"
+	NoteColorBlue   NoteColor = "blue"
+	NoteColorPink   NoteColor = "pink"
+	NoteColorPurple NoteColor = "purple"
+)
+
+func (c NoteColor) IsValid() bool {
+	switch c {
+	case NoteColorYellow, NoteColorGreen, NoteColorBlue, NoteColorPink, NoteColorPurple:
+		return true
+	default:
+		return false
+	}
+}
+
+// BookNote 사용자가 책에 남긴 메모, 하이라이트, 인용구입니다.
+// 인용구(Quote)와 개인 메모(Comment) 중 하나 이상은 있어야 합니다.
+type BookNote struct {
+	ID       uuid.UUID `json:"id"`
+	OwnerID  uuid.UUID `json:"owner_id"`
+	BookID   uuid.UUID `json:"book_id"`
+	Quote    string    `json:"quote"`
+	Comment  string    `json:"comment"`
+	Page     *int      `json:"page"`
+	Location string    `json:"location"`
+	Color    NoteColor `json:"color,omitempty"`
+	Label    string    `json:"label"`
+	// BookTitle 메모 검색 결과에서 어떤 책의 메모인지 보여주기 위해 채워집니다.
+	BookTitle string    `json:"book_title,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// BookNoteRequest 메모 작성 및 수정 요청입니다. 수정 시에는 모든 항목을 요청 값으로 바꿉니다.
+type BookNoteRequest struct {
+	Quote    string    `json:"quote"`
+	Comment  string    `json:"comment"`
+	Page     *int      `json:"page"`
+	Location string    `json:"location"`
+	Color    NoteColor `json:"color"`
+	Label    string    `json:"label"`
+}
+
+// BookNoteSearchFilter 내 메모 검색 조건입니다. Query의 모든 단어가 포함된 메모를 최신순으로 찾습니다.
+type BookNoteSearchFilter struct {
+	Query  string
+	Cursor string
+	Limit  int
+}
+
+// BookNotePage 커서 기반으로 조회한 메모 목록의 한 페이지
+type BookNotePage struct {
+	Items []*BookNote `json:"items"`
+	PageInfo
+}
+
+type BookNoteRepository interface {
+	Create(userID, bookID uuid.UUID, note *BookNote) (*BookNote, error)
+	GetByID(id uuid.UUID) (*BookNote, error)
+	GetByBookID(userID, bookID uuid.UUID) ([]*BookNote, error)
+	Update(note *BookNote) (*BookNote, error)
+	Delete(id uuid.UUID) error
+	Search(userID uuid.UUID, filter *BookNoteSearchFilter) (*BookNotePage, error)
+}
+
+type BookNoteUseCase interface {
+	CreateNote(userID, bookID uuid.UUID, req *BookNoteRequest) (*BookNote, error)
+	GetBookNotes(userID, bookID uuid.UUID) ([]*BookNote, error)
+	GetNote(userID, id uuid.UUID) (*BookNote, error)
+	UpdateNote(userID, id uuid.UUID, req *BookNoteRequest) (*BookNote, error)
+	DeleteNote(userID, id uuid.UUID) error
+	SearchNotes(userID uuid.UUID, filter *BookNoteSearchFilter) (*BookNotePage, error)
+}
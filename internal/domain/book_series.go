@@ -0,0 +1,21 @@
+package domain
+
+// BookSeries 책이 속한 시리즈와 권 번호입니다. 서지 정보이므로 카탈로그 항목에 저장됩니다.
+// 시리즈 이름이 비어 있으면 시리즈에 속하지 않은 책입니다.
+type BookSeries struct {
+	SeriesName         string `json:"series_name,omitempty"`
+	SeriesVolume       *int   `json:"series_volume,omitempty"`
+	SeriesTotalVolumes *int   `json:"series_total_volumes,omitempty"`
+}
+
+// Series 사용자가 가진 한 시리즈의 권 현황입니다.
+// 빠진 권은 1권부터 전체 권 수(모르면 가진 권 중 가장 큰 번호)까지 중 없는 번호입니다.
+type Series struct {
+	Name           string  `json:"series_name"`
+	TotalVolumes   *int    `json:"total_volumes"`
+	BookCount      int     `json:"book_count"` // 권 번호가 없는 책도 포함한 책 수
+	OwnedVolumes   []int   `json:"owned_volumes"`
+	MissingVolumes []int   `json:"missing_volumes"`
+	IsComplete     bool    `json:"is_complete"` // 전체 권 수를 알고 빠진 권이 없는 경우
+	Books          []*Book `json:"books,omitempty"`
+}
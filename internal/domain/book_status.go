@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// BookStatus 책의 읽기 상태
+type BookStatus string
+
+const (
+	BookStatusUnread    BookStatus = "unread"
+	BookStatusReading   BookStatus = "reading"
+	BookStatusFinished  BookStatus = "finished"
+	BookStatusPaused    BookStatus = "paused"
+	BookStatusAbandoned BookStatus = "abandoned"
+)
+
+// 이전 버전에서 사용하던 정수 상태값 (0: 읽지 않음, 1: 읽는 중, 2: 읽음)
+var legacyBookStatuses = map[int]BookStatus{
+	0: BookStatusUnread,
+	1: BookStatusReading,
+	2: BookStatusFinished,
+}
+
+// 현재 상태에서 변경할 수 있는 상태 목록입니다.
+var bookStatusTransitions = map[BookStatus][]BookStatus{
+	BookStatusUnread:    {BookStatusReading, BookStatusFinished},
+	BookStatusReading:   {BookStatusPaused, BookStatusFinished, BookStatusAbandoned, BookStatusUnread},
+	BookStatusPaused:    {BookStatusReading, BookStatusFinished, BookStatusAbandoned},
+	BookStatusFinished:  {BookStatusReading},
+	BookStatusAbandoned: {BookStatusReading, BookStatusUnread},
+}
+
+func (s BookStatus) IsValid() bool {
+	_, ok := bookStatusTransitions[s]
+	return ok
+}
+
+// CanTransitionTo 현재 상태에서 next로 변경할 수 있는지 확인합니다. 같은 상태로의 변경은 허용하지 않습니다.
+func (s BookStatus) CanTransitionTo(next BookStatus) bool {
+	for _, allowed := range bookStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// UnmarshalJSON 문자열 상태값과 함께 기존 클라이언트의 정수 상태값(0, 1, 2)도 받습니다.
+func (s *BookStatus) UnmarshalJSON(data []byte) error {
+	var legacy int
+	if err := json.Unmarshal(data, &legacy); err == nil {
+		status, ok := legacyBookStatuses[legacy]
+		if !ok {
+			return ErrInvalidBookStatus
+		}
+		*s = status
+		return nil
+	}
+
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*s = BookStatus(v)
+	return nil
+}
+
+// ParseBookStatus 쿼리 파라미터 등의 문자열을 읽기 상태로 변환합니다. 기존 정수 상태값도 받습니다.
+func ParseBookStatus(v string) (BookStatus, error) {
+	if legacy, err := strconv.Atoi(v); err == nil {
+		status, ok := legacyBookStatuses[legacy]
+		if !ok {
+			return "", ErrInvalidBookStatus
+		}
+		return status, nil
+	}
+
+	status := BookStatus(v)
+	if !status.IsValid() {
+		return "", ErrInvalidBookStatus
+	}
+
+	return status, nil
+}
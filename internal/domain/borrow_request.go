@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BorrowRequestStatus 사용자 간 대여 요청의 상태
+type BorrowRequestStatus string
+
+const (
+	BorrowRequestPending    BorrowRequestStatus = "pending"
+	BorrowRequestAccepted   BorrowRequestStatus = "accepted"
+	BorrowRequestDeclined   BorrowRequestStatus = "declined"
+	BorrowRequestCanceled   BorrowRequestStatus = "canceled"
+	BorrowRequestHandedOver BorrowRequestStatus = "handed_over"
+	BorrowRequestReturned   BorrowRequestStatus = "returned"
+)
+
+// 현재 상태에서 변경할 수 있는 상태 목록입니다. 거절, 취소, 반납은 끝난 요청입니다.
+var borrowRequestTransitions = map[BorrowRequestStatus][]BorrowRequestStatus{
+	BorrowRequestPending:    {BorrowRequestAccepted, BorrowRequestDeclined, BorrowRequestCanceled},
+	BorrowRequestAccepted:   {BorrowRequestHandedOver, BorrowRequestCanceled},
+	BorrowRequestHandedOver: {BorrowRequestReturned},
+	BorrowRequestDeclined:   {},
+	BorrowRequestCanceled:   {},
+	BorrowRequestReturned:   {},
+}
+
+func (s BorrowRequestStatus) IsValid() bool {
+	_, ok := borrowRequestTransitions[s]
+	return ok
+}
+
+// IsOpen 아직 끝나지 않은 요청인지 확인합니다.
+func (s BorrowRequestStatus) IsOpen() bool {
+	return len(borrowRequestTransitions[s]) > 0
+}
+
+// CanTransitionTo 현재 상태에서 next로 변경할 수 있는지 확인합니다.
+func (s BorrowRequestStatus) CanTransitionTo(next BorrowRequestStatus) bool {
+	for _, allowed := range borrowRequestTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// BorrowRequestRole 대여 요청 목록에서 내가 보낸 요청인지 받은 요청인지 구분합니다.
+type BorrowRequestRole string
+
+const (
+	BorrowRequestRoleSent     BorrowRequestRole = "sent"
+	BorrowRequestRoleReceived BorrowRequestRole = "received"
+)
+
+// BorrowRequest 다른 사용자의 공개 서재에 있는 책을 빌려달라는 요청입니다.
+// 책을 건네주면 책 주인의 대여 기록(Loan)이 만들어지고 LoanID로 연결됩니다.
+type BorrowRequest struct {
+	ID                uuid.UUID           `json:"id"`
+	RequesterID       uuid.UUID           `json:"requester_id"`
+	RequesterNickname string              `json:"requester_nickname"`
+	OwnerID           uuid.UUID           `json:"owner_id"`
+	OwnerNickname     string              `json:"owner_nickname"`
+	BookID            uuid.UUID           `json:"book_id"`
+	BookTitle         string              `json:"book_title"`
+	Status            BorrowRequestStatus `json:"status"`
+	Message           string              `json:"message"`
+	ResponseMessage   string              `json:"response_message"`
+	DueAt             *time.Time          `json:"due_at"`
+	LoanID            *uuid.UUID          `json:"loan_id,omitempty"`
+	RespondedAt       *time.Time          `json:"responded_at"`
+	HandedOverAt      *time.Time          `json:"handed_over_at"`
+	ReturnedAt        *time.Time          `json:"returned_at"`
+	CreatedAt         time.Time           `json:"created_at"`
+	UpdatedAt         time.Time           `json:"updated_at"`
+}
+
+// CreateBorrowRequest 대여 요청 생성 요청입니다. DueAt은 요청한 사람이 희망하는 반납 예정일입니다.
+type CreateBorrowRequest struct {
+	BookID  uuid.UUID  `json:"book_id"`
+	Message string     `json:"message"`
+	DueAt   *time.Time `json:"due_at"`
+}
+
+// RespondBorrowRequest 수락/거절 요청입니다. 수락할 때 DueAt을 보내면 반납 예정일을 바꿉니다.
+type RespondBorrowRequest struct {
+	Message string     `json:"message"`
+	DueAt   *time.Time `json:"due_at"`
+}
+
+// BorrowRequestFilter 대여 요청 목록 조회 조건입니다. Status가 비어 있으면 모든 상태를 조회합니다.
+type BorrowRequestFilter struct {
+	Role   BorrowRequestRole
+	Status BorrowRequestStatus
+}
+
+type BorrowRequestRepository interface {
+	Create(requesterID, ownerID, bookID uuid.UUID, req *BorrowRequest) (*BorrowRequest, error)
+	GetByID(id uuid.UUID) (*BorrowRequest, error)
+	GetByUserID(userID uuid.UUID, filter *BorrowRequestFilter) ([]*BorrowRequest, error)
+	// HasOpenRequest 같은 사용자가 같은 책에 보낸, 끝나지 않은 요청이 있는지 확인합니다.
+	HasOpenRequest(requesterID, bookID uuid.UUID) (bool, error)
+	// Update 요청이 아직 from 상태일 때만 저장하며, 그사이 상태가 바뀌었으면 ErrInvalidRequestState를 반환합니다.
+	Update(req *BorrowRequest, from BorrowRequestStatus) (*BorrowRequest, error)
+}
+
+type BorrowRequestUseCase interface {
+	CreateRequest(userID uuid.UUID, req *CreateBorrowRequest) (*BorrowRequest, error)
+	GetRequests(userID uuid.UUID, filter *BorrowRequestFilter) ([]*BorrowRequest, error)
+	GetRequest(userID, id uuid.UUID) (*BorrowRequest, error)
+	Accept(userID, id uuid.UUID, req *RespondBorrowRequest) (*BorrowRequest, error)
+	Decline(userID, id uuid.UUID, req *RespondBorrowRequest) (*BorrowRequest, error)
+	Cancel(userID, id uuid.UUID) (*BorrowRequest, error)
+	HandOver(userID, id uuid.UUID) (*BorrowRequest, error)
+	MarkReturned(userID, id uuid.UUID) (*BorrowRequest, error)
+}
@@ -3,28 +3,55 @@ package domain
 import "errors"
 
 var (
-	ErrNotFound              = errors.New("해당 정보를 찾을 수 없습니다.")
-	ErrAlreadyExists         = errors.New("해당 정보는 이미 존재합니다.")
-	ErrInvalidInput          = errors.New("유효하지 않은 입력입니다.")
-	ErrInternal              = errors.New("내부 오류가 발생했습니다.")
-	ErrUserNotLoggedIn       = errors.New("사용자가 로그인하지 않았습니다.")
-	ErrInvalidCredentials    = errors.New("유효하지 않은 자격 증명입니다.")
-	ErrPermissionDenied      = errors.New("권한이 거부되었습니다.")
-	ErrPrivateAccount        = errors.New("개인 계정입니다.")
-	ErrInvalidNickname       = errors.New("유효하지 않은 닉네임입니다.")
-	ErrAlreadyNickname       = errors.New("이미 존재하는 닉네임입니다.")
-	ErrInvalidCSRFToken      = errors.New("유효하지 않은 CSRF 토큰입니다.")
-	ErrTooManyRequests       = errors.New("너무 많은 요청입니다. 잠시 후 다시 시도해주세요.")
-	ErrTokenExpired          = errors.New("토큰이 만료되었습니다.")
-	ErrInvalidToken          = errors.New("유효하지 않은 토큰입니다.")
-	ErrTermsNotAgreed        = errors.New("이용약관에 동의해야 합니다.")
-	ErrInvalidReminderTime   = errors.New("유효하지 않은 알림 시간입니다. HH:MM 형식이어야 합니다.")
-	ErrInvalidDayOfWeek      = errors.New("유효하지 않은 요일입니다.")
-	ErrInvalidTimezone       = errors.New("유효하지 않은 타임존입니다.")
-	ErrReminderNotFound      = errors.New("해당 알림을 찾을 수 없습니다.")
-	ErrReminderOwnerMismatch = errors.New("알림 소유자가 일치하지 않습니다.")
-	ErrEmailNotVerified      = errors.New("이메일 인증이 완료되지 않았습니다.")
-	ErrVerificationCodeSent  = errors.New("이미 인증 메일이 발송되었습니다. 5분 후 다시 시도해주세요.")
-	ErrPasswordMismatch      = errors.New("새 비밀번호가 일치하지 않습니다.")
-	ErrPrivacyNotAgreed      = errors.New("개인정보 수집 이용에 동의해야 합니다.")
+	ErrNotFound                = errors.New("해당 정보를 찾을 수 없습니다.")
+	ErrAlreadyExists           = errors.New("해당 정보는 이미 존재합니다.")
+	ErrInvalidInput            = errors.New("유효하지 않은 입력입니다.")
+	ErrInternal                = errors.New("내부 오류가 발생했습니다.")
+	ErrUserNotLoggedIn         = errors.New("사용자가 로그인하지 않았습니다.")
+	ErrInvalidCredentials      = errors.New("유효하지 않은 자격 증명입니다.")
+	ErrPermissionDenied        = errors.New("권한이 거부되었습니다.")
+	ErrPrivateAccount          = errors.New("개인 계정입니다.")
+	ErrInvalidNickname         = errors.New("유효하지 않은 닉네임입니다.")
+	ErrAlreadyNickname         = errors.New("이미 존재하는 닉네임입니다.")
+	ErrInvalidCSRFToken        = errors.New("유효하지 않은 CSRF 토큰입니다.")
+	ErrTooManyRequests         = errors.New("너무 많은 요청입니다. 잠시 후 다시 시도해주세요.")
+	ErrTokenExpired            = errors.New("토큰이 만료되었습니다.")
+	ErrInvalidToken            = errors.New("유효하지 않은 토큰입니다.")
+	ErrTermsNotAgreed          = errors.New("이용약관에 동의해야 합니다.")
+	ErrInvalidReminderTime     = errors.New("유효하지 않은 알림 시간입니다. HH:MM 형식이어야 합니다.")
+	ErrInvalidDayOfWeek        = errors.New("유효하지 않은 요일입니다.")
+	ErrInvalidTimezone         = errors.New("유효하지 않은 타임존입니다.")
+	ErrReminderNotFound        = errors.New("해당 알림을 찾을 수 없습니다.")
+	ErrReminderOwnerMismatch   = errors.New("알림 소유자가 일치하지 않습니다.")
+	ErrEmailNotVerified        = errors.New("이메일 인증이 완료되지 않았습니다.")
+	ErrVerificationCodeSent    = errors.New("이미 인증 메일이 발송되었습니다. 5분 후 다시 시도해주세요.")
+	ErrPasswordMismatch        = errors.New("새 비밀번호가 일치하지 않습니다.")
+	ErrPrivacyNotAgreed        = errors.New("개인정보 수집 이용에 동의해야 합니다.")
+	ErrInvalidCursor           = errors.New("유효하지 않은 커서입니다.")
+	ErrBookMetadataNotFound    = errors.New("해당 도서 정보를 찾을 수 없습니다.")
+	ErrMetadataUnavailable     = errors.New("도서 정보 제공자를 사용할 수 없습니다.")
+	ErrInvalidISBN             = errors.New("유효하지 않은 ISBN입니다.")
+	ErrReadingSessionActive    = errors.New("이미 진행 중인 독서 세션이 있습니다.")
+	ErrNoActiveSession         = errors.New("진행 중인 독서 세션이 없습니다.")
+	ErrInvalidPage             = errors.New("유효하지 않은 페이지입니다.")
+	ErrInvalidBookStatus       = errors.New("유효하지 않은 읽기 상태입니다.")
+	ErrInvalidStatusTransition = errors.New("현재 읽기 상태에서 변경할 수 없는 상태입니다.")
+	ErrDuplicateShelfName      = errors.New("같은 이름의 책장이 이미 있습니다.")
+	ErrBookAlreadyOnShelf      = errors.New("이미 책장에 있는 책입니다.")
+	ErrInvalidImportFile       = errors.New("가져올 수 없는 파일입니다.")
+	ErrImportInProgress        = errors.New("이미 진행 중인 가져오기 작업이 있습니다.")
+	ErrDuplicateBook           = errors.New("이미 서재에 같은 ISBN의 책이 있습니다.")
+	ErrBookAlreadyLent         = errors.New("이미 다른 사람에게 빌려준 책입니다.")
+	ErrLoanAlreadyReturned     = errors.New("이미 반납된 대여입니다.")
+	ErrBorrowRequestExists     = errors.New("이미 이 책에 보낸 대여 요청이 있습니다.")
+	ErrInvalidRequestState     = errors.New("현재 대여 요청 상태에서 처리할 수 없습니다.")
+	ErrAlreadyOnWishlist       = errors.New("이미 위시리스트에 있는 책입니다.")
+	ErrWishlistItemClaimed     = errors.New("이미 다른 사람이 선물하기로 한 책입니다.")
+	ErrPreconditionFailed      = errors.New("다른 곳에서 먼저 수정되었습니다. 최신 정보를 확인한 뒤 다시 시도해주세요.")
+	ErrAlreadyLibraryMember    = errors.New("이미 서재에 참여한 사용자입니다.")
+	ErrLibraryInvitationExists = errors.New("이미 이 사용자에게 보낸 서재 초대가 있습니다.")
+	ErrInvalidInvitationState  = errors.New("이미 처리된 서재 초대입니다.")
+	ErrLastLibraryOwner        = errors.New("서재에는 관리자가 한 명 이상 있어야 합니다.")
+	ErrDuplicateReadingGoal    = errors.New("같은 연도에 같은 단위의 독서 목표가 이미 있습니다.")
+	ErrCatalogFieldConflict    = errors.New("바꾸려는 ISBN의 서지 정보와 다른 제목, 저자, 표지, 출판사, 출간일은 함께 수정할 수 없습니다.")
 )
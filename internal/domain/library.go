@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LibraryRole 공유 서재 구성원의 역할
+type LibraryRole string
+
+const (
+	LibraryRoleOwner  LibraryRole = "owner"  // 서재 관리, 구성원 초대/내보내기, 책 수정
+	LibraryRoleEditor LibraryRole = "editor" // 책 정보와 읽기 상태 수정
+	LibraryRoleViewer LibraryRole = "viewer" // 보기만 가능
+)
+
+func (r LibraryRole) IsValid() bool {
+	switch r {
+	case LibraryRoleOwner, LibraryRoleEditor, LibraryRoleViewer:
+		return true
+	}
+	return false
+}
+
+// CanEdit 서재의 책을 수정할 수 있는 역할인지 확인합니다.
+func (r LibraryRole) CanEdit() bool {
+	return r == LibraryRoleOwner || r == LibraryRoleEditor
+}
+
+// CanManage 서재 이름, 구성원, 초대를 관리할 수 있는 역할인지 확인합니다.
+func (r LibraryRole) CanManage() bool {
+	return r == LibraryRoleOwner
+}
+
+// LibraryInvitationStatus 공유 서재 초대의 상태
+type LibraryInvitationStatus string
+
+const (
+	LibraryInvitationPending  LibraryInvitationStatus = "pending"
+	LibraryInvitationAccepted LibraryInvitationStatus = "accepted"
+	LibraryInvitationDeclined LibraryInvitationStatus = "declined"
+	LibraryInvitationCanceled LibraryInvitationStatus = "canceled"
+)
+
+// Library 한 집에서 실물 서재를 함께 쓰는 사용자들의 공유 서재입니다.
+// 책의 소유자는 그대로 두고, 서재에 넣은 책은 구성원이 역할에 따라 보거나 수정할 수 있습니다.
+type Library struct {
+	ID          uuid.UUID        `json:"id"`
+	Name        string           `json:"name"`
+	MyRole      LibraryRole      `json:"my_role"`
+	MemberCount int              `json:"member_count"`
+	BookCount   int              `json:"book_count"`
+	Members     []*LibraryMember `json:"members,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// LibraryMember 공유 서재 구성원
+type LibraryMember struct {
+	UserID   uuid.UUID   `json:"user_id"`
+	Nickname string      `json:"nickname"`
+	Role     LibraryRole `json:"role"`
+	JoinedAt time.Time   `json:"joined_at"`
+}
+
+// LibraryInvitation 공유 서재 초대입니다. 초대받은 사람이 수락하면 Role로 구성원이 됩니다.
+type LibraryInvitation struct {
+	ID              uuid.UUID               `json:"id"`
+	LibraryID       uuid.UUID               `json:"library_id"`
+	LibraryName     string                  `json:"library_name"`
+	InviterID       uuid.UUID               `json:"inviter_id"`
+	InviterNickname string                  `json:"inviter_nickname"`
+	InviteeID       uuid.UUID               `json:"invitee_id"`
+	InviteeNickname string                  `json:"invitee_nickname"`
+	Role            LibraryRole             `json:"role"`
+	Status          LibraryInvitationStatus `json:"status"`
+	RespondedAt     *time.Time              `json:"responded_at"`
+	CreatedAt       time.Time               `json:"created_at"`
+}
+
+// CreateLibraryRequest 공유 서재 생성 및 이름 변경 요청
+type CreateLibraryRequest struct {
+	Name string `json:"name"`
+}
+
+type UpdateLibraryRequest struct {
+	Name string `json:"name"`
+}
+
+// InviteLibraryMemberRequest 이메일 또는 닉네임 중 하나로 초대할 사용자를 지정합니다.
+// 역할을 생략하면 viewer로 초대합니다.
+type InviteLibraryMemberRequest struct {
+	Email    string      `json:"email"`
+	NickName string      `json:"nick_name"`
+	Role     LibraryRole `json:"role"`
+}
+
+// UpdateLibraryMemberRequest 구성원의 역할을 변경합니다.
+type UpdateLibraryMemberRequest struct {
+	Role LibraryRole `json:"role"`
+}
+
+// MoveBookToLibraryRequest 책을 공유 서재로 옮깁니다. LibraryID가 null이면 개인 서재로 되돌립니다.
+type MoveBookToLibraryRequest struct {
+	LibraryID *uuid.UUID `json:"library_id"`
+}
+
+type LibraryRepository interface {
+	// Create 서재를 만들고 만든 사용자를 관리자(owner)로 추가합니다.
+	Create(userID uuid.UUID, name string) (*Library, error)
+	// GetByID 서재와 구성원 목록을 조회합니다. MyRole은 userID 기준으로 채웁니다.
+	GetByID(userID, id uuid.UUID) (*Library, error)
+	GetByUserID(userID uuid.UUID) ([]*Library, error)
+	// GetMemberRole 구성원이 아니면 ErrNotFound를 반환합니다.
+	GetMemberRole(libraryID, userID uuid.UUID) (LibraryRole, error)
+	UpdateName(id uuid.UUID, name string) error
+	// Delete 서재를 삭제합니다. 서재에 있던 책은 각 소유자의 개인 서재로 돌아갑니다.
+	Delete(id uuid.UUID) error
+
+	UpdateMemberRole(libraryID, userID uuid.UUID, role LibraryRole) error
+	// RemoveMember 구성원을 내보내고, 그 사람이 서재에 넣은 책을 개인 서재로 되돌립니다.
+	RemoveMember(libraryID, userID uuid.UUID) error
+	CountOwners(libraryID uuid.UUID) (int, error)
+
+	// FindUserID 이메일 또는 닉네임으로 초대할 사용자를 찾습니다. 없으면 ErrNotFound를 반환합니다.
+	FindUserID(email, nickname string) (uuid.UUID, error)
+
+	CreateInvitation(libraryID, inviterID, inviteeID uuid.UUID, role LibraryRole) (*LibraryInvitation, error)
+	GetInvitation(id uuid.UUID) (*LibraryInvitation, error)
+	GetPendingInvitations(inviteeID uuid.UUID) ([]*LibraryInvitation, error)
+	GetLibraryInvitations(libraryID uuid.UUID) ([]*LibraryInvitation, error)
+	HasPendingInvitation(libraryID, inviteeID uuid.UUID) (bool, error)
+	UpdateInvitationStatus(id uuid.UUID, status LibraryInvitationStatus) (*LibraryInvitation, error)
+	// AcceptInvitation 초대를 수락 처리하고 초대받은 사람을 구성원으로 추가합니다.
+	AcceptInvitation(id uuid.UUID) (*LibraryInvitation, error)
+
+	GetBooks(libraryID uuid.UUID) ([]*Book, error)
+	// SetBookLibrary 책을 서재로 옮깁니다. libraryID가 nil이면 개인 서재로 되돌립니다.
+	SetBookLibrary(bookID uuid.UUID, libraryID *uuid.UUID, expected ExpectedVersions) error
+}
+
+type LibraryUseCase interface {
+	CreateLibrary(userID uuid.UUID, req *CreateLibraryRequest) (*Library, error)
+	GetLibraries(userID uuid.UUID) ([]*Library, error)
+	GetLibrary(userID, id uuid.UUID) (*Library, error)
+	UpdateLibrary(userID, id uuid.UUID, req *UpdateLibraryRequest) (*Library, error)
+	DeleteLibrary(userID, id uuid.UUID) error
+	GetLibraryBooks(userID, id uuid.UUID) ([]*Book, error)
+
+	UpdateMemberRole(userID, id, memberID uuid.UUID, req *UpdateLibraryMemberRequest) (*Library, error)
+	RemoveMember(userID, id, memberID uuid.UUID) error
+
+	Invite(userID, id uuid.UUID, req *InviteLibraryMemberRequest) (*LibraryInvitation, error)
+	GetLibraryInvitations(userID, id uuid.UUID) ([]*LibraryInvitation, error)
+	CancelInvitation(userID, id, invitationID uuid.UUID) (*LibraryInvitation, error)
+	GetMyInvitations(userID uuid.UUID) ([]*LibraryInvitation, error)
+	AcceptInvitation(userID, invitationID uuid.UUID) (*LibraryInvitation, error)
+	DeclineInvitation(userID, invitationID uuid.UUID) (*LibraryInvitation, error)
+
+	MoveBook(userID, bookID uuid.UUID, req *MoveBookToLibraryRequest, expected ExpectedVersions) (*Book, error)
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// ExportFormat 서재 내보내기 형식
+type ExportFormat string
+
+const (
+	// ExportFormatJSON 책, 북마크, 리뷰, 리마인더를 모두 담은 JSON
+	ExportFormatJSON ExportFormat = "json"
+	// ExportFormatCSV 책 한 권을 한 행으로 담은 CSV
+	ExportFormatCSV ExportFormat = "csv"
+	// ExportFormatGoodreads Goodreads 가져오기 형식의 CSV
+	ExportFormatGoodreads ExportFormat = "goodreads"
+)
+
+func (f ExportFormat) IsValid() bool {
+	switch f {
+	case ExportFormatJSON, ExportFormatCSV, ExportFormatGoodreads:
+		return true
+	default:
+		return false
+	}
+}
+
+type LibraryExportUseCase interface {
+	// Export 사용자의 서재를 w에 형식에 맞춰 기록합니다. 책은 페이지 단위로 조회하며 바로 기록합니다.
+	Export(userID uuid.UUID, format ExportFormat, w io.Writer) error
+}
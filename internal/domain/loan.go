@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// LoanState 대여 목록 조회 조건
+type LoanState string
+
+const (
+	// LoanStateActive 아직 반납되지 않은 대여 (연체 포함)
+	LoanStateActive LoanState = "active"
+	// LoanStateOverdue 반납 예정일이 지났는데 반납되지 않은 대여
+	LoanStateOverdue LoanState = "overdue"
+	// LoanStateReturned 반납이 끝난 대여 기록
+	LoanStateReturned LoanState = "returned"
+	LoanStateAll      LoanState = "all"
+)
+
+func (s LoanState) IsValid() bool {
+	switch s {
+	case LoanStateActive, LoanStateOverdue, LoanStateReturned, LoanStateAll:
+		return true
+	default:
+		return false
+	}
+}
+
+// Loan 사용자가 다른 사람에게 빌려준 책의 기록입니다.
+// 빌린 사람은 서비스 사용자가 아니어도 되므로 이름과 연락처만 저장합니다.
+type Loan struct {
+	ID              uuid.UUID  `json:"id"`
+	OwnerID         uuid.UUID  `json:"owner_id"`
+	BookID          uuid.UUID  `json:"book_id"`
+	BookTitle       string     `json:"book_title,omitempty"`
+	BorrowerName    string     `json:"borrower_name"`
+	BorrowerContact string     `json:"borrower_contact"`
+	LentAt          time.Time  `json:"lent_at"`
+	DueAt           *time.Time `json:"due_at"`
+	ReturnedAt      *time.Time `json:"returned_at"`
+	Notes           string     `json:"notes"`
+	// Overdue 반납 예정일이 지났는데 반납되지 않았으면 true입니다.
+	Overdue   bool      `json:"overdue"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// IsActive 반납되지 않은 대여인지 확인합니다.
+func (l *Loan) IsActive() bool {
+	return l.ReturnedAt == nil
+}
+
+// IsOverdueAt 기준 시각에 연체 상태인지 확인합니다.
+func (l *Loan) IsOverdueAt(now time.Time) bool {
+	return l.IsActive() && l.DueAt != nil && l.DueAt.Before(now)
+}
+
+// LoanRequest 대여 등록 및 수정 요청입니다. 수정 시에는 모든 항목을 요청 값으로 바꿉니다.
+// LentAt을 비워 두면 등록 시점으로 기록합니다.
+type LoanRequest struct {
+	BorrowerName    string     `json:"borrower_name"`
+	BorrowerContact string     `json:"borrower_contact"`
+	LentAt          *time.Time `json:"lent_at"`
+	DueAt           *time.Time `json:"due_at"`
+	Notes           string     `json:"notes"`
+}
+
+// ReturnLoanRequest 반납 처리 요청입니다. ReturnedAt을 비워 두면 현재 시각으로 기록합니다.
+type ReturnLoanRequest struct {
+	ReturnedAt *time.Time `json:"returned_at"`
+}
+
+// LoanDueReminder 반납 예정일 알림을 보낼 대여와 빌려준 사람의 FCM 토큰
+type LoanDueReminder struct {
+	Loan     *Loan
+	FCMToken string
+}
+
+type LoanRepository interface {
+	Create(userID, bookID uuid.UUID, loan *Loan) (*Loan, error)
+	GetByID(id uuid.UUID) (*Loan, error)
+	GetByBookID(userID, bookID uuid.UUID) ([]*Loan, error)
+	GetByUserID(userID uuid.UUID, state LoanState) ([]*Loan, error)
+	HasActiveLoan(bookID uuid.UUID) (bool, error)
+	Update(loan *Loan) (*Loan, error)
+	MarkReturned(id uuid.UUID, returnedAt time.Time) (*Loan, error)
+	Delete(id uuid.UUID) error
+	// GetDueReminders 반납 예정일이 before 이전이고 아직 알림을 보내지 않은 대여를 조회합니다.
+	GetDueReminders(before time.Time) ([]*LoanDueReminder, error)
+	MarkReminderSent(id uuid.UUID, sentAt time.Time) error
+}
+
+type LoanUseCase interface {
+	LendBook(userID, bookID uuid.UUID, req *LoanRequest) (*Loan, error)
+	GetBookLoans(userID, bookID uuid.UUID) ([]*Loan, error)
+	GetLoans(userID uuid.UUID, state LoanState) ([]*Loan, error)
+	GetLoan(userID, id uuid.UUID) (*Loan, error)
+	UpdateLoan(userID, id uuid.UUID, req *LoanRequest) (*Loan, error)
+	ReturnLoan(userID, id uuid.UUID, req *ReturnLoanRequest) (*Loan, error)
+	DeleteLoan(userID, id uuid.UUID) error
+}
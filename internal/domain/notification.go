@@ -0,0 +1,8 @@
+package domain
+
+import "context"
+
+// PushNotifier 사용자 기기로 푸시 알림을 보냅니다. (FCM 등)
+type PushNotifier interface {
+	SendPush(ctx context.Context, token, title, body string) error
+}
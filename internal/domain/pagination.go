@@ -0,0 +1,15 @@
+package domain
+
+// SortOrder 목록 정렬 방향
+type SortOrder string
+
+const (
+	SortAsc  SortOrder = "asc"
+	SortDesc SortOrder = "desc"
+)
+
+// PageInfo 커서 기반 페이지네이션 응답 정보
+type PageInfo struct {
+	NextCursor string `json:"next_cursor"`
+	HasMore    bool   `json:"has_more"`
+}
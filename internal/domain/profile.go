@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BookVisibility 공개 프로필에서 책을 보여줄지 정합니다.
+// inherit이면 숨김 책장(hide_books)에 담긴 책은 숨기고 나머지는 보여주며, public/private는 책장 설정보다 우선합니다.
+type BookVisibility string
+
+const (
+	BookVisibilityInherit BookVisibility = "inherit"
+	BookVisibilityPublic  BookVisibility = "public"
+	BookVisibilityPrivate BookVisibility = "private"
+)
+
+func (v BookVisibility) IsValid() bool {
+	switch v {
+	case BookVisibilityInherit, BookVisibilityPublic, BookVisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+// UpdateBookVisibilityRequest 책의 공개 범위를 변경합니다.
+type UpdateBookVisibilityRequest struct {
+	Visibility BookVisibility `json:"visibility"`
+}
+
+// PublicProfile 다른 사용자에게 보여주는 프로필입니다. 이메일, 설정 등 개인 정보는 담지 않습니다.
+type PublicProfile struct {
+	NickName  string         `json:"nick_name"`
+	BookCount int            `json:"book_count"` // 공개된 책 수
+	Shelves   []*PublicShelf `json:"shelves"`
+	JoinedAt  time.Time      `json:"joined_at"`
+}
+
+// PublicBook 공개 프로필에서 보여주는 책 정보입니다.
+// 보관 위치, 구입 정보, 대여 여부, 태그, 읽은 쪽수 등은 담지 않습니다.
+type PublicBook struct {
+	ID            uuid.UUID  `json:"id"`
+	Title         string     `json:"title"`
+	Author        string     `json:"author"`
+	BookISBN      string     `json:"book_isbn"`
+	ThumbnailURL  string     `json:"thumbnail_url"`
+	Publisher     string     `json:"publisher,omitempty"`
+	PublishedDate string     `json:"published_date,omitempty"`
+	Status        BookStatus `json:"status"`
+	SeriesName    string     `json:"series_name,omitempty"`
+	SeriesVolume  *int       `json:"series_volume,omitempty"`
+}
+
+// PublicShelf 공개 프로필에서 보여주는 책장입니다. BookCount는 공개된 책만 셉니다.
+type PublicShelf struct {
+	ID          uuid.UUID     `json:"id"`
+	Name        string        `json:"name"`
+	Description string        `json:"description"`
+	BookCount   int           `json:"book_count"`
+	Books       []*PublicBook `json:"books,omitempty"`
+}
+
+// PublicBookPage 공개 프로필의 책 목록 한 페이지
+type PublicBookPage struct {
+	Items []*PublicBook `json:"items"`
+	PageInfo
+}
+
+// ProfilePreview 내 공개 프로필이 다른 사람에게 어떻게 보이는지 미리 봅니다.
+// IsPublished가 false면 다른 사람에게는 403 (개인 계정)으로 보입니다.
+type ProfilePreview struct {
+	IsPublished     bool           `json:"is_published"`
+	HiddenBookCount int            `json:"hidden_book_count"`
+	Profile         *PublicProfile `json:"profile"`
+}
+
+type ProfileRepository interface {
+	// GetUserByNickname 닉네임으로 사용자를 찾습니다. 없으면 ErrNotFound를 반환합니다.
+	GetUserByNickname(name string) (*User, error)
+	CountBooks(ownerID uuid.UUID) (total, visible int, err error)
+	// ListVisibleBooks 공개된 책만 조회합니다. 책장으로 거를 때는 공개 책장만 허용합니다.
+	ListVisibleBooks(ownerID uuid.UUID, filter *BookListFilter) (*BookPage, error)
+	// GetPublicShelves 공개 책장과 책장별 공개된 책 수를 조회합니다.
+	GetPublicShelves(ownerID uuid.UUID) ([]*Shelf, error)
+	// GetPublicShelf 공개 책장이 아니면 ErrNotFound를 반환합니다.
+	GetPublicShelf(ownerID, shelfID uuid.UUID) (*Shelf, error)
+	GetVisibleShelfBooks(shelfID uuid.UUID) ([]*Book, error)
+}
+
+type ProfileUseCase interface {
+	GetProfile(name string) (*PublicProfile, error)
+	ListBooks(name string, filter *BookListFilter) (*PublicBookPage, error)
+	GetShelf(name string, shelfID uuid.UUID) (*PublicShelf, error)
+
+	GetPreview(userID uuid.UUID) (*ProfilePreview, error)
+	ListPreviewBooks(userID uuid.UUID, filter *BookListFilter) (*PublicBookPage, error)
+}
@@ -0,0 +1,130 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReadingGoalType 독서 목표의 단위
+type ReadingGoalType string
+
+const (
+	ReadingGoalBooks ReadingGoalType = "books" // 다 읽은 책 권수
+	ReadingGoalPages ReadingGoalType = "pages" // 다 읽은 책의 전체 쪽수 합
+)
+
+func (t ReadingGoalType) IsValid() bool {
+	return t == ReadingGoalBooks || t == ReadingGoalPages
+}
+
+// ReadingGoalStatus 목표 대비 현재 진행 상태
+type ReadingGoalStatus string
+
+const (
+	ReadingGoalUpcoming  ReadingGoalStatus = "upcoming"  // 아직 시작하지 않은 해의 목표
+	ReadingGoalOnTrack   ReadingGoalStatus = "on_track"  // 목표 속도 이상으로 읽는 중
+	ReadingGoalBehind    ReadingGoalStatus = "behind"    // 목표 속도보다 뒤처짐
+	ReadingGoalCompleted ReadingGoalStatus = "completed" // 목표 달성
+	ReadingGoalMissed    ReadingGoalStatus = "missed"    // 해가 끝났지만 달성하지 못함
+)
+
+// ReadingGoal "2026년에 30권 읽기", "5000쪽 읽기"처럼 한 해 동안의 독서 목표입니다.
+// 연도는 사용자 타임존 기준이며, 사용자는 연도별로 단위마다 목표를 하나만 가질 수 있습니다.
+type ReadingGoal struct {
+	ID             uuid.UUID       `json:"id"`
+	UserID         uuid.UUID       `json:"user_id"`
+	Year           int             `json:"year"`
+	Type           ReadingGoalType `json:"type"`
+	Target         int             `json:"target"`
+	PaceReminder   bool            `json:"pace_reminder"` // 목표 속도보다 뒤처지면 푸시 알림을 보냅니다.
+	ReminderSentAt *time.Time      `json:"reminder_sent_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// FinishedBook 목표 기간 안에 다 읽은 책입니다. 같은 해에 여러 번 다 읽었으면 처음 다 읽은 시간을 사용합니다.
+type FinishedBook struct {
+	BookID     uuid.UUID `json:"book_id"`
+	Title      string    `json:"title"`
+	Author     string    `json:"author"`
+	TotalPages int       `json:"total_pages"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+// ReadingGoalProgress 목표의 진행 상황, 필요한 속도, 예상 달성일입니다.
+// 속도는 주 단위(권/주 또는 쪽/주)이며, 예상 값은 지금까지의 속도를 그대로 유지한다고 가정합니다.
+type ReadingGoalProgress struct {
+	Goal              *ReadingGoal      `json:"goal"`
+	Current           int               `json:"current"`
+	Remaining         int               `json:"remaining"`
+	Percent           float64           `json:"percent"`
+	Status            ReadingGoalStatus `json:"status"`
+	ExpectedByNow     int               `json:"expected_by_now"` // 목표 속도대로라면 지금까지 읽었어야 하는 양
+	DaysElapsed       int               `json:"days_elapsed"`
+	DaysRemaining     int               `json:"days_remaining"`
+	CurrentPace       float64           `json:"current_pace"`  // 지금까지의 주당 평균
+	RequiredPace      float64           `json:"required_pace"` // 남은 기간 동안 필요한 주당 평균
+	ProjectedTotal    int               `json:"projected_total"`
+	ProjectedDate     *time.Time        `json:"projected_completion_date"` // 지금 속도로 달성할 날 (읽은 책이 없으면 null)
+	CompletedAt       *time.Time        `json:"completed_at,omitempty"`
+	BooksWithoutPages int               `json:"books_without_pages,omitempty"` // 쪽수 목표에서 전체 쪽수를 몰라 0쪽으로 센 책 수
+	FinishedBooks     []*FinishedBook   `json:"finished_books,omitempty"`
+}
+
+// ReadingGoalReminder 속도 알림을 보낼 목표와 목표 소유자의 FCM 토큰, 타임존
+type ReadingGoalReminder struct {
+	Goal     *ReadingGoal
+	FCMToken string
+	Timezone string
+}
+
+// ReadingGoalPaceReminder 뒤처진 목표의 진행 상황과 알림을 받을 FCM 토큰
+type ReadingGoalPaceReminder struct {
+	Progress *ReadingGoalProgress
+	FCMToken string
+}
+
+// CreateReadingGoalRequest 연도를 생략하면 사용자 타임존 기준 올해 목표로 만듭니다.
+// 속도 알림은 생략하면 켜집니다.
+type CreateReadingGoalRequest struct {
+	Year         int             `json:"year"`
+	Type         ReadingGoalType `json:"type"`
+	Target       int             `json:"target"`
+	PaceReminder *bool           `json:"pace_reminder,omitempty"`
+}
+
+// UpdateReadingGoalRequest 보낸 항목만 수정합니다.
+type UpdateReadingGoalRequest struct {
+	Target       *int  `json:"target,omitempty"`
+	PaceReminder *bool `json:"pace_reminder,omitempty"`
+}
+
+type ReadingGoalRepository interface {
+	// Create 같은 연도, 같은 단위의 목표가 있으면 ErrDuplicateReadingGoal을 반환합니다.
+	Create(userID uuid.UUID, goal *ReadingGoal) (*ReadingGoal, error)
+	GetByID(id uuid.UUID) (*ReadingGoal, error)
+	// GetByUserID 사용자의 목표를 최근 연도순으로 조회합니다. year가 0이면 모든 연도를 조회합니다.
+	GetByUserID(userID uuid.UUID, year int) ([]*ReadingGoal, error)
+	Update(goal *ReadingGoal) error
+	Delete(id uuid.UUID) error
+	// GetFinishedBooks 기간 안에 다 읽음(finished)으로 바뀐 사용자의 책을 다 읽은 순서대로 조회합니다. 휴지통의 책은 제외합니다.
+	GetFinishedBooks(userID uuid.UUID, from, to time.Time) ([]*FinishedBook, error)
+	// GetReminderTargets 속도 알림을 켠 연도의 목표 중 before 이전에 알림을 보내지 않은 목표를 조회합니다. FCM 토큰이 없는 사용자는 제외합니다.
+	GetReminderTargets(years []int, before time.Time) ([]*ReadingGoalReminder, error)
+	MarkReminderSent(id uuid.UUID, sentAt time.Time) error
+}
+
+type ReadingGoalUseCase interface {
+	CreateGoal(userID uuid.UUID, req *CreateReadingGoalRequest) (*ReadingGoalProgress, error)
+	// GetGoals 목표별 진행 상황을 조회합니다. year가 0이면 모든 연도를 조회합니다.
+	GetGoals(userID uuid.UUID, year int) ([]*ReadingGoalProgress, error)
+	// GetGoal 진행 상황과 함께 다 읽은 책 목록을 조회합니다.
+	GetGoal(userID, id uuid.UUID) (*ReadingGoalProgress, error)
+	UpdateGoal(userID, id uuid.UUID, req *UpdateReadingGoalRequest) (*ReadingGoalProgress, error)
+	DeleteGoal(userID, id uuid.UUID) error
+
+	// GetBehindPaceReminders 목표 속도보다 뒤처진 목표 중 알림을 보낼 목표와 진행 상황을 조회합니다.
+	GetBehindPaceReminders(now time.Time) ([]*ReadingGoalPaceReminder, error)
+	MarkReminderSent(id uuid.UUID, sentAt time.Time) error
+}
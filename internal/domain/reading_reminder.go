@@ -28,6 +28,7 @@ type ReadingReminder struct {
 	Message      string    `json:"message"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
+	Version      int       `json:"version"` // 수정할 때마다 1씩 증가하며 ETag로 사용합니다.
 }
 
 type ReminderWithUser struct {
@@ -53,8 +54,8 @@ type ReadingReminderRepository interface {
 	Create(userID uuid.UUID, reminder *ReadingReminder) (*ReadingReminder, error)
 	GetByID(id uuid.UUID) (*ReadingReminder, error)
 	GetByUserID(userID uuid.UUID) ([]*ReadingReminder, error)
-	Update(reminder *ReadingReminder) error
-	Delete(id uuid.UUID) error
+	Update(reminder *ReadingReminder, expected ExpectedVersions) error
+	Delete(id uuid.UUID, expected ExpectedVersions) error
 	GetDueReminders(currentTimeUTC time.Time) ([]*ReminderWithUser, error)
 }
 
@@ -62,7 +63,7 @@ type ReadingReminderUseCase interface {
 	CreateReminder(userID uuid.UUID, req *CreateReminderRequest) (*ReadingReminder, error)
 	GetUserReminders(userID uuid.UUID) ([]*ReadingReminder, error)
 	GetReminderByID(id uuid.UUID) (*ReadingReminder, error)
-	UpdateReminder(id uuid.UUID, userID uuid.UUID, req *UpdateReminderRequest) (*ReadingReminder, error)
-	ToggleReminder(id uuid.UUID, userID uuid.UUID) (*ReadingReminder, error)
-	DeleteReminder(id uuid.UUID, userID uuid.UUID) error
+	UpdateReminder(id uuid.UUID, userID uuid.UUID, req *UpdateReminderRequest, expected ExpectedVersions) (*ReadingReminder, error)
+	ToggleReminder(id uuid.UUID, userID uuid.UUID, expected ExpectedVersions) (*ReadingReminder, error)
+	DeleteReminder(id uuid.UUID, userID uuid.UUID, expected ExpectedVersions) error
 }
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ReadingSession 책 한 권을 읽은 한 번의 독서 기록입니다. 종료 전에는 EndPage와 EndedAt이 비어 있습니다.
+type ReadingSession struct {
+	ID              uuid.UUID  `json:"id"`
+	OwnerID         uuid.UUID  `json:"owner_id"`
+	BookID          uuid.UUID  `json:"book_id"`
+	StartPage       int        `json:"start_page"`
+	EndPage         *int       `json:"end_page"`
+	PagesRead       int        `json:"pages_read"`
+	StartedAt       time.Time  `json:"started_at"`
+	EndedAt         *time.Time `json:"ended_at"`
+	DurationMinutes int        `json:"duration_minutes"`
+}
+
+// ReadingProgress 책별 진행률과 독서 시간 요약입니다.
+type ReadingProgress struct {
+	BookID        uuid.UUID       `json:"book_id"`
+	CurrentPage   int             `json:"current_page"`
+	TotalPages    int             `json:"total_pages"`
+	Percent       float64         `json:"percent"`
+	TotalMinutes  int             `json:"total_minutes"`
+	WeekMinutes   int             `json:"week_minutes"`
+	SessionCount  int             `json:"session_count"`
+	ActiveSession *ReadingSession `json:"active_session,omitempty"`
+}
+
+type StartReadingSessionRequest struct {
+	StartPage *int `json:"start_page,omitempty"`
+}
+
+// StopReadingSessionRequest 종료 페이지 또는 진행률(%) 중 하나로 읽은 위치를 기록합니다.
+type StopReadingSessionRequest struct {
+	EndPage *int     `json:"end_page,omitempty"`
+	Percent *float64 `json:"percent,omitempty"`
+}
+
+type UpdateReadingProgressRequest struct {
+	CurrentPage *int     `json:"current_page,omitempty"`
+	TotalPages  *int     `json:"total_pages,omitempty"`
+	Percent     *float64 `json:"percent,omitempty"`
+}
+
+type ReadingSessionRepository interface {
+	Create(userID, bookID uuid.UUID, session *ReadingSession) (*ReadingSession, error)
+	GetActiveByBookID(userID, bookID uuid.UUID) (*ReadingSession, error)
+	End(session *ReadingSession) (*ReadingSession, error)
+	GetByBookID(userID, bookID uuid.UUID) ([]*ReadingSession, error)
+	GetByUserID(userID uuid.UUID, limit int) ([]*ReadingSession, error)
+}
+
+type ReadingSessionUseCase interface {
+	StartSession(userID, bookID uuid.UUID, req *StartReadingSessionRequest) (*ReadingSession, error)
+	StopSession(userID, bookID uuid.UUID, req *StopReadingSessionRequest) (*ReadingSession, error)
+	GetBookSessions(userID, bookID uuid.UUID) ([]*ReadingSession, error)
+	GetRecentSessions(userID uuid.UUID, limit int) ([]*ReadingSession, error)
+	GetProgress(userID, bookID uuid.UUID) (*ReadingProgress, error)
+	UpdateProgress(userID, bookID uuid.UUID, req *UpdateReadingProgressRequest) (*ReadingProgress, error)
+}
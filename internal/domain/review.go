@@ -7,14 +7,17 @@ import (
 )
 
 type Review struct {
-	ID        uuid.UUID `json:"id"`
-	OwnerID   uuid.UUID `json:"owner_id"`
-	BookISBN  string    `json:"book_isbn"`
-	Content   string    `json:"content"`
-	Rating    int       `json:"rating"`
-	IsPublic  bool      `json:"is_public"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID        uuid.UUID  `json:"id"`
+	OwnerID   uuid.UUID  `json:"owner_id"`
+	CatalogID *uuid.UUID `json:"catalog_id,omitempty"`
+	BookISBN  string     `json:"book_isbn"`
+	Content   string     `json:"content"`
+	Rating    int        `json:"rating"`
+	IsPublic  bool       `json:"is_public"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty"` // 휴지통으로 옮긴 시간
+	Version   int        `json:"version"`              // 수정할 때마다 1씩 증가하며 ETag로 사용합니다.
 }
 
 type ReviewResponse struct {
@@ -66,8 +69,15 @@ type ReviewRepository interface {
 	GetPublicByISBN(isbn string) ([]*ReviewResponse, error)
 	GetByUserID(userID uuid.UUID) ([]*Review, error)
 	ExistsByUserAndISBN(userID uuid.UUID, isbn string) (bool, error)
-	Update(review *Review) (*Review, error)
-	Delete(userID, reviewID uuid.UUID) error
+	Update(review *Review, expected ExpectedVersions) (*Review, error)
+	// Delete 리뷰를 휴지통으로 옮깁니다.
+	Delete(userID, reviewID uuid.UUID, expected ExpectedVersions) error
+	// Review Trash
+	GetDeletedByUserID(userID uuid.UUID) ([]*Review, error)
+	GetDeletedByID(userID, reviewID uuid.UUID) (*Review, error)
+	Restore(reviewID uuid.UUID) error
+	Purge(reviewID uuid.UUID) error
+	PurgeDeletedBefore(before time.Time) (int, error)
 }
 
 type ReviewUseCase interface {
@@ -75,6 +85,6 @@ type ReviewUseCase interface {
 	GetReviewByID(id uuid.UUID) (*Review, error)
 	GetReviewsByISBN(isbn string) ([]*ReviewResponse, error)
 	GetUserReviews(userID uuid.UUID) ([]*Review, error)
-	UpdateReview(userID, reviewID uuid.UUID, req *UpdateReviewRequest) (*Review, error)
-	DeleteReview(userID, reviewID uuid.UUID) error
+	UpdateReview(userID, reviewID uuid.UUID, req *UpdateReviewRequest, expected ExpectedVersions) (*Review, error)
+	DeleteReview(userID, reviewID uuid.UUID, expected ExpectedVersions) error
 }
@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShelfVisibility 책장 공개 범위
+type ShelfVisibility string
+
+const (
+	ShelfPrivate ShelfVisibility = "private"
+	ShelfPublic  ShelfVisibility = "public"
+)
+
+func (v ShelfVisibility) IsValid() bool {
+	return v == ShelfPrivate || v == ShelfPublic
+}
+
+// Shelf 사용자가 직접 만든 책장(컬렉션)입니다. 한 책은 여러 책장에 담길 수 있습니다.
+type Shelf struct {
+	ID          uuid.UUID       `json:"id"`
+	OwnerID     uuid.UUID       `json:"owner_id"`
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Visibility  ShelfVisibility `json:"visibility"`
+	// HideBooks 이 책장에 담긴 책을 공개 프로필에서 숨깁니다. 책의 공개 범위가 public이면 그 책은 보입니다.
+	HideBooks bool `json:"hide_books"`
+	BookCount int  `json:"book_count"`
+	// Books 책장 안의 순서대로 정렬된 책 목록이며, 책장 상세 조회 시에만 채워집니다.
+	Books     []*Book   `json:"books,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CreateShelfRequest struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Visibility  ShelfVisibility `json:"visibility"`
+	HideBooks   bool            `json:"hide_books"`
+}
+
+// UpdateShelfRequest 공개 범위와 숨김 설정은 생략하면 현재 값을 유지합니다.
+type UpdateShelfRequest struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	Visibility  ShelfVisibility `json:"visibility"`
+	HideBooks   *bool           `json:"hide_books"`
+}
+
+type AddShelfBookRequest struct {
+	BookID uuid.UUID `json:"book_id"`
+}
+
+// ReorderShelfBooksRequest 책장의 모든 책 ID를 원하는 순서대로 나열합니다.
+type ReorderShelfBooksRequest struct {
+	BookIDs []uuid.UUID `json:"book_ids"`
+}
+
+type ShelfRepository interface {
+	Create(userID uuid.UUID, shelf *Shelf) (*Shelf, error)
+	GetByID(id uuid.UUID) (*Shelf, error)
+	GetByUserID(userID uuid.UUID) ([]*Shelf, error)
+	Update(shelf *Shelf) error
+	Delete(id uuid.UUID) error
+	GetBooks(shelfID uuid.UUID) ([]*Book, error)
+	AddBook(shelfID, bookID uuid.UUID) error
+	RemoveBook(shelfID, bookID uuid.UUID) error
+	ReorderBooks(shelfID uuid.UUID, bookIDs []uuid.UUID) error
+}
+
+type ShelfUseCase interface {
+	CreateShelf(userID uuid.UUID, req *CreateShelfRequest) (*Shelf, error)
+	GetMyShelves(userID uuid.UUID) ([]*Shelf, error)
+	GetShelf(userID, id uuid.UUID) (*Shelf, error)
+	UpdateShelf(userID, id uuid.UUID, req *UpdateShelfRequest) (*Shelf, error)
+	DeleteShelf(userID, id uuid.UUID) error
+	AddBook(userID, shelfID, bookID uuid.UUID) (*Shelf, error)
+	RemoveBook(userID, shelfID, bookID uuid.UUID) error
+	ReorderBooks(userID, shelfID uuid.UUID, bookIDs []uuid.UUID) (*Shelf, error)
+}
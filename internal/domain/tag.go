@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// Tag 사용자가 책에 자유롭게 붙이는 태그입니다.
+type Tag struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	BookCount int       `json:"book_count"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TagMatch 여러 태그로 책 목록을 거를 때의 조건
+type TagMatch string
+
+const (
+	// TagMatchAny 태그 중 하나라도 붙은 책
+	TagMatchAny TagMatch = "any"
+	// TagMatchAll 모든 태그가 붙은 책
+	TagMatchAll TagMatch = "all"
+)
+
+// BulkTagRequest 여러 책에 태그를 한 번에 붙이거나 뗍니다.
+type BulkTagRequest struct {
+	BookIDs []uuid.UUID `json:"book_ids"`
+	Add     []string    `json:"add"`
+	Remove  []string    `json:"remove"`
+}
+
+// NormalizeTagName 앞뒤 공백과 앞의 '#'을 제거하고, 연속된 공백을 하나로 줄인 뒤 소문자로 변환합니다.
+// 변환 후 비어 있거나 maxLength자를 넘으면 ErrInvalidInput을 반환합니다.
+func NormalizeTagName(name string, maxLength int) (string, error) {
+	name = strings.TrimLeft(strings.TrimSpace(name), "#")
+	name = strings.ToLower(strings.Join(strings.Fields(name), " "))
+
+	if name == "" || utf8.RuneCountInString(name) > maxLength {
+		return "", ErrInvalidInput
+	}
+
+	return name, nil
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "github.com/google/uuid"
+
+// Trash 휴지통으로 옮긴 책과 리뷰입니다. 보관 기간이 지나면 스케줄러가 영구 삭제합니다.
+type Trash struct {
+	Books         []*Book   `json:"books"`
+	Reviews       []*Review `json:"reviews"`
+	RetentionDays int       `json:"retention_days"`
+}
+
+type TrashUseCase interface {
+	GetTrash(userID uuid.UUID) (*Trash, error)
+	RestoreBook(userID, bookID uuid.UUID) (*Book, error)
+	PurgeBook(userID, bookID uuid.UUID) error
+	RestoreReview(userID, reviewID uuid.UUID) (*Review, error)
+	PurgeReview(userID, reviewID uuid.UUID) error
+}
@@ -0,0 +1,21 @@
+package domain
+
+// ExpectedVersions If-Match 헤더로 받은 리소스의 기대 버전 목록입니다.
+// nil이면 버전을 검사하지 않고, 그렇지 않으면 현재 버전이 목록 중 하나와 같을 때만 수정합니다.
+// 빈 목록(약한 ETag만 보낸 경우 등)은 어떤 버전과도 일치하지 않습니다.
+type ExpectedVersions []int
+
+// Matches 현재 버전이 기대 버전 중 하나와 같은지 확인합니다.
+func (e ExpectedVersions) Matches(version int) bool {
+	if e == nil {
+		return true
+	}
+
+	for _, v := range e {
+		if v == version {
+			return true
+		}
+	}
+
+	return false
+}
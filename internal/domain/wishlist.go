@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WishlistPriority 위시리스트 우선순위
+type WishlistPriority string
+
+const (
+	WishlistPriorityLow    WishlistPriority = "low"
+	WishlistPriorityMedium WishlistPriority = "medium"
+	WishlistPriorityHigh   WishlistPriority = "high"
+)
+
+func (p WishlistPriority) IsValid() bool {
+	switch p {
+	case WishlistPriorityLow, WishlistPriorityMedium, WishlistPriorityHigh:
+		return true
+	default:
+		return false
+	}
+}
+
+// Rank 정렬에 사용하는 순위입니다. 높을수록 먼저 옵니다.
+func (p WishlistPriority) Rank() int {
+	switch p {
+	case WishlistPriorityHigh:
+		return 2
+	case WishlistPriorityMedium:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// WishlistItem 아직 갖고 있지 않지만 갖고 싶은 책입니다.
+// 선물 표시(Claim) 정보는 주인에게 보여주지 않도록 JSON에서 제외합니다.
+type WishlistItem struct {
+	ID           uuid.UUID        `json:"id"`
+	OwnerID      uuid.UUID        `json:"owner_id"`
+	Title        string           `json:"title"`
+	Author       string           `json:"author"`
+	ISBN         string           `json:"isbn"`
+	Publisher    string           `json:"publisher"`
+	ThumbnailURL string           `json:"thumbnail_url"`
+	Priority     WishlistPriority `json:"priority"`
+	Note         string           `json:"note"`
+	TargetPrice  *float64         `json:"target_price"`
+	Currency     string           `json:"currency"`
+	ClaimedBy    string           `json:"-"`
+	ClaimCode    string           `json:"-"`
+	ClaimedAt    *time.Time       `json:"-"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+// WishlistItemRequest 위시리스트 추가 및 수정 요청입니다. 수정 시에는 모든 항목을 요청 값으로 바꿉니다.
+// 추가할 때 제목 없이 ISBN만 보내면 도서 정보 제공자에서 서지 정보를 채웁니다.
+type WishlistItemRequest struct {
+	Title        string           `json:"title"`
+	Author       string           `json:"author"`
+	ISBN         string           `json:"isbn"`
+	Publisher    string           `json:"publisher"`
+	ThumbnailURL string           `json:"thumbnail_url"`
+	Priority     WishlistPriority `json:"priority"`
+	Note         string           `json:"note"`
+	TargetPrice  *float64         `json:"target_price"`
+	Currency     string           `json:"currency"`
+}
+
+// MoveWishlistItemRequest 위시리스트 항목을 서재로 옮길 때 함께 저장할 읽기 상태와 구입 정보입니다.
+type MoveWishlistItemRequest struct {
+	Status BookStatus `json:"status"`
+	BookCopyDetails
+}
+
+// WishlistShare 위시리스트 공개 링크 정보
+type WishlistShare struct {
+	Token string `json:"share_token"`
+}
+
+// SharedWishlistItem 공개 위시리스트에서 보여주는 항목입니다. 선물이 겹치지 않도록 선물 표시 여부를 포함합니다.
+type SharedWishlistItem struct {
+	ID           uuid.UUID        `json:"id"`
+	Title        string           `json:"title"`
+	Author       string           `json:"author"`
+	ISBN         string           `json:"isbn"`
+	Publisher    string           `json:"publisher"`
+	ThumbnailURL string           `json:"thumbnail_url"`
+	Priority     WishlistPriority `json:"priority"`
+	Note         string           `json:"note"`
+	TargetPrice  *float64         `json:"target_price"`
+	Currency     string           `json:"currency"`
+	Claimed      bool             `json:"claimed"`
+	ClaimedBy    string           `json:"claimed_by,omitempty"`
+}
+
+// SharedWishlist 공개 링크로 조회한 위시리스트
+type SharedWishlist struct {
+	OwnerNickname string                `json:"owner_nickname"`
+	Items         []*SharedWishlistItem `json:"items"`
+}
+
+// ClaimWishlistItemRequest 공개 위시리스트 항목에 선물 표시를 합니다.
+type ClaimWishlistItemRequest struct {
+	Name string `json:"name"`
+}
+
+// UnclaimWishlistItemRequest 선물 표시를 취소합니다. 표시할 때 받은 코드가 필요합니다.
+type UnclaimWishlistItemRequest struct {
+	ClaimCode string `json:"claim_code"`
+}
+
+// WishlistClaim 선물 표시 결과입니다. ClaimCode는 표시를 취소할 때 필요하며 이때만 반환합니다.
+type WishlistClaim struct {
+	Item      *SharedWishlistItem `json:"item"`
+	ClaimCode string              `json:"claim_code"`
+}
+
+type WishlistRepository interface {
+	Create(userID uuid.UUID, item *WishlistItem) (*WishlistItem, error)
+	GetByID(id uuid.UUID) (*WishlistItem, error)
+	GetByUserID(userID uuid.UUID) ([]*WishlistItem, error)
+	GetByISBN(userID uuid.UUID, isbn string) (*WishlistItem, error)
+	Update(item *WishlistItem) (*WishlistItem, error)
+	// Claim 아직 선물 표시가 없는 항목에만 표시를 저장하며, 이미 표시되어 있으면 ErrWishlistItemClaimed를 반환합니다.
+	Claim(id uuid.UUID, claimedBy, claimCode string, claimedAt time.Time) (*WishlistItem, error)
+	// Unclaim 선물 표시 코드가 일치할 때만 표시를 지우며, 코드가 다르면 ErrPermissionDenied를 반환합니다.
+	Unclaim(id uuid.UUID, claimCode string) (*WishlistItem, error)
+	Delete(id uuid.UUID) error
+	// Share
+	SetShareToken(userID uuid.UUID, token *string) error
+	GetShareToken(userID uuid.UUID) (*string, error)
+	GetOwnerByShareToken(token string) (*User, error)
+}
+
+type WishlistUseCase interface {
+	AddItem(userID uuid.UUID, req *WishlistItemRequest) (*WishlistItem, error)
+	GetItems(userID uuid.UUID) ([]*WishlistItem, error)
+	GetItem(userID, id uuid.UUID) (*WishlistItem, error)
+	UpdateItem(userID, id uuid.UUID, req *WishlistItemRequest) (*WishlistItem, error)
+	DeleteItem(userID, id uuid.UUID) error
+	MoveToLibrary(userID, id uuid.UUID, req *MoveWishlistItemRequest) (*Book, error)
+	// Share
+	GetShare(userID uuid.UUID) (*WishlistShare, error)
+	EnableShare(userID uuid.UUID) (*WishlistShare, error)
+	DisableShare(userID uuid.UUID) error
+	GetSharedWishlist(token string) (*SharedWishlist, error)
+	ClaimItem(token string, id uuid.UUID, req *ClaimWishlistItemRequest) (*WishlistClaim, error)
+	UnclaimItem(token string, id uuid.UUID, req *UnclaimWishlistItemRequest) (*SharedWishlistItem, error)
+}
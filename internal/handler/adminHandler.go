@@ -10,12 +10,14 @@ import (
 type AdminHandler struct {
 	userRepo      domain.UserRepository
 	apiKeyUseCase domain.AdminAPIKeyUseCase
+	metadataCache domain.BookMetadataCache
 }
 
-func NewAdminHandler(userRepo domain.UserRepository, apiKeyUseCase domain.AdminAPIKeyUseCase) *AdminHandler {
+func NewAdminHandler(userRepo domain.UserRepository, apiKeyUseCase domain.AdminAPIKeyUseCase, metadataCache domain.BookMetadataCache) *AdminHandler {
 	return &AdminHandler{
 		userRepo:      userRepo,
 		apiKeyUseCase: apiKeyUseCase,
+		metadataCache: metadataCache,
 	}
 }
 
@@ -127,3 +129,42 @@ func (h *AdminHandler) DeleteAPIKeyHandler(ctx *fiber.Ctx) error {
 
 	return ctx.Status(fiber.StatusNoContent).JSON(nil)
 }
+
+// PurgeBookMetadataCacheHandler 도서 정보 검색 캐시를 삭제합니다.
+// isbn 또는 query 파라미터가 있으면 해당 항목만, 없으면 전체 캐시를 삭제합니다.
+func (h *AdminHandler) PurgeBookMetadataCacheHandler(ctx *fiber.Ctx) error {
+	isbn := ctx.Query("isbn")
+	query := ctx.Query("query")
+
+	switch {
+	case isbn != "":
+		if err := h.metadataCache.PurgeISBN(isbn); err != nil {
+			logger.Sugar().Errorf("도서 정보 캐시 삭제 실패 (ISBN: %s): %v", isbn, err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+		}
+		logger.Sugar().Infof("도서 정보 캐시를 삭제했습니다. ISBN: %s", isbn)
+	case query != "":
+		if err := h.metadataCache.PurgeQuery(query); err != nil {
+			logger.Sugar().Errorf("도서 정보 캐시 삭제 실패 (검색어: %s): %v", query, err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+		}
+		logger.Sugar().Infof("도서 정보 캐시를 삭제했습니다. 검색어: %s", query)
+	default:
+		deleted, err := h.metadataCache.PurgeAll()
+		if err != nil {
+			logger.Sugar().Errorf("도서 정보 캐시 전체 삭제 실패: %v", err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+		}
+		logger.Sugar().Infof("도서 정보 캐시를 전체 삭제했습니다. 삭제된 키: %d", deleted)
+		return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+			"success":       true,
+			"message":       "도서 정보 캐시가 전체 삭제되었습니다.",
+			"deleted_count": deleted,
+		})
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"success": true,
+		"message": "도서 정보 캐시가 삭제되었습니다.",
+	})
+}
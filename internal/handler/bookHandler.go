@@ -1,14 +1,12 @@
 package handler
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
-	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
 	"github.com/dev-hyunsang/my-own-library-backend/logger"
@@ -17,8 +15,9 @@ import (
 )
 
 type BookHandler struct {
-	bookUseCase domain.BookUseCase
-	AuthHandler domain.AuthUseCase
+	bookUseCase     domain.BookUseCase
+	metadataUseCase domain.BookMetadataUseCase
+	AuthHandler     domain.AuthUseCase
 }
 
 type SaveBookRequest struct {
@@ -26,37 +25,25 @@ type SaveBookRequest struct {
 	Author       string `json:"author"`
 	BookISBN     string `json:"book_isbn"`
 	ThumbnailURL string `json:"thumbnail_url"`
-	Status       int    `json:"status"` // 0: 읽지 않음, 1: 읽는 중, 2: 읽음
+	// Status unread, reading, finished, paused, abandoned 중 하나이며 기존 정수값(0, 1, 2)도 받습니다.
+	Status domain.BookStatus `json:"status"`
+	// 보관 위치, 상태, 구입 정보 (선택)
+	domain.BookCopyDetails
+	// 시리즈 이름, 권 번호, 전체 권 수 (선택)
+	domain.BookSeries
 }
 
+// SearchBookRequest ISBN 또는 검색어로 도서 정보를 찾습니다. ISBN이 있으면 ISBN 검색을 우선합니다.
 type SearchBookRequest struct {
 	BookISBN string `json:"book_isbn"`
+	Query    string `json:"query"`
 }
 
-type ApiResponse struct {
-	LastBuildDate string `json:"lastBuildDate"`
-	Total         int    `json:"total"`
-	Start         int    `json:"start"`
-	Display       int    `json:"display"`
-	Items         []Book `json:"items"`
-}
-
-type Book struct {
-	Title       string `json:"title"`
-	Link        string `json:"link"`
-	Image       string `json:"image"`
-	Author      string `json:"author"`
-	Discount    string `json:"discount"`
-	Publisher   string `json:"publisher"`
-	PubDate     string `json:"pubdate"`
-	ISBN        string `json:"isbn"`
-	Description string `json:"description"`
-}
-
-func NewBookHandler(bookUseCase domain.BookUseCase, AuthHandler domain.AuthUseCase) *BookHandler {
+func NewBookHandler(bookUseCase domain.BookUseCase, metadataUseCase domain.BookMetadataUseCase, AuthHandler domain.AuthUseCase) *BookHandler {
 	return &BookHandler{
-		bookUseCase: bookUseCase,
-		AuthHandler: AuthHandler,
+		bookUseCase:     bookUseCase,
+		metadataUseCase: metadataUseCase,
+		AuthHandler:     AuthHandler,
 	}
 }
 
@@ -75,19 +62,27 @@ func (h *BookHandler) SaveBookHandler(ctx *fiber.Ctx) error {
 	}
 
 	createdBook := &domain.Book{
-		ID:           uuid.New(),
-		OwnerID:      userID,
-		Title:        book.Title,
-		Author:       book.Author,
-		BookISBN:     book.BookISBN,
-		ThumbnailURL: book.ThumbnailURL,
-		Status:       book.Status,
-		CreatedAt:    time.Now(),
-		UpdatedAt:    time.Now(),
+		ID:              uuid.New(),
+		OwnerID:         userID,
+		Title:           book.Title,
+		Author:          book.Author,
+		BookISBN:        book.BookISBN,
+		ThumbnailURL:    book.ThumbnailURL,
+		Status:          book.Status,
+		BookCopyDetails: book.BookCopyDetails,
+		BookSeries:      book.BookSeries,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 
 	result, err := h.bookUseCase.SaveByBookID(userID, createdBook)
 	if err != nil {
+		if errors.Is(err, domain.ErrDuplicateBook) {
+			return duplicateBookResponse(ctx, err)
+		}
+		if errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrInvalidISBN) || errors.Is(err, domain.ErrInvalidBookStatus) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+		}
 		logger.Sugar().Errorf("책을 저장하는 도중 오류가 발생했습니다: %v", err)
 		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
 	}
@@ -101,10 +96,114 @@ func (h *BookHandler) SaveBookHandler(ctx *fiber.Ctx) error {
 	})
 }
 
+// SaveBookByISBNRequest ISBN 등록 시 선택적으로 읽기 상태를 지정합니다.
+type SaveBookByISBNRequest struct {
+	Status domain.BookStatus `json:"status"`
+}
+
+// SaveBooksByISBNBatchRequest 스캔한 여러 ISBN을 한 번에 등록합니다.
+type SaveBooksByISBNBatchRequest struct {
+	ISBNs  []string          `json:"isbns"`
+	Status domain.BookStatus `json:"status"`
+}
+
+// POST /api/books/isbn/:isbn
+func (h *BookHandler) SaveBookByISBNHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	isbn := ctx.Params("isbn")
+	if len(isbn) == 0 {
+		logger.Sugar().Error("ISBN이 입력되지 않았습니다.")
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(SaveBookByISBNRequest)
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(req); err != nil {
+			logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+	}
+
+	result, err := h.bookUseCase.SaveByISBN(userID, isbn, req.Status)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		case errors.Is(err, domain.ErrInvalidISBN):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidISBN))
+		case errors.Is(err, domain.ErrInvalidBookStatus):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidBookStatus))
+		case errors.Is(err, domain.ErrDuplicateBook):
+			return duplicateBookResponse(ctx, err)
+		case errors.Is(err, domain.ErrBookMetadataNotFound):
+			logger.Sugar().Warnf("등록할 ISBN의 도서 정보를 찾을 수 없습니다: %s", isbn)
+			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrBookMetadataNotFound))
+		case errors.Is(err, domain.ErrMetadataUnavailable):
+			logger.Sugar().Errorf("도서 정보 제공자 장애로 ISBN 등록에 실패했습니다: %v", err)
+			return ctx.Status(fiber.StatusBadGateway).JSON(ErrorHandler(domain.ErrMetadataUnavailable))
+		default:
+			logger.Sugar().Errorf("ISBN으로 책을 저장하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
+		}
+	}
+
+	logger.Sugar().Infof("ISBN으로 책이 성공적으로 저장되었습니다 / 책ID: %s, ISBN: %s, 사용자ID: %s", result.ID.String(), isbn, userID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         result,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/books/isbn/batch
+func (h *BookHandler) SaveBooksByISBNBatchHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	req := new(SaveBooksByISBNBatchRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	results, err := h.bookUseCase.SaveByISBNBatch(userID, req.ISBNs, req.Status)
+	if err != nil {
+		logger.Sugar().Errorf("ISBN 일괄 등록 요청이 올바르지 않습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	}
+
+	succeeded := 0
+	for _, r := range results {
+		if r.Success {
+			succeeded++
+		}
+	}
+
+	logger.Sugar().Infof("ISBN 일괄 등록을 완료했습니다 / 사용자ID: %s, 성공: %d, 실패: %d", userID.String(), succeeded, len(results)-succeeded)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":    true,
+		"data":          results,
+		"success_count": succeeded,
+		"failed_count":  len(results) - succeeded,
+		"responsed_at":  time.Now(),
+	})
+}
+
+// UpdateBookRequest 상태를 생략하면 현재 읽기 상태를 유지합니다.
 type UpdateBookRequest struct {
-	Title  string `json:"title"`
-	Author string `json:"author"`
-	Status int    `json:"status"`
+	Title  string            `json:"title"`
+	Author string            `json:"author"`
+	Status domain.BookStatus `json:"status"`
 }
 
 func (h *BookHandler) UpdateBookHandler(ctx *fiber.Ctx) error {
@@ -128,7 +227,7 @@ func (h *BookHandler) UpdateBookHandler(ctx *fiber.Ctx) error {
 
 	existingBook, err := h.bookUseCase.GetBookByID(userID, parsedBookID)
 	if err != nil {
-		if ent.IsNotFound(err) {
+		if ent.IsNotFound(err) || errors.Is(err, domain.ErrNotFound) {
 			logger.Sugar().Errorf("해당 책을 찾을 수 없습니다: %v", err)
 			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
 		}
@@ -136,11 +235,6 @@ func (h *BookHandler) UpdateBookHandler(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
 	}
 
-	if existingBook.OwnerID != userID {
-		logger.Sugar().Error("해당 책의 소유자가 아닙니다.")
-		return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
-	}
-
 	req := new(UpdateBookRequest)
 	if err := ctx.BodyParser(req); err != nil {
 		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
@@ -148,26 +242,560 @@ func (h *BookHandler) UpdateBookHandler(ctx *fiber.Ctx) error {
 	}
 
 	updatedBook := &domain.Book{
-		ID:           parsedBookID,
-		OwnerID:      userID,
-		Title:        req.Title,
-		Author:       req.Author,
-		BookISBN:     existingBook.BookISBN,
-		ThumbnailURL: existingBook.ThumbnailURL,
-		Status:       req.Status,
-		UpdatedAt:    time.Now(),
+		ID:              parsedBookID,
+		OwnerID:         existingBook.OwnerID,
+		Title:           req.Title,
+		Author:          req.Author,
+		BookISBN:        existingBook.BookISBN,
+		ThumbnailURL:    existingBook.ThumbnailURL,
+		Publisher:       existingBook.Publisher,
+		PublishedDate:   existingBook.PublishedDate,
+		Status:          req.Status,
+		BookCopyDetails: existingBook.BookCopyDetails,
+		UpdatedAt:       time.Now(),
 	}
 
-	if err := h.bookUseCase.Edit(parsedBookID, updatedBook); err != nil {
+	if err := h.bookUseCase.Edit(userID, parsedBookID, updatedBook, expectedVersions(ctx)); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPreconditionFailed):
+			return bookPreconditionFailed(ctx, h.bookUseCase, userID, parsedBookID)
+		case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidISBN), errors.Is(err, domain.ErrInvalidBookStatus):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+		case errors.Is(err, domain.ErrPermissionDenied):
+			return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+		case errors.Is(err, domain.ErrInvalidStatusTransition):
+			return ctx.Status(fiber.StatusUnprocessableEntity).JSON(ErrorHandler(err))
+		}
 		logger.Sugar().Errorf("책을 수정하는 도중 오류가 발생했습니다: %v", err)
 		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
 	}
 
 	logger.Sugar().Infof("책이 성공적으로 수정되었습니다 / 책ID: %s, 사용자ID: %s", bookID, userID.String())
 
+	stored, err := h.bookUseCase.GetBookByID(userID, parsedBookID)
+	if err != nil {
+		logger.Sugar().Errorf("수정한 책을 조회하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+	setETag(ctx, stored.Version)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         stored,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PATCH /api/books/:id
+// 보낸 항목만 수정하고 저장된 책을 반환합니다.
+func (h *BookHandler) PatchBookHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.PatchBookRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	updated, err := h.bookUseCase.Patch(userID, bookID, req, expectedVersions(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrPreconditionFailed) {
+			return bookPreconditionFailed(ctx, h.bookUseCase, userID, bookID)
+		}
+		return patchBookError(ctx, err)
+	}
+	setETag(ctx, updated.Version)
+
+	logger.Sugar().Infof("책이 성공적으로 수정되었습니다 / 책ID: %s, 사용자ID: %s", bookID.String(), userID.String())
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         updated,
+		"responsed_at": time.Now(),
+	})
+}
+
+func patchBookError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrDuplicateBook):
+		return duplicateBookResponse(ctx, err)
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidISBN), errors.Is(err, domain.ErrInvalidBookStatus):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrInvalidStatusTransition), errors.Is(err, domain.ErrCatalogFieldConflict):
+		return ctx.Status(fiber.StatusUnprocessableEntity).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	default:
+		logger.Sugar().Errorf("책을 수정하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
+
+// PUT /api/books/:id/status
+func (h *BookHandler) UpdateBookStatusHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.UpdateBookStatusRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidBookStatus))
+	}
+
+	result, err := h.bookUseCase.ChangeStatus(userID, bookID, req.Status, expectedVersions(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrPreconditionFailed) {
+			return bookPreconditionFailed(ctx, h.bookUseCase, userID, bookID)
+		}
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidBookStatus):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+		case errors.Is(err, domain.ErrPermissionDenied):
+			return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+		case errors.Is(err, domain.ErrNotFound):
+			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+		case errors.Is(err, domain.ErrInvalidStatusTransition):
+			return ctx.Status(fiber.StatusUnprocessableEntity).JSON(ErrorHandler(err))
+		default:
+			logger.Sugar().Errorf("읽기 상태를 변경하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+		}
+	}
+
+	logger.Sugar().Infof("읽기 상태가 변경되었습니다 / 책ID: %s, 상태: %s", bookID.String(), result.Status)
+	setETag(ctx, result.Version)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         result,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/:id/status-history
+func (h *BookHandler) GetBookStatusHistoryHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	histories, err := h.bookUseCase.GetStatusHistory(userID, bookID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+		}
+		logger.Sugar().Errorf("읽기 상태 변경 기록을 조회하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         histories,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PUT /api/books/:id/copy
+func (h *BookHandler) UpdateBookCopyHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.BookCopyDetails)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	updated, err := h.bookUseCase.UpdateCopyDetails(userID, bookID, req, expectedVersions(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrPreconditionFailed) {
+			return bookPreconditionFailed(ctx, h.bookUseCase, userID, bookID)
+		}
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		case errors.Is(err, domain.ErrPermissionDenied):
+			return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+		case errors.Is(err, domain.ErrNotFound):
+			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+		default:
+			logger.Sugar().Errorf("책의 사본 정보를 수정하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+		}
+	}
+	setETag(ctx, updated.Version)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         updated,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/locations/summary
+func (h *BookHandler) GetLocationSummaryHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	summaries, err := h.bookUseCase.GetLocationSummary(userID, domain.LocationSummaryLevel(ctx.Query("level")))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+		logger.Sugar().Errorf("위치별 책 요약을 조회하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         summaries,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PUT /api/books/:id/series
+func (h *BookHandler) UpdateBookSeriesHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.BookSeries)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	updated, err := h.bookUseCase.UpdateSeries(userID, bookID, req, expectedVersions(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrPreconditionFailed) {
+			return bookPreconditionFailed(ctx, h.bookUseCase, userID, bookID)
+		}
+		return seriesError(ctx, err)
+	}
+	setETag(ctx, updated.Version)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         updated,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/series
+func (h *BookHandler) GetSeriesHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	series, err := h.bookUseCase.GetSeries(userID)
+	if err != nil {
+		return seriesError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         series,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/series/detail?name=
+func (h *BookHandler) GetSeriesDetailHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	series, err := h.bookUseCase.GetSeriesByName(userID, ctx.Query("name"))
+	if err != nil {
+		return seriesError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         series,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/series/missing
+func (h *BookHandler) GetMissingVolumesHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	series, err := h.bookUseCase.GetMissingVolumes(userID)
+	if err != nil {
+		return seriesError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         series,
+		"responsed_at": time.Now(),
+	})
+}
+
+func seriesError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	default:
+		logger.Sugar().Errorf("시리즈 정보를 처리하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
+
+// PUT /api/books/:id/visibility
+func (h *BookHandler) UpdateBookVisibilityHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.UpdateBookVisibilityRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	updated, err := h.bookUseCase.UpdateVisibility(userID, bookID, req.Visibility, expectedVersions(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrPreconditionFailed) {
+			return bookPreconditionFailed(ctx, h.bookUseCase, userID, bookID)
+		}
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		case errors.Is(err, domain.ErrPermissionDenied):
+			return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+		case errors.Is(err, domain.ErrNotFound):
+			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+		default:
+			logger.Sugar().Errorf("책의 공개 범위를 수정하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+		}
+	}
+	setETag(ctx, updated.Version)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         updated,
+		"responsed_at": time.Now(),
+	})
+}
+
+// bookPreconditionFailed 기대 버전과 달라 수정하지 못했을 때 책의 현재 정보와 ETag를 담아 412로 응답합니다.
+// 공유 서재 등 책을 다루는 다른 핸들러에서도 같은 방식으로 응답할 수 있도록 분리했습니다.
+func bookPreconditionFailed(ctx *fiber.Ctx, bookUseCase domain.BookUseCase, userID, bookID uuid.UUID) error {
+	current, err := bookUseCase.GetBookByID(userID, bookID)
+	if err != nil {
+		logger.Sugar().Errorf("책의 현재 버전을 조회하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusPreconditionFailed).JSON(ErrorHandler(domain.ErrPreconditionFailed))
+	}
+
+	return preconditionFailed(ctx, current.Version, current)
+}
+
+// 409 응답에 이미 서재에 있는 책의 ID를 담아 클라이언트가 기존 책으로 이동할 수 있도록 합니다.
+func duplicateBookResponse(ctx *fiber.Ctx, err error) error {
+	response := fiber.Map{
+		"is_success":   false,
+		"message":      domain.ErrDuplicateBook.Error(),
+		"responsed_at": time.Now(),
+	}
+
+	var dup *domain.DuplicateBookError
+	if errors.As(err, &dup) {
+		response["existing_book_id"] = dup.BookID
+	}
+
+	return ctx.Status(fiber.StatusConflict).JSON(response)
+}
+
+// GET /api/books/duplicates
+func (h *BookHandler) GetDuplicateBooksHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	groups, err := h.bookUseCase.GetDuplicateBooks(userID)
+	if err != nil {
+		logger.Sugar().Errorf("중복된 책을 조회하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         groups,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/books/:id/merge
+func (h *BookHandler) MergeBooksHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	targetID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.MergeBooksRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	merged, err := h.bookUseCase.MergeBooks(userID, targetID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		case errors.Is(err, domain.ErrNotFound):
+			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+		default:
+			logger.Sugar().Errorf("책을 병합하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+		}
+	}
+
+	logger.Sugar().Infof("중복된 책을 병합했습니다 / 책ID: %s, 병합한 책 %d권, 사용자ID: %s", targetID.String(), len(req.SourceIDs), userID.String())
+
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
 		"is_success":   true,
-		"data":         updatedBook,
+		"data":         merged,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/tags/bulk
+func (h *BookHandler) BulkTagBooksHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	req := new(domain.BulkTagRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	if err := h.bookUseCase.BulkTagBooks(userID, req); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		case errors.Is(err, domain.ErrNotFound):
+			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+		case errors.Is(err, domain.ErrPermissionDenied):
+			return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+		default:
+			logger.Sugar().Errorf("태그를 일괄 처리하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+		}
+	}
+
+	logger.Sugar().Infof("태그 일괄 처리가 완료되었습니다 / 책 %d권, 사용자ID: %s", len(req.BookIDs), userID.String())
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/tags
+func (h *BookHandler) SuggestTagsHandler(ctx *fiber.Ctx) error {
+	userID, err := h.AuthHandler.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	tags, err := h.bookUseCase.SuggestTags(userID, ctx.Query("q"), ctx.QueryInt("limit"))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+		logger.Sugar().Errorf("태그 목록을 조회하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         tags,
 		"responsed_at": time.Now(),
 	})
 }
@@ -210,7 +838,7 @@ func (h *BookHandler) GetBookHandler(ctx *fiber.Ctx) error {
 
 	book, err := h.bookUseCase.GetBookByID(parsedUserID, parsedBookID)
 	if err != nil {
-		if ent.IsNotFound(err) {
+		if ent.IsNotFound(err) || errors.Is(err, domain.ErrNotFound) {
 			logger.Sugar().Errorf("해당 책을 찾을 수 없습니다: %v", err)
 			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
 		}
@@ -219,6 +847,7 @@ func (h *BookHandler) GetBookHandler(ctx *fiber.Ctx) error {
 	}
 
 	logger.Sugar().Infof("책을 성공적으로 조회했습니다 / 책ID: %s, 사용자ID: %s", bookID, userID)
+	setETag(ctx, book.Version)
 
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
 		"is_success":   true,
@@ -235,42 +864,118 @@ func (h *BookHandler) GetBooksHandler(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
 	}
 
-	books, err := h.bookUseCase.GetBooksByUserID(userID)
+	filter, err := parseBookListFilter(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("책 목록 조회 조건이 올바르지 않습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	page, err := h.bookUseCase.ListBooksByUserID(userID, filter)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidInput) || errors.Is(err, domain.ErrInvalidCursor) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+		}
 		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
 	}
 
 	logger.Sugar().Infof("사용자의 책 목록을 성공적으로 조회했습니다. / 사용자ID: %s", userID.String())
 
-	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
-		"is_success":   true,
-		"data":         books,
-		"responsed_at": time.Now(),
-	})
+	return ctx.Status(fiber.StatusOK).JSON(SuccessPageResponse(page.Items, page.PageInfo))
 }
 
-func (h *BookHandler) GetBooksByUserNameHandler(ctx *fiber.Ctx) error {
-	name := ctx.Params("name")
-	if len(name) == 0 {
-		logger.Sugar().Error("사용자 이름이 입력되지 않았습니다.")
-		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+// 쿼리 파라미터에서 책 목록 필터, 정렬, 커서 조건을 읽어옵니다.
+// 날짜는 RFC3339 또는 YYYY-MM-DD 형식을 지원합니다.
+func parseBookListFilter(ctx *fiber.Ctx) (*domain.BookListFilter, error) {
+	filter := &domain.BookListFilter{
+		Author:     ctx.Query("author"),
+		ISBNPrefix: ctx.Query("isbn_prefix"),
+		Location: domain.BookLocation{
+			Room:     ctx.Query("location_room"),
+			Bookcase: ctx.Query("location_bookcase"),
+			Shelf:    ctx.Query("location_shelf"),
+		},
+		TagMatch: domain.TagMatch(ctx.Query("tag_match")),
+		SortBy:   domain.BookSortField(ctx.Query("sort")),
+		Order:    domain.SortOrder(ctx.Query("order")),
+		Cursor:   ctx.Query("cursor"),
 	}
 
-	books, err := h.bookUseCase.GetBooksByUserName(name)
-	if err != nil {
-		if ent.IsNotFound(err) || errors.Is(err, domain.ErrNotFound) {
-			logger.Sugar().Errorf("등록된 책을 찾을 수 없습니다: %v", err)
-			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	if v := ctx.Query("status"); v != "" {
+		status, err := domain.ParseBookStatus(v)
+		if err != nil {
+			return nil, fmt.Errorf("status: %w", err)
 		}
-		logger.Sugar().Errorf("책의 목록을 가져오는 도중 오류가 발생했습니다: %v", err)
-		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
+		filter.Status = &status
 	}
 
-	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
-		"is_success":   true,
-		"data":         books,
-		"responsed_at": time.Now(),
-	})
+	// 여러 태그는 쉼표로 구분합니다. (예: tags=소설,2026)
+	if v := ctx.Query("tags"); v != "" {
+		filter.Tags = strings.Split(v, ",")
+	}
+
+	if v := ctx.Query("lent"); v != "" {
+		lent, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("lent: %w", err)
+		}
+		filter.Lent = &lent
+	}
+
+	if v := ctx.Query("shelf_id"); v != "" {
+		shelfID, err := uuid.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("shelf_id: %w", err)
+		}
+		filter.ShelfID = &shelfID
+	}
+
+	if v := ctx.Query("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("limit: %s", v)
+		}
+		filter.Limit = limit
+	}
+
+	dates := []struct {
+		key        string
+		target     **time.Time
+		upperBound bool
+	}{
+		{"created_from", &filter.CreatedAfter, false},
+		{"created_to", &filter.CreatedBefore, true},
+		{"updated_from", &filter.UpdatedAfter, false},
+		{"updated_to", &filter.UpdatedBefore, true},
+	}
+	for _, d := range dates {
+		v := ctx.Query(d.key)
+		if v == "" {
+			continue
+		}
+		t, err := parseQueryTime(v, d.upperBound)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", d.key, err)
+		}
+		*d.target = &t
+	}
+
+	return filter, nil
+}
+
+// 날짜만 입력된 상한값은 해당 날짜를 포함하도록 다음 날 0시로 변환합니다.
+func parseQueryTime(v string, upperBound bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse("2006-01-02", v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if upperBound {
+		t = t.AddDate(0, 0, 1)
+	}
+	return t, nil
 }
 
 func (h *BookHandler) BookDeleteHandler(ctx *fiber.Ctx) error {
@@ -287,17 +992,29 @@ func (h *BookHandler) BookDeleteHandler(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
 	}
 
-	err = h.bookUseCase.DeleteByID(userID, uuid.MustParse(id))
+	bookID, err := uuid.Parse(id)
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	err = h.bookUseCase.DeleteByID(userID, bookID, expectedVersions(ctx))
 	if err != nil {
-		if ent.IsNotFound(err) {
+		if errors.Is(err, domain.ErrPreconditionFailed) {
+			return bookPreconditionFailed(ctx, h.bookUseCase, userID, bookID)
+		}
+		if ent.IsNotFound(err) || errors.Is(err, domain.ErrNotFound) {
 			logger.Sugar().Errorf("등록된 책을 찾을 수 없습니다: %v", err)
 			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
 		}
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+		}
 		logger.Sugar().Errorf("책을 삭제하는 도중 오류가 발생했습니다: %v", err)
 		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
 	}
 
-	logger.Sugar().Infof("책이 성공적으로 삭제되었습니다 / 책ID: %s, 사용자ID: %s", id, userID.String())
+	logger.Sugar().Infof("책을 휴지통으로 옮겼습니다 / 책ID: %s, 사용자ID: %s", id, userID.String())
 
 	return ctx.SendStatus(fiber.StatusNoContent)
 }
@@ -316,49 +1033,41 @@ func (h *BookHandler) SearchBookIsbnHandler(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
 	}
 
-	logger.Sugar().Infof("검색하는 책 ISBN: %s", req.BookISBN)
-
-	// 네이버 OpenAI를 사용하여 ISBN과 동일한 서적을 검색합니다.
-	// 한 개의 결과값만 나오며, 그 결과을 반환합니다.
-	searchURL := fmt.Sprintf("https://openapi.naver.com/v1/search/book.json?query=%s&display=10&start=1&sort=sim", req.BookISBN)
-
-	bookReq, err := http.NewRequest("GET", searchURL, nil)
-	if err != nil {
-		logger.Sugar().Errorf("책 검색 API 요청 생성 실패: %v", err)
-		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
-	}
-
-	bookReq.Header.Add("X-Naver-Client-Id", config.GetEnv("NAVER_API_CLIENT_ID"))
-	bookReq.Header.Add("X-Naver-Client-Secret", config.GetEnv("NAVER_API_CLIENT_SECRET"))
-
-	client := &http.Client{}
-	resp, err := client.Do(bookReq)
-	if err != nil {
-		logger.Sugar().Errorf("책 검색 API 요청 실패: %v", err)
-		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
-	}
-
-	defer resp.Body.Close()
+	var items []*domain.BookMetadata
+	if req.BookISBN != "" {
+		logger.Sugar().Infof("검색하는 책 ISBN: %s", req.BookISBN)
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		logger.Sugar().Errorf("책 검색 API 응답 본문 읽기 실패: %v", err)
-		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
+		var result *domain.BookMetadata
+		result, err = h.metadataUseCase.SearchByISBN(req.BookISBN)
+		if result != nil {
+			items = []*domain.BookMetadata{result}
+		}
+	} else {
+		items, err = h.metadataUseCase.Search(req.Query)
 	}
 
-	var res ApiResponse
-	err = json.Unmarshal([]byte(body), &res)
 	if err != nil {
-		logger.Sugar().Errorf("책 검색 API 응답 본문을 언마샬하는 도중 오류가 발생했습니다: %v", err)
-		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(err))
+		switch {
+		case errors.Is(err, domain.ErrInvalidInput):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		case errors.Is(err, domain.ErrInvalidISBN):
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidISBN))
+		case errors.Is(err, domain.ErrBookMetadataNotFound):
+			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrBookMetadataNotFound))
+		default:
+			logger.Sugar().Errorf("도서 정보 검색 중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusBadGateway).JSON(ErrorHandler(domain.ErrMetadataUnavailable))
+		}
 	}
 
-	logger.Sugar().Infof("네이버 책 검색 API 요청이 성공적으로 완료되었습니다. / 사용자ID: %s", userID.String())
-	logger.Sugar().Infof("네이버 책 검색 API 응답: %v", res)
+	logger.Sugar().Infof("도서 정보 검색이 성공적으로 완료되었습니다. / 사용자ID: %s, 결과 수: %d", userID.String(), len(items))
 
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
-		"is_success":   true,
-		"data":         res,
+		"is_success": true,
+		"data": fiber.Map{
+			"total": len(items),
+			"items": items,
+		},
 		"responsed_at": time.Now(),
 	})
 }
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type BookImportHandler struct {
+	importUseCase domain.BookImportUseCase
+	authUseCase   domain.AuthUseCase
+}
+
+func NewBookImportHandler(importUseCase domain.BookImportUseCase, authUseCase domain.AuthUseCase) *BookImportHandler {
+	return &BookImportHandler{
+		importUseCase: importUseCase,
+		authUseCase:   authUseCase,
+	}
+}
+
+// POST /api/books/import
+// multipart/form-data로 file, format(goodreads|csv), mapping(JSON, 선택)을 받습니다.
+func (h *BookImportHandler) StartImportHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		logger.Sugar().Errorf("가져올 파일을 읽는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidImportFile))
+	}
+	if fileHeader.Size > config.MaxImportFileSize {
+		return ctx.Status(fiber.StatusRequestEntityTooLarge).JSON(ErrorHandler(domain.ErrInvalidImportFile))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		logger.Sugar().Errorf("가져올 파일을 여는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidImportFile))
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, config.MaxImportFileSize+1))
+	if err != nil {
+		logger.Sugar().Errorf("가져올 파일을 읽는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidImportFile))
+	}
+
+	format := domain.ImportFormat(ctx.FormValue("format", string(domain.ImportFormatGoodreads)))
+
+	var mapping *domain.ImportColumnMapping
+	if raw := ctx.FormValue("mapping"); raw != "" {
+		mapping = new(domain.ImportColumnMapping)
+		if err := json.Unmarshal([]byte(raw), mapping); err != nil {
+			logger.Sugar().Errorf("열 매핑을 파싱하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+	}
+
+	job, err := h.importUseCase.StartImport(userID, format, mapping, data)
+	if err != nil {
+		return bookImportError(ctx, err)
+	}
+
+	logger.Sugar().Infof("가져오기 작업이 시작되었습니다. 작업ID: %s, 행 수: %d", job.ID.String(), job.TotalRows)
+
+	return ctx.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         job,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/import/:id
+func (h *BookImportHandler) GetImportJobHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	jobID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 가져오기 작업 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	job, err := h.importUseCase.GetImportJob(userID, jobID)
+	if err != nil {
+		return bookImportError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         job,
+		"responsed_at": time.Now(),
+	})
+}
+
+func bookImportError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	case errors.Is(err, domain.ErrInvalidImportFile):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidImportFile))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrImportInProgress):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrImportInProgress))
+	default:
+		logger.Sugar().Errorf("가져오기 작업 처리 중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
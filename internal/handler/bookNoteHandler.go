@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type BookNoteHandler struct {
+	noteUseCase domain.BookNoteUseCase
+	authUseCase domain.AuthUseCase
+}
+
+func NewBookNoteHandler(noteUseCase domain.BookNoteUseCase, authUseCase domain.AuthUseCase) *BookNoteHandler {
+	return &BookNoteHandler{
+		noteUseCase: noteUseCase,
+		authUseCase: authUseCase,
+	}
+}
+
+// POST /api/books/:id/notes
+func (h *BookNoteHandler) CreateNoteHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.BookNoteRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	note, err := h.noteUseCase.CreateNote(userID, bookID, req)
+	if err != nil {
+		return bookNoteError(ctx, err)
+	}
+
+	logger.Sugar().Infof("메모가 저장되었습니다. 메모ID: %s, 책ID: %s", note.ID.String(), bookID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         note,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/:id/notes
+func (h *BookNoteHandler) GetBookNotesHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	notes, err := h.noteUseCase.GetBookNotes(userID, bookID)
+	if err != nil {
+		return bookNoteError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         notes,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/notes
+func (h *BookNoteHandler) SearchNotesHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	page, err := h.noteUseCase.SearchNotes(userID, &domain.BookNoteSearchFilter{
+		Query:  ctx.Query("q"),
+		Cursor: ctx.Query("cursor"),
+		Limit:  ctx.QueryInt("limit"),
+	})
+	if err != nil {
+		return bookNoteError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(SuccessPageResponse(page.Items, page.PageInfo))
+}
+
+// GET /api/notes/:id
+func (h *BookNoteHandler) GetNoteHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	noteID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 메모 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	note, err := h.noteUseCase.GetNote(userID, noteID)
+	if err != nil {
+		return bookNoteError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         note,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PUT /api/notes/:id
+func (h *BookNoteHandler) UpdateNoteHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	noteID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 메모 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.BookNoteRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	note, err := h.noteUseCase.UpdateNote(userID, noteID, req)
+	if err != nil {
+		return bookNoteError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         note,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/notes/:id
+func (h *BookNoteHandler) DeleteNoteHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	noteID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 메모 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	if err := h.noteUseCase.DeleteNote(userID, noteID); err != nil {
+		return bookNoteError(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func bookNoteError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidPage), errors.Is(err, domain.ErrInvalidCursor):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	default:
+		logger.Sugar().Errorf("메모 처리 중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type BorrowRequestHandler struct {
+	requestUseCase domain.BorrowRequestUseCase
+	authUseCase    domain.AuthUseCase
+}
+
+func NewBorrowRequestHandler(requestUseCase domain.BorrowRequestUseCase, authUseCase domain.AuthUseCase) *BorrowRequestHandler {
+	return &BorrowRequestHandler{
+		requestUseCase: requestUseCase,
+		authUseCase:    authUseCase,
+	}
+}
+
+// POST /api/borrow-requests
+func (h *BorrowRequestHandler) CreateRequestHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	req := new(domain.CreateBorrowRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	br, err := h.requestUseCase.CreateRequest(userID, req)
+	if err != nil {
+		return borrowRequestError(ctx, err)
+	}
+
+	logger.Sugar().Infof("대여 요청이 생성되었습니다. 요청ID: %s, 책ID: %s", br.ID.String(), br.BookID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         br,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/borrow-requests?role=sent|received&status=
+func (h *BorrowRequestHandler) GetRequestsHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	requests, err := h.requestUseCase.GetRequests(userID, &domain.BorrowRequestFilter{
+		Role:   domain.BorrowRequestRole(ctx.Query("role")),
+		Status: domain.BorrowRequestStatus(ctx.Query("status")),
+	})
+	if err != nil {
+		return borrowRequestError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         requests,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/borrow-requests/:id
+func (h *BorrowRequestHandler) GetRequestHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	requestID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 대여 요청 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	br, err := h.requestUseCase.GetRequest(userID, requestID)
+	if err != nil {
+		return borrowRequestError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         br,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/borrow-requests/:id/accept
+func (h *BorrowRequestHandler) AcceptRequestHandler(ctx *fiber.Ctx) error {
+	return h.respond(ctx, h.requestUseCase.Accept)
+}
+
+// POST /api/borrow-requests/:id/decline
+func (h *BorrowRequestHandler) DeclineRequestHandler(ctx *fiber.Ctx) error {
+	return h.respond(ctx, h.requestUseCase.Decline)
+}
+
+// POST /api/borrow-requests/:id/cancel
+func (h *BorrowRequestHandler) CancelRequestHandler(ctx *fiber.Ctx) error {
+	return h.transition(ctx, h.requestUseCase.Cancel)
+}
+
+// POST /api/borrow-requests/:id/hand-over
+func (h *BorrowRequestHandler) HandOverHandler(ctx *fiber.Ctx) error {
+	return h.transition(ctx, h.requestUseCase.HandOver)
+}
+
+// POST /api/borrow-requests/:id/return
+func (h *BorrowRequestHandler) MarkReturnedHandler(ctx *fiber.Ctx) error {
+	return h.transition(ctx, h.requestUseCase.MarkReturned)
+}
+
+// 수락/거절처럼 선택적인 응답 메시지를 받는 상태 변경을 처리합니다.
+func (h *BorrowRequestHandler) respond(ctx *fiber.Ctx, fn func(userID, id uuid.UUID, req *domain.RespondBorrowRequest) (*domain.BorrowRequest, error)) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	requestID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 대여 요청 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.RespondBorrowRequest)
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(req); err != nil {
+			logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+	}
+
+	br, err := fn(userID, requestID, req)
+	if err != nil {
+		return borrowRequestError(ctx, err)
+	}
+
+	logger.Sugar().Infof("대여 요청 상태가 변경되었습니다. 요청ID: %s, 상태: %s", br.ID.String(), br.Status)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         br,
+		"responsed_at": time.Now(),
+	})
+}
+
+// 본문 없이 처리하는 상태 변경입니다.
+func (h *BorrowRequestHandler) transition(ctx *fiber.Ctx, fn func(userID, id uuid.UUID) (*domain.BorrowRequest, error)) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	requestID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 대여 요청 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	br, err := fn(userID, requestID)
+	if err != nil {
+		return borrowRequestError(ctx, err)
+	}
+
+	logger.Sugar().Infof("대여 요청 상태가 변경되었습니다. 요청ID: %s, 상태: %s", br.ID.String(), br.Status)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         br,
+		"responsed_at": time.Now(),
+	})
+}
+
+func borrowRequestError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrPrivateAccount):
+		return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPrivateAccount))
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+	case errors.Is(err, domain.ErrBorrowRequestExists):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrBorrowRequestExists))
+	case errors.Is(err, domain.ErrInvalidRequestState):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrInvalidRequestState))
+	case errors.Is(err, domain.ErrBookAlreadyLent):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrBookAlreadyLent))
+	default:
+		logger.Sugar().Errorf("대여 요청 처리 중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
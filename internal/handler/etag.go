@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// 리소스의 버전으로 ETag 값을 만듭니다. 예: "3"
+func versionETag(version int) string {
+	return strconv.Quote(strconv.Itoa(version))
+}
+
+// setETag 응답에 리소스의 현재 버전을 ETag 헤더로 담습니다.
+func setETag(ctx *fiber.Ctx, version int) {
+	ctx.Set(fiber.HeaderETag, versionETag(version))
+}
+
+// expectedVersions If-Match 헤더를 수정 조건으로 넘길 기대 버전 목록으로 바꿉니다.
+// 헤더가 없거나 "*"이면 nil을 반환해 버전을 검사하지 않습니다.
+// 약한 ETag(W/)는 강한 비교 규칙에 따라 어떤 버전과도 일치하지 않으므로 목록에 넣지 않습니다.
+func expectedVersions(ctx *fiber.Ctx) domain.ExpectedVersions {
+	header := strings.TrimSpace(ctx.Get(fiber.HeaderIfMatch))
+	if header == "" || header == "*" {
+		return nil
+	}
+
+	versions := domain.ExpectedVersions{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		unquoted, err := strconv.Unquote(tag)
+		if err != nil {
+			continue
+		}
+		if v, err := strconv.Atoi(unquoted); err == nil && versionETag(v) == tag {
+			versions = append(versions, v)
+		}
+	}
+
+	return versions
+}
+
+// preconditionFailed 412 응답에 현재 리소스와 ETag를 담아 클라이언트가 최신 정보를 보고 다시 수정할 수 있도록 합니다.
+func preconditionFailed(ctx *fiber.Ctx, version int, current interface{}) error {
+	setETag(ctx, version)
+
+	return ctx.Status(fiber.StatusPreconditionFailed).JSON(fiber.Map{
+		"is_success":   false,
+		"message":      domain.ErrPreconditionFailed.Error(),
+		"data":         current,
+		"responsed_at": time.Now(),
+	})
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"bufio"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+)
+
+type LibraryExportHandler struct {
+	exportUseCase domain.LibraryExportUseCase
+	authUseCase   domain.AuthUseCase
+}
+
+func NewLibraryExportHandler(exportUseCase domain.LibraryExportUseCase, authUseCase domain.AuthUseCase) *LibraryExportHandler {
+	return &LibraryExportHandler{
+		exportUseCase: exportUseCase,
+		authUseCase:   authUseCase,
+	}
+}
+
+// GET /api/export?format=json|csv|goodreads
+// 응답 본문은 책을 조회하는 대로 스트리밍하므로 전송 도중 발생한 오류는 로그로만 남습니다.
+func (h *LibraryExportHandler) ExportLibraryHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	format := domain.ExportFormat(ctx.Query("format", string(domain.ExportFormatJSON)))
+	if !format.IsValid() {
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	contentType, ext := fiber.MIMEApplicationJSONCharsetUTF8, "json"
+	if format != domain.ExportFormatJSON {
+		contentType, ext = "text/csv; charset=utf-8", "csv"
+	}
+
+	filename := fmt.Sprintf("library-%s-%s.%s", format, time.Now().Format("20060102"), ext)
+	ctx.Set(fiber.HeaderContentType, contentType)
+	ctx.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := h.exportUseCase.Export(userID, format, w); err != nil {
+			logger.Sugar().Errorf("서재 내보내기 중 오류가 발생했습니다. 사용자ID: %s, 오류: %v", userID.String(), err)
+		}
+	})
+
+	return nil
+}
@@ -0,0 +1,461 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type LibraryHandler struct {
+	libraryUseCase domain.LibraryUseCase
+	bookUseCase    domain.BookUseCase
+	authUseCase    domain.AuthUseCase
+}
+
+func NewLibraryHandler(libraryUseCase domain.LibraryUseCase, bookUseCase domain.BookUseCase, authUseCase domain.AuthUseCase) *LibraryHandler {
+	return &LibraryHandler{
+		libraryUseCase: libraryUseCase,
+		bookUseCase:    bookUseCase,
+		authUseCase:    authUseCase,
+	}
+}
+
+// POST /api/libraries
+func (h *LibraryHandler) CreateLibraryHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	req := new(domain.CreateLibraryRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	library, err := h.libraryUseCase.CreateLibrary(userID, req)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	logger.Sugar().Infof("공유 서재가 생성되었습니다. 서재ID: %s, 사용자ID: %s", library.ID.String(), userID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         library,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/libraries
+func (h *LibraryHandler) GetLibrariesHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraries, err := h.libraryUseCase.GetLibraries(userID)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         libraries,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/libraries/:id
+func (h *LibraryHandler) GetLibraryHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 서재 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	library, err := h.libraryUseCase.GetLibrary(userID, libraryID)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         library,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PUT /api/libraries/:id
+func (h *LibraryHandler) UpdateLibraryHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 서재 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.UpdateLibraryRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	library, err := h.libraryUseCase.UpdateLibrary(userID, libraryID, req)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         library,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/libraries/:id
+func (h *LibraryHandler) DeleteLibraryHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 서재 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	if err := h.libraryUseCase.DeleteLibrary(userID, libraryID); err != nil {
+		return libraryError(ctx, err)
+	}
+
+	logger.Sugar().Infof("공유 서재가 삭제되었습니다. 서재ID: %s, 사용자ID: %s", libraryID.String(), userID.String())
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// GET /api/libraries/:id/books
+func (h *LibraryHandler) GetLibraryBooksHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 서재 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	books, err := h.libraryUseCase.GetLibraryBooks(userID, libraryID)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         books,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PUT /api/libraries/:id/members/:user_id
+func (h *LibraryHandler) UpdateMemberRoleHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 서재 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	memberID, err := uuid.Parse(ctx.Params("user_id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 사용자 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.UpdateLibraryMemberRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	library, err := h.libraryUseCase.UpdateMemberRole(userID, libraryID, memberID, req)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	logger.Sugar().Infof("서재 구성원의 역할이 변경되었습니다. 서재ID: %s, 구성원ID: %s, 역할: %s", libraryID.String(), memberID.String(), req.Role)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         library,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/libraries/:id/members/:user_id
+// 관리자가 구성원을 내보내거나, 자신의 ID로 요청해 서재를 나갑니다.
+func (h *LibraryHandler) RemoveMemberHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 서재 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	memberID, err := uuid.Parse(ctx.Params("user_id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 사용자 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	if err := h.libraryUseCase.RemoveMember(userID, libraryID, memberID); err != nil {
+		return libraryError(ctx, err)
+	}
+
+	logger.Sugar().Infof("서재 구성원이 삭제되었습니다. 서재ID: %s, 구성원ID: %s", libraryID.String(), memberID.String())
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// POST /api/libraries/:id/invitations
+func (h *LibraryHandler) InviteHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 서재 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.InviteLibraryMemberRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	invitation, err := h.libraryUseCase.Invite(userID, libraryID, req)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	logger.Sugar().Infof("서재 초대를 보냈습니다. 서재ID: %s, 초대ID: %s", libraryID.String(), invitation.ID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         invitation,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/libraries/:id/invitations
+func (h *LibraryHandler) GetLibraryInvitationsHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 서재 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	invitations, err := h.libraryUseCase.GetLibraryInvitations(userID, libraryID)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         invitations,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/libraries/:id/invitations/:invitation_id
+func (h *LibraryHandler) CancelInvitationHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	libraryID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 서재 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	invitationID, err := uuid.Parse(ctx.Params("invitation_id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 초대 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	invitation, err := h.libraryUseCase.CancelInvitation(userID, libraryID, invitationID)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         invitation,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/libraries/invitations
+func (h *LibraryHandler) GetMyInvitationsHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	invitations, err := h.libraryUseCase.GetMyInvitations(userID)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         invitations,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/libraries/invitations/:id/accept
+func (h *LibraryHandler) AcceptInvitationHandler(ctx *fiber.Ctx) error {
+	return h.respond(ctx, h.libraryUseCase.AcceptInvitation)
+}
+
+// POST /api/libraries/invitations/:id/decline
+func (h *LibraryHandler) DeclineInvitationHandler(ctx *fiber.Ctx) error {
+	return h.respond(ctx, h.libraryUseCase.DeclineInvitation)
+}
+
+// 받은 초대에 수락/거절로 응답합니다.
+func (h *LibraryHandler) respond(ctx *fiber.Ctx, fn func(userID, invitationID uuid.UUID) (*domain.LibraryInvitation, error)) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	invitationID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 초대 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	invitation, err := fn(userID, invitationID)
+	if err != nil {
+		return libraryError(ctx, err)
+	}
+
+	logger.Sugar().Infof("서재 초대에 응답했습니다. 초대ID: %s, 상태: %s", invitation.ID.String(), invitation.Status)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         invitation,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PUT /api/books/:id/library
+// 책 소유자가 책을 공유 서재로 옮기거나, library_id를 null로 보내 개인 서재로 되돌립니다.
+func (h *LibraryHandler) MoveBookHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.MoveBookToLibraryRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	book, err := h.libraryUseCase.MoveBook(userID, bookID, req, expectedVersions(ctx))
+	if err != nil {
+		if errors.Is(err, domain.ErrPreconditionFailed) {
+			return bookPreconditionFailed(ctx, h.bookUseCase, userID, bookID)
+		}
+		return libraryError(ctx, err)
+	}
+	setETag(ctx, book.Version)
+
+	logger.Sugar().Infof("책의 서재를 변경했습니다. 책ID: %s, 사용자ID: %s", bookID.String(), userID.String())
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         book,
+		"responsed_at": time.Now(),
+	})
+}
+
+func libraryError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+	case errors.Is(err, domain.ErrAlreadyLibraryMember):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrAlreadyLibraryMember))
+	case errors.Is(err, domain.ErrLibraryInvitationExists):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrLibraryInvitationExists))
+	case errors.Is(err, domain.ErrInvalidInvitationState):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrInvalidInvitationState))
+	case errors.Is(err, domain.ErrLastLibraryOwner):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrLastLibraryOwner))
+	default:
+		logger.Sugar().Errorf("공유 서재 처리 중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
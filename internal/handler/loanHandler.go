@@ -0,0 +1,234 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type LoanHandler struct {
+	loanUseCase domain.LoanUseCase
+	authUseCase domain.AuthUseCase
+}
+
+func NewLoanHandler(loanUseCase domain.LoanUseCase, authUseCase domain.AuthUseCase) *LoanHandler {
+	return &LoanHandler{
+		loanUseCase: loanUseCase,
+		authUseCase: authUseCase,
+	}
+}
+
+// POST /api/books/:id/loans
+func (h *LoanHandler) LendBookHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.LoanRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	loan, err := h.loanUseCase.LendBook(userID, bookID, req)
+	if err != nil {
+		return loanError(ctx, err)
+	}
+
+	logger.Sugar().Infof("대여 기록이 저장되었습니다. 대여ID: %s, 책ID: %s", loan.ID.String(), bookID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         loan,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/:id/loans
+func (h *LoanHandler) GetBookLoansHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	loans, err := h.loanUseCase.GetBookLoans(userID, bookID)
+	if err != nil {
+		return loanError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         loans,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/loans?state=active|overdue|returned|all
+func (h *LoanHandler) GetLoansHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	loans, err := h.loanUseCase.GetLoans(userID, domain.LoanState(ctx.Query("state")))
+	if err != nil {
+		return loanError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         loans,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/loans/:id
+func (h *LoanHandler) GetLoanHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	loanID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 대여 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	loan, err := h.loanUseCase.GetLoan(userID, loanID)
+	if err != nil {
+		return loanError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         loan,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PUT /api/loans/:id
+func (h *LoanHandler) UpdateLoanHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	loanID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 대여 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.LoanRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	loan, err := h.loanUseCase.UpdateLoan(userID, loanID, req)
+	if err != nil {
+		return loanError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         loan,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/loans/:id/return
+func (h *LoanHandler) ReturnLoanHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	loanID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 대여 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.ReturnLoanRequest)
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(req); err != nil {
+			logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+	}
+
+	loan, err := h.loanUseCase.ReturnLoan(userID, loanID, req)
+	if err != nil {
+		return loanError(ctx, err)
+	}
+
+	logger.Sugar().Infof("대여한 책이 반납되었습니다. 대여ID: %s", loan.ID.String())
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         loan,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/loans/:id
+func (h *LoanHandler) DeleteLoanHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	loanID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 대여 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	if err := h.loanUseCase.DeleteLoan(userID, loanID); err != nil {
+		return loanError(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func loanError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrBookAlreadyLent):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrBookAlreadyLent))
+	case errors.Is(err, domain.ErrLoanAlreadyReturned):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrLoanAlreadyReturned))
+	default:
+		logger.Sugar().Errorf("대여 처리 중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
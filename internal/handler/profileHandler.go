@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ProfileHandler struct {
+	profileUseCase domain.ProfileUseCase
+	authUseCase    domain.AuthUseCase
+}
+
+func NewProfileHandler(profileUseCase domain.ProfileUseCase, authUseCase domain.AuthUseCase) *ProfileHandler {
+	return &ProfileHandler{
+		profileUseCase: profileUseCase,
+		authUseCase:    authUseCase,
+	}
+}
+
+// GET /api/profiles/:name
+func (h *ProfileHandler) GetProfileHandler(ctx *fiber.Ctx) error {
+	profile, err := h.profileUseCase.GetProfile(ctx.Params("name"))
+	if err != nil {
+		return profileError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         profile,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/profiles/:name/books
+// GET /api/books/:name
+func (h *ProfileHandler) GetProfileBooksHandler(ctx *fiber.Ctx) error {
+	filter, err := parseBookListFilter(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("책 목록 조회 조건이 올바르지 않습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	page, err := h.profileUseCase.ListBooks(ctx.Params("name"), filter)
+	if err != nil {
+		return profileError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(SuccessPageResponse(page.Items, page.PageInfo))
+}
+
+// GET /api/shelves/user/:name
+func (h *ProfileHandler) GetProfileShelvesHandler(ctx *fiber.Ctx) error {
+	profile, err := h.profileUseCase.GetProfile(ctx.Params("name"))
+	if err != nil {
+		return profileError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         profile.Shelves,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/profiles/:name/shelves/:id
+func (h *ProfileHandler) GetProfileShelfHandler(ctx *fiber.Ctx) error {
+	shelfID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책장 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	shelf, err := h.profileUseCase.GetShelf(ctx.Params("name"), shelfID)
+	if err != nil {
+		return profileError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         shelf,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/users/me/public-profile
+func (h *ProfileHandler) GetPreviewHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	preview, err := h.profileUseCase.GetPreview(userID)
+	if err != nil {
+		return profileError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         preview,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/users/me/public-profile/books
+func (h *ProfileHandler) GetPreviewBooksHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	filter, err := parseBookListFilter(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("책 목록 조회 조건이 올바르지 않습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	page, err := h.profileUseCase.ListPreviewBooks(userID, filter)
+	if err != nil {
+		return profileError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(SuccessPageResponse(page.Items, page.PageInfo))
+}
+
+func profileError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidCursor):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrPrivateAccount):
+		return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPrivateAccount))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	default:
+		logger.Sugar().Errorf("공개 프로필을 조회하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
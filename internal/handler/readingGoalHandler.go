@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ReadingGoalHandler struct {
+	goalUseCase domain.ReadingGoalUseCase
+	authUseCase domain.AuthUseCase
+}
+
+func NewReadingGoalHandler(goalUseCase domain.ReadingGoalUseCase, authUseCase domain.AuthUseCase) *ReadingGoalHandler {
+	return &ReadingGoalHandler{
+		goalUseCase: goalUseCase,
+		authUseCase: authUseCase,
+	}
+}
+
+// POST /api/goals
+func (h *ReadingGoalHandler) CreateGoalHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	req := new(domain.CreateReadingGoalRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	progress, err := h.goalUseCase.CreateGoal(userID, req)
+	if err != nil {
+		return readingGoalError(ctx, err)
+	}
+
+	logger.Sugar().Infof("독서 목표가 생성되었습니다. 목표ID: %s, 사용자ID: %s", progress.Goal.ID.String(), userID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         progress,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/goals?year=2026
+func (h *ReadingGoalHandler) GetGoalsHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	var year int
+	if v := ctx.Query("year"); v != "" {
+		year, err = strconv.Atoi(v)
+		if err != nil {
+			logger.Sugar().Errorf("잘못된 연도 형식입니다: %v", err)
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+	}
+
+	goals, err := h.goalUseCase.GetGoals(userID, year)
+	if err != nil {
+		return readingGoalError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         goals,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/goals/:id
+func (h *ReadingGoalHandler) GetGoalHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	goalID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 독서 목표 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	progress, err := h.goalUseCase.GetGoal(userID, goalID)
+	if err != nil {
+		return readingGoalError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         progress,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PATCH /api/goals/:id
+func (h *ReadingGoalHandler) UpdateGoalHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	goalID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 독서 목표 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.UpdateReadingGoalRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	progress, err := h.goalUseCase.UpdateGoal(userID, goalID, req)
+	if err != nil {
+		return readingGoalError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         progress,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/goals/:id
+func (h *ReadingGoalHandler) DeleteGoalHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	goalID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 독서 목표 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	if err := h.goalUseCase.DeleteGoal(userID, goalID); err != nil {
+		return readingGoalError(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func readingGoalError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrDuplicateReadingGoal):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrDuplicateReadingGoal))
+	default:
+		logger.Sugar().Errorf("독서 목표 처리 중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
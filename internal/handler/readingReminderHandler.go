@@ -69,6 +69,35 @@ func (h *ReadingReminderHandler) GetRemindersHandler(ctx *fiber.Ctx) error {
 	})
 }
 
+func (h *ReadingReminderHandler) GetReminderHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰에서 사용자 ID 추출 실패: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	reminderID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	reminder, err := h.reminderUseCase.GetReminderByID(reminderID)
+	if err != nil {
+		logger.Sugar().Errorf("알림 조회 실패: %v", err)
+		if err == domain.ErrReminderNotFound {
+			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(err))
+		}
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+
+	if reminder.UserID != userID {
+		return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+	}
+
+	setETag(ctx, reminder.Version)
+	return ctx.Status(fiber.StatusOK).JSON(reminder)
+}
+
 func (h *ReadingReminderHandler) UpdateReminderHandler(ctx *fiber.Ctx) error {
 	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
 	if err != nil {
@@ -92,7 +121,7 @@ func (h *ReadingReminderHandler) UpdateReminderHandler(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
 	}
 
-	reminder, err := h.reminderUseCase.UpdateReminder(reminderID, userID, req)
+	reminder, err := h.reminderUseCase.UpdateReminder(reminderID, userID, req, expectedVersions(ctx))
 	if err != nil {
 		logger.Sugar().Errorf("알림 수정 실패: %v", err)
 		switch err {
@@ -100,6 +129,8 @@ func (h *ReadingReminderHandler) UpdateReminderHandler(ctx *fiber.Ctx) error {
 			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(err))
 		case domain.ErrReminderOwnerMismatch:
 			return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+		case domain.ErrPreconditionFailed:
+			return h.reminderPreconditionFailed(ctx, reminderID)
 		case domain.ErrInvalidReminderTime, domain.ErrInvalidDayOfWeek:
 			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
 		default:
@@ -108,6 +139,7 @@ func (h *ReadingReminderHandler) UpdateReminderHandler(ctx *fiber.Ctx) error {
 	}
 
 	logger.Sugar().Infof("알림이 수정되었습니다. 알림ID: %s", reminder.ID.String())
+	setETag(ctx, reminder.Version)
 	return ctx.Status(fiber.StatusOK).JSON(reminder)
 }
 
@@ -128,7 +160,7 @@ func (h *ReadingReminderHandler) ToggleReminderHandler(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
 	}
 
-	reminder, err := h.reminderUseCase.ToggleReminder(reminderID, userID)
+	reminder, err := h.reminderUseCase.ToggleReminder(reminderID, userID, expectedVersions(ctx))
 	if err != nil {
 		logger.Sugar().Errorf("알림 토글 실패: %v", err)
 		switch err {
@@ -136,12 +168,15 @@ func (h *ReadingReminderHandler) ToggleReminderHandler(ctx *fiber.Ctx) error {
 			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(err))
 		case domain.ErrReminderOwnerMismatch:
 			return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+		case domain.ErrPreconditionFailed:
+			return h.reminderPreconditionFailed(ctx, reminderID)
 		default:
 			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
 		}
 	}
 
 	logger.Sugar().Infof("알림이 토글되었습니다. 알림ID: %s, 활성화: %v", reminder.ID.String(), reminder.IsEnabled)
+	setETag(ctx, reminder.Version)
 	return ctx.Status(fiber.StatusOK).JSON(reminder)
 }
 
@@ -162,7 +197,7 @@ func (h *ReadingReminderHandler) DeleteReminderHandler(ctx *fiber.Ctx) error {
 		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
 	}
 
-	err = h.reminderUseCase.DeleteReminder(reminderID, userID)
+	err = h.reminderUseCase.DeleteReminder(reminderID, userID, expectedVersions(ctx))
 	if err != nil {
 		logger.Sugar().Errorf("알림 삭제 실패: %v", err)
 		switch err {
@@ -170,6 +205,8 @@ func (h *ReadingReminderHandler) DeleteReminderHandler(ctx *fiber.Ctx) error {
 			return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(err))
 		case domain.ErrReminderOwnerMismatch:
 			return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+		case domain.ErrPreconditionFailed:
+			return h.reminderPreconditionFailed(ctx, reminderID)
 		default:
 			return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
 		}
@@ -178,3 +215,14 @@ func (h *ReadingReminderHandler) DeleteReminderHandler(ctx *fiber.Ctx) error {
 	logger.Sugar().Infof("알림이 삭제되었습니다. 알림ID: %s", reminderID.String())
 	return ctx.Status(fiber.StatusNoContent).JSON(nil)
 }
+
+// reminderPreconditionFailed 기대 버전과 달라 수정하지 못했을 때 알림의 현재 정보와 ETag를 담아 412로 응답합니다.
+func (h *ReadingReminderHandler) reminderPreconditionFailed(ctx *fiber.Ctx, reminderID uuid.UUID) error {
+	current, err := h.reminderUseCase.GetReminderByID(reminderID)
+	if err != nil {
+		logger.Sugar().Errorf("알림 버전 확인 실패: %v", err)
+		return ctx.Status(fiber.StatusPreconditionFailed).JSON(ErrorHandler(domain.ErrPreconditionFailed))
+	}
+
+	return preconditionFailed(ctx, current.Version, current)
+}
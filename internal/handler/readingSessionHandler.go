@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type ReadingSessionHandler struct {
+	sessionUseCase domain.ReadingSessionUseCase
+	authUseCase    domain.AuthUseCase
+}
+
+func NewReadingSessionHandler(sessionUseCase domain.ReadingSessionUseCase, authUseCase domain.AuthUseCase) *ReadingSessionHandler {
+	return &ReadingSessionHandler{
+		sessionUseCase: sessionUseCase,
+		authUseCase:    authUseCase,
+	}
+}
+
+// POST /api/books/:id/sessions/start
+func (h *ReadingSessionHandler) StartSessionHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.StartReadingSessionRequest)
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(req); err != nil {
+			logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+	}
+
+	session, err := h.sessionUseCase.StartSession(userID, bookID, req)
+	if err != nil {
+		return readingSessionError(ctx, err)
+	}
+
+	logger.Sugar().Infof("독서 세션이 시작되었습니다. 세션ID: %s, 책ID: %s", session.ID.String(), bookID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         session,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/books/:id/sessions/stop
+func (h *ReadingSessionHandler) StopSessionHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.StopReadingSessionRequest)
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(req); err != nil {
+			logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+	}
+
+	session, err := h.sessionUseCase.StopSession(userID, bookID, req)
+	if err != nil {
+		return readingSessionError(ctx, err)
+	}
+
+	logger.Sugar().Infof("독서 세션이 종료되었습니다. 세션ID: %s, 읽은 페이지: %d", session.ID.String(), session.PagesRead)
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         session,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/:id/sessions
+func (h *ReadingSessionHandler) GetBookSessionsHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	sessions, err := h.sessionUseCase.GetBookSessions(userID, bookID)
+	if err != nil {
+		return readingSessionError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         sessions,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/reading-sessions
+func (h *ReadingSessionHandler) GetRecentSessionsHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	sessions, err := h.sessionUseCase.GetRecentSessions(userID, ctx.QueryInt("limit"))
+	if err != nil {
+		return readingSessionError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         sessions,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/books/:id/progress
+func (h *ReadingSessionHandler) GetProgressHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	progress, err := h.sessionUseCase.GetProgress(userID, bookID)
+	if err != nil {
+		return readingSessionError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         progress,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PUT /api/books/:id/progress
+func (h *ReadingSessionHandler) UpdateProgressHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.UpdateReadingProgressRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	progress, err := h.sessionUseCase.UpdateProgress(userID, bookID, req)
+	if err != nil {
+		return readingSessionError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         progress,
+		"responsed_at": time.Now(),
+	})
+}
+
+func readingSessionError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidPage):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrNoActiveSession):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNoActiveSession))
+	case errors.Is(err, domain.ErrReadingSessionActive):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrReadingSessionActive))
+	default:
+		logger.Sugar().Errorf("독서 세션 처리 중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"time"
 
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
 	"github.com/gofiber/fiber/v2"
 )
 
@@ -46,3 +47,13 @@ func SuccessListResponse(data interface{}, count int) fiber.Map {
 		"count":      count,
 	}
 }
+
+// SuccessPageResponse creates a success response with cursor pagination metadata
+func SuccessPageResponse(data interface{}, pageInfo domain.PageInfo) fiber.Map {
+	return fiber.Map{
+		"is_success":   true,
+		"data":         data,
+		"pagination":   pageInfo,
+		"responsed_at": time.Now(),
+	}
+}
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"time"
 
 	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
@@ -87,6 +88,13 @@ func (h *ReviewHandler) GetReviewsByISBNHandler(ctx *fiber.Ctx) error {
 
 	reviews, err := h.reviewUseCase.GetReviewsByISBN(isbn)
 	if err != nil {
+		if errors.Is(err, domain.ErrInvalidISBN) {
+			return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"is_success": false,
+				"message":    err.Error(),
+				"time":       time.Now().String(),
+			})
+		}
 		logger.Sugar().Errorf("리뷰 조회 실패: %v", err)
 		return ctx.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"is_success": false,
@@ -123,6 +131,8 @@ func (h *ReviewHandler) GetReviewByIDHandler(ctx *fiber.Ctx) error {
 		})
 	}
 
+	setETag(ctx, review.Version)
+
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
 		"is_success": true,
 		"data":       review,
@@ -160,8 +170,11 @@ func (h *ReviewHandler) UpdateReviewHandler(ctx *fiber.Ctx) error {
 		})
 	}
 
-	review, err := h.reviewUseCase.UpdateReview(userID, reviewID, req)
+	review, err := h.reviewUseCase.UpdateReview(userID, reviewID, req, expectedVersions(ctx))
 	if err != nil {
+		if errors.Is(err, domain.ErrPreconditionFailed) {
+			return h.reviewPreconditionFailed(ctx, reviewID)
+		}
 		logger.Sugar().Errorf("리뷰 수정 실패: %v", err)
 		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"is_success": false,
@@ -171,6 +184,7 @@ func (h *ReviewHandler) UpdateReviewHandler(ctx *fiber.Ctx) error {
 	}
 
 	logger.Sugar().Infof("리뷰가 수정되었습니다. ID: %s", review.ID.String())
+	setETag(ctx, review.Version)
 
 	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
 		"is_success": true,
@@ -200,8 +214,11 @@ func (h *ReviewHandler) DeleteReviewHandler(ctx *fiber.Ctx) error {
 		})
 	}
 
-	err = h.reviewUseCase.DeleteReview(userID, reviewID)
+	err = h.reviewUseCase.DeleteReview(userID, reviewID, expectedVersions(ctx))
 	if err != nil {
+		if errors.Is(err, domain.ErrPreconditionFailed) {
+			return h.reviewPreconditionFailed(ctx, reviewID)
+		}
 		logger.Sugar().Errorf("리뷰 삭제 실패: %v", err)
 		return ctx.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"is_success": false,
@@ -218,6 +235,17 @@ func (h *ReviewHandler) DeleteReviewHandler(ctx *fiber.Ctx) error {
 	})
 }
 
+// reviewPreconditionFailed 기대 버전과 달라 수정하지 못했을 때 리뷰의 현재 정보와 ETag를 담아 412로 응답합니다.
+func (h *ReviewHandler) reviewPreconditionFailed(ctx *fiber.Ctx, reviewID uuid.UUID) error {
+	current, err := h.reviewUseCase.GetReviewByID(reviewID)
+	if err != nil {
+		logger.Sugar().Errorf("리뷰 조회 실패: %v", err)
+		return ctx.Status(fiber.StatusPreconditionFailed).JSON(ErrorHandler(domain.ErrPreconditionFailed))
+	}
+
+	return preconditionFailed(ctx, current.Version, current)
+}
+
 // GET /api/reviews/me
 func (h *ReviewHandler) GetMyReviewsHandler(ctx *fiber.Ctx) error {
 	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type TrashHandler struct {
+	trashUseCase domain.TrashUseCase
+	authUseCase  domain.AuthUseCase
+}
+
+func NewTrashHandler(trashUseCase domain.TrashUseCase, authUseCase domain.AuthUseCase) *TrashHandler {
+	return &TrashHandler{
+		trashUseCase: trashUseCase,
+		authUseCase:  authUseCase,
+	}
+}
+
+// GET /api/trash
+func (h *TrashHandler) GetTrashHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	trash, err := h.trashUseCase.GetTrash(userID)
+	if err != nil {
+		return trashError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         trash,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/trash/books/:id/restore
+func (h *TrashHandler) RestoreBookHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	book, err := h.trashUseCase.RestoreBook(userID, bookID)
+	if err != nil {
+		return trashError(ctx, err)
+	}
+
+	logger.Sugar().Infof("휴지통의 책을 복원했습니다. 책ID: %s, 사용자ID: %s", bookID.String(), userID.String())
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         book,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/trash/books/:id
+func (h *TrashHandler) PurgeBookHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	bookID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 책 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	if err := h.trashUseCase.PurgeBook(userID, bookID); err != nil {
+		return trashError(ctx, err)
+	}
+
+	logger.Sugar().Infof("휴지통의 책을 영구 삭제했습니다. 책ID: %s, 사용자ID: %s", bookID.String(), userID.String())
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// POST /api/trash/reviews/:id/restore
+func (h *TrashHandler) RestoreReviewHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	reviewID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 리뷰 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	review, err := h.trashUseCase.RestoreReview(userID, reviewID)
+	if err != nil {
+		return trashError(ctx, err)
+	}
+
+	logger.Sugar().Infof("휴지통의 리뷰를 복원했습니다. 리뷰ID: %s, 사용자ID: %s", reviewID.String(), userID.String())
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         review,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/trash/reviews/:id
+func (h *TrashHandler) PurgeReviewHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	reviewID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 리뷰 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	if err := h.trashUseCase.PurgeReview(userID, reviewID); err != nil {
+		return trashError(ctx, err)
+	}
+
+	logger.Sugar().Infof("휴지통의 리뷰를 영구 삭제했습니다. 리뷰ID: %s, 사용자ID: %s", reviewID.String(), userID.String())
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+func trashError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrDuplicateBook):
+		return duplicateBookResponse(ctx, err)
+	case errors.Is(err, domain.ErrAlreadyExists):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrAlreadyExists))
+	default:
+		logger.Sugar().Errorf("휴지통을 처리하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
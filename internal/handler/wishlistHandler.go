@@ -0,0 +1,331 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type WishlistHandler struct {
+	wishlistUseCase domain.WishlistUseCase
+	authUseCase     domain.AuthUseCase
+}
+
+func NewWishlistHandler(wishlistUseCase domain.WishlistUseCase, authUseCase domain.AuthUseCase) *WishlistHandler {
+	return &WishlistHandler{
+		wishlistUseCase: wishlistUseCase,
+		authUseCase:     authUseCase,
+	}
+}
+
+// POST /api/wishlist
+func (h *WishlistHandler) AddItemHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	req := new(domain.WishlistItemRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	item, err := h.wishlistUseCase.AddItem(userID, req)
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	logger.Sugar().Infof("위시리스트에 책이 추가되었습니다. 항목ID: %s", item.ID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         item,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/wishlist
+func (h *WishlistHandler) GetItemsHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	items, err := h.wishlistUseCase.GetItems(userID)
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         items,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/wishlist/:id
+func (h *WishlistHandler) GetItemHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 위시리스트 항목 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	item, err := h.wishlistUseCase.GetItem(userID, itemID)
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         item,
+		"responsed_at": time.Now(),
+	})
+}
+
+// PUT /api/wishlist/:id
+func (h *WishlistHandler) UpdateItemHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 위시리스트 항목 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.WishlistItemRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	item, err := h.wishlistUseCase.UpdateItem(userID, itemID, req)
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         item,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/wishlist/:id
+func (h *WishlistHandler) DeleteItemHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 위시리스트 항목 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	if err := h.wishlistUseCase.DeleteItem(userID, itemID); err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// POST /api/wishlist/:id/move
+func (h *WishlistHandler) MoveToLibraryHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 위시리스트 항목 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.MoveWishlistItemRequest)
+	if len(ctx.Body()) > 0 {
+		if err := ctx.BodyParser(req); err != nil {
+			logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+			return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+		}
+	}
+
+	book, err := h.wishlistUseCase.MoveToLibrary(userID, itemID, req)
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	logger.Sugar().Infof("위시리스트 항목을 서재로 옮겼습니다. 항목ID: %s, 책ID: %s", itemID.String(), book.ID.String())
+
+	return ctx.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         book,
+		"responsed_at": time.Now(),
+	})
+}
+
+// GET /api/wishlist/share
+func (h *WishlistHandler) GetShareHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	share, err := h.wishlistUseCase.GetShare(userID)
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         share,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/wishlist/share
+func (h *WishlistHandler) EnableShareHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	share, err := h.wishlistUseCase.EnableShare(userID)
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         share,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/wishlist/share
+func (h *WishlistHandler) DisableShareHandler(ctx *fiber.Ctx) error {
+	userID, err := h.authUseCase.GetUserIDFromToken(ctx)
+	if err != nil {
+		logger.Sugar().Errorf("JWT 토큰을 통한 사용자 인증에 실패했습니다: %v", err)
+		return ctx.Status(fiber.StatusUnauthorized).JSON(ErrorHandler(domain.ErrUserNotLoggedIn))
+	}
+
+	if err := h.wishlistUseCase.DisableShare(userID); err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.SendStatus(fiber.StatusNoContent)
+}
+
+// GET /api/wishlist/shared/:token
+func (h *WishlistHandler) GetSharedWishlistHandler(ctx *fiber.Ctx) error {
+	wishlist, err := h.wishlistUseCase.GetSharedWishlist(ctx.Params("token"))
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         wishlist,
+		"responsed_at": time.Now(),
+	})
+}
+
+// POST /api/wishlist/shared/:token/items/:id/claim
+func (h *WishlistHandler) ClaimItemHandler(ctx *fiber.Ctx) error {
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 위시리스트 항목 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.ClaimWishlistItemRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	claim, err := h.wishlistUseCase.ClaimItem(ctx.Params("token"), itemID, req)
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         claim,
+		"responsed_at": time.Now(),
+	})
+}
+
+// DELETE /api/wishlist/shared/:token/items/:id/claim
+func (h *WishlistHandler) UnclaimItemHandler(ctx *fiber.Ctx) error {
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		logger.Sugar().Errorf("잘못된 위시리스트 항목 ID 형식입니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	req := new(domain.UnclaimWishlistItemRequest)
+	if err := ctx.BodyParser(req); err != nil {
+		logger.Sugar().Errorf("요청 본문을 파싱하는 도중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(domain.ErrInvalidInput))
+	}
+
+	item, err := h.wishlistUseCase.UnclaimItem(ctx.Params("token"), itemID, req)
+	if err != nil {
+		return wishlistError(ctx, err)
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(fiber.Map{
+		"is_success":   true,
+		"data":         item,
+		"responsed_at": time.Now(),
+	})
+}
+
+func wishlistError(ctx *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrInvalidISBN), errors.Is(err, domain.ErrInvalidBookStatus):
+		return ctx.Status(fiber.StatusBadRequest).JSON(ErrorHandler(err))
+	case errors.Is(err, domain.ErrNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrNotFound))
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return ctx.Status(fiber.StatusForbidden).JSON(ErrorHandler(domain.ErrPermissionDenied))
+	case errors.Is(err, domain.ErrDuplicateBook):
+		return duplicateBookResponse(ctx, err)
+	case errors.Is(err, domain.ErrAlreadyOnWishlist):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrAlreadyOnWishlist))
+	case errors.Is(err, domain.ErrWishlistItemClaimed):
+		return ctx.Status(fiber.StatusConflict).JSON(ErrorHandler(domain.ErrWishlistItemClaimed))
+	case errors.Is(err, domain.ErrBookMetadataNotFound):
+		return ctx.Status(fiber.StatusNotFound).JSON(ErrorHandler(domain.ErrBookMetadataNotFound))
+	case errors.Is(err, domain.ErrMetadataUnavailable):
+		return ctx.Status(fiber.StatusBadGateway).JSON(ErrorHandler(domain.ErrMetadataUnavailable))
+	default:
+		logger.Sugar().Errorf("위시리스트 처리 중 오류가 발생했습니다: %v", err)
+		return ctx.Status(fiber.StatusInternalServerError).JSON(ErrorHandler(domain.ErrInternal))
+	}
+}
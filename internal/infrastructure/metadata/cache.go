@@ -0,0 +1,209 @@
+package metadata
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/cache"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/isbn"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/go-redis/redis/v8"
+)
+
+const (
+	cacheKeyPrefix      = "book_metadata:"
+	cacheISBNPrefix     = cacheKeyPrefix + "isbn:"
+	cacheQueryPrefix    = cacheKeyPrefix + "query:"
+	cacheLockPrefix     = cacheKeyPrefix + "lock:"
+	cacheLockTTL        = 10 * time.Second
+	cacheLockWait       = 3 * time.Second
+	cacheLockRetryDelay = 100 * time.Millisecond
+	cacheLockTokenBytes = 16
+)
+
+// CachedProvider 제공자 결과를 Redis에 저장하는 read-through 캐시입니다.
+// 결과가 있는 경우와 없는 경우의 TTL을 분리하며, 제공자 장애는 캐시하지 않습니다.
+// 같은 키에 대한 동시 요청은 SetNX 락으로 한 요청만 제공자를 호출하도록 하며, 락은 잡은 요청만 해제합니다.
+type CachedProvider struct {
+	provider    domain.BookMetadataProvider
+	redisClient *cache.RedisClient
+	ttl         time.Duration
+	negativeTTL time.Duration
+}
+
+type cacheEntry struct {
+	Found bool                   `json:"found"`
+	Items []*domain.BookMetadata `json:"items,omitempty"`
+}
+
+func NewCachedProvider(provider domain.BookMetadataProvider, redisClient *cache.RedisClient, ttl, negativeTTL time.Duration) *CachedProvider {
+	return &CachedProvider{
+		provider:    provider,
+		redisClient: redisClient,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+	}
+}
+
+func (c *CachedProvider) Name() string {
+	return c.provider.Name()
+}
+
+func (c *CachedProvider) SearchByISBN(ctx context.Context, isbn string) (*domain.BookMetadata, error) {
+	entry, err := c.load(ctx, isbnCacheKey(isbn), func() ([]*domain.BookMetadata, error) {
+		result, err := c.provider.SearchByISBN(ctx, isbn)
+		if err != nil {
+			return nil, err
+		}
+		return []*domain.BookMetadata{result}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry[0], nil
+}
+
+func (c *CachedProvider) Search(ctx context.Context, query string) ([]*domain.BookMetadata, error) {
+	return c.load(ctx, queryCacheKey(query), func() ([]*domain.BookMetadata, error) {
+		return c.provider.Search(ctx, query)
+	})
+}
+
+func (c *CachedProvider) PurgeISBN(isbn string) error {
+	return c.redisClient.Delete(isbnCacheKey(isbn))
+}
+
+func (c *CachedProvider) PurgeQuery(query string) error {
+	return c.redisClient.Delete(queryCacheKey(query))
+}
+
+func (c *CachedProvider) PurgeAll() (int64, error) {
+	return c.redisClient.DeleteByPattern(cacheKeyPrefix + "*")
+}
+
+func (c *CachedProvider) load(ctx context.Context, key string, fetch func() ([]*domain.BookMetadata, error)) ([]*domain.BookMetadata, error) {
+	if entry, ok := c.get(key); ok {
+		return entry.result()
+	}
+
+	// 락 값으로 요청마다 다른 토큰을 저장해, 조회가 TTL보다 오래 걸려도 다른 요청이 잡은 락은 지우지 않도록 합니다.
+	token, err := lockToken()
+	if err != nil {
+		return nil, err
+	}
+
+	lockKey := cacheLockPrefix + key
+	acquired, err := c.redisClient.SetNX(lockKey, token, cacheLockTTL)
+	if err != nil {
+		// Redis 장애 시에도 검색은 가능해야 하므로 캐시 없이 제공자를 호출합니다.
+		logger.Sugar().Warnf("도서 정보 캐시 락 획득 실패, 캐시 없이 조회합니다: %v", err)
+		return fetch()
+	}
+
+	if !acquired {
+		// 다른 요청이 같은 키를 조회 중이므로 결과가 캐시될 때까지 잠시 기다립니다.
+		deadline := time.NewTimer(cacheLockWait)
+		defer deadline.Stop()
+		ticker := time.NewTicker(cacheLockRetryDelay)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-deadline.C:
+				logger.Sugar().Warnf("도서 정보 캐시 대기 시간이 초과되어 직접 조회합니다. 키: %s", key)
+				return fetch()
+			case <-ticker.C:
+				if entry, ok := c.get(key); ok {
+					return entry.result()
+				}
+			}
+		}
+	}
+	defer func() {
+		if _, err := c.redisClient.DeleteIfEqual(lockKey, token); err != nil {
+			logger.Sugar().Warnf("도서 정보 캐시 락 해제 실패: %v", err)
+		}
+	}()
+
+	items, err := fetch()
+	switch {
+	case err == nil:
+		c.set(key, &cacheEntry{Found: true, Items: items}, c.ttl)
+	case errors.Is(err, domain.ErrBookMetadataNotFound):
+		c.set(key, &cacheEntry{Found: false}, c.negativeTTL)
+	}
+
+	return items, err
+}
+
+func (c *CachedProvider) get(key string) (*cacheEntry, bool) {
+	data, err := c.redisClient.Get(key)
+	if err != nil {
+		if !errors.Is(err, redis.Nil) {
+			logger.Sugar().Warnf("도서 정보 캐시 조회 실패: %v", err)
+		}
+		return nil, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal([]byte(data), &entry); err != nil {
+		logger.Sugar().Warnf("도서 정보 캐시 역직렬화 실패, 캐시를 무시합니다: %v", err)
+		return nil, false
+	}
+
+	return &entry, true
+}
+
+func (c *CachedProvider) set(key string, entry *cacheEntry, ttl time.Duration) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		logger.Sugar().Warnf("도서 정보 캐시 직렬화 실패: %v", err)
+		return
+	}
+
+	if err := c.redisClient.Set(key, string(data), ttl); err != nil {
+		logger.Sugar().Warnf("도서 정보 캐시 저장 실패: %v", err)
+	}
+}
+
+func (e *cacheEntry) result() ([]*domain.BookMetadata, error) {
+	if !e.Found || len(e.Items) == 0 {
+		return nil, domain.ErrBookMetadataNotFound
+	}
+	return e.Items, nil
+}
+
+func lockToken() (string, error) {
+	b := make([]byte, cacheLockTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("도서 정보 캐시 락 토큰 생성 실패: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ISBN은 ISBN-13으로 정규화한 형태를 키로 사용하여 ISBN-10과 하이픈 표기가 같은 항목을 공유하도록 합니다.
+func isbnCacheKey(raw string) string {
+	normalized, err := isbn.Normalize(raw)
+	if err != nil {
+		normalized = isbn.Clean(raw)
+	}
+	return cacheISBNPrefix + normalized
+}
+
+// 검색어는 대소문자와 연속 공백을 정규화한 뒤 해시하여 키 길이를 일정하게 유지합니다.
+func queryCacheKey(query string) string {
+	normalized := strings.ToLower(strings.Join(strings.Fields(query), " "))
+	sum := sha256.Sum256([]byte(normalized))
+	return cacheQueryPrefix + hex.EncodeToString(sum[:])
+}
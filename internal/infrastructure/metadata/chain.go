@@ -0,0 +1,106 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+)
+
+// ProviderChain 여러 제공자를 우선순위 순서대로 호출하는 대체(fallback) 체인입니다.
+// 결과가 없거나 오류가 발생하면 다음 제공자를 시도합니다.
+type ProviderChain struct {
+	providers []domain.BookMetadataProvider
+}
+
+func NewProviderChain(providers ...domain.BookMetadataProvider) *ProviderChain {
+	return &ProviderChain{providers: providers}
+}
+
+// NewProviderChainFromConfig 설정된 순서대로 제공자를 구성합니다.
+// 인증 정보가 없는 제공자는 건너뜁니다.
+func NewProviderChainFromConfig(cfg config.BookConfig) (*ProviderChain, error) {
+	client := &http.Client{Timeout: cfg.Timeout}
+
+	var providers []domain.BookMetadataProvider
+	for _, name := range cfg.Providers {
+		switch name {
+		case "naver":
+			if cfg.NaverClientID == "" || cfg.NaverClientSecret == "" {
+				logger.Sugar().Warn("네이버 API 인증 정보가 없어 네이버 도서 검색을 비활성화합니다.")
+				continue
+			}
+			providers = append(providers, NewNaverProvider(client, cfg.NaverClientID, cfg.NaverClientSecret))
+		case "kakao":
+			if cfg.KakaoRESTAPIKey == "" {
+				logger.Sugar().Warn("카카오 API 키가 없어 카카오 도서 검색을 비활성화합니다.")
+				continue
+			}
+			providers = append(providers, NewKakaoProvider(client, cfg.KakaoRESTAPIKey))
+		case "openlibrary":
+			providers = append(providers, NewOpenLibraryProvider(client))
+		default:
+			return nil, fmt.Errorf("지원하지 않는 도서 정보 제공자입니다: %s", name)
+		}
+	}
+
+	if len(providers) == 0 {
+		return nil, fmt.Errorf("사용 가능한 도서 정보 제공자가 없습니다")
+	}
+
+	return NewProviderChain(providers...), nil
+}
+
+func (c *ProviderChain) Name() string {
+	names := make([]string, 0, len(c.providers))
+	for _, p := range c.providers {
+		names = append(names, p.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+func (c *ProviderChain) SearchByISBN(ctx context.Context, isbn string) (*domain.BookMetadata, error) {
+	var lastErr error
+	for _, p := range c.providers {
+		result, err := p.SearchByISBN(ctx, isbn)
+		if err == nil {
+			return result, nil
+		}
+		if !errors.Is(err, domain.ErrBookMetadataNotFound) {
+			logger.Sugar().Warnf("%s 도서 정보 제공자 조회 실패, 다음 제공자를 시도합니다: %v", p.Name(), err)
+			lastErr = err
+		}
+	}
+
+	return nil, c.finalError(lastErr)
+}
+
+func (c *ProviderChain) Search(ctx context.Context, query string) ([]*domain.BookMetadata, error) {
+	var lastErr error
+	for _, p := range c.providers {
+		result, err := p.Search(ctx, query)
+		if err == nil && len(result) > 0 {
+			return result, nil
+		}
+		if err != nil && !errors.Is(err, domain.ErrBookMetadataNotFound) {
+			logger.Sugar().Warnf("%s 도서 정보 제공자 검색 실패, 다음 제공자를 시도합니다: %v", p.Name(), err)
+			lastErr = err
+		}
+	}
+
+	return nil, c.finalError(lastErr)
+}
+
+// 모든 제공자가 결과 없음으로 응답했다면 ErrBookMetadataNotFound를,
+// 하나라도 장애가 있었다면 결과를 확정할 수 없으므로 ErrMetadataUnavailable을 반환합니다.
+func (c *ProviderChain) finalError(lastErr error) error {
+	if lastErr != nil {
+		return fmt.Errorf("%w: %v", domain.ErrMetadataUnavailable, lastErr)
+	}
+	return domain.ErrBookMetadataNotFound
+}
@@ -0,0 +1,100 @@
+package metadata
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+)
+
+// FakeProvider 외부 API 호출 없이 메모리에 등록된 도서 정보만 반환하는 제공자입니다.
+// 테스트나 API 키가 없는 로컬 개발 환경에서 사용합니다.
+type FakeProvider struct {
+	mu    sync.RWMutex
+	name  string
+	books map[string]*domain.BookMetadata
+	err   error
+	calls int
+}
+
+func NewFakeProvider(name string, books ...*domain.BookMetadata) *FakeProvider {
+	p := &FakeProvider{
+		name:  name,
+		books: make(map[string]*domain.BookMetadata),
+	}
+	for _, b := range books {
+		p.Add(b)
+	}
+	return p
+}
+
+func (p *FakeProvider) Name() string {
+	return p.name
+}
+
+// Add ISBN을 키로 도서 정보를 등록합니다.
+func (p *FakeProvider) Add(book *domain.BookMetadata) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.books[book.ISBN] = book
+}
+
+// SetError 이후의 모든 검색이 지정한 오류를 반환하도록 설정합니다. nil이면 해제합니다.
+func (p *FakeProvider) SetError(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+// Calls 지금까지 검색이 호출된 횟수를 반환합니다.
+func (p *FakeProvider) Calls() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.calls
+}
+
+func (p *FakeProvider) SearchByISBN(ctx context.Context, isbn string) (*domain.BookMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	b, ok := p.books[isbn]
+	if !ok {
+		return nil, domain.ErrBookMetadataNotFound
+	}
+
+	result := *b
+	result.Source = p.name
+	return &result, nil
+}
+
+func (p *FakeProvider) Search(ctx context.Context, query string) ([]*domain.BookMetadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.calls++
+
+	if p.err != nil {
+		return nil, p.err
+	}
+
+	var result []*domain.BookMetadata
+	q := strings.ToLower(query)
+	for _, b := range p.books {
+		if b.ISBN == query || strings.Contains(strings.ToLower(b.Title), q) || strings.Contains(strings.ToLower(b.Author), q) {
+			m := *b
+			m.Source = p.name
+			result = append(result, &m)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil, domain.ErrBookMetadataNotFound
+	}
+
+	return result, nil
+}
@@ -0,0 +1,71 @@
+package metadata
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+var htmlTagRegex = regexp.MustCompile(`<[^>]*>`)
+
+// 외부 API에 GET 요청을 보내고 JSON 응답을 out에 디코딩합니다.
+func getJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("도서 검색 API 요청 생성 실패: %w", err)
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("도서 검색 API 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("도서 검색 API가 %d 상태 코드를 반환했습니다: %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("도서 검색 API 응답을 디코딩하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// 검색 결과에 포함된 HTML 강조 태그를 제거합니다.
+func stripTags(s string) string {
+	return strings.TrimSpace(htmlTagRegex.ReplaceAllString(s, ""))
+}
+
+// "8996991341 9788996991342"처럼 공백으로 구분된 ISBN 목록에서 ISBN-13을 우선 선택합니다.
+func pickISBN(raw string) string {
+	fields := strings.Fields(raw)
+	for _, f := range fields {
+		if len(f) == 13 {
+			return f
+		}
+	}
+	if len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
+
+// 검색 결과의 ISBN 목록에 찾는 ISBN이 포함되어 있는지 확인합니다.
+func containsISBN(raw, isbn string) bool {
+	for _, f := range strings.Fields(raw) {
+		if f == isbn {
+			return true
+		}
+	}
+	return false
+}
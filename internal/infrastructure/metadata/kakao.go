@@ -0,0 +1,114 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+)
+
+const kakaoSearchURL = "https://dapi.kakao.com/v3/search/book"
+
+type KakaoProvider struct {
+	client     *http.Client
+	restAPIKey string
+}
+
+type kakaoResponse struct {
+	Documents []kakaoDocument `json:"documents"`
+}
+
+type kakaoDocument struct {
+	Title     string   `json:"title"`
+	Contents  string   `json:"contents"`
+	URL       string   `json:"url"`
+	ISBN      string   `json:"isbn"`
+	Datetime  string   `json:"datetime"`
+	Authors   []string `json:"authors"`
+	Publisher string   `json:"publisher"`
+	Thumbnail string   `json:"thumbnail"`
+}
+
+func NewKakaoProvider(client *http.Client, restAPIKey string) *KakaoProvider {
+	return &KakaoProvider{
+		client:     client,
+		restAPIKey: restAPIKey,
+	}
+}
+
+func (p *KakaoProvider) Name() string {
+	return "kakao"
+}
+
+func (p *KakaoProvider) SearchByISBN(ctx context.Context, isbn string) (*domain.BookMetadata, error) {
+	docs, err := p.search(ctx, isbn, "isbn")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, doc := range docs {
+		if containsISBN(doc.ISBN, isbn) {
+			return p.toMetadata(doc), nil
+		}
+	}
+
+	return nil, domain.ErrBookMetadataNotFound
+}
+
+func (p *KakaoProvider) Search(ctx context.Context, query string) ([]*domain.BookMetadata, error) {
+	docs, err := p.search(ctx, query, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(docs) == 0 {
+		return nil, domain.ErrBookMetadataNotFound
+	}
+
+	result := make([]*domain.BookMetadata, 0, len(docs))
+	for _, doc := range docs {
+		result = append(result, p.toMetadata(doc))
+	}
+
+	return result, nil
+}
+
+func (p *KakaoProvider) search(ctx context.Context, query, target string) ([]kakaoDocument, error) {
+	searchURL := fmt.Sprintf("%s?query=%s&size=10", kakaoSearchURL, url.QueryEscape(query))
+	if target != "" {
+		searchURL += "&target=" + target
+	}
+
+	var res kakaoResponse
+	err := getJSON(ctx, p.client, searchURL, map[string]string{
+		"Authorization": "KakaoAK " + p.restAPIKey,
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("카카오 %w", err)
+	}
+
+	return res.Documents, nil
+}
+
+func (p *KakaoProvider) toMetadata(doc kakaoDocument) *domain.BookMetadata {
+	// datetime은 "2014-11-17T00:00:00.000+09:00" 형식이므로 날짜 부분만 YYYYMMDD로 맞춥니다.
+	publishedDate := doc.Datetime
+	if len(publishedDate) >= 10 {
+		publishedDate = strings.ReplaceAll(publishedDate[:10], "-", "")
+	}
+
+	return withSeries(&domain.BookMetadata{
+		ISBN:          pickISBN(doc.ISBN),
+		Title:         doc.Title,
+		Author:        strings.Join(doc.Authors, ", "),
+		Publisher:     doc.Publisher,
+		PublishedDate: publishedDate,
+		Description:   doc.Contents,
+		ThumbnailURL:  doc.Thumbnail,
+		Link:          doc.URL,
+		Source:        p.Name(),
+	})
+}
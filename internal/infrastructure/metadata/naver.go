@@ -0,0 +1,109 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+)
+
+const naverSearchURL = "https://openapi.naver.com/v1/search/book.json"
+
+type NaverProvider struct {
+	client       *http.Client
+	clientID     string
+	clientSecret string
+}
+
+type naverResponse struct {
+	Items []naverItem `json:"items"`
+}
+
+type naverItem struct {
+	Title       string `json:"title"`
+	Link        string `json:"link"`
+	Image       string `json:"image"`
+	Author      string `json:"author"`
+	Publisher   string `json:"publisher"`
+	PubDate     string `json:"pubdate"`
+	ISBN        string `json:"isbn"`
+	Description string `json:"description"`
+}
+
+func NewNaverProvider(client *http.Client, clientID, clientSecret string) *NaverProvider {
+	return &NaverProvider{
+		client:       client,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+	}
+}
+
+func (p *NaverProvider) Name() string {
+	return "naver"
+}
+
+func (p *NaverProvider) SearchByISBN(ctx context.Context, isbn string) (*domain.BookMetadata, error) {
+	items, err := p.search(ctx, isbn)
+	if err != nil {
+		return nil, err
+	}
+
+	// 네이버는 ISBN 검색 시에도 유사한 결과를 함께 반환하므로 ISBN이 일치하는 항목을 찾습니다.
+	for _, item := range items {
+		if containsISBN(item.ISBN, isbn) {
+			return p.toMetadata(item), nil
+		}
+	}
+
+	return nil, domain.ErrBookMetadataNotFound
+}
+
+func (p *NaverProvider) Search(ctx context.Context, query string) ([]*domain.BookMetadata, error) {
+	items, err := p.search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(items) == 0 {
+		return nil, domain.ErrBookMetadataNotFound
+	}
+
+	result := make([]*domain.BookMetadata, 0, len(items))
+	for _, item := range items {
+		result = append(result, p.toMetadata(item))
+	}
+
+	return result, nil
+}
+
+func (p *NaverProvider) search(ctx context.Context, query string) ([]naverItem, error) {
+	searchURL := fmt.Sprintf("%s?query=%s&display=10&start=1&sort=sim", naverSearchURL, url.QueryEscape(query))
+
+	var res naverResponse
+	err := getJSON(ctx, p.client, searchURL, map[string]string{
+		"X-Naver-Client-Id":     p.clientID,
+		"X-Naver-Client-Secret": p.clientSecret,
+	}, &res)
+	if err != nil {
+		return nil, fmt.Errorf("네이버 %w", err)
+	}
+
+	return res.Items, nil
+}
+
+func (p *NaverProvider) toMetadata(item naverItem) *domain.BookMetadata {
+	return withSeries(&domain.BookMetadata{
+		ISBN:          pickISBN(item.ISBN),
+		Title:         stripTags(item.Title),
+		Author:        strings.ReplaceAll(stripTags(item.Author), "^", ", "),
+		Publisher:     stripTags(item.Publisher),
+		PublishedDate: item.PubDate,
+		Description:   stripTags(item.Description),
+		ThumbnailURL:  item.Image,
+		Link:          item.Link,
+		Source:        p.Name(),
+	})
+}
@@ -0,0 +1,140 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+)
+
+const (
+	openLibraryBooksURL  = "https://openlibrary.org/api/books"
+	openLibrarySearchURL = "https://openlibrary.org/search.json"
+	openLibraryCoverURL  = "https://covers.openlibrary.org/b/id/%d-M.jpg"
+)
+
+// OpenLibraryProvider 별도의 인증 키 없이 사용할 수 있는 Open Library API 제공자입니다.
+// 국내 도서 정보가 부족하므로 주로 마지막 대체 제공자로 사용합니다.
+type OpenLibraryProvider struct {
+	client *http.Client
+}
+
+type openLibraryBook struct {
+	Title       string `json:"title"`
+	Subtitle    string `json:"subtitle"`
+	URL         string `json:"url"`
+	PublishDate string `json:"publish_date"`
+	Authors     []struct {
+		Name string `json:"name"`
+	} `json:"authors"`
+	Publishers []struct {
+		Name string `json:"name"`
+	} `json:"publishers"`
+	Cover struct {
+		Medium string `json:"medium"`
+	} `json:"cover"`
+}
+
+type openLibrarySearchResponse struct {
+	Docs []openLibraryDoc `json:"docs"`
+}
+
+type openLibraryDoc struct {
+	Key              string   `json:"key"`
+	Title            string   `json:"title"`
+	AuthorName       []string `json:"author_name"`
+	ISBN             []string `json:"isbn"`
+	Publisher        []string `json:"publisher"`
+	FirstPublishYear int      `json:"first_publish_year"`
+	CoverID          int      `json:"cover_i"`
+}
+
+func NewOpenLibraryProvider(client *http.Client) *OpenLibraryProvider {
+	return &OpenLibraryProvider{client: client}
+}
+
+func (p *OpenLibraryProvider) Name() string {
+	return "openlibrary"
+}
+
+func (p *OpenLibraryProvider) SearchByISBN(ctx context.Context, isbn string) (*domain.BookMetadata, error) {
+	bibKey := "ISBN:" + isbn
+	searchURL := fmt.Sprintf("%s?bibkeys=%s&format=json&jscmd=data", openLibraryBooksURL, url.QueryEscape(bibKey))
+
+	res := map[string]openLibraryBook{}
+	if err := getJSON(ctx, p.client, searchURL, nil, &res); err != nil {
+		return nil, fmt.Errorf("Open Library %w", err)
+	}
+
+	b, ok := res[bibKey]
+	if !ok {
+		return nil, domain.ErrBookMetadataNotFound
+	}
+
+	title := b.Title
+	if b.Subtitle != "" {
+		title = fmt.Sprintf("%s: %s", b.Title, b.Subtitle)
+	}
+
+	authors := make([]string, 0, len(b.Authors))
+	for _, a := range b.Authors {
+		authors = append(authors, a.Name)
+	}
+
+	publisher := ""
+	if len(b.Publishers) > 0 {
+		publisher = b.Publishers[0].Name
+	}
+
+	return withSeries(&domain.BookMetadata{
+		ISBN:          isbn,
+		Title:         title,
+		Author:        strings.Join(authors, ", "),
+		Publisher:     publisher,
+		PublishedDate: b.PublishDate,
+		ThumbnailURL:  b.Cover.Medium,
+		Link:          b.URL,
+		Source:        p.Name(),
+	}), nil
+}
+
+func (p *OpenLibraryProvider) Search(ctx context.Context, query string) ([]*domain.BookMetadata, error) {
+	searchURL := fmt.Sprintf("%s?q=%s&limit=10", openLibrarySearchURL, url.QueryEscape(query))
+
+	var res openLibrarySearchResponse
+	if err := getJSON(ctx, p.client, searchURL, nil, &res); err != nil {
+		return nil, fmt.Errorf("Open Library %w", err)
+	}
+
+	if len(res.Docs) == 0 {
+		return nil, domain.ErrBookMetadataNotFound
+	}
+
+	result := make([]*domain.BookMetadata, 0, len(res.Docs))
+	for _, doc := range res.Docs {
+		m := &domain.BookMetadata{
+			Title:  doc.Title,
+			Author: strings.Join(doc.AuthorName, ", "),
+			Link:   "https://openlibrary.org" + doc.Key,
+			Source: p.Name(),
+		}
+		if len(doc.ISBN) > 0 {
+			m.ISBN = pickISBN(strings.Join(doc.ISBN, " "))
+		}
+		if len(doc.Publisher) > 0 {
+			m.Publisher = doc.Publisher[0]
+		}
+		if doc.FirstPublishYear > 0 {
+			m.PublishedDate = fmt.Sprintf("%d", doc.FirstPublishYear)
+		}
+		if doc.CoverID > 0 {
+			m.ThumbnailURL = fmt.Sprintf(openLibraryCoverURL, doc.CoverID)
+		}
+		result = append(result, withSeries(m))
+	}
+
+	return result, nil
+}
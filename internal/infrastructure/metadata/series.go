@@ -0,0 +1,70 @@
+package metadata
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+)
+
+var (
+	// "원피스 105 (완결)", "슬램덩크 1 [신장재편판]"처럼 숫자가 없는 꾸밈 괄호
+	seriesSuffixRegex   = regexp.MustCompile(`\s*[\(\[][^\(\)\[\]0-9]*[\)\]]\s*$`)
+	seriesVolumeRegexes = []*regexp.Regexp{
+		// "진격의 거인 (34)", "나루토 [1권]"
+		regexp.MustCompile(`^(.+?)\s*[\(\[]\s*(?:제\s*)?(\d{1,3})\s*권?\s*[\)\]]$`),
+		// "Berserk Vol. 3", "Dune, Volume 2"
+		regexp.MustCompile(`(?i)^(.+?)[\s,:-]*\bvol(?:ume)?\.?\s*(\d{1,3})$`),
+		// "원피스 105", "해리 포터 제3권", "삼국지 2부"
+		regexp.MustCompile(`^(.+?)\s+(?:제\s*)?(\d{1,3})\s*(?:권|편|부)?$`),
+		// "미생3권"
+		regexp.MustCompile(`^(.+?)(\d{1,3})권$`),
+	}
+)
+
+// withSeries 도서 정보 제공자가 시리즈 정보를 주지 않은 경우 제목에서 시리즈 이름과 권 번호를 추정합니다.
+// 국내 만화와 장르 소설은 "원피스 105"처럼 제목 끝에 권 번호를 붙이는 경우가 대부분입니다.
+func withSeries(m *domain.BookMetadata) *domain.BookMetadata {
+	if m == nil || m.SeriesName != "" {
+		return m
+	}
+
+	if name, volume, ok := parseSeriesTitle(m.Title); ok {
+		m.SeriesName = name
+		m.SeriesVolume = volume
+	}
+	return m
+}
+
+func parseSeriesTitle(title string) (string, int, bool) {
+	title = strings.TrimSpace(title)
+	for {
+		stripped := seriesSuffixRegex.ReplaceAllString(title, "")
+		if stripped == title {
+			break
+		}
+		title = stripped
+	}
+
+	for _, re := range seriesVolumeRegexes {
+		match := re.FindStringSubmatch(title)
+		if match == nil {
+			continue
+		}
+
+		volume, err := strconv.Atoi(match[2])
+		if err != nil || volume < 1 {
+			return "", 0, false
+		}
+
+		name := strings.TrimRight(strings.TrimSpace(match[1]), " ,:-")
+		if strings.IndexFunc(name, unicode.IsLetter) < 0 {
+			return "", 0, false
+		}
+		return name, volume, true
+	}
+
+	return "", 0, false
+}
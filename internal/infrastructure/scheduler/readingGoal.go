@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+)
+
+// sendGoalPaceReminders 올해 독서 목표의 속도보다 뒤처진 사용자에게 남은 기간 동안 필요한 속도를 알립니다.
+// 같은 목표에는 config.ReadingGoalReminderInterval에 한 번만 보냅니다.
+func (rs *ReminderScheduler) sendGoalPaceReminders() {
+	ctx := context.Background()
+
+	if rs.fcmService == nil {
+		logger.Sugar().Warn("FCM service is not initialized, skipping reading goal pace reminder")
+		return
+	}
+
+	if rs.goalUseCase == nil {
+		logger.Sugar().Warn("Reading goal use case is not initialized, skipping reading goal pace reminder")
+		return
+	}
+
+	now := time.Now()
+	reminders, err := rs.goalUseCase.GetBehindPaceReminders(now)
+	if err != nil {
+		logger.Sugar().Errorf("Failed to get reading goals behind pace: %v", err)
+		return
+	}
+
+	successCount := 0
+	for _, r := range reminders {
+		goal := r.Progress.Goal
+		title := "나만의 서재"
+		body := goalPaceMessage(r.Progress)
+
+		err := rs.fcmService.SendPush(ctx, r.FCMToken, title, body)
+		if err != nil {
+			logger.Sugar().Errorf("Failed to send reading goal pace reminder for goal %s: %v", goal.ID.String(), err)
+			continue
+		}
+
+		if err := rs.goalUseCase.MarkReminderSent(goal.ID, now); err != nil {
+			logger.Sugar().Errorf("Failed to mark reading goal pace reminder as sent for goal %s: %v", goal.ID.String(), err)
+			continue
+		}
+		successCount++
+	}
+
+	logger.Sugar().Infof("Reading goal pace reminder sent for %d/%d goals", successCount, len(reminders))
+}
+
+func goalPaceMessage(p *domain.ReadingGoalProgress) string {
+	unit := "권"
+	if p.Goal.Type == domain.ReadingGoalPages {
+		unit = "쪽"
+	}
+
+	return fmt.Sprintf("%d년 목표 %d%s 중 %d%s을 읽었어요. 목표를 이루려면 일주일에 %.1f%s씩 읽어야 해요.",
+		p.Goal.Year, p.Goal.Target, unit, p.Current, unit, p.RequiredPace, unit)
+}
@@ -2,8 +2,10 @@ package scheduler
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/infrastructure/fcm"
 	"github.com/dev-hyunsang/my-own-library-backend/logger"
@@ -14,10 +16,12 @@ type ReminderScheduler struct {
 	scheduler    gocron.Scheduler
 	reminderRepo domain.ReadingReminderRepository
 	userRepo     domain.UserRepository
+	loanRepo     domain.LoanRepository
+	goalUseCase  domain.ReadingGoalUseCase
 	fcmService   *fcm.FCMService
 }
 
-func NewReminderScheduler(reminderRepo domain.ReadingReminderRepository, userRepo domain.UserRepository, fcmService *fcm.FCMService) (*ReminderScheduler, error) {
+func NewReminderScheduler(reminderRepo domain.ReadingReminderRepository, userRepo domain.UserRepository, loanRepo domain.LoanRepository, goalUseCase domain.ReadingGoalUseCase, fcmService *fcm.FCMService) (*ReminderScheduler, error) {
 	s, err := gocron.NewScheduler()
 	if err != nil {
 		return nil, err
@@ -27,6 +31,8 @@ func NewReminderScheduler(reminderRepo domain.ReadingReminderRepository, userRep
 		scheduler:    s,
 		reminderRepo: reminderRepo,
 		userRepo:     userRepo,
+		loanRepo:     loanRepo,
+		goalUseCase:  goalUseCase,
 		fcmService:   fcmService,
 	}, nil
 }
@@ -59,6 +65,24 @@ func (rs *ReminderScheduler) Start() error {
 		return err
 	}
 
+	// 대여 반납 예정 알림 (매시 정각)
+	_, err = rs.scheduler.NewJob(
+		gocron.CronJob("0 * * * *", false),
+		gocron.NewTask(rs.sendLoanDueReminders),
+	)
+	if err != nil {
+		return err
+	}
+
+	// 독서 목표 속도 알림 (매시 정각, 사용자 타임존으로 19시인 사용자에게 보냄)
+	_, err = rs.scheduler.NewJob(
+		gocron.CronJob("0 * * * *", false),
+		gocron.NewTask(rs.sendGoalPaceReminders),
+	)
+	if err != nil {
+		return err
+	}
+
 	rs.scheduler.Start()
 	logger.Sugar().Info("Reading reminder scheduler started (with daily 10:00, 20:00 notifications)")
 	return nil
@@ -163,3 +187,49 @@ func (rs *ReminderScheduler) sendDailyReadingReminder() {
 
 	logger.Sugar().Infof("Daily reading reminder sent to %d/%d users", successCount, len(users))
 }
+
+// sendLoanDueReminders 반납 예정일이 config.LoanReminderLead 안으로 다가온 대여를 빌려준 사용자에게 알립니다.
+// 대여마다 한 번만 보내며, 반납 예정일이 바뀌면 다시 보낼 수 있습니다.
+func (rs *ReminderScheduler) sendLoanDueReminders() {
+	ctx := context.Background()
+
+	if rs.fcmService == nil {
+		logger.Sugar().Warn("FCM service is not initialized, skipping loan due reminder")
+		return
+	}
+
+	if rs.loanRepo == nil {
+		logger.Sugar().Warn("Loan repository is not initialized, skipping loan due reminder")
+		return
+	}
+
+	now := time.Now()
+	reminders, err := rs.loanRepo.GetDueReminders(now.Add(config.LoanReminderLead))
+	if err != nil {
+		logger.Sugar().Errorf("Failed to get loans due soon: %v", err)
+		return
+	}
+
+	successCount := 0
+	for _, lr := range reminders {
+		title := "나만의 서재"
+		body := fmt.Sprintf("%s님에게 빌려준 「%s」의 반납 예정일이 다가왔어요.", lr.Loan.BorrowerName, lr.Loan.BookTitle)
+		if lr.Loan.IsOverdueAt(now) {
+			body = fmt.Sprintf("%s님에게 빌려준 「%s」의 반납 예정일이 지났어요.", lr.Loan.BorrowerName, lr.Loan.BookTitle)
+		}
+
+		err := rs.fcmService.SendPush(ctx, lr.FCMToken, title, body)
+		if err != nil {
+			logger.Sugar().Errorf("Failed to send loan due reminder for loan %s: %v", lr.Loan.ID.String(), err)
+			continue
+		}
+
+		if err := rs.loanRepo.MarkReminderSent(lr.Loan.ID, now); err != nil {
+			logger.Sugar().Errorf("Failed to mark loan due reminder as sent for loan %s: %v", lr.Loan.ID.String(), err)
+			continue
+		}
+		successCount++
+	}
+
+	logger.Sugar().Infof("Loan due reminder sent for %d/%d loans", successCount, len(reminders))
+}
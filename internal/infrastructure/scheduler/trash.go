@@ -0,0 +1,66 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/go-co-op/gocron/v2"
+)
+
+// TrashScheduler 보관 기간이 지난 휴지통의 책과 리뷰를 영구 삭제합니다.
+type TrashScheduler struct {
+	scheduler  gocron.Scheduler
+	bookRepo   domain.BookRepository
+	reviewRepo domain.ReviewRepository
+	retention  time.Duration
+}
+
+func NewTrashScheduler(bookRepo domain.BookRepository, reviewRepo domain.ReviewRepository, retention time.Duration) (*TrashScheduler, error) {
+	s, err := gocron.NewScheduler()
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrashScheduler{
+		scheduler:  s,
+		bookRepo:   bookRepo,
+		reviewRepo: reviewRepo,
+		retention:  retention,
+	}, nil
+}
+
+func (ts *TrashScheduler) Start() error {
+	// 휴지통 비우기 (매일 4시)
+	_, err := ts.scheduler.NewJob(
+		gocron.CronJob("0 4 * * *", false),
+		gocron.NewTask(ts.purgeExpired),
+	)
+	if err != nil {
+		return err
+	}
+
+	ts.scheduler.Start()
+	logger.Sugar().Infof("Trash purge scheduler started (retention %s)", ts.retention)
+	return nil
+}
+
+func (ts *TrashScheduler) Stop() error {
+	return ts.scheduler.Shutdown()
+}
+
+func (ts *TrashScheduler) purgeExpired() {
+	before := time.Now().Add(-ts.retention)
+
+	books, err := ts.bookRepo.PurgeDeletedBefore(before)
+	if err != nil {
+		logger.Sugar().Errorf("Failed to purge expired books from trash: %v", err)
+	}
+
+	reviews, err := ts.reviewRepo.PurgeDeletedBefore(before)
+	if err != nil {
+		logger.Sugar().Errorf("Failed to purge expired reviews from trash: %v", err)
+	}
+
+	logger.Sugar().Infof("Purged %d books and %d reviews deleted before %s", books, reviews, before.Format(time.RFC3339))
+}
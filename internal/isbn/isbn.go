@@ -0,0 +1,94 @@
+// Package isbn ISBN-10/ISBN-13 검증과 정규화를 제공합니다.
+// 저장되는 모든 ISBN은 하이픈과 공백이 없는 ISBN-13 형식으로 통일합니다.
+package isbn
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalid = errors.New("유효하지 않은 ISBN입니다.")
+
+// Clean 하이픈과 공백을 제거하고 ISBN-10 체크 문자 x를 대문자로 바꿉니다.
+func Clean(s string) string {
+	return strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(strings.TrimSpace(s)))
+}
+
+// Normalize ISBN을 검증하고 ISBN-13 형식으로 변환합니다.
+func Normalize(s string) (string, error) {
+	cleaned := Clean(s)
+
+	switch {
+	case IsValid13(cleaned):
+		return cleaned, nil
+	case IsValid10(cleaned):
+		return To13(cleaned), nil
+	default:
+		return "", ErrInvalid
+	}
+}
+
+// IsValid10 정리된 ISBN-10의 체크섬을 검증합니다. 마지막 자리는 X(10)일 수 있습니다.
+func IsValid10(s string) bool {
+	if len(s) != 10 {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 10; i++ {
+		c := s[i]
+		var v int
+		switch {
+		case c >= '0' && c <= '9':
+			v = int(c - '0')
+		case c == 'X' && i == 9:
+			v = 10
+		default:
+			return false
+		}
+		sum += v * (10 - i)
+	}
+
+	return sum%11 == 0
+}
+
+// IsValid13 정리된 ISBN-13의 체크섬을 검증합니다.
+func IsValid13(s string) bool {
+	if len(s) != 13 || !isDigits(s) {
+		return false
+	}
+
+	if !strings.HasPrefix(s, "978") && !strings.HasPrefix(s, "979") {
+		return false
+	}
+
+	return checkDigit13(s[:12]) == s[12]
+}
+
+// To13 유효한 ISBN-10을 978 접두사를 붙인 ISBN-13으로 변환합니다.
+func To13(isbn10 string) string {
+	body := "978" + isbn10[:9]
+	return body + string(checkDigit13(body))
+}
+
+func checkDigit13(body string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		v := int(body[i] - '0')
+		if i%2 == 1 {
+			v *= 3
+		}
+		sum += v
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
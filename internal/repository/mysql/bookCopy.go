@@ -0,0 +1,124 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// UpdateCopyDetails 책의 보관 위치, 상태, 구입 정보를 통째로 바꿉니다.
+func (bc *BookRepository) UpdateCopyDetails(id uuid.UUID, details *domain.BookCopyDetails, expected domain.ExpectedVersions) error {
+	ctx := context.Background()
+
+	update := bc.client.Book.UpdateOneID(id).
+		Where(bookVersionIn(expected)).
+		SetUpdatedAt(time.Now()).
+		AddVersion(1)
+	setCopyDetails(update.Mutation(), *details)
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return missedBookUpdate(ctx, bc.client, id, expected)
+		}
+		return fmt.Errorf("책의 사본 정보를 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// GetLocationSummary 위치와 통화별로 책 수와 가격 합계를 집계한 뒤 요청한 단위의 위치로 합칩니다.
+func (bc *BookRepository) GetLocationSummary(userID uuid.UUID, level domain.LocationSummaryLevel) ([]*domain.BookLocationSummary, error) {
+	var rows []struct {
+		LocationRoom     string   `json:"location_room"`
+		LocationBookcase string   `json:"location_bookcase"`
+		LocationShelf    string   `json:"location_shelf"`
+		Currency         string   `json:"currency"`
+		Count            int      `json:"count"`
+		Sum              *float64 `json:"sum"`
+	}
+
+	err := bc.client.Book.Query().
+		Where(book.HasOwnerWith(user.ID(userID)), book.DeletedAtIsNil()).
+		GroupBy(book.FieldLocationRoom, book.FieldLocationBookcase, book.FieldLocationShelf, book.FieldCurrency).
+		Aggregate(ent.Count(), ent.Sum(book.FieldPrice)).
+		Scan(context.Background(), &rows)
+	if err != nil {
+		return nil, fmt.Errorf("위치별 책 요약을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	summaries := make(map[domain.BookLocation]*domain.BookLocationSummary)
+	for _, r := range rows {
+		loc := domain.BookLocation{Room: r.LocationRoom}
+		if level != domain.LocationLevelRoom {
+			loc.Bookcase = r.LocationBookcase
+		}
+		if level == domain.LocationLevelShelf {
+			loc.Shelf = r.LocationShelf
+		}
+
+		summary, ok := summaries[loc]
+		if !ok {
+			summary = &domain.BookLocationSummary{BookLocation: loc, TotalValue: map[string]float64{}}
+			summaries[loc] = summary
+		}
+
+		summary.BookCount += r.Count
+		if r.Sum != nil && r.Currency != "" {
+			summary.TotalValue[r.Currency] += *r.Sum
+		}
+	}
+
+	result := make([]*domain.BookLocationSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i].BookLocation, result[j].BookLocation
+		if a.Room != b.Room {
+			return a.Room < b.Room
+		}
+		if a.Bookcase != b.Bookcase {
+			return a.Bookcase < b.Bookcase
+		}
+		return a.Shelf < b.Shelf
+	})
+
+	return result, nil
+}
+
+// setCopyDetails 책 생성/수정 mutation에 사본 정보를 반영합니다. 비어 있는 값은 지웁니다.
+func setCopyDetails(m *ent.BookMutation, d domain.BookCopyDetails) {
+	m.SetLocationRoom(d.Room)
+	m.SetLocationBookcase(d.Bookcase)
+	m.SetLocationShelf(d.Shelf)
+	m.SetCurrency(d.Currency)
+	m.SetAcquiredFrom(d.AcquiredFrom)
+
+	if d.Condition != "" {
+		m.SetCondition(book.Condition(d.Condition))
+	} else {
+		m.ClearCondition()
+	}
+	if d.Format != "" {
+		m.SetFormat(book.Format(d.Format))
+	} else {
+		m.ClearFormat()
+	}
+	if d.PurchasedAt != nil {
+		m.SetPurchasedAt(*d.PurchasedAt)
+	} else {
+		m.ClearPurchasedAt()
+	}
+	if d.Price != nil {
+		m.SetPrice(*d.Price)
+	} else {
+		m.ClearPrice()
+	}
+}
@@ -0,0 +1,50 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// GetBookAccess 책과 함께 사용자가 그 책에 가진 역할을 조회합니다.
+// 책 소유자는 owner, 책이 있는 공유 서재의 구성원은 서재에서의 역할을 가지며, 둘 다 아니면 domain.ErrNotFound를 반환합니다.
+func (bc *BookRepository) GetBookAccess(userID, id uuid.UUID) (*domain.Book, domain.LibraryRole, error) {
+	b, err := bc.client.Book.Query().
+		Where(
+			book.ID(id),
+			book.DeletedAtIsNil(),
+		).
+		WithOwner().
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
+		WithLibrary(func(q *ent.LibraryQuery) {
+			q.WithMembers(func(mq *ent.LibraryMemberQuery) {
+				mq.Where(librarymember.HasUserWith(user.ID(userID)))
+			})
+		}).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, "", domain.ErrNotFound
+		}
+		return nil, "", fmt.Errorf("책 정보를 가져오는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := BookConverter{}.ToDomainWithEdges(b)
+	if result.OwnerID == userID {
+		return result, domain.LibraryRoleOwner, nil
+	}
+
+	if l := b.Edges.Library; l != nil && len(l.Edges.Members) > 0 {
+		return result, domain.LibraryRole(l.Edges.Members[0].Role), nil
+	}
+
+	return nil, "", domain.ErrNotFound
+}
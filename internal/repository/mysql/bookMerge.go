@@ -0,0 +1,263 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// GetDuplicateBooks 사용자가 같은 ISBN으로 두 번 이상 등록한 책을 ISBN별로 묶어 반환합니다.
+func (bc *BookRepository) GetDuplicateBooks(userID uuid.UUID) ([]*domain.DuplicateBookGroup, error) {
+	books, err := bc.client.Book.Query().
+		Where(
+			book.HasOwnerWith(user.ID(userID)),
+			book.HasCatalogWith(bookcatalog.IsbnNotNil()),
+			book.DeletedAtIsNil(),
+		).
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
+		Order(ent.Asc(book.FieldCreatedAt), ent.Asc(book.FieldID)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("중복된 책을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	groups := make(map[string]*domain.DuplicateBookGroup)
+	var order []string
+	for _, b := range books {
+		isbn := catalogISBN(b.Edges.Catalog)
+		group, ok := groups[isbn]
+		if !ok {
+			group = &domain.DuplicateBookGroup{ISBN: isbn}
+			groups[isbn] = group
+			order = append(order, isbn)
+		}
+		group.Books = append(group.Books, BookConverter{}.ToDomain(b, userID))
+	}
+
+	result := make([]*domain.DuplicateBookGroup, 0)
+	for _, isbn := range order {
+		if len(groups[isbn].Books) > 1 {
+			result = append(result, groups[isbn])
+		}
+	}
+
+	return result, nil
+}
+
+// MergeBooks 여러 테이블을 함께 바꾸므로 하나의 트랜잭션에서 처리합니다. 중간에 실패하면 아무것도 바뀌지 않습니다.
+func (bc *BookRepository) MergeBooks(targetID uuid.UUID, sourceIDs []uuid.UUID) error {
+	ctx := context.Background()
+
+	tx, err := bc.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("책 병합 트랜잭션을 시작하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if err := mergeBooks(ctx, tx, targetID, sourceIDs); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w (롤백 실패: %v)", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("책 병합을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func mergeBooks(ctx context.Context, tx *ent.Tx, targetID uuid.UUID, sourceIDs []uuid.UUID) error {
+	fromSources := book.IDIn(sourceIDs...)
+	now := time.Now()
+
+	// 원본 책에서 진행 중이던 세션은 대상 책의 세션과 겹치지 않도록 읽은 페이지 없이 종료합니다.
+	active, err := tx.ReadingSession.Query().
+		Where(readingsession.HasBookWith(fromSources), readingsession.EndedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("진행 중인 독서 세션을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+	for _, s := range active {
+		if err := tx.ReadingSession.UpdateOne(s).
+			SetEndPage(s.StartPage).
+			SetPagesRead(0).
+			SetEndedAt(now).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("독서 세션을 종료하는 도중 오류가 발생했습니다: %w", err)
+		}
+	}
+
+	if _, err := tx.ReadingSession.Update().
+		Where(readingsession.HasBookWith(fromSources)).
+		SetBookID(targetID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("독서 세션을 옮기는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if _, err := tx.Review.Update().
+		Where(review.HasBookWith(fromSources)).
+		SetBookID(targetID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("리뷰를 옮기는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if _, err := tx.BookNote.Update().
+		Where(booknote.HasBookWith(fromSources)).
+		SetBookID(targetID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("메모를 옮기는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if _, err := tx.Loan.Update().
+		Where(loan.HasBookWith(fromSources)).
+		SetBookID(targetID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("대여 기록을 옮기는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if _, err := tx.BorrowRequest.Update().
+		Where(borrowrequest.HasBookWith(fromSources)).
+		SetBookID(targetID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("대여 요청을 옮기는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if _, err := tx.BookStatusHistory.Update().
+		Where(bookstatushistory.HasBookWith(fromSources)).
+		SetBookID(targetID).
+		Save(ctx); err != nil {
+		return fmt.Errorf("상태 기록을 옮기는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if err := mergeBookmarks(ctx, tx, targetID, fromSources); err != nil {
+		return err
+	}
+	if err := mergeTags(ctx, tx, targetID, sourceIDs); err != nil {
+		return err
+	}
+	if err := mergeShelves(ctx, tx, targetID, sourceIDs); err != nil {
+		return err
+	}
+
+	if _, err := tx.Book.Delete().Where(fromSources).Exec(ctx); err != nil {
+		return fmt.Errorf("병합한 책을 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return tx.Book.UpdateOneID(targetID).
+		SetUpdatedAt(now).
+		AddVersion(1).
+		Exec(ctx)
+}
+
+// 북마크는 책마다 하나면 충분하므로 대상 책에 없을 때 가장 오래된 북마크 하나만 옮깁니다.
+func mergeBookmarks(ctx context.Context, tx *ent.Tx, targetID uuid.UUID, fromSources predicate.Book) error {
+	exists, err := tx.Bookmark.Query().
+		Where(bookmark.HasBookWith(book.ID(targetID))).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("북마크를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if !exists {
+		first, err := tx.Bookmark.Query().
+			Where(bookmark.HasBookWith(fromSources)).
+			Order(ent.Asc(bookmark.FieldCreatedAt)).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("북마크를 조회하는 도중 오류가 발생했습니다: %w", err)
+		}
+		if first != nil {
+			if err := tx.Bookmark.UpdateOne(first).SetBookID(targetID).Exec(ctx); err != nil {
+				return fmt.Errorf("북마크를 옮기는 도중 오류가 발생했습니다: %w", err)
+			}
+		}
+	}
+
+	// 옮기지 않은 북마크는 원본 책과 함께 삭제됩니다.
+	return nil
+}
+
+func mergeTags(ctx context.Context, tx *ent.Tx, targetID uuid.UUID, sourceIDs []uuid.UUID) error {
+	current, err := tx.Tag.Query().
+		Where(tag.HasBooksWith(book.ID(targetID))).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("태그를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	missing, err := tx.Tag.Query().
+		Where(
+			tag.HasBooksWith(book.IDIn(sourceIDs...)),
+			tag.IDNotIn(current...),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("태그를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	if err := tx.Book.UpdateOneID(targetID).AddTagIDs(missing...).Exec(ctx); err != nil {
+		return fmt.Errorf("태그를 옮기는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// 원본 책이 꽂혀 있던 책장에 대상 책이 없으면 원본 책의 자리에 대상 책을 꽂습니다.
+func mergeShelves(ctx context.Context, tx *ent.Tx, targetID uuid.UUID, sourceIDs []uuid.UUID) error {
+	bookIDs := append([]uuid.UUID{targetID}, sourceIDs...)
+	rows, err := tx.ShelfBook.Query().
+		Where(shelfbook.BookIDIn(bookIDs...)).
+		Order(ent.Asc(shelfbook.FieldAddedAt)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("책장을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	onShelf := make(map[uuid.UUID]bool)
+	for _, r := range rows {
+		if r.BookID == targetID {
+			onShelf[r.ShelfID] = true
+		}
+	}
+
+	for _, r := range rows {
+		if r.BookID == targetID || onShelf[r.ShelfID] {
+			continue
+		}
+		onShelf[r.ShelfID] = true
+
+		if err := tx.ShelfBook.Create().
+			SetShelfID(r.ShelfID).
+			SetBookID(targetID).
+			SetPosition(r.Position).
+			SetAddedAt(r.AddedAt).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("책장에 책을 옮기는 도중 오류가 발생했습니다: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// 메모 검색은 최신순으로만 정렬하므로 커서의 정렬 기준이 고정됩니다.
+const noteSearchSort = "created_at"
+
+type BookNoteRepository struct {
+	client *ent.Client
+}
+
+func NewBookNoteRepository(client *ent.Client) *BookNoteRepository {
+	return &BookNoteRepository{
+		client: client,
+	}
+}
+
+func (r *BookNoteRepository) Create(userID, bookID uuid.UUID, note *domain.BookNote) (*domain.BookNote, error) {
+	created, err := r.client.BookNote.Create().
+		SetOwnerID(userID).
+		SetBookID(bookID).
+		SetQuote(note.Quote).
+		SetComment(note.Comment).
+		SetNillablePage(note.Page).
+		SetLocation(note.Location).
+		SetColor(string(note.Color)).
+		SetLabel(note.Label).
+		Save(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("메모를 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := BookNoteConverter{}.ToDomain(created)
+	result.OwnerID = userID
+	result.BookID = bookID
+	return result, nil
+}
+
+func (r *BookNoteRepository) GetByID(id uuid.UUID) (*domain.BookNote, error) {
+	n, err := r.client.BookNote.Query().
+		Where(booknote.ID(id)).
+		WithOwner().
+		WithBook(func(q *ent.BookQuery) {
+			q.WithCatalog()
+		}).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("메모를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return BookNoteConverter{}.ToDomain(n), nil
+}
+
+// GetByBookID 책의 메모를 페이지 순서로 조회합니다. 페이지가 없는 메모는 뒤에 작성 순서대로 옵니다.
+func (r *BookNoteRepository) GetByBookID(userID, bookID uuid.UUID) ([]*domain.BookNote, error) {
+	notes, err := r.client.BookNote.Query().
+		Where(
+			booknote.HasOwnerWith(user.ID(userID)),
+			booknote.HasBookWith(book.ID(bookID)),
+		).
+		WithOwner().
+		WithBook().
+		Order(ent.Asc(booknote.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("책의 메모 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := BookNoteConverter{}.ToDomainList(notes)
+	sort.SliceStable(result, func(i, j int) bool {
+		pi, pj := result[i].Page, result[j].Page
+		switch {
+		case pi == nil:
+			return false
+		case pj == nil:
+			return true
+		default:
+			return *pi < *pj
+		}
+	})
+
+	return result, nil
+}
+
+func (r *BookNoteRepository) Update(note *domain.BookNote) (*domain.BookNote, error) {
+	update := r.client.BookNote.UpdateOneID(note.ID).
+		SetQuote(note.Quote).
+		SetComment(note.Comment).
+		SetLocation(note.Location).
+		SetColor(string(note.Color)).
+		SetLabel(note.Label).
+		SetUpdatedAt(time.Now())
+	if note.Page != nil {
+		update.SetPage(*note.Page)
+	} else {
+		update.ClearPage()
+	}
+
+	updated, err := update.Save(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("메모를 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := BookNoteConverter{}.ToDomain(updated)
+	result.OwnerID = note.OwnerID
+	result.BookID = note.BookID
+	return result, nil
+}
+
+func (r *BookNoteRepository) Delete(id uuid.UUID) error {
+	err := r.client.BookNote.DeleteOneID(id).Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("메모를 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// Search 사용자의 메모 중 검색어의 모든 단어가 인용구, 메모, 위치, 라벨 또는 책 제목에 포함된 메모를 최신순으로 찾습니다.
+func (r *BookNoteRepository) Search(userID uuid.UUID, filter *domain.BookNoteSearchFilter) (*domain.BookNotePage, error) {
+	query := r.client.BookNote.Query().
+		Where(
+			booknote.HasOwnerWith(user.ID(userID)),
+			booknote.HasBookWith(book.DeletedAtIsNil()),
+		)
+
+	for _, word := range strings.Fields(filter.Query) {
+		query = query.Where(noteContains(word))
+	}
+
+	if filter.Cursor != "" {
+		c, err := decodeCursor(filter.Cursor, noteSearchSort, string(domain.SortDesc))
+		if err != nil {
+			return nil, err
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+
+		query = query.Where(booknote.Or(
+			booknote.CreatedAtLT(t),
+			booknote.And(booknote.CreatedAtEQ(t), booknote.IDLT(c.ID)),
+		))
+	}
+
+	// 다음 페이지 존재 여부를 확인하기 위해 한 개를 더 조회합니다.
+	notes, err := query.
+		Order(
+			booknote.ByCreatedAt(sql.OrderDesc()),
+			booknote.ByID(sql.OrderDesc()),
+		).
+		Limit(filter.Limit + 1).
+		WithOwner().
+		WithBook(func(q *ent.BookQuery) {
+			q.WithCatalog()
+		}).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("메모를 검색하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	page := &domain.BookNotePage{}
+	if len(notes) > filter.Limit {
+		notes = notes[:filter.Limit]
+		page.HasMore = true
+	}
+	page.Items = BookNoteConverter{}.ToDomainList(notes)
+
+	if page.HasMore {
+		last := notes[len(notes)-1]
+		page.NextCursor = encodeCursor(pageCursor{
+			Sort:  noteSearchSort,
+			Order: string(domain.SortDesc),
+			Value: last.CreatedAt.Format(time.RFC3339Nano),
+			ID:    last.ID,
+		})
+	}
+
+	return page, nil
+}
+
+func noteContains(word string) predicate.BookNote {
+	return booknote.Or(
+		booknote.QuoteContainsFold(word),
+		booknote.CommentContainsFold(word),
+		booknote.LocationContainsFold(word),
+		booknote.LabelContainsFold(word),
+		booknote.HasBookWith(bookTitleContains(word)),
+	)
+}
@@ -6,10 +6,16 @@ import (
 	"log"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
 	"github.com/dev-hyunsang/my-own-library-backend/logger"
 	"github.com/google/uuid"
@@ -45,34 +51,24 @@ func (rc *BookRepository) SaveByBookID(userID uuid.UUID, book *domain.Book) (*do
 		return nil, fmt.Errorf("새로운 UUID를 생성하던 도중 오류가 발생했습니다. %w", err)
 	}
 
-	b, err := client.Book.Create().
-		SetOwnerID(userID).
-		SetID(BookID).
-		SetBookTitle(book.Title).
-		SetAuthor(book.Author).
-		SetBookIsbn(book.BookISBN).
-		SetThumbnailURL(book.ThumbnailURL).
-		SetStatus(book.Status).
-		SetCreatedAt(time.Now()).
-		SetUpdatedAt(time.Now()).
-		Save(context.Background())
+	cat, err := resolveCatalog(context.Background(), client, book)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := rc.createBook(context.Background(), userID, BookID, cat, book)
 	if err == nil {
 		logger.UserInfoLog(userID.String(), "해당 유저의 새로운 책을 저장했습니다.")
-		return &domain.Book{
-			ID:           b.ID,
-			OwnerID:      b.QueryOwner().OnlyIDX(context.Background()),
-			Title:        b.BookTitle,
-			Author:       b.Author,
-			BookISBN:     b.BookIsbn,
-			ThumbnailURL: b.ThumbnailURL,
-			Status:       b.Status,
-			CreatedAt:    b.CreatedAt,
-			UpdatedAt:    b.UpdatedAt,
-		}, nil
+		b.Edges.Catalog = cat
+		return BookConverter{}.ToDomain(b, userID), nil
 	}
 
 	switch {
 	case ent.IsConstraintError(err):
+		// 같은 ISBN의 책이 동시에 등록된 경우 유니크 인덱스에서 걸러집니다.
+		if dup := liveDuplicateError(context.Background(), client, userID, cat.ID); dup != nil {
+			return nil, dup
+		}
 		logger.Sugar().Errorf("책을 저장하는 도중 제약 조건 오류가 발생했습니다: %w", err)
 		return nil, fmt.Errorf("책을 저장하는 도중 제약 조건 오류가 발생했습니다: %w", err)
 	default:
@@ -80,6 +76,49 @@ func (rc *BookRepository) SaveByBookID(userID uuid.UUID, book *domain.Book) (*do
 	}
 }
 
+// createBook 책과 첫 읽기 상태 변경 기록을 하나의 트랜잭션에서 저장합니다.
+func (rc *BookRepository) createBook(ctx context.Context, userID, id uuid.UUID, cat *ent.BookCatalog, b *domain.Book) (*ent.Book, error) {
+	tx, err := rc.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("책 저장 트랜잭션을 시작하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	create := tx.Book.Create().
+		SetOwnerID(userID).
+		SetID(id).
+		SetCatalog(cat).
+		SetReadingStatus(entReadingStatus(b.Status)).
+		SetNillableStartedAt(b.StartedAt).
+		SetNillableFinishedAt(b.FinishedAt).
+		SetLive(true).
+		SetCreatedAt(time.Now()).
+		SetUpdatedAt(time.Now())
+	setCopyDetails(create.Mutation(), b.BookCopyDetails)
+
+	// 다 읽은 날짜가 지정된 책(가져오기)은 첫 변경 기록도 그 날짜로 남깁니다.
+	changedAt := time.Now()
+	if b.Status == domain.BookStatusFinished && b.FinishedAt != nil {
+		changedAt = *b.FinishedAt
+	}
+
+	created, err := create.Save(ctx)
+	if err == nil {
+		err = recordStatusChange(ctx, tx.Client(), created.ID, nil, b.Status, changedAt)
+	}
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("%w (롤백 실패: %v)", err, rerr)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("책을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return created, nil
+}
+
 // 책 정보를 가져옵니다. UserID와 (Book)ID가 일치하여만 책 정보를 가져올 수 있습니다.
 func (rc *BookRepository) GetBookByID(userID, id uuid.UUID) (*domain.Book, error) {
 	client := rc.client
@@ -88,7 +127,11 @@ func (rc *BookRepository) GetBookByID(userID, id uuid.UUID) (*domain.Book, error
 		Query().
 		Where(
 			book.ID(id),
-			book.HasOwnerWith(user.ID(userID))). // UserID와 일치하는 조건을 찾습니다.
+			book.HasOwnerWith(user.ID(userID)), // UserID와 일치하는 조건을 찾습니다.
+			book.DeletedAtIsNil()).
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
 		Only(context.Background())
 
 	if err != nil {
@@ -100,51 +143,69 @@ func (rc *BookRepository) GetBookByID(userID, id uuid.UUID) (*domain.Book, error
 
 	logger.UserInfoLog(userID.String(), "해당 유저의 책 정보를 가지고 왔습니다.")
 
-	return &domain.Book{
-		ID:           result.ID,
-		OwnerID:      result.QueryOwner().OnlyIDX(context.Background()),
-		Title:        result.BookTitle,
-		Author:       result.Author,
-		BookISBN:     result.BookIsbn,
-		ThumbnailURL: result.ThumbnailURL,
-		Status:       result.Status,
-		CreatedAt:    result.CreatedAt,
-		UpdatedAt:    result.UpdatedAt,
-	}, nil
+	return BookConverter{}.ToDomain(result, userID), nil
 }
 
 // GetBookByISBN ISBN을 통해 사용자의 책을 조회합니다.
+// 중복 등록을 막기 전에 저장된 책이 있을 수 있으므로 여러 권이면 먼저 등록한 책을 반환합니다.
 func (rc *BookRepository) GetBookByISBN(userID uuid.UUID, isbn string) (*domain.Book, error) {
 	client := rc.client
 
 	result, err := client.Book.
 		Query().
 		Where(
-			book.BookIsbn(isbn),
+			book.HasCatalogWith(bookcatalog.Isbn(isbn)),
 			book.HasOwnerWith(user.ID(userID)),
+			book.DeletedAtIsNil(),
 		).
-		Only(context.Background())
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
+		Order(ent.Asc(book.FieldCreatedAt), ent.Asc(book.FieldID)).
+		First(context.Background())
 
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return nil, fmt.Errorf("해당 ISBN으로 등록된 책을 찾을 수 없습니다: %w", err)
+			return nil, domain.ErrNotFound
 		}
 		return nil, fmt.Errorf("책 정보를 가져오는 도중 오류가 발생했습니다: %w", err)
 	}
 
 	logger.UserInfoLog(userID.String(), fmt.Sprintf("ISBN을 통해 책 정보를 조회했습니다. ISBN: %s", isbn))
 
-	return &domain.Book{
-		ID:           result.ID,
-		OwnerID:      result.QueryOwner().OnlyIDX(context.Background()),
-		Title:        result.BookTitle,
-		Author:       result.Author,
-		BookISBN:     result.BookIsbn,
-		ThumbnailURL: result.ThumbnailURL,
-		Status:       result.Status,
-		CreatedAt:    result.CreatedAt,
-		UpdatedAt:    result.UpdatedAt,
-	}, nil
+	return BookConverter{}.ToDomain(result, userID), nil
+}
+
+// GetCatalogByISBN ISBN에 해당하는 공유 카탈로그 항목을 조회합니다.
+func (rc *BookRepository) GetCatalogByISBN(isbn string) (*domain.BookCatalog, error) {
+	cat, err := rc.client.BookCatalog.Query().
+		Where(bookcatalog.Isbn(isbn)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("카탈로그 항목을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return BookCatalogConverter{}.ToDomain(cat), nil
+}
+
+// liveDuplicateError 같은 카탈로그 항목을 가리키는 사용자의 책이 서재에 있으면 이를 가리키는 domain.DuplicateBookError를 반환합니다.
+// 유니크 인덱스 위반이 다른 원인이면 nil을 반환합니다.
+func liveDuplicateError(ctx context.Context, client *ent.Client, ownerID, catalogID uuid.UUID) error {
+	existingID, err := client.Book.Query().
+		Where(
+			book.HasOwnerWith(user.ID(ownerID)),
+			book.HasCatalogWith(bookcatalog.ID(catalogID)),
+			book.Live(true),
+		).
+		FirstID(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return &domain.DuplicateBookError{BookID: existingID}
 }
 
 // GetAnyBookByISBN ISBN으로 등록된 책을 조회합니다 (소유자 무관).
@@ -154,8 +215,9 @@ func (rc *BookRepository) GetAnyBookByISBN(isbn string) (*domain.Book, error) {
 
 	result, err := client.Book.
 		Query().
-		Where(book.BookIsbn(isbn)).
+		Where(book.HasCatalogWith(bookcatalog.Isbn(isbn)), book.DeletedAtIsNil()).
 		WithOwner().
+		WithCatalog().
 		First(context.Background())
 
 	if err != nil {
@@ -165,22 +227,28 @@ func (rc *BookRepository) GetAnyBookByISBN(isbn string) (*domain.Book, error) {
 		return nil, fmt.Errorf("책 정보를 가져오는 도중 오류가 발생했습니다: %w", err)
 	}
 
-	ownerID := uuid.Nil
-	if result.Edges.Owner != nil {
-		ownerID = result.Edges.Owner.ID
+	return BookConverter{}.ToDomainWithEdges(result), nil
+}
+
+// GetVisibleBookByID 다른 사람에게 보이는 책을 책 ID로 조회합니다 (소유자 무관).
+// 다른 사용자의 책에 대여 요청을 보낼 때 사용하며, 숨긴 책은 공개 프로필과 같이 찾을 수 없는 것으로 처리합니다.
+func (rc *BookRepository) GetVisibleBookByID(id uuid.UUID) (*domain.Book, error) {
+	result, err := rc.client.Book.
+		Query().
+		Where(book.ID(id), book.DeletedAtIsNil(), visibleBook()).
+		WithOwner().
+		WithCatalog().
+		WithLoans(withActiveLoans).
+		Only(context.Background())
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("책 정보를 가져오는 도중 오류가 발생했습니다: %w", err)
 	}
 
-	return &domain.Book{
-		ID:           result.ID,
-		OwnerID:      ownerID,
-		Title:        result.BookTitle,
-		Author:       result.Author,
-		BookISBN:     result.BookIsbn,
-		ThumbnailURL: result.ThumbnailURL,
-		Status:       result.Status,
-		CreatedAt:    result.CreatedAt,
-		UpdatedAt:    result.UpdatedAt,
-	}, nil
+	return BookConverter{}.ToDomainWithEdges(result), nil
 }
 
 // 유저가 소유한 책의 목록을 가져옵니다. / UserID와 일치하는 경우에만 책의 목록을 가져올 수 있습니다.
@@ -191,22 +259,13 @@ func (rc *BookRepository) GetBooksByUserID(userID uuid.UUID) ([]*domain.Book, er
 
 	books, err := client.Book.
 		Query().
-		Where(book.HasOwnerWith(user.ID(userID))).
+		Where(book.HasOwnerWith(user.ID(userID)), book.DeletedAtIsNil()).
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
 		All(context.Background())
 	if err == nil {
-		for _, b := range books {
-			result = append(result, &domain.Book{
-				ID:           b.ID,
-				OwnerID:      userID,
-				Title:        b.BookTitle,
-				Author:       b.Author,
-				BookISBN:     string(b.BookIsbn),
-				ThumbnailURL: b.ThumbnailURL,
-				Status:       b.Status,
-				CreatedAt:    b.CreatedAt,
-				UpdatedAt:    b.UpdatedAt,
-			})
-		}
+		result = append(result, BookConverter{}.ToDomainList(books, userID)...)
 
 		return result, nil
 	}
@@ -223,95 +282,478 @@ func (rc *BookRepository) GetBooksByUserID(userID uuid.UUID) ([]*domain.Book, er
 	}
 }
 
-// 사용자 이름을 통해 책의 목록을 가져옵니다.
-func (bc *BookRepository) GetBooksByUserName(name string) ([]*domain.Book, error) {
-	client := bc.client
+// ListBooksByUserID 사용자의 책 목록을 필터와 정렬 조건에 맞춰 커서 기반으로 가져옵니다.
+func (bc *BookRepository) ListBooksByUserID(userID uuid.UUID, filter *domain.BookListFilter) (*domain.BookPage, error) {
+	return bc.listBooks(filter, book.HasOwnerWith(user.ID(userID)))
+}
 
-	books, err := client.Book.
+func (bc *BookRepository) listBooks(filter *domain.BookListFilter, base ...predicate.Book) (*domain.BookPage, error) {
+	query := bc.client.Book.
 		Query().
-		Where(book.HasOwnerWith(user.NickName(name))).
-		Where(book.HasOwnerWith(user.IsPublished(true))).
-		WithOwner(). // Owner 관계를 명시적으로 로드
+		Where(base...).
+		Where(book.DeletedAtIsNil()).
+		Where(bookFilterPredicates(filter)...)
+
+	if filter.Cursor != "" {
+		c, err := decodeCursor(filter.Cursor, string(filter.SortBy), string(filter.Order))
+		if err != nil {
+			return nil, err
+		}
+
+		after, err := bookCursorPredicate(filter, c)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(after)
+	}
+
+	// 다음 페이지 존재 여부를 확인하기 위해 한 개를 더 조회합니다.
+	books, err := query.
+		Order(bookOrder(filter)...).
+		Limit(filter.Limit + 1).
+		WithOwner().
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
 		All(context.Background())
 	if err != nil {
-		switch {
-		case ent.IsNotFound(err):
-			return nil, fmt.Errorf("등록된 책을 찾을 수 없습니다: %w", err)
-		case ent.IsNotLoaded(err):
-			return nil, fmt.Errorf("책의 목록을 불러오는 도중 데이터가 로드되지 않았습니다: %w", err)
-		case ent.IsConstraintError(err):
-			return nil, fmt.Errorf("책의 목록을 가져오는 도중 제약 조건 오류가 발생했습니다: %w", err)
-		default:
-			return nil, fmt.Errorf("책의 목록을 가져오는 도중 알 수 없는 오류가 발생했습니다: %w", err)
-		}
+		return nil, fmt.Errorf("책의 목록을 가져오는 도중 오류가 발생했습니다: %w", err)
 	}
 
-	logger.Sugar().Infof("유저 닉네임을 통해 유저의 저장된 책 목록들을 가져왔습니다: %s", name)
-	result := make([]*domain.Book, 0, len(books))
+	page := &domain.BookPage{Items: make([]*domain.Book, 0, len(books))}
+	if len(books) > filter.Limit {
+		books = books[:filter.Limit]
+		page.HasMore = true
+	}
+
+	converter := BookConverter{}
 	for _, b := range books {
-		// Owner가 로드되었는지 확인
-		if b.Edges.Owner == nil {
-			logger.Sugar().Errorf("책 ID %s의 Owner가 로드되지 않았습니다", b.ID.String())
-			continue
-		}
+		page.Items = append(page.Items, converter.ToDomainWithEdges(b))
+	}
 
-		result = append(result, &domain.Book{
-			ID:           b.ID,
-			OwnerID:      b.Edges.Owner.ID,
-			Title:        b.BookTitle,
-			Author:       b.Author,
-			BookISBN:     b.BookIsbn,
-			CreatedAt:    b.CreatedAt,
-			UpdatedAt:    b.UpdatedAt,
-			ThumbnailURL: b.ThumbnailURL,
-			Status:       b.Status,
+	if page.HasMore {
+		last := books[len(books)-1]
+		page.NextCursor = encodeCursor(pageCursor{
+			Sort:  string(filter.SortBy),
+			Order: string(filter.Order),
+			Value: bookSortValue(last, filter.SortBy),
+			ID:    last.ID,
 		})
 	}
 
-	return result, nil
+	return page, nil
 }
 
-func (bc *BookRepository) Edit(id uuid.UUID, book *domain.Book) error {
-	client := bc.client
+// (정렬 컬럼, ID) 순서의 정렬 조건을 만듭니다.
+// 제목과 저자는 화면에 보이는 값과 순서가 같도록 사본의 override가 있으면 그 값으로, 없으면 카탈로그 항목의 값으로 정렬합니다.
+func bookOrder(filter *domain.BookListFilter) []book.OrderOption {
+	direction := sql.OrderAsc()
+	if filter.Order == domain.SortDesc {
+		direction = sql.OrderDesc()
+	}
+
+	switch filter.SortBy {
+	case domain.BookSortByTitle, domain.BookSortByAuthor:
+		override, field := bookDisplayFields(filter.SortBy)
+		return []book.OrderOption{
+			func(s *sql.Selector) {
+				column := bookDisplayColumn(s, override, field)
+				if filter.Order == domain.SortDesc {
+					column = sql.Desc(column)
+				}
+				s.OrderBy(column)
+			},
+			book.ByID(direction),
+		}
+	default:
+		return []book.OrderOption{book.ByCreatedAt(direction), book.ByID(direction)}
+	}
+}
+
+func bookSortValue(b *ent.Book, sortBy domain.BookSortField) string {
+	switch sortBy {
+	case domain.BookSortByTitle:
+		return bookTitle(b)
+	case domain.BookSortByAuthor:
+		return bookAuthor(b)
+	default:
+		return b.CreatedAt.Format(time.RFC3339Nano)
+	}
+}
+
+// 정렬 기준이 제목이면 제목, 저자면 저자의 (override 필드, 카탈로그 필드)를 반환합니다.
+func bookDisplayFields(sortBy domain.BookSortField) (string, string) {
+	if sortBy == domain.BookSortByAuthor {
+		return book.FieldAuthorOverride, bookcatalog.FieldAuthor
+	}
+	return book.FieldTitleOverride, bookcatalog.FieldTitle
+}
+
+// 커서 이후의 행만 조회하도록 (정렬 컬럼, ID) 기준의 키셋 조건을 만듭니다.
+// 제목과 저자는 정렬과 같은 COALESCE(override, 카탈로그 값) 식으로 비교합니다.
+func bookCursorPredicate(filter *domain.BookListFilter, c *pageCursor) (predicate.Book, error) {
+	var compare func(op func(string, any) *sql.Predicate) predicate.Book
+
+	switch filter.SortBy {
+	case domain.BookSortByTitle, domain.BookSortByAuthor:
+		override, field := bookDisplayFields(filter.SortBy)
+		compare = func(op func(string, any) *sql.Predicate) predicate.Book {
+			return func(s *sql.Selector) {
+				s.Where(op(bookDisplayColumn(s, override, field), c.Value))
+			}
+		}
+	default:
+		t, err := time.Parse(time.RFC3339Nano, c.Value)
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		compare = func(op func(string, any) *sql.Predicate) predicate.Book {
+			return func(s *sql.Selector) {
+				s.Where(op(s.C(book.FieldCreatedAt), t))
+			}
+		}
+	}
+
+	if filter.Order == domain.SortDesc {
+		return book.Or(
+			compare(sql.LT),
+			book.And(compare(sql.EQ), book.IDLT(c.ID)),
+		), nil
+	}
+
+	return book.Or(
+		compare(sql.GT),
+		book.And(compare(sql.EQ), book.IDGT(c.ID)),
+	), nil
+}
+
+// 책 목록에서 대여 중 여부를 표시하기 위해 반납되지 않은 대여만 불러옵니다.
+func withActiveLoans(q *ent.LoanQuery) {
+	q.Where(loan.ReturnedAtIsNil())
+}
+
+func bookFilterPredicates(filter *domain.BookListFilter) []predicate.Book {
+	var predicates []predicate.Book
+
+	if filter.Status != nil {
+		predicates = append(predicates, book.ReadingStatusEQ(entReadingStatus(*filter.Status)))
+	}
+	if filter.ShelfID != nil {
+		predicates = append(predicates, book.HasShelvesWith(shelf.ID(*filter.ShelfID)))
+	}
+	if filter.Lent != nil {
+		if *filter.Lent {
+			predicates = append(predicates, book.HasLoansWith(loan.ReturnedAtIsNil()))
+		} else {
+			predicates = append(predicates, book.Not(book.HasLoansWith(loan.ReturnedAtIsNil())))
+		}
+	}
+	if filter.Location.Room != "" {
+		predicates = append(predicates, book.LocationRoom(filter.Location.Room))
+	}
+	if filter.Location.Bookcase != "" {
+		predicates = append(predicates, book.LocationBookcase(filter.Location.Bookcase))
+	}
+	if filter.Location.Shelf != "" {
+		predicates = append(predicates, book.LocationShelf(filter.Location.Shelf))
+	}
+	if len(filter.Tags) > 0 {
+		predicates = append(predicates, bookTagPredicate(filter.Tags, filter.TagMatch)...)
+	}
+	if filter.Author != "" {
+		predicates = append(predicates, bookAuthorContains(filter.Author))
+	}
+	if filter.ISBNPrefix != "" {
+		predicates = append(predicates, book.HasCatalogWith(bookcatalog.IsbnHasPrefix(filter.ISBNPrefix)))
+	}
+	if filter.CreatedAfter != nil {
+		predicates = append(predicates, book.CreatedAtGTE(*filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		predicates = append(predicates, book.CreatedAtLT(*filter.CreatedBefore))
+	}
+	if filter.UpdatedAfter != nil {
+		predicates = append(predicates, book.UpdatedAtGTE(*filter.UpdatedAfter))
+	}
+	if filter.UpdatedBefore != nil {
+		predicates = append(predicates, book.UpdatedAtLT(*filter.UpdatedBefore))
+	}
 
-	_, err := client.Book.UpdateOneID(id).
-		SetBookTitle(book.Title).
-		SetAuthor(book.Author).
-		SetBookIsbn(book.BookISBN).
-		SetThumbnailURL(book.ThumbnailURL).
-		SetStatus(book.Status).
+	return predicates
+}
+
+// Edit 책의 읽기 상태와 서지 정보를 수정합니다.
+// ISBN이 바뀌면 해당 ISBN의 카탈로그 항목을 가리키도록 바꿉니다.
+// 같은 항목이면 개인 항목은 그 자리에서 수정하고, 공유 항목은 고친 값을 사본의 override 필드에 저장합니다.
+// 카탈로그 항목과 책을 함께 바꾸므로 하나의 트랜잭션에서 처리하며, 기대 버전과 다르면 아무것도 바꾸지 않습니다.
+func (bc *BookRepository) Edit(id uuid.UUID, b *domain.Book, expected domain.ExpectedVersions) error {
+	ctx := context.Background()
+
+	tx, err := bc.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("책 수정 트랜잭션을 시작하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if err := editBook(ctx, tx.Client(), id, b, expected); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w (롤백 실패: %v)", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("책 수정을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func editBook(ctx context.Context, client *ent.Client, id uuid.UUID, b *domain.Book, expected domain.ExpectedVersions) error {
+	current, err := client.Book.Query().
+		Where(book.ID(id), book.DeletedAtIsNil()).
+		WithCatalog().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return fmt.Errorf("등록된 책을 찾을 수 없습니다: %w", err)
+		}
+		return fmt.Errorf("책을 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+	if !expected.Matches(current.Version) {
+		return domain.ErrPreconditionFailed
+	}
+
+	previous := current.Edges.Catalog
+	// 읽기 상태 변경 기록의 이전 상태가 실제로 바뀐 상태와 같도록, 읽은 뒤 상태가 바뀌었으면 수정하지 않습니다.
+	update := setReadingStatus(client.Book.UpdateOneID(id), b).
+		Where(bookVersionIn(expected), book.ReadingStatusEQ(current.ReadingStatus))
+
+	var cat *ent.BookCatalog
+	switch {
+	case previous == nil || catalogISBN(previous) != b.BookISBN:
+		cat, err = switchCatalog(ctx, client, b)
+		clearCatalogOverrides(update.Mutation())
+	case previous.Isbn == nil:
+		cat, err = updatePrivateCatalog(ctx, previous, b)
+	default:
+		// 공유 카탈로그 항목은 다른 사용자의 책에도 보이므로 고친 서지 정보는 이 사본에만 저장합니다.
+		cat = previous
+		setCatalogOverrides(update.Mutation(), cat, b)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = update.
+		SetCatalog(cat).
 		SetUpdatedAt(time.Now()).
-		Save(context.Background())
+		AddVersion(1).
+		Save(ctx)
 	if err == nil {
+		if previous != nil && previous.ID != cat.ID {
+			deleteOrphanPrivateCatalog(ctx, client, previous)
+		}
+		if string(current.ReadingStatus) != string(b.Status) {
+			from := domain.BookStatus(current.ReadingStatus)
+			return recordStatusChange(ctx, client, id, &from, b.Status, time.Now())
+		}
 		return nil
 	}
 
 	switch {
 	case ent.IsNotFound(err):
+		if missed := changedOrMissingBook(ctx, client, id); missed == domain.ErrPreconditionFailed {
+			return missed
+		}
 		return fmt.Errorf("등록된 책을 찾을 수 없습니다: %w", err)
 	case ent.IsConstraintError(err):
+		if dup := liveDuplicateError(ctx, client, b.OwnerID, cat.ID); dup != nil {
+			return dup
+		}
 		return fmt.Errorf("책을 수정하는 도중 제약 조건 오류가 발생했습니다: %w", err)
 	default:
 		return fmt.Errorf("책을 수정하는 도중 오류가 발생했습니다: %w", err)
 	}
 }
 
-func (bc *BookRepository) DeleteByID(userID, id uuid.UUID) error {
-	client := bc.client
+// bookVersionIn If-Match로 받은 기대 버전을 수정 조건으로 바꿉니다. 기대 버전이 없으면 버전을 검사하지 않습니다.
+func bookVersionIn(expected domain.ExpectedVersions) predicate.Book {
+	if expected == nil {
+		return func(*sql.Selector) {}
+	}
+	return book.VersionIn(expected...)
+}
 
-	err := client.Book.DeleteOneID(id).Exec(context.Background())
-	if err == nil {
+// missedBookUpdate 조건부 수정에서 바뀐 행이 없을 때 책이 없는지, 그사이 다른 곳에서 수정되었는지 구분합니다.
+func missedBookUpdate(ctx context.Context, client *ent.Client, id uuid.UUID, expected domain.ExpectedVersions) error {
+	if expected == nil {
+		return domain.ErrNotFound
+	}
+
+	return changedOrMissingBook(ctx, client, id)
+}
+
+// changedOrMissingBook 항상 조건을 거는 수정에서 바뀐 행이 없을 때, 책이 있으면 그사이 다른 곳에서 수정된 것으로 봅니다.
+func changedOrMissingBook(ctx context.Context, client *ent.Client, id uuid.UUID) error {
+	exists, err := client.Book.Query().
+		Where(book.ID(id), book.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("책 정보를 가져오는 도중 오류가 발생했습니다: %w", err)
+	}
+	if exists {
+		return domain.ErrPreconditionFailed
+	}
+
+	return domain.ErrNotFound
+}
+
+// UpdateProgress 책의 현재 페이지와 전체 페이지 수를 저장합니다.
+func (bc *BookRepository) UpdateProgress(id uuid.UUID, currentPage, totalPages int) error {
+	err := bc.client.Book.UpdateOneID(id).
+		SetCurrentPage(currentPage).
+		SetTotalPages(totalPages).
+		SetUpdatedAt(time.Now()).
+		AddVersion(1).
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("독서 진행 상황을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateStatus 책의 읽기 상태와 시작/완료 시간을 저장하고, 상태가 바뀌었으면 변경 기록을 남깁니다.
+// 책과 변경 기록을 하나의 트랜잭션에서 저장하며, 그사이 다른 곳에서 읽기 상태가 바뀌었으면 domain.ErrPreconditionFailed를 반환합니다.
+func (bc *BookRepository) UpdateStatus(id uuid.UUID, b *domain.Book, expected domain.ExpectedVersions) error {
+	ctx := context.Background()
+
+	tx, err := bc.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("읽기 상태 변경 트랜잭션을 시작하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if err := updateStatus(ctx, tx.Client(), id, b, expected); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w (롤백 실패: %v)", err, rerr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("읽기 상태를 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func updateStatus(ctx context.Context, client *ent.Client, id uuid.UUID, b *domain.Book, expected domain.ExpectedVersions) error {
+	current, err := client.Book.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("책 정보를 가져오는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	err = setReadingStatus(client.Book.UpdateOneID(id), b).
+		Where(bookVersionIn(expected), book.ReadingStatusEQ(current.ReadingStatus)).
+		SetUpdatedAt(time.Now()).
+		AddVersion(1).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return changedOrMissingBook(ctx, client, id)
+		}
+		return fmt.Errorf("읽기 상태를 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	if string(current.ReadingStatus) == string(b.Status) {
 		return nil
 	}
 
-	switch {
-	case ent.IsNotFound(err):
-		return fmt.Errorf("등록된 책을 찾을 수 없습니다: %w", err)
-	case ent.IsConstraintError(err):
-		return fmt.Errorf("책을 삭제하는 도중 제약 조건 오류가 발생했습니다: %w", err)
-	default:
+	from := domain.BookStatus(current.ReadingStatus)
+	return recordStatusChange(ctx, client, id, &from, b.Status, time.Now())
+}
+
+// GetStatusHistory 책의 읽기 상태 변경 기록을 오래된 순서로 가져옵니다.
+func (bc *BookRepository) GetStatusHistory(bookID uuid.UUID) ([]*domain.BookStatusHistory, error) {
+	histories, err := bc.client.BookStatusHistory.Query().
+		Where(bookstatushistory.HasBookWith(book.ID(bookID))).
+		Order(ent.Asc(bookstatushistory.FieldChangedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("읽기 상태 변경 기록을 가져오는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return BookStatusHistoryConverter{}.ToDomainList(histories, bookID), nil
+}
+
+// 읽기 상태와 시작/완료 시간을 설정합니다. 시간이 nil이면 저장된 값을 지웁니다.
+func setReadingStatus(u *ent.BookUpdateOne, b *domain.Book) *ent.BookUpdateOne {
+	u.SetReadingStatus(entReadingStatus(b.Status))
+
+	if b.StartedAt != nil {
+		u.SetStartedAt(*b.StartedAt)
+	} else {
+		u.ClearStartedAt()
+	}
+
+	if b.FinishedAt != nil {
+		u.SetFinishedAt(*b.FinishedAt)
+	} else {
+		u.ClearFinishedAt()
+	}
+
+	return u
+}
+
+func entReadingStatus(s domain.BookStatus) book.ReadingStatus {
+	return book.ReadingStatus(s)
+}
+
+func recordStatusChange(ctx context.Context, client *ent.Client, bookID uuid.UUID, from *domain.BookStatus, to domain.BookStatus, changedAt time.Time) error {
+	create := client.BookStatusHistory.Create().
+		SetBookID(bookID).
+		SetToStatus(bookstatushistory.ToStatus(to)).
+		SetChangedAt(changedAt)
+	if from != nil {
+		create.SetFromStatus(bookstatushistory.FromStatus(*from))
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("읽기 상태 변경 기록을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteByID 책을 휴지통으로 옮깁니다. 리뷰, 북마크 등 연결된 기록은 복원할 수 있도록 그대로 둡니다.
+func (bc *BookRepository) DeleteByID(userID, id uuid.UUID, expected domain.ExpectedVersions) error {
+	ctx := context.Background()
+
+	n, err := bc.client.Book.Update().
+		Where(
+			book.ID(id),
+			book.HasOwnerWith(user.ID(userID)),
+			book.DeletedAtIsNil(),
+			bookVersionIn(expected),
+		).
+		SetDeletedAt(time.Now()).
+		ClearLive().
+		AddVersion(1).
+		Save(ctx)
+	if err != nil {
 		return fmt.Errorf("책을 삭제하는 도중 오류가 발생했습니다: %w", err)
 	}
+	if n == 0 {
+		return missedBookUpdate(ctx, bc.client, id, expected)
+	}
+
+	return nil
 }
 
 func (bc *BookRepository) AddBookmarkByBookID(ownerID, bookID uuid.UUID) (*domain.Bookmark, error) {
@@ -340,7 +782,10 @@ func (bc *BookRepository) GetBookmarksByUserID(userID uuid.UUID) ([]*domain.Book
 	client := bc.client
 
 	bookmarks, err := client.Bookmark.Query().
-		Where(bookmark.HasOwnerWith(user.ID(userID))).
+		Where(
+			bookmark.HasOwnerWith(user.ID(userID)),
+			bookmark.HasBookWith(book.DeletedAtIsNil()),
+		).
 		All(context.Background())
 	if err != nil {
 		return nil, fmt.Errorf("북마크 목록을 가져오는 도중 오류가 발생했습니다: %w", err)
@@ -0,0 +1,85 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// UpdateSeries 책이 가리키는 카탈로그 항목의 시리즈 정보를 통째로 바꿉니다.
+// 시리즈 이름이 비어 있으면 시리즈 정보를 모두 지웁니다.
+func (bc *BookRepository) UpdateSeries(id uuid.UUID, series *domain.BookSeries, expected domain.ExpectedVersions) error {
+	ctx := context.Background()
+
+	// 시리즈 정보는 카탈로그에 있지만 책 응답에 포함되므로 책의 버전도 올립니다.
+	// 기대 버전과 다르면 카탈로그 항목을 바꾸기 전에 멈추도록 먼저 올립니다.
+	if err := bc.client.Book.UpdateOneID(id).
+		Where(bookVersionIn(expected)).
+		AddVersion(1).
+		Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return missedBookUpdate(ctx, bc.client, id, expected)
+		}
+		return fmt.Errorf("책의 버전을 갱신하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	cat, err := bc.client.Book.Query().
+		Where(book.ID(id)).
+		QueryCatalog().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("책의 카탈로그 항목을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	update := cat.Update()
+	setCatalogSeries(update.Mutation(), *series)
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("책의 시리즈 정보를 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// GetSeriesBooks 사용자의 책 중 시리즈에 속한 책만 반환합니다.
+func (bc *BookRepository) GetSeriesBooks(userID uuid.UUID) ([]*domain.Book, error) {
+	books, err := bc.client.Book.Query().
+		Where(
+			book.HasOwnerWith(user.ID(userID)),
+			book.HasCatalogWith(bookcatalog.SeriesNameNEQ("")),
+			book.DeletedAtIsNil(),
+		).
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("시리즈에 속한 책을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return BookConverter{}.ToDomainList(books, userID), nil
+}
+
+// setCatalogSeries 카탈로그 항목 생성/수정 시 시리즈 정보를 채웁니다.
+func setCatalogSeries(m *ent.BookCatalogMutation, s domain.BookSeries) {
+	m.SetSeriesName(s.SeriesName)
+
+	if s.SeriesVolume != nil {
+		m.SetSeriesVolume(*s.SeriesVolume)
+	} else {
+		m.ClearSeriesVolume()
+	}
+	if s.SeriesTotalVolumes != nil {
+		m.SetSeriesTotalVolumes(*s.SeriesTotalVolumes)
+	} else {
+		m.ClearSeriesTotalVolumes()
+	}
+}
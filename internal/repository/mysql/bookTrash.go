@@ -0,0 +1,177 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// GetDeletedBooks 휴지통에 있는 사용자의 책을 최근에 삭제한 순서로 조회합니다.
+func (bc *BookRepository) GetDeletedBooks(userID uuid.UUID) ([]*domain.Book, error) {
+	books, err := bc.client.Book.Query().
+		Where(
+			book.HasOwnerWith(user.ID(userID)),
+			book.DeletedAtNotNil(),
+		).
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
+		Order(ent.Desc(book.FieldDeletedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("휴지통의 책 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return BookConverter{}.ToDomainList(books, userID), nil
+}
+
+// GetDeletedBookByID 휴지통에 있는 사용자의 책을 조회합니다.
+func (bc *BookRepository) GetDeletedBookByID(userID, id uuid.UUID) (*domain.Book, error) {
+	b, err := bc.client.Book.Query().
+		Where(
+			book.ID(id),
+			book.HasOwnerWith(user.ID(userID)),
+			book.DeletedAtNotNil(),
+		).
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("휴지통의 책을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return BookConverter{}.ToDomain(b, userID), nil
+}
+
+// RestoreByID 휴지통의 책을 서재로 되돌립니다.
+// 그사이 같은 ISBN의 책이 서재에 등록되었으면 domain.DuplicateBookError를 반환합니다.
+func (bc *BookRepository) RestoreByID(id uuid.UUID) error {
+	ctx := context.Background()
+
+	err := bc.client.Book.UpdateOneID(id).
+		Where(book.DeletedAtNotNil()).
+		ClearDeletedAt().
+		SetLive(true).
+		AddVersion(1).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		if ent.IsConstraintError(err) {
+			if dup := bc.restoreDuplicateError(ctx, id); dup != nil {
+				return dup
+			}
+		}
+		return fmt.Errorf("책을 복원하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func (bc *BookRepository) restoreDuplicateError(ctx context.Context, id uuid.UUID) error {
+	b, err := bc.client.Book.Query().
+		Where(book.ID(id)).
+		WithOwner().
+		WithCatalog().
+		Only(ctx)
+	if err != nil || b.Edges.Owner == nil || b.Edges.Catalog == nil {
+		return nil
+	}
+
+	return liveDuplicateError(ctx, bc.client, b.Edges.Owner.ID, b.Edges.Catalog.ID)
+}
+
+// PurgeByID 휴지통의 책을 영구 삭제합니다.
+func (bc *BookRepository) PurgeByID(id uuid.UUID) error {
+	n, err := bc.purgeBooksWhere(book.ID(id), book.DeletedAtNotNil())
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeletedBefore before 이전에 휴지통으로 옮긴 책을 모두 영구 삭제하고 삭제한 책 수를 반환합니다.
+func (bc *BookRepository) PurgeDeletedBefore(before time.Time) (int, error) {
+	return bc.purgeBooksWhere(book.DeletedAtLT(before))
+}
+
+// purgeBooksWhere 조건에 맞는 책을 하나의 트랜잭션에서 영구 삭제합니다.
+// 리뷰는 사용자가 따로 관리하는 기록이므로 책과의 연결만 끊고 남겨 두며, 북마크, 메모 등 나머지 기록은 함께 삭제됩니다.
+func (bc *BookRepository) purgeBooksWhere(predicates ...predicate.Book) (int, error) {
+	ctx := context.Background()
+
+	books, err := bc.client.Book.Query().
+		Where(predicates...).
+		WithCatalog().
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("영구 삭제할 책을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+	if len(books) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(books))
+	for _, b := range books {
+		ids = append(ids, b.ID)
+	}
+
+	tx, err := bc.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("책 영구 삭제 트랜잭션을 시작하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	n, err := purgeBooks(ctx, tx, ids)
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return 0, fmt.Errorf("%w (롤백 실패: %v)", err, rerr)
+		}
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("책 영구 삭제를 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	// 사본만을 위한 개인 카탈로그 항목은 책과 함께 정리합니다.
+	for _, b := range books {
+		deleteOrphanPrivateCatalog(ctx, bc.client, b.Edges.Catalog)
+	}
+
+	return n, nil
+}
+
+func purgeBooks(ctx context.Context, tx *ent.Tx, ids []uuid.UUID) (int, error) {
+	if err := tx.Review.Update().
+		Where(review.HasBookWith(book.IDIn(ids...))).
+		ClearBook().
+		Exec(ctx); err != nil {
+		return 0, fmt.Errorf("영구 삭제할 책의 리뷰 연결을 끊는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	n, err := tx.Book.Delete().
+		Where(book.IDIn(ids...), book.DeletedAtNotNil()).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("책을 영구 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return n, nil
+}
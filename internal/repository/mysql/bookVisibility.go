@@ -0,0 +1,32 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/google/uuid"
+)
+
+// UpdateVisibility 책의 공개 프로필 공개 범위를 바꿉니다.
+func (bc *BookRepository) UpdateVisibility(id uuid.UUID, visibility domain.BookVisibility, expected domain.ExpectedVersions) error {
+	ctx := context.Background()
+
+	err := bc.client.Book.UpdateOneID(id).
+		Where(bookVersionIn(expected)).
+		SetVisibility(book.Visibility(visibility)).
+		SetUpdatedAt(time.Now()).
+		AddVersion(1).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return missedBookUpdate(ctx, bc.client, id, expected)
+		}
+		return fmt.Errorf("책의 공개 범위를 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// 끝나지 않은 대여 요청 상태입니다.
+var openBorrowRequestStatuses = []borrowrequest.Status{
+	borrowrequest.StatusPending,
+	borrowrequest.StatusAccepted,
+	borrowrequest.StatusHandedOver,
+}
+
+type BorrowRequestRepository struct {
+	client *ent.Client
+}
+
+func NewBorrowRequestRepository(client *ent.Client) *BorrowRequestRepository {
+	return &BorrowRequestRepository{
+		client: client,
+	}
+}
+
+func (r *BorrowRequestRepository) Create(requesterID, ownerID, bookID uuid.UUID, req *domain.BorrowRequest) (*domain.BorrowRequest, error) {
+	created, err := r.client.BorrowRequest.Create().
+		SetRequesterID(requesterID).
+		SetOwnerID(ownerID).
+		SetBookID(bookID).
+		SetMessage(req.Message).
+		SetNillableDueAt(req.DueAt).
+		Save(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("대여 요청을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetByID(created.ID)
+}
+
+func (r *BorrowRequestRepository) GetByID(id uuid.UUID) (*domain.BorrowRequest, error) {
+	br, err := r.requestQuery().
+		Where(borrowrequest.ID(id)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("대여 요청을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return BorrowRequestConverter{}.ToDomain(br), nil
+}
+
+// GetByUserID 내가 보낸 요청 또는 받은 요청을 최신순으로 조회합니다.
+func (r *BorrowRequestRepository) GetByUserID(userID uuid.UUID, filter *domain.BorrowRequestFilter) ([]*domain.BorrowRequest, error) {
+	query := r.requestQuery()
+
+	if filter.Role == domain.BorrowRequestRoleSent {
+		query.Where(borrowrequest.HasRequesterWith(user.ID(userID)))
+	} else {
+		query.Where(borrowrequest.HasOwnerWith(user.ID(userID)))
+	}
+	if filter.Status != "" {
+		query.Where(borrowrequest.StatusEQ(borrowrequest.Status(filter.Status)))
+	}
+
+	requests, err := query.
+		Order(ent.Desc(borrowrequest.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("대여 요청 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return BorrowRequestConverter{}.ToDomainList(requests), nil
+}
+
+func (r *BorrowRequestRepository) HasOpenRequest(requesterID, bookID uuid.UUID) (bool, error) {
+	exists, err := r.client.BorrowRequest.Query().
+		Where(
+			borrowrequest.HasRequesterWith(user.ID(requesterID)),
+			borrowrequest.HasBookWith(book.ID(bookID)),
+			borrowrequest.StatusIn(openBorrowRequestStatuses...),
+		).
+		Exist(context.Background())
+	if err != nil {
+		return false, fmt.Errorf("대여 요청 여부를 확인하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return exists, nil
+}
+
+// Update 대여 요청의 상태와 응답 정보를 저장합니다.
+// 요청이 아직 from 상태일 때만 저장해, 수락과 취소처럼 동시에 들어온 상태 변경 중 하나만 반영되도록 합니다.
+func (r *BorrowRequestRepository) Update(req *domain.BorrowRequest, from domain.BorrowRequestStatus) (*domain.BorrowRequest, error) {
+	update := r.client.BorrowRequest.UpdateOneID(req.ID).
+		Where(borrowrequest.StatusEQ(borrowrequest.Status(from))).
+		SetStatus(borrowrequest.Status(req.Status)).
+		SetResponseMessage(req.ResponseMessage).
+		SetNillableRespondedAt(req.RespondedAt).
+		SetNillableHandedOverAt(req.HandedOverAt).
+		SetNillableReturnedAt(req.ReturnedAt).
+		SetNillableLoanID(req.LoanID).
+		SetUpdatedAt(time.Now())
+	if req.DueAt != nil {
+		update.SetDueAt(*req.DueAt)
+	} else {
+		update.ClearDueAt()
+	}
+
+	if err := update.Exec(context.Background()); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, r.missedUpdate(req.ID)
+		}
+		return nil, fmt.Errorf("대여 요청을 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetByID(req.ID)
+}
+
+// 요청 목록에 닉네임과 책 제목을 함께 보여주기 위해 관련 항목을 함께 불러옵니다.
+// 조건부 수정에서 바뀐 행이 없을 때 요청이 없는지, 그사이 다른 상태로 바뀌었는지 구분합니다.
+func (r *BorrowRequestRepository) missedUpdate(id uuid.UUID) error {
+	exists, err := r.client.BorrowRequest.Query().
+		Where(borrowrequest.ID(id)).
+		Exist(context.Background())
+	if err != nil {
+		return fmt.Errorf("대여 요청을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+	if exists {
+		return domain.ErrInvalidRequestState
+	}
+
+	return domain.ErrNotFound
+}
+
+func (r *BorrowRequestRepository) requestQuery() *ent.BorrowRequestQuery {
+	return r.client.BorrowRequest.Query().
+		WithRequester().
+		WithOwner().
+		WithBook(func(q *ent.BookQuery) {
+			q.WithCatalog()
+		}).
+		WithLoan()
+}
@@ -0,0 +1,247 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+)
+
+// 직접 입력한 서지 정보의 출처
+const manualCatalogSource = "manual"
+
+// resolveCatalog 책의 서지 정보에 해당하는 카탈로그 항목을 찾거나 새로 만듭니다.
+// ISBN이 있으면 같은 ISBN의 항목을 모든 사용자가 공유하고, 없으면 해당 사본만을 위한 개인 항목을 만듭니다.
+func resolveCatalog(ctx context.Context, client *ent.Client, b *domain.Book) (*ent.BookCatalog, error) {
+	cat, created, err := findOrCreateCatalog(ctx, client, b)
+	if err != nil || created {
+		return cat, err
+	}
+
+	return fillCatalog(ctx, cat, b)
+}
+
+// switchCatalog 책의 ISBN을 바꿀 때 가리킬 카탈로그 항목을 찾거나 새로 만듭니다.
+// 사용자의 수정이 공유 항목을 바꾸지 않도록 이미 있는 항목은 채우지 않고 그대로 사용합니다.
+func switchCatalog(ctx context.Context, client *ent.Client, b *domain.Book) (*ent.BookCatalog, error) {
+	cat, _, err := findOrCreateCatalog(ctx, client, b)
+	return cat, err
+}
+
+// findOrCreateCatalog 같은 ISBN의 카탈로그 항목을 찾고, 없으면 새로 만듭니다. 새로 만들었으면 true를 반환합니다.
+func findOrCreateCatalog(ctx context.Context, client *ent.Client, b *domain.Book) (*ent.BookCatalog, bool, error) {
+	if b.BookISBN == "" {
+		created, err := createCatalog(ctx, client, b)
+		return created, true, err
+	}
+
+	existing, err := client.BookCatalog.Query().
+		Where(bookcatalog.Isbn(b.BookISBN)).
+		Only(ctx)
+	if err == nil {
+		return existing, false, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, false, fmt.Errorf("카탈로그 항목을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	created, err := createCatalog(ctx, client, b)
+	if err != nil && ent.IsConstraintError(err) {
+		// 같은 ISBN이 동시에 등록된 경우 먼저 생성된 항목을 사용합니다.
+		existing, err := client.BookCatalog.Query().
+			Where(bookcatalog.Isbn(b.BookISBN)).
+			Only(ctx)
+		return existing, false, err
+	}
+
+	return created, true, err
+}
+
+func createCatalog(ctx context.Context, client *ent.Client, b *domain.Book) (*ent.BookCatalog, error) {
+	source := b.MetadataSource
+	if source == "" {
+		source = manualCatalogSource
+	}
+
+	create := client.BookCatalog.Create().
+		SetTitle(b.Title).
+		SetAuthor(b.Author).
+		SetPublisher(b.Publisher).
+		SetPublishedDate(b.PublishedDate).
+		SetThumbnailURL(b.ThumbnailURL).
+		SetSource(source)
+	setCatalogSeries(create.Mutation(), b.BookSeries)
+	if b.BookISBN != "" {
+		create.SetIsbn(b.BookISBN)
+	}
+
+	cat, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("카탈로그 항목을 생성하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return cat, nil
+}
+
+// fillCatalog 기존 카탈로그 항목의 비어 있는 서지 정보만 새로 들어온 값으로 채웁니다.
+func fillCatalog(ctx context.Context, cat *ent.BookCatalog, b *domain.Book) (*ent.BookCatalog, error) {
+	update := cat.Update()
+	changed := false
+
+	if cat.ThumbnailURL == "" && b.ThumbnailURL != "" {
+		update.SetThumbnailURL(b.ThumbnailURL)
+		changed = true
+	}
+	if cat.Publisher == "" && b.Publisher != "" {
+		update.SetPublisher(b.Publisher)
+		changed = true
+	}
+	if cat.PublishedDate == "" && b.PublishedDate != "" {
+		update.SetPublishedDate(b.PublishedDate)
+		changed = true
+	}
+	if cat.SeriesName == "" && b.SeriesName != "" {
+		setCatalogSeries(update.Mutation(), b.BookSeries)
+		changed = true
+	}
+
+	if !changed {
+		return cat, nil
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("카탈로그 항목을 갱신하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return updated, nil
+}
+
+// updatePrivateCatalog 사용자가 수정한 서지 정보를 개인 카탈로그 항목(ISBN 없음)에 그대로 반영합니다.
+// 개인 항목은 한 사본만 가리키므로 바로 수정해도 다른 사용자의 책에 영향을 주지 않습니다.
+// 표지, 출판사, 출간일이 비어 있으면 지운 것으로 봅니다.
+func updatePrivateCatalog(ctx context.Context, cat *ent.BookCatalog, b *domain.Book) (*ent.BookCatalog, error) {
+	update := cat.Update().
+		SetTitle(b.Title).
+		SetAuthor(b.Author).
+		SetThumbnailURL(b.ThumbnailURL).
+		SetPublisher(b.Publisher).
+		SetPublishedDate(b.PublishedDate)
+	// 시리즈 정보는 PUT /api/books/:id/series로 지울 수 있으므로 보낸 경우에만 반영합니다.
+	if b.SeriesName != "" {
+		setCatalogSeries(update.Mutation(), b.BookSeries)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("카탈로그 항목을 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return updated, nil
+}
+
+// setCatalogOverrides 공유 카탈로그 항목과 다른 서지 정보를 사본의 override 필드에 저장합니다.
+// 카탈로그 값과 같아지면 override를 지워 다시 카탈로그 값을 따르게 합니다.
+func setCatalogOverrides(m *ent.BookMutation, cat *ent.BookCatalog, b *domain.Book) {
+	setOverride(b.Title, cat.Title, m.SetTitleOverride, m.ClearTitleOverride)
+	setOverride(b.Author, cat.Author, m.SetAuthorOverride, m.ClearAuthorOverride)
+	setOverride(b.Publisher, cat.Publisher, m.SetPublisherOverride, m.ClearPublisherOverride)
+	setOverride(b.PublishedDate, cat.PublishedDate, m.SetPublishedDateOverride, m.ClearPublishedDateOverride)
+	setOverride(b.ThumbnailURL, cat.ThumbnailURL, m.SetThumbnailURLOverride, m.ClearThumbnailURLOverride)
+}
+
+func setOverride(value, catalogValue string, set func(string), clear func()) {
+	if value == catalogValue {
+		clear()
+		return
+	}
+	set(value)
+}
+
+// clearCatalogOverrides 사본이 다른 카탈로그 항목을 가리키게 될 때 이전 항목 기준으로 고친 값을 지웁니다.
+func clearCatalogOverrides(m *ent.BookMutation) {
+	m.ClearTitleOverride()
+	m.ClearAuthorOverride()
+	m.ClearPublisherOverride()
+	m.ClearPublishedDateOverride()
+	m.ClearThumbnailURLOverride()
+}
+
+// overrideOr 사용자가 고친 값이 있으면 그 값을, 없으면 카탈로그 값을 반환합니다.
+func overrideOr(override *string, catalogValue string) string {
+	if override != nil {
+		return *override
+	}
+	return catalogValue
+}
+
+// bookTitle 사본에 보여줄 제목을 반환합니다. 카탈로그 항목이 로드되어 있어야 합니다.
+func bookTitle(b *ent.Book) string {
+	if b.Edges.Catalog == nil {
+		return overrideOr(b.TitleOverride, "")
+	}
+	return overrideOr(b.TitleOverride, b.Edges.Catalog.Title)
+}
+
+// bookAuthor 사본에 보여줄 저자를 반환합니다. 카탈로그 항목이 로드되어 있어야 합니다.
+func bookAuthor(b *ent.Book) string {
+	if b.Edges.Catalog == nil {
+		return overrideOr(b.AuthorOverride, "")
+	}
+	return overrideOr(b.AuthorOverride, b.Edges.Catalog.Author)
+}
+
+// bookDisplayColumn 사본의 override가 있으면 그 값을, 없으면 카탈로그 항목의 값을 나타내는 정렬·비교용 식을 만듭니다.
+// 카탈로그 항목은 상관 서브쿼리로 읽으므로 조인 없이 정렬과 키셋 조건에 함께 쓸 수 있습니다.
+func bookDisplayColumn(s *sql.Selector, overrideField, catalogField string) string {
+	t := sql.Table(bookcatalog.Table)
+	sub := sql.Dialect(s.Dialect()).
+		Select(t.C(catalogField)).
+		From(t).
+		Where(sql.ColumnsEQ(t.C(bookcatalog.FieldID), s.C(book.CatalogColumn)))
+	query, _ := sub.Query()
+
+	return fmt.Sprintf("COALESCE(%s, (%s))", s.C(overrideField), query)
+}
+
+// bookTitleContains 고친 제목이 있으면 그 값으로, 없으면 카탈로그 제목으로 검색합니다.
+func bookTitleContains(word string) predicate.Book {
+	return book.Or(
+		book.TitleOverrideContainsFold(word),
+		book.And(book.TitleOverrideIsNil(), book.HasCatalogWith(bookcatalog.TitleContainsFold(word))),
+	)
+}
+
+// bookAuthorContains 고친 저자가 있으면 그 값으로, 없으면 카탈로그 저자로 검색합니다.
+func bookAuthorContains(author string) predicate.Book {
+	return book.Or(
+		book.AuthorOverrideContainsFold(author),
+		book.And(book.AuthorOverrideIsNil(), book.HasCatalogWith(bookcatalog.AuthorContainsFold(author))),
+	)
+}
+
+// 사본이 더 이상 가리키지 않는 개인 카탈로그 항목(ISBN 없음)을 정리합니다.
+func deleteOrphanPrivateCatalog(ctx context.Context, client *ent.Client, cat *ent.BookCatalog) {
+	if cat == nil || cat.Isbn != nil {
+		return
+	}
+
+	hasCopies, err := cat.QueryCopies().Exist(ctx)
+	if err != nil || hasCopies {
+		return
+	}
+
+	_ = client.BookCatalog.DeleteOneID(cat.ID).Exec(ctx)
+}
+
+func catalogISBN(cat *ent.BookCatalog) string {
+	if cat == nil || cat.Isbn == nil {
+		return ""
+	}
+	return *cat.Isbn
+}
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"time"
 
 	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
@@ -42,22 +43,73 @@ func (c UserConverter) ToDomainList(users []*ent.User) []*domain.User {
 // BookConverter converts between ent.Book and domain.Book
 type BookConverter struct{}
 
-// ToDomain converts ent.Book to domain.Book with explicit ownerID
+// ToDomain converts ent.Book to domain.Book with explicit ownerID.
+// Bibliographic fields are filled from the catalog edge, which must be loaded,
+// unless the copy overrides them.
 func (c BookConverter) ToDomain(b *ent.Book, ownerID uuid.UUID) *domain.Book {
 	if b == nil {
 		return nil
 	}
-	return &domain.Book{
-		ID:           b.ID,
-		OwnerID:      ownerID,
-		Title:        b.BookTitle,
-		Author:       b.Author,
-		BookISBN:     b.BookIsbn,
-		ThumbnailURL: b.ThumbnailURL,
-		Status:       b.Status,
-		CreatedAt:    b.CreatedAt,
-		UpdatedAt:    b.UpdatedAt,
+
+	result := &domain.Book{
+		ID:          b.ID,
+		OwnerID:     ownerID,
+		Status:      domain.BookStatus(b.ReadingStatus),
+		StartedAt:   b.StartedAt,
+		FinishedAt:  b.FinishedAt,
+		CurrentPage: b.CurrentPage,
+		TotalPages:  b.TotalPages,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+		DeletedAt:   b.DeletedAt,
+		Version:     b.Version,
+		LibraryID:   b.LibraryID,
+		Visibility:  domain.BookVisibility(b.Visibility),
+		BookCopyDetails: domain.BookCopyDetails{
+			BookLocation: domain.BookLocation{
+				Room:     b.LocationRoom,
+				Bookcase: b.LocationBookcase,
+				Shelf:    b.LocationShelf,
+			},
+			PurchasedAt:  b.PurchasedAt,
+			Price:        b.Price,
+			Currency:     b.Currency,
+			AcquiredFrom: b.AcquiredFrom,
+		},
+	}
+
+	if b.Condition != nil {
+		result.Condition = domain.BookCondition(*b.Condition)
+	}
+	if b.Format != nil {
+		result.Format = domain.BookFormat(*b.Format)
+	}
+
+	if cat := b.Edges.Catalog; cat != nil {
+		result.CatalogID = cat.ID
+		result.Title = overrideOr(b.TitleOverride, cat.Title)
+		result.Author = overrideOr(b.AuthorOverride, cat.Author)
+		result.ThumbnailURL = overrideOr(b.ThumbnailURLOverride, cat.ThumbnailURL)
+		result.Publisher = overrideOr(b.PublisherOverride, cat.Publisher)
+		result.PublishedDate = overrideOr(b.PublishedDateOverride, cat.PublishedDate)
+		if cat.Isbn != nil {
+			result.BookISBN = *cat.Isbn
+		}
+		result.BookSeries = domain.BookSeries{
+			SeriesName:         cat.SeriesName,
+			SeriesVolume:       cat.SeriesVolume,
+			SeriesTotalVolumes: cat.SeriesTotalVolumes,
+		}
 	}
+
+	for _, t := range b.Edges.Tags {
+		result.Tags = append(result.Tags, t.Name)
+	}
+
+	// 대여 중 여부는 반납되지 않은 대여만 불러온 경우에 채워집니다. (withActiveLoans)
+	result.IsLent = len(b.Edges.Loans) > 0
+
+	return result
 }
 
 // ToDomainWithEdges converts ent.Book to domain.Book using loaded edges
@@ -86,6 +138,26 @@ func (c BookConverter) ToDomainList(books []*ent.Book, ownerID uuid.UUID) []*dom
 	return result
 }
 
+// BookCatalogConverter converts between ent.BookCatalog and domain.BookCatalog
+type BookCatalogConverter struct{}
+
+// ToDomain converts ent.BookCatalog to domain.BookCatalog
+func (c BookCatalogConverter) ToDomain(cat *ent.BookCatalog) *domain.BookCatalog {
+	if cat == nil {
+		return nil
+	}
+
+	return &domain.BookCatalog{
+		ID:            cat.ID,
+		ISBN:          catalogISBN(cat),
+		Title:         cat.Title,
+		Author:        cat.Author,
+		ThumbnailURL:  cat.ThumbnailURL,
+		Publisher:     cat.Publisher,
+		PublishedDate: cat.PublishedDate,
+	}
+}
+
 // ReviewConverter converts between ent.Review and domain.Review
 type ReviewConverter struct{}
 
@@ -94,7 +166,7 @@ func (c ReviewConverter) ToDomain(r *ent.Review, ownerID uuid.UUID) *domain.Revi
 	if r == nil {
 		return nil
 	}
-	return &domain.Review{
+	result := &domain.Review{
 		ID:        r.ID,
 		OwnerID:   ownerID,
 		BookISBN:  r.BookIsbn,
@@ -103,7 +175,15 @@ func (c ReviewConverter) ToDomain(r *ent.Review, ownerID uuid.UUID) *domain.Revi
 		IsPublic:  r.IsPublic,
 		CreatedAt: r.CreatedAt,
 		UpdatedAt: r.UpdatedAt,
+		DeletedAt: r.DeletedAt,
+		Version:   r.Version,
 	}
+
+	if r.Edges.Catalog != nil {
+		result.CatalogID = &r.Edges.Catalog.ID
+	}
+
+	return result
 }
 
 // ToDomainWithEdges converts ent.Review to domain.Review using loaded edges
@@ -163,6 +243,7 @@ func (c ReminderConverter) ToDomain(r *ent.ReadingReminder, userID uuid.UUID) *d
 		Message:      r.Message,
 		CreatedAt:    r.CreatedAt,
 		UpdatedAt:    r.UpdatedAt,
+		Version:      r.Version,
 	}
 }
 
@@ -197,3 +278,416 @@ func (c EmailVerificationConverter) ToDomain(v *ent.EmailVerification) *domain.E
 		CreatedAt:  v.CreatedAt,
 	}
 }
+
+// ReadingSessionConverter converts ent.ReadingSession
+type ReadingSessionConverter struct{}
+
+// ToDomain converts ent.ReadingSession to domain.ReadingSession
+func (c ReadingSessionConverter) ToDomain(s *ent.ReadingSession, ownerID, bookID uuid.UUID) *domain.ReadingSession {
+	if s == nil {
+		return nil
+	}
+
+	result := &domain.ReadingSession{
+		ID:        s.ID,
+		OwnerID:   ownerID,
+		BookID:    bookID,
+		StartPage: s.StartPage,
+		EndPage:   s.EndPage,
+		PagesRead: s.PagesRead,
+		StartedAt: s.StartedAt,
+		EndedAt:   s.EndedAt,
+	}
+
+	if s.EndedAt != nil {
+		result.DurationMinutes = int(s.EndedAt.Sub(s.StartedAt).Minutes())
+	}
+
+	return result
+}
+
+// ToDomainWithEdges converts ent.ReadingSession to domain.ReadingSession using loaded edges
+func (c ReadingSessionConverter) ToDomainWithEdges(s *ent.ReadingSession) *domain.ReadingSession {
+	if s == nil {
+		return nil
+	}
+
+	ownerID, bookID := uuid.Nil, uuid.Nil
+	if s.Edges.Owner != nil {
+		ownerID = s.Edges.Owner.ID
+	}
+	if s.Edges.Book != nil {
+		bookID = s.Edges.Book.ID
+	}
+
+	return c.ToDomain(s, ownerID, bookID)
+}
+
+// BookStatusHistoryConverter converts ent.BookStatusHistory
+type BookStatusHistoryConverter struct{}
+
+// ToDomain converts ent.BookStatusHistory to domain.BookStatusHistory
+func (c BookStatusHistoryConverter) ToDomain(h *ent.BookStatusHistory, bookID uuid.UUID) *domain.BookStatusHistory {
+	if h == nil {
+		return nil
+	}
+
+	result := &domain.BookStatusHistory{
+		ID:        h.ID,
+		BookID:    bookID,
+		ToStatus:  domain.BookStatus(h.ToStatus),
+		ChangedAt: h.ChangedAt,
+	}
+
+	if h.FromStatus != nil {
+		from := domain.BookStatus(*h.FromStatus)
+		result.FromStatus = &from
+	}
+
+	return result
+}
+
+// ToDomainList converts a slice of ent.BookStatusHistory to domain.BookStatusHistory
+func (c BookStatusHistoryConverter) ToDomainList(histories []*ent.BookStatusHistory, bookID uuid.UUID) []*domain.BookStatusHistory {
+	result := make([]*domain.BookStatusHistory, 0, len(histories))
+	for _, h := range histories {
+		result = append(result, c.ToDomain(h, bookID))
+	}
+	return result
+}
+
+// ShelfConverter converts ent.Shelf
+type ShelfConverter struct{}
+
+// ToDomain converts ent.Shelf to domain.Shelf using loaded owner and shelf_books edges
+func (c ShelfConverter) ToDomain(s *ent.Shelf) *domain.Shelf {
+	if s == nil {
+		return nil
+	}
+
+	result := &domain.Shelf{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		Visibility:  domain.ShelfVisibility(s.Visibility),
+		HideBooks:   s.HideBooks,
+		BookCount:   len(s.Edges.ShelfBooks),
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+
+	if s.Edges.Owner != nil {
+		result.OwnerID = s.Edges.Owner.ID
+	}
+
+	return result
+}
+
+// ToDomainList converts a slice of ent.Shelf to domain.Shelf
+func (c ShelfConverter) ToDomainList(shelves []*ent.Shelf) []*domain.Shelf {
+	result := make([]*domain.Shelf, 0, len(shelves))
+	for _, s := range shelves {
+		result = append(result, c.ToDomain(s))
+	}
+	return result
+}
+
+// BookNoteConverter converts ent.BookNote
+type BookNoteConverter struct{}
+
+// ToDomain converts ent.BookNote to domain.BookNote using loaded owner and book edges
+func (c BookNoteConverter) ToDomain(n *ent.BookNote) *domain.BookNote {
+	if n == nil {
+		return nil
+	}
+
+	result := &domain.BookNote{
+		ID:        n.ID,
+		Quote:     n.Quote,
+		Comment:   n.Comment,
+		Page:      n.Page,
+		Location:  n.Location,
+		Color:     domain.NoteColor(n.Color),
+		Label:     n.Label,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+	}
+
+	if n.Edges.Owner != nil {
+		result.OwnerID = n.Edges.Owner.ID
+	}
+	if b := n.Edges.Book; b != nil {
+		result.BookID = b.ID
+		result.BookTitle = bookTitle(b)
+	}
+
+	return result
+}
+
+// ToDomainList converts a slice of ent.BookNote to domain.BookNote
+func (c BookNoteConverter) ToDomainList(notes []*ent.BookNote) []*domain.BookNote {
+	result := make([]*domain.BookNote, 0, len(notes))
+	for _, n := range notes {
+		result = append(result, c.ToDomain(n))
+	}
+	return result
+}
+
+// LoanConverter converts ent.Loan
+type LoanConverter struct{}
+
+// ToDomain converts ent.Loan to domain.Loan using loaded owner and book edges
+func (c LoanConverter) ToDomain(l *ent.Loan) *domain.Loan {
+	if l == nil {
+		return nil
+	}
+
+	result := &domain.Loan{
+		ID:              l.ID,
+		BorrowerName:    l.BorrowerName,
+		BorrowerContact: l.BorrowerContact,
+		LentAt:          l.LentAt,
+		DueAt:           l.DueAt,
+		ReturnedAt:      l.ReturnedAt,
+		Notes:           l.Notes,
+		CreatedAt:       l.CreatedAt,
+		UpdatedAt:       l.UpdatedAt,
+	}
+	result.Overdue = result.IsOverdueAt(time.Now())
+
+	if l.Edges.Owner != nil {
+		result.OwnerID = l.Edges.Owner.ID
+	}
+	if b := l.Edges.Book; b != nil {
+		result.BookID = b.ID
+		result.BookTitle = bookTitle(b)
+	}
+
+	return result
+}
+
+// ToDomainList converts a slice of ent.Loan to domain.Loan
+func (c LoanConverter) ToDomainList(loans []*ent.Loan) []*domain.Loan {
+	result := make([]*domain.Loan, 0, len(loans))
+	for _, l := range loans {
+		result = append(result, c.ToDomain(l))
+	}
+	return result
+}
+
+// BorrowRequestConverter converts ent.BorrowRequest
+type BorrowRequestConverter struct{}
+
+// ToDomain converts ent.BorrowRequest to domain.BorrowRequest using loaded requester, owner, book and loan edges
+func (c BorrowRequestConverter) ToDomain(br *ent.BorrowRequest) *domain.BorrowRequest {
+	if br == nil {
+		return nil
+	}
+
+	result := &domain.BorrowRequest{
+		ID:              br.ID,
+		Status:          domain.BorrowRequestStatus(br.Status),
+		Message:         br.Message,
+		ResponseMessage: br.ResponseMessage,
+		DueAt:           br.DueAt,
+		RespondedAt:     br.RespondedAt,
+		HandedOverAt:    br.HandedOverAt,
+		ReturnedAt:      br.ReturnedAt,
+		CreatedAt:       br.CreatedAt,
+		UpdatedAt:       br.UpdatedAt,
+	}
+
+	if u := br.Edges.Requester; u != nil {
+		result.RequesterID = u.ID
+		result.RequesterNickname = u.NickName
+	}
+	if u := br.Edges.Owner; u != nil {
+		result.OwnerID = u.ID
+		result.OwnerNickname = u.NickName
+	}
+	if b := br.Edges.Book; b != nil {
+		result.BookID = b.ID
+		result.BookTitle = bookTitle(b)
+	}
+	if l := br.Edges.Loan; l != nil {
+		result.LoanID = &l.ID
+	}
+
+	return result
+}
+
+// ToDomainList converts a slice of ent.BorrowRequest to domain.BorrowRequest
+func (c BorrowRequestConverter) ToDomainList(requests []*ent.BorrowRequest) []*domain.BorrowRequest {
+	result := make([]*domain.BorrowRequest, 0, len(requests))
+	for _, br := range requests {
+		result = append(result, c.ToDomain(br))
+	}
+	return result
+}
+
+// WishlistItemConverter converts ent.WishlistItem
+type WishlistItemConverter struct{}
+
+// ToDomain converts ent.WishlistItem to domain.WishlistItem using the loaded owner edge
+func (c WishlistItemConverter) ToDomain(w *ent.WishlistItem) *domain.WishlistItem {
+	if w == nil {
+		return nil
+	}
+
+	result := &domain.WishlistItem{
+		ID:           w.ID,
+		Title:        w.Title,
+		Author:       w.Author,
+		Publisher:    w.Publisher,
+		ThumbnailURL: w.ThumbnailURL,
+		Priority:     domain.WishlistPriority(w.Priority),
+		Note:         w.Note,
+		TargetPrice:  w.TargetPrice,
+		Currency:     w.Currency,
+		ClaimedBy:    w.ClaimedBy,
+		ClaimCode:    w.ClaimCode,
+		ClaimedAt:    w.ClaimedAt,
+		CreatedAt:    w.CreatedAt,
+		UpdatedAt:    w.UpdatedAt,
+	}
+	if w.Isbn != nil {
+		result.ISBN = *w.Isbn
+	}
+	if w.Edges.Owner != nil {
+		result.OwnerID = w.Edges.Owner.ID
+	}
+
+	return result
+}
+
+// ToDomainList converts a slice of ent.WishlistItem to domain.WishlistItem
+func (c WishlistItemConverter) ToDomainList(items []*ent.WishlistItem) []*domain.WishlistItem {
+	result := make([]*domain.WishlistItem, 0, len(items))
+	for _, w := range items {
+		result = append(result, c.ToDomain(w))
+	}
+	return result
+}
+
+// LibraryConverter converts ent.Library
+type LibraryConverter struct{}
+
+// ToDomain converts ent.Library to domain.Library using loaded members with their user edges
+func (c LibraryConverter) ToDomain(l *ent.Library) *domain.Library {
+	if l == nil {
+		return nil
+	}
+
+	result := &domain.Library{
+		ID:          l.ID,
+		Name:        l.Name,
+		MemberCount: len(l.Edges.Members),
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+	}
+
+	for _, m := range l.Edges.Members {
+		result.Members = append(result.Members, LibraryMemberConverter{}.ToDomain(m))
+	}
+
+	return result
+}
+
+// LibraryMemberConverter converts ent.LibraryMember
+type LibraryMemberConverter struct{}
+
+// ToDomain converts ent.LibraryMember to domain.LibraryMember using the loaded user edge
+func (c LibraryMemberConverter) ToDomain(m *ent.LibraryMember) *domain.LibraryMember {
+	if m == nil {
+		return nil
+	}
+
+	result := &domain.LibraryMember{
+		Role:     domain.LibraryRole(m.Role),
+		JoinedAt: m.CreatedAt,
+	}
+	if u := m.Edges.User; u != nil {
+		result.UserID = u.ID
+		result.Nickname = u.NickName
+	}
+
+	return result
+}
+
+// LibraryInvitationConverter converts ent.LibraryInvitation
+type LibraryInvitationConverter struct{}
+
+// ToDomain converts ent.LibraryInvitation to domain.LibraryInvitation using loaded library, inviter and invitee edges
+func (c LibraryInvitationConverter) ToDomain(i *ent.LibraryInvitation) *domain.LibraryInvitation {
+	if i == nil {
+		return nil
+	}
+
+	result := &domain.LibraryInvitation{
+		ID:          i.ID,
+		Role:        domain.LibraryRole(i.Role),
+		Status:      domain.LibraryInvitationStatus(i.Status),
+		RespondedAt: i.RespondedAt,
+		CreatedAt:   i.CreatedAt,
+	}
+
+	if l := i.Edges.Library; l != nil {
+		result.LibraryID = l.ID
+		result.LibraryName = l.Name
+	}
+	if u := i.Edges.Inviter; u != nil {
+		result.InviterID = u.ID
+		result.InviterNickname = u.NickName
+	}
+	if u := i.Edges.Invitee; u != nil {
+		result.InviteeID = u.ID
+		result.InviteeNickname = u.NickName
+	}
+
+	return result
+}
+
+// ToDomainList converts a slice of ent.LibraryInvitation to domain.LibraryInvitation
+func (c LibraryInvitationConverter) ToDomainList(invitations []*ent.LibraryInvitation) []*domain.LibraryInvitation {
+	result := make([]*domain.LibraryInvitation, 0, len(invitations))
+	for _, i := range invitations {
+		result = append(result, c.ToDomain(i))
+	}
+	return result
+}
+
+// ReadingGoalConverter converts ent.ReadingGoal
+type ReadingGoalConverter struct{}
+
+// ToDomain converts ent.ReadingGoal to domain.ReadingGoal using the loaded owner edge
+func (c ReadingGoalConverter) ToDomain(g *ent.ReadingGoal) *domain.ReadingGoal {
+	if g == nil {
+		return nil
+	}
+
+	result := &domain.ReadingGoal{
+		ID:             g.ID,
+		Year:           g.Year,
+		Type:           domain.ReadingGoalType(g.GoalType),
+		Target:         g.Target,
+		PaceReminder:   g.PaceReminder,
+		ReminderSentAt: g.ReminderSentAt,
+		CreatedAt:      g.CreatedAt,
+		UpdatedAt:      g.UpdatedAt,
+	}
+
+	if g.Edges.Owner != nil {
+		result.UserID = g.Edges.Owner.ID
+	}
+
+	return result
+}
+
+// ToDomainList converts a slice of ent.ReadingGoal to domain.ReadingGoal
+func (c ReadingGoalConverter) ToDomainList(goals []*ent.ReadingGoal) []*domain.ReadingGoal {
+	result := make([]*domain.ReadingGoal, 0, len(goals))
+	for _, g := range goals {
+		result = append(result, c.ToDomain(g))
+	}
+	return result
+}
@@ -0,0 +1,45 @@
+package mysql
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// pageCursor 클라이언트에 불투명한 문자열로 전달되는 키셋 페이지네이션 커서입니다.
+// 정렬 기준과 방향을 함께 담아, 다른 정렬 조건으로 재사용되는 것을 막습니다.
+type pageCursor struct {
+	Sort  string    `json:"s"`
+	Order string    `json:"o"`
+	Value string    `json:"v"`
+	ID    uuid.UUID `json:"id"`
+}
+
+func encodeCursor(c pageCursor) string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(s, sort, order string) (*pageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	var c pageCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	if c.Sort != sort || c.Order != order || c.ID == uuid.Nil {
+		return nil, domain.ErrInvalidCursor
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,465 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+type LibraryRepository struct {
+	client *ent.Client
+}
+
+func NewLibraryRepository(client *ent.Client) *LibraryRepository {
+	return &LibraryRepository{
+		client: client,
+	}
+}
+
+// Create 서재와 관리자 구성원을 한 트랜잭션으로 저장합니다.
+func (r *LibraryRepository) Create(userID uuid.UUID, name string) (*domain.Library, error) {
+	ctx := context.Background()
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("서재 생성 트랜잭션을 시작하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	created, err := tx.Library.Create().
+		SetName(name).
+		Save(ctx)
+	if err == nil {
+		err = tx.LibraryMember.Create().
+			SetLibraryID(created.ID).
+			SetUserID(userID).
+			SetRole(librarymember.RoleOwner).
+			Exec(ctx)
+	}
+	if err != nil {
+		err = fmt.Errorf("서재를 저장하는 도중 오류가 발생했습니다: %w", err)
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("%w (롤백 실패: %v)", err, rerr)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("서재 생성을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetByID(userID, created.ID)
+}
+
+func (r *LibraryRepository) GetByID(userID, id uuid.UUID) (*domain.Library, error) {
+	l, err := r.libraryQuery().
+		Where(library.ID(id)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("서재를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.toDomain(l, userID)
+}
+
+// GetByUserID 사용자가 참여한 서재를 먼저 만든 순서로 조회합니다.
+func (r *LibraryRepository) GetByUserID(userID uuid.UUID) ([]*domain.Library, error) {
+	libraries, err := r.libraryQuery().
+		Where(library.HasMembersWith(librarymember.HasUserWith(user.ID(userID)))).
+		Order(ent.Asc(library.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("서재 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := make([]*domain.Library, 0, len(libraries))
+	for _, l := range libraries {
+		converted, err := r.toDomain(l, userID)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
+
+func (r *LibraryRepository) GetMemberRole(libraryID, userID uuid.UUID) (domain.LibraryRole, error) {
+	m, err := r.client.LibraryMember.Query().
+		Where(
+			librarymember.HasLibraryWith(library.ID(libraryID)),
+			librarymember.HasUserWith(user.ID(userID)),
+		).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "", domain.ErrNotFound
+		}
+		return "", fmt.Errorf("서재 구성원 역할을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return domain.LibraryRole(m.Role), nil
+}
+
+func (r *LibraryRepository) UpdateName(id uuid.UUID, name string) error {
+	err := r.client.Library.UpdateOneID(id).
+		SetName(name).
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("서재 이름을 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// Delete 서재를 삭제합니다. 구성원과 초대는 함께 지워지고, 책은 외래 키 설정(SET NULL)에 따라 개인 서재로 돌아갑니다.
+func (r *LibraryRepository) Delete(id uuid.UUID) error {
+	err := r.client.Library.DeleteOneID(id).
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("서재를 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LibraryRepository) UpdateMemberRole(libraryID, userID uuid.UUID, role domain.LibraryRole) error {
+	n, err := r.client.LibraryMember.Update().
+		Where(
+			librarymember.HasLibraryWith(library.ID(libraryID)),
+			librarymember.HasUserWith(user.ID(userID)),
+		).
+		SetRole(librarymember.Role(role)).
+		Save(context.Background())
+	if err != nil {
+		return fmt.Errorf("서재 구성원 역할을 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// RemoveMember 구성원을 내보내면서 그 사람이 서재에 넣어 둔 책을 개인 서재로 되돌립니다.
+func (r *LibraryRepository) RemoveMember(libraryID, userID uuid.UUID) error {
+	ctx := context.Background()
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("서재 구성원 삭제 트랜잭션을 시작하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	n, err := tx.LibraryMember.Delete().
+		Where(
+			librarymember.HasLibraryWith(library.ID(libraryID)),
+			librarymember.HasUserWith(user.ID(userID)),
+		).
+		Exec(ctx)
+	if err == nil && n > 0 {
+		err = tx.Book.Update().
+			Where(
+				book.LibraryID(libraryID),
+				book.HasOwnerWith(user.ID(userID)),
+			).
+			ClearLibraryID().
+			AddVersion(1).
+			Exec(ctx)
+	}
+	if err != nil {
+		err = fmt.Errorf("서재 구성원을 삭제하는 도중 오류가 발생했습니다: %w", err)
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w (롤백 실패: %v)", err, rerr)
+		}
+		return err
+	}
+	if n == 0 {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w (롤백 실패: %v)", domain.ErrNotFound, rerr)
+		}
+		return domain.ErrNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("서재 구성원 삭제를 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func (r *LibraryRepository) CountOwners(libraryID uuid.UUID) (int, error) {
+	n, err := r.client.LibraryMember.Query().
+		Where(
+			librarymember.HasLibraryWith(library.ID(libraryID)),
+			librarymember.RoleEQ(librarymember.RoleOwner),
+		).
+		Count(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("서재 관리자 수를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return n, nil
+}
+
+// FindUserID 이메일이 있으면 이메일로, 없으면 닉네임으로 사용자를 찾습니다.
+func (r *LibraryRepository) FindUserID(email, nickname string) (uuid.UUID, error) {
+	query := r.client.User.Query()
+	if email != "" {
+		query.Where(user.Email(email))
+	} else {
+		query.Where(user.NickName(nickname))
+	}
+
+	id, err := query.OnlyID(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return uuid.Nil, domain.ErrNotFound
+		}
+		return uuid.Nil, fmt.Errorf("초대할 사용자를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return id, nil
+}
+
+func (r *LibraryRepository) CreateInvitation(libraryID, inviterID, inviteeID uuid.UUID, role domain.LibraryRole) (*domain.LibraryInvitation, error) {
+	created, err := r.client.LibraryInvitation.Create().
+		SetLibraryID(libraryID).
+		SetInviterID(inviterID).
+		SetInviteeID(inviteeID).
+		SetRole(libraryinvitation.Role(role)).
+		Save(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("서재 초대를 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetInvitation(created.ID)
+}
+
+func (r *LibraryRepository) GetInvitation(id uuid.UUID) (*domain.LibraryInvitation, error) {
+	i, err := r.invitationQuery().
+		Where(libraryinvitation.ID(id)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("서재 초대를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return LibraryInvitationConverter{}.ToDomain(i), nil
+}
+
+// GetPendingInvitations 사용자가 받은 초대 중 아직 응답하지 않은 초대를 최신순으로 조회합니다.
+func (r *LibraryRepository) GetPendingInvitations(inviteeID uuid.UUID) ([]*domain.LibraryInvitation, error) {
+	invitations, err := r.invitationQuery().
+		Where(
+			libraryinvitation.HasInviteeWith(user.ID(inviteeID)),
+			libraryinvitation.StatusEQ(libraryinvitation.StatusPending),
+		).
+		Order(ent.Desc(libraryinvitation.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("받은 서재 초대 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return LibraryInvitationConverter{}.ToDomainList(invitations), nil
+}
+
+// GetLibraryInvitations 서재에서 보낸 초대를 상태와 관계없이 최신순으로 조회합니다.
+func (r *LibraryRepository) GetLibraryInvitations(libraryID uuid.UUID) ([]*domain.LibraryInvitation, error) {
+	invitations, err := r.invitationQuery().
+		Where(libraryinvitation.HasLibraryWith(library.ID(libraryID))).
+		Order(ent.Desc(libraryinvitation.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("서재 초대 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return LibraryInvitationConverter{}.ToDomainList(invitations), nil
+}
+
+func (r *LibraryRepository) HasPendingInvitation(libraryID, inviteeID uuid.UUID) (bool, error) {
+	exists, err := r.client.LibraryInvitation.Query().
+		Where(
+			libraryinvitation.HasLibraryWith(library.ID(libraryID)),
+			libraryinvitation.HasInviteeWith(user.ID(inviteeID)),
+			libraryinvitation.StatusEQ(libraryinvitation.StatusPending),
+		).
+		Exist(context.Background())
+	if err != nil {
+		return false, fmt.Errorf("서재 초대 여부를 확인하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return exists, nil
+}
+
+// UpdateInvitationStatus 대기 중인 초대의 상태를 바꾸고 응답 시간을 기록합니다.
+// 이미 처리된 초대면 domain.ErrInvalidInvitationState를 반환합니다.
+func (r *LibraryRepository) UpdateInvitationStatus(id uuid.UUID, status domain.LibraryInvitationStatus) (*domain.LibraryInvitation, error) {
+	if err := updatePendingInvitation(context.Background(), r.client.LibraryInvitation, id, status); err != nil {
+		return nil, err
+	}
+
+	return r.GetInvitation(id)
+}
+
+// AcceptInvitation 초대 상태 변경과 구성원 추가를 한 트랜잭션으로 처리합니다.
+func (r *LibraryRepository) AcceptInvitation(id uuid.UUID) (*domain.LibraryInvitation, error) {
+	ctx := context.Background()
+
+	invitation, err := r.GetInvitation(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("서재 초대 수락 트랜잭션을 시작하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	err = updatePendingInvitation(ctx, tx.LibraryInvitation, id, domain.LibraryInvitationAccepted)
+	if err == nil {
+		err = tx.LibraryMember.Create().
+			SetLibraryID(invitation.LibraryID).
+			SetUserID(invitation.InviteeID).
+			SetRole(librarymember.Role(invitation.Role)).
+			Exec(ctx)
+		if ent.IsConstraintError(err) {
+			err = domain.ErrAlreadyLibraryMember
+		} else if err != nil {
+			err = fmt.Errorf("서재 구성원을 추가하는 도중 오류가 발생했습니다: %w", err)
+		}
+	}
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return nil, fmt.Errorf("%w (롤백 실패: %v)", err, rerr)
+		}
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("서재 초대 수락을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetInvitation(id)
+}
+
+// 동시에 수락과 취소가 들어와도 한 번만 처리되도록 대기 중인 초대만 변경합니다.
+func updatePendingInvitation(ctx context.Context, client *ent.LibraryInvitationClient, id uuid.UUID, status domain.LibraryInvitationStatus) error {
+	n, err := client.Update().
+		Where(
+			libraryinvitation.ID(id),
+			libraryinvitation.StatusEQ(libraryinvitation.StatusPending),
+		).
+		SetStatus(libraryinvitation.Status(status)).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("서재 초대 상태를 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrInvalidInvitationState
+	}
+
+	return nil
+}
+
+// GetBooks 서재에 있는 책을 소유자와 관계없이 최신순으로 조회합니다. 휴지통의 책은 제외합니다.
+func (r *LibraryRepository) GetBooks(libraryID uuid.UUID) ([]*domain.Book, error) {
+	books, err := r.client.Book.Query().
+		Where(
+			book.LibraryID(libraryID),
+			book.DeletedAtIsNil(),
+		).
+		WithOwner().
+		WithCatalog().
+		WithTags().
+		WithLoans(withActiveLoans).
+		Order(ent.Desc(book.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("서재의 책 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := make([]*domain.Book, 0, len(books))
+	for _, b := range books {
+		result = append(result, BookConverter{}.ToDomainWithEdges(b))
+	}
+
+	return result, nil
+}
+
+func (r *LibraryRepository) SetBookLibrary(bookID uuid.UUID, libraryID *uuid.UUID, expected domain.ExpectedVersions) error {
+	ctx := context.Background()
+
+	update := r.client.Book.UpdateOneID(bookID).
+		Where(bookVersionIn(expected)).
+		AddVersion(1)
+	if libraryID != nil {
+		update.SetLibraryID(*libraryID)
+	} else {
+		update.ClearLibraryID()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return missedBookUpdate(ctx, r.client, bookID, expected)
+		}
+		return fmt.Errorf("책의 서재를 변경하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// 구성원 닉네임을 함께 보여주기 위해 구성원과 사용자를 함께 불러옵니다.
+func (r *LibraryRepository) libraryQuery() *ent.LibraryQuery {
+	return r.client.Library.Query().
+		WithMembers(func(q *ent.LibraryMemberQuery) {
+			q.WithUser().
+				Order(ent.Asc(librarymember.FieldCreatedAt))
+		})
+}
+
+func (r *LibraryRepository) invitationQuery() *ent.LibraryInvitationQuery {
+	return r.client.LibraryInvitation.Query().
+		WithLibrary().
+		WithInviter().
+		WithInvitee()
+}
+
+// 구성원 목록에서 요청한 사용자의 역할을 채우고, 휴지통에 없는 책 수를 셉니다.
+func (r *LibraryRepository) toDomain(l *ent.Library, userID uuid.UUID) (*domain.Library, error) {
+	result := LibraryConverter{}.ToDomain(l)
+	for _, m := range result.Members {
+		if m.UserID == userID {
+			result.MyRole = m.Role
+		}
+	}
+
+	n, err := l.QueryBooks().
+		Where(book.DeletedAtIsNil()).
+		Count(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("서재의 책 수를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+	result.BookCount = n
+
+	return result, nil
+}
@@ -0,0 +1,246 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+type LoanRepository struct {
+	client *ent.Client
+}
+
+func NewLoanRepository(client *ent.Client) *LoanRepository {
+	return &LoanRepository{
+		client: client,
+	}
+}
+
+func (r *LoanRepository) Create(userID, bookID uuid.UUID, l *domain.Loan) (*domain.Loan, error) {
+	created, err := r.client.Loan.Create().
+		SetOwnerID(userID).
+		SetBookID(bookID).
+		SetBorrowerName(l.BorrowerName).
+		SetBorrowerContact(l.BorrowerContact).
+		SetLentAt(l.LentAt).
+		SetNillableDueAt(l.DueAt).
+		SetNotes(l.Notes).
+		Save(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("대여 기록을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := LoanConverter{}.ToDomain(created)
+	result.OwnerID = userID
+	result.BookID = bookID
+	result.BookTitle = l.BookTitle
+	return result, nil
+}
+
+func (r *LoanRepository) GetByID(id uuid.UUID) (*domain.Loan, error) {
+	l, err := r.loanQuery().
+		Where(loan.ID(id)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("대여 기록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return LoanConverter{}.ToDomain(l), nil
+}
+
+// GetByBookID 책의 대여 기록을 최근 순으로 조회합니다.
+func (r *LoanRepository) GetByBookID(userID, bookID uuid.UUID) ([]*domain.Loan, error) {
+	loans, err := r.loanQuery().
+		Where(
+			loan.HasOwnerWith(user.ID(userID)),
+			loan.HasBookWith(book.ID(bookID)),
+		).
+		Order(ent.Desc(loan.FieldLentAt), ent.Desc(loan.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("책의 대여 기록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return LoanConverter{}.ToDomainList(loans), nil
+}
+
+// GetByUserID 사용자의 대여 기록을 상태별로 조회합니다.
+// 연체 목록은 반납 예정일이 오래된 순, 나머지는 최근에 빌려준 순으로 정렬합니다.
+func (r *LoanRepository) GetByUserID(userID uuid.UUID, state domain.LoanState) ([]*domain.Loan, error) {
+	query := r.loanQuery().
+		Where(loan.HasOwnerWith(user.ID(userID)))
+
+	switch state {
+	case domain.LoanStateActive:
+		query.Where(loan.ReturnedAtIsNil())
+	case domain.LoanStateOverdue:
+		query.Where(
+			loan.ReturnedAtIsNil(),
+			loan.DueAtNotNil(),
+			loan.DueAtLT(time.Now()),
+		)
+	case domain.LoanStateReturned:
+		query.Where(loan.ReturnedAtNotNil())
+	}
+
+	if state == domain.LoanStateOverdue {
+		query.Order(ent.Asc(loan.FieldDueAt))
+	} else {
+		query.Order(ent.Desc(loan.FieldLentAt), ent.Desc(loan.FieldCreatedAt))
+	}
+
+	loans, err := query.All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("대여 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return LoanConverter{}.ToDomainList(loans), nil
+}
+
+// HasActiveLoan 책이 반납되지 않은 대여 중인지 확인합니다.
+func (r *LoanRepository) HasActiveLoan(bookID uuid.UUID) (bool, error) {
+	exists, err := r.client.Loan.Query().
+		Where(
+			loan.HasBookWith(book.ID(bookID)),
+			loan.ReturnedAtIsNil(),
+		).
+		Exist(context.Background())
+	if err != nil {
+		return false, fmt.Errorf("책의 대여 여부를 확인하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return exists, nil
+}
+
+// Update 대여 정보를 수정합니다. 반납 예정일이 바뀌면 알림을 다시 보낼 수 있도록 발송 기록을 지웁니다.
+func (r *LoanRepository) Update(l *domain.Loan) (*domain.Loan, error) {
+	current, err := r.client.Loan.Get(context.Background(), l.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("대여 기록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	update := r.client.Loan.UpdateOneID(l.ID).
+		SetBorrowerName(l.BorrowerName).
+		SetBorrowerContact(l.BorrowerContact).
+		SetLentAt(l.LentAt).
+		SetNotes(l.Notes).
+		SetUpdatedAt(time.Now())
+	if l.DueAt != nil {
+		update.SetDueAt(*l.DueAt)
+	} else {
+		update.ClearDueAt()
+	}
+	if !sameTime(current.DueAt, l.DueAt) {
+		update.ClearReminderSentAt()
+	}
+
+	if _, err := update.Save(context.Background()); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("대여 기록을 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetByID(l.ID)
+}
+
+func (r *LoanRepository) MarkReturned(id uuid.UUID, returnedAt time.Time) (*domain.Loan, error) {
+	err := r.client.Loan.UpdateOneID(id).
+		SetReturnedAt(returnedAt).
+		SetUpdatedAt(time.Now()).
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("반납을 처리하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetByID(id)
+}
+
+func (r *LoanRepository) Delete(id uuid.UUID) error {
+	err := r.client.Loan.DeleteOneID(id).Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("대여 기록을 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// GetDueReminders 반납 예정일이 before 이전인 미반납 대여 중 아직 알림을 보내지 않은 것을 조회합니다.
+// FCM 토큰이 없는 사용자의 대여는 제외합니다.
+func (r *LoanRepository) GetDueReminders(before time.Time) ([]*domain.LoanDueReminder, error) {
+	loans, err := r.loanQuery().
+		Where(
+			loan.ReturnedAtIsNil(),
+			loan.DueAtNotNil(),
+			loan.DueAtLTE(before),
+			loan.ReminderSentAtIsNil(),
+			loan.HasOwnerWith(user.FcmTokenNEQ("")),
+		).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("반납 예정 알림 대상을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := make([]*domain.LoanDueReminder, 0, len(loans))
+	for _, l := range loans {
+		if l.Edges.Owner == nil {
+			continue
+		}
+
+		result = append(result, &domain.LoanDueReminder{
+			Loan:     LoanConverter{}.ToDomain(l),
+			FCMToken: l.Edges.Owner.FcmToken,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *LoanRepository) MarkReminderSent(id uuid.UUID, sentAt time.Time) error {
+	err := r.client.Loan.UpdateOneID(id).
+		SetReminderSentAt(sentAt).
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("반납 예정 알림 발송 기록을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// 대여 목록에 책 제목을 함께 보여주기 위해 책과 카탈로그를 함께 불러옵니다.
+func (r *LoanRepository) loanQuery() *ent.LoanQuery {
+	return r.client.Loan.Query().
+		WithOwner().
+		WithBook(func(q *ent.BookQuery) {
+			q.WithCatalog()
+		})
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,157 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// ProfileRepository 공개 프로필에 보여줄 책과 책장을 공개 범위 설정에 맞춰 조회합니다.
+// 계정 공개 여부(is_published)는 미리보기에서도 같은 조회를 쓰기 위해 유스케이스에서 확인합니다.
+type ProfileRepository struct {
+	client *ent.Client
+	books  *BookRepository
+}
+
+func NewProfileRepository(client *ent.Client) *ProfileRepository {
+	return &ProfileRepository{
+		client: client,
+		books:  NewBookRepository(client),
+	}
+}
+
+// visibleBook 다른 사람에게 보이는 책의 조건입니다.
+// 책의 공개 범위가 public이면 보이고, private이면 숨기며, inherit이면 숨김 책장에 담기지 않은 경우에만 보입니다.
+func visibleBook() predicate.Book {
+	return book.Or(
+		book.VisibilityEQ(book.VisibilityPublic),
+		book.And(
+			book.VisibilityEQ(book.VisibilityInherit),
+			book.Not(book.HasShelvesWith(shelf.HideBooks(true))),
+		),
+	)
+}
+
+// 공개 책장의 책 수에는 휴지통의 책과 숨긴 책을 세지 않습니다.
+func withVisibleShelfBooks(q *ent.ShelfBookQuery) {
+	q.Where(shelfbook.HasBookWith(book.DeletedAtIsNil(), visibleBook()))
+}
+
+func (r *ProfileRepository) GetUserByNickname(name string) (*domain.User, error) {
+	u, err := r.client.User.Query().
+		Where(user.NickName(name)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("사용자 정보를 닉네임으로 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return UserConverter{}.ToDomain(u), nil
+}
+
+// CountBooks 휴지통에 없는 전체 책 수와 그중 공개된 책 수를 셉니다.
+func (r *ProfileRepository) CountBooks(ownerID uuid.UUID) (int, int, error) {
+	ctx := context.Background()
+	owned := []predicate.Book{book.HasOwnerWith(user.ID(ownerID)), book.DeletedAtIsNil()}
+
+	total, err := r.client.Book.Query().
+		Where(owned...).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("책 수를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	visible, err := r.client.Book.Query().
+		Where(owned...).
+		Where(visibleBook()).
+		Count(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("공개된 책 수를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return total, visible, nil
+}
+
+func (r *ProfileRepository) ListVisibleBooks(ownerID uuid.UUID, filter *domain.BookListFilter) (*domain.BookPage, error) {
+	base := []predicate.Book{book.HasOwnerWith(user.ID(ownerID)), visibleBook()}
+
+	// 책장으로 거를 때는 공개 책장만 허용합니다.
+	if filter.ShelfID != nil {
+		base = append(base, book.HasShelvesWith(shelf.ID(*filter.ShelfID), shelf.VisibilityEQ(shelf.VisibilityPublic)))
+	}
+
+	return r.books.listBooks(filter, base...)
+}
+
+// GetPublicShelves 공개 책장을 이름순으로 조회합니다.
+func (r *ProfileRepository) GetPublicShelves(ownerID uuid.UUID) ([]*domain.Shelf, error) {
+	shelves, err := r.client.Shelf.Query().
+		Where(
+			shelf.HasOwnerWith(user.ID(ownerID)),
+			shelf.VisibilityEQ(shelf.VisibilityPublic),
+		).
+		WithOwner().
+		WithShelfBooks(withVisibleShelfBooks).
+		Order(ent.Asc(shelf.FieldName)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("공개 책장 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ShelfConverter{}.ToDomainList(shelves), nil
+}
+
+func (r *ProfileRepository) GetPublicShelf(ownerID, shelfID uuid.UUID) (*domain.Shelf, error) {
+	s, err := r.client.Shelf.Query().
+		Where(
+			shelf.ID(shelfID),
+			shelf.HasOwnerWith(user.ID(ownerID)),
+			shelf.VisibilityEQ(shelf.VisibilityPublic),
+		).
+		WithOwner().
+		WithShelfBooks(withVisibleShelfBooks).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("공개 책장을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ShelfConverter{}.ToDomain(s), nil
+}
+
+// GetVisibleShelfBooks 책장의 공개된 책을 책장 안의 순서대로 조회합니다.
+func (r *ProfileRepository) GetVisibleShelfBooks(shelfID uuid.UUID) ([]*domain.Book, error) {
+	entries, err := r.client.ShelfBook.Query().
+		Where(
+			shelfbook.ShelfID(shelfID),
+			shelfbook.HasBookWith(book.DeletedAtIsNil(), visibleBook()),
+		).
+		WithBook(func(q *ent.BookQuery) {
+			q.WithOwner().WithCatalog()
+		}).
+		Order(ent.Asc(shelfbook.FieldPosition), ent.Asc(shelfbook.FieldAddedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("공개 책장의 책 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	converter := BookConverter{}
+	books := make([]*domain.Book, 0, len(entries))
+	for _, e := range entries {
+		books = append(books, converter.ToDomainWithEdges(e.Edges.Book))
+	}
+
+	return books, nil
+}
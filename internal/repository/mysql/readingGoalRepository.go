@@ -0,0 +1,203 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+type ReadingGoalRepository struct {
+	client *ent.Client
+}
+
+func NewReadingGoalRepository(client *ent.Client) *ReadingGoalRepository {
+	return &ReadingGoalRepository{
+		client: client,
+	}
+}
+
+func (r *ReadingGoalRepository) Create(userID uuid.UUID, g *domain.ReadingGoal) (*domain.ReadingGoal, error) {
+	created, err := r.client.ReadingGoal.Create().
+		SetOwnerID(userID).
+		SetYear(g.Year).
+		SetGoalType(readinggoal.GoalType(g.Type)).
+		SetTarget(g.Target).
+		SetPaceReminder(g.PaceReminder).
+		Save(context.Background())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, domain.ErrDuplicateReadingGoal
+		}
+		return nil, fmt.Errorf("독서 목표를 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := ReadingGoalConverter{}.ToDomain(created)
+	result.UserID = userID
+	return result, nil
+}
+
+func (r *ReadingGoalRepository) GetByID(id uuid.UUID) (*domain.ReadingGoal, error) {
+	g, err := r.client.ReadingGoal.Query().
+		Where(readinggoal.ID(id)).
+		WithOwner().
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("독서 목표를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ReadingGoalConverter{}.ToDomain(g), nil
+}
+
+// GetByUserID 최근 연도순으로, 같은 연도에서는 권수 목표를 먼저 조회합니다.
+func (r *ReadingGoalRepository) GetByUserID(userID uuid.UUID, year int) ([]*domain.ReadingGoal, error) {
+	query := r.client.ReadingGoal.Query().
+		Where(readinggoal.HasOwnerWith(user.ID(userID)))
+	if year != 0 {
+		query = query.Where(readinggoal.Year(year))
+	}
+
+	goals, err := query.
+		WithOwner().
+		Order(ent.Desc(readinggoal.FieldYear), ent.Asc(readinggoal.FieldGoalType)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("독서 목표 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ReadingGoalConverter{}.ToDomainList(goals), nil
+}
+
+func (r *ReadingGoalRepository) Update(g *domain.ReadingGoal) error {
+	err := r.client.ReadingGoal.UpdateOneID(g.ID).
+		SetTarget(g.Target).
+		SetPaceReminder(g.PaceReminder).
+		SetUpdatedAt(time.Now()).
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("독서 목표를 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ReadingGoalRepository) Delete(id uuid.UUID) error {
+	err := r.client.ReadingGoal.DeleteOneID(id).Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("독서 목표를 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// GetFinishedBooks 상태 변경 기록에서 [from, to) 사이에 finished가 된 책을 찾습니다.
+// 같은 기간에 여러 번 다 읽은 책은 처음 다 읽은 기록만 사용합니다.
+// 다 읽은 뒤 다시 다른 상태로 옮긴 책은 지금도 finished인 경우에만 셉니다.
+func (r *ReadingGoalRepository) GetFinishedBooks(userID uuid.UUID, from, to time.Time) ([]*domain.FinishedBook, error) {
+	histories, err := r.client.BookStatusHistory.Query().
+		Where(
+			bookstatushistory.ToStatusEQ(bookstatushistory.ToStatusFinished),
+			bookstatushistory.ChangedAtGTE(from),
+			bookstatushistory.ChangedAtLT(to),
+			bookstatushistory.HasBookWith(
+				book.HasOwnerWith(user.ID(userID)),
+				book.DeletedAtIsNil(),
+				book.ReadingStatusEQ(book.ReadingStatusFinished),
+			),
+		).
+		WithBook(func(q *ent.BookQuery) {
+			q.WithCatalog()
+		}).
+		Order(ent.Asc(bookstatushistory.FieldChangedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("다 읽은 책 기록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(histories))
+	result := make([]*domain.FinishedBook, 0, len(histories))
+	for _, h := range histories {
+		b := h.Edges.Book
+		if b == nil || seen[b.ID] {
+			continue
+		}
+		seen[b.ID] = true
+
+		finished := &domain.FinishedBook{
+			BookID:     b.ID,
+			TotalPages: b.TotalPages,
+			FinishedAt: h.ChangedAt,
+		}
+		if b.Edges.Catalog != nil {
+			finished.Title = bookTitle(b)
+			finished.Author = bookAuthor(b)
+		}
+		result = append(result, finished)
+	}
+
+	return result, nil
+}
+
+func (r *ReadingGoalRepository) GetReminderTargets(years []int, before time.Time) ([]*domain.ReadingGoalReminder, error) {
+	goals, err := r.client.ReadingGoal.Query().
+		Where(
+			readinggoal.YearIn(years...),
+			readinggoal.PaceReminder(true),
+			readinggoal.Or(
+				readinggoal.ReminderSentAtIsNil(),
+				readinggoal.ReminderSentAtLT(before),
+			),
+			readinggoal.HasOwnerWith(user.FcmTokenNEQ("")),
+		).
+		WithOwner().
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("독서 목표 알림 대상을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := make([]*domain.ReadingGoalReminder, 0, len(goals))
+	for _, g := range goals {
+		owner := g.Edges.Owner
+		if owner == nil {
+			continue
+		}
+
+		result = append(result, &domain.ReadingGoalReminder{
+			Goal:     ReadingGoalConverter{}.ToDomain(g),
+			FCMToken: owner.FcmToken,
+			Timezone: owner.Timezone,
+		})
+	}
+
+	return result, nil
+}
+
+func (r *ReadingGoalRepository) MarkReminderSent(id uuid.UUID, sentAt time.Time) error {
+	err := r.client.ReadingGoal.UpdateOneID(id).
+		SetReminderSentAt(sentAt).
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("독서 목표 알림 발송 기록을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
@@ -6,8 +6,10 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingreminder"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
 	"github.com/dev-hyunsang/my-own-library-backend/logger"
@@ -50,6 +52,7 @@ func (r *ReadingReminderRepository) Create(userID uuid.UUID, reminder *domain.Re
 		Message:      rr.Message,
 		CreatedAt:    rr.CreatedAt,
 		UpdatedAt:    rr.UpdatedAt,
+		Version:      rr.Version,
 	}, nil
 }
 
@@ -80,6 +83,7 @@ func (r *ReadingReminderRepository) GetByID(id uuid.UUID) (*domain.ReadingRemind
 		Message:      rr.Message,
 		CreatedAt:    rr.CreatedAt,
 		UpdatedAt:    rr.UpdatedAt,
+		Version:      rr.Version,
 	}, nil
 }
 
@@ -104,36 +108,48 @@ func (r *ReadingReminderRepository) GetByUserID(userID uuid.UUID) ([]*domain.Rea
 			Message:      rr.Message,
 			CreatedAt:    rr.CreatedAt,
 			UpdatedAt:    rr.UpdatedAt,
+			Version:      rr.Version,
 		}
 	}
 
 	return result, nil
 }
 
-func (r *ReadingReminderRepository) Update(reminder *domain.ReadingReminder) error {
-	err := r.client.ReadingReminder.UpdateOneID(reminder.ID).
+// Update 알림을 수정하고 버전을 올립니다. 저장된 버전과 수정 시간은 reminder에 반영합니다.
+func (r *ReadingReminderRepository) Update(reminder *domain.ReadingReminder, expected domain.ExpectedVersions) error {
+	ctx := context.Background()
+
+	saved, err := r.client.ReadingReminder.UpdateOneID(reminder.ID).
+		Where(reminderVersionIn(expected)).
 		SetReminderTime(reminder.ReminderTime).
 		SetDayOfWeek(readingreminder.DayOfWeek(reminder.DayOfWeek)).
 		SetIsEnabled(reminder.IsEnabled).
 		SetMessage(reminder.Message).
-		Exec(context.Background())
+		AddVersion(1).
+		Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return domain.ErrReminderNotFound
+			return r.missedUpdate(ctx, reminder.ID, expected)
 		}
 		return fmt.Errorf("알림 수정 중 오류가 발생했습니다: %w", err)
 	}
 
+	reminder.Version, reminder.UpdatedAt = saved.Version, saved.UpdatedAt
+
 	logger.Sugar().Infof("알림을 수정했습니다. 알림ID: %s", reminder.ID.String())
 	return nil
 }
 
-func (r *ReadingReminderRepository) Delete(id uuid.UUID) error {
-	err := r.client.ReadingReminder.DeleteOneID(id).Exec(context.Background())
+func (r *ReadingReminderRepository) Delete(id uuid.UUID, expected domain.ExpectedVersions) error {
+	ctx := context.Background()
+
+	err := r.client.ReadingReminder.DeleteOneID(id).
+		Where(reminderVersionIn(expected)).
+		Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return domain.ErrReminderNotFound
+			return r.missedUpdate(ctx, id, expected)
 		}
 		return fmt.Errorf("알림 삭제 중 오류가 발생했습니다: %w", err)
 	}
@@ -142,6 +158,28 @@ func (r *ReadingReminderRepository) Delete(id uuid.UUID) error {
 	return nil
 }
 
+// reminderVersionIn If-Match로 받은 기대 버전을 수정 조건으로 바꿉니다. 기대 버전이 없으면 버전을 검사하지 않습니다.
+func reminderVersionIn(expected domain.ExpectedVersions) predicate.ReadingReminder {
+	if expected == nil {
+		return func(*sql.Selector) {}
+	}
+	return readingreminder.VersionIn(expected...)
+}
+
+// missedUpdate 조건부 수정에서 바뀐 행이 없을 때 알림이 없는지, 그사이 다른 곳에서 수정되었는지 구분합니다.
+func (r *ReadingReminderRepository) missedUpdate(ctx context.Context, id uuid.UUID, expected domain.ExpectedVersions) error {
+	if expected != nil {
+		exists, err := r.client.ReadingReminder.Query().
+			Where(readingreminder.ID(id)).
+			Exist(ctx)
+		if err == nil && exists {
+			return domain.ErrPreconditionFailed
+		}
+	}
+
+	return domain.ErrReminderNotFound
+}
+
 func (r *ReadingReminderRepository) GetDueReminders(currentTimeUTC time.Time) ([]*domain.ReminderWithUser, error) {
 	currentHHMM := currentTimeUTC.Format("15:04")
 	currentWeekday := strings.ToLower(currentTimeUTC.Weekday().String())
@@ -183,6 +221,7 @@ func (r *ReadingReminderRepository) GetDueReminders(currentTimeUTC time.Time) ([
 				Message:      rr.Message,
 				CreatedAt:    rr.CreatedAt,
 				UpdatedAt:    rr.UpdatedAt,
+				Version:      rr.Version,
 			},
 			FCMToken: owner.FcmToken,
 			Timezone: owner.Timezone,
@@ -0,0 +1,121 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+type ReadingSessionRepository struct {
+	client *ent.Client
+}
+
+func NewReadingSessionRepository(client *ent.Client) *ReadingSessionRepository {
+	return &ReadingSessionRepository{
+		client: client,
+	}
+}
+
+func (r *ReadingSessionRepository) Create(userID, bookID uuid.UUID, session *domain.ReadingSession) (*domain.ReadingSession, error) {
+	created, err := r.client.ReadingSession.Create().
+		SetOwnerID(userID).
+		SetBookID(bookID).
+		SetStartPage(session.StartPage).
+		SetStartedAt(time.Now()).
+		Save(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("독서 세션을 생성하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ReadingSessionConverter{}.ToDomain(created, userID, bookID), nil
+}
+
+// GetActiveByBookID 종료되지 않은 독서 세션을 조회합니다. 없으면 domain.ErrNoActiveSession을 반환합니다.
+func (r *ReadingSessionRepository) GetActiveByBookID(userID, bookID uuid.UUID) (*domain.ReadingSession, error) {
+	s, err := r.client.ReadingSession.Query().
+		Where(
+			readingsession.HasOwnerWith(user.ID(userID)),
+			readingsession.HasBookWith(book.ID(bookID)),
+			readingsession.EndedAtIsNil(),
+		).
+		Order(ent.Desc(readingsession.FieldStartedAt)).
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNoActiveSession
+		}
+		return nil, fmt.Errorf("진행 중인 독서 세션을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ReadingSessionConverter{}.ToDomain(s, userID, bookID), nil
+}
+
+// End 세션의 종료 페이지, 읽은 페이지 수, 종료 시간을 저장합니다.
+func (r *ReadingSessionRepository) End(session *domain.ReadingSession) (*domain.ReadingSession, error) {
+	update := r.client.ReadingSession.UpdateOneID(session.ID).
+		SetPagesRead(session.PagesRead).
+		SetNillableEndPage(session.EndPage)
+	if session.EndedAt != nil {
+		update.SetEndedAt(*session.EndedAt)
+	}
+
+	updated, err := update.Save(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNoActiveSession
+		}
+		return nil, fmt.Errorf("독서 세션을 종료하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ReadingSessionConverter{}.ToDomain(updated, session.OwnerID, session.BookID), nil
+}
+
+// GetByBookID 책의 독서 세션을 최신순으로 조회합니다.
+func (r *ReadingSessionRepository) GetByBookID(userID, bookID uuid.UUID) ([]*domain.ReadingSession, error) {
+	sessions, err := r.client.ReadingSession.Query().
+		Where(
+			readingsession.HasOwnerWith(user.ID(userID)),
+			readingsession.HasBookWith(book.ID(bookID)),
+		).
+		Order(ent.Desc(readingsession.FieldStartedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("독서 세션 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := make([]*domain.ReadingSession, 0, len(sessions))
+	for _, s := range sessions {
+		result = append(result, ReadingSessionConverter{}.ToDomain(s, userID, bookID))
+	}
+
+	return result, nil
+}
+
+// GetByUserID 사용자의 최근 독서 세션을 최신순으로 limit개까지 조회합니다.
+func (r *ReadingSessionRepository) GetByUserID(userID uuid.UUID, limit int) ([]*domain.ReadingSession, error) {
+	sessions, err := r.client.ReadingSession.Query().
+		Where(readingsession.HasOwnerWith(user.ID(userID))).
+		WithBook().
+		Order(ent.Desc(readingsession.FieldStartedAt)).
+		Limit(limit).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("독서 세션 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := make([]*domain.ReadingSession, 0, len(sessions))
+	for _, s := range sessions {
+		converted := ReadingSessionConverter{}.ToDomainWithEdges(s)
+		converted.OwnerID = userID
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
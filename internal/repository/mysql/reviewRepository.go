@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
 	"github.com/dev-hyunsang/my-own-library-backend/logger"
@@ -22,7 +25,20 @@ func NewReviewRepository(client *ent.Client) *ReviewRepository {
 }
 
 func (r *ReviewRepository) Create(rev *domain.Review) (*domain.Review, error) {
-	created, err := r.client.Review.Create().
+	// 같은 ISBN의 카탈로그 항목이 있으면 리뷰를 해당 항목에 연결합니다.
+	catalogID, err := r.client.BookCatalog.Query().
+		Where(bookcatalog.Isbn(rev.BookISBN)).
+		OnlyID(context.Background())
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("리뷰에 연결할 카탈로그 항목을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	create := r.client.Review.Create()
+	if err == nil {
+		create.SetCatalogID(catalogID)
+	}
+
+	created, err := create.
 		SetID(rev.ID).
 		SetBookIsbn(rev.BookISBN).
 		SetContent(rev.Content).
@@ -42,22 +58,19 @@ func (r *ReviewRepository) Create(rev *domain.Review) (*domain.Review, error) {
 
 	logger.Sugar().Infof("리뷰가 생성되었습니다. ID: %s, ISBN: %s", created.ID.String(), created.BookIsbn)
 
-	return &domain.Review{
-		ID:        created.ID,
-		OwnerID:   rev.OwnerID,
-		BookISBN:  created.BookIsbn,
-		Content:   created.Content,
-		Rating:    created.Rating,
-		IsPublic:  created.IsPublic,
-		CreatedAt: created.CreatedAt,
-		UpdatedAt: created.UpdatedAt,
-	}, nil
+	result := ReviewConverter{}.ToDomain(created, rev.OwnerID)
+	if catalogID != uuid.Nil {
+		result.CatalogID = &catalogID
+	}
+
+	return result, nil
 }
 
 func (r *ReviewRepository) GetByID(id uuid.UUID) (*domain.Review, error) {
 	rev, err := r.client.Review.Query().
-		Where(review.ID(id)).
+		Where(review.ID(id), review.DeletedAtIsNil()).
 		WithOwner().
+		WithCatalog().
 		Only(context.Background())
 
 	if err != nil {
@@ -67,22 +80,14 @@ func (r *ReviewRepository) GetByID(id uuid.UUID) (*domain.Review, error) {
 		return nil, fmt.Errorf("리뷰 조회 중 오류가 발생했습니다: %w", err)
 	}
 
-	return &domain.Review{
-		ID:        rev.ID,
-		OwnerID:   rev.Edges.Owner.ID,
-		BookISBN:  rev.BookIsbn,
-		Content:   rev.Content,
-		Rating:    rev.Rating,
-		IsPublic:  rev.IsPublic,
-		CreatedAt: rev.CreatedAt,
-		UpdatedAt: rev.UpdatedAt,
-	}, nil
+	return ReviewConverter{}.ToDomainWithEdges(rev), nil
 }
 
 func (r *ReviewRepository) GetByISBN(isbn string) ([]*domain.Review, error) {
 	reviews, err := r.client.Review.Query().
-		Where(review.BookIsbn(isbn)).
+		Where(review.BookIsbn(isbn), review.DeletedAtIsNil()).
 		WithOwner().
+		WithCatalog().
 		Order(ent.Desc(review.FieldCreatedAt)).
 		All(context.Background())
 
@@ -92,16 +97,7 @@ func (r *ReviewRepository) GetByISBN(isbn string) ([]*domain.Review, error) {
 
 	result := make([]*domain.Review, len(reviews))
 	for i, rev := range reviews {
-		result[i] = &domain.Review{
-			ID:        rev.ID,
-			OwnerID:   rev.Edges.Owner.ID,
-			BookISBN:  rev.BookIsbn,
-			Content:   rev.Content,
-			Rating:    rev.Rating,
-			IsPublic:  rev.IsPublic,
-			CreatedAt: rev.CreatedAt,
-			UpdatedAt: rev.UpdatedAt,
-		}
+		result[i] = ReviewConverter{}.ToDomainWithEdges(rev)
 	}
 
 	return result, nil
@@ -112,6 +108,7 @@ func (r *ReviewRepository) GetPublicByISBN(isbn string) ([]*domain.ReviewRespons
 		Where(
 			review.BookIsbn(isbn),
 			review.IsPublic(true),
+			review.DeletedAtIsNil(),
 		).
 		WithOwner().
 		Order(ent.Desc(review.FieldCreatedAt)).
@@ -149,6 +146,7 @@ func (r *ReviewRepository) ExistsByUserAndISBN(userID uuid.UUID, isbn string) (b
 		Where(
 			review.HasOwnerWith(user.ID(userID)),
 			review.BookIsbn(isbn),
+			review.DeletedAtIsNil(),
 		).
 		Exist(context.Background())
 
@@ -161,7 +159,8 @@ func (r *ReviewRepository) ExistsByUserAndISBN(userID uuid.UUID, isbn string) (b
 
 func (r *ReviewRepository) GetByUserID(userID uuid.UUID) ([]*domain.Review, error) {
 	reviews, err := r.client.Review.Query().
-		Where(review.HasOwnerWith(user.ID(userID))).
+		Where(review.HasOwnerWith(user.ID(userID)), review.DeletedAtIsNil()).
+		WithCatalog().
 		Order(ent.Desc(review.FieldCreatedAt)).
 		All(context.Background())
 
@@ -171,31 +170,29 @@ func (r *ReviewRepository) GetByUserID(userID uuid.UUID) ([]*domain.Review, erro
 
 	result := make([]*domain.Review, len(reviews))
 	for i, rev := range reviews {
-		result[i] = &domain.Review{
-			ID:        rev.ID,
-			OwnerID:   userID,
-			BookISBN:  rev.BookIsbn,
-			Content:   rev.Content,
-			Rating:    rev.Rating,
-			IsPublic:  rev.IsPublic,
-			CreatedAt: rev.CreatedAt,
-			UpdatedAt: rev.UpdatedAt,
-		}
+		result[i] = ReviewConverter{}.ToDomain(rev, userID)
 	}
 
 	return result, nil
 }
 
-func (r *ReviewRepository) Update(rev *domain.Review) (*domain.Review, error) {
+func (r *ReviewRepository) Update(rev *domain.Review, expected domain.ExpectedVersions) (*domain.Review, error) {
+	ctx := context.Background()
+
 	updated, err := r.client.Review.UpdateOneID(rev.ID).
+		Where(review.DeletedAtIsNil(), reviewVersionIn(expected)).
 		SetContent(rev.Content).
 		SetRating(rev.Rating).
 		SetIsPublic(rev.IsPublic).
 		SetUpdatedAt(time.Now()).
-		Save(context.Background())
+		AddVersion(1).
+		Save(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
+			if r.versionChanged(ctx, rev.ID, expected) {
+				return nil, domain.ErrPreconditionFailed
+			}
 			return nil, fmt.Errorf("해당 리뷰를 찾을 수 없습니다: %w", err)
 		}
 		return nil, fmt.Errorf("리뷰 수정 중 오류가 발생했습니다: %w", err)
@@ -212,12 +209,14 @@ func (r *ReviewRepository) Update(rev *domain.Review) (*domain.Review, error) {
 		IsPublic:  updated.IsPublic,
 		CreatedAt: updated.CreatedAt,
 		UpdatedAt: updated.UpdatedAt,
+		Version:   updated.Version,
 	}, nil
 }
 
-func (r *ReviewRepository) Delete(userID, reviewID uuid.UUID) error {
+// Delete 리뷰를 휴지통으로 옮깁니다.
+func (r *ReviewRepository) Delete(userID, reviewID uuid.UUID, expected domain.ExpectedVersions) error {
 	rev, err := r.client.Review.Query().
-		Where(review.ID(reviewID)).
+		Where(review.ID(reviewID), review.DeletedAtIsNil()).
 		WithOwner().
 		Only(context.Background())
 
@@ -232,11 +231,121 @@ func (r *ReviewRepository) Delete(userID, reviewID uuid.UUID) error {
 		return fmt.Errorf("리뷰를 삭제할 권한이 없습니다")
 	}
 
-	err = r.client.Review.DeleteOneID(reviewID).Exec(context.Background())
+	err = r.client.Review.UpdateOneID(reviewID).
+		Where(review.DeletedAtIsNil(), reviewVersionIn(expected)).
+		SetDeletedAt(time.Now()).
+		AddVersion(1).
+		Exec(context.Background())
 	if err != nil {
+		if ent.IsNotFound(err) && r.versionChanged(context.Background(), reviewID, expected) {
+			return domain.ErrPreconditionFailed
+		}
 		return fmt.Errorf("리뷰 삭제 중 오류가 발생했습니다: %w", err)
 	}
 
-	logger.Sugar().Infof("리뷰가 삭제되었습니다. ID: %s", reviewID.String())
+	logger.Sugar().Infof("리뷰를 휴지통으로 옮겼습니다. ID: %s", reviewID.String())
 	return nil
 }
+
+// reviewVersionIn If-Match로 받은 기대 버전을 수정 조건으로 바꿉니다. 기대 버전이 없으면 버전을 검사하지 않습니다.
+func reviewVersionIn(expected domain.ExpectedVersions) predicate.Review {
+	if expected == nil {
+		return func(*sql.Selector) {}
+	}
+	return review.VersionIn(expected...)
+}
+
+// versionChanged 조건부 수정에서 바뀐 행이 없을 때 리뷰가 남아 있으면 그사이 다른 곳에서 수정된 것으로 봅니다.
+func (r *ReviewRepository) versionChanged(ctx context.Context, id uuid.UUID, expected domain.ExpectedVersions) bool {
+	if expected == nil {
+		return false
+	}
+
+	exists, err := r.client.Review.Query().
+		Where(review.ID(id), review.DeletedAtIsNil()).
+		Exist(ctx)
+	return err == nil && exists
+}
+
+// GetDeletedByUserID 휴지통에 있는 사용자의 리뷰를 최근에 삭제한 순서로 조회합니다.
+func (r *ReviewRepository) GetDeletedByUserID(userID uuid.UUID) ([]*domain.Review, error) {
+	reviews, err := r.client.Review.Query().
+		Where(review.HasOwnerWith(user.ID(userID)), review.DeletedAtNotNil()).
+		WithCatalog().
+		Order(ent.Desc(review.FieldDeletedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("휴지통의 리뷰 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := make([]*domain.Review, len(reviews))
+	for i, rev := range reviews {
+		result[i] = ReviewConverter{}.ToDomain(rev, userID)
+	}
+
+	return result, nil
+}
+
+// GetDeletedByID 휴지통에 있는 사용자의 리뷰를 조회합니다.
+func (r *ReviewRepository) GetDeletedByID(userID, reviewID uuid.UUID) (*domain.Review, error) {
+	rev, err := r.client.Review.Query().
+		Where(
+			review.ID(reviewID),
+			review.HasOwnerWith(user.ID(userID)),
+			review.DeletedAtNotNil(),
+		).
+		WithCatalog().
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("휴지통의 리뷰를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ReviewConverter{}.ToDomain(rev, userID), nil
+}
+
+// Restore 휴지통의 리뷰를 되돌립니다.
+func (r *ReviewRepository) Restore(reviewID uuid.UUID) error {
+	err := r.client.Review.UpdateOneID(reviewID).
+		Where(review.DeletedAtNotNil()).
+		ClearDeletedAt().
+		AddVersion(1).
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("리뷰를 복원하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// Purge 휴지통의 리뷰를 영구 삭제합니다.
+func (r *ReviewRepository) Purge(reviewID uuid.UUID) error {
+	n, err := r.client.Review.Delete().
+		Where(review.ID(reviewID), review.DeletedAtNotNil()).
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("리뷰를 영구 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// PurgeDeletedBefore before 이전에 휴지통으로 옮긴 리뷰를 모두 영구 삭제하고 삭제한 리뷰 수를 반환합니다.
+func (r *ReviewRepository) PurgeDeletedBefore(before time.Time) (int, error) {
+	n, err := r.client.Review.Delete().
+		Where(review.DeletedAtLT(before)).
+		Exec(context.Background())
+	if err != nil {
+		return 0, fmt.Errorf("보관 기간이 지난 리뷰를 영구 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,207 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+type ShelfRepository struct {
+	client *ent.Client
+}
+
+func NewShelfRepository(client *ent.Client) *ShelfRepository {
+	return &ShelfRepository{
+		client: client,
+	}
+}
+
+func (r *ShelfRepository) Create(userID uuid.UUID, s *domain.Shelf) (*domain.Shelf, error) {
+	created, err := r.client.Shelf.Create().
+		SetOwnerID(userID).
+		SetName(s.Name).
+		SetDescription(s.Description).
+		SetVisibility(shelf.Visibility(s.Visibility)).
+		SetHideBooks(s.HideBooks).
+		Save(context.Background())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, domain.ErrDuplicateShelfName
+		}
+		return nil, fmt.Errorf("책장을 생성하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := ShelfConverter{}.ToDomain(created)
+	result.OwnerID = userID
+	return result, nil
+}
+
+func (r *ShelfRepository) GetByID(id uuid.UUID) (*domain.Shelf, error) {
+	s, err := r.client.Shelf.Query().
+		Where(shelf.ID(id)).
+		WithOwner().
+		WithShelfBooks(withLiveShelfBooks).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("책장을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ShelfConverter{}.ToDomain(s), nil
+}
+
+// GetByUserID 사용자의 모든 책장을 이름순으로 조회합니다.
+func (r *ShelfRepository) GetByUserID(userID uuid.UUID) ([]*domain.Shelf, error) {
+	shelves, err := r.client.Shelf.Query().
+		Where(shelf.HasOwnerWith(user.ID(userID))).
+		WithOwner().
+		WithShelfBooks(withLiveShelfBooks).
+		Order(ent.Asc(shelf.FieldName)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("책장 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return ShelfConverter{}.ToDomainList(shelves), nil
+}
+
+func (r *ShelfRepository) Update(s *domain.Shelf) error {
+	err := r.client.Shelf.UpdateOneID(s.ID).
+		SetName(s.Name).
+		SetDescription(s.Description).
+		SetVisibility(shelf.Visibility(s.Visibility)).
+		SetHideBooks(s.HideBooks).
+		SetUpdatedAt(time.Now()).
+		Exec(context.Background())
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			return domain.ErrNotFound
+		case ent.IsConstraintError(err):
+			return domain.ErrDuplicateShelfName
+		default:
+			return fmt.Errorf("책장을 수정하는 도중 오류가 발생했습니다: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Delete 책장을 삭제합니다. 책장에 담긴 책은 삭제되지 않고 책장과의 연결만 제거됩니다.
+func (r *ShelfRepository) Delete(id uuid.UUID) error {
+	err := r.client.Shelf.DeleteOneID(id).Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("책장을 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// 책장의 책 수에 휴지통으로 옮긴 책은 세지 않습니다.
+func withLiveShelfBooks(q *ent.ShelfBookQuery) {
+	q.Where(shelfbook.HasBookWith(book.DeletedAtIsNil()))
+}
+
+// GetBooks 책장의 책을 지정한 순서대로 조회합니다. 순서가 같으면 먼저 추가한 책이 앞에 옵니다.
+func (r *ShelfRepository) GetBooks(shelfID uuid.UUID) ([]*domain.Book, error) {
+	entries, err := r.client.ShelfBook.Query().
+		Where(shelfbook.ShelfID(shelfID), shelfbook.HasBookWith(book.DeletedAtIsNil())).
+		WithBook(func(q *ent.BookQuery) {
+			q.WithOwner().WithCatalog().WithTags().WithLoans(withActiveLoans)
+		}).
+		Order(ent.Asc(shelfbook.FieldPosition), ent.Asc(shelfbook.FieldAddedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("책장의 책 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	converter := BookConverter{}
+	books := make([]*domain.Book, 0, len(entries))
+	for _, e := range entries {
+		books = append(books, converter.ToDomainWithEdges(e.Edges.Book))
+	}
+
+	return books, nil
+}
+
+// AddBook 책을 책장의 맨 뒤에 추가합니다. 이미 담긴 책이면 domain.ErrBookAlreadyOnShelf를 반환합니다.
+func (r *ShelfRepository) AddBook(shelfID, bookID uuid.UUID) error {
+	ctx := context.Background()
+
+	last, err := r.client.ShelfBook.Query().
+		Where(shelfbook.ShelfID(shelfID)).
+		Order(ent.Desc(shelfbook.FieldPosition)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("책장의 마지막 순서를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	position := 0
+	if last != nil {
+		position = last.Position + 1
+	}
+
+	err = r.client.ShelfBook.Create().
+		SetShelfID(shelfID).
+		SetBookID(bookID).
+		SetPosition(position).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return domain.ErrBookAlreadyOnShelf
+		}
+		return fmt.Errorf("책장에 책을 추가하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ShelfRepository) RemoveBook(shelfID, bookID uuid.UUID) error {
+	deleted, err := r.client.ShelfBook.Delete().
+		Where(
+			shelfbook.ShelfID(shelfID),
+			shelfbook.BookID(bookID),
+		).
+		Exec(context.Background())
+	if err != nil {
+		return fmt.Errorf("책장에서 책을 제거하는 도중 오류가 발생했습니다: %w", err)
+	}
+	if deleted == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// ReorderBooks 주어진 책 ID 순서대로 책장 안의 순서를 다시 매깁니다.
+func (r *ShelfRepository) ReorderBooks(shelfID uuid.UUID, bookIDs []uuid.UUID) error {
+	ctx := context.Background()
+
+	for position, bookID := range bookIDs {
+		err := r.client.ShelfBook.Update().
+			Where(
+				shelfbook.ShelfID(shelfID),
+				shelfbook.BookID(bookID),
+			).
+			SetPosition(position).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("책장의 순서를 변경하는 도중 오류가 발생했습니다: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// AddTags 사용자의 태그를 찾거나 새로 만들어 책들에 붙입니다. 이미 붙은 태그는 건너뜁니다.
+// 태그를 붙여도 책의 updated_at은 변경되지 않습니다.
+func (bc *BookRepository) AddTags(userID uuid.UUID, bookIDs []uuid.UUID, names []string) error {
+	client := bc.client
+	ctx := context.Background()
+
+	for _, name := range names {
+		t, err := findOrCreateTag(ctx, client, userID, name)
+		if err != nil {
+			return err
+		}
+
+		missing, err := client.Book.Query().
+			Where(
+				book.IDIn(bookIDs...),
+				book.Not(book.HasTagsWith(tag.ID(t.ID))),
+			).
+			IDs(ctx)
+		if err != nil {
+			return fmt.Errorf("태그가 없는 책을 조회하는 도중 오류가 발생했습니다: %w", err)
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		if err := client.Tag.UpdateOneID(t.ID).AddBookIDs(missing...).Exec(ctx); err != nil {
+			return fmt.Errorf("책에 태그를 붙이는 도중 오류가 발생했습니다: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// RemoveTags 책들에서 태그를 떼고, 더 이상 어떤 책에도 붙어 있지 않은 사용자의 태그를 정리합니다.
+func (bc *BookRepository) RemoveTags(userID uuid.UUID, bookIDs []uuid.UUID, names []string) error {
+	client := bc.client
+	ctx := context.Background()
+
+	tags, err := client.Tag.Query().
+		Where(
+			tag.HasOwnerWith(user.ID(userID)),
+			tag.NameIn(names...),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("태그를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	for _, t := range tags {
+		if err := client.Tag.UpdateOneID(t.ID).RemoveBookIDs(bookIDs...).Exec(ctx); err != nil {
+			return fmt.Errorf("책에서 태그를 떼는 도중 오류가 발생했습니다: %w", err)
+		}
+	}
+
+	if _, err := client.Tag.Delete().
+		Where(
+			tag.HasOwnerWith(user.ID(userID)),
+			tag.Not(tag.HasBooks()),
+		).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("사용하지 않는 태그를 정리하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// GetTagsByUserID 사용자의 태그 중 prefix로 시작하는 태그를 많이 사용한 순서로 조회합니다.
+func (bc *BookRepository) GetTagsByUserID(userID uuid.UUID, prefix string) ([]*domain.Tag, error) {
+	query := bc.client.Tag.Query().
+		Where(tag.HasOwnerWith(user.ID(userID)))
+	if prefix != "" {
+		query = query.Where(tag.NameHasPrefix(prefix))
+	}
+
+	tags, err := query.
+		WithBooks(func(q *ent.BookQuery) {
+			q.Where(book.DeletedAtIsNil()).Select(book.FieldID)
+		}).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("태그 목록을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := make([]*domain.Tag, 0, len(tags))
+	for _, t := range tags {
+		result = append(result, &domain.Tag{
+			ID:        t.ID,
+			Name:      t.Name,
+			BookCount: len(t.Edges.Books),
+			CreatedAt: t.CreatedAt,
+		})
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].BookCount != result[j].BookCount {
+			return result[i].BookCount > result[j].BookCount
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+// 동시에 같은 태그를 만드는 경우 고유 제약 조건 오류가 나므로 다시 조회합니다.
+func findOrCreateTag(ctx context.Context, client *ent.Client, userID uuid.UUID, name string) (*ent.Tag, error) {
+	query := func() (*ent.Tag, error) {
+		return client.Tag.Query().
+			Where(
+				tag.HasOwnerWith(user.ID(userID)),
+				tag.Name(name),
+			).
+			Only(ctx)
+	}
+
+	t, err := query()
+	if err == nil {
+		return t, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("태그를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	t, err = client.Tag.Create().
+		SetOwnerID(userID).
+		SetName(name).
+		Save(ctx)
+	if err == nil {
+		return t, nil
+	}
+	if !ent.IsConstraintError(err) {
+		return nil, fmt.Errorf("태그를 생성하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	t, err = query()
+	if err != nil {
+		return nil, fmt.Errorf("태그를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return t, nil
+}
+
+// 태그 필터 조건입니다. all이면 모든 태그가, 그 외에는 태그 중 하나라도 붙은 책을 찾습니다.
+func bookTagPredicate(names []string, match domain.TagMatch) []predicate.Book {
+	if match == domain.TagMatchAll {
+		predicates := make([]predicate.Book, 0, len(names))
+		for _, name := range names {
+			predicates = append(predicates, book.HasTagsWith(tag.Name(name)))
+		}
+		return predicates
+	}
+
+	return []predicate.Book{book.HasTagsWith(tag.NameIn(names...))}
+}
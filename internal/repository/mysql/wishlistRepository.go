@@ -0,0 +1,241 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
+	"github.com/google/uuid"
+)
+
+type WishlistRepository struct {
+	client *ent.Client
+}
+
+func NewWishlistRepository(client *ent.Client) *WishlistRepository {
+	return &WishlistRepository{
+		client: client,
+	}
+}
+
+func (r *WishlistRepository) Create(userID uuid.UUID, item *domain.WishlistItem) (*domain.WishlistItem, error) {
+	create := r.client.WishlistItem.Create().
+		SetOwnerID(userID).
+		SetTitle(item.Title).
+		SetAuthor(item.Author).
+		SetPublisher(item.Publisher).
+		SetThumbnailURL(item.ThumbnailURL).
+		SetPriority(wishlistitem.Priority(item.Priority)).
+		SetNote(item.Note).
+		SetNillableTargetPrice(item.TargetPrice).
+		SetCurrency(item.Currency)
+	if item.ISBN != "" {
+		create.SetIsbn(item.ISBN)
+	}
+
+	created, err := create.Save(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("위시리스트에 책을 추가하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := WishlistItemConverter{}.ToDomain(created)
+	result.OwnerID = userID
+	return result, nil
+}
+
+func (r *WishlistRepository) GetByID(id uuid.UUID) (*domain.WishlistItem, error) {
+	item, err := r.client.WishlistItem.Query().
+		Where(wishlistitem.ID(id)).
+		WithOwner().
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("위시리스트 항목을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return WishlistItemConverter{}.ToDomain(item), nil
+}
+
+// GetByUserID 위시리스트를 우선순위가 높은 순으로, 같은 우선순위는 최근에 추가한 순으로 조회합니다.
+func (r *WishlistRepository) GetByUserID(userID uuid.UUID) ([]*domain.WishlistItem, error) {
+	items, err := r.client.WishlistItem.Query().
+		Where(wishlistitem.HasOwnerWith(user.ID(userID))).
+		WithOwner().
+		Order(ent.Desc(wishlistitem.FieldCreatedAt)).
+		All(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("위시리스트를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	result := WishlistItemConverter{}.ToDomainList(items)
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Priority.Rank() > result[j].Priority.Rank()
+	})
+
+	return result, nil
+}
+
+func (r *WishlistRepository) GetByISBN(userID uuid.UUID, isbn string) (*domain.WishlistItem, error) {
+	item, err := r.client.WishlistItem.Query().
+		Where(
+			wishlistitem.HasOwnerWith(user.ID(userID)),
+			wishlistitem.Isbn(isbn),
+		).
+		WithOwner().
+		First(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("위시리스트 항목을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return WishlistItemConverter{}.ToDomain(item), nil
+}
+
+// Update 위시리스트 항목의 정보를 수정합니다. 선물 표시는 바꾸지 않습니다.
+func (r *WishlistRepository) Update(item *domain.WishlistItem) (*domain.WishlistItem, error) {
+	update := r.client.WishlistItem.UpdateOneID(item.ID).
+		SetTitle(item.Title).
+		SetAuthor(item.Author).
+		SetPublisher(item.Publisher).
+		SetThumbnailURL(item.ThumbnailURL).
+		SetPriority(wishlistitem.Priority(item.Priority)).
+		SetNote(item.Note).
+		SetCurrency(item.Currency).
+		SetUpdatedAt(time.Now())
+	if item.ISBN != "" {
+		update.SetIsbn(item.ISBN)
+	} else {
+		update.ClearIsbn()
+	}
+	if item.TargetPrice != nil {
+		update.SetTargetPrice(*item.TargetPrice)
+	} else {
+		update.ClearTargetPrice()
+	}
+
+	if err := update.Exec(context.Background()); err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("위시리스트 항목을 수정하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetByID(item.ID)
+}
+
+// Claim 선물 표시 정보를 저장합니다. 표시가 없는 항목만 수정하므로 동시에 표시해도 한 사람만 성공합니다.
+func (r *WishlistRepository) Claim(id uuid.UUID, claimedBy, claimCode string, claimedAt time.Time) (*domain.WishlistItem, error) {
+	err := r.client.WishlistItem.UpdateOneID(id).
+		Where(wishlistitem.ClaimedAtIsNil()).
+		SetClaimedBy(claimedBy).
+		SetClaimCode(claimCode).
+		SetClaimedAt(claimedAt).
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, r.missedClaim(id, domain.ErrWishlistItemClaimed)
+		}
+		return nil, fmt.Errorf("선물 표시를 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetByID(id)
+}
+
+// Unclaim 선물 표시를 지웁니다. 표시할 때 받은 코드가 일치하는 경우에만 수정합니다.
+func (r *WishlistRepository) Unclaim(id uuid.UUID, claimCode string) (*domain.WishlistItem, error) {
+	err := r.client.WishlistItem.UpdateOneID(id).
+		Where(wishlistitem.ClaimCode(claimCode), wishlistitem.ClaimedAtNotNil()).
+		SetClaimedBy("").
+		SetClaimCode("").
+		ClearClaimedAt().
+		Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, r.missedClaim(id, domain.ErrPermissionDenied)
+		}
+		return nil, fmt.Errorf("선물 표시를 취소하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return r.GetByID(id)
+}
+
+// 조건부 수정에서 바뀐 행이 없을 때 항목이 없으면 domain.ErrNotFound를, 있으면 conflict를 반환합니다.
+func (r *WishlistRepository) missedClaim(id uuid.UUID, conflict error) error {
+	exists, err := r.client.WishlistItem.Query().
+		Where(wishlistitem.ID(id)).
+		Exist(context.Background())
+	if err != nil {
+		return fmt.Errorf("위시리스트 항목을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+	if exists {
+		return conflict
+	}
+
+	return domain.ErrNotFound
+}
+
+func (r *WishlistRepository) Delete(id uuid.UUID) error {
+	err := r.client.WishlistItem.DeleteOneID(id).Exec(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("위시리스트 항목을 삭제하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+// SetShareToken 위시리스트 공개 링크 토큰을 저장합니다. nil이면 공유를 중지합니다.
+func (r *WishlistRepository) SetShareToken(userID uuid.UUID, token *string) error {
+	update := r.client.User.UpdateOneID(userID)
+	if token != nil {
+		update.SetWishlistShareToken(*token)
+	} else {
+		update.ClearWishlistShareToken()
+	}
+
+	if err := update.Exec(context.Background()); err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("위시리스트 공유 설정을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func (r *WishlistRepository) GetShareToken(userID uuid.UUID) (*string, error) {
+	u, err := r.client.User.Get(context.Background(), userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("위시리스트 공유 설정을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return u.WishlistShareToken, nil
+}
+
+func (r *WishlistRepository) GetOwnerByShareToken(token string) (*domain.User, error) {
+	u, err := r.client.User.Query().
+		Where(user.WishlistShareToken(token)).
+		Only(context.Background())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("공유된 위시리스트를 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return UserConverter{}.ToDomain(u), nil
+}
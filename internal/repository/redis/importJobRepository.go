@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/cache"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+const (
+	importJobPrefix  = "book_import:job:"
+	importLockPrefix = "book_import:lock:"
+)
+
+// ImportJobRepository 가져오기 작업의 진행 상황을 Redis에 저장합니다. 작업 결과는 일정 기간 후 만료됩니다.
+type ImportJobRepository struct {
+	redisClient *cache.RedisClient
+}
+
+func NewImportJobRepository(redisClient *cache.RedisClient) *ImportJobRepository {
+	return &ImportJobRepository{
+		redisClient: redisClient,
+	}
+}
+
+func (r *ImportJobRepository) Save(job *domain.ImportJob) error {
+	data, err := json.Marshal(job)
+	if err != nil {
+		return fmt.Errorf("가져오기 작업 직렬화 중 오류가 발생했습니다: %w", err)
+	}
+
+	if err := r.redisClient.Set(importJobPrefix+job.ID.String(), string(data), config.ImportJobTTL); err != nil {
+		return fmt.Errorf("가져오기 작업을 저장하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
+
+func (r *ImportJobRepository) GetByID(id uuid.UUID) (*domain.ImportJob, error) {
+	data, err := r.redisClient.Get(importJobPrefix + id.String())
+	if err != nil {
+		if err == redis.Nil {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("가져오기 작업을 조회하는 도중 오류가 발생했습니다: %w", err)
+	}
+
+	var job domain.ImportJob
+	if err := json.Unmarshal([]byte(data), &job); err != nil {
+		return nil, fmt.Errorf("가져오기 작업 역직렬화 중 오류가 발생했습니다: %w", err)
+	}
+
+	return &job, nil
+}
+
+// AcquireLock 작업이 비정상 종료되어도 잠금이 남지 않도록 만료 시간을 둡니다.
+func (r *ImportJobRepository) AcquireLock(userID uuid.UUID) (bool, error) {
+	ok, err := r.redisClient.SetNX(importLockPrefix+userID.String(), "1", config.ImportLockTTL)
+	if err != nil {
+		return false, fmt.Errorf("가져오기 작업 잠금 중 오류가 발생했습니다: %w", err)
+	}
+
+	return ok, nil
+}
+
+func (r *ImportJobRepository) ReleaseLock(userID uuid.UUID) error {
+	if err := r.redisClient.Delete(importLockPrefix + userID.String()); err != nil {
+		return fmt.Errorf("가져오기 작업 잠금 해제 중 오류가 발생했습니다: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// UpdateCopyDetails 책의 보관 위치, 상태, 구입 정보를 통째로 바꿉니다. 생략한 항목은 지워집니다.
+func (bc *BookUseCase) UpdateCopyDetails(userID, id uuid.UUID, details *domain.BookCopyDetails, expected domain.ExpectedVersions) (*domain.Book, error) {
+	if userID == uuid.Nil || id == uuid.Nil || details == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if err := normalizeCopyDetails(details); err != nil {
+		return nil, err
+	}
+
+	if _, err := bc.accessibleBook(userID, id, true); err != nil {
+		return nil, err
+	}
+
+	if err := bc.bookRepo.UpdateCopyDetails(id, details, expected); err != nil {
+		return nil, err
+	}
+
+	return bc.accessibleBook(userID, id, false)
+}
+
+// GetLocationSummary 위치별 책 수와 통화별 가치 합계를 반환합니다. 단위를 생략하면 칸 단위로 묶습니다.
+func (bc *BookUseCase) GetLocationSummary(userID uuid.UUID, level domain.LocationSummaryLevel) ([]*domain.BookLocationSummary, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	switch level {
+	case "":
+		level = domain.LocationLevelShelf
+	case domain.LocationLevelRoom, domain.LocationLevelBookcase, domain.LocationLevelShelf:
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+
+	return bc.bookRepo.GetLocationSummary(userID, level)
+}
+
+// normalizeCopyDetails 사본 정보를 검증하고 정리합니다.
+// 통화는 대문자 세 글자(ISO 4217)로 맞추며, 가격만 입력하면 기본 통화를 사용합니다. 가격이 없으면 통화도 지웁니다.
+func normalizeCopyDetails(d *domain.BookCopyDetails) error {
+	d.BookLocation = normalizeLocation(d.BookLocation)
+	d.AcquiredFrom = strings.TrimSpace(d.AcquiredFrom)
+	d.Currency = strings.ToUpper(strings.TrimSpace(d.Currency))
+
+	for _, part := range []string{d.Room, d.Bookcase, d.Shelf} {
+		if utf8.RuneCountInString(part) > config.MaxLocationLength {
+			return domain.ErrInvalidInput
+		}
+	}
+	if utf8.RuneCountInString(d.AcquiredFrom) > config.MaxAcquiredFromLength {
+		return domain.ErrInvalidInput
+	}
+
+	if d.Condition != "" && !d.Condition.IsValid() {
+		return domain.ErrInvalidInput
+	}
+	if d.Format != "" && !d.Format.IsValid() {
+		return domain.ErrInvalidInput
+	}
+
+	if d.Price == nil {
+		d.Currency = ""
+		return nil
+	}
+	if *d.Price < 0 || *d.Price > config.MaxBookPrice {
+		return domain.ErrInvalidInput
+	}
+	if d.Currency == "" {
+		d.Currency = config.DefaultCurrency
+	}
+	if !isCurrencyCode(d.Currency) {
+		return domain.ErrInvalidInput
+	}
+
+	return nil
+}
+
+func normalizeLocation(loc domain.BookLocation) domain.BookLocation {
+	return domain.BookLocation{
+		Room:     strings.TrimSpace(loc.Room),
+		Bookcase: strings.TrimSpace(loc.Bookcase),
+		Shelf:    strings.TrimSpace(loc.Shelf),
+	}
+}
+
+func isCurrencyCode(s string) bool {
+	if len(s) != 3 {
+		return false
+	}
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
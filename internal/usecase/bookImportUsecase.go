@@ -0,0 +1,452 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/isbn"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/google/uuid"
+)
+
+type BookImportUseCase struct {
+	jobRepo       domain.ImportJobRepository
+	bookUseCase   domain.BookUseCase
+	reviewUseCase domain.ReviewUseCase
+}
+
+func NewBookImportUseCase(jobRepo domain.ImportJobRepository, bookUseCase domain.BookUseCase, reviewUseCase domain.ReviewUseCase) *BookImportUseCase {
+	return &BookImportUseCase{
+		jobRepo:       jobRepo,
+		bookUseCase:   bookUseCase,
+		reviewUseCase: reviewUseCase,
+	}
+}
+
+// importRecord 파일에서 읽은 한 행을 형식과 관계없이 정리한 값입니다.
+type importRecord struct {
+	Line   int
+	Title  string
+	Author string
+	ISBN   string
+	Status domain.BookStatus
+	Rating int
+	Review string
+	// FinishedAt 다 읽은 날짜입니다. 파일에 날짜가 없으면 nil이며, 가져온 시간으로 기록됩니다.
+	FinishedAt *time.Time
+}
+
+// Goodreads 내보내기 파일의 열 이름
+var goodreadsColumns = domain.ImportColumnMapping{
+	Title:      "Title",
+	Author:     "Author",
+	ISBN:       "ISBN13",
+	Status:     "Exclusive Shelf",
+	Rating:     "My Rating",
+	Review:     "My Review",
+	FinishedAt: "Date Read",
+}
+
+// Goodreads에서 다 읽은 날짜가 없는 행은 책을 추가한 날짜를 사용합니다.
+const goodreadsDateAddedColumn = "Date Added"
+
+// 다 읽은 날짜 형식입니다. Goodreads는 2006/01/02 형식으로 내보냅니다.
+var importDateLayouts = []string{"2006/01/02", "2006-01-02", time.RFC3339}
+
+// 일반 CSV에서 매핑을 생략한 항목에 사용할 열 이름
+var defaultCSVColumns = domain.ImportColumnMapping{
+	Title:      "title",
+	Author:     "author",
+	ISBN:       "isbn",
+	Status:     "status",
+	Rating:     "rating",
+	Review:     "review",
+	FinishedAt: "finished_at",
+}
+
+// Goodreads 책장 이름과 읽기 상태의 대응입니다. 목록에 없는 책장은 읽지 않음으로 가져옵니다.
+var goodreadsShelfStatuses = map[string]domain.BookStatus{
+	"to-read":           domain.BookStatusUnread,
+	"currently-reading": domain.BookStatusReading,
+	"read":              domain.BookStatusFinished,
+	"paused":            domain.BookStatusPaused,
+	"on-hold":           domain.BookStatusPaused,
+	"dnf":               domain.BookStatusAbandoned,
+	"did-not-finish":    domain.BookStatusAbandoned,
+	"abandoned":         domain.BookStatusAbandoned,
+}
+
+// StartImport 파일을 읽어 행을 검증한 뒤 백그라운드에서 책을 등록하고 작업을 반환합니다.
+// 파일 형식이 잘못되었으면 작업을 만들지 않고 domain.ErrInvalidImportFile을 반환하며,
+// 같은 사용자의 가져오기 작업이 진행 중이면 domain.ErrImportInProgress를 반환합니다.
+func (uc *BookImportUseCase) StartImport(userID uuid.UUID, format domain.ImportFormat, mapping *domain.ImportColumnMapping, data []byte) (*domain.ImportJob, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+	if len(data) == 0 || len(data) > config.MaxImportFileSize {
+		return nil, domain.ErrInvalidImportFile
+	}
+
+	var columns domain.ImportColumnMapping
+	switch format {
+	case domain.ImportFormatGoodreads:
+		columns = goodreadsColumns
+	case domain.ImportFormatCSV:
+		columns = mergeColumnMapping(defaultCSVColumns, mapping)
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+
+	rows, err := parseImportRows(data, columns, format)
+	if err != nil {
+		return nil, err
+	}
+
+	locked, err := uc.jobRepo.AcquireLock(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, domain.ErrImportInProgress
+	}
+
+	job := &domain.ImportJob{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Format:     format,
+		Status:     domain.ImportJobPending,
+		TotalRows:  len(rows),
+		Duplicates: []*domain.ImportRowResult{},
+		Failures:   []*domain.ImportRowResult{},
+		CreatedAt:  time.Now(),
+	}
+	if err := uc.jobRepo.Save(job); err != nil {
+		uc.releaseLock(userID)
+		return nil, err
+	}
+
+	snapshot := *job
+	go uc.run(job, rows)
+
+	return &snapshot, nil
+}
+
+// GetImportJob 작업 진행 상황을 조회합니다. 다른 사용자의 작업은 찾을 수 없는 것으로 처리합니다.
+func (uc *BookImportUseCase) GetImportJob(userID, id uuid.UUID) (*domain.ImportJob, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	job, err := uc.jobRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if job.UserID != userID {
+		return nil, domain.ErrNotFound
+	}
+
+	return job, nil
+}
+
+// run 행을 순서대로 등록하며 일정 행마다 진행 상황을 저장합니다.
+func (uc *BookImportUseCase) run(job *domain.ImportJob, rows []*importRecord) {
+	defer uc.releaseLock(job.UserID)
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Sugar().Errorf("가져오기 작업 중 패닉이 발생했습니다. 작업ID: %s, 오류: %v", job.ID.String(), r)
+			uc.finish(job, domain.ImportJobFailed, domain.ErrInternal.Error())
+		}
+	}()
+
+	job.Status = domain.ImportJobRunning
+	uc.saveProgress(job)
+
+	seen := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		uc.importRow(job, row, seen)
+
+		job.ProcessedRows++
+		if job.ProcessedRows%config.ImportProgressEvery == 0 {
+			uc.saveProgress(job)
+		}
+	}
+
+	uc.finish(job, domain.ImportJobCompleted, "")
+	logger.Sugar().Infof("가져오기 작업이 완료되었습니다. 작업ID: %s, 등록: %d, 중복: %d, 실패: %d",
+		job.ID.String(), job.CreatedCount, job.DuplicateCount, job.FailedCount)
+}
+
+func (uc *BookImportUseCase) importRow(job *domain.ImportJob, row *importRecord, seen map[string]bool) {
+	result := &domain.ImportRowResult{Line: row.Line, Title: row.Title, ISBN: row.ISBN}
+
+	if row.ISBN != "" {
+		normalized, err := isbn.Normalize(row.ISBN)
+		if err != nil {
+			uc.fail(job, result, domain.ErrInvalidISBN)
+			return
+		}
+		row.ISBN = normalized
+		result.ISBN = normalized
+	} else if row.Title == "" {
+		uc.fail(job, result, domain.ErrInvalidInput)
+		return
+	}
+
+	// 같은 파일에 두 번 나오거나 이미 서재에 있는 책은 중복으로 보고합니다.
+	key := importRowKey(row)
+	if seen[key] {
+		uc.duplicate(job, result, nil)
+		return
+	}
+	seen[key] = true
+
+	saved, err := uc.saveRow(job.UserID, row)
+	if err != nil {
+		var dup *domain.DuplicateBookError
+		if errors.As(err, &dup) {
+			uc.duplicate(job, result, &dup.BookID)
+			return
+		}
+		uc.fail(job, result, err)
+		return
+	}
+	job.CreatedCount++
+
+	if row.Rating > 0 && saved.BookISBN != "" {
+		uc.importReview(job, row, saved.BookISBN)
+	}
+}
+
+// 제목이 없으면 ISBN으로 도서 정보를 조회해 등록하고, 있으면 파일의 정보를 그대로 등록합니다.
+func (uc *BookImportUseCase) saveRow(userID uuid.UUID, row *importRecord) (*domain.Book, error) {
+	if row.Title == "" {
+		return uc.bookUseCase.SaveByISBN(userID, row.ISBN, row.Status)
+	}
+
+	author := row.Author
+	if author == "" {
+		author = config.UnknownAuthor
+	}
+
+	return uc.bookUseCase.SaveByBookID(userID, &domain.Book{
+		OwnerID:    userID,
+		Title:      row.Title,
+		Author:     author,
+		BookISBN:   row.ISBN,
+		Status:     row.Status,
+		FinishedAt: row.FinishedAt,
+	})
+}
+
+// 별점은 비공개 리뷰로 가져옵니다. 리뷰 등록에 실패해도 책 등록은 유지합니다.
+func (uc *BookImportUseCase) importReview(job *domain.ImportJob, row *importRecord, bookISBN string) {
+	content := row.Review
+	if content == "" {
+		content = config.ImportedReviewContent
+	}
+
+	_, err := uc.reviewUseCase.CreateReview(job.UserID, bookISBN, &domain.CreateReviewRequest{
+		Content: content,
+		Rating:  row.Rating,
+	})
+	if err != nil {
+		logger.Sugar().Warnf("가져온 별점을 리뷰로 등록하지 못했습니다. 작업ID: %s, 행: %d, 오류: %v", job.ID.String(), row.Line, err)
+		return
+	}
+
+	job.ReviewCount++
+}
+
+func (uc *BookImportUseCase) duplicate(job *domain.ImportJob, result *domain.ImportRowResult, bookID *uuid.UUID) {
+	result.BookID = bookID
+	job.DuplicateCount++
+	job.Duplicates = append(job.Duplicates, result)
+}
+
+func (uc *BookImportUseCase) fail(job *domain.ImportJob, result *domain.ImportRowResult, err error) {
+	result.Error = batchItemError(err).Error()
+	job.FailedCount++
+	job.Failures = append(job.Failures, result)
+}
+
+func (uc *BookImportUseCase) finish(job *domain.ImportJob, status domain.ImportJobStatus, message string) {
+	now := time.Now()
+	job.Status = status
+	job.Error = message
+	job.FinishedAt = &now
+	uc.saveProgress(job)
+}
+
+func (uc *BookImportUseCase) saveProgress(job *domain.ImportJob) {
+	if err := uc.jobRepo.Save(job); err != nil {
+		logger.Sugar().Errorf("가져오기 작업 진행 상황을 저장하지 못했습니다. 작업ID: %s, 오류: %v", job.ID.String(), err)
+	}
+}
+
+func (uc *BookImportUseCase) releaseLock(userID uuid.UUID) {
+	if err := uc.jobRepo.ReleaseLock(userID); err != nil {
+		logger.Sugar().Errorf("가져오기 작업 잠금을 해제하지 못했습니다. 사용자ID: %s, 오류: %v", userID.String(), err)
+	}
+}
+
+// parseImportRows CSV 헤더에서 열 위치를 찾고 각 행을 importRecord로 변환합니다.
+// 제목과 ISBN 열이 모두 없거나 행 수가 제한을 넘으면 domain.ErrInvalidImportFile을 반환합니다.
+func parseImportRows(data []byte, columns domain.ImportColumnMapping, format domain.ImportFormat) ([]*importRecord, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, domain.ErrInvalidImportFile
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	column := func(name string) int {
+		if i, ok := index[strings.ToLower(strings.TrimSpace(name))]; ok && name != "" {
+			return i
+		}
+		return -1
+	}
+
+	titleCol, authorCol, isbnCol := column(columns.Title), column(columns.Author), column(columns.ISBN)
+	statusCol, ratingCol, reviewCol := column(columns.Status), column(columns.Rating), column(columns.Review)
+	// Goodreads는 ISBN13이 비어 있고 ISBN(10자리)만 있는 행이 있습니다.
+	finishedCol := column(columns.FinishedAt)
+	isbn10Col, dateAddedCol := -1, -1
+	if format == domain.ImportFormatGoodreads {
+		isbn10Col = column("ISBN")
+		dateAddedCol = column(goodreadsDateAddedColumn)
+	}
+	if titleCol < 0 && isbnCol < 0 {
+		return nil, domain.ErrInvalidImportFile
+	}
+
+	var rows []*importRecord
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", domain.ErrInvalidImportFile, err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &importRecord{
+			Line:   line,
+			Title:  csvField(record, titleCol),
+			Author: csvField(record, authorCol),
+			ISBN:   goodreadsISBN(csvField(record, isbnCol)),
+			Review: csvField(record, reviewCol),
+		}
+		if row.ISBN == "" {
+			row.ISBN = goodreadsISBN(csvField(record, isbn10Col))
+		}
+		if row.Title == "" && row.ISBN == "" {
+			continue
+		}
+
+		row.Status = importStatus(csvField(record, statusCol))
+		// 예전에 다 읽은 책이 가져온 해의 독서 목표에 들어가지 않도록 파일의 날짜를 다 읽은 시간으로 사용합니다.
+		if row.Status == domain.BookStatusFinished {
+			row.FinishedAt = importDate(csvField(record, finishedCol))
+			if row.FinishedAt == nil {
+				row.FinishedAt = importDate(csvField(record, dateAddedCol))
+			}
+		}
+		if rating, err := strconv.Atoi(csvField(record, ratingCol)); err == nil && rating >= 1 && rating <= 5 {
+			row.Rating = rating
+		}
+
+		rows = append(rows, row)
+		if len(rows) > config.MaxImportRows {
+			return nil, domain.ErrInvalidImportFile
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil, domain.ErrInvalidImportFile
+	}
+
+	return rows, nil
+}
+
+func mergeColumnMapping(base domain.ImportColumnMapping, mapping *domain.ImportColumnMapping) domain.ImportColumnMapping {
+	if mapping == nil {
+		return base
+	}
+
+	for _, pair := range []struct {
+		dst *string
+		src string
+	}{
+		{&base.Title, mapping.Title},
+		{&base.Author, mapping.Author},
+		{&base.ISBN, mapping.ISBN},
+		{&base.Status, mapping.Status},
+		{&base.Rating, mapping.Rating},
+		{&base.Review, mapping.Review},
+		{&base.FinishedAt, mapping.FinishedAt},
+	} {
+		if strings.TrimSpace(pair.src) != "" {
+			*pair.dst = pair.src
+		}
+	}
+
+	return base
+}
+
+func csvField(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+// Goodreads는 ISBN을 ="9781234567890" 형태로 내보냅니다.
+func goodreadsISBN(s string) string {
+	s = strings.TrimPrefix(s, "=")
+	return strings.Trim(s, `"`)
+}
+
+// 날짜가 비어 있거나 형식이 맞지 않으면 nil을 반환합니다.
+func importDate(s string) *time.Time {
+	for _, layout := range importDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+// 읽기 상태 값이나 Goodreads 책장 이름을 읽기 상태로 변환합니다. 알 수 없는 값은 읽지 않음입니다.
+func importStatus(s string) domain.BookStatus {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if status, ok := goodreadsShelfStatuses[s]; ok {
+		return status
+	}
+	if status, err := domain.ParseBookStatus(s); err == nil && status.IsValid() {
+		return status
+	}
+	return domain.BookStatusUnread
+}
+
+// 파일 안의 중복을 찾기 위한 키입니다. ISBN이 없으면 제목과 저자로 비교합니다.
+func importRowKey(row *importRecord) string {
+	if row.ISBN != "" {
+		return row.ISBN
+	}
+	return strings.ToLower(row.Title) + "\x00" + strings.ToLower(row.Author)
+}
@@ -0,0 +1,31 @@
+package usecase
+
+import (
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// accessibleBook 사용자가 볼 수 있는 책을 조회합니다. 책 소유자이거나 책이 있는 공유 서재의 구성원이어야 합니다.
+// edit이 true면 책을 수정할 수 있는 역할(owner, editor)인지도 확인합니다.
+func (bc *BookUseCase) accessibleBook(userID, id uuid.UUID, edit bool) (*domain.Book, error) {
+	return accessibleBookFrom(bc.bookRepo, userID, id, edit)
+}
+
+// accessibleBookFrom 책에 딸린 기록(독서 세션, 메모, 대여, 책장)을 다루는 다른 유스케이스에서도
+// 같은 기준으로 책 접근 권한을 확인할 수 있도록 분리했습니다.
+func accessibleBookFrom(bookRepo domain.BookRepository, userID, id uuid.UUID, edit bool) (*domain.Book, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	book, role, err := bookRepo.GetBookAccess(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if edit && !role.CanEdit() {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	return book, nil
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/isbn"
+)
+
+type BookMetadataUseCase struct {
+	provider domain.BookMetadataProvider
+}
+
+func NewBookMetadataUseCase(provider domain.BookMetadataProvider) *BookMetadataUseCase {
+	return &BookMetadataUseCase{
+		provider: provider,
+	}
+}
+
+func (uc *BookMetadataUseCase) SearchByISBN(rawISBN string) (*domain.BookMetadata, error) {
+	if strings.TrimSpace(rawISBN) == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	normalized, err := isbn.Normalize(rawISBN)
+	if err != nil {
+		return nil, domain.ErrInvalidISBN
+	}
+
+	return uc.provider.SearchByISBN(context.Background(), normalized)
+}
+
+func (uc *BookMetadataUseCase) Search(query string) ([]*domain.BookMetadata, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.provider.Search(context.Background(), query)
+}
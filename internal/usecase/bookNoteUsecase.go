@@ -0,0 +1,158 @@
+package usecase
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type BookNoteUseCase struct {
+	noteRepo domain.BookNoteRepository
+	bookRepo domain.BookRepository
+}
+
+func NewBookNoteUseCase(noteRepo domain.BookNoteRepository, bookRepo domain.BookRepository) *BookNoteUseCase {
+	return &BookNoteUseCase{
+		noteRepo: noteRepo,
+		bookRepo: bookRepo,
+	}
+}
+
+func (uc *BookNoteUseCase) CreateNote(userID, bookID uuid.UUID, req *domain.BookNoteRequest) (*domain.BookNote, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	b, err := accessibleBookFrom(uc.bookRepo, userID, bookID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := noteFromRequest(req, b)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.noteRepo.Create(userID, bookID, note)
+}
+
+func (uc *BookNoteUseCase) GetBookNotes(userID, bookID uuid.UUID) ([]*domain.BookNote, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := accessibleBookFrom(uc.bookRepo, userID, bookID, false); err != nil {
+		return nil, err
+	}
+
+	return uc.noteRepo.GetByBookID(userID, bookID)
+}
+
+func (uc *BookNoteUseCase) GetNote(userID, id uuid.UUID) (*domain.BookNote, error) {
+	return uc.ownedNote(userID, id)
+}
+
+// UpdateNote 메모의 모든 항목을 요청 값으로 바꿉니다.
+func (uc *BookNoteUseCase) UpdateNote(userID, id uuid.UUID, req *domain.BookNoteRequest) (*domain.BookNote, error) {
+	if req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	current, err := uc.ownedNote(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := accessibleBookFrom(uc.bookRepo, userID, current.BookID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := noteFromRequest(req, b)
+	if err != nil {
+		return nil, err
+	}
+	note.ID = current.ID
+	note.OwnerID = current.OwnerID
+	note.BookID = current.BookID
+
+	return uc.noteRepo.Update(note)
+}
+
+func (uc *BookNoteUseCase) DeleteNote(userID, id uuid.UUID) error {
+	if _, err := uc.ownedNote(userID, id); err != nil {
+		return err
+	}
+
+	return uc.noteRepo.Delete(id)
+}
+
+func (uc *BookNoteUseCase) SearchNotes(userID uuid.UUID, filter *domain.BookNoteSearchFilter) (*domain.BookNotePage, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if filter == nil {
+		filter = &domain.BookNoteSearchFilter{}
+	}
+	filter.Query = strings.TrimSpace(filter.Query)
+
+	if filter.Limit <= 0 {
+		filter.Limit = config.DefaultPageSize
+	}
+	if filter.Limit > config.MaxPageSize {
+		filter.Limit = config.MaxPageSize
+	}
+
+	return uc.noteRepo.Search(userID, filter)
+}
+
+// 다른 사용자의 메모는 존재 여부를 드러내지 않도록 domain.ErrNotFound를 반환합니다.
+func (uc *BookNoteUseCase) ownedNote(userID, id uuid.UUID) (*domain.BookNote, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	note, err := uc.noteRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if note.OwnerID != userID {
+		return nil, domain.ErrNotFound
+	}
+
+	return note, nil
+}
+
+// 요청 값을 정리하고 검증합니다. 페이지는 책의 전체 페이지 수를 넘을 수 없습니다.
+func noteFromRequest(req *domain.BookNoteRequest, b *domain.Book) (*domain.BookNote, error) {
+	note := &domain.BookNote{
+		Quote:    strings.TrimSpace(req.Quote),
+		Comment:  strings.TrimSpace(req.Comment),
+		Page:     req.Page,
+		Location: strings.TrimSpace(req.Location),
+		Color:    req.Color,
+		Label:    strings.TrimSpace(req.Label),
+	}
+
+	if note.Quote == "" && note.Comment == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if utf8.RuneCountInString(note.Quote) > config.MaxNoteTextLength || utf8.RuneCountInString(note.Comment) > config.MaxNoteTextLength {
+		return nil, domain.ErrInvalidInput
+	}
+	if utf8.RuneCountInString(note.Location) > config.MaxNoteLocationLength || utf8.RuneCountInString(note.Label) > config.MaxNoteLabelLength {
+		return nil, domain.ErrInvalidInput
+	}
+	if note.Color != "" && !note.Color.IsValid() {
+		return nil, domain.ErrInvalidInput
+	}
+	if note.Page != nil && !validPage(*note.Page, b.TotalPages) {
+		return nil, domain.ErrInvalidPage
+	}
+
+	return note, nil
+}
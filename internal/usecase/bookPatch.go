@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Patch 보낸 항목만 검증해 현재 책 정보에 덮어쓰고, 저장된 책을 다시 조회해 반환합니다.
+// 바꿀 항목이 없으면 현재 책을 그대로 반환합니다.
+func (bc *BookUseCase) Patch(userID, id uuid.UUID, req *domain.PatchBookRequest, expected domain.ExpectedVersions) (*domain.Book, error) {
+	if userID == uuid.Nil || id == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	current, err := bc.accessibleBook(userID, id, true)
+	if err != nil {
+		return nil, err
+	}
+	if !expected.Matches(current.Version) {
+		return nil, domain.ErrPreconditionFailed
+	}
+
+	updated := *current
+	changed, err := applyBookPatch(&updated, req)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		return current, nil
+	}
+
+	if err := bc.checkCatalogSwitch(current, &updated, req); err != nil {
+		return nil, err
+	}
+
+	if err := bc.Edit(userID, id, &updated, expected); err != nil {
+		return nil, err
+	}
+
+	return bc.accessibleBook(userID, id, false)
+}
+
+// applyBookPatch 요청에 포함된 항목을 검증한 뒤 책에 반영합니다. 반영한 항목이 있으면 true를 반환합니다.
+// ISBN 정규화와 읽기 상태 전이 검사는 Edit에서 처리합니다.
+func applyBookPatch(book *domain.Book, req *domain.PatchBookRequest) (bool, error) {
+	changed := false
+
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if title == "" || utf8.RuneCountInString(title) > config.MaxBookTitleLength {
+			return false, domain.ErrInvalidInput
+		}
+		book.Title = title
+		changed = true
+	}
+
+	if req.Author != nil {
+		author := strings.TrimSpace(*req.Author)
+		if author == "" || utf8.RuneCountInString(author) > config.MaxBookAuthorLength {
+			return false, domain.ErrInvalidInput
+		}
+		book.Author = author
+		changed = true
+	}
+
+	if req.BookISBN != nil {
+		book.BookISBN = *req.BookISBN
+		changed = true
+	}
+
+	if req.ThumbnailURL != nil {
+		thumbnail := strings.TrimSpace(*req.ThumbnailURL)
+		if thumbnail != "" && !isValidThumbnailURL(thumbnail) {
+			return false, domain.ErrInvalidInput
+		}
+		book.ThumbnailURL = thumbnail
+		changed = true
+	}
+
+	if req.Publisher != nil {
+		publisher := strings.TrimSpace(*req.Publisher)
+		if utf8.RuneCountInString(publisher) > config.MaxBookPublisherLength {
+			return false, domain.ErrInvalidInput
+		}
+		book.Publisher = publisher
+		changed = true
+	}
+
+	if req.PublishedDate != nil {
+		published := strings.TrimSpace(*req.PublishedDate)
+		if utf8.RuneCountInString(published) > config.MaxPublishedDateLength {
+			return false, domain.ErrInvalidInput
+		}
+		book.PublishedDate = published
+		changed = true
+	}
+
+	if req.Status != nil {
+		// 빈 상태는 "현재 상태 유지"가 아니라 잘못된 값으로 봅니다.
+		if *req.Status == "" {
+			return false, domain.ErrInvalidBookStatus
+		}
+		book.Status = *req.Status
+		changed = true
+	}
+
+	return changed, nil
+}
+
+// checkCatalogSwitch 이미 등록된 ISBN으로 바꾸면 서지 정보는 해당 카탈로그 항목을 따르므로,
+// 함께 보낸 서지 정보가 카탈로그 값과 다르면 조용히 버리지 않고 domain.ErrCatalogFieldConflict를 반환합니다.
+func (bc *BookUseCase) checkCatalogSwitch(current, updated *domain.Book, req *domain.PatchBookRequest) error {
+	if req.BookISBN == nil {
+		return nil
+	}
+	if err := normalizeBookISBN(updated); err != nil {
+		return err
+	}
+	if updated.BookISBN == "" || updated.BookISBN == current.BookISBN {
+		return nil
+	}
+
+	cat, err := bc.bookRepo.GetCatalogByISBN(updated.BookISBN)
+	if errors.Is(err, domain.ErrNotFound) {
+		// 새 카탈로그 항목은 보낸 서지 정보로 만들어집니다.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	conflict := (req.Title != nil && updated.Title != cat.Title) ||
+		(req.Author != nil && updated.Author != cat.Author) ||
+		(req.ThumbnailURL != nil && updated.ThumbnailURL != cat.ThumbnailURL) ||
+		(req.Publisher != nil && updated.Publisher != cat.Publisher) ||
+		(req.PublishedDate != nil && updated.PublishedDate != cat.PublishedDate)
+	if conflict {
+		return domain.ErrCatalogFieldConflict
+	}
+
+	return nil
+}
+
+// 표지 이미지 URL은 http(s) 절대 경로만 허용합니다.
+func isValidThumbnailURL(raw string) bool {
+	if len(raw) > config.MaxThumbnailURLLength {
+		return false
+	}
+
+	u, err := url.ParseRequestURI(raw)
+	if err != nil {
+		return false
+	}
+
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
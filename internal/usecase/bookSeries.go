@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// UpdateSeries 책의 시리즈 이름, 권 번호, 전체 권 수를 통째로 바꿉니다. 시리즈 이름을 비우면 시리즈 정보가 지워집니다.
+// 시리즈 정보는 카탈로그 항목에 저장되므로 같은 ISBN의 책을 가진 다른 사용자에게도 반영됩니다.
+func (bc *BookUseCase) UpdateSeries(userID, id uuid.UUID, series *domain.BookSeries, expected domain.ExpectedVersions) (*domain.Book, error) {
+	if userID == uuid.Nil || id == uuid.Nil || series == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if err := normalizeSeries(series); err != nil {
+		return nil, err
+	}
+
+	if _, err := bc.accessibleBook(userID, id, true); err != nil {
+		return nil, err
+	}
+
+	if err := bc.bookRepo.UpdateSeries(id, series, expected); err != nil {
+		return nil, err
+	}
+
+	return bc.accessibleBook(userID, id, false)
+}
+
+// GetSeries 사용자가 가진 시리즈별 권 현황을 이름순으로 반환합니다.
+func (bc *BookUseCase) GetSeries(userID uuid.UUID) ([]*domain.Series, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	books, err := bc.bookRepo.GetSeriesBooks(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	series := groupSeries(books)
+	for _, s := range series {
+		s.Books = nil
+	}
+	return series, nil
+}
+
+// GetSeriesByName 한 시리즈의 권 현황과 책 목록을 반환합니다. 이름의 대소문자와 공백 차이는 무시합니다.
+func (bc *BookUseCase) GetSeriesByName(userID uuid.UUID, name string) (*domain.Series, error) {
+	key := seriesKey(name)
+	if userID == uuid.Nil || key == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	books, err := bc.bookRepo.GetSeriesBooks(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range groupSeries(books) {
+		if seriesKey(s.Name) == key {
+			return s, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+// GetMissingVolumes 빠진 권이 있는 시리즈만 반환합니다.
+func (bc *BookUseCase) GetMissingVolumes(userID uuid.UUID) ([]*domain.Series, error) {
+	series, err := bc.GetSeries(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Series, 0, len(series))
+	for _, s := range series {
+		if len(s.MissingVolumes) > 0 {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+// groupSeries 책을 시리즈별로 묶고 가진 권과 빠진 권을 계산합니다. 각 시리즈의 책은 권 번호순입니다.
+func groupSeries(books []*domain.Book) []*domain.Series {
+	groups := make(map[string]*domain.Series)
+	var result []*domain.Series
+
+	for _, b := range books {
+		key := seriesKey(b.SeriesName)
+		if key == "" {
+			continue
+		}
+
+		s, ok := groups[key]
+		if !ok {
+			s = &domain.Series{Name: strings.Join(strings.Fields(b.SeriesName), " ")}
+			groups[key] = s
+			result = append(result, s)
+		}
+
+		s.Books = append(s.Books, b)
+		// 책마다 전체 권 수가 다르게 입력되어 있으면 가장 큰 값을 사용합니다.
+		if b.SeriesTotalVolumes != nil && (s.TotalVolumes == nil || *b.SeriesTotalVolumes > *s.TotalVolumes) {
+			total := *b.SeriesTotalVolumes
+			s.TotalVolumes = &total
+		}
+	}
+
+	for _, s := range result {
+		sort.SliceStable(s.Books, func(i, j int) bool {
+			return seriesVolumeOrder(s.Books[i]) < seriesVolumeOrder(s.Books[j])
+		})
+		s.BookCount = len(s.Books)
+		s.OwnedVolumes, s.MissingVolumes = seriesVolumes(s.Books, s.TotalVolumes)
+		s.IsComplete = s.TotalVolumes != nil && len(s.MissingVolumes) == 0
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// seriesVolumes 가진 권 번호(중복 제거)와 1권부터 마지막 권까지 중 빠진 권 번호를 오름차순으로 반환합니다.
+func seriesVolumes(books []*domain.Book, total *int) ([]int, []int) {
+	owned := make(map[int]bool)
+	last := 0
+	for _, b := range books {
+		if b.SeriesVolume == nil {
+			continue
+		}
+		owned[*b.SeriesVolume] = true
+		if *b.SeriesVolume > last {
+			last = *b.SeriesVolume
+		}
+	}
+	if total != nil && *total > last {
+		last = *total
+	}
+
+	ownedVolumes := make([]int, 0, len(owned))
+	missingVolumes := make([]int, 0)
+	for v := 1; v <= last; v++ {
+		if owned[v] {
+			ownedVolumes = append(ownedVolumes, v)
+		} else {
+			missingVolumes = append(missingVolumes, v)
+		}
+	}
+	return ownedVolumes, missingVolumes
+}
+
+// 권 번호가 없는 책은 시리즈의 맨 뒤에 둡니다.
+func seriesVolumeOrder(b *domain.Book) int {
+	if b.SeriesVolume == nil {
+		return config.MaxSeriesVolume + 1
+	}
+	return *b.SeriesVolume
+}
+
+func seriesKey(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
+
+// normalizeSeries 시리즈 정보를 검증하고 정리합니다. 시리즈 이름이 없으면 권 번호와 전체 권 수도 지웁니다.
+func normalizeSeries(s *domain.BookSeries) error {
+	s.SeriesName = strings.Join(strings.Fields(s.SeriesName), " ")
+	if s.SeriesName == "" {
+		s.SeriesVolume, s.SeriesTotalVolumes = nil, nil
+		return nil
+	}
+
+	if utf8.RuneCountInString(s.SeriesName) > config.MaxSeriesNameLength {
+		return domain.ErrInvalidInput
+	}
+	for _, n := range []*int{s.SeriesVolume, s.SeriesTotalVolumes} {
+		if n != nil && (*n < 1 || *n > config.MaxSeriesVolume) {
+			return domain.ErrInvalidInput
+		}
+	}
+	if s.SeriesVolume != nil && s.SeriesTotalVolumes != nil && *s.SeriesVolume > *s.SeriesTotalVolumes {
+		return domain.ErrInvalidInput
+	}
+
+	return nil
+}
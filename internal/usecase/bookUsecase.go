@@ -1,17 +1,28 @@
 package usecase
 
 import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
 	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/isbn"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
 	"github.com/google/uuid"
 )
 
 type BookUseCase struct {
-	bookRepo domain.BookRepository
+	bookRepo         domain.BookRepository
+	metadataProvider domain.BookMetadataProvider
 }
 
-func NewBookUseCase(repo domain.BookRepository) *BookUseCase {
+func NewBookUseCase(repo domain.BookRepository, metadataProvider domain.BookMetadataProvider) *BookUseCase {
 	return &BookUseCase{
-		bookRepo: repo,
+		bookRepo:         repo,
+		metadataProvider: metadataProvider,
 	}
 }
 
@@ -20,31 +31,151 @@ func (bc *BookUseCase) SaveByBookID(userID uuid.UUID, book *domain.Book) (*domai
 		return nil, domain.ErrInvalidInput
 	}
 
+	if err := normalizeBookISBN(book); err != nil {
+		return nil, err
+	}
+	if err := bc.checkDuplicateISBN(userID, book.BookISBN); err != nil {
+		return nil, err
+	}
+	if err := normalizeCopyDetails(&book.BookCopyDetails); err != nil {
+		return nil, err
+	}
+	if err := normalizeSeries(&book.BookSeries); err != nil {
+		return nil, err
+	}
+
+	if book.Status == "" {
+		book.Status = domain.BookStatusUnread
+	}
+	if !book.Status.IsValid() {
+		return nil, domain.ErrInvalidBookStatus
+	}
+	// 가져오기처럼 다 읽은 날짜를 알고 있으면 그 날짜로 시작/완료 시간과 변경 기록을 남깁니다.
+	changedAt := time.Now()
+	if book.Status == domain.BookStatusFinished && book.FinishedAt != nil && book.FinishedAt.Before(changedAt) {
+		changedAt = *book.FinishedAt
+	}
+	book.StartedAt, book.FinishedAt = nil, nil
+	applyStatusTimestamps(book, "", changedAt)
+
 	return bc.bookRepo.SaveByBookID(userID, book)
 }
 
-func (bc *BookUseCase) GetBookByID(userID, id uuid.UUID) (*domain.Book, error) {
-	if id == uuid.Nil {
+// SaveByISBN 도서 정보 제공자에서 ISBN으로 조회한 정보로 책을 저장합니다.
+// 클라이언트가 보낸 제목/저자 대신 조회 결과를 사용하여 저장된 정보와 실제 도서가 일치하도록 합니다.
+func (bc *BookUseCase) SaveByISBN(userID uuid.UUID, rawISBN string, status domain.BookStatus) (*domain.Book, error) {
+	if userID == uuid.Nil || strings.TrimSpace(rawISBN) == "" {
 		return nil, domain.ErrInvalidInput
 	}
 
-	return bc.bookRepo.GetBookByID(userID, id)
+	normalized, err := isbn.Normalize(rawISBN)
+	if err != nil {
+		return nil, domain.ErrInvalidISBN
+	}
+
+	// 이미 있는 책이면 도서 정보 제공자를 호출하지 않습니다.
+	if err := bc.checkDuplicateISBN(userID, normalized); err != nil {
+		return nil, err
+	}
+
+	metadata, err := bc.metadataProvider.SearchByISBN(context.Background(), normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	author := metadata.Author
+	if author == "" {
+		author = config.UnknownAuthor
+	}
+
+	series := domain.BookSeries{SeriesName: metadata.SeriesName}
+	if metadata.SeriesVolume > 0 {
+		series.SeriesVolume = &metadata.SeriesVolume
+	}
+
+	return bc.SaveByBookID(userID, &domain.Book{
+		OwnerID:        userID,
+		Title:          metadata.Title,
+		Author:         author,
+		BookISBN:       normalized,
+		ThumbnailURL:   metadata.ThumbnailURL,
+		Publisher:      metadata.Publisher,
+		PublishedDate:  metadata.PublishedDate,
+		Status:         status,
+		BookSeries:     series,
+		MetadataSource: metadata.Source,
+	})
 }
 
-func (bc *BookUseCase) GetBookByISBN(userID uuid.UUID, isbn string) (*domain.Book, error) {
-	if userID == uuid.Nil || isbn == "" {
+// SaveByISBNBatch 여러 ISBN을 한 번에 등록합니다. 일부가 실패해도 나머지는 계속 처리하며,
+// 항목별 결과를 입력 순서대로 반환합니다.
+func (bc *BookUseCase) SaveByISBNBatch(userID uuid.UUID, isbns []string, status domain.BookStatus) ([]*domain.BookISBNResult, error) {
+	if userID == uuid.Nil || len(isbns) == 0 || len(isbns) > config.MaxISBNBatchSize {
 		return nil, domain.ErrInvalidInput
 	}
+	if status != "" && !status.IsValid() {
+		return nil, domain.ErrInvalidBookStatus
+	}
+
+	results := make([]*domain.BookISBNResult, 0, len(isbns))
+	for _, isbn := range isbns {
+		result := &domain.BookISBNResult{ISBN: isbn}
+
+		book, err := bc.SaveByISBN(userID, isbn, status)
+		if err != nil {
+			result.Error = batchItemError(err).Error()
+		} else {
+			result.Success = true
+			result.Book = book
+		}
 
-	return bc.bookRepo.GetBookByISBN(userID, isbn)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// 일괄 처리 결과에는 내부 오류 내용을 노출하지 않고 클라이언트가 구분할 수 있는 오류만 담습니다.
+func batchItemError(err error) error {
+	for _, known := range []error{domain.ErrInvalidInput, domain.ErrInvalidISBN, domain.ErrInvalidBookStatus, domain.ErrDuplicateBook, domain.ErrBookMetadataNotFound, domain.ErrMetadataUnavailable} {
+		if errors.Is(err, known) {
+			return known
+		}
+	}
+
+	logger.Sugar().Errorf("일괄 처리 중 오류가 발생했습니다: %v", err)
+	return domain.ErrInternal
+}
+
+// GetBookByID 내 책이나 내가 참여한 공유 서재의 책을 조회합니다.
+func (bc *BookUseCase) GetBookByID(userID, id uuid.UUID) (*domain.Book, error) {
+	return bc.accessibleBook(userID, id, false)
+}
+
+func (bc *BookUseCase) GetBookByISBN(userID uuid.UUID, rawISBN string) (*domain.Book, error) {
+	if userID == uuid.Nil || rawISBN == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	normalized, err := isbn.Normalize(rawISBN)
+	if err != nil {
+		return nil, domain.ErrInvalidISBN
+	}
+
+	return bc.bookRepo.GetBookByISBN(userID, normalized)
 }
 
-func (bc *BookUseCase) GetAnyBookByISBN(isbn string) (*domain.Book, error) {
-	if isbn == "" {
+func (bc *BookUseCase) GetAnyBookByISBN(rawISBN string) (*domain.Book, error) {
+	if rawISBN == "" {
 		return nil, domain.ErrInvalidInput
 	}
 
-	return bc.bookRepo.GetAnyBookByISBN(isbn)
+	normalized, err := isbn.Normalize(rawISBN)
+	if err != nil {
+		return nil, domain.ErrInvalidISBN
+	}
+
+	return bc.bookRepo.GetAnyBookByISBN(normalized)
 }
 
 func (bc *BookUseCase) GetBooksByUserID(userID uuid.UUID) ([]*domain.Book, error) {
@@ -55,28 +186,429 @@ func (bc *BookUseCase) GetBooksByUserID(userID uuid.UUID) ([]*domain.Book, error
 	return bc.bookRepo.GetBooksByUserID(userID)
 }
 
-func (bc *BookUseCase) GetBooksByUserName(name string) ([]*domain.Book, error) {
-	if len(name) == 0 {
+func (bc *BookUseCase) ListBooksByUserID(userID uuid.UUID, filter *domain.BookListFilter) (*domain.BookPage, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	filter, err := normalizeBookListFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return bc.bookRepo.ListBooksByUserID(userID, filter)
+}
+
+// checkDuplicateISBN 같은 ISBN의 책이 이미 서재에 있으면 기존 책을 가리키는 domain.DuplicateBookError를 반환합니다.
+// 동시에 같은 ISBN의 책을 등록하는 경우는 저장 시 (소유자, 카탈로그 항목) 유니크 인덱스에서 걸러지며,
+// 저장소가 같은 domain.DuplicateBookError를 반환합니다.
+func (bc *BookUseCase) checkDuplicateISBN(userID uuid.UUID, normalized string) error {
+	if normalized == "" {
+		return nil
+	}
+
+	existing, err := bc.bookRepo.GetBookByISBN(userID, normalized)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return &domain.DuplicateBookError{BookID: existing.ID}
+}
+
+// ISBN이 입력된 경우 검증 후 ISBN-13으로 정규화합니다. ISBN 없이 직접 입력한 책도 허용합니다.
+func normalizeBookISBN(book *domain.Book) error {
+	if strings.TrimSpace(book.BookISBN) == "" {
+		book.BookISBN = ""
+		return nil
+	}
+
+	normalized, err := isbn.Normalize(book.BookISBN)
+	if err != nil {
+		return domain.ErrInvalidISBN
+	}
+
+	book.BookISBN = normalized
+	return nil
+}
+
+// 제목과 저자의 앞뒤 공백을 정리하고, 비어 있거나 너무 길면 domain.ErrInvalidInput을 반환합니다.
+func normalizeBookTitleAuthor(book *domain.Book) error {
+	book.Title = strings.TrimSpace(book.Title)
+	book.Author = strings.TrimSpace(book.Author)
+
+	if book.Title == "" || utf8.RuneCountInString(book.Title) > config.MaxBookTitleLength {
+		return domain.ErrInvalidInput
+	}
+	if book.Author == "" || utf8.RuneCountInString(book.Author) > config.MaxBookAuthorLength {
+		return domain.ErrInvalidInput
+	}
+
+	return nil
+}
+
+// 정렬 기준, 방향, 페이지 크기의 기본값을 채우고 유효성을 검사합니다.
+func normalizeBookListFilter(filter *domain.BookListFilter) (*domain.BookListFilter, error) {
+	if filter == nil {
+		filter = &domain.BookListFilter{}
+	}
+
+	switch filter.SortBy {
+	case "":
+		filter.SortBy = domain.BookSortByCreatedAt
+	case domain.BookSortByCreatedAt, domain.BookSortByTitle, domain.BookSortByAuthor:
+	default:
 		return nil, domain.ErrInvalidInput
 	}
 
-	return bc.bookRepo.GetBooksByUserName(name)
+	switch filter.Order {
+	case "":
+		// 등록일은 최신순, 제목/저자는 가나다순을 기본으로 합니다.
+		if filter.SortBy == domain.BookSortByCreatedAt {
+			filter.Order = domain.SortDesc
+		} else {
+			filter.Order = domain.SortAsc
+		}
+	case domain.SortAsc, domain.SortDesc:
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+
+	filter.ISBNPrefix = isbn.Clean(filter.ISBNPrefix)
+	filter.Location = normalizeLocation(filter.Location)
+
+	tags, err := normalizeTagNames(filter.Tags)
+	if err != nil {
+		return nil, err
+	}
+	filter.Tags = tags
+
+	switch filter.TagMatch {
+	case "":
+		filter.TagMatch = domain.TagMatchAny
+	case domain.TagMatchAny, domain.TagMatchAll:
+	default:
+		return nil, domain.ErrInvalidInput
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = config.DefaultPageSize
+	}
+	if filter.Limit > config.MaxPageSize {
+		filter.Limit = config.MaxPageSize
+	}
+
+	return filter, nil
 }
 
-func (bc *BookUseCase) Edit(id uuid.UUID, book *domain.Book) error {
-	if id == uuid.Nil || book == nil {
+// Edit 책 정보를 수정합니다. 책 소유자나 공유 서재의 편집자(editor) 이상만 수정할 수 있으며,
+// ISBN 중복은 수정하는 사람이 아니라 책 소유자의 서재를 기준으로 확인합니다.
+// 기대 버전이 있으면 현재 버전이 그중 하나일 때만 수정하고, 아니면 domain.ErrPreconditionFailed를 반환합니다.
+func (bc *BookUseCase) Edit(userID, id uuid.UUID, book *domain.Book, expected domain.ExpectedVersions) error {
+	if book == nil {
 		return domain.ErrInvalidInput
 	}
 
-	return bc.bookRepo.Edit(id, book)
+	if err := normalizeBookTitleAuthor(book); err != nil {
+		return err
+	}
+	if err := normalizeBookISBN(book); err != nil {
+		return err
+	}
+	if err := normalizeSeries(&book.BookSeries); err != nil {
+		return err
+	}
+
+	current, err := bc.accessibleBook(userID, id, true)
+	if err != nil {
+		return err
+	}
+	book.OwnerID = current.OwnerID
+
+	if book.BookISBN != current.BookISBN {
+		if err := bc.checkDuplicateISBN(book.OwnerID, book.BookISBN); err != nil {
+			return err
+		}
+	}
+
+	if err := prepareStatusChange(current, book); err != nil {
+		return err
+	}
+
+	return bc.bookRepo.Edit(id, book, expected)
+}
+
+// ChangeStatus 책의 읽기 상태만 변경합니다. 같은 상태로 변경하면 아무것도 바꾸지 않습니다.
+func (bc *BookUseCase) ChangeStatus(userID, id uuid.UUID, status domain.BookStatus, expected domain.ExpectedVersions) (*domain.Book, error) {
+	if userID == uuid.Nil || id == uuid.Nil || status == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	current, err := bc.accessibleBook(userID, id, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !expected.Matches(current.Version) {
+		return nil, domain.ErrPreconditionFailed
+	}
+	if current.Status == status {
+		return current, nil
+	}
+
+	updated := *current
+	updated.Status = status
+	if err := prepareStatusChange(current, &updated); err != nil {
+		return nil, err
+	}
+
+	if err := bc.bookRepo.UpdateStatus(id, &updated, expected); err != nil {
+		return nil, err
+	}
+
+	return bc.accessibleBook(userID, id, false)
 }
 
-func (bc *BookUseCase) DeleteByID(userID, id uuid.UUID) error {
+func (bc *BookUseCase) GetStatusHistory(userID, id uuid.UUID) ([]*domain.BookStatusHistory, error) {
 	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := bc.accessibleBook(userID, id, false); err != nil {
+		return nil, err
+	}
+
+	return bc.bookRepo.GetStatusHistory(id)
+}
+
+// 변경할 읽기 상태를 검증하고 시작/완료 시간을 채웁니다. 상태가 비어 있으면 현재 상태를 유지합니다.
+func prepareStatusChange(current, book *domain.Book) error {
+	book.StartedAt, book.FinishedAt = current.StartedAt, current.FinishedAt
+
+	if book.Status == "" || book.Status == current.Status {
+		book.Status = current.Status
+		return nil
+	}
+
+	if !book.Status.IsValid() {
+		return domain.ErrInvalidBookStatus
+	}
+	if !current.Status.CanTransitionTo(book.Status) {
+		return domain.ErrInvalidStatusTransition
+	}
+
+	applyStatusTimestamps(book, current.Status, time.Now())
+	return nil
+}
+
+// 새 읽기 상태에 맞춰 시작/완료 시간을 갱신합니다.
+// 다 읽은 책을 다시 읽으면 시작 시간을 새로 기록하고, 읽지 않음으로 되돌리면 두 시간을 모두 지웁니다.
+func applyStatusTimestamps(book *domain.Book, from domain.BookStatus, now time.Time) {
+	switch book.Status {
+	case domain.BookStatusReading:
+		if book.StartedAt == nil || from == domain.BookStatusFinished {
+			book.StartedAt = &now
+		}
+		book.FinishedAt = nil
+	case domain.BookStatusFinished:
+		if book.StartedAt == nil {
+			book.StartedAt = &now
+		}
+		book.FinishedAt = &now
+	case domain.BookStatusUnread:
+		book.StartedAt, book.FinishedAt = nil, nil
+	}
+}
+
+// DeleteByID 책을 휴지통으로 옮깁니다. 공유 서재의 편집자가 삭제한 책은 소유자의 휴지통으로 갑니다.
+func (bc *BookUseCase) DeleteByID(userID, id uuid.UUID, expected domain.ExpectedVersions) error {
+	book, err := bc.accessibleBook(userID, id, true)
+	if err != nil {
+		return err
+	}
+
+	return bc.bookRepo.DeleteByID(book.OwnerID, id, expected)
+}
+
+// BulkTagBooks 여러 책에 태그를 한 번에 붙이거나 뗍니다. 모든 책을 수정할 수 있어야 합니다.
+func (bc *BookUseCase) BulkTagBooks(userID uuid.UUID, req *domain.BulkTagRequest) error {
+	if userID == uuid.Nil || req == nil {
+		return domain.ErrInvalidInput
+	}
+
+	bookIDs := uniqueIDs(req.BookIDs)
+	if len(bookIDs) == 0 || len(bookIDs) > config.MaxBulkTagBooks {
 		return domain.ErrInvalidInput
 	}
 
-	return bc.bookRepo.DeleteByID(userID, id)
+	add, err := normalizeTagNames(req.Add)
+	if err != nil {
+		return err
+	}
+	remove, err := normalizeTagNames(req.Remove)
+	if err != nil {
+		return err
+	}
+	if len(add)+len(remove) == 0 {
+		return domain.ErrInvalidInput
+	}
+
+	// 다른 책 수정과 같이 책 소유자이거나 공유 서재의 편집자(editor) 이상이어야 합니다.
+	for _, id := range bookIDs {
+		if _, err := bc.accessibleBook(userID, id, true); err != nil {
+			return err
+		}
+	}
+
+	if len(add) > 0 {
+		if err := bc.bookRepo.AddTags(userID, bookIDs, add); err != nil {
+			return err
+		}
+	}
+	if len(remove) > 0 {
+		if err := bc.bookRepo.RemoveTags(userID, bookIDs, remove); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SuggestTags 입력 중인 태그의 자동 완성 후보를 많이 사용한 순서로 반환합니다.
+func (bc *BookUseCase) SuggestTags(userID uuid.UUID, prefix string, limit int) ([]*domain.Tag, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if limit <= 0 {
+		limit = config.DefaultTagSuggestion
+	}
+	if limit > config.MaxPageSize {
+		limit = config.MaxPageSize
+	}
+
+	// 자동 완성 중에는 빈 접두사(전체 목록)도 허용합니다.
+	if strings.TrimSpace(prefix) != "" {
+		normalized, err := domain.NormalizeTagName(prefix, config.MaxTagLength)
+		if err != nil {
+			return nil, err
+		}
+		prefix = normalized
+	}
+
+	tags, err := bc.bookRepo.GetTagsByUserID(userID, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) > limit {
+		tags = tags[:limit]
+	}
+
+	return tags, nil
+}
+
+func (bc *BookUseCase) GetDuplicateBooks(userID uuid.UUID) ([]*domain.DuplicateBookGroup, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return bc.bookRepo.GetDuplicateBooks(userID)
+}
+
+// MergeBooks 같은 ISBN으로 중복 등록된 책들을 대상 책 하나로 합치고 합친 책을 반환합니다.
+// 원본 책은 모두 사용자의 책이고 대상 책과 ISBN이 같아야 합니다. 읽기 상태는 대상 책을 따르고,
+// 현재 페이지는 가장 많이 읽은 책을, 전체 페이지 수는 대상 책에 없을 때 원본 책의 값을 사용합니다.
+func (bc *BookUseCase) MergeBooks(userID, targetID uuid.UUID, req *domain.MergeBooksRequest) (*domain.Book, error) {
+	if userID == uuid.Nil || targetID == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	sourceIDs := uniqueIDs(req.SourceIDs)
+	if len(sourceIDs) == 0 || len(sourceIDs) > config.MaxMergeBooks {
+		return nil, domain.ErrInvalidInput
+	}
+
+	target, err := bc.accessibleBook(userID, targetID, true)
+	if err != nil {
+		return nil, err
+	}
+	if target.BookISBN == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	currentPage, totalPages := target.CurrentPage, target.TotalPages
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return nil, domain.ErrInvalidInput
+		}
+
+		source, err := bc.accessibleBook(userID, id, true)
+		if err != nil {
+			return nil, err
+		}
+		// 중복은 소유자의 서재 안에서만 생기므로 소유자가 다른 책은 합칠 수 없습니다.
+		if source.OwnerID != target.OwnerID || source.BookISBN != target.BookISBN {
+			return nil, domain.ErrInvalidInput
+		}
+
+		currentPage = max(currentPage, source.CurrentPage)
+		if totalPages == 0 {
+			totalPages = source.TotalPages
+		}
+	}
+	if totalPages > 0 {
+		currentPage = min(currentPage, totalPages)
+	}
+
+	if err := bc.bookRepo.MergeBooks(targetID, sourceIDs); err != nil {
+		return nil, err
+	}
+
+	if currentPage != target.CurrentPage || totalPages != target.TotalPages {
+		if err := bc.bookRepo.UpdateProgress(targetID, currentPage, totalPages); err != nil {
+			return nil, err
+		}
+	}
+
+	return bc.accessibleBook(userID, targetID, false)
+}
+
+// 태그 이름을 정규화하고 중복을 제거합니다. 한 번에 처리할 수 있는 태그 수를 넘으면 오류를 반환합니다.
+func normalizeTagNames(names []string) ([]string, error) {
+	if len(names) > config.MaxTagsPerRequest {
+		return nil, domain.ErrInvalidInput
+	}
+
+	seen := make(map[string]bool, len(names))
+	result := make([]string, 0, len(names))
+	for _, name := range names {
+		normalized, err := domain.NormalizeTagName(name, config.MaxTagLength)
+		if err != nil {
+			return nil, err
+		}
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		result = append(result, normalized)
+	}
+
+	return result, nil
+}
+
+func uniqueIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if id == uuid.Nil || seen[id] {
+			continue
+		}
+		seen[id] = true
+		result = append(result, id)
+	}
+	return result
 }
 
 func (bc *BookUseCase) AddBookmarkByBookID(userID, bookID uuid.UUID) (*domain.Bookmark, error) {
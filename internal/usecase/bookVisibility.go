@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// UpdateVisibility 책이 공개 프로필에 보이는지 정합니다. 공유 서재의 구성원이 아니라 책 소유자만 바꿀 수 있습니다.
+func (bc *BookUseCase) UpdateVisibility(userID, id uuid.UUID, visibility domain.BookVisibility, expected domain.ExpectedVersions) (*domain.Book, error) {
+	if !visibility.IsValid() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	current, err := bc.accessibleBook(userID, id, false)
+	if err != nil {
+		return nil, err
+	}
+	if current.OwnerID != userID {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	if !expected.Matches(current.Version) {
+		return nil, domain.ErrPreconditionFailed
+	}
+	if current.Visibility == visibility {
+		return current, nil
+	}
+
+	if err := bc.bookRepo.UpdateVisibility(id, visibility, expected); err != nil {
+		return nil, err
+	}
+
+	return bc.bookRepo.GetBookByID(userID, id)
+}
@@ -0,0 +1,330 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/google/uuid"
+)
+
+// BorrowRequestUseCase 서비스 사용자끼리 책을 빌려달라고 요청하고 주고받는 과정을 관리합니다.
+// 요청 → 수락/거절 → 건네줌 → 반납 순서로 진행되며, 상태가 바뀔 때마다 상대방에게 알림을 보냅니다.
+type BorrowRequestUseCase struct {
+	requestRepo domain.BorrowRequestRepository
+	bookRepo    domain.BookRepository
+	userRepo    domain.UserRepository
+	loanUseCase domain.LoanUseCase
+	notifier    domain.PushNotifier
+}
+
+// notifier가 nil이면 알림을 보내지 않습니다.
+func NewBorrowRequestUseCase(requestRepo domain.BorrowRequestRepository, bookRepo domain.BookRepository, userRepo domain.UserRepository, loanUseCase domain.LoanUseCase, notifier domain.PushNotifier) *BorrowRequestUseCase {
+	return &BorrowRequestUseCase{
+		requestRepo: requestRepo,
+		bookRepo:    bookRepo,
+		userRepo:    userRepo,
+		loanUseCase: loanUseCase,
+		notifier:    notifier,
+	}
+}
+
+// CreateRequest 다른 사용자의 책에 대여 요청을 보냅니다. 서재를 공개한 사용자의 책 중 공개 프로필에 보이는 책에만 요청할 수 있습니다.
+func (uc *BorrowRequestUseCase) CreateRequest(userID uuid.UUID, req *domain.CreateBorrowRequest) (*domain.BorrowRequest, error) {
+	if userID == uuid.Nil || req == nil || req.BookID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	message := strings.TrimSpace(req.Message)
+	if utf8.RuneCountInString(message) > config.MaxBorrowMessageLength {
+		return nil, domain.ErrInvalidInput
+	}
+	if req.DueAt != nil && req.DueAt.Before(time.Now()) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	b, err := uc.bookRepo.GetVisibleBookByID(req.BookID)
+	if err != nil {
+		return nil, err
+	}
+	if b.OwnerID == userID {
+		return nil, domain.ErrInvalidInput
+	}
+
+	owner, err := uc.userRepo.GetByID(b.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	if !owner.IsPublished {
+		return nil, domain.ErrPrivateAccount
+	}
+
+	exists, err := uc.requestRepo.HasOpenRequest(userID, b.ID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, domain.ErrBorrowRequestExists
+	}
+
+	created, err := uc.requestRepo.Create(userID, b.OwnerID, b.ID, &domain.BorrowRequest{
+		Message: message,
+		DueAt:   req.DueAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notify(created.OwnerID, fmt.Sprintf("%s님이 「%s」을(를) 빌려달라고 요청했어요.", created.RequesterNickname, created.BookTitle))
+	return created, nil
+}
+
+// GetRequests 내가 보낸 요청(sent) 또는 받은 요청(received)을 조회합니다. 기본값은 받은 요청입니다.
+func (uc *BorrowRequestUseCase) GetRequests(userID uuid.UUID, filter *domain.BorrowRequestFilter) ([]*domain.BorrowRequest, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if filter == nil {
+		filter = &domain.BorrowRequestFilter{}
+	}
+	if filter.Role == "" {
+		filter.Role = domain.BorrowRequestRoleReceived
+	}
+	if filter.Role != domain.BorrowRequestRoleSent && filter.Role != domain.BorrowRequestRoleReceived {
+		return nil, domain.ErrInvalidInput
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.requestRepo.GetByUserID(userID, filter)
+}
+
+func (uc *BorrowRequestUseCase) GetRequest(userID, id uuid.UUID) (*domain.BorrowRequest, error) {
+	return uc.participatingRequest(userID, id)
+}
+
+// Accept 책 주인이 요청을 수락합니다. 반납 예정일을 함께 보내면 요청한 날짜 대신 사용합니다.
+func (uc *BorrowRequestUseCase) Accept(userID, id uuid.UUID, req *domain.RespondBorrowRequest) (*domain.BorrowRequest, error) {
+	br, err := uc.ownerRequest(userID, id, domain.BorrowRequestAccepted)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := responseMessage(req)
+	if err != nil {
+		return nil, err
+	}
+	if req != nil && req.DueAt != nil {
+		if req.DueAt.Before(time.Now()) {
+			return nil, domain.ErrInvalidInput
+		}
+		br.DueAt = req.DueAt
+	}
+
+	now := time.Now()
+	from := br.Status
+	br.Status = domain.BorrowRequestAccepted
+	br.ResponseMessage = message
+	br.RespondedAt = &now
+
+	updated, err := uc.requestRepo.Update(br, from)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notify(updated.RequesterID, fmt.Sprintf("%s님이 「%s」 대여 요청을 수락했어요.", updated.OwnerNickname, updated.BookTitle))
+	return updated, nil
+}
+
+// Decline 책 주인이 요청을 거절합니다.
+func (uc *BorrowRequestUseCase) Decline(userID, id uuid.UUID, req *domain.RespondBorrowRequest) (*domain.BorrowRequest, error) {
+	br, err := uc.ownerRequest(userID, id, domain.BorrowRequestDeclined)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := responseMessage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from := br.Status
+	br.Status = domain.BorrowRequestDeclined
+	br.ResponseMessage = message
+	br.RespondedAt = &now
+
+	updated, err := uc.requestRepo.Update(br, from)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notify(updated.RequesterID, fmt.Sprintf("%s님이 「%s」 대여 요청을 거절했어요.", updated.OwnerNickname, updated.BookTitle))
+	return updated, nil
+}
+
+// Cancel 책을 건네주기 전이라면 요청한 사람과 책 주인 모두 요청을 취소할 수 있습니다.
+func (uc *BorrowRequestUseCase) Cancel(userID, id uuid.UUID) (*domain.BorrowRequest, error) {
+	br, err := uc.participatingRequest(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !br.Status.CanTransitionTo(domain.BorrowRequestCanceled) {
+		return nil, domain.ErrInvalidRequestState
+	}
+
+	from := br.Status
+	br.Status = domain.BorrowRequestCanceled
+
+	updated, err := uc.requestRepo.Update(br, from)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID == updated.RequesterID {
+		uc.notify(updated.OwnerID, fmt.Sprintf("%s님이 「%s」 대여 요청을 취소했어요.", updated.RequesterNickname, updated.BookTitle))
+	} else {
+		uc.notify(updated.RequesterID, fmt.Sprintf("%s님이 「%s」 대여를 취소했어요.", updated.OwnerNickname, updated.BookTitle))
+	}
+	return updated, nil
+}
+
+// HandOver 책 주인이 책을 건네준 것으로 기록합니다. 책 주인의 대여 기록도 함께 만들어집니다.
+func (uc *BorrowRequestUseCase) HandOver(userID, id uuid.UUID) (*domain.BorrowRequest, error) {
+	br, err := uc.ownerRequest(userID, id, domain.BorrowRequestHandedOver)
+	if err != nil {
+		return nil, err
+	}
+
+	// 이미 다른 사람에게 빌려준 책이면 domain.ErrBookAlreadyLent를 반환합니다.
+	loan, err := uc.loanUseCase.LendBook(br.OwnerID, br.BookID, &domain.LoanRequest{
+		BorrowerName: br.RequesterNickname,
+		DueAt:        br.DueAt,
+		Notes:        br.Message,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	from := br.Status
+	br.Status = domain.BorrowRequestHandedOver
+	br.HandedOverAt = &now
+	br.LoanID = &loan.ID
+
+	updated, err := uc.requestRepo.Update(br, from)
+	if err != nil {
+		// 그사이 요청이 취소되었으면 방금 만든 대여 기록을 되돌립니다.
+		if errors.Is(err, domain.ErrInvalidRequestState) {
+			if derr := uc.loanUseCase.DeleteLoan(br.OwnerID, loan.ID); derr != nil {
+				logger.Sugar().Errorf("취소된 대여 요청의 대여 기록을 삭제하지 못했습니다. 대여ID: %s, %v", loan.ID.String(), derr)
+			}
+		}
+		return nil, err
+	}
+
+	uc.notify(updated.RequesterID, fmt.Sprintf("%s님에게 「%s」을(를) 받았어요. 즐거운 독서 되세요!", updated.OwnerNickname, updated.BookTitle))
+	return updated, nil
+}
+
+// MarkReturned 책 주인이 책을 돌려받은 것으로 기록합니다. 연결된 대여 기록도 반납 처리합니다.
+func (uc *BorrowRequestUseCase) MarkReturned(userID, id uuid.UUID) (*domain.BorrowRequest, error) {
+	br, err := uc.ownerRequest(userID, id, domain.BorrowRequestReturned)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	// 대여 기록에서 먼저 반납 처리했거나 기록을 지웠을 수 있습니다.
+	if br.LoanID != nil {
+		_, err := uc.loanUseCase.ReturnLoan(br.OwnerID, *br.LoanID, &domain.ReturnLoanRequest{ReturnedAt: &now})
+		if err != nil && !errors.Is(err, domain.ErrLoanAlreadyReturned) && !errors.Is(err, domain.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	from := br.Status
+	br.Status = domain.BorrowRequestReturned
+	br.ReturnedAt = &now
+
+	updated, err := uc.requestRepo.Update(br, from)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notify(updated.RequesterID, fmt.Sprintf("%s님이 「%s」 반납을 확인했어요.", updated.OwnerNickname, updated.BookTitle))
+	return updated, nil
+}
+
+// 요청한 사람이나 책 주인이 아니면 존재 여부를 드러내지 않도록 domain.ErrNotFound를 반환합니다.
+func (uc *BorrowRequestUseCase) participatingRequest(userID, id uuid.UUID) (*domain.BorrowRequest, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	br, err := uc.requestRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if br.RequesterID != userID && br.OwnerID != userID {
+		return nil, domain.ErrNotFound
+	}
+
+	return br, nil
+}
+
+// 책 주인만 처리할 수 있는 상태 변경을 확인합니다.
+func (uc *BorrowRequestUseCase) ownerRequest(userID, id uuid.UUID, next domain.BorrowRequestStatus) (*domain.BorrowRequest, error) {
+	br, err := uc.participatingRequest(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if br.OwnerID != userID {
+		return nil, domain.ErrPermissionDenied
+	}
+	if !br.Status.CanTransitionTo(next) {
+		return nil, domain.ErrInvalidRequestState
+	}
+
+	return br, nil
+}
+
+// 알림 전송에 실패해도 요청 처리는 계속합니다.
+func (uc *BorrowRequestUseCase) notify(userID uuid.UUID, body string) {
+	if uc.notifier == nil {
+		return
+	}
+
+	u, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		logger.Sugar().Errorf("대여 요청 알림 대상 사용자를 조회하지 못했습니다. 사용자ID: %s, %v", userID.String(), err)
+		return
+	}
+	if u.FCMToken == "" {
+		return
+	}
+
+	if err := uc.notifier.SendPush(context.Background(), u.FCMToken, "나만의 서재", body); err != nil {
+		logger.Sugar().Errorf("대여 요청 알림을 보내지 못했습니다. 사용자ID: %s, %v", userID.String(), err)
+	}
+}
+
+func responseMessage(req *domain.RespondBorrowRequest) (string, error) {
+	if req == nil {
+		return "", nil
+	}
+
+	message := strings.TrimSpace(req.Message)
+	if utf8.RuneCountInString(message) > config.MaxBorrowMessageLength {
+		return "", domain.ErrInvalidInput
+	}
+	return message, nil
+}
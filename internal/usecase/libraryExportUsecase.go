@@ -0,0 +1,313 @@
+package usecase
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type LibraryExportUseCase struct {
+	bookRepo     domain.BookRepository
+	reviewRepo   domain.ReviewRepository
+	reminderRepo domain.ReadingReminderRepository
+}
+
+func NewLibraryExportUseCase(bookRepo domain.BookRepository, reviewRepo domain.ReviewRepository, reminderRepo domain.ReadingReminderRepository) *LibraryExportUseCase {
+	return &LibraryExportUseCase{
+		bookRepo:     bookRepo,
+		reviewRepo:   reviewRepo,
+		reminderRepo: reminderRepo,
+	}
+}
+
+// 내보내기 CSV의 열 순서
+var exportCSVHeader = []string{
+	"id", "title", "author", "isbn", "publisher", "published_date", "status", "started_at", "finished_at",
+	"current_page", "total_pages", "tags", "bookmarked", "rating", "review",
+	"location_room", "location_bookcase", "location_shelf", "condition", "format", "purchased_at", "price", "currency", "acquired_from",
+	"created_at", "updated_at",
+}
+
+// Goodreads "Export Library" 파일과 같은 열 순서입니다. Goodreads 가져오기와 이 서비스의 가져오기 모두 읽을 수 있습니다.
+var goodreadsExportHeader = []string{
+	"Book Id", "Title", "Author", "Author l-f", "Additional Authors", "ISBN", "ISBN13", "My Rating",
+	"Average Rating", "Publisher", "Binding", "Number of Pages", "Year Published", "Original Publication Year",
+	"Date Read", "Date Added", "Bookshelves", "Bookshelves with positions", "Exclusive Shelf", "My Review",
+	"Spoiler", "Private Notes", "Read Count", "Owned Copies",
+}
+
+// 읽기 상태를 Goodreads 책장 이름으로 변환합니다. 가져오기의 goodreadsShelfStatuses와 짝을 이룹니다.
+var goodreadsExportShelves = map[domain.BookStatus]string{
+	domain.BookStatusUnread:    "to-read",
+	domain.BookStatusReading:   "currently-reading",
+	domain.BookStatusFinished:  "read",
+	domain.BookStatusPaused:    "paused",
+	domain.BookStatusAbandoned: "dnf",
+}
+
+// Export 형식이 잘못되었으면 아무것도 기록하지 않고 domain.ErrInvalidInput을 반환합니다.
+// 기록을 시작한 뒤 발생한 오류는 이미 보낸 내용을 되돌릴 수 없으므로 호출자가 기록만 합니다.
+func (uc *LibraryExportUseCase) Export(userID uuid.UUID, format domain.ExportFormat, w io.Writer) error {
+	if userID == uuid.Nil || !format.IsValid() {
+		return domain.ErrInvalidInput
+	}
+
+	// 리뷰와 북마크는 책보다 훨씬 적으므로 미리 조회해 책 행에 합칩니다.
+	reviews, err := uc.reviewRepo.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+	bookmarks, err := uc.bookRepo.GetBookmarksByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	switch format {
+	case domain.ExportFormatJSON:
+		return uc.exportJSON(userID, reviews, bookmarks, w)
+	case domain.ExportFormatCSV:
+		return uc.exportCSV(userID, reviews, bookmarks, w)
+	default:
+		return uc.exportGoodreads(userID, reviews, w)
+	}
+}
+
+// exportJSON 책 목록을 페이지 단위로 기록하는 JSON 문서를 만듭니다.
+func (uc *LibraryExportUseCase) exportJSON(userID uuid.UUID, reviews []*domain.Review, bookmarks []*domain.Bookmark, w io.Writer) error {
+	reminders, err := uc.reminderRepo.GetByUserID(userID)
+	if err != nil {
+		return err
+	}
+
+	header, err := json.Marshal(struct {
+		UserID     uuid.UUID `json:"user_id"`
+		ExportedAt time.Time `json:"exported_at"`
+	}{userID, time.Now()})
+	if err != nil {
+		return err
+	}
+
+	// 헤더 객체의 닫는 괄호를 떼고 books 배열을 이어 씁니다.
+	if _, err := fmt.Fprintf(w, `%s,"books":[`, header[:len(header)-1]); err != nil {
+		return err
+	}
+
+	first := true
+	err = uc.eachBookPage(userID, func(b *domain.Book) error {
+		data, err := json.Marshal(b)
+		if err != nil {
+			return err
+		}
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		first = false
+		_, err = w.Write(data)
+		return err
+	}, func() error {
+		return flushWriter(w)
+	})
+	if err != nil {
+		return err
+	}
+
+	rest, err := json.Marshal(struct {
+		Bookmarks []*domain.Bookmark        `json:"bookmarks"`
+		Reviews   []*domain.Review          `json:"reviews"`
+		Reminders []*domain.ReadingReminder `json:"reminders"`
+	}{nonNil(bookmarks), nonNil(reviews), nonNil(reminders)})
+	if err != nil {
+		return err
+	}
+
+	// 나머지 객체의 여는 괄호를 떼고 books 배열 뒤에 붙입니다.
+	if _, err := fmt.Fprintf(w, "],%s", rest[1:]); err != nil {
+		return err
+	}
+	return flushWriter(w)
+}
+
+func (uc *LibraryExportUseCase) exportCSV(userID uuid.UUID, reviews []*domain.Review, bookmarks []*domain.Bookmark, w io.Writer) error {
+	reviewByISBN := reviewsByISBN(reviews)
+	bookmarked := make(map[uuid.UUID]bool, len(bookmarks))
+	for _, bm := range bookmarks {
+		bookmarked[bm.BookID] = true
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportCSVHeader); err != nil {
+		return err
+	}
+
+	err := uc.eachBookPage(userID, func(b *domain.Book) error {
+		var rating, content string
+		if r, ok := reviewByISBN[b.BookISBN]; ok && b.BookISBN != "" {
+			rating, content = strconv.Itoa(r.Rating), r.Content
+		}
+
+		return writer.Write([]string{
+			b.ID.String(), b.Title, b.Author, b.BookISBN, b.Publisher, b.PublishedDate, string(b.Status),
+			formatExportTime(b.StartedAt, time.RFC3339), formatExportTime(b.FinishedAt, time.RFC3339),
+			strconv.Itoa(b.CurrentPage), strconv.Itoa(b.TotalPages), strings.Join(b.Tags, ", "),
+			strconv.FormatBool(bookmarked[b.ID]), rating, content,
+			b.Room, b.Bookcase, b.Shelf, string(b.Condition), string(b.Format),
+			formatExportTime(b.PurchasedAt, "2006-01-02"), formatExportPrice(b.Price), b.Currency, b.AcquiredFrom,
+			b.CreatedAt.Format(time.RFC3339), b.UpdatedAt.Format(time.RFC3339),
+		})
+	}, func() error {
+		return flushCSV(writer, w)
+	})
+	if err != nil {
+		return err
+	}
+
+	return flushCSV(writer, w)
+}
+
+func (uc *LibraryExportUseCase) exportGoodreads(userID uuid.UUID, reviews []*domain.Review, w io.Writer) error {
+	reviewByISBN := reviewsByISBN(reviews)
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(goodreadsExportHeader); err != nil {
+		return err
+	}
+
+	err := uc.eachBookPage(userID, func(b *domain.Book) error {
+		rating, content := "0", ""
+		if r, ok := reviewByISBN[b.BookISBN]; ok && b.BookISBN != "" {
+			rating, content = strconv.Itoa(r.Rating), r.Content
+		}
+
+		pages := ""
+		if b.TotalPages > 0 {
+			pages = strconv.Itoa(b.TotalPages)
+		}
+		readCount := "0"
+		if b.Status == domain.BookStatusFinished {
+			readCount = "1"
+		}
+
+		return writer.Write([]string{
+			b.ID.String(), b.Title, b.Author, authorLastFirst(b.Author), "",
+			`=""`, fmt.Sprintf(`="%s"`, b.BookISBN), rating,
+			"", b.Publisher, "", pages, publishedYear(b.PublishedDate), "",
+			formatExportTime(b.FinishedAt, "2006/01/02"), b.CreatedAt.Format("2006/01/02"),
+			strings.Join(b.Tags, ", "), "", goodreadsExportShelves[b.Status], content,
+			"", "", readCount, "1",
+		})
+	}, func() error {
+		return flushCSV(writer, w)
+	})
+	if err != nil {
+		return err
+	}
+
+	return flushCSV(writer, w)
+}
+
+// flushWriter 버퍼를 사용하는 Writer면 비워서 기록한 내용이 바로 전송되도록 합니다.
+func flushWriter(w io.Writer) error {
+	if f, ok := w.(interface{ Flush() error }); ok {
+		return f.Flush()
+	}
+	return nil
+}
+
+// eachBookPage 책을 등록순으로 한 페이지씩 조회해 fn을 호출하고, 페이지마다 flush를 호출합니다.
+func (uc *LibraryExportUseCase) eachBookPage(userID uuid.UUID, fn func(*domain.Book) error, flush func() error) error {
+	filter := &domain.BookListFilter{
+		SortBy: domain.BookSortByCreatedAt,
+		Order:  domain.SortAsc,
+		Limit:  config.ExportPageSize,
+	}
+
+	for {
+		page, err := uc.bookRepo.ListBooksByUserID(userID, filter)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range page.Items {
+			if err := fn(b); err != nil {
+				return err
+			}
+		}
+
+		if err := flush(); err != nil {
+			return err
+		}
+
+		if !page.HasMore {
+			return nil
+		}
+		filter.Cursor = page.NextCursor
+	}
+}
+
+func flushCSV(writer *csv.Writer, w io.Writer) error {
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	return flushWriter(w)
+}
+
+// 같은 ISBN에 리뷰는 하나만 작성할 수 있습니다.
+func reviewsByISBN(reviews []*domain.Review) map[string]*domain.Review {
+	result := make(map[string]*domain.Review, len(reviews))
+	for _, r := range reviews {
+		result[r.BookISBN] = r
+	}
+	return result
+}
+
+func formatExportTime(t *time.Time, layout string) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(layout)
+}
+
+func formatExportPrice(price *float64) string {
+	if price == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*price, 'f', -1, 64)
+}
+
+// "이름 성" 형태의 저자명을 Goodreads의 "성, 이름" 형태로 바꿉니다. 한 단어면 그대로 둡니다.
+func authorLastFirst(author string) string {
+	i := strings.LastIndex(author, " ")
+	if i < 0 {
+		return author
+	}
+	return author[i+1:] + ", " + author[:i]
+}
+
+// 출판일 문자열(2024, 2024-05, 2024-05-01 등)에서 연도만 꺼냅니다.
+func publishedYear(date string) string {
+	if len(date) >= 4 {
+		if _, err := strconv.Atoi(date[:4]); err == nil {
+			return date[:4]
+		}
+	}
+	return ""
+}
+
+// JSON에서 빈 목록을 null 대신 []로 기록합니다.
+func nonNil[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
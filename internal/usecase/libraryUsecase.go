@@ -0,0 +1,405 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/google/uuid"
+)
+
+// LibraryUseCase 여러 사용자가 한 실물 서재를 함께 쓰는 공유 서재를 관리합니다.
+// 책의 소유자는 바뀌지 않으며, 소유자가 책을 공유 서재에 넣으면 구성원이 역할에 따라 보거나 수정할 수 있습니다.
+type LibraryUseCase struct {
+	libraryRepo domain.LibraryRepository
+	bookRepo    domain.BookRepository
+	userRepo    domain.UserRepository
+	notifier    domain.PushNotifier
+}
+
+// notifier가 nil이면 알림을 보내지 않습니다.
+func NewLibraryUseCase(libraryRepo domain.LibraryRepository, bookRepo domain.BookRepository, userRepo domain.UserRepository, notifier domain.PushNotifier) *LibraryUseCase {
+	return &LibraryUseCase{
+		libraryRepo: libraryRepo,
+		bookRepo:    bookRepo,
+		userRepo:    userRepo,
+		notifier:    notifier,
+	}
+}
+
+// CreateLibrary 공유 서재를 만듭니다. 만든 사람은 관리자(owner)가 됩니다.
+func (uc *LibraryUseCase) CreateLibrary(userID uuid.UUID, req *domain.CreateLibraryRequest) (*domain.Library, error) {
+	if userID == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	name, err := normalizeLibraryName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.libraryRepo.Create(userID, name)
+}
+
+func (uc *LibraryUseCase) GetLibraries(userID uuid.UUID) ([]*domain.Library, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.libraryRepo.GetByUserID(userID)
+}
+
+// GetLibrary 구성원만 서재와 구성원 목록을 볼 수 있습니다.
+func (uc *LibraryUseCase) GetLibrary(userID, id uuid.UUID) (*domain.Library, error) {
+	if _, err := uc.memberRole(userID, id); err != nil {
+		return nil, err
+	}
+
+	return uc.libraryRepo.GetByID(userID, id)
+}
+
+func (uc *LibraryUseCase) UpdateLibrary(userID, id uuid.UUID, req *domain.UpdateLibraryRequest) (*domain.Library, error) {
+	if req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	name, err := normalizeLibraryName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.requireManager(userID, id); err != nil {
+		return nil, err
+	}
+
+	if err := uc.libraryRepo.UpdateName(id, name); err != nil {
+		return nil, err
+	}
+
+	return uc.libraryRepo.GetByID(userID, id)
+}
+
+// DeleteLibrary 관리자만 서재를 삭제할 수 있습니다. 서재에 있던 책은 각 소유자의 개인 서재로 돌아갑니다.
+func (uc *LibraryUseCase) DeleteLibrary(userID, id uuid.UUID) error {
+	if err := uc.requireManager(userID, id); err != nil {
+		return err
+	}
+
+	return uc.libraryRepo.Delete(id)
+}
+
+// GetLibraryBooks 서재에 있는 모든 구성원의 책을 조회합니다.
+func (uc *LibraryUseCase) GetLibraryBooks(userID, id uuid.UUID) ([]*domain.Book, error) {
+	if _, err := uc.memberRole(userID, id); err != nil {
+		return nil, err
+	}
+
+	return uc.libraryRepo.GetBooks(id)
+}
+
+// UpdateMemberRole 관리자가 구성원의 역할을 바꿉니다. 마지막 관리자의 역할은 낮출 수 없습니다.
+func (uc *LibraryUseCase) UpdateMemberRole(userID, id, memberID uuid.UUID, req *domain.UpdateLibraryMemberRequest) (*domain.Library, error) {
+	if memberID == uuid.Nil || req == nil || !req.Role.IsValid() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if err := uc.requireManager(userID, id); err != nil {
+		return nil, err
+	}
+
+	current, err := uc.libraryRepo.GetMemberRole(id, memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	if current == req.Role {
+		return uc.libraryRepo.GetByID(userID, id)
+	}
+
+	if current == domain.LibraryRoleOwner {
+		if err := uc.ensureAnotherOwner(id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.libraryRepo.UpdateMemberRole(id, memberID, req.Role); err != nil {
+		return nil, err
+	}
+
+	return uc.libraryRepo.GetByID(userID, id)
+}
+
+// RemoveMember 관리자가 구성원을 내보내거나, 구성원이 스스로 서재를 나갑니다.
+// 나간 사람이 서재에 넣어 둔 책은 그 사람의 개인 서재로 돌아가며, 마지막 관리자는 나갈 수 없습니다.
+func (uc *LibraryUseCase) RemoveMember(userID, id, memberID uuid.UUID) error {
+	if memberID == uuid.Nil {
+		return domain.ErrInvalidInput
+	}
+
+	role, err := uc.memberRole(userID, id)
+	if err != nil {
+		return err
+	}
+	if memberID != userID && !role.CanManage() {
+		return domain.ErrPermissionDenied
+	}
+
+	target, err := uc.libraryRepo.GetMemberRole(id, memberID)
+	if err != nil {
+		return err
+	}
+
+	if target == domain.LibraryRoleOwner {
+		if err := uc.ensureAnotherOwner(id); err != nil {
+			return err
+		}
+	}
+
+	return uc.libraryRepo.RemoveMember(id, memberID)
+}
+
+// Invite 관리자가 이메일이나 닉네임으로 사용자를 초대하고 초대받은 사람에게 알림을 보냅니다.
+func (uc *LibraryUseCase) Invite(userID, id uuid.UUID, req *domain.InviteLibraryMemberRequest) (*domain.LibraryInvitation, error) {
+	if req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	email := strings.TrimSpace(req.Email)
+	nickname := strings.TrimSpace(req.NickName)
+	if (email == "") == (nickname == "") {
+		// 이메일과 닉네임 중 정확히 하나만 보내야 합니다.
+		return nil, domain.ErrInvalidInput
+	}
+
+	role := req.Role
+	if role == "" {
+		role = domain.LibraryRoleViewer
+	}
+	if role != domain.LibraryRoleEditor && role != domain.LibraryRoleViewer {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if err := uc.requireManager(userID, id); err != nil {
+		return nil, err
+	}
+
+	inviteeID, err := uc.libraryRepo.FindUserID(email, nickname)
+	if err != nil {
+		return nil, err
+	}
+	if inviteeID == userID {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := uc.libraryRepo.GetMemberRole(id, inviteeID); err == nil {
+		return nil, domain.ErrAlreadyLibraryMember
+	} else if !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	exists, err := uc.libraryRepo.HasPendingInvitation(id, inviteeID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, domain.ErrLibraryInvitationExists
+	}
+
+	created, err := uc.libraryRepo.CreateInvitation(id, userID, inviteeID, role)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notify(inviteeID, fmt.Sprintf("%s님이 '%s' 서재에 초대했습니다.", created.InviterNickname, created.LibraryName))
+
+	return created, nil
+}
+
+// GetLibraryInvitations 관리자가 서재에서 보낸 초대 목록을 조회합니다.
+func (uc *LibraryUseCase) GetLibraryInvitations(userID, id uuid.UUID) ([]*domain.LibraryInvitation, error) {
+	if err := uc.requireManager(userID, id); err != nil {
+		return nil, err
+	}
+
+	return uc.libraryRepo.GetLibraryInvitations(id)
+}
+
+// CancelInvitation 관리자가 아직 응답하지 않은 초대를 취소합니다.
+func (uc *LibraryUseCase) CancelInvitation(userID, id, invitationID uuid.UUID) (*domain.LibraryInvitation, error) {
+	if invitationID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if err := uc.requireManager(userID, id); err != nil {
+		return nil, err
+	}
+
+	invitation, err := uc.libraryRepo.GetInvitation(invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.LibraryID != id {
+		return nil, domain.ErrNotFound
+	}
+
+	return uc.libraryRepo.UpdateInvitationStatus(invitationID, domain.LibraryInvitationCanceled)
+}
+
+// GetMyInvitations 내가 받은 초대 중 아직 응답하지 않은 초대를 조회합니다.
+func (uc *LibraryUseCase) GetMyInvitations(userID uuid.UUID) ([]*domain.LibraryInvitation, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.libraryRepo.GetPendingInvitations(userID)
+}
+
+// AcceptInvitation 초대를 수락하고 서재의 구성원이 됩니다. 초대한 사람에게 알림을 보냅니다.
+func (uc *LibraryUseCase) AcceptInvitation(userID, invitationID uuid.UUID) (*domain.LibraryInvitation, error) {
+	if _, err := uc.receivedInvitation(userID, invitationID); err != nil {
+		return nil, err
+	}
+
+	accepted, err := uc.libraryRepo.AcceptInvitation(invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notify(accepted.InviterID, fmt.Sprintf("%s님이 '%s' 서재 초대를 수락했습니다.", accepted.InviteeNickname, accepted.LibraryName))
+
+	return accepted, nil
+}
+
+func (uc *LibraryUseCase) DeclineInvitation(userID, invitationID uuid.UUID) (*domain.LibraryInvitation, error) {
+	if _, err := uc.receivedInvitation(userID, invitationID); err != nil {
+		return nil, err
+	}
+
+	declined, err := uc.libraryRepo.UpdateInvitationStatus(invitationID, domain.LibraryInvitationDeclined)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.notify(declined.InviterID, fmt.Sprintf("%s님이 '%s' 서재 초대를 거절했습니다.", declined.InviteeNickname, declined.LibraryName))
+
+	return declined, nil
+}
+
+// MoveBook 책 소유자가 책을 공유 서재로 옮기거나 개인 서재로 되돌립니다.
+// 옮길 서재에서 편집자(editor) 이상이어야 합니다.
+func (uc *LibraryUseCase) MoveBook(userID, bookID uuid.UUID, req *domain.MoveBookToLibraryRequest, expected domain.ExpectedVersions) (*domain.Book, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	current, err := uc.bookRepo.GetBookByID(userID, bookID)
+	if err != nil {
+		return nil, err
+	}
+	if !expected.Matches(current.Version) {
+		return nil, domain.ErrPreconditionFailed
+	}
+
+	if req.LibraryID != nil {
+		role, err := uc.memberRole(userID, *req.LibraryID)
+		if err != nil {
+			return nil, err
+		}
+		if !role.CanEdit() {
+			return nil, domain.ErrPermissionDenied
+		}
+	}
+
+	if err := uc.libraryRepo.SetBookLibrary(bookID, req.LibraryID, expected); err != nil {
+		return nil, err
+	}
+
+	return uc.bookRepo.GetBookByID(userID, bookID)
+}
+
+// 구성원이 아니면 서재가 있는지 알 수 없도록 domain.ErrNotFound를 반환합니다.
+func (uc *LibraryUseCase) memberRole(userID, id uuid.UUID) (domain.LibraryRole, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return "", domain.ErrInvalidInput
+	}
+
+	return uc.libraryRepo.GetMemberRole(id, userID)
+}
+
+func (uc *LibraryUseCase) requireManager(userID, id uuid.UUID) error {
+	role, err := uc.memberRole(userID, id)
+	if err != nil {
+		return err
+	}
+	if !role.CanManage() {
+		return domain.ErrPermissionDenied
+	}
+
+	return nil
+}
+
+// 관리자의 역할을 낮추거나 내보내기 전에 다른 관리자가 남는지 확인합니다.
+func (uc *LibraryUseCase) ensureAnotherOwner(id uuid.UUID) error {
+	owners, err := uc.libraryRepo.CountOwners(id)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return domain.ErrLastLibraryOwner
+	}
+
+	return nil
+}
+
+// 받은 사람만 초대에 응답할 수 있습니다. 다른 사람의 초대는 찾을 수 없는 것으로 봅니다.
+func (uc *LibraryUseCase) receivedInvitation(userID, invitationID uuid.UUID) (*domain.LibraryInvitation, error) {
+	if userID == uuid.Nil || invitationID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	invitation, err := uc.libraryRepo.GetInvitation(invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if invitation.InviteeID != userID {
+		return nil, domain.ErrNotFound
+	}
+	if invitation.Status != domain.LibraryInvitationPending {
+		return nil, domain.ErrInvalidInvitationState
+	}
+
+	return invitation, nil
+}
+
+func (uc *LibraryUseCase) notify(userID uuid.UUID, body string) {
+	if uc.notifier == nil {
+		return
+	}
+
+	u, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		logger.Sugar().Errorf("서재 알림 대상 사용자를 조회하지 못했습니다. 사용자ID: %s, %v", userID.String(), err)
+		return
+	}
+	if u.FCMToken == "" {
+		return
+	}
+
+	if err := uc.notifier.SendPush(context.Background(), u.FCMToken, "나만의 서재", body); err != nil {
+		logger.Sugar().Errorf("서재 알림을 보내지 못했습니다. 사용자ID: %s, %v", userID.String(), err)
+	}
+}
+
+func normalizeLibraryName(raw string) (string, error) {
+	name := strings.TrimSpace(raw)
+	if name == "" || utf8.RuneCountInString(name) > config.MaxLibraryNameLength {
+		return "", domain.ErrInvalidInput
+	}
+
+	return name, nil
+}
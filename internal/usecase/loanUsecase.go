@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type LoanUseCase struct {
+	loanRepo domain.LoanRepository
+	bookRepo domain.BookRepository
+}
+
+func NewLoanUseCase(loanRepo domain.LoanRepository, bookRepo domain.BookRepository) *LoanUseCase {
+	return &LoanUseCase{
+		loanRepo: loanRepo,
+		bookRepo: bookRepo,
+	}
+}
+
+// LendBook 책을 빌려준 기록을 남깁니다. 아직 돌려받지 못한 책은 다시 빌려줄 수 없습니다.
+func (uc *LoanUseCase) LendBook(userID, bookID uuid.UUID, req *domain.LoanRequest) (*domain.Loan, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	b, err := accessibleBookFrom(uc.bookRepo, userID, bookID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := loanFromRequest(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	lent, err := uc.loanRepo.HasActiveLoan(bookID)
+	if err != nil {
+		return nil, err
+	}
+	if lent {
+		return nil, domain.ErrBookAlreadyLent
+	}
+
+	l.BookTitle = b.Title
+	return uc.loanRepo.Create(userID, bookID, l)
+}
+
+func (uc *LoanUseCase) GetBookLoans(userID, bookID uuid.UUID) ([]*domain.Loan, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := accessibleBookFrom(uc.bookRepo, userID, bookID, false); err != nil {
+		return nil, err
+	}
+
+	return uc.loanRepo.GetByBookID(userID, bookID)
+}
+
+// GetLoans 사용자의 대여 목록을 조회합니다. 상태를 지정하지 않으면 반납되지 않은 대여를 조회합니다.
+func (uc *LoanUseCase) GetLoans(userID uuid.UUID, state domain.LoanState) ([]*domain.Loan, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if state == "" {
+		state = domain.LoanStateActive
+	}
+	if !state.IsValid() {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.loanRepo.GetByUserID(userID, state)
+}
+
+func (uc *LoanUseCase) GetLoan(userID, id uuid.UUID) (*domain.Loan, error) {
+	return uc.ownedLoan(userID, id)
+}
+
+// UpdateLoan 대여 정보의 모든 항목을 요청 값으로 바꿉니다. 반납 여부는 바꾸지 않습니다.
+func (uc *LoanUseCase) UpdateLoan(userID, id uuid.UUID, req *domain.LoanRequest) (*domain.Loan, error) {
+	if req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	current, err := uc.ownedLoan(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 빌려준 날짜를 비워 두면 기존 값을 유지합니다.
+	l, err := loanFromRequest(req, current.LentAt)
+	if err != nil {
+		return nil, err
+	}
+	if current.ReturnedAt != nil && current.ReturnedAt.Before(l.LentAt) {
+		return nil, domain.ErrInvalidInput
+	}
+	l.ID = current.ID
+	l.OwnerID = current.OwnerID
+	l.BookID = current.BookID
+
+	return uc.loanRepo.Update(l)
+}
+
+// ReturnLoan 빌려준 책을 돌려받은 것으로 기록합니다.
+func (uc *LoanUseCase) ReturnLoan(userID, id uuid.UUID, req *domain.ReturnLoanRequest) (*domain.Loan, error) {
+	current, err := uc.ownedLoan(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !current.IsActive() {
+		return nil, domain.ErrLoanAlreadyReturned
+	}
+
+	returnedAt := time.Now()
+	if req != nil && req.ReturnedAt != nil {
+		returnedAt = *req.ReturnedAt
+	}
+	if returnedAt.Before(current.LentAt) || returnedAt.After(time.Now()) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.loanRepo.MarkReturned(id, returnedAt)
+}
+
+func (uc *LoanUseCase) DeleteLoan(userID, id uuid.UUID) error {
+	if _, err := uc.ownedLoan(userID, id); err != nil {
+		return err
+	}
+
+	return uc.loanRepo.Delete(id)
+}
+
+// 다른 사용자의 대여 기록은 존재 여부를 드러내지 않도록 domain.ErrNotFound를 반환합니다.
+func (uc *LoanUseCase) ownedLoan(userID, id uuid.UUID) (*domain.Loan, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	l, err := uc.loanRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if l.OwnerID != userID {
+		return nil, domain.ErrNotFound
+	}
+
+	return l, nil
+}
+
+// 요청 값을 정리하고 검증합니다. 빌려준 날짜가 없으면 defaultLentAt을 사용하며,
+// 반납 예정일은 빌려준 날짜보다 앞설 수 없습니다.
+func loanFromRequest(req *domain.LoanRequest, defaultLentAt time.Time) (*domain.Loan, error) {
+	l := &domain.Loan{
+		BorrowerName:    strings.TrimSpace(req.BorrowerName),
+		BorrowerContact: strings.TrimSpace(req.BorrowerContact),
+		LentAt:          defaultLentAt,
+		DueAt:           req.DueAt,
+		Notes:           strings.TrimSpace(req.Notes),
+	}
+	if req.LentAt != nil {
+		l.LentAt = *req.LentAt
+	}
+
+	if l.BorrowerName == "" || utf8.RuneCountInString(l.BorrowerName) > config.MaxBorrowerNameLength {
+		return nil, domain.ErrInvalidInput
+	}
+	if utf8.RuneCountInString(l.BorrowerContact) > config.MaxBorrowerContactLength {
+		return nil, domain.ErrInvalidInput
+	}
+	if utf8.RuneCountInString(l.Notes) > config.MaxLoanNotesLength {
+		return nil, domain.ErrInvalidInput
+	}
+	if l.LentAt.After(time.Now()) {
+		return nil, domain.ErrInvalidInput
+	}
+	if l.DueAt != nil && l.DueAt.Before(l.LentAt) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return l, nil
+}
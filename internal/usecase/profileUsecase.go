@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type ProfileUseCase struct {
+	profileRepo domain.ProfileRepository
+	userRepo    domain.UserRepository
+}
+
+func NewProfileUseCase(profileRepo domain.ProfileRepository, userRepo domain.UserRepository) *ProfileUseCase {
+	return &ProfileUseCase{
+		profileRepo: profileRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// GetProfile 닉네임으로 공개 프로필을 조회합니다. 비공개 계정이면 domain.ErrPrivateAccount를 반환합니다.
+func (uc *ProfileUseCase) GetProfile(name string) (*domain.PublicProfile, error) {
+	u, err := uc.publishedUser(name)
+	if err != nil {
+		return nil, err
+	}
+
+	_, visible, err := uc.profileRepo.CountBooks(u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.buildProfile(u, visible)
+}
+
+func (uc *ProfileUseCase) ListBooks(name string, filter *domain.BookListFilter) (*domain.PublicBookPage, error) {
+	u, err := uc.publishedUser(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.listVisibleBooks(u.ID, filter)
+}
+
+// GetShelf 공개 책장과 그 안의 공개된 책을 조회합니다.
+// 비공개 책장이거나 다른 사용자의 책장이면 존재 여부를 드러내지 않도록 domain.ErrNotFound를 반환합니다.
+func (uc *ProfileUseCase) GetShelf(name string, shelfID uuid.UUID) (*domain.PublicShelf, error) {
+	if shelfID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	u, err := uc.publishedUser(name)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := uc.profileRepo.GetPublicShelf(u.ID, shelfID)
+	if err != nil {
+		return nil, err
+	}
+
+	books, err := uc.profileRepo.GetVisibleShelfBooks(s.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	shelf := toPublicShelf(s)
+	shelf.Books = toPublicBooks(books)
+
+	return shelf, nil
+}
+
+// GetPreview 내 공개 프로필이 다른 사람에게 어떻게 보이는지 조회합니다.
+// 계정이 비공개여도 공개했을 때의 모습을 보여주고, IsPublished로 현재 공개 여부를 알려줍니다.
+func (uc *ProfileUseCase) GetPreview(userID uuid.UUID) (*domain.ProfilePreview, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	u, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	total, visible, err := uc.profileRepo.CountBooks(u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := uc.buildProfile(u, visible)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.ProfilePreview{
+		IsPublished:     u.IsPublished,
+		HiddenBookCount: total - visible,
+		Profile:         profile,
+	}, nil
+}
+
+func (uc *ProfileUseCase) ListPreviewBooks(userID uuid.UUID, filter *domain.BookListFilter) (*domain.PublicBookPage, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.listVisibleBooks(userID, filter)
+}
+
+// publishedUser 닉네임으로 사용자를 찾고 공개 계정인지 확인합니다.
+func (uc *ProfileUseCase) publishedUser(name string) (*domain.User, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	u, err := uc.profileRepo.GetUserByNickname(name)
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsPublished {
+		return nil, domain.ErrPrivateAccount
+	}
+
+	return u, nil
+}
+
+func (uc *ProfileUseCase) buildProfile(u *domain.User, bookCount int) (*domain.PublicProfile, error) {
+	shelves, err := uc.profileRepo.GetPublicShelves(u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &domain.PublicProfile{
+		NickName:  u.NickName,
+		BookCount: bookCount,
+		Shelves:   make([]*domain.PublicShelf, 0, len(shelves)),
+		JoinedAt:  u.CreatedAt,
+	}
+	for _, s := range shelves {
+		profile.Shelves = append(profile.Shelves, toPublicShelf(s))
+	}
+
+	return profile, nil
+}
+
+// listVisibleBooks 공개 프로필에서는 보관 위치, 대여 여부, 태그처럼 공개하지 않는 정보로 거를 수 없습니다.
+func (uc *ProfileUseCase) listVisibleBooks(ownerID uuid.UUID, filter *domain.BookListFilter) (*domain.PublicBookPage, error) {
+	if filter != nil {
+		filter.Location = domain.BookLocation{}
+		filter.Lent = nil
+		filter.Tags = nil
+		filter.TagMatch = ""
+	}
+
+	filter, err := normalizeBookListFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := uc.profileRepo.ListVisibleBooks(ownerID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.PublicBookPage{
+		Items:    toPublicBooks(page.Items),
+		PageInfo: page.PageInfo,
+	}, nil
+}
+
+func toPublicShelf(s *domain.Shelf) *domain.PublicShelf {
+	return &domain.PublicShelf{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		BookCount:   s.BookCount,
+	}
+}
+
+func toPublicBooks(books []*domain.Book) []*domain.PublicBook {
+	result := make([]*domain.PublicBook, 0, len(books))
+	for _, b := range books {
+		result = append(result, &domain.PublicBook{
+			ID:            b.ID,
+			Title:         b.Title,
+			Author:        b.Author,
+			BookISBN:      b.BookISBN,
+			ThumbnailURL:  b.ThumbnailURL,
+			Publisher:     b.Publisher,
+			PublishedDate: b.PublishedDate,
+			Status:        b.Status,
+			SeriesName:    b.SeriesName,
+			SeriesVolume:  b.SeriesVolume,
+		})
+	}
+
+	return result
+}
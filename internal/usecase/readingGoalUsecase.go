@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"math"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/logger"
+	"github.com/google/uuid"
+)
+
+type ReadingGoalUseCase struct {
+	goalRepo domain.ReadingGoalRepository
+	userRepo domain.UserRepository
+}
+
+func NewReadingGoalUseCase(goalRepo domain.ReadingGoalRepository, userRepo domain.UserRepository) *ReadingGoalUseCase {
+	return &ReadingGoalUseCase{
+		goalRepo: goalRepo,
+		userRepo: userRepo,
+	}
+}
+
+func (uc *ReadingGoalUseCase) CreateGoal(userID uuid.UUID, req *domain.CreateReadingGoalRequest) (*domain.ReadingGoalProgress, error) {
+	if userID == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	loc := uc.userLocation(userID)
+
+	g := &domain.ReadingGoal{
+		Year:         req.Year,
+		Type:         req.Type,
+		Target:       req.Target,
+		PaceReminder: true,
+	}
+	if g.Year == 0 {
+		g.Year = time.Now().In(loc).Year()
+	}
+	if req.PaceReminder != nil {
+		g.PaceReminder = *req.PaceReminder
+	}
+	if err := validateReadingGoal(g); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.goalRepo.Create(userID, g)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.progress(created, loc, false)
+}
+
+func (uc *ReadingGoalUseCase) GetGoals(userID uuid.UUID, year int) ([]*domain.ReadingGoalProgress, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	goals, err := uc.goalRepo.GetByUserID(userID, year)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := uc.userLocation(userID)
+	result := make([]*domain.ReadingGoalProgress, 0, len(goals))
+	for _, g := range goals {
+		p, err := uc.progress(g, loc, false)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, p)
+	}
+
+	return result, nil
+}
+
+func (uc *ReadingGoalUseCase) GetGoal(userID, id uuid.UUID) (*domain.ReadingGoalProgress, error) {
+	g, err := uc.ownedGoal(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.progress(g, uc.userLocation(userID), true)
+}
+
+func (uc *ReadingGoalUseCase) UpdateGoal(userID, id uuid.UUID, req *domain.UpdateReadingGoalRequest) (*domain.ReadingGoalProgress, error) {
+	if req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	g, err := uc.ownedGoal(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Target != nil {
+		g.Target = *req.Target
+	}
+	if req.PaceReminder != nil {
+		g.PaceReminder = *req.PaceReminder
+	}
+	if err := validateReadingGoal(g); err != nil {
+		return nil, err
+	}
+
+	if err := uc.goalRepo.Update(g); err != nil {
+		return nil, err
+	}
+	g.UpdatedAt = time.Now()
+
+	return uc.progress(g, uc.userLocation(userID), true)
+}
+
+func (uc *ReadingGoalUseCase) DeleteGoal(userID, id uuid.UUID) error {
+	if _, err := uc.ownedGoal(userID, id); err != nil {
+		return err
+	}
+
+	return uc.goalRepo.Delete(id)
+}
+
+// GetBehindPaceReminders 올해 목표 중 목표 속도보다 뒤처졌고 최근 config.ReadingGoalReminderInterval 안에 알림을 보내지 않은 목표를 찾습니다.
+// 매시 호출되며, 사용자 타임존으로 config.ReadingGoalReminderHour시인 사용자의 목표만 반환합니다.
+// 연도는 사용자 타임존 기준이므로 연말연시에는 앞뒤 연도의 목표를 함께 조회한 뒤 진행 상황으로 거릅니다.
+func (uc *ReadingGoalUseCase) GetBehindPaceReminders(now time.Time) ([]*domain.ReadingGoalPaceReminder, error) {
+	// 타임존은 UTC-12부터 UTC+14까지입니다.
+	years := []int{now.UTC().Year()}
+	for _, y := range []int{now.Add(-14 * time.Hour).UTC().Year(), now.Add(14 * time.Hour).UTC().Year()} {
+		if y != years[0] {
+			years = append(years, y)
+		}
+	}
+
+	targets, err := uc.goalRepo.GetReminderTargets(years, now.Add(-config.ReadingGoalReminderInterval))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.ReadingGoalPaceReminder, 0, len(targets))
+	for _, t := range targets {
+		loc := locationOf(t.Timezone)
+		if now.In(loc).Hour() != config.ReadingGoalReminderHour {
+			continue
+		}
+
+		p, err := uc.progressAt(t.Goal, loc, now, false)
+		if err != nil {
+			logger.Sugar().Errorf("독서 목표의 진행 상황을 계산하지 못했습니다. 목표ID: %s, %v", t.Goal.ID.String(), err)
+			continue
+		}
+		if p.Status != domain.ReadingGoalBehind || p.DaysElapsed < config.ReadingGoalReminderMinDays {
+			continue
+		}
+
+		result = append(result, &domain.ReadingGoalPaceReminder{
+			Progress: p,
+			FCMToken: t.FCMToken,
+		})
+	}
+
+	return result, nil
+}
+
+func (uc *ReadingGoalUseCase) MarkReminderSent(id uuid.UUID, sentAt time.Time) error {
+	return uc.goalRepo.MarkReminderSent(id, sentAt)
+}
+
+func (uc *ReadingGoalUseCase) ownedGoal(userID, id uuid.UUID) (*domain.ReadingGoal, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	g, err := uc.goalRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if g.UserID != userID {
+		return nil, domain.ErrNotFound
+	}
+
+	return g, nil
+}
+
+func (uc *ReadingGoalUseCase) progress(g *domain.ReadingGoal, loc *time.Location, withBooks bool) (*domain.ReadingGoalProgress, error) {
+	return uc.progressAt(g, loc, time.Now(), withBooks)
+}
+
+// progressAt 목표 연도(사용자 타임존 기준 1월 1일 0시부터 다음 해 1월 1일 0시 전까지)에 다 읽은 책으로 진행 상황을 계산합니다.
+func (uc *ReadingGoalUseCase) progressAt(g *domain.ReadingGoal, loc *time.Location, now time.Time, withBooks bool) (*domain.ReadingGoalProgress, error) {
+	start := time.Date(g.Year, time.January, 1, 0, 0, 0, 0, loc)
+	end := start.AddDate(1, 0, 0)
+
+	finished, err := uc.goalRepo.GetFinishedBooks(g.UserID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	p := calculateGoalProgress(g, finished, start, end, now)
+	if withBooks {
+		p.FinishedBooks = finished
+	}
+
+	return p, nil
+}
+
+// calculateGoalProgress 목표 속도는 한 해 동안 고르게 읽는다고 보고, 예상 값은 지금까지의 평균 속도를 유지한다고 가정합니다.
+func calculateGoalProgress(g *domain.ReadingGoal, finished []*domain.FinishedBook, start, end, now time.Time) *domain.ReadingGoalProgress {
+	p := &domain.ReadingGoalProgress{Goal: g}
+
+	for _, b := range finished {
+		amount := 1
+		if g.Type == domain.ReadingGoalPages {
+			amount = b.TotalPages
+			if b.TotalPages <= 0 {
+				p.BooksWithoutPages++
+			}
+		}
+
+		p.Current += amount
+		if p.CompletedAt == nil && p.Current >= g.Target {
+			completedAt := b.FinishedAt
+			p.CompletedAt = &completedAt
+		}
+	}
+
+	p.Remaining = max(g.Target-p.Current, 0)
+	p.Percent = progressPercent(p.Current, g.Target)
+
+	totalDays := end.Sub(start).Hours() / 24
+	var elapsed float64
+	switch {
+	case now.Before(start):
+		elapsed = 0
+	case now.Before(end):
+		elapsed = now.Sub(start).Hours() / 24
+	default:
+		elapsed = totalDays
+	}
+
+	p.DaysElapsed = int(elapsed)
+	p.DaysRemaining = int(math.Ceil(totalDays - elapsed))
+	p.ExpectedByNow = int(float64(g.Target) * elapsed / totalDays)
+
+	if elapsed > 0 {
+		// 연초 하루 안에 읽은 책으로 속도가 지나치게 커지지 않도록 최소 하루로 나눕니다.
+		perDay := float64(p.Current) / math.Max(elapsed, 1)
+		p.CurrentPace = roundTenth(perDay * 7)
+		p.ProjectedTotal = int(math.Round(perDay * totalDays))
+		if p.ProjectedTotal < p.Current {
+			p.ProjectedTotal = p.Current
+		}
+
+		if p.CompletedAt == nil && p.Current > 0 && now.Before(end) {
+			// 목표에 닿는 날의 0시 (사용자 타임존 기준)
+			projected := start.AddDate(0, 0, int(float64(g.Target)/perDay))
+			p.ProjectedDate = &projected
+		}
+	}
+
+	if p.Remaining > 0 && totalDays > elapsed {
+		p.RequiredPace = roundTenth(float64(p.Remaining) / (totalDays - elapsed) * 7)
+	}
+
+	switch {
+	case p.CompletedAt != nil:
+		p.Status = domain.ReadingGoalCompleted
+	case now.Before(start):
+		p.Status = domain.ReadingGoalUpcoming
+	case !now.Before(end):
+		p.Status = domain.ReadingGoalMissed
+	case p.Current < p.ExpectedByNow:
+		p.Status = domain.ReadingGoalBehind
+	default:
+		p.Status = domain.ReadingGoalOnTrack
+	}
+
+	return p
+}
+
+func validateReadingGoal(g *domain.ReadingGoal) error {
+	if !g.Type.IsValid() {
+		return domain.ErrInvalidInput
+	}
+	if g.Year < config.MinReadingGoalYear || g.Year > config.MaxReadingGoalYear {
+		return domain.ErrInvalidInput
+	}
+	if g.Target <= 0 || g.Target > config.MaxReadingGoalTarget {
+		return domain.ErrInvalidInput
+	}
+	return nil
+}
+
+func (uc *ReadingGoalUseCase) userLocation(userID uuid.UUID) *time.Location {
+	u, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return time.UTC
+	}
+
+	return locationOf(u.Timezone)
+}
+
+func roundTenth(v float64) float64 {
+	return math.Round(v*10) / 10
+}
@@ -74,7 +74,7 @@ func (uc *readingReminderUseCase) GetReminderByID(id uuid.UUID) (*domain.Reading
 	return uc.reminderRepo.GetByID(id)
 }
 
-func (uc *readingReminderUseCase) UpdateReminder(id uuid.UUID, userID uuid.UUID, req *domain.UpdateReminderRequest) (*domain.ReadingReminder, error) {
+func (uc *readingReminderUseCase) UpdateReminder(id uuid.UUID, userID uuid.UUID, req *domain.UpdateReminderRequest, expected domain.ExpectedVersions) (*domain.ReadingReminder, error) {
 	reminder, err := uc.reminderRepo.GetByID(id)
 	if err != nil {
 		return nil, err
@@ -102,7 +102,7 @@ func (uc *readingReminderUseCase) UpdateReminder(id uuid.UUID, userID uuid.UUID,
 		reminder.Message = req.Message
 	}
 
-	err = uc.reminderRepo.Update(reminder)
+	err = uc.reminderRepo.Update(reminder, expected)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +110,7 @@ func (uc *readingReminderUseCase) UpdateReminder(id uuid.UUID, userID uuid.UUID,
 	return reminder, nil
 }
 
-func (uc *readingReminderUseCase) ToggleReminder(id uuid.UUID, userID uuid.UUID) (*domain.ReadingReminder, error) {
+func (uc *readingReminderUseCase) ToggleReminder(id uuid.UUID, userID uuid.UUID, expected domain.ExpectedVersions) (*domain.ReadingReminder, error) {
 	reminder, err := uc.reminderRepo.GetByID(id)
 	if err != nil {
 		return nil, err
@@ -122,7 +122,7 @@ func (uc *readingReminderUseCase) ToggleReminder(id uuid.UUID, userID uuid.UUID)
 
 	reminder.IsEnabled = !reminder.IsEnabled
 
-	err = uc.reminderRepo.Update(reminder)
+	err = uc.reminderRepo.Update(reminder, expected)
 	if err != nil {
 		return nil, err
 	}
@@ -130,7 +130,7 @@ func (uc *readingReminderUseCase) ToggleReminder(id uuid.UUID, userID uuid.UUID)
 	return reminder, nil
 }
 
-func (uc *readingReminderUseCase) DeleteReminder(id uuid.UUID, userID uuid.UUID) error {
+func (uc *readingReminderUseCase) DeleteReminder(id uuid.UUID, userID uuid.UUID, expected domain.ExpectedVersions) error {
 	reminder, err := uc.reminderRepo.GetByID(id)
 	if err != nil {
 		return err
@@ -140,5 +140,5 @@ func (uc *readingReminderUseCase) DeleteReminder(id uuid.UUID, userID uuid.UUID)
 		return domain.ErrReminderOwnerMismatch
 	}
 
-	return uc.reminderRepo.Delete(id)
+	return uc.reminderRepo.Delete(id, expected)
 }
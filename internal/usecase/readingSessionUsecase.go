@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type ReadingSessionUseCase struct {
+	sessionRepo domain.ReadingSessionRepository
+	bookRepo    domain.BookRepository
+	userRepo    domain.UserRepository
+}
+
+func NewReadingSessionUseCase(sessionRepo domain.ReadingSessionRepository, bookRepo domain.BookRepository, userRepo domain.UserRepository) *ReadingSessionUseCase {
+	return &ReadingSessionUseCase{
+		sessionRepo: sessionRepo,
+		bookRepo:    bookRepo,
+		userRepo:    userRepo,
+	}
+}
+
+// StartSession 책의 독서 세션을 시작합니다. 시작 페이지를 생략하면 현재 페이지부터 시작합니다.
+// 같은 책에 진행 중인 세션이 있으면 domain.ErrReadingSessionActive를 반환합니다.
+func (uc *ReadingSessionUseCase) StartSession(userID, bookID uuid.UUID, req *domain.StartReadingSessionRequest) (*domain.ReadingSession, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	b, err := accessibleBookFrom(uc.bookRepo, userID, bookID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = uc.sessionRepo.GetActiveByBookID(userID, bookID)
+	if err == nil {
+		return nil, domain.ErrReadingSessionActive
+	}
+	if !errors.Is(err, domain.ErrNoActiveSession) {
+		return nil, err
+	}
+
+	startPage := b.CurrentPage
+	if req != nil && req.StartPage != nil {
+		startPage = *req.StartPage
+	}
+	if !validPage(startPage, b.TotalPages) {
+		return nil, domain.ErrInvalidPage
+	}
+
+	return uc.sessionRepo.Create(userID, bookID, &domain.ReadingSession{
+		StartPage: startPage,
+	})
+}
+
+// StopSession 진행 중인 세션을 종료하고 책의 현재 페이지를 종료 위치로 갱신합니다.
+func (uc *ReadingSessionUseCase) StopSession(userID, bookID uuid.UUID, req *domain.StopReadingSessionRequest) (*domain.ReadingSession, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	b, err := accessibleBookFrom(uc.bookRepo, userID, bookID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := uc.sessionRepo.GetActiveByBookID(userID, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	endPage := session.StartPage
+	if req != nil {
+		endPage, err = resolvePage(req.EndPage, req.Percent, session.StartPage, b.TotalPages)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	session.EndPage = &endPage
+	session.PagesRead = max(endPage-session.StartPage, 0)
+	session.EndedAt = &now
+
+	ended, err := uc.sessionRepo.End(session)
+	if err != nil {
+		return nil, err
+	}
+
+	if endPage != b.CurrentPage {
+		if err := uc.bookRepo.UpdateProgress(bookID, endPage, b.TotalPages); err != nil {
+			return nil, err
+		}
+	}
+
+	return ended, nil
+}
+
+func (uc *ReadingSessionUseCase) GetBookSessions(userID, bookID uuid.UUID) ([]*domain.ReadingSession, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := accessibleBookFrom(uc.bookRepo, userID, bookID, false); err != nil {
+		return nil, err
+	}
+
+	return uc.sessionRepo.GetByBookID(userID, bookID)
+}
+
+func (uc *ReadingSessionUseCase) GetRecentSessions(userID uuid.UUID, limit int) ([]*domain.ReadingSession, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if limit <= 0 {
+		limit = config.DefaultPageSize
+	}
+	if limit > config.MaxPageSize {
+		limit = config.MaxPageSize
+	}
+
+	return uc.sessionRepo.GetByUserID(userID, limit)
+}
+
+// GetProgress 책의 진행률과 누적/이번 주 독서 시간을 계산합니다.
+// 이번 주는 사용자 타임존 기준 월요일 0시부터이며, 진행 중인 세션은 현재 시각까지의 시간을 포함합니다.
+func (uc *ReadingSessionUseCase) GetProgress(userID, bookID uuid.UUID) (*domain.ReadingProgress, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	b, err := accessibleBookFrom(uc.bookRepo, userID, bookID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := uc.sessionRepo.GetByBookID(userID, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	weekStart := startOfWeek(now.In(uc.userLocation(userID)))
+
+	progress := &domain.ReadingProgress{
+		BookID:       bookID,
+		CurrentPage:  b.CurrentPage,
+		TotalPages:   b.TotalPages,
+		Percent:      progressPercent(b.CurrentPage, b.TotalPages),
+		SessionCount: len(sessions),
+	}
+
+	var total, week time.Duration
+	for _, s := range sessions {
+		end := now
+		if s.EndedAt != nil {
+			end = *s.EndedAt
+		} else if progress.ActiveSession == nil {
+			progress.ActiveSession = s
+		}
+
+		elapsed := end.Sub(s.StartedAt)
+		total += elapsed
+
+		// 이번 주에 걸쳐 있는 세션은 이번 주에 해당하는 시간만 더합니다.
+		if end.After(weekStart) {
+			week += end.Sub(laterOf(s.StartedAt, weekStart))
+		}
+	}
+
+	progress.TotalMinutes = int(total.Minutes())
+	progress.WeekMinutes = int(week.Minutes())
+
+	return progress, nil
+}
+
+// UpdateProgress 세션 없이 현재 페이지나 전체 페이지 수를 직접 수정합니다.
+func (uc *ReadingSessionUseCase) UpdateProgress(userID, bookID uuid.UUID, req *domain.UpdateReadingProgressRequest) (*domain.ReadingProgress, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	b, err := accessibleBookFrom(uc.bookRepo, userID, bookID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := b.TotalPages
+	if req.TotalPages != nil {
+		if *req.TotalPages < 0 {
+			return nil, domain.ErrInvalidPage
+		}
+		totalPages = *req.TotalPages
+	}
+
+	currentPage, err := resolvePage(req.CurrentPage, req.Percent, b.CurrentPage, totalPages)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.bookRepo.UpdateProgress(bookID, currentPage, totalPages); err != nil {
+		return nil, err
+	}
+
+	return uc.GetProgress(userID, bookID)
+}
+
+// 페이지 또는 진행률(%)로 받은 위치를 페이지로 변환합니다. 둘 다 없으면 fallback을 사용합니다.
+// 진행률은 전체 페이지 수를 알아야 변환할 수 있습니다.
+func resolvePage(page *int, percent *float64, fallback, totalPages int) (int, error) {
+	switch {
+	case page != nil:
+		if !validPage(*page, totalPages) {
+			return 0, domain.ErrInvalidPage
+		}
+		return *page, nil
+	case percent != nil:
+		if totalPages <= 0 || *percent < 0 || *percent > 100 {
+			return 0, domain.ErrInvalidPage
+		}
+		return int(math.Round(*percent / 100 * float64(totalPages))), nil
+	default:
+		return fallback, nil
+	}
+}
+
+func validPage(page, totalPages int) bool {
+	if page < 0 {
+		return false
+	}
+	return totalPages == 0 || page <= totalPages
+}
+
+// 소수점 첫째 자리까지의 진행률입니다. 전체 페이지 수를 모르면 0입니다.
+func progressPercent(currentPage, totalPages int) float64 {
+	if totalPages <= 0 {
+		return 0
+	}
+	return math.Round(float64(currentPage)/float64(totalPages)*1000) / 10
+}
+
+func (uc *ReadingSessionUseCase) userLocation(userID uuid.UUID) *time.Location {
+	u, err := uc.userRepo.GetByID(userID)
+	if err != nil {
+		return time.UTC
+	}
+
+	return locationOf(u.Timezone)
+}
+
+// locationOf 사용자 타임존을 불러옵니다. 비어 있거나 잘못된 값이면 UTC를 사용합니다.
+func locationOf(timezone string) *time.Location {
+	if timezone == "" {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// 주어진 시각이 속한 주의 월요일 0시를 반환합니다.
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	y, m, d := t.AddDate(0, 0, -offset).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func laterOf(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/isbn"
 	"github.com/google/uuid"
 )
 
@@ -17,11 +18,16 @@ func NewReviewUseCase(repo domain.ReviewRepository) *ReviewUseCase {
 	}
 }
 
-func (uc *ReviewUseCase) CreateReview(userID uuid.UUID, isbn string, req *domain.CreateReviewRequest) (*domain.Review, error) {
-	if isbn == "" {
+func (uc *ReviewUseCase) CreateReview(userID uuid.UUID, rawISBN string, req *domain.CreateReviewRequest) (*domain.Review, error) {
+	if rawISBN == "" {
 		return nil, fmt.Errorf("ISBN은 필수입니다")
 	}
 
+	normalized, err := isbn.Normalize(rawISBN)
+	if err != nil {
+		return nil, domain.ErrInvalidISBN
+	}
+
 	if req.Content == "" {
 		return nil, fmt.Errorf("리뷰 내용은 필수입니다")
 	}
@@ -31,7 +37,7 @@ func (uc *ReviewUseCase) CreateReview(userID uuid.UUID, isbn string, req *domain
 	}
 
 	// 사용자당 ISBN별 리뷰는 1개만 작성 가능
-	exists, err := uc.reviewRepo.ExistsByUserAndISBN(userID, isbn)
+	exists, err := uc.reviewRepo.ExistsByUserAndISBN(userID, normalized)
 	if err != nil {
 		return nil, fmt.Errorf("리뷰 중복 확인 중 오류가 발생했습니다: %w", err)
 	}
@@ -42,7 +48,7 @@ func (uc *ReviewUseCase) CreateReview(userID uuid.UUID, isbn string, req *domain
 	review := &domain.Review{
 		ID:       uuid.New(),
 		OwnerID:  userID,
-		BookISBN: isbn,
+		BookISBN: normalized,
 		Content:  req.Content,
 		Rating:   req.Rating,
 		IsPublic: req.IsPublic,
@@ -55,19 +61,24 @@ func (uc *ReviewUseCase) GetReviewByID(id uuid.UUID) (*domain.Review, error) {
 	return uc.reviewRepo.GetByID(id)
 }
 
-func (uc *ReviewUseCase) GetReviewsByISBN(isbn string) ([]*domain.ReviewResponse, error) {
-	if isbn == "" {
+func (uc *ReviewUseCase) GetReviewsByISBN(rawISBN string) ([]*domain.ReviewResponse, error) {
+	if rawISBN == "" {
 		return nil, fmt.Errorf("ISBN은 필수입니다")
 	}
 
-	return uc.reviewRepo.GetPublicByISBN(isbn)
+	normalized, err := isbn.Normalize(rawISBN)
+	if err != nil {
+		return nil, domain.ErrInvalidISBN
+	}
+
+	return uc.reviewRepo.GetPublicByISBN(normalized)
 }
 
 func (uc *ReviewUseCase) GetUserReviews(userID uuid.UUID) ([]*domain.Review, error) {
 	return uc.reviewRepo.GetByUserID(userID)
 }
 
-func (uc *ReviewUseCase) UpdateReview(userID, reviewID uuid.UUID, req *domain.UpdateReviewRequest) (*domain.Review, error) {
+func (uc *ReviewUseCase) UpdateReview(userID, reviewID uuid.UUID, req *domain.UpdateReviewRequest, expected domain.ExpectedVersions) (*domain.Review, error) {
 	existing, err := uc.reviewRepo.GetByID(reviewID)
 	if err != nil {
 		return nil, err
@@ -95,9 +106,9 @@ func (uc *ReviewUseCase) UpdateReview(userID, reviewID uuid.UUID, req *domain.Up
 		existing.IsPublic = *req.IsPublic
 	}
 
-	return uc.reviewRepo.Update(existing)
+	return uc.reviewRepo.Update(existing, expected)
 }
 
-func (uc *ReviewUseCase) DeleteReview(userID, reviewID uuid.UUID) error {
-	return uc.reviewRepo.Delete(userID, reviewID)
+func (uc *ReviewUseCase) DeleteReview(userID, reviewID uuid.UUID, expected domain.ExpectedVersions) error {
+	return uc.reviewRepo.Delete(userID, reviewID, expected)
 }
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type ShelfUseCase struct {
+	shelfRepo domain.ShelfRepository
+	bookRepo  domain.BookRepository
+}
+
+func NewShelfUseCase(shelfRepo domain.ShelfRepository, bookRepo domain.BookRepository) *ShelfUseCase {
+	return &ShelfUseCase{
+		shelfRepo: shelfRepo,
+		bookRepo:  bookRepo,
+	}
+}
+
+func (uc *ShelfUseCase) CreateShelf(userID uuid.UUID, req *domain.CreateShelfRequest) (*domain.Shelf, error) {
+	if userID == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	s := &domain.Shelf{
+		Name:        strings.TrimSpace(req.Name),
+		Description: strings.TrimSpace(req.Description),
+		Visibility:  req.Visibility,
+		HideBooks:   req.HideBooks,
+	}
+	if s.Visibility == "" {
+		s.Visibility = domain.ShelfPrivate
+	}
+	if err := validateShelf(s); err != nil {
+		return nil, err
+	}
+
+	return uc.shelfRepo.Create(userID, s)
+}
+
+func (uc *ShelfUseCase) GetMyShelves(userID uuid.UUID) ([]*domain.Shelf, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.shelfRepo.GetByUserID(userID)
+}
+
+// GetShelf 책장과 책장에 담긴 책을 순서대로 조회합니다.
+// 다른 사용자의 책장은 존재 여부를 드러내지 않도록 domain.ErrNotFound를 반환합니다.
+// 다른 사용자의 공개 책장은 공개 범위가 적용되는 프로필 API(ProfileUseCase.GetShelf)로 조회합니다.
+func (uc *ShelfUseCase) GetShelf(userID, id uuid.UUID) (*domain.Shelf, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	s, err := uc.shelfRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if s.OwnerID != userID {
+		return nil, domain.ErrNotFound
+	}
+
+	books, err := uc.shelfRepo.GetBooks(id)
+	if err != nil {
+		return nil, err
+	}
+	s.Books = books
+
+	return s, nil
+}
+
+func (uc *ShelfUseCase) UpdateShelf(userID, id uuid.UUID, req *domain.UpdateShelfRequest) (*domain.Shelf, error) {
+	if req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	s, err := uc.ownedShelf(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Name = strings.TrimSpace(req.Name)
+	s.Description = strings.TrimSpace(req.Description)
+	if req.Visibility != "" {
+		s.Visibility = req.Visibility
+	}
+	if req.HideBooks != nil {
+		s.HideBooks = *req.HideBooks
+	}
+	if err := validateShelf(s); err != nil {
+		return nil, err
+	}
+
+	if err := uc.shelfRepo.Update(s); err != nil {
+		return nil, err
+	}
+
+	return uc.shelfRepo.GetByID(id)
+}
+
+func (uc *ShelfUseCase) DeleteShelf(userID, id uuid.UUID) error {
+	if _, err := uc.ownedShelf(userID, id); err != nil {
+		return err
+	}
+
+	return uc.shelfRepo.Delete(id)
+}
+
+// AddBook 내 책이나 편집할 수 있는 공유 서재의 책을 책장의 맨 뒤에 추가합니다.
+func (uc *ShelfUseCase) AddBook(userID, shelfID, bookID uuid.UUID) (*domain.Shelf, error) {
+	if bookID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if _, err := uc.ownedShelf(userID, shelfID); err != nil {
+		return nil, err
+	}
+
+	if _, err := accessibleBookFrom(uc.bookRepo, userID, bookID, true); err != nil {
+		return nil, err
+	}
+
+	if err := uc.shelfRepo.AddBook(shelfID, bookID); err != nil {
+		return nil, err
+	}
+
+	return uc.GetShelf(userID, shelfID)
+}
+
+func (uc *ShelfUseCase) RemoveBook(userID, shelfID, bookID uuid.UUID) error {
+	if bookID == uuid.Nil {
+		return domain.ErrInvalidInput
+	}
+
+	if _, err := uc.ownedShelf(userID, shelfID); err != nil {
+		return err
+	}
+
+	return uc.shelfRepo.RemoveBook(shelfID, bookID)
+}
+
+// ReorderBooks 책장 안의 순서를 변경합니다. 요청한 ID 목록은 책장의 모든 책을 정확히 한 번씩 포함해야 합니다.
+func (uc *ShelfUseCase) ReorderBooks(userID, shelfID uuid.UUID, bookIDs []uuid.UUID) (*domain.Shelf, error) {
+	if _, err := uc.ownedShelf(userID, shelfID); err != nil {
+		return nil, err
+	}
+
+	current, err := uc.shelfRepo.GetBooks(shelfID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bookIDs) != len(current) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	onShelf := make(map[uuid.UUID]bool, len(current))
+	for _, b := range current {
+		onShelf[b.ID] = true
+	}
+	for _, id := range bookIDs {
+		if !onShelf[id] {
+			return nil, domain.ErrInvalidInput
+		}
+		// 같은 ID가 두 번 들어오지 않도록 확인한 항목은 제거합니다.
+		delete(onShelf, id)
+	}
+
+	if err := uc.shelfRepo.ReorderBooks(shelfID, bookIDs); err != nil {
+		return nil, err
+	}
+
+	return uc.GetShelf(userID, shelfID)
+}
+
+// 사용자가 소유한 책장을 조회합니다. 다른 사용자의 책장이면 domain.ErrPermissionDenied를 반환합니다.
+func (uc *ShelfUseCase) ownedShelf(userID, id uuid.UUID) (*domain.Shelf, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	s, err := uc.shelfRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if s.OwnerID != userID {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	return s, nil
+}
+
+func validateShelf(s *domain.Shelf) error {
+	if s.Name == "" || utf8.RuneCountInString(s.Name) > config.MaxShelfNameLength {
+		return domain.ErrInvalidInput
+	}
+	if utf8.RuneCountInString(s.Description) > config.MaxShelfDescriptionLength {
+		return domain.ErrInvalidInput
+	}
+	if !s.Visibility.IsValid() {
+		return domain.ErrInvalidInput
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type TrashUseCase struct {
+	bookRepo   domain.BookRepository
+	reviewRepo domain.ReviewRepository
+	retention  time.Duration
+}
+
+func NewTrashUseCase(bookRepo domain.BookRepository, reviewRepo domain.ReviewRepository, retention time.Duration) *TrashUseCase {
+	return &TrashUseCase{
+		bookRepo:   bookRepo,
+		reviewRepo: reviewRepo,
+		retention:  retention,
+	}
+}
+
+// GetTrash 휴지통에 있는 책과 리뷰를 최근에 삭제한 순서로 반환합니다.
+func (uc *TrashUseCase) GetTrash(userID uuid.UUID) (*domain.Trash, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	books, err := uc.bookRepo.GetDeletedBooks(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := uc.reviewRepo.GetDeletedByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Trash{
+		Books:         books,
+		Reviews:       reviews,
+		RetentionDays: int(uc.retention / (24 * time.Hour)),
+	}, nil
+}
+
+// RestoreBook 휴지통의 책을 서재로 되돌립니다.
+// 삭제한 뒤 같은 ISBN의 책을 다시 등록했다면 중복을 만들지 않도록 기존 책의 ID와 함께 거절합니다.
+func (uc *TrashUseCase) RestoreBook(userID, bookID uuid.UUID) (*domain.Book, error) {
+	if userID == uuid.Nil || bookID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	deleted, err := uc.bookRepo.GetDeletedBookByID(userID, bookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted.BookISBN != "" {
+		existing, err := uc.bookRepo.GetBookByISBN(userID, deleted.BookISBN)
+		if err == nil {
+			return nil, &domain.DuplicateBookError{BookID: existing.ID}
+		}
+		if !errors.Is(err, domain.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	if err := uc.bookRepo.RestoreByID(bookID); err != nil {
+		return nil, err
+	}
+
+	return uc.bookRepo.GetBookByID(userID, bookID)
+}
+
+// PurgeBook 휴지통의 책을 영구 삭제합니다. 리뷰는 남겨 둡니다.
+func (uc *TrashUseCase) PurgeBook(userID, bookID uuid.UUID) error {
+	if userID == uuid.Nil || bookID == uuid.Nil {
+		return domain.ErrInvalidInput
+	}
+
+	if _, err := uc.bookRepo.GetDeletedBookByID(userID, bookID); err != nil {
+		return err
+	}
+
+	return uc.bookRepo.PurgeByID(bookID)
+}
+
+// RestoreReview 휴지통의 리뷰를 되돌립니다. 같은 책에 새 리뷰를 이미 작성했다면 거절합니다.
+func (uc *TrashUseCase) RestoreReview(userID, reviewID uuid.UUID) (*domain.Review, error) {
+	if userID == uuid.Nil || reviewID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	deleted, err := uc.reviewRepo.GetDeletedByID(userID, reviewID)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := uc.reviewRepo.ExistsByUserAndISBN(userID, deleted.BookISBN)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, domain.ErrAlreadyExists
+	}
+
+	if err := uc.reviewRepo.Restore(reviewID); err != nil {
+		return nil, err
+	}
+
+	return uc.reviewRepo.GetByID(reviewID)
+}
+
+// PurgeReview 휴지통의 리뷰를 영구 삭제합니다.
+func (uc *TrashUseCase) PurgeReview(userID, reviewID uuid.UUID) error {
+	if userID == uuid.Nil || reviewID == uuid.Nil {
+		return domain.ErrInvalidInput
+	}
+
+	if _, err := uc.reviewRepo.GetDeletedByID(userID, reviewID); err != nil {
+		return err
+	}
+
+	return uc.reviewRepo.Purge(reviewID)
+}
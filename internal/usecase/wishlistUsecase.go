@@ -0,0 +1,433 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/my-own-library-backend/internal/config"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/domain"
+	"github.com/dev-hyunsang/my-own-library-backend/internal/isbn"
+	"github.com/google/uuid"
+)
+
+type WishlistUseCase struct {
+	wishlistRepo     domain.WishlistRepository
+	bookUseCase      domain.BookUseCase
+	metadataProvider domain.BookMetadataProvider
+}
+
+func NewWishlistUseCase(wishlistRepo domain.WishlistRepository, bookUseCase domain.BookUseCase, metadataProvider domain.BookMetadataProvider) *WishlistUseCase {
+	return &WishlistUseCase{
+		wishlistRepo:     wishlistRepo,
+		bookUseCase:      bookUseCase,
+		metadataProvider: metadataProvider,
+	}
+}
+
+// AddItem 위시리스트에 책을 추가합니다. 이미 서재나 위시리스트에 같은 ISBN의 책이 있으면 추가하지 않습니다.
+func (uc *WishlistUseCase) AddItem(userID uuid.UUID, req *domain.WishlistItemRequest) (*domain.WishlistItem, error) {
+	if userID == uuid.Nil || req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	item, err := wishlistItemFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkDuplicate(userID, item.ISBN, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	if item.Title == "" && item.ISBN != "" {
+		if err := uc.fillMetadata(item); err != nil {
+			return nil, err
+		}
+	}
+	if item.Title == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.wishlistRepo.Create(userID, item)
+}
+
+func (uc *WishlistUseCase) GetItems(userID uuid.UUID) ([]*domain.WishlistItem, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return uc.wishlistRepo.GetByUserID(userID)
+}
+
+func (uc *WishlistUseCase) GetItem(userID, id uuid.UUID) (*domain.WishlistItem, error) {
+	return uc.ownedItem(userID, id)
+}
+
+// UpdateItem 위시리스트 항목의 모든 정보를 요청 값으로 바꿉니다.
+func (uc *WishlistUseCase) UpdateItem(userID, id uuid.UUID, req *domain.WishlistItemRequest) (*domain.WishlistItem, error) {
+	if req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	current, err := uc.ownedItem(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := wishlistItemFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if item.Title == "" {
+		return nil, domain.ErrInvalidInput
+	}
+	if item.ISBN != current.ISBN {
+		if err := uc.checkDuplicate(userID, item.ISBN, current.ID); err != nil {
+			return nil, err
+		}
+	}
+	item.ID = current.ID
+	item.OwnerID = current.OwnerID
+
+	return uc.wishlistRepo.Update(item)
+}
+
+func (uc *WishlistUseCase) DeleteItem(userID, id uuid.UUID) error {
+	if _, err := uc.ownedItem(userID, id); err != nil {
+		return err
+	}
+
+	return uc.wishlistRepo.Delete(id)
+}
+
+// MoveToLibrary 위시리스트 항목으로 서재에 책을 등록하고 위시리스트에서 지웁니다.
+func (uc *WishlistUseCase) MoveToLibrary(userID, id uuid.UUID, req *domain.MoveWishlistItemRequest) (*domain.Book, error) {
+	item, err := uc.ownedItem(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		req = &domain.MoveWishlistItemRequest{}
+	}
+
+	author := item.Author
+	if author == "" {
+		author = config.UnknownAuthor
+	}
+
+	// 이미 같은 ISBN의 책이 서재에 있으면 domain.DuplicateBookError를 반환합니다.
+	saved, err := uc.bookUseCase.SaveByBookID(userID, &domain.Book{
+		OwnerID:         userID,
+		Title:           item.Title,
+		Author:          author,
+		BookISBN:        item.ISBN,
+		ThumbnailURL:    item.ThumbnailURL,
+		Publisher:       item.Publisher,
+		Status:          req.Status,
+		BookCopyDetails: req.BookCopyDetails,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.wishlistRepo.Delete(item.ID); err != nil {
+		return nil, err
+	}
+
+	return saved, nil
+}
+
+// GetShare 위시리스트 공개 링크 토큰을 조회합니다. 공유하지 않으면 빈 값입니다.
+func (uc *WishlistUseCase) GetShare(userID uuid.UUID) (*domain.WishlistShare, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	token, err := uc.wishlistRepo.GetShareToken(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	share := &domain.WishlistShare{}
+	if token != nil {
+		share.Token = *token
+	}
+	return share, nil
+}
+
+// EnableShare 새 공개 링크 토큰을 발급합니다. 이전 링크는 더 이상 사용할 수 없습니다.
+func (uc *WishlistUseCase) EnableShare(userID uuid.UUID) (*domain.WishlistShare, error) {
+	if userID == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	token, err := randomHex(config.WishlistShareTokenBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.wishlistRepo.SetShareToken(userID, &token); err != nil {
+		return nil, err
+	}
+
+	return &domain.WishlistShare{Token: token}, nil
+}
+
+func (uc *WishlistUseCase) DisableShare(userID uuid.UUID) error {
+	if userID == uuid.Nil {
+		return domain.ErrInvalidInput
+	}
+
+	return uc.wishlistRepo.SetShareToken(userID, nil)
+}
+
+// GetSharedWishlist 공개 링크로 위시리스트를 조회합니다. 로그인하지 않아도 볼 수 있습니다.
+func (uc *WishlistUseCase) GetSharedWishlist(token string) (*domain.SharedWishlist, error) {
+	owner, err := uc.shareOwner(token)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := uc.wishlistRepo.GetByUserID(owner.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &domain.SharedWishlist{
+		OwnerNickname: owner.NickName,
+		Items:         make([]*domain.SharedWishlistItem, 0, len(items)),
+	}
+	for _, item := range items {
+		result.Items = append(result.Items, sharedWishlistItem(item))
+	}
+
+	return result, nil
+}
+
+// ClaimItem 공개 위시리스트 항목에 선물하겠다고 표시합니다. 이미 표시된 항목은 다시 표시할 수 없습니다.
+func (uc *WishlistUseCase) ClaimItem(token string, id uuid.UUID, req *domain.ClaimWishlistItemRequest) (*domain.WishlistClaim, error) {
+	if req == nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || utf8.RuneCountInString(name) > config.MaxWishlistClaimNameLength {
+		return nil, domain.ErrInvalidInput
+	}
+
+	item, err := uc.sharedItem(token, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.ClaimedAt != nil {
+		return nil, domain.ErrWishlistItemClaimed
+	}
+
+	code, err := randomHex(config.WishlistClaimCodeBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	// 그사이 다른 사람이 먼저 표시했으면 domain.ErrWishlistItemClaimed를 반환합니다.
+	updated, err := uc.wishlistRepo.Claim(item.ID, name, code, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.WishlistClaim{
+		Item:      sharedWishlistItem(updated),
+		ClaimCode: code,
+	}, nil
+}
+
+// UnclaimItem 선물 표시를 취소합니다. 표시할 때 받은 코드가 일치해야 합니다.
+func (uc *WishlistUseCase) UnclaimItem(token string, id uuid.UUID, req *domain.UnclaimWishlistItemRequest) (*domain.SharedWishlistItem, error) {
+	if req == nil || req.ClaimCode == "" {
+		return nil, domain.ErrInvalidInput
+	}
+
+	item, err := uc.sharedItem(token, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.ClaimedAt == nil {
+		return sharedWishlistItem(item), nil
+	}
+	if subtle.ConstantTimeCompare([]byte(item.ClaimCode), []byte(req.ClaimCode)) != 1 {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	updated, err := uc.wishlistRepo.Unclaim(item.ID, req.ClaimCode)
+	if err != nil {
+		return nil, err
+	}
+
+	return sharedWishlistItem(updated), nil
+}
+
+// 다른 사용자의 항목은 존재 여부를 드러내지 않도록 domain.ErrNotFound를 반환합니다.
+func (uc *WishlistUseCase) ownedItem(userID, id uuid.UUID) (*domain.WishlistItem, error) {
+	if userID == uuid.Nil || id == uuid.Nil {
+		return nil, domain.ErrInvalidInput
+	}
+
+	item, err := uc.wishlistRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if item.OwnerID != userID {
+		return nil, domain.ErrNotFound
+	}
+
+	return item, nil
+}
+
+func (uc *WishlistUseCase) shareOwner(token string) (*domain.User, error) {
+	if token == "" {
+		return nil, domain.ErrNotFound
+	}
+
+	return uc.wishlistRepo.GetOwnerByShareToken(token)
+}
+
+// 공개 링크의 주인이 가진 항목인지 확인합니다.
+func (uc *WishlistUseCase) sharedItem(token string, id uuid.UUID) (*domain.WishlistItem, error) {
+	owner, err := uc.shareOwner(token)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := uc.wishlistRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if item.OwnerID != owner.ID {
+		return nil, domain.ErrNotFound
+	}
+
+	return item, nil
+}
+
+// 서재에 이미 있는 책이면 domain.DuplicateBookError를, 위시리스트에 있으면 domain.ErrAlreadyOnWishlist를 반환합니다.
+func (uc *WishlistUseCase) checkDuplicate(userID uuid.UUID, normalized string, exceptID uuid.UUID) error {
+	if normalized == "" {
+		return nil
+	}
+
+	owned, err := uc.bookUseCase.GetBookByISBN(userID, normalized)
+	if err == nil {
+		return &domain.DuplicateBookError{BookID: owned.ID}
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	wished, err := uc.wishlistRepo.GetByISBN(userID, normalized)
+	if err == nil && wished.ID != exceptID {
+		return domain.ErrAlreadyOnWishlist
+	}
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+// 제목 없이 ISBN만 입력하면 도서 정보 제공자에서 서지 정보를 채웁니다.
+func (uc *WishlistUseCase) fillMetadata(item *domain.WishlistItem) error {
+	metadata, err := uc.metadataProvider.SearchByISBN(context.Background(), item.ISBN)
+	if err != nil {
+		return err
+	}
+
+	item.Title = metadata.Title
+	if item.Author == "" {
+		item.Author = metadata.Author
+	}
+	if item.Publisher == "" {
+		item.Publisher = metadata.Publisher
+	}
+	if item.ThumbnailURL == "" {
+		item.ThumbnailURL = metadata.ThumbnailURL
+	}
+	return nil
+}
+
+// 요청 값을 정리하고 검증합니다. 통화는 희망 가격이 있을 때만 저장합니다.
+func wishlistItemFromRequest(req *domain.WishlistItemRequest) (*domain.WishlistItem, error) {
+	item := &domain.WishlistItem{
+		Title:        strings.TrimSpace(req.Title),
+		Author:       strings.TrimSpace(req.Author),
+		Publisher:    strings.TrimSpace(req.Publisher),
+		ThumbnailURL: strings.TrimSpace(req.ThumbnailURL),
+		Priority:     req.Priority,
+		Note:         strings.TrimSpace(req.Note),
+		TargetPrice:  req.TargetPrice,
+		Currency:     strings.ToUpper(strings.TrimSpace(req.Currency)),
+	}
+
+	if raw := strings.TrimSpace(req.ISBN); raw != "" {
+		normalized, err := isbn.Normalize(raw)
+		if err != nil {
+			return nil, domain.ErrInvalidISBN
+		}
+		item.ISBN = normalized
+	}
+
+	if item.Priority == "" {
+		item.Priority = domain.WishlistPriorityMedium
+	}
+	if !item.Priority.IsValid() {
+		return nil, domain.ErrInvalidInput
+	}
+	if utf8.RuneCountInString(item.Note) > config.MaxWishlistNoteLength {
+		return nil, domain.ErrInvalidInput
+	}
+
+	if item.TargetPrice == nil {
+		item.Currency = ""
+		return item, nil
+	}
+	if *item.TargetPrice < 0 || *item.TargetPrice > config.MaxBookPrice {
+		return nil, domain.ErrInvalidInput
+	}
+	if item.Currency == "" {
+		item.Currency = config.DefaultCurrency
+	}
+	if !isCurrencyCode(item.Currency) {
+		return nil, domain.ErrInvalidInput
+	}
+
+	return item, nil
+}
+
+// 공개 위시리스트에서는 선물 표시 여부와 표시한 사람만 보여주고 취소 코드는 숨깁니다.
+func sharedWishlistItem(item *domain.WishlistItem) *domain.SharedWishlistItem {
+	return &domain.SharedWishlistItem{
+		ID:           item.ID,
+		Title:        item.Title,
+		Author:       item.Author,
+		ISBN:         item.ISBN,
+		Publisher:    item.Publisher,
+		ThumbnailURL: item.ThumbnailURL,
+		Priority:     item.Priority,
+		Note:         item.Note,
+		TargetPrice:  item.TargetPrice,
+		Currency:     item.Currency,
+		Claimed:      item.ClaimedAt != nil,
+		ClaimedBy:    item.ClaimedBy,
+	}
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -10,6 +10,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
 	"github.com/google/uuid"
 )
@@ -19,38 +21,97 @@ type Book struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
-	// BookTitle holds the value of the "book_title" field.
-	BookTitle string `json:"book_title,omitempty"`
-	// Author holds the value of the "author" field.
-	Author string `json:"author,omitempty"`
-	// BookIsbn holds the value of the "book_isbn" field.
-	BookIsbn string `json:"book_isbn,omitempty"`
-	// ThumbnailURL holds the value of the "thumbnail_url" field.
-	ThumbnailURL string `json:"thumbnail_url,omitempty"`
-	// Status holds the value of the "status" field.
-	Status int `json:"status,omitempty"`
+	// 읽기 상태
+	ReadingStatus book.ReadingStatus `json:"reading_status,omitempty"`
+	// 읽기 시작한 시간
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// 다 읽은 시간
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// 현재 읽고 있는 페이지
+	CurrentPage int `json:"current_page,omitempty"`
+	// 전체 페이지 수 (0이면 알 수 없음)
+	TotalPages int `json:"total_pages,omitempty"`
+	// 사용자가 고친 제목 (null이면 카탈로그 값을 사용)
+	TitleOverride *string `json:"title_override,omitempty"`
+	// 사용자가 고친 저자 (null이면 카탈로그 값을 사용)
+	AuthorOverride *string `json:"author_override,omitempty"`
+	// 사용자가 고친 출판사 (null이면 카탈로그 값을 사용)
+	PublisherOverride *string `json:"publisher_override,omitempty"`
+	// 사용자가 고친 출간일 (null이면 카탈로그 값을 사용)
+	PublishedDateOverride *string `json:"published_date_override,omitempty"`
+	// 사용자가 고친 표지 이미지 URL (null이면 카탈로그 값을 사용)
+	ThumbnailURLOverride *string `json:"thumbnail_url_override,omitempty"`
+	// 보관 위치: 방
+	LocationRoom string `json:"location_room,omitempty"`
+	// 보관 위치: 책장
+	LocationBookcase string `json:"location_bookcase,omitempty"`
+	// 보관 위치: 책장의 칸
+	LocationShelf string `json:"location_shelf,omitempty"`
+	// 실물 책의 상태
+	Condition *book.Condition `json:"condition,omitempty"`
+	// 책의 형태
+	Format *book.Format `json:"format,omitempty"`
+	// 구입일
+	PurchasedAt *time.Time `json:"purchased_at,omitempty"`
+	// 구입 가격
+	Price *float64 `json:"price,omitempty"`
+	// 구입 가격의 통화 (ISO 4217)
+	Currency string `json:"currency,omitempty"`
+	// 구입처 또는 입수 경로
+	AcquiredFrom string `json:"acquired_from,omitempty"`
+	// 서재에 있는 책이면 true, 휴지통에 있으면 null (같은 책의 중복 등록을 막는 유니크 인덱스용)
+	Live *bool `json:"live,omitempty"`
+	// 낙관적 동시성 제어용 버전 (수정할 때마다 1씩 증가)
+	Version int `json:"version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 휴지통으로 옮긴 시간 (null이면 삭제되지 않음)
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// 책을 함께 쓰는 공유 서재 (null이면 소유자 개인 서재)
+	LibraryID *uuid.UUID `json:"library_id,omitempty"`
+	// 공개 프로필에서의 공개 범위 (inherit: 책장 설정을 따름)
+	Visibility book.Visibility `json:"visibility,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the BookQuery when eager-loading is set.
-	Edges        BookEdges `json:"edges"`
-	user_books   *uuid.UUID
-	selectValues sql.SelectValues
+	Edges               BookEdges `json:"edges"`
+	book_catalog_copies *uuid.UUID
+	user_books          *uuid.UUID
+	selectValues        sql.SelectValues
 }
 
 // BookEdges holds the relations/edges for other nodes in the graph.
 type BookEdges struct {
 	// Owner holds the value of the owner edge.
 	Owner *User `json:"owner,omitempty"`
+	// Catalog holds the value of the catalog edge.
+	Catalog *BookCatalog `json:"catalog,omitempty"`
+	// Library holds the value of the library edge.
+	Library *Library `json:"library,omitempty"`
 	// Reviews holds the value of the reviews edge.
 	Reviews []*Review `json:"reviews,omitempty"`
 	// Bookmarks holds the value of the bookmarks edge.
 	Bookmarks []*Bookmark `json:"bookmarks,omitempty"`
+	// ReadingSessions holds the value of the reading_sessions edge.
+	ReadingSessions []*ReadingSession `json:"reading_sessions,omitempty"`
+	// StatusHistories holds the value of the status_histories edge.
+	StatusHistories []*BookStatusHistory `json:"status_histories,omitempty"`
+	// Shelves holds the value of the shelves edge.
+	Shelves []*Shelf `json:"shelves,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Notes holds the value of the notes edge.
+	Notes []*BookNote `json:"notes,omitempty"`
+	// Loans holds the value of the loans edge.
+	Loans []*Loan `json:"loans,omitempty"`
+	// BorrowRequests holds the value of the borrow_requests edge.
+	BorrowRequests []*BorrowRequest `json:"borrow_requests,omitempty"`
+	// ShelfBooks holds the value of the shelf_books edge.
+	ShelfBooks []*ShelfBook `json:"shelf_books,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [13]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -64,10 +125,32 @@ func (e BookEdges) OwnerOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "owner"}
 }
 
+// CatalogOrErr returns the Catalog value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookEdges) CatalogOrErr() (*BookCatalog, error) {
+	if e.Catalog != nil {
+		return e.Catalog, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: bookcatalog.Label}
+	}
+	return nil, &NotLoadedError{edge: "catalog"}
+}
+
+// LibraryOrErr returns the Library value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookEdges) LibraryOrErr() (*Library, error) {
+	if e.Library != nil {
+		return e.Library, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: library.Label}
+	}
+	return nil, &NotLoadedError{edge: "library"}
+}
+
 // ReviewsOrErr returns the Reviews value or an error if the edge
 // was not loaded in eager-loading.
 func (e BookEdges) ReviewsOrErr() ([]*Review, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[3] {
 		return e.Reviews, nil
 	}
 	return nil, &NotLoadedError{edge: "reviews"}
@@ -76,26 +159,106 @@ func (e BookEdges) ReviewsOrErr() ([]*Review, error) {
 // BookmarksOrErr returns the Bookmarks value or an error if the edge
 // was not loaded in eager-loading.
 func (e BookEdges) BookmarksOrErr() ([]*Bookmark, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[4] {
 		return e.Bookmarks, nil
 	}
 	return nil, &NotLoadedError{edge: "bookmarks"}
 }
 
+// ReadingSessionsOrErr returns the ReadingSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) ReadingSessionsOrErr() ([]*ReadingSession, error) {
+	if e.loadedTypes[5] {
+		return e.ReadingSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "reading_sessions"}
+}
+
+// StatusHistoriesOrErr returns the StatusHistories value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) StatusHistoriesOrErr() ([]*BookStatusHistory, error) {
+	if e.loadedTypes[6] {
+		return e.StatusHistories, nil
+	}
+	return nil, &NotLoadedError{edge: "status_histories"}
+}
+
+// ShelvesOrErr returns the Shelves value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) ShelvesOrErr() ([]*Shelf, error) {
+	if e.loadedTypes[7] {
+		return e.Shelves, nil
+	}
+	return nil, &NotLoadedError{edge: "shelves"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[8] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// NotesOrErr returns the Notes value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) NotesOrErr() ([]*BookNote, error) {
+	if e.loadedTypes[9] {
+		return e.Notes, nil
+	}
+	return nil, &NotLoadedError{edge: "notes"}
+}
+
+// LoansOrErr returns the Loans value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) LoansOrErr() ([]*Loan, error) {
+	if e.loadedTypes[10] {
+		return e.Loans, nil
+	}
+	return nil, &NotLoadedError{edge: "loans"}
+}
+
+// BorrowRequestsOrErr returns the BorrowRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) BorrowRequestsOrErr() ([]*BorrowRequest, error) {
+	if e.loadedTypes[11] {
+		return e.BorrowRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "borrow_requests"}
+}
+
+// ShelfBooksOrErr returns the ShelfBooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookEdges) ShelfBooksOrErr() ([]*ShelfBook, error) {
+	if e.loadedTypes[12] {
+		return e.ShelfBooks, nil
+	}
+	return nil, &NotLoadedError{edge: "shelf_books"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Book) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case book.FieldStatus:
+		case book.FieldLibraryID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case book.FieldLive:
+			values[i] = new(sql.NullBool)
+		case book.FieldPrice:
+			values[i] = new(sql.NullFloat64)
+		case book.FieldCurrentPage, book.FieldTotalPages, book.FieldVersion:
 			values[i] = new(sql.NullInt64)
-		case book.FieldBookTitle, book.FieldAuthor, book.FieldBookIsbn, book.FieldThumbnailURL:
+		case book.FieldReadingStatus, book.FieldTitleOverride, book.FieldAuthorOverride, book.FieldPublisherOverride, book.FieldPublishedDateOverride, book.FieldThumbnailURLOverride, book.FieldLocationRoom, book.FieldLocationBookcase, book.FieldLocationShelf, book.FieldCondition, book.FieldFormat, book.FieldCurrency, book.FieldAcquiredFrom, book.FieldVisibility:
 			values[i] = new(sql.NullString)
-		case book.FieldCreatedAt, book.FieldUpdatedAt:
+		case book.FieldStartedAt, book.FieldFinishedAt, book.FieldPurchasedAt, book.FieldCreatedAt, book.FieldUpdatedAt, book.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case book.FieldID:
 			values[i] = new(uuid.UUID)
-		case book.ForeignKeys[0]: // user_books
+		case book.ForeignKeys[0]: // book_catalog_copies
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case book.ForeignKeys[1]: // user_books
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -118,35 +281,143 @@ func (_m *Book) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.ID = *value
 			}
-		case book.FieldBookTitle:
+		case book.FieldReadingStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reading_status", values[i])
+			} else if value.Valid {
+				_m.ReadingStatus = book.ReadingStatus(value.String)
+			}
+		case book.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = new(time.Time)
+				*_m.StartedAt = value.Time
+			}
+		case book.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				_m.FinishedAt = new(time.Time)
+				*_m.FinishedAt = value.Time
+			}
+		case book.FieldCurrentPage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field current_page", values[i])
+			} else if value.Valid {
+				_m.CurrentPage = int(value.Int64)
+			}
+		case book.FieldTotalPages:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_pages", values[i])
+			} else if value.Valid {
+				_m.TotalPages = int(value.Int64)
+			}
+		case book.FieldTitleOverride:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title_override", values[i])
+			} else if value.Valid {
+				_m.TitleOverride = new(string)
+				*_m.TitleOverride = value.String
+			}
+		case book.FieldAuthorOverride:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author_override", values[i])
+			} else if value.Valid {
+				_m.AuthorOverride = new(string)
+				*_m.AuthorOverride = value.String
+			}
+		case book.FieldPublisherOverride:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field book_title", values[i])
+				return fmt.Errorf("unexpected type %T for field publisher_override", values[i])
 			} else if value.Valid {
-				_m.BookTitle = value.String
+				_m.PublisherOverride = new(string)
+				*_m.PublisherOverride = value.String
 			}
-		case book.FieldAuthor:
+		case book.FieldPublishedDateOverride:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field author", values[i])
+				return fmt.Errorf("unexpected type %T for field published_date_override", values[i])
 			} else if value.Valid {
-				_m.Author = value.String
+				_m.PublishedDateOverride = new(string)
+				*_m.PublishedDateOverride = value.String
 			}
-		case book.FieldBookIsbn:
+		case book.FieldThumbnailURLOverride:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field book_isbn", values[i])
+				return fmt.Errorf("unexpected type %T for field thumbnail_url_override", values[i])
 			} else if value.Valid {
-				_m.BookIsbn = value.String
+				_m.ThumbnailURLOverride = new(string)
+				*_m.ThumbnailURLOverride = value.String
 			}
-		case book.FieldThumbnailURL:
+		case book.FieldLocationRoom:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+				return fmt.Errorf("unexpected type %T for field location_room", values[i])
 			} else if value.Valid {
-				_m.ThumbnailURL = value.String
+				_m.LocationRoom = value.String
 			}
-		case book.FieldStatus:
+		case book.FieldLocationBookcase:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location_bookcase", values[i])
+			} else if value.Valid {
+				_m.LocationBookcase = value.String
+			}
+		case book.FieldLocationShelf:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location_shelf", values[i])
+			} else if value.Valid {
+				_m.LocationShelf = value.String
+			}
+		case book.FieldCondition:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field condition", values[i])
+			} else if value.Valid {
+				_m.Condition = new(book.Condition)
+				*_m.Condition = book.Condition(value.String)
+			}
+		case book.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = new(book.Format)
+				*_m.Format = book.Format(value.String)
+			}
+		case book.FieldPurchasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field purchased_at", values[i])
+			} else if value.Valid {
+				_m.PurchasedAt = new(time.Time)
+				*_m.PurchasedAt = value.Time
+			}
+		case book.FieldPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				_m.Price = new(float64)
+				*_m.Price = value.Float64
+			}
+		case book.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case book.FieldAcquiredFrom:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field acquired_from", values[i])
+			} else if value.Valid {
+				_m.AcquiredFrom = value.String
+			}
+		case book.FieldLive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field live", values[i])
+			} else if value.Valid {
+				_m.Live = new(bool)
+				*_m.Live = value.Bool
+			}
+		case book.FieldVersion:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for field status", values[i])
+				return fmt.Errorf("unexpected type %T for field version", values[i])
 			} else if value.Valid {
-				_m.Status = int(value.Int64)
+				_m.Version = int(value.Int64)
 			}
 		case book.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -160,7 +431,34 @@ func (_m *Book) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case book.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case book.FieldLibraryID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field library_id", values[i])
+			} else if value.Valid {
+				_m.LibraryID = new(uuid.UUID)
+				*_m.LibraryID = *value.S.(*uuid.UUID)
+			}
+		case book.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = book.Visibility(value.String)
+			}
 		case book.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field book_catalog_copies", values[i])
+			} else if value.Valid {
+				_m.book_catalog_copies = new(uuid.UUID)
+				*_m.book_catalog_copies = *value.S.(*uuid.UUID)
+			}
+		case book.ForeignKeys[1]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_books", values[i])
 			} else if value.Valid {
@@ -185,6 +483,16 @@ func (_m *Book) QueryOwner() *UserQuery {
 	return NewBookClient(_m.config).QueryOwner(_m)
 }
 
+// QueryCatalog queries the "catalog" edge of the Book entity.
+func (_m *Book) QueryCatalog() *BookCatalogQuery {
+	return NewBookClient(_m.config).QueryCatalog(_m)
+}
+
+// QueryLibrary queries the "library" edge of the Book entity.
+func (_m *Book) QueryLibrary() *LibraryQuery {
+	return NewBookClient(_m.config).QueryLibrary(_m)
+}
+
 // QueryReviews queries the "reviews" edge of the Book entity.
 func (_m *Book) QueryReviews() *ReviewQuery {
 	return NewBookClient(_m.config).QueryReviews(_m)
@@ -195,6 +503,46 @@ func (_m *Book) QueryBookmarks() *BookmarkQuery {
 	return NewBookClient(_m.config).QueryBookmarks(_m)
 }
 
+// QueryReadingSessions queries the "reading_sessions" edge of the Book entity.
+func (_m *Book) QueryReadingSessions() *ReadingSessionQuery {
+	return NewBookClient(_m.config).QueryReadingSessions(_m)
+}
+
+// QueryStatusHistories queries the "status_histories" edge of the Book entity.
+func (_m *Book) QueryStatusHistories() *BookStatusHistoryQuery {
+	return NewBookClient(_m.config).QueryStatusHistories(_m)
+}
+
+// QueryShelves queries the "shelves" edge of the Book entity.
+func (_m *Book) QueryShelves() *ShelfQuery {
+	return NewBookClient(_m.config).QueryShelves(_m)
+}
+
+// QueryTags queries the "tags" edge of the Book entity.
+func (_m *Book) QueryTags() *TagQuery {
+	return NewBookClient(_m.config).QueryTags(_m)
+}
+
+// QueryNotes queries the "notes" edge of the Book entity.
+func (_m *Book) QueryNotes() *BookNoteQuery {
+	return NewBookClient(_m.config).QueryNotes(_m)
+}
+
+// QueryLoans queries the "loans" edge of the Book entity.
+func (_m *Book) QueryLoans() *LoanQuery {
+	return NewBookClient(_m.config).QueryLoans(_m)
+}
+
+// QueryBorrowRequests queries the "borrow_requests" edge of the Book entity.
+func (_m *Book) QueryBorrowRequests() *BorrowRequestQuery {
+	return NewBookClient(_m.config).QueryBorrowRequests(_m)
+}
+
+// QueryShelfBooks queries the "shelf_books" edge of the Book entity.
+func (_m *Book) QueryShelfBooks() *ShelfBookQuery {
+	return NewBookClient(_m.config).QueryShelfBooks(_m)
+}
+
 // Update returns a builder for updating this Book.
 // Note that you need to call Book.Unwrap() before calling this method if this Book
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -218,26 +566,111 @@ func (_m *Book) String() string {
 	var builder strings.Builder
 	builder.WriteString("Book(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
-	builder.WriteString("book_title=")
-	builder.WriteString(_m.BookTitle)
+	builder.WriteString("reading_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReadingStatus))
+	builder.WriteString(", ")
+	if v := _m.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("current_page=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CurrentPage))
+	builder.WriteString(", ")
+	builder.WriteString("total_pages=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalPages))
+	builder.WriteString(", ")
+	if v := _m.TitleOverride; v != nil {
+		builder.WriteString("title_override=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.AuthorOverride; v != nil {
+		builder.WriteString("author_override=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PublisherOverride; v != nil {
+		builder.WriteString("publisher_override=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.PublishedDateOverride; v != nil {
+		builder.WriteString("published_date_override=")
+		builder.WriteString(*v)
+	}
 	builder.WriteString(", ")
-	builder.WriteString("author=")
-	builder.WriteString(_m.Author)
+	if v := _m.ThumbnailURLOverride; v != nil {
+		builder.WriteString("thumbnail_url_override=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("location_room=")
+	builder.WriteString(_m.LocationRoom)
+	builder.WriteString(", ")
+	builder.WriteString("location_bookcase=")
+	builder.WriteString(_m.LocationBookcase)
+	builder.WriteString(", ")
+	builder.WriteString("location_shelf=")
+	builder.WriteString(_m.LocationShelf)
+	builder.WriteString(", ")
+	if v := _m.Condition; v != nil {
+		builder.WriteString("condition=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
-	builder.WriteString("book_isbn=")
-	builder.WriteString(_m.BookIsbn)
+	if v := _m.Format; v != nil {
+		builder.WriteString("format=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
-	builder.WriteString("thumbnail_url=")
-	builder.WriteString(_m.ThumbnailURL)
+	if v := _m.PurchasedAt; v != nil {
+		builder.WriteString("purchased_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.Price; v != nil {
+		builder.WriteString("price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
-	builder.WriteString("status=")
-	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("acquired_from=")
+	builder.WriteString(_m.AcquiredFrom)
+	builder.WriteString(", ")
+	if v := _m.Live; v != nil {
+		builder.WriteString("live=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LibraryID; v != nil {
+		builder.WriteString("library_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
 	builder.WriteByte(')')
 	return builder.String()
 }
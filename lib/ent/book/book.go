@@ -3,6 +3,7 @@
 package book
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -14,26 +15,84 @@ const (
 	Label = "book"
 	// FieldID holds the string denoting the id field in the database.
 	FieldID = "id"
-	// FieldBookTitle holds the string denoting the book_title field in the database.
-	FieldBookTitle = "book_title"
-	// FieldAuthor holds the string denoting the author field in the database.
-	FieldAuthor = "author"
-	// FieldBookIsbn holds the string denoting the book_isbn field in the database.
-	FieldBookIsbn = "book_isbn"
-	// FieldThumbnailURL holds the string denoting the thumbnail_url field in the database.
-	FieldThumbnailURL = "thumbnail_url"
-	// FieldStatus holds the string denoting the status field in the database.
-	FieldStatus = "status"
+	// FieldReadingStatus holds the string denoting the reading_status field in the database.
+	FieldReadingStatus = "reading_status"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldCurrentPage holds the string denoting the current_page field in the database.
+	FieldCurrentPage = "current_page"
+	// FieldTotalPages holds the string denoting the total_pages field in the database.
+	FieldTotalPages = "total_pages"
+	// FieldTitleOverride holds the string denoting the title_override field in the database.
+	FieldTitleOverride = "title_override"
+	// FieldAuthorOverride holds the string denoting the author_override field in the database.
+	FieldAuthorOverride = "author_override"
+	// FieldPublisherOverride holds the string denoting the publisher_override field in the database.
+	FieldPublisherOverride = "publisher_override"
+	// FieldPublishedDateOverride holds the string denoting the published_date_override field in the database.
+	FieldPublishedDateOverride = "published_date_override"
+	// FieldThumbnailURLOverride holds the string denoting the thumbnail_url_override field in the database.
+	FieldThumbnailURLOverride = "thumbnail_url_override"
+	// FieldLocationRoom holds the string denoting the location_room field in the database.
+	FieldLocationRoom = "location_room"
+	// FieldLocationBookcase holds the string denoting the location_bookcase field in the database.
+	FieldLocationBookcase = "location_bookcase"
+	// FieldLocationShelf holds the string denoting the location_shelf field in the database.
+	FieldLocationShelf = "location_shelf"
+	// FieldCondition holds the string denoting the condition field in the database.
+	FieldCondition = "condition"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldPurchasedAt holds the string denoting the purchased_at field in the database.
+	FieldPurchasedAt = "purchased_at"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldAcquiredFrom holds the string denoting the acquired_from field in the database.
+	FieldAcquiredFrom = "acquired_from"
+	// FieldLive holds the string denoting the live field in the database.
+	FieldLive = "live"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldLibraryID holds the string denoting the library_id field in the database.
+	FieldLibraryID = "library_id"
+	// FieldVisibility holds the string denoting the visibility field in the database.
+	FieldVisibility = "visibility"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
+	// EdgeCatalog holds the string denoting the catalog edge name in mutations.
+	EdgeCatalog = "catalog"
+	// EdgeLibrary holds the string denoting the library edge name in mutations.
+	EdgeLibrary = "library"
 	// EdgeReviews holds the string denoting the reviews edge name in mutations.
 	EdgeReviews = "reviews"
 	// EdgeBookmarks holds the string denoting the bookmarks edge name in mutations.
 	EdgeBookmarks = "bookmarks"
+	// EdgeReadingSessions holds the string denoting the reading_sessions edge name in mutations.
+	EdgeReadingSessions = "reading_sessions"
+	// EdgeStatusHistories holds the string denoting the status_histories edge name in mutations.
+	EdgeStatusHistories = "status_histories"
+	// EdgeShelves holds the string denoting the shelves edge name in mutations.
+	EdgeShelves = "shelves"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeNotes holds the string denoting the notes edge name in mutations.
+	EdgeNotes = "notes"
+	// EdgeLoans holds the string denoting the loans edge name in mutations.
+	EdgeLoans = "loans"
+	// EdgeBorrowRequests holds the string denoting the borrow_requests edge name in mutations.
+	EdgeBorrowRequests = "borrow_requests"
+	// EdgeShelfBooks holds the string denoting the shelf_books edge name in mutations.
+	EdgeShelfBooks = "shelf_books"
 	// Table holds the table name of the book in the database.
 	Table = "books"
 	// OwnerTable is the table that holds the owner relation/edge.
@@ -43,6 +102,20 @@ const (
 	OwnerInverseTable = "users"
 	// OwnerColumn is the table column denoting the owner relation/edge.
 	OwnerColumn = "user_books"
+	// CatalogTable is the table that holds the catalog relation/edge.
+	CatalogTable = "books"
+	// CatalogInverseTable is the table name for the BookCatalog entity.
+	// It exists in this package in order to avoid circular dependency with the "bookcatalog" package.
+	CatalogInverseTable = "book_catalogs"
+	// CatalogColumn is the table column denoting the catalog relation/edge.
+	CatalogColumn = "book_catalog_copies"
+	// LibraryTable is the table that holds the library relation/edge.
+	LibraryTable = "books"
+	// LibraryInverseTable is the table name for the Library entity.
+	// It exists in this package in order to avoid circular dependency with the "library" package.
+	LibraryInverseTable = "libraries"
+	// LibraryColumn is the table column denoting the library relation/edge.
+	LibraryColumn = "library_id"
 	// ReviewsTable is the table that holds the reviews relation/edge.
 	ReviewsTable = "reviews"
 	// ReviewsInverseTable is the table name for the Review entity.
@@ -57,26 +130,107 @@ const (
 	BookmarksInverseTable = "bookmarks"
 	// BookmarksColumn is the table column denoting the bookmarks relation/edge.
 	BookmarksColumn = "book_bookmarks"
+	// ReadingSessionsTable is the table that holds the reading_sessions relation/edge.
+	ReadingSessionsTable = "reading_sessions"
+	// ReadingSessionsInverseTable is the table name for the ReadingSession entity.
+	// It exists in this package in order to avoid circular dependency with the "readingsession" package.
+	ReadingSessionsInverseTable = "reading_sessions"
+	// ReadingSessionsColumn is the table column denoting the reading_sessions relation/edge.
+	ReadingSessionsColumn = "book_reading_sessions"
+	// StatusHistoriesTable is the table that holds the status_histories relation/edge.
+	StatusHistoriesTable = "book_status_histories"
+	// StatusHistoriesInverseTable is the table name for the BookStatusHistory entity.
+	// It exists in this package in order to avoid circular dependency with the "bookstatushistory" package.
+	StatusHistoriesInverseTable = "book_status_histories"
+	// StatusHistoriesColumn is the table column denoting the status_histories relation/edge.
+	StatusHistoriesColumn = "book_status_histories"
+	// ShelvesTable is the table that holds the shelves relation/edge. The primary key declared below.
+	ShelvesTable = "shelf_books"
+	// ShelvesInverseTable is the table name for the Shelf entity.
+	// It exists in this package in order to avoid circular dependency with the "shelf" package.
+	ShelvesInverseTable = "shelves"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "tag_books"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// NotesTable is the table that holds the notes relation/edge.
+	NotesTable = "book_notes"
+	// NotesInverseTable is the table name for the BookNote entity.
+	// It exists in this package in order to avoid circular dependency with the "booknote" package.
+	NotesInverseTable = "book_notes"
+	// NotesColumn is the table column denoting the notes relation/edge.
+	NotesColumn = "book_notes"
+	// LoansTable is the table that holds the loans relation/edge.
+	LoansTable = "loans"
+	// LoansInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	LoansInverseTable = "loans"
+	// LoansColumn is the table column denoting the loans relation/edge.
+	LoansColumn = "book_loans"
+	// BorrowRequestsTable is the table that holds the borrow_requests relation/edge.
+	BorrowRequestsTable = "borrow_requests"
+	// BorrowRequestsInverseTable is the table name for the BorrowRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "borrowrequest" package.
+	BorrowRequestsInverseTable = "borrow_requests"
+	// BorrowRequestsColumn is the table column denoting the borrow_requests relation/edge.
+	BorrowRequestsColumn = "book_borrow_requests"
+	// ShelfBooksTable is the table that holds the shelf_books relation/edge.
+	ShelfBooksTable = "shelf_books"
+	// ShelfBooksInverseTable is the table name for the ShelfBook entity.
+	// It exists in this package in order to avoid circular dependency with the "shelfbook" package.
+	ShelfBooksInverseTable = "shelf_books"
+	// ShelfBooksColumn is the table column denoting the shelf_books relation/edge.
+	ShelfBooksColumn = "book_id"
 )
 
 // Columns holds all SQL columns for book fields.
 var Columns = []string{
 	FieldID,
-	FieldBookTitle,
-	FieldAuthor,
-	FieldBookIsbn,
-	FieldThumbnailURL,
-	FieldStatus,
+	FieldReadingStatus,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldCurrentPage,
+	FieldTotalPages,
+	FieldTitleOverride,
+	FieldAuthorOverride,
+	FieldPublisherOverride,
+	FieldPublishedDateOverride,
+	FieldThumbnailURLOverride,
+	FieldLocationRoom,
+	FieldLocationBookcase,
+	FieldLocationShelf,
+	FieldCondition,
+	FieldFormat,
+	FieldPurchasedAt,
+	FieldPrice,
+	FieldCurrency,
+	FieldAcquiredFrom,
+	FieldLive,
+	FieldVersion,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldLibraryID,
+	FieldVisibility,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "books"
 // table and are not defined as standalone fields in the schema.
 var ForeignKeys = []string{
+	"book_catalog_copies",
 	"user_books",
 }
 
+var (
+	// ShelvesPrimaryKey and ShelvesColumn2 are the table columns denoting the
+	// primary key for the shelves relation (M2M).
+	ShelvesPrimaryKey = []string{"shelf_id", "book_id"}
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"tag_id", "book_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -93,12 +247,30 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// BookTitleValidator is a validator for the "book_title" field. It is called by the builders before save.
-	BookTitleValidator func(string) error
-	// AuthorValidator is a validator for the "author" field. It is called by the builders before save.
-	AuthorValidator func(string) error
-	// DefaultStatus holds the default value on creation for the "status" field.
-	DefaultStatus int
+	// DefaultCurrentPage holds the default value on creation for the "current_page" field.
+	DefaultCurrentPage int
+	// CurrentPageValidator is a validator for the "current_page" field. It is called by the builders before save.
+	CurrentPageValidator func(int) error
+	// DefaultTotalPages holds the default value on creation for the "total_pages" field.
+	DefaultTotalPages int
+	// TotalPagesValidator is a validator for the "total_pages" field. It is called by the builders before save.
+	TotalPagesValidator func(int) error
+	// DefaultLocationRoom holds the default value on creation for the "location_room" field.
+	DefaultLocationRoom string
+	// DefaultLocationBookcase holds the default value on creation for the "location_bookcase" field.
+	DefaultLocationBookcase string
+	// DefaultLocationShelf holds the default value on creation for the "location_shelf" field.
+	DefaultLocationShelf string
+	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	PriceValidator func(float64) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultAcquiredFrom holds the default value on creation for the "acquired_from" field.
+	DefaultAcquiredFrom string
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	VersionValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -107,6 +279,113 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 )
 
+// ReadingStatus defines the type for the "reading_status" enum field.
+type ReadingStatus string
+
+// ReadingStatusUnread is the default value of the ReadingStatus enum.
+const DefaultReadingStatus = ReadingStatusUnread
+
+// ReadingStatus values.
+const (
+	ReadingStatusUnread    ReadingStatus = "unread"
+	ReadingStatusReading   ReadingStatus = "reading"
+	ReadingStatusFinished  ReadingStatus = "finished"
+	ReadingStatusPaused    ReadingStatus = "paused"
+	ReadingStatusAbandoned ReadingStatus = "abandoned"
+)
+
+func (rs ReadingStatus) String() string {
+	return string(rs)
+}
+
+// ReadingStatusValidator is a validator for the "reading_status" field enum values. It is called by the builders before save.
+func ReadingStatusValidator(rs ReadingStatus) error {
+	switch rs {
+	case ReadingStatusUnread, ReadingStatusReading, ReadingStatusFinished, ReadingStatusPaused, ReadingStatusAbandoned:
+		return nil
+	default:
+		return fmt.Errorf("book: invalid enum value for reading_status field: %q", rs)
+	}
+}
+
+// Condition defines the type for the "condition" enum field.
+type Condition string
+
+// Condition values.
+const (
+	ConditionNew     Condition = "new"
+	ConditionLikeNew Condition = "like_new"
+	ConditionGood    Condition = "good"
+	ConditionFair    Condition = "fair"
+	ConditionPoor    Condition = "poor"
+)
+
+func (c Condition) String() string {
+	return string(c)
+}
+
+// ConditionValidator is a validator for the "condition" field enum values. It is called by the builders before save.
+func ConditionValidator(c Condition) error {
+	switch c {
+	case ConditionNew, ConditionLikeNew, ConditionGood, ConditionFair, ConditionPoor:
+		return nil
+	default:
+		return fmt.Errorf("book: invalid enum value for condition field: %q", c)
+	}
+}
+
+// Format defines the type for the "format" enum field.
+type Format string
+
+// Format values.
+const (
+	FormatHardcover Format = "hardcover"
+	FormatPaperback Format = "paperback"
+	FormatEbook     Format = "ebook"
+	FormatAudiobook Format = "audiobook"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatHardcover, FormatPaperback, FormatEbook, FormatAudiobook:
+		return nil
+	default:
+		return fmt.Errorf("book: invalid enum value for format field: %q", f)
+	}
+}
+
+// Visibility defines the type for the "visibility" enum field.
+type Visibility string
+
+// VisibilityInherit is the default value of the Visibility enum.
+const DefaultVisibility = VisibilityInherit
+
+// Visibility values.
+const (
+	VisibilityInherit Visibility = "inherit"
+	VisibilityPublic  Visibility = "public"
+	VisibilityPrivate Visibility = "private"
+)
+
+func (v Visibility) String() string {
+	return string(v)
+}
+
+// VisibilityValidator is a validator for the "visibility" field enum values. It is called by the builders before save.
+func VisibilityValidator(v Visibility) error {
+	switch v {
+	case VisibilityInherit, VisibilityPublic, VisibilityPrivate:
+		return nil
+	default:
+		return fmt.Errorf("book: invalid enum value for visibility field: %q", v)
+	}
+}
+
 // OrderOption defines the ordering options for the Book queries.
 type OrderOption func(*sql.Selector)
 
@@ -115,29 +394,109 @@ func ByID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldID, opts...).ToFunc()
 }
 
-// ByBookTitle orders the results by the book_title field.
-func ByBookTitle(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldBookTitle, opts...).ToFunc()
+// ByReadingStatus orders the results by the reading_status field.
+func ByReadingStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadingStatus, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByCurrentPage orders the results by the current_page field.
+func ByCurrentPage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentPage, opts...).ToFunc()
+}
+
+// ByTotalPages orders the results by the total_pages field.
+func ByTotalPages(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalPages, opts...).ToFunc()
+}
+
+// ByTitleOverride orders the results by the title_override field.
+func ByTitleOverride(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitleOverride, opts...).ToFunc()
+}
+
+// ByAuthorOverride orders the results by the author_override field.
+func ByAuthorOverride(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorOverride, opts...).ToFunc()
+}
+
+// ByPublisherOverride orders the results by the publisher_override field.
+func ByPublisherOverride(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublisherOverride, opts...).ToFunc()
+}
+
+// ByPublishedDateOverride orders the results by the published_date_override field.
+func ByPublishedDateOverride(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedDateOverride, opts...).ToFunc()
+}
+
+// ByThumbnailURLOverride orders the results by the thumbnail_url_override field.
+func ByThumbnailURLOverride(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThumbnailURLOverride, opts...).ToFunc()
+}
+
+// ByLocationRoom orders the results by the location_room field.
+func ByLocationRoom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocationRoom, opts...).ToFunc()
+}
+
+// ByLocationBookcase orders the results by the location_bookcase field.
+func ByLocationBookcase(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocationBookcase, opts...).ToFunc()
+}
+
+// ByLocationShelf orders the results by the location_shelf field.
+func ByLocationShelf(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocationShelf, opts...).ToFunc()
+}
+
+// ByCondition orders the results by the condition field.
+func ByCondition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCondition, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// ByPurchasedAt orders the results by the purchased_at field.
+func ByPurchasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPurchasedAt, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
 }
 
-// ByAuthor orders the results by the author field.
-func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
 }
 
-// ByBookIsbn orders the results by the book_isbn field.
-func ByBookIsbn(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldBookIsbn, opts...).ToFunc()
+// ByAcquiredFrom orders the results by the acquired_from field.
+func ByAcquiredFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcquiredFrom, opts...).ToFunc()
 }
 
-// ByThumbnailURL orders the results by the thumbnail_url field.
-func ByThumbnailURL(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldThumbnailURL, opts...).ToFunc()
+// ByLive orders the results by the live field.
+func ByLive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLive, opts...).ToFunc()
 }
 
-// ByStatus orders the results by the status field.
-func ByStatus(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
 }
 
 // ByCreatedAt orders the results by the created_at field.
@@ -150,6 +509,21 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByLibraryID orders the results by the library_id field.
+func ByLibraryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLibraryID, opts...).ToFunc()
+}
+
+// ByVisibility orders the results by the visibility field.
+func ByVisibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVisibility, opts...).ToFunc()
+}
+
 // ByOwnerField orders the results by owner field.
 func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -157,6 +531,20 @@ func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByCatalogField orders the results by catalog field.
+func ByCatalogField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCatalogStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLibraryField orders the results by library field.
+func ByLibraryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLibraryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByReviewsCount orders the results by reviews count.
 func ByReviewsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -184,6 +572,118 @@ func ByBookmarks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newBookmarksStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByReadingSessionsCount orders the results by reading_sessions count.
+func ByReadingSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReadingSessionsStep(), opts...)
+	}
+}
+
+// ByReadingSessions orders the results by reading_sessions terms.
+func ByReadingSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReadingSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStatusHistoriesCount orders the results by status_histories count.
+func ByStatusHistoriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusHistoriesStep(), opts...)
+	}
+}
+
+// ByStatusHistories orders the results by status_histories terms.
+func ByStatusHistories(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusHistoriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShelvesCount orders the results by shelves count.
+func ByShelvesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShelvesStep(), opts...)
+	}
+}
+
+// ByShelves orders the results by shelves terms.
+func ByShelves(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShelvesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByNotesCount orders the results by notes count.
+func ByNotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newNotesStep(), opts...)
+	}
+}
+
+// ByNotes orders the results by notes terms.
+func ByNotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newNotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLoansCount orders the results by loans count.
+func ByLoansCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoansStep(), opts...)
+	}
+}
+
+// ByLoans orders the results by loans terms.
+func ByLoans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoansStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBorrowRequestsCount orders the results by borrow_requests count.
+func ByBorrowRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBorrowRequestsStep(), opts...)
+	}
+}
+
+// ByBorrowRequests orders the results by borrow_requests terms.
+func ByBorrowRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBorrowRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShelfBooksCount orders the results by shelf_books count.
+func ByShelfBooksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShelfBooksStep(), opts...)
+	}
+}
+
+// ByShelfBooks orders the results by shelf_books terms.
+func ByShelfBooks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShelfBooksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOwnerStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -191,6 +691,20 @@ func newOwnerStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
 	)
 }
+func newCatalogStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CatalogInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CatalogTable, CatalogColumn),
+	)
+}
+func newLibraryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LibraryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LibraryTable, LibraryColumn),
+	)
+}
 func newReviewsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -205,3 +719,59 @@ func newBookmarksStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, BookmarksTable, BookmarksColumn),
 	)
 }
+func newReadingSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReadingSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReadingSessionsTable, ReadingSessionsColumn),
+	)
+}
+func newStatusHistoriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusHistoriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusHistoriesTable, StatusHistoriesColumn),
+	)
+}
+func newShelvesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShelvesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, ShelvesTable, ShelvesPrimaryKey...),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newNotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(NotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, NotesTable, NotesColumn),
+	)
+}
+func newLoansStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoansInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LoansTable, LoansColumn),
+	)
+}
+func newBorrowRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BorrowRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BorrowRequestsTable, BorrowRequestsColumn),
+	)
+}
+func newShelfBooksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShelfBooksInverseTable, ShelfBooksColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, ShelfBooksTable, ShelfBooksColumn),
+	)
+}
@@ -56,29 +56,94 @@ func IDLTE(id uuid.UUID) predicate.Book {
 	return predicate.Book(sql.FieldLTE(FieldID, id))
 }
 
-// BookTitle applies equality check predicate on the "book_title" field. It's identical to BookTitleEQ.
-func BookTitle(v string) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldBookTitle, v))
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldStartedAt, v))
 }
 
-// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
-func Author(v string) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldAuthor, v))
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldFinishedAt, v))
 }
 
-// BookIsbn applies equality check predicate on the "book_isbn" field. It's identical to BookIsbnEQ.
-func BookIsbn(v string) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldBookIsbn, v))
+// CurrentPage applies equality check predicate on the "current_page" field. It's identical to CurrentPageEQ.
+func CurrentPage(v int) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldCurrentPage, v))
 }
 
-// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
-func ThumbnailURL(v string) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldThumbnailURL, v))
+// TotalPages applies equality check predicate on the "total_pages" field. It's identical to TotalPagesEQ.
+func TotalPages(v int) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldTotalPages, v))
 }
 
-// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
-func Status(v int) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldStatus, v))
+// TitleOverride applies equality check predicate on the "title_override" field. It's identical to TitleOverrideEQ.
+func TitleOverride(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldTitleOverride, v))
+}
+
+// AuthorOverride applies equality check predicate on the "author_override" field. It's identical to AuthorOverrideEQ.
+func AuthorOverride(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldAuthorOverride, v))
+}
+
+// PublisherOverride applies equality check predicate on the "publisher_override" field. It's identical to PublisherOverrideEQ.
+func PublisherOverride(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldPublisherOverride, v))
+}
+
+// PublishedDateOverride applies equality check predicate on the "published_date_override" field. It's identical to PublishedDateOverrideEQ.
+func PublishedDateOverride(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldPublishedDateOverride, v))
+}
+
+// ThumbnailURLOverride applies equality check predicate on the "thumbnail_url_override" field. It's identical to ThumbnailURLOverrideEQ.
+func ThumbnailURLOverride(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldThumbnailURLOverride, v))
+}
+
+// LocationRoom applies equality check predicate on the "location_room" field. It's identical to LocationRoomEQ.
+func LocationRoom(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLocationRoom, v))
+}
+
+// LocationBookcase applies equality check predicate on the "location_bookcase" field. It's identical to LocationBookcaseEQ.
+func LocationBookcase(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLocationBookcase, v))
+}
+
+// LocationShelf applies equality check predicate on the "location_shelf" field. It's identical to LocationShelfEQ.
+func LocationShelf(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLocationShelf, v))
+}
+
+// PurchasedAt applies equality check predicate on the "purchased_at" field. It's identical to PurchasedAtEQ.
+func PurchasedAt(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldPurchasedAt, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v float64) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldPrice, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldCurrency, v))
+}
+
+// AcquiredFrom applies equality check predicate on the "acquired_from" field. It's identical to AcquiredFromEQ.
+func AcquiredFrom(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldAcquiredFrom, v))
+}
+
+// Live applies equality check predicate on the "live" field. It's identical to LiveEQ.
+func Live(v bool) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLive, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldVersion, v))
 }
 
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
@@ -86,329 +151,1139 @@ func CreatedAt(v time.Time) predicate.Book {
 	return predicate.Book(sql.FieldEQ(FieldCreatedAt, v))
 }
 
-// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
-func UpdatedAt(v time.Time) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldUpdatedAt, v))
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// LibraryID applies equality check predicate on the "library_id" field. It's identical to LibraryIDEQ.
+func LibraryID(v uuid.UUID) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLibraryID, v))
+}
+
+// ReadingStatusEQ applies the EQ predicate on the "reading_status" field.
+func ReadingStatusEQ(v ReadingStatus) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldReadingStatus, v))
+}
+
+// ReadingStatusNEQ applies the NEQ predicate on the "reading_status" field.
+func ReadingStatusNEQ(v ReadingStatus) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldReadingStatus, v))
+}
+
+// ReadingStatusIn applies the In predicate on the "reading_status" field.
+func ReadingStatusIn(vs ...ReadingStatus) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldReadingStatus, vs...))
+}
+
+// ReadingStatusNotIn applies the NotIn predicate on the "reading_status" field.
+func ReadingStatusNotIn(vs ...ReadingStatus) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldReadingStatus, vs...))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// StartedAtIsNil applies the IsNil predicate on the "started_at" field.
+func StartedAtIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldStartedAt))
+}
+
+// StartedAtNotNil applies the NotNil predicate on the "started_at" field.
+func StartedAtNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldStartedAt))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// CurrentPageEQ applies the EQ predicate on the "current_page" field.
+func CurrentPageEQ(v int) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldCurrentPage, v))
+}
+
+// CurrentPageNEQ applies the NEQ predicate on the "current_page" field.
+func CurrentPageNEQ(v int) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldCurrentPage, v))
+}
+
+// CurrentPageIn applies the In predicate on the "current_page" field.
+func CurrentPageIn(vs ...int) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldCurrentPage, vs...))
+}
+
+// CurrentPageNotIn applies the NotIn predicate on the "current_page" field.
+func CurrentPageNotIn(vs ...int) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldCurrentPage, vs...))
+}
+
+// CurrentPageGT applies the GT predicate on the "current_page" field.
+func CurrentPageGT(v int) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldCurrentPage, v))
+}
+
+// CurrentPageGTE applies the GTE predicate on the "current_page" field.
+func CurrentPageGTE(v int) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldCurrentPage, v))
+}
+
+// CurrentPageLT applies the LT predicate on the "current_page" field.
+func CurrentPageLT(v int) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldCurrentPage, v))
+}
+
+// CurrentPageLTE applies the LTE predicate on the "current_page" field.
+func CurrentPageLTE(v int) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldCurrentPage, v))
+}
+
+// TotalPagesEQ applies the EQ predicate on the "total_pages" field.
+func TotalPagesEQ(v int) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldTotalPages, v))
+}
+
+// TotalPagesNEQ applies the NEQ predicate on the "total_pages" field.
+func TotalPagesNEQ(v int) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldTotalPages, v))
+}
+
+// TotalPagesIn applies the In predicate on the "total_pages" field.
+func TotalPagesIn(vs ...int) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldTotalPages, vs...))
+}
+
+// TotalPagesNotIn applies the NotIn predicate on the "total_pages" field.
+func TotalPagesNotIn(vs ...int) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldTotalPages, vs...))
+}
+
+// TotalPagesGT applies the GT predicate on the "total_pages" field.
+func TotalPagesGT(v int) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldTotalPages, v))
+}
+
+// TotalPagesGTE applies the GTE predicate on the "total_pages" field.
+func TotalPagesGTE(v int) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldTotalPages, v))
+}
+
+// TotalPagesLT applies the LT predicate on the "total_pages" field.
+func TotalPagesLT(v int) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldTotalPages, v))
+}
+
+// TotalPagesLTE applies the LTE predicate on the "total_pages" field.
+func TotalPagesLTE(v int) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldTotalPages, v))
+}
+
+// TitleOverrideEQ applies the EQ predicate on the "title_override" field.
+func TitleOverrideEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldTitleOverride, v))
+}
+
+// TitleOverrideNEQ applies the NEQ predicate on the "title_override" field.
+func TitleOverrideNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldTitleOverride, v))
+}
+
+// TitleOverrideIn applies the In predicate on the "title_override" field.
+func TitleOverrideIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldTitleOverride, vs...))
+}
+
+// TitleOverrideNotIn applies the NotIn predicate on the "title_override" field.
+func TitleOverrideNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldTitleOverride, vs...))
+}
+
+// TitleOverrideGT applies the GT predicate on the "title_override" field.
+func TitleOverrideGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldTitleOverride, v))
+}
+
+// TitleOverrideGTE applies the GTE predicate on the "title_override" field.
+func TitleOverrideGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldTitleOverride, v))
+}
+
+// TitleOverrideLT applies the LT predicate on the "title_override" field.
+func TitleOverrideLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldTitleOverride, v))
+}
+
+// TitleOverrideLTE applies the LTE predicate on the "title_override" field.
+func TitleOverrideLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldTitleOverride, v))
+}
+
+// TitleOverrideContains applies the Contains predicate on the "title_override" field.
+func TitleOverrideContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldTitleOverride, v))
+}
+
+// TitleOverrideHasPrefix applies the HasPrefix predicate on the "title_override" field.
+func TitleOverrideHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldTitleOverride, v))
+}
+
+// TitleOverrideHasSuffix applies the HasSuffix predicate on the "title_override" field.
+func TitleOverrideHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldTitleOverride, v))
+}
+
+// TitleOverrideIsNil applies the IsNil predicate on the "title_override" field.
+func TitleOverrideIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldTitleOverride))
+}
+
+// TitleOverrideNotNil applies the NotNil predicate on the "title_override" field.
+func TitleOverrideNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldTitleOverride))
+}
+
+// TitleOverrideEqualFold applies the EqualFold predicate on the "title_override" field.
+func TitleOverrideEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldTitleOverride, v))
+}
+
+// TitleOverrideContainsFold applies the ContainsFold predicate on the "title_override" field.
+func TitleOverrideContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldTitleOverride, v))
+}
+
+// AuthorOverrideEQ applies the EQ predicate on the "author_override" field.
+func AuthorOverrideEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideNEQ applies the NEQ predicate on the "author_override" field.
+func AuthorOverrideNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideIn applies the In predicate on the "author_override" field.
+func AuthorOverrideIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldAuthorOverride, vs...))
+}
+
+// AuthorOverrideNotIn applies the NotIn predicate on the "author_override" field.
+func AuthorOverrideNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldAuthorOverride, vs...))
+}
+
+// AuthorOverrideGT applies the GT predicate on the "author_override" field.
+func AuthorOverrideGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideGTE applies the GTE predicate on the "author_override" field.
+func AuthorOverrideGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideLT applies the LT predicate on the "author_override" field.
+func AuthorOverrideLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideLTE applies the LTE predicate on the "author_override" field.
+func AuthorOverrideLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideContains applies the Contains predicate on the "author_override" field.
+func AuthorOverrideContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideHasPrefix applies the HasPrefix predicate on the "author_override" field.
+func AuthorOverrideHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideHasSuffix applies the HasSuffix predicate on the "author_override" field.
+func AuthorOverrideHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideIsNil applies the IsNil predicate on the "author_override" field.
+func AuthorOverrideIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldAuthorOverride))
+}
+
+// AuthorOverrideNotNil applies the NotNil predicate on the "author_override" field.
+func AuthorOverrideNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldAuthorOverride))
+}
+
+// AuthorOverrideEqualFold applies the EqualFold predicate on the "author_override" field.
+func AuthorOverrideEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldAuthorOverride, v))
+}
+
+// AuthorOverrideContainsFold applies the ContainsFold predicate on the "author_override" field.
+func AuthorOverrideContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldAuthorOverride, v))
+}
+
+// PublisherOverrideEQ applies the EQ predicate on the "publisher_override" field.
+func PublisherOverrideEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideNEQ applies the NEQ predicate on the "publisher_override" field.
+func PublisherOverrideNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideIn applies the In predicate on the "publisher_override" field.
+func PublisherOverrideIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldPublisherOverride, vs...))
+}
+
+// PublisherOverrideNotIn applies the NotIn predicate on the "publisher_override" field.
+func PublisherOverrideNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldPublisherOverride, vs...))
+}
+
+// PublisherOverrideGT applies the GT predicate on the "publisher_override" field.
+func PublisherOverrideGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideGTE applies the GTE predicate on the "publisher_override" field.
+func PublisherOverrideGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideLT applies the LT predicate on the "publisher_override" field.
+func PublisherOverrideLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideLTE applies the LTE predicate on the "publisher_override" field.
+func PublisherOverrideLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideContains applies the Contains predicate on the "publisher_override" field.
+func PublisherOverrideContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideHasPrefix applies the HasPrefix predicate on the "publisher_override" field.
+func PublisherOverrideHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideHasSuffix applies the HasSuffix predicate on the "publisher_override" field.
+func PublisherOverrideHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideIsNil applies the IsNil predicate on the "publisher_override" field.
+func PublisherOverrideIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldPublisherOverride))
+}
+
+// PublisherOverrideNotNil applies the NotNil predicate on the "publisher_override" field.
+func PublisherOverrideNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldPublisherOverride))
+}
+
+// PublisherOverrideEqualFold applies the EqualFold predicate on the "publisher_override" field.
+func PublisherOverrideEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldPublisherOverride, v))
+}
+
+// PublisherOverrideContainsFold applies the ContainsFold predicate on the "publisher_override" field.
+func PublisherOverrideContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldPublisherOverride, v))
+}
+
+// PublishedDateOverrideEQ applies the EQ predicate on the "published_date_override" field.
+func PublishedDateOverrideEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideNEQ applies the NEQ predicate on the "published_date_override" field.
+func PublishedDateOverrideNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideIn applies the In predicate on the "published_date_override" field.
+func PublishedDateOverrideIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldPublishedDateOverride, vs...))
+}
+
+// PublishedDateOverrideNotIn applies the NotIn predicate on the "published_date_override" field.
+func PublishedDateOverrideNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldPublishedDateOverride, vs...))
+}
+
+// PublishedDateOverrideGT applies the GT predicate on the "published_date_override" field.
+func PublishedDateOverrideGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideGTE applies the GTE predicate on the "published_date_override" field.
+func PublishedDateOverrideGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideLT applies the LT predicate on the "published_date_override" field.
+func PublishedDateOverrideLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideLTE applies the LTE predicate on the "published_date_override" field.
+func PublishedDateOverrideLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideContains applies the Contains predicate on the "published_date_override" field.
+func PublishedDateOverrideContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideHasPrefix applies the HasPrefix predicate on the "published_date_override" field.
+func PublishedDateOverrideHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideHasSuffix applies the HasSuffix predicate on the "published_date_override" field.
+func PublishedDateOverrideHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideIsNil applies the IsNil predicate on the "published_date_override" field.
+func PublishedDateOverrideIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldPublishedDateOverride))
+}
+
+// PublishedDateOverrideNotNil applies the NotNil predicate on the "published_date_override" field.
+func PublishedDateOverrideNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldPublishedDateOverride))
+}
+
+// PublishedDateOverrideEqualFold applies the EqualFold predicate on the "published_date_override" field.
+func PublishedDateOverrideEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldPublishedDateOverride, v))
+}
+
+// PublishedDateOverrideContainsFold applies the ContainsFold predicate on the "published_date_override" field.
+func PublishedDateOverrideContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldPublishedDateOverride, v))
+}
+
+// ThumbnailURLOverrideEQ applies the EQ predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideNEQ applies the NEQ predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideIn applies the In predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldThumbnailURLOverride, vs...))
+}
+
+// ThumbnailURLOverrideNotIn applies the NotIn predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldThumbnailURLOverride, vs...))
+}
+
+// ThumbnailURLOverrideGT applies the GT predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideGTE applies the GTE predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideLT applies the LT predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideLTE applies the LTE predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideContains applies the Contains predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideHasPrefix applies the HasPrefix predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideHasSuffix applies the HasSuffix predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideIsNil applies the IsNil predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldThumbnailURLOverride))
+}
+
+// ThumbnailURLOverrideNotNil applies the NotNil predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldThumbnailURLOverride))
+}
+
+// ThumbnailURLOverrideEqualFold applies the EqualFold predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldThumbnailURLOverride, v))
+}
+
+// ThumbnailURLOverrideContainsFold applies the ContainsFold predicate on the "thumbnail_url_override" field.
+func ThumbnailURLOverrideContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldThumbnailURLOverride, v))
+}
+
+// LocationRoomEQ applies the EQ predicate on the "location_room" field.
+func LocationRoomEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLocationRoom, v))
+}
+
+// LocationRoomNEQ applies the NEQ predicate on the "location_room" field.
+func LocationRoomNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldLocationRoom, v))
+}
+
+// LocationRoomIn applies the In predicate on the "location_room" field.
+func LocationRoomIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldLocationRoom, vs...))
+}
+
+// LocationRoomNotIn applies the NotIn predicate on the "location_room" field.
+func LocationRoomNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldLocationRoom, vs...))
+}
+
+// LocationRoomGT applies the GT predicate on the "location_room" field.
+func LocationRoomGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldLocationRoom, v))
+}
+
+// LocationRoomGTE applies the GTE predicate on the "location_room" field.
+func LocationRoomGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldLocationRoom, v))
+}
+
+// LocationRoomLT applies the LT predicate on the "location_room" field.
+func LocationRoomLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldLocationRoom, v))
+}
+
+// LocationRoomLTE applies the LTE predicate on the "location_room" field.
+func LocationRoomLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldLocationRoom, v))
+}
+
+// LocationRoomContains applies the Contains predicate on the "location_room" field.
+func LocationRoomContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldLocationRoom, v))
+}
+
+// LocationRoomHasPrefix applies the HasPrefix predicate on the "location_room" field.
+func LocationRoomHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldLocationRoom, v))
+}
+
+// LocationRoomHasSuffix applies the HasSuffix predicate on the "location_room" field.
+func LocationRoomHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldLocationRoom, v))
+}
+
+// LocationRoomEqualFold applies the EqualFold predicate on the "location_room" field.
+func LocationRoomEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldLocationRoom, v))
+}
+
+// LocationRoomContainsFold applies the ContainsFold predicate on the "location_room" field.
+func LocationRoomContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldLocationRoom, v))
+}
+
+// LocationBookcaseEQ applies the EQ predicate on the "location_bookcase" field.
+func LocationBookcaseEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseNEQ applies the NEQ predicate on the "location_bookcase" field.
+func LocationBookcaseNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseIn applies the In predicate on the "location_bookcase" field.
+func LocationBookcaseIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldLocationBookcase, vs...))
+}
+
+// LocationBookcaseNotIn applies the NotIn predicate on the "location_bookcase" field.
+func LocationBookcaseNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldLocationBookcase, vs...))
+}
+
+// LocationBookcaseGT applies the GT predicate on the "location_bookcase" field.
+func LocationBookcaseGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseGTE applies the GTE predicate on the "location_bookcase" field.
+func LocationBookcaseGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseLT applies the LT predicate on the "location_bookcase" field.
+func LocationBookcaseLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseLTE applies the LTE predicate on the "location_bookcase" field.
+func LocationBookcaseLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseContains applies the Contains predicate on the "location_bookcase" field.
+func LocationBookcaseContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseHasPrefix applies the HasPrefix predicate on the "location_bookcase" field.
+func LocationBookcaseHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseHasSuffix applies the HasSuffix predicate on the "location_bookcase" field.
+func LocationBookcaseHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseEqualFold applies the EqualFold predicate on the "location_bookcase" field.
+func LocationBookcaseEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldLocationBookcase, v))
+}
+
+// LocationBookcaseContainsFold applies the ContainsFold predicate on the "location_bookcase" field.
+func LocationBookcaseContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldLocationBookcase, v))
+}
+
+// LocationShelfEQ applies the EQ predicate on the "location_shelf" field.
+func LocationShelfEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLocationShelf, v))
+}
+
+// LocationShelfNEQ applies the NEQ predicate on the "location_shelf" field.
+func LocationShelfNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldLocationShelf, v))
+}
+
+// LocationShelfIn applies the In predicate on the "location_shelf" field.
+func LocationShelfIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldLocationShelf, vs...))
+}
+
+// LocationShelfNotIn applies the NotIn predicate on the "location_shelf" field.
+func LocationShelfNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldLocationShelf, vs...))
+}
+
+// LocationShelfGT applies the GT predicate on the "location_shelf" field.
+func LocationShelfGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldLocationShelf, v))
+}
+
+// LocationShelfGTE applies the GTE predicate on the "location_shelf" field.
+func LocationShelfGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldLocationShelf, v))
+}
+
+// LocationShelfLT applies the LT predicate on the "location_shelf" field.
+func LocationShelfLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldLocationShelf, v))
+}
+
+// LocationShelfLTE applies the LTE predicate on the "location_shelf" field.
+func LocationShelfLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldLocationShelf, v))
+}
+
+// LocationShelfContains applies the Contains predicate on the "location_shelf" field.
+func LocationShelfContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldLocationShelf, v))
+}
+
+// LocationShelfHasPrefix applies the HasPrefix predicate on the "location_shelf" field.
+func LocationShelfHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldLocationShelf, v))
+}
+
+// LocationShelfHasSuffix applies the HasSuffix predicate on the "location_shelf" field.
+func LocationShelfHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldLocationShelf, v))
+}
+
+// LocationShelfEqualFold applies the EqualFold predicate on the "location_shelf" field.
+func LocationShelfEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldLocationShelf, v))
 }
 
-// BookTitleEQ applies the EQ predicate on the "book_title" field.
-func BookTitleEQ(v string) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldBookTitle, v))
+// LocationShelfContainsFold applies the ContainsFold predicate on the "location_shelf" field.
+func LocationShelfContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldLocationShelf, v))
 }
 
-// BookTitleNEQ applies the NEQ predicate on the "book_title" field.
-func BookTitleNEQ(v string) predicate.Book {
-	return predicate.Book(sql.FieldNEQ(FieldBookTitle, v))
+// ConditionEQ applies the EQ predicate on the "condition" field.
+func ConditionEQ(v Condition) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldCondition, v))
 }
 
-// BookTitleIn applies the In predicate on the "book_title" field.
-func BookTitleIn(vs ...string) predicate.Book {
-	return predicate.Book(sql.FieldIn(FieldBookTitle, vs...))
+// ConditionNEQ applies the NEQ predicate on the "condition" field.
+func ConditionNEQ(v Condition) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldCondition, v))
 }
 
-// BookTitleNotIn applies the NotIn predicate on the "book_title" field.
-func BookTitleNotIn(vs ...string) predicate.Book {
-	return predicate.Book(sql.FieldNotIn(FieldBookTitle, vs...))
+// ConditionIn applies the In predicate on the "condition" field.
+func ConditionIn(vs ...Condition) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldCondition, vs...))
 }
 
-// BookTitleGT applies the GT predicate on the "book_title" field.
-func BookTitleGT(v string) predicate.Book {
-	return predicate.Book(sql.FieldGT(FieldBookTitle, v))
+// ConditionNotIn applies the NotIn predicate on the "condition" field.
+func ConditionNotIn(vs ...Condition) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldCondition, vs...))
 }
 
-// BookTitleGTE applies the GTE predicate on the "book_title" field.
-func BookTitleGTE(v string) predicate.Book {
-	return predicate.Book(sql.FieldGTE(FieldBookTitle, v))
+// ConditionIsNil applies the IsNil predicate on the "condition" field.
+func ConditionIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldCondition))
 }
 
-// BookTitleLT applies the LT predicate on the "book_title" field.
-func BookTitleLT(v string) predicate.Book {
-	return predicate.Book(sql.FieldLT(FieldBookTitle, v))
+// ConditionNotNil applies the NotNil predicate on the "condition" field.
+func ConditionNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldCondition))
 }
 
-// BookTitleLTE applies the LTE predicate on the "book_title" field.
-func BookTitleLTE(v string) predicate.Book {
-	return predicate.Book(sql.FieldLTE(FieldBookTitle, v))
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldFormat, v))
 }
 
-// BookTitleContains applies the Contains predicate on the "book_title" field.
-func BookTitleContains(v string) predicate.Book {
-	return predicate.Book(sql.FieldContains(FieldBookTitle, v))
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldFormat, v))
 }
 
-// BookTitleHasPrefix applies the HasPrefix predicate on the "book_title" field.
-func BookTitleHasPrefix(v string) predicate.Book {
-	return predicate.Book(sql.FieldHasPrefix(FieldBookTitle, v))
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldFormat, vs...))
 }
 
-// BookTitleHasSuffix applies the HasSuffix predicate on the "book_title" field.
-func BookTitleHasSuffix(v string) predicate.Book {
-	return predicate.Book(sql.FieldHasSuffix(FieldBookTitle, v))
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldFormat, vs...))
 }
 
-// BookTitleEqualFold applies the EqualFold predicate on the "book_title" field.
-func BookTitleEqualFold(v string) predicate.Book {
-	return predicate.Book(sql.FieldEqualFold(FieldBookTitle, v))
+// FormatIsNil applies the IsNil predicate on the "format" field.
+func FormatIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldFormat))
 }
 
-// BookTitleContainsFold applies the ContainsFold predicate on the "book_title" field.
-func BookTitleContainsFold(v string) predicate.Book {
-	return predicate.Book(sql.FieldContainsFold(FieldBookTitle, v))
+// FormatNotNil applies the NotNil predicate on the "format" field.
+func FormatNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldFormat))
 }
 
-// AuthorEQ applies the EQ predicate on the "author" field.
-func AuthorEQ(v string) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldAuthor, v))
+// PurchasedAtEQ applies the EQ predicate on the "purchased_at" field.
+func PurchasedAtEQ(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldPurchasedAt, v))
 }
 
-// AuthorNEQ applies the NEQ predicate on the "author" field.
-func AuthorNEQ(v string) predicate.Book {
-	return predicate.Book(sql.FieldNEQ(FieldAuthor, v))
+// PurchasedAtNEQ applies the NEQ predicate on the "purchased_at" field.
+func PurchasedAtNEQ(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldPurchasedAt, v))
 }
 
-// AuthorIn applies the In predicate on the "author" field.
-func AuthorIn(vs ...string) predicate.Book {
-	return predicate.Book(sql.FieldIn(FieldAuthor, vs...))
+// PurchasedAtIn applies the In predicate on the "purchased_at" field.
+func PurchasedAtIn(vs ...time.Time) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldPurchasedAt, vs...))
 }
 
-// AuthorNotIn applies the NotIn predicate on the "author" field.
-func AuthorNotIn(vs ...string) predicate.Book {
-	return predicate.Book(sql.FieldNotIn(FieldAuthor, vs...))
+// PurchasedAtNotIn applies the NotIn predicate on the "purchased_at" field.
+func PurchasedAtNotIn(vs ...time.Time) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldPurchasedAt, vs...))
 }
 
-// AuthorGT applies the GT predicate on the "author" field.
-func AuthorGT(v string) predicate.Book {
-	return predicate.Book(sql.FieldGT(FieldAuthor, v))
+// PurchasedAtGT applies the GT predicate on the "purchased_at" field.
+func PurchasedAtGT(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldPurchasedAt, v))
 }
 
-// AuthorGTE applies the GTE predicate on the "author" field.
-func AuthorGTE(v string) predicate.Book {
-	return predicate.Book(sql.FieldGTE(FieldAuthor, v))
+// PurchasedAtGTE applies the GTE predicate on the "purchased_at" field.
+func PurchasedAtGTE(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldPurchasedAt, v))
 }
 
-// AuthorLT applies the LT predicate on the "author" field.
-func AuthorLT(v string) predicate.Book {
-	return predicate.Book(sql.FieldLT(FieldAuthor, v))
+// PurchasedAtLT applies the LT predicate on the "purchased_at" field.
+func PurchasedAtLT(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldPurchasedAt, v))
 }
 
-// AuthorLTE applies the LTE predicate on the "author" field.
-func AuthorLTE(v string) predicate.Book {
-	return predicate.Book(sql.FieldLTE(FieldAuthor, v))
+// PurchasedAtLTE applies the LTE predicate on the "purchased_at" field.
+func PurchasedAtLTE(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldPurchasedAt, v))
 }
 
-// AuthorContains applies the Contains predicate on the "author" field.
-func AuthorContains(v string) predicate.Book {
-	return predicate.Book(sql.FieldContains(FieldAuthor, v))
+// PurchasedAtIsNil applies the IsNil predicate on the "purchased_at" field.
+func PurchasedAtIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldPurchasedAt))
 }
 
-// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
-func AuthorHasPrefix(v string) predicate.Book {
-	return predicate.Book(sql.FieldHasPrefix(FieldAuthor, v))
+// PurchasedAtNotNil applies the NotNil predicate on the "purchased_at" field.
+func PurchasedAtNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldPurchasedAt))
 }
 
-// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
-func AuthorHasSuffix(v string) predicate.Book {
-	return predicate.Book(sql.FieldHasSuffix(FieldAuthor, v))
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v float64) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldPrice, v))
 }
 
-// AuthorEqualFold applies the EqualFold predicate on the "author" field.
-func AuthorEqualFold(v string) predicate.Book {
-	return predicate.Book(sql.FieldEqualFold(FieldAuthor, v))
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v float64) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldPrice, v))
 }
 
-// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
-func AuthorContainsFold(v string) predicate.Book {
-	return predicate.Book(sql.FieldContainsFold(FieldAuthor, v))
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...float64) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldPrice, vs...))
 }
 
-// BookIsbnEQ applies the EQ predicate on the "book_isbn" field.
-func BookIsbnEQ(v string) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldBookIsbn, v))
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...float64) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldPrice, vs...))
 }
 
-// BookIsbnNEQ applies the NEQ predicate on the "book_isbn" field.
-func BookIsbnNEQ(v string) predicate.Book {
-	return predicate.Book(sql.FieldNEQ(FieldBookIsbn, v))
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v float64) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldPrice, v))
 }
 
-// BookIsbnIn applies the In predicate on the "book_isbn" field.
-func BookIsbnIn(vs ...string) predicate.Book {
-	return predicate.Book(sql.FieldIn(FieldBookIsbn, vs...))
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v float64) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldPrice, v))
 }
 
-// BookIsbnNotIn applies the NotIn predicate on the "book_isbn" field.
-func BookIsbnNotIn(vs ...string) predicate.Book {
-	return predicate.Book(sql.FieldNotIn(FieldBookIsbn, vs...))
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v float64) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldPrice, v))
 }
 
-// BookIsbnGT applies the GT predicate on the "book_isbn" field.
-func BookIsbnGT(v string) predicate.Book {
-	return predicate.Book(sql.FieldGT(FieldBookIsbn, v))
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v float64) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldPrice, v))
 }
 
-// BookIsbnGTE applies the GTE predicate on the "book_isbn" field.
-func BookIsbnGTE(v string) predicate.Book {
-	return predicate.Book(sql.FieldGTE(FieldBookIsbn, v))
+// PriceIsNil applies the IsNil predicate on the "price" field.
+func PriceIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldPrice))
 }
 
-// BookIsbnLT applies the LT predicate on the "book_isbn" field.
-func BookIsbnLT(v string) predicate.Book {
-	return predicate.Book(sql.FieldLT(FieldBookIsbn, v))
+// PriceNotNil applies the NotNil predicate on the "price" field.
+func PriceNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldPrice))
 }
 
-// BookIsbnLTE applies the LTE predicate on the "book_isbn" field.
-func BookIsbnLTE(v string) predicate.Book {
-	return predicate.Book(sql.FieldLTE(FieldBookIsbn, v))
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldCurrency, v))
 }
 
-// BookIsbnContains applies the Contains predicate on the "book_isbn" field.
-func BookIsbnContains(v string) predicate.Book {
-	return predicate.Book(sql.FieldContains(FieldBookIsbn, v))
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldCurrency, v))
 }
 
-// BookIsbnHasPrefix applies the HasPrefix predicate on the "book_isbn" field.
-func BookIsbnHasPrefix(v string) predicate.Book {
-	return predicate.Book(sql.FieldHasPrefix(FieldBookIsbn, v))
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldCurrency, vs...))
 }
 
-// BookIsbnHasSuffix applies the HasSuffix predicate on the "book_isbn" field.
-func BookIsbnHasSuffix(v string) predicate.Book {
-	return predicate.Book(sql.FieldHasSuffix(FieldBookIsbn, v))
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldCurrency, vs...))
 }
 
-// BookIsbnIsNil applies the IsNil predicate on the "book_isbn" field.
-func BookIsbnIsNil() predicate.Book {
-	return predicate.Book(sql.FieldIsNull(FieldBookIsbn))
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldCurrency, v))
 }
 
-// BookIsbnNotNil applies the NotNil predicate on the "book_isbn" field.
-func BookIsbnNotNil() predicate.Book {
-	return predicate.Book(sql.FieldNotNull(FieldBookIsbn))
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldCurrency, v))
 }
 
-// BookIsbnEqualFold applies the EqualFold predicate on the "book_isbn" field.
-func BookIsbnEqualFold(v string) predicate.Book {
-	return predicate.Book(sql.FieldEqualFold(FieldBookIsbn, v))
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldCurrency, v))
 }
 
-// BookIsbnContainsFold applies the ContainsFold predicate on the "book_isbn" field.
-func BookIsbnContainsFold(v string) predicate.Book {
-	return predicate.Book(sql.FieldContainsFold(FieldBookIsbn, v))
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldCurrency, v))
 }
 
-// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
-func ThumbnailURLEQ(v string) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldThumbnailURL, v))
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldCurrency, v))
 }
 
-// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
-func ThumbnailURLNEQ(v string) predicate.Book {
-	return predicate.Book(sql.FieldNEQ(FieldThumbnailURL, v))
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldCurrency, v))
 }
 
-// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
-func ThumbnailURLIn(vs ...string) predicate.Book {
-	return predicate.Book(sql.FieldIn(FieldThumbnailURL, vs...))
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldCurrency, v))
 }
 
-// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
-func ThumbnailURLNotIn(vs ...string) predicate.Book {
-	return predicate.Book(sql.FieldNotIn(FieldThumbnailURL, vs...))
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldCurrency, v))
 }
 
-// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
-func ThumbnailURLGT(v string) predicate.Book {
-	return predicate.Book(sql.FieldGT(FieldThumbnailURL, v))
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldCurrency, v))
 }
 
-// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
-func ThumbnailURLGTE(v string) predicate.Book {
-	return predicate.Book(sql.FieldGTE(FieldThumbnailURL, v))
+// AcquiredFromEQ applies the EQ predicate on the "acquired_from" field.
+func AcquiredFromEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldAcquiredFrom, v))
 }
 
-// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
-func ThumbnailURLLT(v string) predicate.Book {
-	return predicate.Book(sql.FieldLT(FieldThumbnailURL, v))
+// AcquiredFromNEQ applies the NEQ predicate on the "acquired_from" field.
+func AcquiredFromNEQ(v string) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldAcquiredFrom, v))
 }
 
-// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
-func ThumbnailURLLTE(v string) predicate.Book {
-	return predicate.Book(sql.FieldLTE(FieldThumbnailURL, v))
+// AcquiredFromIn applies the In predicate on the "acquired_from" field.
+func AcquiredFromIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldAcquiredFrom, vs...))
 }
 
-// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
-func ThumbnailURLContains(v string) predicate.Book {
-	return predicate.Book(sql.FieldContains(FieldThumbnailURL, v))
+// AcquiredFromNotIn applies the NotIn predicate on the "acquired_from" field.
+func AcquiredFromNotIn(vs ...string) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldAcquiredFrom, vs...))
 }
 
-// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
-func ThumbnailURLHasPrefix(v string) predicate.Book {
-	return predicate.Book(sql.FieldHasPrefix(FieldThumbnailURL, v))
+// AcquiredFromGT applies the GT predicate on the "acquired_from" field.
+func AcquiredFromGT(v string) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldAcquiredFrom, v))
 }
 
-// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
-func ThumbnailURLHasSuffix(v string) predicate.Book {
-	return predicate.Book(sql.FieldHasSuffix(FieldThumbnailURL, v))
+// AcquiredFromGTE applies the GTE predicate on the "acquired_from" field.
+func AcquiredFromGTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldAcquiredFrom, v))
 }
 
-// ThumbnailURLIsNil applies the IsNil predicate on the "thumbnail_url" field.
-func ThumbnailURLIsNil() predicate.Book {
-	return predicate.Book(sql.FieldIsNull(FieldThumbnailURL))
+// AcquiredFromLT applies the LT predicate on the "acquired_from" field.
+func AcquiredFromLT(v string) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldAcquiredFrom, v))
 }
 
-// ThumbnailURLNotNil applies the NotNil predicate on the "thumbnail_url" field.
-func ThumbnailURLNotNil() predicate.Book {
-	return predicate.Book(sql.FieldNotNull(FieldThumbnailURL))
+// AcquiredFromLTE applies the LTE predicate on the "acquired_from" field.
+func AcquiredFromLTE(v string) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldAcquiredFrom, v))
 }
 
-// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
-func ThumbnailURLEqualFold(v string) predicate.Book {
-	return predicate.Book(sql.FieldEqualFold(FieldThumbnailURL, v))
+// AcquiredFromContains applies the Contains predicate on the "acquired_from" field.
+func AcquiredFromContains(v string) predicate.Book {
+	return predicate.Book(sql.FieldContains(FieldAcquiredFrom, v))
 }
 
-// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
-func ThumbnailURLContainsFold(v string) predicate.Book {
-	return predicate.Book(sql.FieldContainsFold(FieldThumbnailURL, v))
+// AcquiredFromHasPrefix applies the HasPrefix predicate on the "acquired_from" field.
+func AcquiredFromHasPrefix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasPrefix(FieldAcquiredFrom, v))
 }
 
-// StatusEQ applies the EQ predicate on the "status" field.
-func StatusEQ(v int) predicate.Book {
-	return predicate.Book(sql.FieldEQ(FieldStatus, v))
+// AcquiredFromHasSuffix applies the HasSuffix predicate on the "acquired_from" field.
+func AcquiredFromHasSuffix(v string) predicate.Book {
+	return predicate.Book(sql.FieldHasSuffix(FieldAcquiredFrom, v))
 }
 
-// StatusNEQ applies the NEQ predicate on the "status" field.
-func StatusNEQ(v int) predicate.Book {
-	return predicate.Book(sql.FieldNEQ(FieldStatus, v))
+// AcquiredFromEqualFold applies the EqualFold predicate on the "acquired_from" field.
+func AcquiredFromEqualFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldEqualFold(FieldAcquiredFrom, v))
 }
 
-// StatusIn applies the In predicate on the "status" field.
-func StatusIn(vs ...int) predicate.Book {
-	return predicate.Book(sql.FieldIn(FieldStatus, vs...))
+// AcquiredFromContainsFold applies the ContainsFold predicate on the "acquired_from" field.
+func AcquiredFromContainsFold(v string) predicate.Book {
+	return predicate.Book(sql.FieldContainsFold(FieldAcquiredFrom, v))
 }
 
-// StatusNotIn applies the NotIn predicate on the "status" field.
-func StatusNotIn(vs ...int) predicate.Book {
-	return predicate.Book(sql.FieldNotIn(FieldStatus, vs...))
+// LiveEQ applies the EQ predicate on the "live" field.
+func LiveEQ(v bool) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLive, v))
 }
 
-// StatusGT applies the GT predicate on the "status" field.
-func StatusGT(v int) predicate.Book {
-	return predicate.Book(sql.FieldGT(FieldStatus, v))
+// LiveNEQ applies the NEQ predicate on the "live" field.
+func LiveNEQ(v bool) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldLive, v))
 }
 
-// StatusGTE applies the GTE predicate on the "status" field.
-func StatusGTE(v int) predicate.Book {
-	return predicate.Book(sql.FieldGTE(FieldStatus, v))
+// LiveIsNil applies the IsNil predicate on the "live" field.
+func LiveIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldLive))
 }
 
-// StatusLT applies the LT predicate on the "status" field.
-func StatusLT(v int) predicate.Book {
-	return predicate.Book(sql.FieldLT(FieldStatus, v))
+// LiveNotNil applies the NotNil predicate on the "live" field.
+func LiveNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldLive))
 }
 
-// StatusLTE applies the LTE predicate on the "status" field.
-func StatusLTE(v int) predicate.Book {
-	return predicate.Book(sql.FieldLTE(FieldStatus, v))
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldVersion, v))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -491,6 +1366,106 @@ func UpdatedAtLTE(v time.Time) predicate.Book {
 	return predicate.Book(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Book {
+	return predicate.Book(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// LibraryIDEQ applies the EQ predicate on the "library_id" field.
+func LibraryIDEQ(v uuid.UUID) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldLibraryID, v))
+}
+
+// LibraryIDNEQ applies the NEQ predicate on the "library_id" field.
+func LibraryIDNEQ(v uuid.UUID) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldLibraryID, v))
+}
+
+// LibraryIDIn applies the In predicate on the "library_id" field.
+func LibraryIDIn(vs ...uuid.UUID) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldLibraryID, vs...))
+}
+
+// LibraryIDNotIn applies the NotIn predicate on the "library_id" field.
+func LibraryIDNotIn(vs ...uuid.UUID) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldLibraryID, vs...))
+}
+
+// LibraryIDIsNil applies the IsNil predicate on the "library_id" field.
+func LibraryIDIsNil() predicate.Book {
+	return predicate.Book(sql.FieldIsNull(FieldLibraryID))
+}
+
+// LibraryIDNotNil applies the NotNil predicate on the "library_id" field.
+func LibraryIDNotNil() predicate.Book {
+	return predicate.Book(sql.FieldNotNull(FieldLibraryID))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Book {
+	return predicate.Book(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Book {
+	return predicate.Book(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Book {
+	return predicate.Book(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Book {
+	return predicate.Book(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Book {
 	return predicate.Book(func(s *sql.Selector) {
@@ -514,6 +1489,52 @@ func HasOwnerWith(preds ...predicate.User) predicate.Book {
 	})
 }
 
+// HasCatalog applies the HasEdge predicate on the "catalog" edge.
+func HasCatalog() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CatalogTable, CatalogColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCatalogWith applies the HasEdge predicate on the "catalog" edge with a given conditions (other predicates).
+func HasCatalogWith(preds ...predicate.BookCatalog) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newCatalogStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLibrary applies the HasEdge predicate on the "library" edge.
+func HasLibrary() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LibraryTable, LibraryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLibraryWith applies the HasEdge predicate on the "library" edge with a given conditions (other predicates).
+func HasLibraryWith(preds ...predicate.Library) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newLibraryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasReviews applies the HasEdge predicate on the "reviews" edge.
 func HasReviews() predicate.Book {
 	return predicate.Book(func(s *sql.Selector) {
@@ -560,6 +1581,190 @@ func HasBookmarksWith(preds ...predicate.Bookmark) predicate.Book {
 	})
 }
 
+// HasReadingSessions applies the HasEdge predicate on the "reading_sessions" edge.
+func HasReadingSessions() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReadingSessionsTable, ReadingSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReadingSessionsWith applies the HasEdge predicate on the "reading_sessions" edge with a given conditions (other predicates).
+func HasReadingSessionsWith(preds ...predicate.ReadingSession) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newReadingSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStatusHistories applies the HasEdge predicate on the "status_histories" edge.
+func HasStatusHistories() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusHistoriesTable, StatusHistoriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusHistoriesWith applies the HasEdge predicate on the "status_histories" edge with a given conditions (other predicates).
+func HasStatusHistoriesWith(preds ...predicate.BookStatusHistory) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newStatusHistoriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShelves applies the HasEdge predicate on the "shelves" edge.
+func HasShelves() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, ShelvesTable, ShelvesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShelvesWith applies the HasEdge predicate on the "shelves" edge with a given conditions (other predicates).
+func HasShelvesWith(preds ...predicate.Shelf) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newShelvesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotes applies the HasEdge predicate on the "notes" edge.
+func HasNotes() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotesTable, NotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotesWith applies the HasEdge predicate on the "notes" edge with a given conditions (other predicates).
+func HasNotesWith(preds ...predicate.BookNote) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newNotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLoans applies the HasEdge predicate on the "loans" edge.
+func HasLoans() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LoansTable, LoansColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoansWith applies the HasEdge predicate on the "loans" edge with a given conditions (other predicates).
+func HasLoansWith(preds ...predicate.Loan) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newLoansStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBorrowRequests applies the HasEdge predicate on the "borrow_requests" edge.
+func HasBorrowRequests() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BorrowRequestsTable, BorrowRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBorrowRequestsWith applies the HasEdge predicate on the "borrow_requests" edge with a given conditions (other predicates).
+func HasBorrowRequestsWith(preds ...predicate.BorrowRequest) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newBorrowRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShelfBooks applies the HasEdge predicate on the "shelf_books" edge.
+func HasShelfBooks() predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, ShelfBooksTable, ShelfBooksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShelfBooksWith applies the HasEdge predicate on the "shelf_books" edge with a given conditions (other predicates).
+func HasShelfBooksWith(preds ...predicate.ShelfBook) predicate.Book {
+	return predicate.Book(func(s *sql.Selector) {
+		step := newShelfBooksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Book) predicate.Book {
 	return predicate.Book(sql.AndPredicates(predicates...))
@@ -11,8 +11,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
 	"github.com/google/uuid"
 )
@@ -24,56 +33,296 @@ type BookCreate struct {
 	hooks    []Hook
 }
 
-// SetBookTitle sets the "book_title" field.
-func (_c *BookCreate) SetBookTitle(v string) *BookCreate {
-	_c.mutation.SetBookTitle(v)
+// SetReadingStatus sets the "reading_status" field.
+func (_c *BookCreate) SetReadingStatus(v book.ReadingStatus) *BookCreate {
+	_c.mutation.SetReadingStatus(v)
 	return _c
 }
 
-// SetAuthor sets the "author" field.
-func (_c *BookCreate) SetAuthor(v string) *BookCreate {
-	_c.mutation.SetAuthor(v)
+// SetNillableReadingStatus sets the "reading_status" field if the given value is not nil.
+func (_c *BookCreate) SetNillableReadingStatus(v *book.ReadingStatus) *BookCreate {
+	if v != nil {
+		_c.SetReadingStatus(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *BookCreate) SetStartedAt(v time.Time) *BookCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *BookCreate) SetNillableStartedAt(v *time.Time) *BookCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_c *BookCreate) SetFinishedAt(v time.Time) *BookCreate {
+	_c.mutation.SetFinishedAt(v)
+	return _c
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_c *BookCreate) SetNillableFinishedAt(v *time.Time) *BookCreate {
+	if v != nil {
+		_c.SetFinishedAt(*v)
+	}
+	return _c
+}
+
+// SetCurrentPage sets the "current_page" field.
+func (_c *BookCreate) SetCurrentPage(v int) *BookCreate {
+	_c.mutation.SetCurrentPage(v)
+	return _c
+}
+
+// SetNillableCurrentPage sets the "current_page" field if the given value is not nil.
+func (_c *BookCreate) SetNillableCurrentPage(v *int) *BookCreate {
+	if v != nil {
+		_c.SetCurrentPage(*v)
+	}
+	return _c
+}
+
+// SetTotalPages sets the "total_pages" field.
+func (_c *BookCreate) SetTotalPages(v int) *BookCreate {
+	_c.mutation.SetTotalPages(v)
+	return _c
+}
+
+// SetNillableTotalPages sets the "total_pages" field if the given value is not nil.
+func (_c *BookCreate) SetNillableTotalPages(v *int) *BookCreate {
+	if v != nil {
+		_c.SetTotalPages(*v)
+	}
+	return _c
+}
+
+// SetTitleOverride sets the "title_override" field.
+func (_c *BookCreate) SetTitleOverride(v string) *BookCreate {
+	_c.mutation.SetTitleOverride(v)
+	return _c
+}
+
+// SetNillableTitleOverride sets the "title_override" field if the given value is not nil.
+func (_c *BookCreate) SetNillableTitleOverride(v *string) *BookCreate {
+	if v != nil {
+		_c.SetTitleOverride(*v)
+	}
+	return _c
+}
+
+// SetAuthorOverride sets the "author_override" field.
+func (_c *BookCreate) SetAuthorOverride(v string) *BookCreate {
+	_c.mutation.SetAuthorOverride(v)
+	return _c
+}
+
+// SetNillableAuthorOverride sets the "author_override" field if the given value is not nil.
+func (_c *BookCreate) SetNillableAuthorOverride(v *string) *BookCreate {
+	if v != nil {
+		_c.SetAuthorOverride(*v)
+	}
+	return _c
+}
+
+// SetPublisherOverride sets the "publisher_override" field.
+func (_c *BookCreate) SetPublisherOverride(v string) *BookCreate {
+	_c.mutation.SetPublisherOverride(v)
+	return _c
+}
+
+// SetNillablePublisherOverride sets the "publisher_override" field if the given value is not nil.
+func (_c *BookCreate) SetNillablePublisherOverride(v *string) *BookCreate {
+	if v != nil {
+		_c.SetPublisherOverride(*v)
+	}
+	return _c
+}
+
+// SetPublishedDateOverride sets the "published_date_override" field.
+func (_c *BookCreate) SetPublishedDateOverride(v string) *BookCreate {
+	_c.mutation.SetPublishedDateOverride(v)
+	return _c
+}
+
+// SetNillablePublishedDateOverride sets the "published_date_override" field if the given value is not nil.
+func (_c *BookCreate) SetNillablePublishedDateOverride(v *string) *BookCreate {
+	if v != nil {
+		_c.SetPublishedDateOverride(*v)
+	}
+	return _c
+}
+
+// SetThumbnailURLOverride sets the "thumbnail_url_override" field.
+func (_c *BookCreate) SetThumbnailURLOverride(v string) *BookCreate {
+	_c.mutation.SetThumbnailURLOverride(v)
+	return _c
+}
+
+// SetNillableThumbnailURLOverride sets the "thumbnail_url_override" field if the given value is not nil.
+func (_c *BookCreate) SetNillableThumbnailURLOverride(v *string) *BookCreate {
+	if v != nil {
+		_c.SetThumbnailURLOverride(*v)
+	}
+	return _c
+}
+
+// SetLocationRoom sets the "location_room" field.
+func (_c *BookCreate) SetLocationRoom(v string) *BookCreate {
+	_c.mutation.SetLocationRoom(v)
+	return _c
+}
+
+// SetNillableLocationRoom sets the "location_room" field if the given value is not nil.
+func (_c *BookCreate) SetNillableLocationRoom(v *string) *BookCreate {
+	if v != nil {
+		_c.SetLocationRoom(*v)
+	}
+	return _c
+}
+
+// SetLocationBookcase sets the "location_bookcase" field.
+func (_c *BookCreate) SetLocationBookcase(v string) *BookCreate {
+	_c.mutation.SetLocationBookcase(v)
+	return _c
+}
+
+// SetNillableLocationBookcase sets the "location_bookcase" field if the given value is not nil.
+func (_c *BookCreate) SetNillableLocationBookcase(v *string) *BookCreate {
+	if v != nil {
+		_c.SetLocationBookcase(*v)
+	}
+	return _c
+}
+
+// SetLocationShelf sets the "location_shelf" field.
+func (_c *BookCreate) SetLocationShelf(v string) *BookCreate {
+	_c.mutation.SetLocationShelf(v)
+	return _c
+}
+
+// SetNillableLocationShelf sets the "location_shelf" field if the given value is not nil.
+func (_c *BookCreate) SetNillableLocationShelf(v *string) *BookCreate {
+	if v != nil {
+		_c.SetLocationShelf(*v)
+	}
+	return _c
+}
+
+// SetCondition sets the "condition" field.
+func (_c *BookCreate) SetCondition(v book.Condition) *BookCreate {
+	_c.mutation.SetCondition(v)
+	return _c
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (_c *BookCreate) SetNillableCondition(v *book.Condition) *BookCreate {
+	if v != nil {
+		_c.SetCondition(*v)
+	}
+	return _c
+}
+
+// SetFormat sets the "format" field.
+func (_c *BookCreate) SetFormat(v book.Format) *BookCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *BookCreate) SetNillableFormat(v *book.Format) *BookCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetPurchasedAt sets the "purchased_at" field.
+func (_c *BookCreate) SetPurchasedAt(v time.Time) *BookCreate {
+	_c.mutation.SetPurchasedAt(v)
+	return _c
+}
+
+// SetNillablePurchasedAt sets the "purchased_at" field if the given value is not nil.
+func (_c *BookCreate) SetNillablePurchasedAt(v *time.Time) *BookCreate {
+	if v != nil {
+		_c.SetPurchasedAt(*v)
+	}
 	return _c
 }
 
-// SetBookIsbn sets the "book_isbn" field.
-func (_c *BookCreate) SetBookIsbn(v string) *BookCreate {
-	_c.mutation.SetBookIsbn(v)
+// SetPrice sets the "price" field.
+func (_c *BookCreate) SetPrice(v float64) *BookCreate {
+	_c.mutation.SetPrice(v)
 	return _c
 }
 
-// SetNillableBookIsbn sets the "book_isbn" field if the given value is not nil.
-func (_c *BookCreate) SetNillableBookIsbn(v *string) *BookCreate {
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_c *BookCreate) SetNillablePrice(v *float64) *BookCreate {
 	if v != nil {
-		_c.SetBookIsbn(*v)
+		_c.SetPrice(*v)
 	}
 	return _c
 }
 
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (_c *BookCreate) SetThumbnailURL(v string) *BookCreate {
-	_c.mutation.SetThumbnailURL(v)
+// SetCurrency sets the "currency" field.
+func (_c *BookCreate) SetCurrency(v string) *BookCreate {
+	_c.mutation.SetCurrency(v)
 	return _c
 }
 
-// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
-func (_c *BookCreate) SetNillableThumbnailURL(v *string) *BookCreate {
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *BookCreate) SetNillableCurrency(v *string) *BookCreate {
 	if v != nil {
-		_c.SetThumbnailURL(*v)
+		_c.SetCurrency(*v)
 	}
 	return _c
 }
 
-// SetStatus sets the "status" field.
-func (_c *BookCreate) SetStatus(v int) *BookCreate {
-	_c.mutation.SetStatus(v)
+// SetAcquiredFrom sets the "acquired_from" field.
+func (_c *BookCreate) SetAcquiredFrom(v string) *BookCreate {
+	_c.mutation.SetAcquiredFrom(v)
 	return _c
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_c *BookCreate) SetNillableStatus(v *int) *BookCreate {
+// SetNillableAcquiredFrom sets the "acquired_from" field if the given value is not nil.
+func (_c *BookCreate) SetNillableAcquiredFrom(v *string) *BookCreate {
 	if v != nil {
-		_c.SetStatus(*v)
+		_c.SetAcquiredFrom(*v)
+	}
+	return _c
+}
+
+// SetLive sets the "live" field.
+func (_c *BookCreate) SetLive(v bool) *BookCreate {
+	_c.mutation.SetLive(v)
+	return _c
+}
+
+// SetNillableLive sets the "live" field if the given value is not nil.
+func (_c *BookCreate) SetNillableLive(v *bool) *BookCreate {
+	if v != nil {
+		_c.SetLive(*v)
+	}
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *BookCreate) SetVersion(v int) *BookCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *BookCreate) SetNillableVersion(v *int) *BookCreate {
+	if v != nil {
+		_c.SetVersion(*v)
 	}
 	return _c
 }
@@ -106,6 +355,48 @@ func (_c *BookCreate) SetNillableUpdatedAt(v *time.Time) *BookCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *BookCreate) SetDeletedAt(v time.Time) *BookCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *BookCreate) SetNillableDeletedAt(v *time.Time) *BookCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetLibraryID sets the "library_id" field.
+func (_c *BookCreate) SetLibraryID(v uuid.UUID) *BookCreate {
+	_c.mutation.SetLibraryID(v)
+	return _c
+}
+
+// SetNillableLibraryID sets the "library_id" field if the given value is not nil.
+func (_c *BookCreate) SetNillableLibraryID(v *uuid.UUID) *BookCreate {
+	if v != nil {
+		_c.SetLibraryID(*v)
+	}
+	return _c
+}
+
+// SetVisibility sets the "visibility" field.
+func (_c *BookCreate) SetVisibility(v book.Visibility) *BookCreate {
+	_c.mutation.SetVisibility(v)
+	return _c
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_c *BookCreate) SetNillableVisibility(v *book.Visibility) *BookCreate {
+	if v != nil {
+		_c.SetVisibility(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *BookCreate) SetID(v uuid.UUID) *BookCreate {
 	_c.mutation.SetID(v)
@@ -123,6 +414,30 @@ func (_c *BookCreate) SetOwner(v *User) *BookCreate {
 	return _c.SetOwnerID(v.ID)
 }
 
+// SetCatalogID sets the "catalog" edge to the BookCatalog entity by ID.
+func (_c *BookCreate) SetCatalogID(id uuid.UUID) *BookCreate {
+	_c.mutation.SetCatalogID(id)
+	return _c
+}
+
+// SetNillableCatalogID sets the "catalog" edge to the BookCatalog entity by ID if the given value is not nil.
+func (_c *BookCreate) SetNillableCatalogID(id *uuid.UUID) *BookCreate {
+	if id != nil {
+		_c = _c.SetCatalogID(*id)
+	}
+	return _c
+}
+
+// SetCatalog sets the "catalog" edge to the BookCatalog entity.
+func (_c *BookCreate) SetCatalog(v *BookCatalog) *BookCreate {
+	return _c.SetCatalogID(v.ID)
+}
+
+// SetLibrary sets the "library" edge to the Library entity.
+func (_c *BookCreate) SetLibrary(v *Library) *BookCreate {
+	return _c.SetLibraryID(v.ID)
+}
+
 // AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
 func (_c *BookCreate) AddReviewIDs(ids ...uuid.UUID) *BookCreate {
 	_c.mutation.AddReviewIDs(ids...)
@@ -153,6 +468,111 @@ func (_c *BookCreate) AddBookmarks(v ...*Bookmark) *BookCreate {
 	return _c.AddBookmarkIDs(ids...)
 }
 
+// AddReadingSessionIDs adds the "reading_sessions" edge to the ReadingSession entity by IDs.
+func (_c *BookCreate) AddReadingSessionIDs(ids ...uuid.UUID) *BookCreate {
+	_c.mutation.AddReadingSessionIDs(ids...)
+	return _c
+}
+
+// AddReadingSessions adds the "reading_sessions" edges to the ReadingSession entity.
+func (_c *BookCreate) AddReadingSessions(v ...*ReadingSession) *BookCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReadingSessionIDs(ids...)
+}
+
+// AddStatusHistoryIDs adds the "status_histories" edge to the BookStatusHistory entity by IDs.
+func (_c *BookCreate) AddStatusHistoryIDs(ids ...uuid.UUID) *BookCreate {
+	_c.mutation.AddStatusHistoryIDs(ids...)
+	return _c
+}
+
+// AddStatusHistories adds the "status_histories" edges to the BookStatusHistory entity.
+func (_c *BookCreate) AddStatusHistories(v ...*BookStatusHistory) *BookCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStatusHistoryIDs(ids...)
+}
+
+// AddShelfIDs adds the "shelves" edge to the Shelf entity by IDs.
+func (_c *BookCreate) AddShelfIDs(ids ...uuid.UUID) *BookCreate {
+	_c.mutation.AddShelfIDs(ids...)
+	return _c
+}
+
+// AddShelves adds the "shelves" edges to the Shelf entity.
+func (_c *BookCreate) AddShelves(v ...*Shelf) *BookCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShelfIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *BookCreate) AddTagIDs(ids ...uuid.UUID) *BookCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *BookCreate) AddTags(v ...*Tag) *BookCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the BookNote entity by IDs.
+func (_c *BookCreate) AddNoteIDs(ids ...uuid.UUID) *BookCreate {
+	_c.mutation.AddNoteIDs(ids...)
+	return _c
+}
+
+// AddNotes adds the "notes" edges to the BookNote entity.
+func (_c *BookCreate) AddNotes(v ...*BookNote) *BookCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddNoteIDs(ids...)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (_c *BookCreate) AddLoanIDs(ids ...uuid.UUID) *BookCreate {
+	_c.mutation.AddLoanIDs(ids...)
+	return _c
+}
+
+// AddLoans adds the "loans" edges to the Loan entity.
+func (_c *BookCreate) AddLoans(v ...*Loan) *BookCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLoanIDs(ids...)
+}
+
+// AddBorrowRequestIDs adds the "borrow_requests" edge to the BorrowRequest entity by IDs.
+func (_c *BookCreate) AddBorrowRequestIDs(ids ...uuid.UUID) *BookCreate {
+	_c.mutation.AddBorrowRequestIDs(ids...)
+	return _c
+}
+
+// AddBorrowRequests adds the "borrow_requests" edges to the BorrowRequest entity.
+func (_c *BookCreate) AddBorrowRequests(v ...*BorrowRequest) *BookCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBorrowRequestIDs(ids...)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (_c *BookCreate) Mutation() *BookMutation {
 	return _c.mutation
@@ -188,9 +608,41 @@ func (_c *BookCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *BookCreate) defaults() {
-	if _, ok := _c.mutation.Status(); !ok {
-		v := book.DefaultStatus
-		_c.mutation.SetStatus(v)
+	if _, ok := _c.mutation.ReadingStatus(); !ok {
+		v := book.DefaultReadingStatus
+		_c.mutation.SetReadingStatus(v)
+	}
+	if _, ok := _c.mutation.CurrentPage(); !ok {
+		v := book.DefaultCurrentPage
+		_c.mutation.SetCurrentPage(v)
+	}
+	if _, ok := _c.mutation.TotalPages(); !ok {
+		v := book.DefaultTotalPages
+		_c.mutation.SetTotalPages(v)
+	}
+	if _, ok := _c.mutation.LocationRoom(); !ok {
+		v := book.DefaultLocationRoom
+		_c.mutation.SetLocationRoom(v)
+	}
+	if _, ok := _c.mutation.LocationBookcase(); !ok {
+		v := book.DefaultLocationBookcase
+		_c.mutation.SetLocationBookcase(v)
+	}
+	if _, ok := _c.mutation.LocationShelf(); !ok {
+		v := book.DefaultLocationShelf
+		_c.mutation.SetLocationShelf(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := book.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.AcquiredFrom(); !ok {
+		v := book.DefaultAcquiredFrom
+		_c.mutation.SetAcquiredFrom(v)
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := book.DefaultVersion
+		_c.mutation.SetVersion(v)
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := book.DefaultCreatedAt
@@ -200,28 +652,75 @@ func (_c *BookCreate) defaults() {
 		v := book.DefaultUpdatedAt
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		v := book.DefaultVisibility
+		_c.mutation.SetVisibility(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *BookCreate) check() error {
-	if _, ok := _c.mutation.BookTitle(); !ok {
-		return &ValidationError{Name: "book_title", err: errors.New(`ent: missing required field "Book.book_title"`)}
+	if _, ok := _c.mutation.ReadingStatus(); !ok {
+		return &ValidationError{Name: "reading_status", err: errors.New(`ent: missing required field "Book.reading_status"`)}
+	}
+	if v, ok := _c.mutation.ReadingStatus(); ok {
+		if err := book.ReadingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "reading_status", err: fmt.Errorf(`ent: validator failed for field "Book.reading_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CurrentPage(); !ok {
+		return &ValidationError{Name: "current_page", err: errors.New(`ent: missing required field "Book.current_page"`)}
+	}
+	if v, ok := _c.mutation.CurrentPage(); ok {
+		if err := book.CurrentPageValidator(v); err != nil {
+			return &ValidationError{Name: "current_page", err: fmt.Errorf(`ent: validator failed for field "Book.current_page": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TotalPages(); !ok {
+		return &ValidationError{Name: "total_pages", err: errors.New(`ent: missing required field "Book.total_pages"`)}
+	}
+	if v, ok := _c.mutation.TotalPages(); ok {
+		if err := book.TotalPagesValidator(v); err != nil {
+			return &ValidationError{Name: "total_pages", err: fmt.Errorf(`ent: validator failed for field "Book.total_pages": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LocationRoom(); !ok {
+		return &ValidationError{Name: "location_room", err: errors.New(`ent: missing required field "Book.location_room"`)}
+	}
+	if _, ok := _c.mutation.LocationBookcase(); !ok {
+		return &ValidationError{Name: "location_bookcase", err: errors.New(`ent: missing required field "Book.location_bookcase"`)}
 	}
-	if v, ok := _c.mutation.BookTitle(); ok {
-		if err := book.BookTitleValidator(v); err != nil {
-			return &ValidationError{Name: "book_title", err: fmt.Errorf(`ent: validator failed for field "Book.book_title": %w`, err)}
+	if _, ok := _c.mutation.LocationShelf(); !ok {
+		return &ValidationError{Name: "location_shelf", err: errors.New(`ent: missing required field "Book.location_shelf"`)}
+	}
+	if v, ok := _c.mutation.Condition(); ok {
+		if err := book.ConditionValidator(v); err != nil {
+			return &ValidationError{Name: "condition", err: fmt.Errorf(`ent: validator failed for field "Book.condition": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.Author(); !ok {
-		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "Book.author"`)}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := book.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Book.format": %w`, err)}
+		}
 	}
-	if v, ok := _c.mutation.Author(); ok {
-		if err := book.AuthorValidator(v); err != nil {
-			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "Book.author": %w`, err)}
+	if v, ok := _c.mutation.Price(); ok {
+		if err := book.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Book.price": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.Status(); !ok {
-		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Book.status"`)}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Book.currency"`)}
+	}
+	if _, ok := _c.mutation.AcquiredFrom(); !ok {
+		return &ValidationError{Name: "acquired_from", err: errors.New(`ent: missing required field "Book.acquired_from"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Book.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := book.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Book.version": %w`, err)}
+		}
 	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Book.created_at"`)}
@@ -229,6 +728,14 @@ func (_c *BookCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Book.updated_at"`)}
 	}
+	if _, ok := _c.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Book.visibility"`)}
+	}
+	if v, ok := _c.mutation.Visibility(); ok {
+		if err := book.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Book.visibility": %w`, err)}
+		}
+	}
 	if len(_c.mutation.OwnerIDs()) == 0 {
 		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Book.owner"`)}
 	}
@@ -267,25 +774,89 @@ func (_c *BookCreate) createSpec() (*Book, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
-	if value, ok := _c.mutation.BookTitle(); ok {
-		_spec.SetField(book.FieldBookTitle, field.TypeString, value)
-		_node.BookTitle = value
+	if value, ok := _c.mutation.ReadingStatus(); ok {
+		_spec.SetField(book.FieldReadingStatus, field.TypeEnum, value)
+		_node.ReadingStatus = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(book.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := _c.mutation.FinishedAt(); ok {
+		_spec.SetField(book.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := _c.mutation.CurrentPage(); ok {
+		_spec.SetField(book.FieldCurrentPage, field.TypeInt, value)
+		_node.CurrentPage = value
 	}
-	if value, ok := _c.mutation.Author(); ok {
-		_spec.SetField(book.FieldAuthor, field.TypeString, value)
-		_node.Author = value
+	if value, ok := _c.mutation.TotalPages(); ok {
+		_spec.SetField(book.FieldTotalPages, field.TypeInt, value)
+		_node.TotalPages = value
 	}
-	if value, ok := _c.mutation.BookIsbn(); ok {
-		_spec.SetField(book.FieldBookIsbn, field.TypeString, value)
-		_node.BookIsbn = value
+	if value, ok := _c.mutation.TitleOverride(); ok {
+		_spec.SetField(book.FieldTitleOverride, field.TypeString, value)
+		_node.TitleOverride = &value
 	}
-	if value, ok := _c.mutation.ThumbnailURL(); ok {
-		_spec.SetField(book.FieldThumbnailURL, field.TypeString, value)
-		_node.ThumbnailURL = value
+	if value, ok := _c.mutation.AuthorOverride(); ok {
+		_spec.SetField(book.FieldAuthorOverride, field.TypeString, value)
+		_node.AuthorOverride = &value
 	}
-	if value, ok := _c.mutation.Status(); ok {
-		_spec.SetField(book.FieldStatus, field.TypeInt, value)
-		_node.Status = value
+	if value, ok := _c.mutation.PublisherOverride(); ok {
+		_spec.SetField(book.FieldPublisherOverride, field.TypeString, value)
+		_node.PublisherOverride = &value
+	}
+	if value, ok := _c.mutation.PublishedDateOverride(); ok {
+		_spec.SetField(book.FieldPublishedDateOverride, field.TypeString, value)
+		_node.PublishedDateOverride = &value
+	}
+	if value, ok := _c.mutation.ThumbnailURLOverride(); ok {
+		_spec.SetField(book.FieldThumbnailURLOverride, field.TypeString, value)
+		_node.ThumbnailURLOverride = &value
+	}
+	if value, ok := _c.mutation.LocationRoom(); ok {
+		_spec.SetField(book.FieldLocationRoom, field.TypeString, value)
+		_node.LocationRoom = value
+	}
+	if value, ok := _c.mutation.LocationBookcase(); ok {
+		_spec.SetField(book.FieldLocationBookcase, field.TypeString, value)
+		_node.LocationBookcase = value
+	}
+	if value, ok := _c.mutation.LocationShelf(); ok {
+		_spec.SetField(book.FieldLocationShelf, field.TypeString, value)
+		_node.LocationShelf = value
+	}
+	if value, ok := _c.mutation.Condition(); ok {
+		_spec.SetField(book.FieldCondition, field.TypeEnum, value)
+		_node.Condition = &value
+	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(book.FieldFormat, field.TypeEnum, value)
+		_node.Format = &value
+	}
+	if value, ok := _c.mutation.PurchasedAt(); ok {
+		_spec.SetField(book.FieldPurchasedAt, field.TypeTime, value)
+		_node.PurchasedAt = &value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(book.FieldPrice, field.TypeFloat64, value)
+		_node.Price = &value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(book.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.AcquiredFrom(); ok {
+		_spec.SetField(book.FieldAcquiredFrom, field.TypeString, value)
+		_node.AcquiredFrom = value
+	}
+	if value, ok := _c.mutation.Live(); ok {
+		_spec.SetField(book.FieldLive, field.TypeBool, value)
+		_node.Live = &value
+	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(book.FieldVersion, field.TypeInt, value)
+		_node.Version = value
 	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(book.FieldCreatedAt, field.TypeTime, value)
@@ -295,6 +866,14 @@ func (_c *BookCreate) createSpec() (*Book, *sqlgraph.CreateSpec) {
 		_spec.SetField(book.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(book.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Visibility(); ok {
+		_spec.SetField(book.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -312,6 +891,40 @@ func (_c *BookCreate) createSpec() (*Book, *sqlgraph.CreateSpec) {
 		_node.user_books = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CatalogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.CatalogTable,
+			Columns: []string{book.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.book_catalog_copies = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LibraryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.LibraryTable,
+			Columns: []string{book.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LibraryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ReviewsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -344,6 +957,122 @@ func (_c *BookCreate) createSpec() (*Book, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReadingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.ReadingSessionsTable,
+			Columns: []string{book.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StatusHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.StatusHistoriesTable,
+			Columns: []string{book.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ShelvesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.ShelvesTable,
+			Columns: book.ShelvesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ShelfBookCreate{config: _c.config, mutation: newShelfBookMutation(_c.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.TagsTable,
+			Columns: book.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.NotesTable,
+			Columns: []string{book.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.LoansTable,
+			Columns: []string{book.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BorrowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.BorrowRequestsTable,
+			Columns: []string{book.BorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,9 +13,19 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
 	"github.com/google/uuid"
 )
@@ -23,14 +33,24 @@ import (
 // BookQuery is the builder for querying Book entities.
 type BookQuery struct {
 	config
-	ctx           *QueryContext
-	order         []book.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.Book
-	withOwner     *UserQuery
-	withReviews   *ReviewQuery
-	withBookmarks *BookmarkQuery
-	withFKs       bool
+	ctx                 *QueryContext
+	order               []book.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Book
+	withOwner           *UserQuery
+	withCatalog         *BookCatalogQuery
+	withLibrary         *LibraryQuery
+	withReviews         *ReviewQuery
+	withBookmarks       *BookmarkQuery
+	withReadingSessions *ReadingSessionQuery
+	withStatusHistories *BookStatusHistoryQuery
+	withShelves         *ShelfQuery
+	withTags            *TagQuery
+	withNotes           *BookNoteQuery
+	withLoans           *LoanQuery
+	withBorrowRequests  *BorrowRequestQuery
+	withShelfBooks      *ShelfBookQuery
+	withFKs             bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -89,6 +109,50 @@ func (_q *BookQuery) QueryOwner() *UserQuery {
 	return query
 }
 
+// QueryCatalog chains the current query on the "catalog" edge.
+func (_q *BookQuery) QueryCatalog() *BookCatalogQuery {
+	query := (&BookCatalogClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(bookcatalog.Table, bookcatalog.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, book.CatalogTable, book.CatalogColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLibrary chains the current query on the "library" edge.
+func (_q *BookQuery) QueryLibrary() *LibraryQuery {
+	query := (&LibraryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(library.Table, library.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, book.LibraryTable, book.LibraryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryReviews chains the current query on the "reviews" edge.
 func (_q *BookQuery) QueryReviews() *ReviewQuery {
 	query := (&ReviewClient{config: _q.config}).Query()
@@ -133,6 +197,182 @@ func (_q *BookQuery) QueryBookmarks() *BookmarkQuery {
 	return query
 }
 
+// QueryReadingSessions chains the current query on the "reading_sessions" edge.
+func (_q *BookQuery) QueryReadingSessions() *ReadingSessionQuery {
+	query := (&ReadingSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(readingsession.Table, readingsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.ReadingSessionsTable, book.ReadingSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStatusHistories chains the current query on the "status_histories" edge.
+func (_q *BookQuery) QueryStatusHistories() *BookStatusHistoryQuery {
+	query := (&BookStatusHistoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(bookstatushistory.Table, bookstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.StatusHistoriesTable, book.StatusHistoriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShelves chains the current query on the "shelves" edge.
+func (_q *BookQuery) QueryShelves() *ShelfQuery {
+	query := (&ShelfClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(shelf.Table, shelf.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, book.ShelvesTable, book.ShelvesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *BookQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, book.TagsTable, book.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryNotes chains the current query on the "notes" edge.
+func (_q *BookQuery) QueryNotes() *BookNoteQuery {
+	query := (&BookNoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(booknote.Table, booknote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.NotesTable, book.NotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLoans chains the current query on the "loans" edge.
+func (_q *BookQuery) QueryLoans() *LoanQuery {
+	query := (&LoanClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.LoansTable, book.LoansColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBorrowRequests chains the current query on the "borrow_requests" edge.
+func (_q *BookQuery) QueryBorrowRequests() *BorrowRequestQuery {
+	query := (&BorrowRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(borrowrequest.Table, borrowrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.BorrowRequestsTable, book.BorrowRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShelfBooks chains the current query on the "shelf_books" edge.
+func (_q *BookQuery) QueryShelfBooks() *ShelfBookQuery {
+	query := (&ShelfBookClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, selector),
+			sqlgraph.To(shelfbook.Table, shelfbook.BookColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, book.ShelfBooksTable, book.ShelfBooksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Book entity from the query.
 // Returns a *NotFoundError when no Book was found.
 func (_q *BookQuery) First(ctx context.Context) (*Book, error) {
@@ -320,14 +560,24 @@ func (_q *BookQuery) Clone() *BookQuery {
 		return nil
 	}
 	return &BookQuery{
-		config:        _q.config,
-		ctx:           _q.ctx.Clone(),
-		order:         append([]book.OrderOption{}, _q.order...),
-		inters:        append([]Interceptor{}, _q.inters...),
-		predicates:    append([]predicate.Book{}, _q.predicates...),
-		withOwner:     _q.withOwner.Clone(),
-		withReviews:   _q.withReviews.Clone(),
-		withBookmarks: _q.withBookmarks.Clone(),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]book.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Book{}, _q.predicates...),
+		withOwner:           _q.withOwner.Clone(),
+		withCatalog:         _q.withCatalog.Clone(),
+		withLibrary:         _q.withLibrary.Clone(),
+		withReviews:         _q.withReviews.Clone(),
+		withBookmarks:       _q.withBookmarks.Clone(),
+		withReadingSessions: _q.withReadingSessions.Clone(),
+		withStatusHistories: _q.withStatusHistories.Clone(),
+		withShelves:         _q.withShelves.Clone(),
+		withTags:            _q.withTags.Clone(),
+		withNotes:           _q.withNotes.Clone(),
+		withLoans:           _q.withLoans.Clone(),
+		withBorrowRequests:  _q.withBorrowRequests.Clone(),
+		withShelfBooks:      _q.withShelfBooks.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -345,6 +595,28 @@ func (_q *BookQuery) WithOwner(opts ...func(*UserQuery)) *BookQuery {
 	return _q
 }
 
+// WithCatalog tells the query-builder to eager-load the nodes that are connected to
+// the "catalog" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithCatalog(opts ...func(*BookCatalogQuery)) *BookQuery {
+	query := (&BookCatalogClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCatalog = query
+	return _q
+}
+
+// WithLibrary tells the query-builder to eager-load the nodes that are connected to
+// the "library" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithLibrary(opts ...func(*LibraryQuery)) *BookQuery {
+	query := (&LibraryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLibrary = query
+	return _q
+}
+
 // WithReviews tells the query-builder to eager-load the nodes that are connected to
 // the "reviews" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *BookQuery) WithReviews(opts ...func(*ReviewQuery)) *BookQuery {
@@ -367,18 +639,106 @@ func (_q *BookQuery) WithBookmarks(opts ...func(*BookmarkQuery)) *BookQuery {
 	return _q
 }
 
+// WithReadingSessions tells the query-builder to eager-load the nodes that are connected to
+// the "reading_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithReadingSessions(opts ...func(*ReadingSessionQuery)) *BookQuery {
+	query := (&ReadingSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReadingSessions = query
+	return _q
+}
+
+// WithStatusHistories tells the query-builder to eager-load the nodes that are connected to
+// the "status_histories" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithStatusHistories(opts ...func(*BookStatusHistoryQuery)) *BookQuery {
+	query := (&BookStatusHistoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStatusHistories = query
+	return _q
+}
+
+// WithShelves tells the query-builder to eager-load the nodes that are connected to
+// the "shelves" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithShelves(opts ...func(*ShelfQuery)) *BookQuery {
+	query := (&ShelfClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShelves = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithTags(opts ...func(*TagQuery)) *BookQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithNotes tells the query-builder to eager-load the nodes that are connected to
+// the "notes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithNotes(opts ...func(*BookNoteQuery)) *BookQuery {
+	query := (&BookNoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withNotes = query
+	return _q
+}
+
+// WithLoans tells the query-builder to eager-load the nodes that are connected to
+// the "loans" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithLoans(opts ...func(*LoanQuery)) *BookQuery {
+	query := (&LoanClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLoans = query
+	return _q
+}
+
+// WithBorrowRequests tells the query-builder to eager-load the nodes that are connected to
+// the "borrow_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithBorrowRequests(opts ...func(*BorrowRequestQuery)) *BookQuery {
+	query := (&BorrowRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBorrowRequests = query
+	return _q
+}
+
+// WithShelfBooks tells the query-builder to eager-load the nodes that are connected to
+// the "shelf_books" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BookQuery) WithShelfBooks(opts ...func(*ShelfBookQuery)) *BookQuery {
+	query := (&ShelfBookClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShelfBooks = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
 // Example:
 //
 //	var v []struct {
-//		BookTitle string `json:"book_title,omitempty"`
+//		ReadingStatus book.ReadingStatus `json:"reading_status,omitempty"`
 //		Count int `json:"count,omitempty"`
 //	}
 //
 //	client.Book.Query().
-//		GroupBy(book.FieldBookTitle).
+//		GroupBy(book.FieldReadingStatus).
 //		Aggregate(ent.Count()).
 //		Scan(ctx, &v)
 func (_q *BookQuery) GroupBy(field string, fields ...string) *BookGroupBy {
@@ -396,11 +756,11 @@ func (_q *BookQuery) GroupBy(field string, fields ...string) *BookGroupBy {
 // Example:
 //
 //	var v []struct {
-//		BookTitle string `json:"book_title,omitempty"`
+//		ReadingStatus book.ReadingStatus `json:"reading_status,omitempty"`
 //	}
 //
 //	client.Book.Query().
-//		Select(book.FieldBookTitle).
+//		Select(book.FieldReadingStatus).
 //		Scan(ctx, &v)
 func (_q *BookQuery) Select(fields ...string) *BookSelect {
 	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
@@ -446,13 +806,23 @@ func (_q *BookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Book, e
 		nodes       = []*Book{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [13]bool{
 			_q.withOwner != nil,
+			_q.withCatalog != nil,
+			_q.withLibrary != nil,
 			_q.withReviews != nil,
 			_q.withBookmarks != nil,
+			_q.withReadingSessions != nil,
+			_q.withStatusHistories != nil,
+			_q.withShelves != nil,
+			_q.withTags != nil,
+			_q.withNotes != nil,
+			_q.withLoans != nil,
+			_q.withBorrowRequests != nil,
+			_q.withShelfBooks != nil,
 		}
 	)
-	if _q.withOwner != nil {
+	if _q.withOwner != nil || _q.withCatalog != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -482,6 +852,18 @@ func (_q *BookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Book, e
 			return nil, err
 		}
 	}
+	if query := _q.withCatalog; query != nil {
+		if err := _q.loadCatalog(ctx, query, nodes, nil,
+			func(n *Book, e *BookCatalog) { n.Edges.Catalog = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLibrary; query != nil {
+		if err := _q.loadLibrary(ctx, query, nodes, nil,
+			func(n *Book, e *Library) { n.Edges.Library = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withReviews; query != nil {
 		if err := _q.loadReviews(ctx, query, nodes,
 			func(n *Book) { n.Edges.Reviews = []*Review{} },
@@ -496,6 +878,62 @@ func (_q *BookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Book, e
 			return nil, err
 		}
 	}
+	if query := _q.withReadingSessions; query != nil {
+		if err := _q.loadReadingSessions(ctx, query, nodes,
+			func(n *Book) { n.Edges.ReadingSessions = []*ReadingSession{} },
+			func(n *Book, e *ReadingSession) { n.Edges.ReadingSessions = append(n.Edges.ReadingSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStatusHistories; query != nil {
+		if err := _q.loadStatusHistories(ctx, query, nodes,
+			func(n *Book) { n.Edges.StatusHistories = []*BookStatusHistory{} },
+			func(n *Book, e *BookStatusHistory) { n.Edges.StatusHistories = append(n.Edges.StatusHistories, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShelves; query != nil {
+		if err := _q.loadShelves(ctx, query, nodes,
+			func(n *Book) { n.Edges.Shelves = []*Shelf{} },
+			func(n *Book, e *Shelf) { n.Edges.Shelves = append(n.Edges.Shelves, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Book) { n.Edges.Tags = []*Tag{} },
+			func(n *Book, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withNotes; query != nil {
+		if err := _q.loadNotes(ctx, query, nodes,
+			func(n *Book) { n.Edges.Notes = []*BookNote{} },
+			func(n *Book, e *BookNote) { n.Edges.Notes = append(n.Edges.Notes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLoans; query != nil {
+		if err := _q.loadLoans(ctx, query, nodes,
+			func(n *Book) { n.Edges.Loans = []*Loan{} },
+			func(n *Book, e *Loan) { n.Edges.Loans = append(n.Edges.Loans, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBorrowRequests; query != nil {
+		if err := _q.loadBorrowRequests(ctx, query, nodes,
+			func(n *Book) { n.Edges.BorrowRequests = []*BorrowRequest{} },
+			func(n *Book, e *BorrowRequest) { n.Edges.BorrowRequests = append(n.Edges.BorrowRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShelfBooks; query != nil {
+		if err := _q.loadShelfBooks(ctx, query, nodes,
+			func(n *Book) { n.Edges.ShelfBooks = []*ShelfBook{} },
+			func(n *Book, e *ShelfBook) { n.Edges.ShelfBooks = append(n.Edges.ShelfBooks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -531,6 +969,70 @@ func (_q *BookQuery) loadOwner(ctx context.Context, query *UserQuery, nodes []*B
 	}
 	return nil
 }
+func (_q *BookQuery) loadCatalog(ctx context.Context, query *BookCatalogQuery, nodes []*Book, init func(*Book), assign func(*Book, *BookCatalog)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Book)
+	for i := range nodes {
+		if nodes[i].book_catalog_copies == nil {
+			continue
+		}
+		fk := *nodes[i].book_catalog_copies
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bookcatalog.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "book_catalog_copies" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BookQuery) loadLibrary(ctx context.Context, query *LibraryQuery, nodes []*Book, init func(*Book), assign func(*Book, *Library)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Book)
+	for i := range nodes {
+		if nodes[i].LibraryID == nil {
+			continue
+		}
+		fk := *nodes[i].LibraryID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(library.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "library_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *BookQuery) loadReviews(ctx context.Context, query *ReviewQuery, nodes []*Book, init func(*Book), assign func(*Book, *Review)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Book)
@@ -593,6 +1095,313 @@ func (_q *BookQuery) loadBookmarks(ctx context.Context, query *BookmarkQuery, no
 	}
 	return nil
 }
+func (_q *BookQuery) loadReadingSessions(ctx context.Context, query *ReadingSessionQuery, nodes []*Book, init func(*Book), assign func(*Book, *ReadingSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Book)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReadingSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(book.ReadingSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.book_reading_sessions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "book_reading_sessions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "book_reading_sessions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BookQuery) loadStatusHistories(ctx context.Context, query *BookStatusHistoryQuery, nodes []*Book, init func(*Book), assign func(*Book, *BookStatusHistory)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Book)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BookStatusHistory(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(book.StatusHistoriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.book_status_histories
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "book_status_histories" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "book_status_histories" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BookQuery) loadShelves(ctx context.Context, query *ShelfQuery, nodes []*Book, init func(*Book), assign func(*Book, *Shelf)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Book)
+	nids := make(map[uuid.UUID]map[*Book]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(book.ShelvesTable)
+		s.Join(joinT).On(s.C(shelf.FieldID), joinT.C(book.ShelvesPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(book.ShelvesPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(book.ShelvesPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Book]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Shelf](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "shelves" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *BookQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Book, init func(*Book), assign func(*Book, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[uuid.UUID]*Book)
+	nids := make(map[uuid.UUID]map[*Book]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(book.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(book.TagsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(book.TagsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(book.TagsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(uuid.UUID)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := *values[0].(*uuid.UUID)
+				inValue := *values[1].(*uuid.UUID)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Book]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *BookQuery) loadNotes(ctx context.Context, query *BookNoteQuery, nodes []*Book, init func(*Book), assign func(*Book, *BookNote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Book)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BookNote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(book.NotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.book_notes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "book_notes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "book_notes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BookQuery) loadLoans(ctx context.Context, query *LoanQuery, nodes []*Book, init func(*Book), assign func(*Book, *Loan)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Book)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Loan(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(book.LoansColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.book_loans
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "book_loans" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "book_loans" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BookQuery) loadBorrowRequests(ctx context.Context, query *BorrowRequestQuery, nodes []*Book, init func(*Book), assign func(*Book, *BorrowRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Book)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BorrowRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(book.BorrowRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.book_borrow_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "book_borrow_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "book_borrow_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *BookQuery) loadShelfBooks(ctx context.Context, query *ShelfBookQuery, nodes []*Book, init func(*Book), assign func(*Book, *ShelfBook)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Book)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shelfbook.FieldBookID)
+	}
+	query.Where(predicate.ShelfBook(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(book.ShelfBooksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BookID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "book_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *BookQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -619,6 +1428,9 @@ func (_q *BookQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withLibrary != nil {
+			_spec.Node.AddColumnOnce(book.FieldLibraryID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
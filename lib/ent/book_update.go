@@ -12,9 +12,18 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
 	"github.com/google/uuid"
 )
@@ -32,92 +41,397 @@ func (_u *BookUpdate) Where(ps ...predicate.Book) *BookUpdate {
 	return _u
 }
 
-// SetBookTitle sets the "book_title" field.
-func (_u *BookUpdate) SetBookTitle(v string) *BookUpdate {
-	_u.mutation.SetBookTitle(v)
+// SetReadingStatus sets the "reading_status" field.
+func (_u *BookUpdate) SetReadingStatus(v book.ReadingStatus) *BookUpdate {
+	_u.mutation.SetReadingStatus(v)
 	return _u
 }
 
-// SetNillableBookTitle sets the "book_title" field if the given value is not nil.
-func (_u *BookUpdate) SetNillableBookTitle(v *string) *BookUpdate {
+// SetNillableReadingStatus sets the "reading_status" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableReadingStatus(v *book.ReadingStatus) *BookUpdate {
 	if v != nil {
-		_u.SetBookTitle(*v)
+		_u.SetReadingStatus(*v)
 	}
 	return _u
 }
 
-// SetAuthor sets the "author" field.
-func (_u *BookUpdate) SetAuthor(v string) *BookUpdate {
-	_u.mutation.SetAuthor(v)
+// SetStartedAt sets the "started_at" field.
+func (_u *BookUpdate) SetStartedAt(v time.Time) *BookUpdate {
+	_u.mutation.SetStartedAt(v)
 	return _u
 }
 
-// SetNillableAuthor sets the "author" field if the given value is not nil.
-func (_u *BookUpdate) SetNillableAuthor(v *string) *BookUpdate {
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableStartedAt(v *time.Time) *BookUpdate {
 	if v != nil {
-		_u.SetAuthor(*v)
+		_u.SetStartedAt(*v)
 	}
 	return _u
 }
 
-// SetBookIsbn sets the "book_isbn" field.
-func (_u *BookUpdate) SetBookIsbn(v string) *BookUpdate {
-	_u.mutation.SetBookIsbn(v)
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *BookUpdate) ClearStartedAt() *BookUpdate {
+	_u.mutation.ClearStartedAt()
 	return _u
 }
 
-// SetNillableBookIsbn sets the "book_isbn" field if the given value is not nil.
-func (_u *BookUpdate) SetNillableBookIsbn(v *string) *BookUpdate {
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BookUpdate) SetFinishedAt(v time.Time) *BookUpdate {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableFinishedAt(v *time.Time) *BookUpdate {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *BookUpdate) ClearFinishedAt() *BookUpdate {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetCurrentPage sets the "current_page" field.
+func (_u *BookUpdate) SetCurrentPage(v int) *BookUpdate {
+	_u.mutation.ResetCurrentPage()
+	_u.mutation.SetCurrentPage(v)
+	return _u
+}
+
+// SetNillableCurrentPage sets the "current_page" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableCurrentPage(v *int) *BookUpdate {
+	if v != nil {
+		_u.SetCurrentPage(*v)
+	}
+	return _u
+}
+
+// AddCurrentPage adds value to the "current_page" field.
+func (_u *BookUpdate) AddCurrentPage(v int) *BookUpdate {
+	_u.mutation.AddCurrentPage(v)
+	return _u
+}
+
+// SetTotalPages sets the "total_pages" field.
+func (_u *BookUpdate) SetTotalPages(v int) *BookUpdate {
+	_u.mutation.ResetTotalPages()
+	_u.mutation.SetTotalPages(v)
+	return _u
+}
+
+// SetNillableTotalPages sets the "total_pages" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableTotalPages(v *int) *BookUpdate {
+	if v != nil {
+		_u.SetTotalPages(*v)
+	}
+	return _u
+}
+
+// AddTotalPages adds value to the "total_pages" field.
+func (_u *BookUpdate) AddTotalPages(v int) *BookUpdate {
+	_u.mutation.AddTotalPages(v)
+	return _u
+}
+
+// SetTitleOverride sets the "title_override" field.
+func (_u *BookUpdate) SetTitleOverride(v string) *BookUpdate {
+	_u.mutation.SetTitleOverride(v)
+	return _u
+}
+
+// SetNillableTitleOverride sets the "title_override" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableTitleOverride(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetTitleOverride(*v)
+	}
+	return _u
+}
+
+// ClearTitleOverride clears the value of the "title_override" field.
+func (_u *BookUpdate) ClearTitleOverride() *BookUpdate {
+	_u.mutation.ClearTitleOverride()
+	return _u
+}
+
+// SetAuthorOverride sets the "author_override" field.
+func (_u *BookUpdate) SetAuthorOverride(v string) *BookUpdate {
+	_u.mutation.SetAuthorOverride(v)
+	return _u
+}
+
+// SetNillableAuthorOverride sets the "author_override" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableAuthorOverride(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetAuthorOverride(*v)
+	}
+	return _u
+}
+
+// ClearAuthorOverride clears the value of the "author_override" field.
+func (_u *BookUpdate) ClearAuthorOverride() *BookUpdate {
+	_u.mutation.ClearAuthorOverride()
+	return _u
+}
+
+// SetPublisherOverride sets the "publisher_override" field.
+func (_u *BookUpdate) SetPublisherOverride(v string) *BookUpdate {
+	_u.mutation.SetPublisherOverride(v)
+	return _u
+}
+
+// SetNillablePublisherOverride sets the "publisher_override" field if the given value is not nil.
+func (_u *BookUpdate) SetNillablePublisherOverride(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetPublisherOverride(*v)
+	}
+	return _u
+}
+
+// ClearPublisherOverride clears the value of the "publisher_override" field.
+func (_u *BookUpdate) ClearPublisherOverride() *BookUpdate {
+	_u.mutation.ClearPublisherOverride()
+	return _u
+}
+
+// SetPublishedDateOverride sets the "published_date_override" field.
+func (_u *BookUpdate) SetPublishedDateOverride(v string) *BookUpdate {
+	_u.mutation.SetPublishedDateOverride(v)
+	return _u
+}
+
+// SetNillablePublishedDateOverride sets the "published_date_override" field if the given value is not nil.
+func (_u *BookUpdate) SetNillablePublishedDateOverride(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetPublishedDateOverride(*v)
+	}
+	return _u
+}
+
+// ClearPublishedDateOverride clears the value of the "published_date_override" field.
+func (_u *BookUpdate) ClearPublishedDateOverride() *BookUpdate {
+	_u.mutation.ClearPublishedDateOverride()
+	return _u
+}
+
+// SetThumbnailURLOverride sets the "thumbnail_url_override" field.
+func (_u *BookUpdate) SetThumbnailURLOverride(v string) *BookUpdate {
+	_u.mutation.SetThumbnailURLOverride(v)
+	return _u
+}
+
+// SetNillableThumbnailURLOverride sets the "thumbnail_url_override" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableThumbnailURLOverride(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetThumbnailURLOverride(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURLOverride clears the value of the "thumbnail_url_override" field.
+func (_u *BookUpdate) ClearThumbnailURLOverride() *BookUpdate {
+	_u.mutation.ClearThumbnailURLOverride()
+	return _u
+}
+
+// SetLocationRoom sets the "location_room" field.
+func (_u *BookUpdate) SetLocationRoom(v string) *BookUpdate {
+	_u.mutation.SetLocationRoom(v)
+	return _u
+}
+
+// SetNillableLocationRoom sets the "location_room" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableLocationRoom(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetLocationRoom(*v)
+	}
+	return _u
+}
+
+// SetLocationBookcase sets the "location_bookcase" field.
+func (_u *BookUpdate) SetLocationBookcase(v string) *BookUpdate {
+	_u.mutation.SetLocationBookcase(v)
+	return _u
+}
+
+// SetNillableLocationBookcase sets the "location_bookcase" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableLocationBookcase(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetLocationBookcase(*v)
+	}
+	return _u
+}
+
+// SetLocationShelf sets the "location_shelf" field.
+func (_u *BookUpdate) SetLocationShelf(v string) *BookUpdate {
+	_u.mutation.SetLocationShelf(v)
+	return _u
+}
+
+// SetNillableLocationShelf sets the "location_shelf" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableLocationShelf(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetLocationShelf(*v)
+	}
+	return _u
+}
+
+// SetCondition sets the "condition" field.
+func (_u *BookUpdate) SetCondition(v book.Condition) *BookUpdate {
+	_u.mutation.SetCondition(v)
+	return _u
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableCondition(v *book.Condition) *BookUpdate {
+	if v != nil {
+		_u.SetCondition(*v)
+	}
+	return _u
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (_u *BookUpdate) ClearCondition() *BookUpdate {
+	_u.mutation.ClearCondition()
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *BookUpdate) SetFormat(v book.Format) *BookUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableFormat(v *book.Format) *BookUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (_u *BookUpdate) ClearFormat() *BookUpdate {
+	_u.mutation.ClearFormat()
+	return _u
+}
+
+// SetPurchasedAt sets the "purchased_at" field.
+func (_u *BookUpdate) SetPurchasedAt(v time.Time) *BookUpdate {
+	_u.mutation.SetPurchasedAt(v)
+	return _u
+}
+
+// SetNillablePurchasedAt sets the "purchased_at" field if the given value is not nil.
+func (_u *BookUpdate) SetNillablePurchasedAt(v *time.Time) *BookUpdate {
+	if v != nil {
+		_u.SetPurchasedAt(*v)
+	}
+	return _u
+}
+
+// ClearPurchasedAt clears the value of the "purchased_at" field.
+func (_u *BookUpdate) ClearPurchasedAt() *BookUpdate {
+	_u.mutation.ClearPurchasedAt()
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *BookUpdate) SetPrice(v float64) *BookUpdate {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *BookUpdate) SetNillablePrice(v *float64) *BookUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *BookUpdate) AddPrice(v float64) *BookUpdate {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// ClearPrice clears the value of the "price" field.
+func (_u *BookUpdate) ClearPrice() *BookUpdate {
+	_u.mutation.ClearPrice()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BookUpdate) SetCurrency(v string) *BookUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableCurrency(v *string) *BookUpdate {
 	if v != nil {
-		_u.SetBookIsbn(*v)
+		_u.SetCurrency(*v)
 	}
 	return _u
 }
 
-// ClearBookIsbn clears the value of the "book_isbn" field.
-func (_u *BookUpdate) ClearBookIsbn() *BookUpdate {
-	_u.mutation.ClearBookIsbn()
+// SetAcquiredFrom sets the "acquired_from" field.
+func (_u *BookUpdate) SetAcquiredFrom(v string) *BookUpdate {
+	_u.mutation.SetAcquiredFrom(v)
+	return _u
+}
+
+// SetNillableAcquiredFrom sets the "acquired_from" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableAcquiredFrom(v *string) *BookUpdate {
+	if v != nil {
+		_u.SetAcquiredFrom(*v)
+	}
 	return _u
 }
 
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (_u *BookUpdate) SetThumbnailURL(v string) *BookUpdate {
-	_u.mutation.SetThumbnailURL(v)
+// SetLive sets the "live" field.
+func (_u *BookUpdate) SetLive(v bool) *BookUpdate {
+	_u.mutation.SetLive(v)
 	return _u
 }
 
-// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
-func (_u *BookUpdate) SetNillableThumbnailURL(v *string) *BookUpdate {
+// SetNillableLive sets the "live" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableLive(v *bool) *BookUpdate {
 	if v != nil {
-		_u.SetThumbnailURL(*v)
+		_u.SetLive(*v)
 	}
 	return _u
 }
 
-// ClearThumbnailURL clears the value of the "thumbnail_url" field.
-func (_u *BookUpdate) ClearThumbnailURL() *BookUpdate {
-	_u.mutation.ClearThumbnailURL()
+// ClearLive clears the value of the "live" field.
+func (_u *BookUpdate) ClearLive() *BookUpdate {
+	_u.mutation.ClearLive()
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *BookUpdate) SetStatus(v int) *BookUpdate {
-	_u.mutation.ResetStatus()
-	_u.mutation.SetStatus(v)
+// SetVersion sets the "version" field.
+func (_u *BookUpdate) SetVersion(v int) *BookUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *BookUpdate) SetNillableStatus(v *int) *BookUpdate {
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableVersion(v *int) *BookUpdate {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetVersion(*v)
 	}
 	return _u
 }
 
-// AddStatus adds value to the "status" field.
-func (_u *BookUpdate) AddStatus(v int) *BookUpdate {
-	_u.mutation.AddStatus(v)
+// AddVersion adds value to the "version" field.
+func (_u *BookUpdate) AddVersion(v int) *BookUpdate {
+	_u.mutation.AddVersion(v)
 	return _u
 }
 
@@ -141,6 +455,60 @@ func (_u *BookUpdate) SetUpdatedAt(v time.Time) *BookUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BookUpdate) SetDeletedAt(v time.Time) *BookUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableDeletedAt(v *time.Time) *BookUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BookUpdate) ClearDeletedAt() *BookUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetLibraryID sets the "library_id" field.
+func (_u *BookUpdate) SetLibraryID(v uuid.UUID) *BookUpdate {
+	_u.mutation.SetLibraryID(v)
+	return _u
+}
+
+// SetNillableLibraryID sets the "library_id" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableLibraryID(v *uuid.UUID) *BookUpdate {
+	if v != nil {
+		_u.SetLibraryID(*v)
+	}
+	return _u
+}
+
+// ClearLibraryID clears the value of the "library_id" field.
+func (_u *BookUpdate) ClearLibraryID() *BookUpdate {
+	_u.mutation.ClearLibraryID()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *BookUpdate) SetVisibility(v book.Visibility) *BookUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *BookUpdate) SetNillableVisibility(v *book.Visibility) *BookUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *BookUpdate) SetOwnerID(id uuid.UUID) *BookUpdate {
 	_u.mutation.SetOwnerID(id)
@@ -152,6 +520,30 @@ func (_u *BookUpdate) SetOwner(v *User) *BookUpdate {
 	return _u.SetOwnerID(v.ID)
 }
 
+// SetCatalogID sets the "catalog" edge to the BookCatalog entity by ID.
+func (_u *BookUpdate) SetCatalogID(id uuid.UUID) *BookUpdate {
+	_u.mutation.SetCatalogID(id)
+	return _u
+}
+
+// SetNillableCatalogID sets the "catalog" edge to the BookCatalog entity by ID if the given value is not nil.
+func (_u *BookUpdate) SetNillableCatalogID(id *uuid.UUID) *BookUpdate {
+	if id != nil {
+		_u = _u.SetCatalogID(*id)
+	}
+	return _u
+}
+
+// SetCatalog sets the "catalog" edge to the BookCatalog entity.
+func (_u *BookUpdate) SetCatalog(v *BookCatalog) *BookUpdate {
+	return _u.SetCatalogID(v.ID)
+}
+
+// SetLibrary sets the "library" edge to the Library entity.
+func (_u *BookUpdate) SetLibrary(v *Library) *BookUpdate {
+	return _u.SetLibraryID(v.ID)
+}
+
 // AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
 func (_u *BookUpdate) AddReviewIDs(ids ...uuid.UUID) *BookUpdate {
 	_u.mutation.AddReviewIDs(ids...)
@@ -182,66 +574,330 @@ func (_u *BookUpdate) AddBookmarks(v ...*Bookmark) *BookUpdate {
 	return _u.AddBookmarkIDs(ids...)
 }
 
-// Mutation returns the BookMutation object of the builder.
-func (_u *BookUpdate) Mutation() *BookMutation {
-	return _u.mutation
+// AddReadingSessionIDs adds the "reading_sessions" edge to the ReadingSession entity by IDs.
+func (_u *BookUpdate) AddReadingSessionIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.AddReadingSessionIDs(ids...)
+	return _u
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (_u *BookUpdate) ClearOwner() *BookUpdate {
-	_u.mutation.ClearOwner()
-	return _u
+// AddReadingSessions adds the "reading_sessions" edges to the ReadingSession entity.
+func (_u *BookUpdate) AddReadingSessions(v ...*ReadingSession) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadingSessionIDs(ids...)
 }
 
-// ClearReviews clears all "reviews" edges to the Review entity.
-func (_u *BookUpdate) ClearReviews() *BookUpdate {
-	_u.mutation.ClearReviews()
+// AddStatusHistoryIDs adds the "status_histories" edge to the BookStatusHistory entity by IDs.
+func (_u *BookUpdate) AddStatusHistoryIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.AddStatusHistoryIDs(ids...)
 	return _u
 }
 
-// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
-func (_u *BookUpdate) RemoveReviewIDs(ids ...uuid.UUID) *BookUpdate {
-	_u.mutation.RemoveReviewIDs(ids...)
+// AddStatusHistories adds the "status_histories" edges to the BookStatusHistory entity.
+func (_u *BookUpdate) AddStatusHistories(v ...*BookStatusHistory) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusHistoryIDs(ids...)
+}
+
+// AddShelfIDs adds the "shelves" edge to the Shelf entity by IDs.
+func (_u *BookUpdate) AddShelfIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.AddShelfIDs(ids...)
 	return _u
 }
 
-// RemoveReviews removes "reviews" edges to Review entities.
-func (_u *BookUpdate) RemoveReviews(v ...*Review) *BookUpdate {
+// AddShelves adds the "shelves" edges to the Shelf entity.
+func (_u *BookUpdate) AddShelves(v ...*Shelf) *BookUpdate {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReviewIDs(ids...)
+	return _u.AddShelfIDs(ids...)
 }
 
-// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
-func (_u *BookUpdate) ClearBookmarks() *BookUpdate {
-	_u.mutation.ClearBookmarks()
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *BookUpdate) AddTagIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.AddTagIDs(ids...)
 	return _u
 }
 
-// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
-func (_u *BookUpdate) RemoveBookmarkIDs(ids ...uuid.UUID) *BookUpdate {
-	_u.mutation.RemoveBookmarkIDs(ids...)
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *BookUpdate) AddTags(v ...*Tag) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the BookNote entity by IDs.
+func (_u *BookUpdate) AddNoteIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.AddNoteIDs(ids...)
 	return _u
 }
 
-// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
-func (_u *BookUpdate) RemoveBookmarks(v ...*Bookmark) *BookUpdate {
+// AddNotes adds the "notes" edges to the BookNote entity.
+func (_u *BookUpdate) AddNotes(v ...*BookNote) *BookUpdate {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBookmarkIDs(ids...)
+	return _u.AddNoteIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *BookUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (_u *BookUpdate) AddLoanIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.AddLoanIDs(ids...)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
+// AddLoans adds the "loans" edges to the Loan entity.
+func (_u *BookUpdate) AddLoans(v ...*Loan) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLoanIDs(ids...)
+}
+
+// AddBorrowRequestIDs adds the "borrow_requests" edge to the BorrowRequest entity by IDs.
+func (_u *BookUpdate) AddBorrowRequestIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.AddBorrowRequestIDs(ids...)
+	return _u
+}
+
+// AddBorrowRequests adds the "borrow_requests" edges to the BorrowRequest entity.
+func (_u *BookUpdate) AddBorrowRequests(v ...*BorrowRequest) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBorrowRequestIDs(ids...)
+}
+
+// Mutation returns the BookMutation object of the builder.
+func (_u *BookUpdate) Mutation() *BookMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *BookUpdate) ClearOwner() *BookUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearCatalog clears the "catalog" edge to the BookCatalog entity.
+func (_u *BookUpdate) ClearCatalog() *BookUpdate {
+	_u.mutation.ClearCatalog()
+	return _u
+}
+
+// ClearLibrary clears the "library" edge to the Library entity.
+func (_u *BookUpdate) ClearLibrary() *BookUpdate {
+	_u.mutation.ClearLibrary()
+	return _u
+}
+
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (_u *BookUpdate) ClearReviews() *BookUpdate {
+	_u.mutation.ClearReviews()
+	return _u
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (_u *BookUpdate) RemoveReviewIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.RemoveReviewIDs(ids...)
+	return _u
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (_u *BookUpdate) RemoveReviews(v ...*Review) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewIDs(ids...)
+}
+
+// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
+func (_u *BookUpdate) ClearBookmarks() *BookUpdate {
+	_u.mutation.ClearBookmarks()
+	return _u
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
+func (_u *BookUpdate) RemoveBookmarkIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.RemoveBookmarkIDs(ids...)
+	return _u
+}
+
+// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
+func (_u *BookUpdate) RemoveBookmarks(v ...*Bookmark) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookmarkIDs(ids...)
+}
+
+// ClearReadingSessions clears all "reading_sessions" edges to the ReadingSession entity.
+func (_u *BookUpdate) ClearReadingSessions() *BookUpdate {
+	_u.mutation.ClearReadingSessions()
+	return _u
+}
+
+// RemoveReadingSessionIDs removes the "reading_sessions" edge to ReadingSession entities by IDs.
+func (_u *BookUpdate) RemoveReadingSessionIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.RemoveReadingSessionIDs(ids...)
+	return _u
+}
+
+// RemoveReadingSessions removes "reading_sessions" edges to ReadingSession entities.
+func (_u *BookUpdate) RemoveReadingSessions(v ...*ReadingSession) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReadingSessionIDs(ids...)
+}
+
+// ClearStatusHistories clears all "status_histories" edges to the BookStatusHistory entity.
+func (_u *BookUpdate) ClearStatusHistories() *BookUpdate {
+	_u.mutation.ClearStatusHistories()
+	return _u
+}
+
+// RemoveStatusHistoryIDs removes the "status_histories" edge to BookStatusHistory entities by IDs.
+func (_u *BookUpdate) RemoveStatusHistoryIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.RemoveStatusHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveStatusHistories removes "status_histories" edges to BookStatusHistory entities.
+func (_u *BookUpdate) RemoveStatusHistories(v ...*BookStatusHistory) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusHistoryIDs(ids...)
+}
+
+// ClearShelves clears all "shelves" edges to the Shelf entity.
+func (_u *BookUpdate) ClearShelves() *BookUpdate {
+	_u.mutation.ClearShelves()
+	return _u
+}
+
+// RemoveShelfIDs removes the "shelves" edge to Shelf entities by IDs.
+func (_u *BookUpdate) RemoveShelfIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.RemoveShelfIDs(ids...)
+	return _u
+}
+
+// RemoveShelves removes "shelves" edges to Shelf entities.
+func (_u *BookUpdate) RemoveShelves(v ...*Shelf) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShelfIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *BookUpdate) ClearTags() *BookUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *BookUpdate) RemoveTagIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *BookUpdate) RemoveTags(v ...*Tag) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearNotes clears all "notes" edges to the BookNote entity.
+func (_u *BookUpdate) ClearNotes() *BookUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// RemoveNoteIDs removes the "notes" edge to BookNote entities by IDs.
+func (_u *BookUpdate) RemoveNoteIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.RemoveNoteIDs(ids...)
+	return _u
+}
+
+// RemoveNotes removes "notes" edges to BookNote entities.
+func (_u *BookUpdate) RemoveNotes(v ...*BookNote) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNoteIDs(ids...)
+}
+
+// ClearLoans clears all "loans" edges to the Loan entity.
+func (_u *BookUpdate) ClearLoans() *BookUpdate {
+	_u.mutation.ClearLoans()
+	return _u
+}
+
+// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
+func (_u *BookUpdate) RemoveLoanIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.RemoveLoanIDs(ids...)
+	return _u
+}
+
+// RemoveLoans removes "loans" edges to Loan entities.
+func (_u *BookUpdate) RemoveLoans(v ...*Loan) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLoanIDs(ids...)
+}
+
+// ClearBorrowRequests clears all "borrow_requests" edges to the BorrowRequest entity.
+func (_u *BookUpdate) ClearBorrowRequests() *BookUpdate {
+	_u.mutation.ClearBorrowRequests()
+	return _u
+}
+
+// RemoveBorrowRequestIDs removes the "borrow_requests" edge to BorrowRequest entities by IDs.
+func (_u *BookUpdate) RemoveBorrowRequestIDs(ids ...uuid.UUID) *BookUpdate {
+	_u.mutation.RemoveBorrowRequestIDs(ids...)
+	return _u
+}
+
+// RemoveBorrowRequests removes "borrow_requests" edges to BorrowRequest entities.
+func (_u *BookUpdate) RemoveBorrowRequests(v ...*BorrowRequest) *BookUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBorrowRequestIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BookUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
 func (_u *BookUpdate) SaveX(ctx context.Context) int {
 	affected, err := _u.Save(ctx)
 	if err != nil {
@@ -273,14 +929,44 @@ func (_u *BookUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *BookUpdate) check() error {
-	if v, ok := _u.mutation.BookTitle(); ok {
-		if err := book.BookTitleValidator(v); err != nil {
-			return &ValidationError{Name: "book_title", err: fmt.Errorf(`ent: validator failed for field "Book.book_title": %w`, err)}
+	if v, ok := _u.mutation.ReadingStatus(); ok {
+		if err := book.ReadingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "reading_status", err: fmt.Errorf(`ent: validator failed for field "Book.reading_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CurrentPage(); ok {
+		if err := book.CurrentPageValidator(v); err != nil {
+			return &ValidationError{Name: "current_page", err: fmt.Errorf(`ent: validator failed for field "Book.current_page": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalPages(); ok {
+		if err := book.TotalPagesValidator(v); err != nil {
+			return &ValidationError{Name: "total_pages", err: fmt.Errorf(`ent: validator failed for field "Book.total_pages": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Condition(); ok {
+		if err := book.ConditionValidator(v); err != nil {
+			return &ValidationError{Name: "condition", err: fmt.Errorf(`ent: validator failed for field "Book.condition": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := book.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Book.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := book.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Book.price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := book.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Book.version": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Author(); ok {
-		if err := book.AuthorValidator(v); err != nil {
-			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "Book.author": %w`, err)}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := book.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Book.visibility": %w`, err)}
 		}
 	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
@@ -301,29 +987,116 @@ func (_u *BookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.BookTitle(); ok {
-		_spec.SetField(book.FieldBookTitle, field.TypeString, value)
+	if value, ok := _u.mutation.ReadingStatus(); ok {
+		_spec.SetField(book.FieldReadingStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(book.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(book.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(book.FieldFinishedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(book.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CurrentPage(); ok {
+		_spec.SetField(book.FieldCurrentPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentPage(); ok {
+		_spec.AddField(book.FieldCurrentPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TotalPages(); ok {
+		_spec.SetField(book.FieldTotalPages, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalPages(); ok {
+		_spec.AddField(book.FieldTotalPages, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TitleOverride(); ok {
+		_spec.SetField(book.FieldTitleOverride, field.TypeString, value)
+	}
+	if _u.mutation.TitleOverrideCleared() {
+		_spec.ClearField(book.FieldTitleOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorOverride(); ok {
+		_spec.SetField(book.FieldAuthorOverride, field.TypeString, value)
+	}
+	if _u.mutation.AuthorOverrideCleared() {
+		_spec.ClearField(book.FieldAuthorOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublisherOverride(); ok {
+		_spec.SetField(book.FieldPublisherOverride, field.TypeString, value)
+	}
+	if _u.mutation.PublisherOverrideCleared() {
+		_spec.ClearField(book.FieldPublisherOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedDateOverride(); ok {
+		_spec.SetField(book.FieldPublishedDateOverride, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.Author(); ok {
-		_spec.SetField(book.FieldAuthor, field.TypeString, value)
+	if _u.mutation.PublishedDateOverrideCleared() {
+		_spec.ClearField(book.FieldPublishedDateOverride, field.TypeString)
 	}
-	if value, ok := _u.mutation.BookIsbn(); ok {
-		_spec.SetField(book.FieldBookIsbn, field.TypeString, value)
+	if value, ok := _u.mutation.ThumbnailURLOverride(); ok {
+		_spec.SetField(book.FieldThumbnailURLOverride, field.TypeString, value)
 	}
-	if _u.mutation.BookIsbnCleared() {
-		_spec.ClearField(book.FieldBookIsbn, field.TypeString)
+	if _u.mutation.ThumbnailURLOverrideCleared() {
+		_spec.ClearField(book.FieldThumbnailURLOverride, field.TypeString)
 	}
-	if value, ok := _u.mutation.ThumbnailURL(); ok {
-		_spec.SetField(book.FieldThumbnailURL, field.TypeString, value)
+	if value, ok := _u.mutation.LocationRoom(); ok {
+		_spec.SetField(book.FieldLocationRoom, field.TypeString, value)
 	}
-	if _u.mutation.ThumbnailURLCleared() {
-		_spec.ClearField(book.FieldThumbnailURL, field.TypeString)
+	if value, ok := _u.mutation.LocationBookcase(); ok {
+		_spec.SetField(book.FieldLocationBookcase, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(book.FieldStatus, field.TypeInt, value)
+	if value, ok := _u.mutation.LocationShelf(); ok {
+		_spec.SetField(book.FieldLocationShelf, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.AddedStatus(); ok {
-		_spec.AddField(book.FieldStatus, field.TypeInt, value)
+	if value, ok := _u.mutation.Condition(); ok {
+		_spec.SetField(book.FieldCondition, field.TypeEnum, value)
+	}
+	if _u.mutation.ConditionCleared() {
+		_spec.ClearField(book.FieldCondition, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(book.FieldFormat, field.TypeEnum, value)
+	}
+	if _u.mutation.FormatCleared() {
+		_spec.ClearField(book.FieldFormat, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.PurchasedAt(); ok {
+		_spec.SetField(book.FieldPurchasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PurchasedAtCleared() {
+		_spec.ClearField(book.FieldPurchasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(book.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(book.FieldPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.PriceCleared() {
+		_spec.ClearField(book.FieldPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(book.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AcquiredFrom(); ok {
+		_spec.SetField(book.FieldAcquiredFrom, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Live(); ok {
+		_spec.SetField(book.FieldLive, field.TypeBool, value)
+	}
+	if _u.mutation.LiveCleared() {
+		_spec.ClearField(book.FieldLive, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(book.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(book.FieldVersion, field.TypeInt, value)
 	}
 	if value, ok := _u.mutation.CreatedAt(); ok {
 		_spec.SetField(book.FieldCreatedAt, field.TypeTime, value)
@@ -331,6 +1104,15 @@ func (_u *BookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(book.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(book.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(book.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(book.FieldVisibility, field.TypeEnum, value)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -360,6 +1142,64 @@ func (_u *BookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CatalogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.CatalogTable,
+			Columns: []string{book.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CatalogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.CatalogTable,
+			Columns: []string{book.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LibraryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.LibraryTable,
+			Columns: []string{book.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LibraryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.LibraryTable,
+			Columns: []string{book.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ReviewsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -450,11 +1290,338 @@ func (_u *BookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{book.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.ReadingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.ReadingSessionsTable,
+			Columns: []string{book.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReadingSessionsIDs(); len(nodes) > 0 && !_u.mutation.ReadingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.ReadingSessionsTable,
+			Columns: []string{book.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReadingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.ReadingSessionsTable,
+			Columns: []string{book.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatusHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.StatusHistoriesTable,
+			Columns: []string{book.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusHistoriesIDs(); len(nodes) > 0 && !_u.mutation.StatusHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.StatusHistoriesTable,
+			Columns: []string{book.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.StatusHistoriesTable,
+			Columns: []string{book.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShelvesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.ShelvesTable,
+			Columns: book.ShelvesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShelvesIDs(); len(nodes) > 0 && !_u.mutation.ShelvesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.ShelvesTable,
+			Columns: book.ShelvesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShelvesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.ShelvesTable,
+			Columns: book.ShelvesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.TagsTable,
+			Columns: book.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.TagsTable,
+			Columns: book.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.TagsTable,
+			Columns: book.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.NotesTable,
+			Columns: []string{book.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotesIDs(); len(nodes) > 0 && !_u.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.NotesTable,
+			Columns: []string{book.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.NotesTable,
+			Columns: []string{book.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.LoansTable,
+			Columns: []string{book.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLoansIDs(); len(nodes) > 0 && !_u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.LoansTable,
+			Columns: []string{book.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.LoansTable,
+			Columns: []string{book.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.BorrowRequestsTable,
+			Columns: []string{book.BorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBorrowRequestsIDs(); len(nodes) > 0 && !_u.mutation.BorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.BorrowRequestsTable,
+			Columns: []string{book.BorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BorrowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.BorrowRequestsTable,
+			Columns: []string{book.BorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{book.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
 		}
 		return 0, err
 	}
@@ -470,115 +1637,474 @@ type BookUpdateOne struct {
 	mutation *BookMutation
 }
 
-// SetBookTitle sets the "book_title" field.
-func (_u *BookUpdateOne) SetBookTitle(v string) *BookUpdateOne {
-	_u.mutation.SetBookTitle(v)
+// SetReadingStatus sets the "reading_status" field.
+func (_u *BookUpdateOne) SetReadingStatus(v book.ReadingStatus) *BookUpdateOne {
+	_u.mutation.SetReadingStatus(v)
+	return _u
+}
+
+// SetNillableReadingStatus sets the "reading_status" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableReadingStatus(v *book.ReadingStatus) *BookUpdateOne {
+	if v != nil {
+		_u.SetReadingStatus(*v)
+	}
+	return _u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_u *BookUpdateOne) SetStartedAt(v time.Time) *BookUpdateOne {
+	_u.mutation.SetStartedAt(v)
+	return _u
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableStartedAt(v *time.Time) *BookUpdateOne {
+	if v != nil {
+		_u.SetStartedAt(*v)
+	}
+	return _u
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (_u *BookUpdateOne) ClearStartedAt() *BookUpdateOne {
+	_u.mutation.ClearStartedAt()
+	return _u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (_u *BookUpdateOne) SetFinishedAt(v time.Time) *BookUpdateOne {
+	_u.mutation.SetFinishedAt(v)
+	return _u
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableFinishedAt(v *time.Time) *BookUpdateOne {
+	if v != nil {
+		_u.SetFinishedAt(*v)
+	}
+	return _u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (_u *BookUpdateOne) ClearFinishedAt() *BookUpdateOne {
+	_u.mutation.ClearFinishedAt()
+	return _u
+}
+
+// SetCurrentPage sets the "current_page" field.
+func (_u *BookUpdateOne) SetCurrentPage(v int) *BookUpdateOne {
+	_u.mutation.ResetCurrentPage()
+	_u.mutation.SetCurrentPage(v)
+	return _u
+}
+
+// SetNillableCurrentPage sets the "current_page" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableCurrentPage(v *int) *BookUpdateOne {
+	if v != nil {
+		_u.SetCurrentPage(*v)
+	}
+	return _u
+}
+
+// AddCurrentPage adds value to the "current_page" field.
+func (_u *BookUpdateOne) AddCurrentPage(v int) *BookUpdateOne {
+	_u.mutation.AddCurrentPage(v)
+	return _u
+}
+
+// SetTotalPages sets the "total_pages" field.
+func (_u *BookUpdateOne) SetTotalPages(v int) *BookUpdateOne {
+	_u.mutation.ResetTotalPages()
+	_u.mutation.SetTotalPages(v)
+	return _u
+}
+
+// SetNillableTotalPages sets the "total_pages" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableTotalPages(v *int) *BookUpdateOne {
+	if v != nil {
+		_u.SetTotalPages(*v)
+	}
+	return _u
+}
+
+// AddTotalPages adds value to the "total_pages" field.
+func (_u *BookUpdateOne) AddTotalPages(v int) *BookUpdateOne {
+	_u.mutation.AddTotalPages(v)
+	return _u
+}
+
+// SetTitleOverride sets the "title_override" field.
+func (_u *BookUpdateOne) SetTitleOverride(v string) *BookUpdateOne {
+	_u.mutation.SetTitleOverride(v)
+	return _u
+}
+
+// SetNillableTitleOverride sets the "title_override" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableTitleOverride(v *string) *BookUpdateOne {
+	if v != nil {
+		_u.SetTitleOverride(*v)
+	}
+	return _u
+}
+
+// ClearTitleOverride clears the value of the "title_override" field.
+func (_u *BookUpdateOne) ClearTitleOverride() *BookUpdateOne {
+	_u.mutation.ClearTitleOverride()
 	return _u
 }
 
-// SetNillableBookTitle sets the "book_title" field if the given value is not nil.
-func (_u *BookUpdateOne) SetNillableBookTitle(v *string) *BookUpdateOne {
+// SetAuthorOverride sets the "author_override" field.
+func (_u *BookUpdateOne) SetAuthorOverride(v string) *BookUpdateOne {
+	_u.mutation.SetAuthorOverride(v)
+	return _u
+}
+
+// SetNillableAuthorOverride sets the "author_override" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableAuthorOverride(v *string) *BookUpdateOne {
 	if v != nil {
-		_u.SetBookTitle(*v)
+		_u.SetAuthorOverride(*v)
 	}
 	return _u
 }
 
-// SetAuthor sets the "author" field.
-func (_u *BookUpdateOne) SetAuthor(v string) *BookUpdateOne {
-	_u.mutation.SetAuthor(v)
+// ClearAuthorOverride clears the value of the "author_override" field.
+func (_u *BookUpdateOne) ClearAuthorOverride() *BookUpdateOne {
+	_u.mutation.ClearAuthorOverride()
+	return _u
+}
+
+// SetPublisherOverride sets the "publisher_override" field.
+func (_u *BookUpdateOne) SetPublisherOverride(v string) *BookUpdateOne {
+	_u.mutation.SetPublisherOverride(v)
+	return _u
+}
+
+// SetNillablePublisherOverride sets the "publisher_override" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillablePublisherOverride(v *string) *BookUpdateOne {
+	if v != nil {
+		_u.SetPublisherOverride(*v)
+	}
+	return _u
+}
+
+// ClearPublisherOverride clears the value of the "publisher_override" field.
+func (_u *BookUpdateOne) ClearPublisherOverride() *BookUpdateOne {
+	_u.mutation.ClearPublisherOverride()
+	return _u
+}
+
+// SetPublishedDateOverride sets the "published_date_override" field.
+func (_u *BookUpdateOne) SetPublishedDateOverride(v string) *BookUpdateOne {
+	_u.mutation.SetPublishedDateOverride(v)
+	return _u
+}
+
+// SetNillablePublishedDateOverride sets the "published_date_override" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillablePublishedDateOverride(v *string) *BookUpdateOne {
+	if v != nil {
+		_u.SetPublishedDateOverride(*v)
+	}
+	return _u
+}
+
+// ClearPublishedDateOverride clears the value of the "published_date_override" field.
+func (_u *BookUpdateOne) ClearPublishedDateOverride() *BookUpdateOne {
+	_u.mutation.ClearPublishedDateOverride()
+	return _u
+}
+
+// SetThumbnailURLOverride sets the "thumbnail_url_override" field.
+func (_u *BookUpdateOne) SetThumbnailURLOverride(v string) *BookUpdateOne {
+	_u.mutation.SetThumbnailURLOverride(v)
+	return _u
+}
+
+// SetNillableThumbnailURLOverride sets the "thumbnail_url_override" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableThumbnailURLOverride(v *string) *BookUpdateOne {
+	if v != nil {
+		_u.SetThumbnailURLOverride(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURLOverride clears the value of the "thumbnail_url_override" field.
+func (_u *BookUpdateOne) ClearThumbnailURLOverride() *BookUpdateOne {
+	_u.mutation.ClearThumbnailURLOverride()
+	return _u
+}
+
+// SetLocationRoom sets the "location_room" field.
+func (_u *BookUpdateOne) SetLocationRoom(v string) *BookUpdateOne {
+	_u.mutation.SetLocationRoom(v)
 	return _u
 }
 
-// SetNillableAuthor sets the "author" field if the given value is not nil.
-func (_u *BookUpdateOne) SetNillableAuthor(v *string) *BookUpdateOne {
+// SetNillableLocationRoom sets the "location_room" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableLocationRoom(v *string) *BookUpdateOne {
 	if v != nil {
-		_u.SetAuthor(*v)
+		_u.SetLocationRoom(*v)
 	}
 	return _u
 }
 
-// SetBookIsbn sets the "book_isbn" field.
-func (_u *BookUpdateOne) SetBookIsbn(v string) *BookUpdateOne {
-	_u.mutation.SetBookIsbn(v)
+// SetLocationBookcase sets the "location_bookcase" field.
+func (_u *BookUpdateOne) SetLocationBookcase(v string) *BookUpdateOne {
+	_u.mutation.SetLocationBookcase(v)
 	return _u
 }
 
-// SetNillableBookIsbn sets the "book_isbn" field if the given value is not nil.
-func (_u *BookUpdateOne) SetNillableBookIsbn(v *string) *BookUpdateOne {
+// SetNillableLocationBookcase sets the "location_bookcase" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableLocationBookcase(v *string) *BookUpdateOne {
 	if v != nil {
-		_u.SetBookIsbn(*v)
+		_u.SetLocationBookcase(*v)
 	}
 	return _u
 }
 
-// ClearBookIsbn clears the value of the "book_isbn" field.
-func (_u *BookUpdateOne) ClearBookIsbn() *BookUpdateOne {
-	_u.mutation.ClearBookIsbn()
+// SetLocationShelf sets the "location_shelf" field.
+func (_u *BookUpdateOne) SetLocationShelf(v string) *BookUpdateOne {
+	_u.mutation.SetLocationShelf(v)
+	return _u
+}
+
+// SetNillableLocationShelf sets the "location_shelf" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableLocationShelf(v *string) *BookUpdateOne {
+	if v != nil {
+		_u.SetLocationShelf(*v)
+	}
+	return _u
+}
+
+// SetCondition sets the "condition" field.
+func (_u *BookUpdateOne) SetCondition(v book.Condition) *BookUpdateOne {
+	_u.mutation.SetCondition(v)
+	return _u
+}
+
+// SetNillableCondition sets the "condition" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableCondition(v *book.Condition) *BookUpdateOne {
+	if v != nil {
+		_u.SetCondition(*v)
+	}
+	return _u
+}
+
+// ClearCondition clears the value of the "condition" field.
+func (_u *BookUpdateOne) ClearCondition() *BookUpdateOne {
+	_u.mutation.ClearCondition()
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *BookUpdateOne) SetFormat(v book.Format) *BookUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableFormat(v *book.Format) *BookUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// ClearFormat clears the value of the "format" field.
+func (_u *BookUpdateOne) ClearFormat() *BookUpdateOne {
+	_u.mutation.ClearFormat()
+	return _u
+}
+
+// SetPurchasedAt sets the "purchased_at" field.
+func (_u *BookUpdateOne) SetPurchasedAt(v time.Time) *BookUpdateOne {
+	_u.mutation.SetPurchasedAt(v)
+	return _u
+}
+
+// SetNillablePurchasedAt sets the "purchased_at" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillablePurchasedAt(v *time.Time) *BookUpdateOne {
+	if v != nil {
+		_u.SetPurchasedAt(*v)
+	}
+	return _u
+}
+
+// ClearPurchasedAt clears the value of the "purchased_at" field.
+func (_u *BookUpdateOne) ClearPurchasedAt() *BookUpdateOne {
+	_u.mutation.ClearPurchasedAt()
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *BookUpdateOne) SetPrice(v float64) *BookUpdateOne {
+	_u.mutation.ResetPrice()
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillablePrice(v *float64) *BookUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// AddPrice adds value to the "price" field.
+func (_u *BookUpdateOne) AddPrice(v float64) *BookUpdateOne {
+	_u.mutation.AddPrice(v)
+	return _u
+}
+
+// ClearPrice clears the value of the "price" field.
+func (_u *BookUpdateOne) ClearPrice() *BookUpdateOne {
+	_u.mutation.ClearPrice()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *BookUpdateOne) SetCurrency(v string) *BookUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableCurrency(v *string) *BookUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetAcquiredFrom sets the "acquired_from" field.
+func (_u *BookUpdateOne) SetAcquiredFrom(v string) *BookUpdateOne {
+	_u.mutation.SetAcquiredFrom(v)
+	return _u
+}
+
+// SetNillableAcquiredFrom sets the "acquired_from" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableAcquiredFrom(v *string) *BookUpdateOne {
+	if v != nil {
+		_u.SetAcquiredFrom(*v)
+	}
+	return _u
+}
+
+// SetLive sets the "live" field.
+func (_u *BookUpdateOne) SetLive(v bool) *BookUpdateOne {
+	_u.mutation.SetLive(v)
+	return _u
+}
+
+// SetNillableLive sets the "live" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableLive(v *bool) *BookUpdateOne {
+	if v != nil {
+		_u.SetLive(*v)
+	}
+	return _u
+}
+
+// ClearLive clears the value of the "live" field.
+func (_u *BookUpdateOne) ClearLive() *BookUpdateOne {
+	_u.mutation.ClearLive()
+	return _u
+}
+
+// SetVersion sets the "version" field.
+func (_u *BookUpdateOne) SetVersion(v int) *BookUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableVersion(v *int) *BookUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *BookUpdateOne) AddVersion(v int) *BookUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_u *BookUpdateOne) SetCreatedAt(v time.Time) *BookUpdateOne {
+	_u.mutation.SetCreatedAt(v)
+	return _u
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableCreatedAt(v *time.Time) *BookUpdateOne {
+	if v != nil {
+		_u.SetCreatedAt(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BookUpdateOne) SetUpdatedAt(v time.Time) *BookUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (_u *BookUpdateOne) SetThumbnailURL(v string) *BookUpdateOne {
-	_u.mutation.SetThumbnailURL(v)
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *BookUpdateOne) SetDeletedAt(v time.Time) *BookUpdateOne {
+	_u.mutation.SetDeletedAt(v)
 	return _u
 }
 
-// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
-func (_u *BookUpdateOne) SetNillableThumbnailURL(v *string) *BookUpdateOne {
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableDeletedAt(v *time.Time) *BookUpdateOne {
 	if v != nil {
-		_u.SetThumbnailURL(*v)
+		_u.SetDeletedAt(*v)
 	}
 	return _u
 }
 
-// ClearThumbnailURL clears the value of the "thumbnail_url" field.
-func (_u *BookUpdateOne) ClearThumbnailURL() *BookUpdateOne {
-	_u.mutation.ClearThumbnailURL()
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *BookUpdateOne) ClearDeletedAt() *BookUpdateOne {
+	_u.mutation.ClearDeletedAt()
 	return _u
 }
 
-// SetStatus sets the "status" field.
-func (_u *BookUpdateOne) SetStatus(v int) *BookUpdateOne {
-	_u.mutation.ResetStatus()
-	_u.mutation.SetStatus(v)
+// SetLibraryID sets the "library_id" field.
+func (_u *BookUpdateOne) SetLibraryID(v uuid.UUID) *BookUpdateOne {
+	_u.mutation.SetLibraryID(v)
 	return _u
 }
 
-// SetNillableStatus sets the "status" field if the given value is not nil.
-func (_u *BookUpdateOne) SetNillableStatus(v *int) *BookUpdateOne {
+// SetNillableLibraryID sets the "library_id" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableLibraryID(v *uuid.UUID) *BookUpdateOne {
 	if v != nil {
-		_u.SetStatus(*v)
+		_u.SetLibraryID(*v)
 	}
 	return _u
 }
 
-// AddStatus adds value to the "status" field.
-func (_u *BookUpdateOne) AddStatus(v int) *BookUpdateOne {
-	_u.mutation.AddStatus(v)
+// ClearLibraryID clears the value of the "library_id" field.
+func (_u *BookUpdateOne) ClearLibraryID() *BookUpdateOne {
+	_u.mutation.ClearLibraryID()
 	return _u
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (_u *BookUpdateOne) SetCreatedAt(v time.Time) *BookUpdateOne {
-	_u.mutation.SetCreatedAt(v)
+// SetVisibility sets the "visibility" field.
+func (_u *BookUpdateOne) SetVisibility(v book.Visibility) *BookUpdateOne {
+	_u.mutation.SetVisibility(v)
 	return _u
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (_u *BookUpdateOne) SetNillableCreatedAt(v *time.Time) *BookUpdateOne {
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableVisibility(v *book.Visibility) *BookUpdateOne {
 	if v != nil {
-		_u.SetCreatedAt(*v)
+		_u.SetVisibility(*v)
 	}
 	return _u
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *BookUpdateOne) SetUpdatedAt(v time.Time) *BookUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *BookUpdateOne) SetOwnerID(id uuid.UUID) *BookUpdateOne {
 	_u.mutation.SetOwnerID(id)
@@ -590,6 +2116,30 @@ func (_u *BookUpdateOne) SetOwner(v *User) *BookUpdateOne {
 	return _u.SetOwnerID(v.ID)
 }
 
+// SetCatalogID sets the "catalog" edge to the BookCatalog entity by ID.
+func (_u *BookUpdateOne) SetCatalogID(id uuid.UUID) *BookUpdateOne {
+	_u.mutation.SetCatalogID(id)
+	return _u
+}
+
+// SetNillableCatalogID sets the "catalog" edge to the BookCatalog entity by ID if the given value is not nil.
+func (_u *BookUpdateOne) SetNillableCatalogID(id *uuid.UUID) *BookUpdateOne {
+	if id != nil {
+		_u = _u.SetCatalogID(*id)
+	}
+	return _u
+}
+
+// SetCatalog sets the "catalog" edge to the BookCatalog entity.
+func (_u *BookUpdateOne) SetCatalog(v *BookCatalog) *BookUpdateOne {
+	return _u.SetCatalogID(v.ID)
+}
+
+// SetLibrary sets the "library" edge to the Library entity.
+func (_u *BookUpdateOne) SetLibrary(v *Library) *BookUpdateOne {
+	return _u.SetLibraryID(v.ID)
+}
+
 // AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
 func (_u *BookUpdateOne) AddReviewIDs(ids ...uuid.UUID) *BookUpdateOne {
 	_u.mutation.AddReviewIDs(ids...)
@@ -620,6 +2170,111 @@ func (_u *BookUpdateOne) AddBookmarks(v ...*Bookmark) *BookUpdateOne {
 	return _u.AddBookmarkIDs(ids...)
 }
 
+// AddReadingSessionIDs adds the "reading_sessions" edge to the ReadingSession entity by IDs.
+func (_u *BookUpdateOne) AddReadingSessionIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.AddReadingSessionIDs(ids...)
+	return _u
+}
+
+// AddReadingSessions adds the "reading_sessions" edges to the ReadingSession entity.
+func (_u *BookUpdateOne) AddReadingSessions(v ...*ReadingSession) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadingSessionIDs(ids...)
+}
+
+// AddStatusHistoryIDs adds the "status_histories" edge to the BookStatusHistory entity by IDs.
+func (_u *BookUpdateOne) AddStatusHistoryIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.AddStatusHistoryIDs(ids...)
+	return _u
+}
+
+// AddStatusHistories adds the "status_histories" edges to the BookStatusHistory entity.
+func (_u *BookUpdateOne) AddStatusHistories(v ...*BookStatusHistory) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStatusHistoryIDs(ids...)
+}
+
+// AddShelfIDs adds the "shelves" edge to the Shelf entity by IDs.
+func (_u *BookUpdateOne) AddShelfIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.AddShelfIDs(ids...)
+	return _u
+}
+
+// AddShelves adds the "shelves" edges to the Shelf entity.
+func (_u *BookUpdateOne) AddShelves(v ...*Shelf) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShelfIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *BookUpdateOne) AddTagIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *BookUpdateOne) AddTags(v ...*Tag) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddNoteIDs adds the "notes" edge to the BookNote entity by IDs.
+func (_u *BookUpdateOne) AddNoteIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.AddNoteIDs(ids...)
+	return _u
+}
+
+// AddNotes adds the "notes" edges to the BookNote entity.
+func (_u *BookUpdateOne) AddNotes(v ...*BookNote) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddNoteIDs(ids...)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (_u *BookUpdateOne) AddLoanIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.AddLoanIDs(ids...)
+	return _u
+}
+
+// AddLoans adds the "loans" edges to the Loan entity.
+func (_u *BookUpdateOne) AddLoans(v ...*Loan) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLoanIDs(ids...)
+}
+
+// AddBorrowRequestIDs adds the "borrow_requests" edge to the BorrowRequest entity by IDs.
+func (_u *BookUpdateOne) AddBorrowRequestIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.AddBorrowRequestIDs(ids...)
+	return _u
+}
+
+// AddBorrowRequests adds the "borrow_requests" edges to the BorrowRequest entity.
+func (_u *BookUpdateOne) AddBorrowRequests(v ...*BorrowRequest) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBorrowRequestIDs(ids...)
+}
+
 // Mutation returns the BookMutation object of the builder.
 func (_u *BookUpdateOne) Mutation() *BookMutation {
 	return _u.mutation
@@ -631,6 +2286,18 @@ func (_u *BookUpdateOne) ClearOwner() *BookUpdateOne {
 	return _u
 }
 
+// ClearCatalog clears the "catalog" edge to the BookCatalog entity.
+func (_u *BookUpdateOne) ClearCatalog() *BookUpdateOne {
+	_u.mutation.ClearCatalog()
+	return _u
+}
+
+// ClearLibrary clears the "library" edge to the Library entity.
+func (_u *BookUpdateOne) ClearLibrary() *BookUpdateOne {
+	_u.mutation.ClearLibrary()
+	return _u
+}
+
 // ClearReviews clears all "reviews" edges to the Review entity.
 func (_u *BookUpdateOne) ClearReviews() *BookUpdateOne {
 	_u.mutation.ClearReviews()
@@ -673,6 +2340,153 @@ func (_u *BookUpdateOne) RemoveBookmarks(v ...*Bookmark) *BookUpdateOne {
 	return _u.RemoveBookmarkIDs(ids...)
 }
 
+// ClearReadingSessions clears all "reading_sessions" edges to the ReadingSession entity.
+func (_u *BookUpdateOne) ClearReadingSessions() *BookUpdateOne {
+	_u.mutation.ClearReadingSessions()
+	return _u
+}
+
+// RemoveReadingSessionIDs removes the "reading_sessions" edge to ReadingSession entities by IDs.
+func (_u *BookUpdateOne) RemoveReadingSessionIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.RemoveReadingSessionIDs(ids...)
+	return _u
+}
+
+// RemoveReadingSessions removes "reading_sessions" edges to ReadingSession entities.
+func (_u *BookUpdateOne) RemoveReadingSessions(v ...*ReadingSession) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReadingSessionIDs(ids...)
+}
+
+// ClearStatusHistories clears all "status_histories" edges to the BookStatusHistory entity.
+func (_u *BookUpdateOne) ClearStatusHistories() *BookUpdateOne {
+	_u.mutation.ClearStatusHistories()
+	return _u
+}
+
+// RemoveStatusHistoryIDs removes the "status_histories" edge to BookStatusHistory entities by IDs.
+func (_u *BookUpdateOne) RemoveStatusHistoryIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.RemoveStatusHistoryIDs(ids...)
+	return _u
+}
+
+// RemoveStatusHistories removes "status_histories" edges to BookStatusHistory entities.
+func (_u *BookUpdateOne) RemoveStatusHistories(v ...*BookStatusHistory) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStatusHistoryIDs(ids...)
+}
+
+// ClearShelves clears all "shelves" edges to the Shelf entity.
+func (_u *BookUpdateOne) ClearShelves() *BookUpdateOne {
+	_u.mutation.ClearShelves()
+	return _u
+}
+
+// RemoveShelfIDs removes the "shelves" edge to Shelf entities by IDs.
+func (_u *BookUpdateOne) RemoveShelfIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.RemoveShelfIDs(ids...)
+	return _u
+}
+
+// RemoveShelves removes "shelves" edges to Shelf entities.
+func (_u *BookUpdateOne) RemoveShelves(v ...*Shelf) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShelfIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *BookUpdateOne) ClearTags() *BookUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *BookUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *BookUpdateOne) RemoveTags(v ...*Tag) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearNotes clears all "notes" edges to the BookNote entity.
+func (_u *BookUpdateOne) ClearNotes() *BookUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// RemoveNoteIDs removes the "notes" edge to BookNote entities by IDs.
+func (_u *BookUpdateOne) RemoveNoteIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.RemoveNoteIDs(ids...)
+	return _u
+}
+
+// RemoveNotes removes "notes" edges to BookNote entities.
+func (_u *BookUpdateOne) RemoveNotes(v ...*BookNote) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveNoteIDs(ids...)
+}
+
+// ClearLoans clears all "loans" edges to the Loan entity.
+func (_u *BookUpdateOne) ClearLoans() *BookUpdateOne {
+	_u.mutation.ClearLoans()
+	return _u
+}
+
+// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
+func (_u *BookUpdateOne) RemoveLoanIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.RemoveLoanIDs(ids...)
+	return _u
+}
+
+// RemoveLoans removes "loans" edges to Loan entities.
+func (_u *BookUpdateOne) RemoveLoans(v ...*Loan) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLoanIDs(ids...)
+}
+
+// ClearBorrowRequests clears all "borrow_requests" edges to the BorrowRequest entity.
+func (_u *BookUpdateOne) ClearBorrowRequests() *BookUpdateOne {
+	_u.mutation.ClearBorrowRequests()
+	return _u
+}
+
+// RemoveBorrowRequestIDs removes the "borrow_requests" edge to BorrowRequest entities by IDs.
+func (_u *BookUpdateOne) RemoveBorrowRequestIDs(ids ...uuid.UUID) *BookUpdateOne {
+	_u.mutation.RemoveBorrowRequestIDs(ids...)
+	return _u
+}
+
+// RemoveBorrowRequests removes "borrow_requests" edges to BorrowRequest entities.
+func (_u *BookUpdateOne) RemoveBorrowRequests(v ...*BorrowRequest) *BookUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBorrowRequestIDs(ids...)
+}
+
 // Where appends a list predicates to the BookUpdate builder.
 func (_u *BookUpdateOne) Where(ps ...predicate.Book) *BookUpdateOne {
 	_u.mutation.Where(ps...)
@@ -724,14 +2538,44 @@ func (_u *BookUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *BookUpdateOne) check() error {
-	if v, ok := _u.mutation.BookTitle(); ok {
-		if err := book.BookTitleValidator(v); err != nil {
-			return &ValidationError{Name: "book_title", err: fmt.Errorf(`ent: validator failed for field "Book.book_title": %w`, err)}
+	if v, ok := _u.mutation.ReadingStatus(); ok {
+		if err := book.ReadingStatusValidator(v); err != nil {
+			return &ValidationError{Name: "reading_status", err: fmt.Errorf(`ent: validator failed for field "Book.reading_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CurrentPage(); ok {
+		if err := book.CurrentPageValidator(v); err != nil {
+			return &ValidationError{Name: "current_page", err: fmt.Errorf(`ent: validator failed for field "Book.current_page": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalPages(); ok {
+		if err := book.TotalPagesValidator(v); err != nil {
+			return &ValidationError{Name: "total_pages", err: fmt.Errorf(`ent: validator failed for field "Book.total_pages": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Condition(); ok {
+		if err := book.ConditionValidator(v); err != nil {
+			return &ValidationError{Name: "condition", err: fmt.Errorf(`ent: validator failed for field "Book.condition": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := book.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Book.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Price(); ok {
+		if err := book.PriceValidator(v); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Book.price": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := book.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Book.version": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Author(); ok {
-		if err := book.AuthorValidator(v); err != nil {
-			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "Book.author": %w`, err)}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := book.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Book.visibility": %w`, err)}
 		}
 	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
@@ -769,58 +2613,212 @@ func (_u *BookUpdateOne) sqlSave(ctx context.Context) (_node *Book, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.BookTitle(); ok {
-		_spec.SetField(book.FieldBookTitle, field.TypeString, value)
+	if value, ok := _u.mutation.ReadingStatus(); ok {
+		_spec.SetField(book.FieldReadingStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StartedAt(); ok {
+		_spec.SetField(book.FieldStartedAt, field.TypeTime, value)
+	}
+	if _u.mutation.StartedAtCleared() {
+		_spec.ClearField(book.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FinishedAt(); ok {
+		_spec.SetField(book.FieldFinishedAt, field.TypeTime, value)
 	}
-	if value, ok := _u.mutation.Author(); ok {
-		_spec.SetField(book.FieldAuthor, field.TypeString, value)
+	if _u.mutation.FinishedAtCleared() {
+		_spec.ClearField(book.FieldFinishedAt, field.TypeTime)
 	}
-	if value, ok := _u.mutation.BookIsbn(); ok {
-		_spec.SetField(book.FieldBookIsbn, field.TypeString, value)
+	if value, ok := _u.mutation.CurrentPage(); ok {
+		_spec.SetField(book.FieldCurrentPage, field.TypeInt, value)
 	}
-	if _u.mutation.BookIsbnCleared() {
-		_spec.ClearField(book.FieldBookIsbn, field.TypeString)
+	if value, ok := _u.mutation.AddedCurrentPage(); ok {
+		_spec.AddField(book.FieldCurrentPage, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.ThumbnailURL(); ok {
-		_spec.SetField(book.FieldThumbnailURL, field.TypeString, value)
+	if value, ok := _u.mutation.TotalPages(); ok {
+		_spec.SetField(book.FieldTotalPages, field.TypeInt, value)
 	}
-	if _u.mutation.ThumbnailURLCleared() {
-		_spec.ClearField(book.FieldThumbnailURL, field.TypeString)
+	if value, ok := _u.mutation.AddedTotalPages(); ok {
+		_spec.AddField(book.FieldTotalPages, field.TypeInt, value)
 	}
-	if value, ok := _u.mutation.Status(); ok {
-		_spec.SetField(book.FieldStatus, field.TypeInt, value)
+	if value, ok := _u.mutation.TitleOverride(); ok {
+		_spec.SetField(book.FieldTitleOverride, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.AddedStatus(); ok {
-		_spec.AddField(book.FieldStatus, field.TypeInt, value)
+	if _u.mutation.TitleOverrideCleared() {
+		_spec.ClearField(book.FieldTitleOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.AuthorOverride(); ok {
+		_spec.SetField(book.FieldAuthorOverride, field.TypeString, value)
+	}
+	if _u.mutation.AuthorOverrideCleared() {
+		_spec.ClearField(book.FieldAuthorOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublisherOverride(); ok {
+		_spec.SetField(book.FieldPublisherOverride, field.TypeString, value)
+	}
+	if _u.mutation.PublisherOverrideCleared() {
+		_spec.ClearField(book.FieldPublisherOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedDateOverride(); ok {
+		_spec.SetField(book.FieldPublishedDateOverride, field.TypeString, value)
+	}
+	if _u.mutation.PublishedDateOverrideCleared() {
+		_spec.ClearField(book.FieldPublishedDateOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThumbnailURLOverride(); ok {
+		_spec.SetField(book.FieldThumbnailURLOverride, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLOverrideCleared() {
+		_spec.ClearField(book.FieldThumbnailURLOverride, field.TypeString)
+	}
+	if value, ok := _u.mutation.LocationRoom(); ok {
+		_spec.SetField(book.FieldLocationRoom, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LocationBookcase(); ok {
+		_spec.SetField(book.FieldLocationBookcase, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.LocationShelf(); ok {
+		_spec.SetField(book.FieldLocationShelf, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Condition(); ok {
+		_spec.SetField(book.FieldCondition, field.TypeEnum, value)
+	}
+	if _u.mutation.ConditionCleared() {
+		_spec.ClearField(book.FieldCondition, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(book.FieldFormat, field.TypeEnum, value)
+	}
+	if _u.mutation.FormatCleared() {
+		_spec.ClearField(book.FieldFormat, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.PurchasedAt(); ok {
+		_spec.SetField(book.FieldPurchasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PurchasedAtCleared() {
+		_spec.ClearField(book.FieldPurchasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(book.FieldPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPrice(); ok {
+		_spec.AddField(book.FieldPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.PriceCleared() {
+		_spec.ClearField(book.FieldPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(book.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AcquiredFrom(); ok {
+		_spec.SetField(book.FieldAcquiredFrom, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Live(); ok {
+		_spec.SetField(book.FieldLive, field.TypeBool, value)
+	}
+	if _u.mutation.LiveCleared() {
+		_spec.ClearField(book.FieldLive, field.TypeBool)
+	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(book.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(book.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CreatedAt(); ok {
+		_spec.SetField(book.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(book.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(book.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(book.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(book.FieldVisibility, field.TypeEnum, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.OwnerTable,
+			Columns: []string{book.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.OwnerTable,
+			Columns: []string{book.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.CreatedAt(); ok {
-		_spec.SetField(book.FieldCreatedAt, field.TypeTime, value)
+	if _u.mutation.CatalogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.CatalogTable,
+			Columns: []string{book.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(book.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := _u.mutation.CatalogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   book.CatalogTable,
+			Columns: []string{book.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.OwnerCleared() {
+	if _u.mutation.LibraryCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   book.OwnerTable,
-			Columns: []string{book.OwnerColumn},
+			Table:   book.LibraryTable,
+			Columns: []string{book.LibraryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LibraryIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
 			Inverse: true,
-			Table:   book.OwnerTable,
-			Columns: []string{book.OwnerColumn},
+			Table:   book.LibraryTable,
+			Columns: []string{book.LibraryColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -918,6 +2916,333 @@ func (_u *BookUpdateOne) sqlSave(ctx context.Context) (_node *Book, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ReadingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.ReadingSessionsTable,
+			Columns: []string{book.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReadingSessionsIDs(); len(nodes) > 0 && !_u.mutation.ReadingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.ReadingSessionsTable,
+			Columns: []string{book.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReadingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.ReadingSessionsTable,
+			Columns: []string{book.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StatusHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.StatusHistoriesTable,
+			Columns: []string{book.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStatusHistoriesIDs(); len(nodes) > 0 && !_u.mutation.StatusHistoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.StatusHistoriesTable,
+			Columns: []string{book.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StatusHistoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.StatusHistoriesTable,
+			Columns: []string{book.StatusHistoriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShelvesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.ShelvesTable,
+			Columns: book.ShelvesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShelvesIDs(); len(nodes) > 0 && !_u.mutation.ShelvesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.ShelvesTable,
+			Columns: book.ShelvesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShelvesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.ShelvesTable,
+			Columns: book.ShelvesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.TagsTable,
+			Columns: book.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.TagsTable,
+			Columns: book.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   book.TagsTable,
+			Columns: book.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.NotesTable,
+			Columns: []string{book.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedNotesIDs(); len(nodes) > 0 && !_u.mutation.NotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.NotesTable,
+			Columns: []string{book.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.NotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.NotesTable,
+			Columns: []string{book.NotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.LoansTable,
+			Columns: []string{book.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLoansIDs(); len(nodes) > 0 && !_u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.LoansTable,
+			Columns: []string{book.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.LoansTable,
+			Columns: []string{book.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.BorrowRequestsTable,
+			Columns: []string{book.BorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBorrowRequestsIDs(); len(nodes) > 0 && !_u.mutation.BorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.BorrowRequestsTable,
+			Columns: []string{book.BorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BorrowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   book.BorrowRequestsTable,
+			Columns: []string{book.BorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Book{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,281 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
+	"github.com/google/uuid"
+)
+
+// BookCatalog is the model entity for the BookCatalog schema.
+type BookCatalog struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 정규화된 ISBN-13 (ISBN 없이 직접 입력한 책은 null)
+	Isbn *string `json:"isbn,omitempty"`
+	// 도서 제목
+	Title string `json:"title,omitempty"`
+	// 저자
+	Author string `json:"author,omitempty"`
+	// 출판사
+	Publisher string `json:"publisher,omitempty"`
+	// 출간일 (제공자 형식 그대로 저장)
+	PublishedDate string `json:"published_date,omitempty"`
+	// 표지 이미지 URL
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// 시리즈 이름 (시리즈가 아니면 빈 문자열)
+	SeriesName string `json:"series_name,omitempty"`
+	// 시리즈 내 권 번호
+	SeriesVolume *int `json:"series_volume,omitempty"`
+	// 시리즈 전체 권 수 (알 수 없으면 null)
+	SeriesTotalVolumes *int `json:"series_total_volumes,omitempty"`
+	// 도서 정보 출처 (manual 또는 도서 정보 제공자 이름)
+	Source string `json:"source,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookCatalogQuery when eager-loading is set.
+	Edges        BookCatalogEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BookCatalogEdges holds the relations/edges for other nodes in the graph.
+type BookCatalogEdges struct {
+	// Copies holds the value of the copies edge.
+	Copies []*Book `json:"copies,omitempty"`
+	// Reviews holds the value of the reviews edge.
+	Reviews []*Review `json:"reviews,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// CopiesOrErr returns the Copies value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookCatalogEdges) CopiesOrErr() ([]*Book, error) {
+	if e.loadedTypes[0] {
+		return e.Copies, nil
+	}
+	return nil, &NotLoadedError{edge: "copies"}
+}
+
+// ReviewsOrErr returns the Reviews value or an error if the edge
+// was not loaded in eager-loading.
+func (e BookCatalogEdges) ReviewsOrErr() ([]*Review, error) {
+	if e.loadedTypes[1] {
+		return e.Reviews, nil
+	}
+	return nil, &NotLoadedError{edge: "reviews"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BookCatalog) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bookcatalog.FieldSeriesVolume, bookcatalog.FieldSeriesTotalVolumes:
+			values[i] = new(sql.NullInt64)
+		case bookcatalog.FieldIsbn, bookcatalog.FieldTitle, bookcatalog.FieldAuthor, bookcatalog.FieldPublisher, bookcatalog.FieldPublishedDate, bookcatalog.FieldThumbnailURL, bookcatalog.FieldSeriesName, bookcatalog.FieldSource:
+			values[i] = new(sql.NullString)
+		case bookcatalog.FieldCreatedAt, bookcatalog.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case bookcatalog.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BookCatalog fields.
+func (_m *BookCatalog) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bookcatalog.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bookcatalog.FieldIsbn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field isbn", values[i])
+			} else if value.Valid {
+				_m.Isbn = new(string)
+				*_m.Isbn = value.String
+			}
+		case bookcatalog.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case bookcatalog.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				_m.Author = value.String
+			}
+		case bookcatalog.FieldPublisher:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publisher", values[i])
+			} else if value.Valid {
+				_m.Publisher = value.String
+			}
+		case bookcatalog.FieldPublishedDate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field published_date", values[i])
+			} else if value.Valid {
+				_m.PublishedDate = value.String
+			}
+		case bookcatalog.FieldThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+			} else if value.Valid {
+				_m.ThumbnailURL = value.String
+			}
+		case bookcatalog.FieldSeriesName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field series_name", values[i])
+			} else if value.Valid {
+				_m.SeriesName = value.String
+			}
+		case bookcatalog.FieldSeriesVolume:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field series_volume", values[i])
+			} else if value.Valid {
+				_m.SeriesVolume = new(int)
+				*_m.SeriesVolume = int(value.Int64)
+			}
+		case bookcatalog.FieldSeriesTotalVolumes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field series_total_volumes", values[i])
+			} else if value.Valid {
+				_m.SeriesTotalVolumes = new(int)
+				*_m.SeriesTotalVolumes = int(value.Int64)
+			}
+		case bookcatalog.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case bookcatalog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case bookcatalog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BookCatalog.
+// This includes values selected through modifiers, order, etc.
+func (_m *BookCatalog) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCopies queries the "copies" edge of the BookCatalog entity.
+func (_m *BookCatalog) QueryCopies() *BookQuery {
+	return NewBookCatalogClient(_m.config).QueryCopies(_m)
+}
+
+// QueryReviews queries the "reviews" edge of the BookCatalog entity.
+func (_m *BookCatalog) QueryReviews() *ReviewQuery {
+	return NewBookCatalogClient(_m.config).QueryReviews(_m)
+}
+
+// Update returns a builder for updating this BookCatalog.
+// Note that you need to call BookCatalog.Unwrap() before calling this method if this BookCatalog
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BookCatalog) Update() *BookCatalogUpdateOne {
+	return NewBookCatalogClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BookCatalog entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BookCatalog) Unwrap() *BookCatalog {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BookCatalog is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BookCatalog) String() string {
+	var builder strings.Builder
+	builder.WriteString("BookCatalog(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.Isbn; v != nil {
+		builder.WriteString("isbn=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(_m.Author)
+	builder.WriteString(", ")
+	builder.WriteString("publisher=")
+	builder.WriteString(_m.Publisher)
+	builder.WriteString(", ")
+	builder.WriteString("published_date=")
+	builder.WriteString(_m.PublishedDate)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_url=")
+	builder.WriteString(_m.ThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("series_name=")
+	builder.WriteString(_m.SeriesName)
+	builder.WriteString(", ")
+	if v := _m.SeriesVolume; v != nil {
+		builder.WriteString("series_volume=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SeriesTotalVolumes; v != nil {
+		builder.WriteString("series_total_volumes=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BookCatalogs is a parsable slice of BookCatalog.
+type BookCatalogs []*BookCatalog
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bookcatalog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the bookcatalog type in the database.
+	Label = "book_catalog"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldIsbn holds the string denoting the isbn field in the database.
+	FieldIsbn = "isbn"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldAuthor holds the string denoting the author field in the database.
+	FieldAuthor = "author"
+	// FieldPublisher holds the string denoting the publisher field in the database.
+	FieldPublisher = "publisher"
+	// FieldPublishedDate holds the string denoting the published_date field in the database.
+	FieldPublishedDate = "published_date"
+	// FieldThumbnailURL holds the string denoting the thumbnail_url field in the database.
+	FieldThumbnailURL = "thumbnail_url"
+	// FieldSeriesName holds the string denoting the series_name field in the database.
+	FieldSeriesName = "series_name"
+	// FieldSeriesVolume holds the string denoting the series_volume field in the database.
+	FieldSeriesVolume = "series_volume"
+	// FieldSeriesTotalVolumes holds the string denoting the series_total_volumes field in the database.
+	FieldSeriesTotalVolumes = "series_total_volumes"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeCopies holds the string denoting the copies edge name in mutations.
+	EdgeCopies = "copies"
+	// EdgeReviews holds the string denoting the reviews edge name in mutations.
+	EdgeReviews = "reviews"
+	// Table holds the table name of the bookcatalog in the database.
+	Table = "book_catalogs"
+	// CopiesTable is the table that holds the copies relation/edge.
+	CopiesTable = "books"
+	// CopiesInverseTable is the table name for the Book entity.
+	// It exists in this package in order to avoid circular dependency with the "book" package.
+	CopiesInverseTable = "books"
+	// CopiesColumn is the table column denoting the copies relation/edge.
+	CopiesColumn = "book_catalog_copies"
+	// ReviewsTable is the table that holds the reviews relation/edge.
+	ReviewsTable = "reviews"
+	// ReviewsInverseTable is the table name for the Review entity.
+	// It exists in this package in order to avoid circular dependency with the "review" package.
+	ReviewsInverseTable = "reviews"
+	// ReviewsColumn is the table column denoting the reviews relation/edge.
+	ReviewsColumn = "book_catalog_reviews"
+)
+
+// Columns holds all SQL columns for bookcatalog fields.
+var Columns = []string{
+	FieldID,
+	FieldIsbn,
+	FieldTitle,
+	FieldAuthor,
+	FieldPublisher,
+	FieldPublishedDate,
+	FieldThumbnailURL,
+	FieldSeriesName,
+	FieldSeriesVolume,
+	FieldSeriesTotalVolumes,
+	FieldSource,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	AuthorValidator func(string) error
+	// SeriesVolumeValidator is a validator for the "series_volume" field. It is called by the builders before save.
+	SeriesVolumeValidator func(int) error
+	// SeriesTotalVolumesValidator is a validator for the "series_total_volumes" field. It is called by the builders before save.
+	SeriesTotalVolumesValidator func(int) error
+	// DefaultSource holds the default value on creation for the "source" field.
+	DefaultSource string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the BookCatalog queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByIsbn orders the results by the isbn field.
+func ByIsbn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsbn, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByAuthor orders the results by the author field.
+func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
+}
+
+// ByPublisher orders the results by the publisher field.
+func ByPublisher(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublisher, opts...).ToFunc()
+}
+
+// ByPublishedDate orders the results by the published_date field.
+func ByPublishedDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishedDate, opts...).ToFunc()
+}
+
+// ByThumbnailURL orders the results by the thumbnail_url field.
+func ByThumbnailURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThumbnailURL, opts...).ToFunc()
+}
+
+// BySeriesName orders the results by the series_name field.
+func BySeriesName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesName, opts...).ToFunc()
+}
+
+// BySeriesVolume orders the results by the series_volume field.
+func BySeriesVolume(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesVolume, opts...).ToFunc()
+}
+
+// BySeriesTotalVolumes orders the results by the series_total_volumes field.
+func BySeriesTotalVolumes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesTotalVolumes, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCopiesCount orders the results by copies count.
+func ByCopiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCopiesStep(), opts...)
+	}
+}
+
+// ByCopies orders the results by copies terms.
+func ByCopies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCopiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReviewsCount orders the results by reviews count.
+func ByReviewsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReviewsStep(), opts...)
+	}
+}
+
+// ByReviews orders the results by reviews terms.
+func ByReviews(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReviewsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newCopiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CopiesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CopiesTable, CopiesColumn),
+	)
+}
+func newReviewsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReviewsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
+	)
+}
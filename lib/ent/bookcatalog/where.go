@@ -0,0 +1,928 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bookcatalog
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldID, id))
+}
+
+// Isbn applies equality check predicate on the "isbn" field. It's identical to IsbnEQ.
+func Isbn(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldIsbn, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldTitle, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldAuthor, v))
+}
+
+// Publisher applies equality check predicate on the "publisher" field. It's identical to PublisherEQ.
+func Publisher(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldPublisher, v))
+}
+
+// PublishedDate applies equality check predicate on the "published_date" field. It's identical to PublishedDateEQ.
+func PublishedDate(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldPublishedDate, v))
+}
+
+// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
+func ThumbnailURL(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// SeriesName applies equality check predicate on the "series_name" field. It's identical to SeriesNameEQ.
+func SeriesName(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldSeriesName, v))
+}
+
+// SeriesVolume applies equality check predicate on the "series_volume" field. It's identical to SeriesVolumeEQ.
+func SeriesVolume(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldSeriesVolume, v))
+}
+
+// SeriesTotalVolumes applies equality check predicate on the "series_total_volumes" field. It's identical to SeriesTotalVolumesEQ.
+func SeriesTotalVolumes(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldSeriesTotalVolumes, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldSource, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// IsbnEQ applies the EQ predicate on the "isbn" field.
+func IsbnEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldIsbn, v))
+}
+
+// IsbnNEQ applies the NEQ predicate on the "isbn" field.
+func IsbnNEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldIsbn, v))
+}
+
+// IsbnIn applies the In predicate on the "isbn" field.
+func IsbnIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldIsbn, vs...))
+}
+
+// IsbnNotIn applies the NotIn predicate on the "isbn" field.
+func IsbnNotIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldIsbn, vs...))
+}
+
+// IsbnGT applies the GT predicate on the "isbn" field.
+func IsbnGT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldIsbn, v))
+}
+
+// IsbnGTE applies the GTE predicate on the "isbn" field.
+func IsbnGTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldIsbn, v))
+}
+
+// IsbnLT applies the LT predicate on the "isbn" field.
+func IsbnLT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldIsbn, v))
+}
+
+// IsbnLTE applies the LTE predicate on the "isbn" field.
+func IsbnLTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldIsbn, v))
+}
+
+// IsbnContains applies the Contains predicate on the "isbn" field.
+func IsbnContains(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContains(FieldIsbn, v))
+}
+
+// IsbnHasPrefix applies the HasPrefix predicate on the "isbn" field.
+func IsbnHasPrefix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasPrefix(FieldIsbn, v))
+}
+
+// IsbnHasSuffix applies the HasSuffix predicate on the "isbn" field.
+func IsbnHasSuffix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasSuffix(FieldIsbn, v))
+}
+
+// IsbnIsNil applies the IsNil predicate on the "isbn" field.
+func IsbnIsNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIsNull(FieldIsbn))
+}
+
+// IsbnNotNil applies the NotNil predicate on the "isbn" field.
+func IsbnNotNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotNull(FieldIsbn))
+}
+
+// IsbnEqualFold applies the EqualFold predicate on the "isbn" field.
+func IsbnEqualFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEqualFold(FieldIsbn, v))
+}
+
+// IsbnContainsFold applies the ContainsFold predicate on the "isbn" field.
+func IsbnContainsFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContainsFold(FieldIsbn, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// PublisherEQ applies the EQ predicate on the "publisher" field.
+func PublisherEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldPublisher, v))
+}
+
+// PublisherNEQ applies the NEQ predicate on the "publisher" field.
+func PublisherNEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldPublisher, v))
+}
+
+// PublisherIn applies the In predicate on the "publisher" field.
+func PublisherIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldPublisher, vs...))
+}
+
+// PublisherNotIn applies the NotIn predicate on the "publisher" field.
+func PublisherNotIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldPublisher, vs...))
+}
+
+// PublisherGT applies the GT predicate on the "publisher" field.
+func PublisherGT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldPublisher, v))
+}
+
+// PublisherGTE applies the GTE predicate on the "publisher" field.
+func PublisherGTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldPublisher, v))
+}
+
+// PublisherLT applies the LT predicate on the "publisher" field.
+func PublisherLT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldPublisher, v))
+}
+
+// PublisherLTE applies the LTE predicate on the "publisher" field.
+func PublisherLTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldPublisher, v))
+}
+
+// PublisherContains applies the Contains predicate on the "publisher" field.
+func PublisherContains(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContains(FieldPublisher, v))
+}
+
+// PublisherHasPrefix applies the HasPrefix predicate on the "publisher" field.
+func PublisherHasPrefix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasPrefix(FieldPublisher, v))
+}
+
+// PublisherHasSuffix applies the HasSuffix predicate on the "publisher" field.
+func PublisherHasSuffix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasSuffix(FieldPublisher, v))
+}
+
+// PublisherIsNil applies the IsNil predicate on the "publisher" field.
+func PublisherIsNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIsNull(FieldPublisher))
+}
+
+// PublisherNotNil applies the NotNil predicate on the "publisher" field.
+func PublisherNotNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotNull(FieldPublisher))
+}
+
+// PublisherEqualFold applies the EqualFold predicate on the "publisher" field.
+func PublisherEqualFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEqualFold(FieldPublisher, v))
+}
+
+// PublisherContainsFold applies the ContainsFold predicate on the "publisher" field.
+func PublisherContainsFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContainsFold(FieldPublisher, v))
+}
+
+// PublishedDateEQ applies the EQ predicate on the "published_date" field.
+func PublishedDateEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldPublishedDate, v))
+}
+
+// PublishedDateNEQ applies the NEQ predicate on the "published_date" field.
+func PublishedDateNEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldPublishedDate, v))
+}
+
+// PublishedDateIn applies the In predicate on the "published_date" field.
+func PublishedDateIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldPublishedDate, vs...))
+}
+
+// PublishedDateNotIn applies the NotIn predicate on the "published_date" field.
+func PublishedDateNotIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldPublishedDate, vs...))
+}
+
+// PublishedDateGT applies the GT predicate on the "published_date" field.
+func PublishedDateGT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldPublishedDate, v))
+}
+
+// PublishedDateGTE applies the GTE predicate on the "published_date" field.
+func PublishedDateGTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldPublishedDate, v))
+}
+
+// PublishedDateLT applies the LT predicate on the "published_date" field.
+func PublishedDateLT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldPublishedDate, v))
+}
+
+// PublishedDateLTE applies the LTE predicate on the "published_date" field.
+func PublishedDateLTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldPublishedDate, v))
+}
+
+// PublishedDateContains applies the Contains predicate on the "published_date" field.
+func PublishedDateContains(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContains(FieldPublishedDate, v))
+}
+
+// PublishedDateHasPrefix applies the HasPrefix predicate on the "published_date" field.
+func PublishedDateHasPrefix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasPrefix(FieldPublishedDate, v))
+}
+
+// PublishedDateHasSuffix applies the HasSuffix predicate on the "published_date" field.
+func PublishedDateHasSuffix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasSuffix(FieldPublishedDate, v))
+}
+
+// PublishedDateIsNil applies the IsNil predicate on the "published_date" field.
+func PublishedDateIsNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIsNull(FieldPublishedDate))
+}
+
+// PublishedDateNotNil applies the NotNil predicate on the "published_date" field.
+func PublishedDateNotNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotNull(FieldPublishedDate))
+}
+
+// PublishedDateEqualFold applies the EqualFold predicate on the "published_date" field.
+func PublishedDateEqualFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEqualFold(FieldPublishedDate, v))
+}
+
+// PublishedDateContainsFold applies the ContainsFold predicate on the "published_date" field.
+func PublishedDateContainsFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContainsFold(FieldPublishedDate, v))
+}
+
+// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
+func ThumbnailURLEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
+func ThumbnailURLNEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
+func ThumbnailURLIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
+func ThumbnailURLNotIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
+func ThumbnailURLGT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
+func ThumbnailURLGTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
+func ThumbnailURLLT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
+func ThumbnailURLLTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
+func ThumbnailURLContains(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContains(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasPrefix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasPrefix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasSuffix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasSuffix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIsNil applies the IsNil predicate on the "thumbnail_url" field.
+func ThumbnailURLIsNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIsNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLNotNil applies the NotNil predicate on the "thumbnail_url" field.
+func ThumbnailURLNotNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
+func ThumbnailURLEqualFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEqualFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
+func ThumbnailURLContainsFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContainsFold(FieldThumbnailURL, v))
+}
+
+// SeriesNameEQ applies the EQ predicate on the "series_name" field.
+func SeriesNameEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldSeriesName, v))
+}
+
+// SeriesNameNEQ applies the NEQ predicate on the "series_name" field.
+func SeriesNameNEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldSeriesName, v))
+}
+
+// SeriesNameIn applies the In predicate on the "series_name" field.
+func SeriesNameIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldSeriesName, vs...))
+}
+
+// SeriesNameNotIn applies the NotIn predicate on the "series_name" field.
+func SeriesNameNotIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldSeriesName, vs...))
+}
+
+// SeriesNameGT applies the GT predicate on the "series_name" field.
+func SeriesNameGT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldSeriesName, v))
+}
+
+// SeriesNameGTE applies the GTE predicate on the "series_name" field.
+func SeriesNameGTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldSeriesName, v))
+}
+
+// SeriesNameLT applies the LT predicate on the "series_name" field.
+func SeriesNameLT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldSeriesName, v))
+}
+
+// SeriesNameLTE applies the LTE predicate on the "series_name" field.
+func SeriesNameLTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldSeriesName, v))
+}
+
+// SeriesNameContains applies the Contains predicate on the "series_name" field.
+func SeriesNameContains(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContains(FieldSeriesName, v))
+}
+
+// SeriesNameHasPrefix applies the HasPrefix predicate on the "series_name" field.
+func SeriesNameHasPrefix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasPrefix(FieldSeriesName, v))
+}
+
+// SeriesNameHasSuffix applies the HasSuffix predicate on the "series_name" field.
+func SeriesNameHasSuffix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasSuffix(FieldSeriesName, v))
+}
+
+// SeriesNameIsNil applies the IsNil predicate on the "series_name" field.
+func SeriesNameIsNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIsNull(FieldSeriesName))
+}
+
+// SeriesNameNotNil applies the NotNil predicate on the "series_name" field.
+func SeriesNameNotNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotNull(FieldSeriesName))
+}
+
+// SeriesNameEqualFold applies the EqualFold predicate on the "series_name" field.
+func SeriesNameEqualFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEqualFold(FieldSeriesName, v))
+}
+
+// SeriesNameContainsFold applies the ContainsFold predicate on the "series_name" field.
+func SeriesNameContainsFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContainsFold(FieldSeriesName, v))
+}
+
+// SeriesVolumeEQ applies the EQ predicate on the "series_volume" field.
+func SeriesVolumeEQ(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldSeriesVolume, v))
+}
+
+// SeriesVolumeNEQ applies the NEQ predicate on the "series_volume" field.
+func SeriesVolumeNEQ(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldSeriesVolume, v))
+}
+
+// SeriesVolumeIn applies the In predicate on the "series_volume" field.
+func SeriesVolumeIn(vs ...int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldSeriesVolume, vs...))
+}
+
+// SeriesVolumeNotIn applies the NotIn predicate on the "series_volume" field.
+func SeriesVolumeNotIn(vs ...int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldSeriesVolume, vs...))
+}
+
+// SeriesVolumeGT applies the GT predicate on the "series_volume" field.
+func SeriesVolumeGT(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldSeriesVolume, v))
+}
+
+// SeriesVolumeGTE applies the GTE predicate on the "series_volume" field.
+func SeriesVolumeGTE(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldSeriesVolume, v))
+}
+
+// SeriesVolumeLT applies the LT predicate on the "series_volume" field.
+func SeriesVolumeLT(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldSeriesVolume, v))
+}
+
+// SeriesVolumeLTE applies the LTE predicate on the "series_volume" field.
+func SeriesVolumeLTE(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldSeriesVolume, v))
+}
+
+// SeriesVolumeIsNil applies the IsNil predicate on the "series_volume" field.
+func SeriesVolumeIsNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIsNull(FieldSeriesVolume))
+}
+
+// SeriesVolumeNotNil applies the NotNil predicate on the "series_volume" field.
+func SeriesVolumeNotNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotNull(FieldSeriesVolume))
+}
+
+// SeriesTotalVolumesEQ applies the EQ predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesEQ(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldSeriesTotalVolumes, v))
+}
+
+// SeriesTotalVolumesNEQ applies the NEQ predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesNEQ(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldSeriesTotalVolumes, v))
+}
+
+// SeriesTotalVolumesIn applies the In predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesIn(vs ...int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldSeriesTotalVolumes, vs...))
+}
+
+// SeriesTotalVolumesNotIn applies the NotIn predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesNotIn(vs ...int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldSeriesTotalVolumes, vs...))
+}
+
+// SeriesTotalVolumesGT applies the GT predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesGT(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldSeriesTotalVolumes, v))
+}
+
+// SeriesTotalVolumesGTE applies the GTE predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesGTE(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldSeriesTotalVolumes, v))
+}
+
+// SeriesTotalVolumesLT applies the LT predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesLT(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldSeriesTotalVolumes, v))
+}
+
+// SeriesTotalVolumesLTE applies the LTE predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesLTE(v int) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldSeriesTotalVolumes, v))
+}
+
+// SeriesTotalVolumesIsNil applies the IsNil predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesIsNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIsNull(FieldSeriesTotalVolumes))
+}
+
+// SeriesTotalVolumesNotNil applies the NotNil predicate on the "series_total_volumes" field.
+func SeriesTotalVolumesNotNil() predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotNull(FieldSeriesTotalVolumes))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldContainsFold(FieldSource, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasCopies applies the HasEdge predicate on the "copies" edge.
+func HasCopies() predicate.BookCatalog {
+	return predicate.BookCatalog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CopiesTable, CopiesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCopiesWith applies the HasEdge predicate on the "copies" edge with a given conditions (other predicates).
+func HasCopiesWith(preds ...predicate.Book) predicate.BookCatalog {
+	return predicate.BookCatalog(func(s *sql.Selector) {
+		step := newCopiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReviews applies the HasEdge predicate on the "reviews" edge.
+func HasReviews() predicate.BookCatalog {
+	return predicate.BookCatalog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReviewsTable, ReviewsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReviewsWith applies the HasEdge predicate on the "reviews" edge with a given conditions (other predicates).
+func HasReviewsWith(preds ...predicate.Review) predicate.BookCatalog {
+	return predicate.BookCatalog(func(s *sql.Selector) {
+		step := newReviewsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BookCatalog) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BookCatalog) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BookCatalog) predicate.BookCatalog {
+	return predicate.BookCatalog(sql.NotPredicates(p))
+}
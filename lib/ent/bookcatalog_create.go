@@ -0,0 +1,512 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/google/uuid"
+)
+
+// BookCatalogCreate is the builder for creating a BookCatalog entity.
+type BookCatalogCreate struct {
+	config
+	mutation *BookCatalogMutation
+	hooks    []Hook
+}
+
+// SetIsbn sets the "isbn" field.
+func (_c *BookCatalogCreate) SetIsbn(v string) *BookCatalogCreate {
+	_c.mutation.SetIsbn(v)
+	return _c
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillableIsbn(v *string) *BookCatalogCreate {
+	if v != nil {
+		_c.SetIsbn(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *BookCatalogCreate) SetTitle(v string) *BookCatalogCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *BookCatalogCreate) SetAuthor(v string) *BookCatalogCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetPublisher sets the "publisher" field.
+func (_c *BookCatalogCreate) SetPublisher(v string) *BookCatalogCreate {
+	_c.mutation.SetPublisher(v)
+	return _c
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillablePublisher(v *string) *BookCatalogCreate {
+	if v != nil {
+		_c.SetPublisher(*v)
+	}
+	return _c
+}
+
+// SetPublishedDate sets the "published_date" field.
+func (_c *BookCatalogCreate) SetPublishedDate(v string) *BookCatalogCreate {
+	_c.mutation.SetPublishedDate(v)
+	return _c
+}
+
+// SetNillablePublishedDate sets the "published_date" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillablePublishedDate(v *string) *BookCatalogCreate {
+	if v != nil {
+		_c.SetPublishedDate(*v)
+	}
+	return _c
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_c *BookCatalogCreate) SetThumbnailURL(v string) *BookCatalogCreate {
+	_c.mutation.SetThumbnailURL(v)
+	return _c
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillableThumbnailURL(v *string) *BookCatalogCreate {
+	if v != nil {
+		_c.SetThumbnailURL(*v)
+	}
+	return _c
+}
+
+// SetSeriesName sets the "series_name" field.
+func (_c *BookCatalogCreate) SetSeriesName(v string) *BookCatalogCreate {
+	_c.mutation.SetSeriesName(v)
+	return _c
+}
+
+// SetNillableSeriesName sets the "series_name" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillableSeriesName(v *string) *BookCatalogCreate {
+	if v != nil {
+		_c.SetSeriesName(*v)
+	}
+	return _c
+}
+
+// SetSeriesVolume sets the "series_volume" field.
+func (_c *BookCatalogCreate) SetSeriesVolume(v int) *BookCatalogCreate {
+	_c.mutation.SetSeriesVolume(v)
+	return _c
+}
+
+// SetNillableSeriesVolume sets the "series_volume" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillableSeriesVolume(v *int) *BookCatalogCreate {
+	if v != nil {
+		_c.SetSeriesVolume(*v)
+	}
+	return _c
+}
+
+// SetSeriesTotalVolumes sets the "series_total_volumes" field.
+func (_c *BookCatalogCreate) SetSeriesTotalVolumes(v int) *BookCatalogCreate {
+	_c.mutation.SetSeriesTotalVolumes(v)
+	return _c
+}
+
+// SetNillableSeriesTotalVolumes sets the "series_total_volumes" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillableSeriesTotalVolumes(v *int) *BookCatalogCreate {
+	if v != nil {
+		_c.SetSeriesTotalVolumes(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BookCatalogCreate) SetSource(v string) *BookCatalogCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillableSource(v *string) *BookCatalogCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BookCatalogCreate) SetCreatedAt(v time.Time) *BookCatalogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillableCreatedAt(v *time.Time) *BookCatalogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BookCatalogCreate) SetUpdatedAt(v time.Time) *BookCatalogCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillableUpdatedAt(v *time.Time) *BookCatalogCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BookCatalogCreate) SetID(v uuid.UUID) *BookCatalogCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BookCatalogCreate) SetNillableID(v *uuid.UUID) *BookCatalogCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddCopyIDs adds the "copies" edge to the Book entity by IDs.
+func (_c *BookCatalogCreate) AddCopyIDs(ids ...uuid.UUID) *BookCatalogCreate {
+	_c.mutation.AddCopyIDs(ids...)
+	return _c
+}
+
+// AddCopies adds the "copies" edges to the Book entity.
+func (_c *BookCatalogCreate) AddCopies(v ...*Book) *BookCatalogCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCopyIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (_c *BookCatalogCreate) AddReviewIDs(ids ...uuid.UUID) *BookCatalogCreate {
+	_c.mutation.AddReviewIDs(ids...)
+	return _c
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (_c *BookCatalogCreate) AddReviews(v ...*Review) *BookCatalogCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReviewIDs(ids...)
+}
+
+// Mutation returns the BookCatalogMutation object of the builder.
+func (_c *BookCatalogCreate) Mutation() *BookCatalogMutation {
+	return _c.mutation
+}
+
+// Save creates the BookCatalog in the database.
+func (_c *BookCatalogCreate) Save(ctx context.Context) (*BookCatalog, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BookCatalogCreate) SaveX(ctx context.Context) *BookCatalog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookCatalogCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookCatalogCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BookCatalogCreate) defaults() {
+	if _, ok := _c.mutation.Source(); !ok {
+		v := bookcatalog.DefaultSource
+		_c.mutation.SetSource(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := bookcatalog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := bookcatalog.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := bookcatalog.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BookCatalogCreate) check() error {
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "BookCatalog.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := bookcatalog.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "BookCatalog.author"`)}
+	}
+	if v, ok := _c.mutation.Author(); ok {
+		if err := bookcatalog.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.author": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SeriesVolume(); ok {
+		if err := bookcatalog.SeriesVolumeValidator(v); err != nil {
+			return &ValidationError{Name: "series_volume", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.series_volume": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.SeriesTotalVolumes(); ok {
+		if err := bookcatalog.SeriesTotalVolumesValidator(v); err != nil {
+			return &ValidationError{Name: "series_total_volumes", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.series_total_volumes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "BookCatalog.source"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BookCatalog.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BookCatalog.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *BookCatalogCreate) sqlSave(ctx context.Context) (*BookCatalog, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BookCatalogCreate) createSpec() (*BookCatalog, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BookCatalog{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bookcatalog.Table, sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Isbn(); ok {
+		_spec.SetField(bookcatalog.FieldIsbn, field.TypeString, value)
+		_node.Isbn = &value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(bookcatalog.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(bookcatalog.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := _c.mutation.Publisher(); ok {
+		_spec.SetField(bookcatalog.FieldPublisher, field.TypeString, value)
+		_node.Publisher = value
+	}
+	if value, ok := _c.mutation.PublishedDate(); ok {
+		_spec.SetField(bookcatalog.FieldPublishedDate, field.TypeString, value)
+		_node.PublishedDate = value
+	}
+	if value, ok := _c.mutation.ThumbnailURL(); ok {
+		_spec.SetField(bookcatalog.FieldThumbnailURL, field.TypeString, value)
+		_node.ThumbnailURL = value
+	}
+	if value, ok := _c.mutation.SeriesName(); ok {
+		_spec.SetField(bookcatalog.FieldSeriesName, field.TypeString, value)
+		_node.SeriesName = value
+	}
+	if value, ok := _c.mutation.SeriesVolume(); ok {
+		_spec.SetField(bookcatalog.FieldSeriesVolume, field.TypeInt, value)
+		_node.SeriesVolume = &value
+	}
+	if value, ok := _c.mutation.SeriesTotalVolumes(); ok {
+		_spec.SetField(bookcatalog.FieldSeriesTotalVolumes, field.TypeInt, value)
+		_node.SeriesTotalVolumes = &value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(bookcatalog.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(bookcatalog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(bookcatalog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.CopiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.CopiesTable,
+			Columns: []string{bookcatalog.CopiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.ReviewsTable,
+			Columns: []string{bookcatalog.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BookCatalogCreateBulk is the builder for creating many BookCatalog entities in bulk.
+type BookCatalogCreateBulk struct {
+	config
+	err      error
+	builders []*BookCatalogCreate
+}
+
+// Save creates the BookCatalog entities in the database.
+func (_c *BookCatalogCreateBulk) Save(ctx context.Context) ([]*BookCatalog, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BookCatalog, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BookCatalogMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BookCatalogCreateBulk) SaveX(ctx context.Context) []*BookCatalog {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookCatalogCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookCatalogCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
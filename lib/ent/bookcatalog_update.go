@@ -0,0 +1,1101 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/google/uuid"
+)
+
+// BookCatalogUpdate is the builder for updating BookCatalog entities.
+type BookCatalogUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BookCatalogMutation
+}
+
+// Where appends a list predicates to the BookCatalogUpdate builder.
+func (_u *BookCatalogUpdate) Where(ps ...predicate.BookCatalog) *BookCatalogUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetIsbn sets the "isbn" field.
+func (_u *BookCatalogUpdate) SetIsbn(v string) *BookCatalogUpdate {
+	_u.mutation.SetIsbn(v)
+	return _u
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillableIsbn(v *string) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetIsbn(*v)
+	}
+	return _u
+}
+
+// ClearIsbn clears the value of the "isbn" field.
+func (_u *BookCatalogUpdate) ClearIsbn() *BookCatalogUpdate {
+	_u.mutation.ClearIsbn()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *BookCatalogUpdate) SetTitle(v string) *BookCatalogUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillableTitle(v *string) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *BookCatalogUpdate) SetAuthor(v string) *BookCatalogUpdate {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillableAuthor(v *string) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// SetPublisher sets the "publisher" field.
+func (_u *BookCatalogUpdate) SetPublisher(v string) *BookCatalogUpdate {
+	_u.mutation.SetPublisher(v)
+	return _u
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillablePublisher(v *string) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetPublisher(*v)
+	}
+	return _u
+}
+
+// ClearPublisher clears the value of the "publisher" field.
+func (_u *BookCatalogUpdate) ClearPublisher() *BookCatalogUpdate {
+	_u.mutation.ClearPublisher()
+	return _u
+}
+
+// SetPublishedDate sets the "published_date" field.
+func (_u *BookCatalogUpdate) SetPublishedDate(v string) *BookCatalogUpdate {
+	_u.mutation.SetPublishedDate(v)
+	return _u
+}
+
+// SetNillablePublishedDate sets the "published_date" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillablePublishedDate(v *string) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetPublishedDate(*v)
+	}
+	return _u
+}
+
+// ClearPublishedDate clears the value of the "published_date" field.
+func (_u *BookCatalogUpdate) ClearPublishedDate() *BookCatalogUpdate {
+	_u.mutation.ClearPublishedDate()
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *BookCatalogUpdate) SetThumbnailURL(v string) *BookCatalogUpdate {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillableThumbnailURL(v *string) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *BookCatalogUpdate) ClearThumbnailURL() *BookCatalogUpdate {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetSeriesName sets the "series_name" field.
+func (_u *BookCatalogUpdate) SetSeriesName(v string) *BookCatalogUpdate {
+	_u.mutation.SetSeriesName(v)
+	return _u
+}
+
+// SetNillableSeriesName sets the "series_name" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillableSeriesName(v *string) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetSeriesName(*v)
+	}
+	return _u
+}
+
+// ClearSeriesName clears the value of the "series_name" field.
+func (_u *BookCatalogUpdate) ClearSeriesName() *BookCatalogUpdate {
+	_u.mutation.ClearSeriesName()
+	return _u
+}
+
+// SetSeriesVolume sets the "series_volume" field.
+func (_u *BookCatalogUpdate) SetSeriesVolume(v int) *BookCatalogUpdate {
+	_u.mutation.ResetSeriesVolume()
+	_u.mutation.SetSeriesVolume(v)
+	return _u
+}
+
+// SetNillableSeriesVolume sets the "series_volume" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillableSeriesVolume(v *int) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetSeriesVolume(*v)
+	}
+	return _u
+}
+
+// AddSeriesVolume adds value to the "series_volume" field.
+func (_u *BookCatalogUpdate) AddSeriesVolume(v int) *BookCatalogUpdate {
+	_u.mutation.AddSeriesVolume(v)
+	return _u
+}
+
+// ClearSeriesVolume clears the value of the "series_volume" field.
+func (_u *BookCatalogUpdate) ClearSeriesVolume() *BookCatalogUpdate {
+	_u.mutation.ClearSeriesVolume()
+	return _u
+}
+
+// SetSeriesTotalVolumes sets the "series_total_volumes" field.
+func (_u *BookCatalogUpdate) SetSeriesTotalVolumes(v int) *BookCatalogUpdate {
+	_u.mutation.ResetSeriesTotalVolumes()
+	_u.mutation.SetSeriesTotalVolumes(v)
+	return _u
+}
+
+// SetNillableSeriesTotalVolumes sets the "series_total_volumes" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillableSeriesTotalVolumes(v *int) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetSeriesTotalVolumes(*v)
+	}
+	return _u
+}
+
+// AddSeriesTotalVolumes adds value to the "series_total_volumes" field.
+func (_u *BookCatalogUpdate) AddSeriesTotalVolumes(v int) *BookCatalogUpdate {
+	_u.mutation.AddSeriesTotalVolumes(v)
+	return _u
+}
+
+// ClearSeriesTotalVolumes clears the value of the "series_total_volumes" field.
+func (_u *BookCatalogUpdate) ClearSeriesTotalVolumes() *BookCatalogUpdate {
+	_u.mutation.ClearSeriesTotalVolumes()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BookCatalogUpdate) SetSource(v string) *BookCatalogUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BookCatalogUpdate) SetNillableSource(v *string) *BookCatalogUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BookCatalogUpdate) SetUpdatedAt(v time.Time) *BookCatalogUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddCopyIDs adds the "copies" edge to the Book entity by IDs.
+func (_u *BookCatalogUpdate) AddCopyIDs(ids ...uuid.UUID) *BookCatalogUpdate {
+	_u.mutation.AddCopyIDs(ids...)
+	return _u
+}
+
+// AddCopies adds the "copies" edges to the Book entity.
+func (_u *BookCatalogUpdate) AddCopies(v ...*Book) *BookCatalogUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCopyIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (_u *BookCatalogUpdate) AddReviewIDs(ids ...uuid.UUID) *BookCatalogUpdate {
+	_u.mutation.AddReviewIDs(ids...)
+	return _u
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (_u *BookCatalogUpdate) AddReviews(v ...*Review) *BookCatalogUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewIDs(ids...)
+}
+
+// Mutation returns the BookCatalogMutation object of the builder.
+func (_u *BookCatalogUpdate) Mutation() *BookCatalogMutation {
+	return _u.mutation
+}
+
+// ClearCopies clears all "copies" edges to the Book entity.
+func (_u *BookCatalogUpdate) ClearCopies() *BookCatalogUpdate {
+	_u.mutation.ClearCopies()
+	return _u
+}
+
+// RemoveCopyIDs removes the "copies" edge to Book entities by IDs.
+func (_u *BookCatalogUpdate) RemoveCopyIDs(ids ...uuid.UUID) *BookCatalogUpdate {
+	_u.mutation.RemoveCopyIDs(ids...)
+	return _u
+}
+
+// RemoveCopies removes "copies" edges to Book entities.
+func (_u *BookCatalogUpdate) RemoveCopies(v ...*Book) *BookCatalogUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCopyIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (_u *BookCatalogUpdate) ClearReviews() *BookCatalogUpdate {
+	_u.mutation.ClearReviews()
+	return _u
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (_u *BookCatalogUpdate) RemoveReviewIDs(ids ...uuid.UUID) *BookCatalogUpdate {
+	_u.mutation.RemoveReviewIDs(ids...)
+	return _u
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (_u *BookCatalogUpdate) RemoveReviews(v ...*Review) *BookCatalogUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BookCatalogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookCatalogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BookCatalogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookCatalogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BookCatalogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bookcatalog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookCatalogUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := bookcatalog.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Author(); ok {
+		if err := bookcatalog.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.author": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SeriesVolume(); ok {
+		if err := bookcatalog.SeriesVolumeValidator(v); err != nil {
+			return &ValidationError{Name: "series_volume", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.series_volume": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SeriesTotalVolumes(); ok {
+		if err := bookcatalog.SeriesTotalVolumesValidator(v); err != nil {
+			return &ValidationError{Name: "series_total_volumes", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.series_total_volumes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BookCatalogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bookcatalog.Table, bookcatalog.Columns, sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Isbn(); ok {
+		_spec.SetField(bookcatalog.FieldIsbn, field.TypeString, value)
+	}
+	if _u.mutation.IsbnCleared() {
+		_spec.ClearField(bookcatalog.FieldIsbn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(bookcatalog.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(bookcatalog.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Publisher(); ok {
+		_spec.SetField(bookcatalog.FieldPublisher, field.TypeString, value)
+	}
+	if _u.mutation.PublisherCleared() {
+		_spec.ClearField(bookcatalog.FieldPublisher, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedDate(); ok {
+		_spec.SetField(bookcatalog.FieldPublishedDate, field.TypeString, value)
+	}
+	if _u.mutation.PublishedDateCleared() {
+		_spec.ClearField(bookcatalog.FieldPublishedDate, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(bookcatalog.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(bookcatalog.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeriesName(); ok {
+		_spec.SetField(bookcatalog.FieldSeriesName, field.TypeString, value)
+	}
+	if _u.mutation.SeriesNameCleared() {
+		_spec.ClearField(bookcatalog.FieldSeriesName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeriesVolume(); ok {
+		_spec.SetField(bookcatalog.FieldSeriesVolume, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeriesVolume(); ok {
+		_spec.AddField(bookcatalog.FieldSeriesVolume, field.TypeInt, value)
+	}
+	if _u.mutation.SeriesVolumeCleared() {
+		_spec.ClearField(bookcatalog.FieldSeriesVolume, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SeriesTotalVolumes(); ok {
+		_spec.SetField(bookcatalog.FieldSeriesTotalVolumes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeriesTotalVolumes(); ok {
+		_spec.AddField(bookcatalog.FieldSeriesTotalVolumes, field.TypeInt, value)
+	}
+	if _u.mutation.SeriesTotalVolumesCleared() {
+		_spec.ClearField(bookcatalog.FieldSeriesTotalVolumes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bookcatalog.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bookcatalog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CopiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.CopiesTable,
+			Columns: []string{bookcatalog.CopiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCopiesIDs(); len(nodes) > 0 && !_u.mutation.CopiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.CopiesTable,
+			Columns: []string{bookcatalog.CopiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CopiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.CopiesTable,
+			Columns: []string{bookcatalog.CopiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.ReviewsTable,
+			Columns: []string{bookcatalog.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !_u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.ReviewsTable,
+			Columns: []string{bookcatalog.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.ReviewsTable,
+			Columns: []string{bookcatalog.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookcatalog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BookCatalogUpdateOne is the builder for updating a single BookCatalog entity.
+type BookCatalogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BookCatalogMutation
+}
+
+// SetIsbn sets the "isbn" field.
+func (_u *BookCatalogUpdateOne) SetIsbn(v string) *BookCatalogUpdateOne {
+	_u.mutation.SetIsbn(v)
+	return _u
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillableIsbn(v *string) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetIsbn(*v)
+	}
+	return _u
+}
+
+// ClearIsbn clears the value of the "isbn" field.
+func (_u *BookCatalogUpdateOne) ClearIsbn() *BookCatalogUpdateOne {
+	_u.mutation.ClearIsbn()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *BookCatalogUpdateOne) SetTitle(v string) *BookCatalogUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillableTitle(v *string) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *BookCatalogUpdateOne) SetAuthor(v string) *BookCatalogUpdateOne {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillableAuthor(v *string) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// SetPublisher sets the "publisher" field.
+func (_u *BookCatalogUpdateOne) SetPublisher(v string) *BookCatalogUpdateOne {
+	_u.mutation.SetPublisher(v)
+	return _u
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillablePublisher(v *string) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetPublisher(*v)
+	}
+	return _u
+}
+
+// ClearPublisher clears the value of the "publisher" field.
+func (_u *BookCatalogUpdateOne) ClearPublisher() *BookCatalogUpdateOne {
+	_u.mutation.ClearPublisher()
+	return _u
+}
+
+// SetPublishedDate sets the "published_date" field.
+func (_u *BookCatalogUpdateOne) SetPublishedDate(v string) *BookCatalogUpdateOne {
+	_u.mutation.SetPublishedDate(v)
+	return _u
+}
+
+// SetNillablePublishedDate sets the "published_date" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillablePublishedDate(v *string) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetPublishedDate(*v)
+	}
+	return _u
+}
+
+// ClearPublishedDate clears the value of the "published_date" field.
+func (_u *BookCatalogUpdateOne) ClearPublishedDate() *BookCatalogUpdateOne {
+	_u.mutation.ClearPublishedDate()
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *BookCatalogUpdateOne) SetThumbnailURL(v string) *BookCatalogUpdateOne {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillableThumbnailURL(v *string) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *BookCatalogUpdateOne) ClearThumbnailURL() *BookCatalogUpdateOne {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetSeriesName sets the "series_name" field.
+func (_u *BookCatalogUpdateOne) SetSeriesName(v string) *BookCatalogUpdateOne {
+	_u.mutation.SetSeriesName(v)
+	return _u
+}
+
+// SetNillableSeriesName sets the "series_name" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillableSeriesName(v *string) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetSeriesName(*v)
+	}
+	return _u
+}
+
+// ClearSeriesName clears the value of the "series_name" field.
+func (_u *BookCatalogUpdateOne) ClearSeriesName() *BookCatalogUpdateOne {
+	_u.mutation.ClearSeriesName()
+	return _u
+}
+
+// SetSeriesVolume sets the "series_volume" field.
+func (_u *BookCatalogUpdateOne) SetSeriesVolume(v int) *BookCatalogUpdateOne {
+	_u.mutation.ResetSeriesVolume()
+	_u.mutation.SetSeriesVolume(v)
+	return _u
+}
+
+// SetNillableSeriesVolume sets the "series_volume" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillableSeriesVolume(v *int) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetSeriesVolume(*v)
+	}
+	return _u
+}
+
+// AddSeriesVolume adds value to the "series_volume" field.
+func (_u *BookCatalogUpdateOne) AddSeriesVolume(v int) *BookCatalogUpdateOne {
+	_u.mutation.AddSeriesVolume(v)
+	return _u
+}
+
+// ClearSeriesVolume clears the value of the "series_volume" field.
+func (_u *BookCatalogUpdateOne) ClearSeriesVolume() *BookCatalogUpdateOne {
+	_u.mutation.ClearSeriesVolume()
+	return _u
+}
+
+// SetSeriesTotalVolumes sets the "series_total_volumes" field.
+func (_u *BookCatalogUpdateOne) SetSeriesTotalVolumes(v int) *BookCatalogUpdateOne {
+	_u.mutation.ResetSeriesTotalVolumes()
+	_u.mutation.SetSeriesTotalVolumes(v)
+	return _u
+}
+
+// SetNillableSeriesTotalVolumes sets the "series_total_volumes" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillableSeriesTotalVolumes(v *int) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetSeriesTotalVolumes(*v)
+	}
+	return _u
+}
+
+// AddSeriesTotalVolumes adds value to the "series_total_volumes" field.
+func (_u *BookCatalogUpdateOne) AddSeriesTotalVolumes(v int) *BookCatalogUpdateOne {
+	_u.mutation.AddSeriesTotalVolumes(v)
+	return _u
+}
+
+// ClearSeriesTotalVolumes clears the value of the "series_total_volumes" field.
+func (_u *BookCatalogUpdateOne) ClearSeriesTotalVolumes() *BookCatalogUpdateOne {
+	_u.mutation.ClearSeriesTotalVolumes()
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BookCatalogUpdateOne) SetSource(v string) *BookCatalogUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BookCatalogUpdateOne) SetNillableSource(v *string) *BookCatalogUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BookCatalogUpdateOne) SetUpdatedAt(v time.Time) *BookCatalogUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddCopyIDs adds the "copies" edge to the Book entity by IDs.
+func (_u *BookCatalogUpdateOne) AddCopyIDs(ids ...uuid.UUID) *BookCatalogUpdateOne {
+	_u.mutation.AddCopyIDs(ids...)
+	return _u
+}
+
+// AddCopies adds the "copies" edges to the Book entity.
+func (_u *BookCatalogUpdateOne) AddCopies(v ...*Book) *BookCatalogUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCopyIDs(ids...)
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (_u *BookCatalogUpdateOne) AddReviewIDs(ids ...uuid.UUID) *BookCatalogUpdateOne {
+	_u.mutation.AddReviewIDs(ids...)
+	return _u
+}
+
+// AddReviews adds the "reviews" edges to the Review entity.
+func (_u *BookCatalogUpdateOne) AddReviews(v ...*Review) *BookCatalogUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewIDs(ids...)
+}
+
+// Mutation returns the BookCatalogMutation object of the builder.
+func (_u *BookCatalogUpdateOne) Mutation() *BookCatalogMutation {
+	return _u.mutation
+}
+
+// ClearCopies clears all "copies" edges to the Book entity.
+func (_u *BookCatalogUpdateOne) ClearCopies() *BookCatalogUpdateOne {
+	_u.mutation.ClearCopies()
+	return _u
+}
+
+// RemoveCopyIDs removes the "copies" edge to Book entities by IDs.
+func (_u *BookCatalogUpdateOne) RemoveCopyIDs(ids ...uuid.UUID) *BookCatalogUpdateOne {
+	_u.mutation.RemoveCopyIDs(ids...)
+	return _u
+}
+
+// RemoveCopies removes "copies" edges to Book entities.
+func (_u *BookCatalogUpdateOne) RemoveCopies(v ...*Book) *BookCatalogUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCopyIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (_u *BookCatalogUpdateOne) ClearReviews() *BookCatalogUpdateOne {
+	_u.mutation.ClearReviews()
+	return _u
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (_u *BookCatalogUpdateOne) RemoveReviewIDs(ids ...uuid.UUID) *BookCatalogUpdateOne {
+	_u.mutation.RemoveReviewIDs(ids...)
+	return _u
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (_u *BookCatalogUpdateOne) RemoveReviews(v ...*Review) *BookCatalogUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewIDs(ids...)
+}
+
+// Where appends a list predicates to the BookCatalogUpdate builder.
+func (_u *BookCatalogUpdateOne) Where(ps ...predicate.BookCatalog) *BookCatalogUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BookCatalogUpdateOne) Select(field string, fields ...string) *BookCatalogUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BookCatalog entity.
+func (_u *BookCatalogUpdateOne) Save(ctx context.Context) (*BookCatalog, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookCatalogUpdateOne) SaveX(ctx context.Context) *BookCatalog {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BookCatalogUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookCatalogUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BookCatalogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := bookcatalog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookCatalogUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := bookcatalog.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Author(); ok {
+		if err := bookcatalog.AuthorValidator(v); err != nil {
+			return &ValidationError{Name: "author", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.author": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SeriesVolume(); ok {
+		if err := bookcatalog.SeriesVolumeValidator(v); err != nil {
+			return &ValidationError{Name: "series_volume", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.series_volume": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SeriesTotalVolumes(); ok {
+		if err := bookcatalog.SeriesTotalVolumesValidator(v); err != nil {
+			return &ValidationError{Name: "series_total_volumes", err: fmt.Errorf(`ent: validator failed for field "BookCatalog.series_total_volumes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BookCatalogUpdateOne) sqlSave(ctx context.Context) (_node *BookCatalog, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bookcatalog.Table, bookcatalog.Columns, sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BookCatalog.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bookcatalog.FieldID)
+		for _, f := range fields {
+			if !bookcatalog.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bookcatalog.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Isbn(); ok {
+		_spec.SetField(bookcatalog.FieldIsbn, field.TypeString, value)
+	}
+	if _u.mutation.IsbnCleared() {
+		_spec.ClearField(bookcatalog.FieldIsbn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(bookcatalog.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(bookcatalog.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Publisher(); ok {
+		_spec.SetField(bookcatalog.FieldPublisher, field.TypeString, value)
+	}
+	if _u.mutation.PublisherCleared() {
+		_spec.ClearField(bookcatalog.FieldPublisher, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublishedDate(); ok {
+		_spec.SetField(bookcatalog.FieldPublishedDate, field.TypeString, value)
+	}
+	if _u.mutation.PublishedDateCleared() {
+		_spec.ClearField(bookcatalog.FieldPublishedDate, field.TypeString)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(bookcatalog.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(bookcatalog.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeriesName(); ok {
+		_spec.SetField(bookcatalog.FieldSeriesName, field.TypeString, value)
+	}
+	if _u.mutation.SeriesNameCleared() {
+		_spec.ClearField(bookcatalog.FieldSeriesName, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeriesVolume(); ok {
+		_spec.SetField(bookcatalog.FieldSeriesVolume, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeriesVolume(); ok {
+		_spec.AddField(bookcatalog.FieldSeriesVolume, field.TypeInt, value)
+	}
+	if _u.mutation.SeriesVolumeCleared() {
+		_spec.ClearField(bookcatalog.FieldSeriesVolume, field.TypeInt)
+	}
+	if value, ok := _u.mutation.SeriesTotalVolumes(); ok {
+		_spec.SetField(bookcatalog.FieldSeriesTotalVolumes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedSeriesTotalVolumes(); ok {
+		_spec.AddField(bookcatalog.FieldSeriesTotalVolumes, field.TypeInt, value)
+	}
+	if _u.mutation.SeriesTotalVolumesCleared() {
+		_spec.ClearField(bookcatalog.FieldSeriesTotalVolumes, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(bookcatalog.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(bookcatalog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CopiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.CopiesTable,
+			Columns: []string{bookcatalog.CopiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCopiesIDs(); len(nodes) > 0 && !_u.mutation.CopiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.CopiesTable,
+			Columns: []string{bookcatalog.CopiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CopiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.CopiesTable,
+			Columns: []string{bookcatalog.CopiesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.ReviewsTable,
+			Columns: []string{bookcatalog.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !_u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.ReviewsTable,
+			Columns: []string{bookcatalog.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   bookcatalog.ReviewsTable,
+			Columns: []string{bookcatalog.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BookCatalog{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookcatalog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// BookNote is the model entity for the BookNote schema.
+type BookNote struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 인용하거나 하이라이트한 문장
+	Quote string `json:"quote,omitempty"`
+	// 개인 메모
+	Comment string `json:"comment,omitempty"`
+	// 페이지 번호
+	Page *int `json:"page,omitempty"`
+	// 전자책 위치, 챕터 등 페이지 외의 위치
+	Location string `json:"location,omitempty"`
+	// 하이라이트 색상
+	Color string `json:"color,omitempty"`
+	// 사용자 지정 라벨
+	Label string `json:"label,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookNoteQuery when eager-loading is set.
+	Edges           BookNoteEdges `json:"edges"`
+	book_notes      *uuid.UUID
+	user_book_notes *uuid.UUID
+	selectValues    sql.SelectValues
+}
+
+// BookNoteEdges holds the relations/edges for other nodes in the graph.
+type BookNoteEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Book holds the value of the book edge.
+	Book *Book `json:"book,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookNoteEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// BookOrErr returns the Book value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookNoteEdges) BookOrErr() (*Book, error) {
+	if e.Book != nil {
+		return e.Book, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: book.Label}
+	}
+	return nil, &NotLoadedError{edge: "book"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BookNote) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case booknote.FieldPage:
+			values[i] = new(sql.NullInt64)
+		case booknote.FieldQuote, booknote.FieldComment, booknote.FieldLocation, booknote.FieldColor, booknote.FieldLabel:
+			values[i] = new(sql.NullString)
+		case booknote.FieldCreatedAt, booknote.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case booknote.FieldID:
+			values[i] = new(uuid.UUID)
+		case booknote.ForeignKeys[0]: // book_notes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case booknote.ForeignKeys[1]: // user_book_notes
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BookNote fields.
+func (_m *BookNote) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case booknote.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case booknote.FieldQuote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field quote", values[i])
+			} else if value.Valid {
+				_m.Quote = value.String
+			}
+		case booknote.FieldComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment", values[i])
+			} else if value.Valid {
+				_m.Comment = value.String
+			}
+		case booknote.FieldPage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field page", values[i])
+			} else if value.Valid {
+				_m.Page = new(int)
+				*_m.Page = int(value.Int64)
+			}
+		case booknote.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				_m.Location = value.String
+			}
+		case booknote.FieldColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field color", values[i])
+			} else if value.Valid {
+				_m.Color = value.String
+			}
+		case booknote.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = value.String
+			}
+		case booknote.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case booknote.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case booknote.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field book_notes", values[i])
+			} else if value.Valid {
+				_m.book_notes = new(uuid.UUID)
+				*_m.book_notes = *value.S.(*uuid.UUID)
+			}
+		case booknote.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_book_notes", values[i])
+			} else if value.Valid {
+				_m.user_book_notes = new(uuid.UUID)
+				*_m.user_book_notes = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BookNote.
+// This includes values selected through modifiers, order, etc.
+func (_m *BookNote) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the BookNote entity.
+func (_m *BookNote) QueryOwner() *UserQuery {
+	return NewBookNoteClient(_m.config).QueryOwner(_m)
+}
+
+// QueryBook queries the "book" edge of the BookNote entity.
+func (_m *BookNote) QueryBook() *BookQuery {
+	return NewBookNoteClient(_m.config).QueryBook(_m)
+}
+
+// Update returns a builder for updating this BookNote.
+// Note that you need to call BookNote.Unwrap() before calling this method if this BookNote
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BookNote) Update() *BookNoteUpdateOne {
+	return NewBookNoteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BookNote entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BookNote) Unwrap() *BookNote {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BookNote is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BookNote) String() string {
+	var builder strings.Builder
+	builder.WriteString("BookNote(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("quote=")
+	builder.WriteString(_m.Quote)
+	builder.WriteString(", ")
+	builder.WriteString("comment=")
+	builder.WriteString(_m.Comment)
+	builder.WriteString(", ")
+	if v := _m.Page; v != nil {
+		builder.WriteString("page=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(_m.Location)
+	builder.WriteString(", ")
+	builder.WriteString("color=")
+	builder.WriteString(_m.Color)
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(_m.Label)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BookNotes is a parsable slice of BookNote.
+type BookNotes []*BookNote
@@ -0,0 +1,658 @@
+// Code generated by ent, DO NOT EDIT.
+
+package booknote
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BookNote {
+	return predicate.BookNote(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLTE(FieldID, id))
+}
+
+// Quote applies equality check predicate on the "quote" field. It's identical to QuoteEQ.
+func Quote(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldQuote, v))
+}
+
+// Comment applies equality check predicate on the "comment" field. It's identical to CommentEQ.
+func Comment(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldComment, v))
+}
+
+// Page applies equality check predicate on the "page" field. It's identical to PageEQ.
+func Page(v int) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldPage, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldLocation, v))
+}
+
+// Color applies equality check predicate on the "color" field. It's identical to ColorEQ.
+func Color(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldColor, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// QuoteEQ applies the EQ predicate on the "quote" field.
+func QuoteEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldQuote, v))
+}
+
+// QuoteNEQ applies the NEQ predicate on the "quote" field.
+func QuoteNEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNEQ(FieldQuote, v))
+}
+
+// QuoteIn applies the In predicate on the "quote" field.
+func QuoteIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldIn(FieldQuote, vs...))
+}
+
+// QuoteNotIn applies the NotIn predicate on the "quote" field.
+func QuoteNotIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotIn(FieldQuote, vs...))
+}
+
+// QuoteGT applies the GT predicate on the "quote" field.
+func QuoteGT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGT(FieldQuote, v))
+}
+
+// QuoteGTE applies the GTE predicate on the "quote" field.
+func QuoteGTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGTE(FieldQuote, v))
+}
+
+// QuoteLT applies the LT predicate on the "quote" field.
+func QuoteLT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLT(FieldQuote, v))
+}
+
+// QuoteLTE applies the LTE predicate on the "quote" field.
+func QuoteLTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLTE(FieldQuote, v))
+}
+
+// QuoteContains applies the Contains predicate on the "quote" field.
+func QuoteContains(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContains(FieldQuote, v))
+}
+
+// QuoteHasPrefix applies the HasPrefix predicate on the "quote" field.
+func QuoteHasPrefix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasPrefix(FieldQuote, v))
+}
+
+// QuoteHasSuffix applies the HasSuffix predicate on the "quote" field.
+func QuoteHasSuffix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasSuffix(FieldQuote, v))
+}
+
+// QuoteIsNil applies the IsNil predicate on the "quote" field.
+func QuoteIsNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldIsNull(FieldQuote))
+}
+
+// QuoteNotNil applies the NotNil predicate on the "quote" field.
+func QuoteNotNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotNull(FieldQuote))
+}
+
+// QuoteEqualFold applies the EqualFold predicate on the "quote" field.
+func QuoteEqualFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEqualFold(FieldQuote, v))
+}
+
+// QuoteContainsFold applies the ContainsFold predicate on the "quote" field.
+func QuoteContainsFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContainsFold(FieldQuote, v))
+}
+
+// CommentEQ applies the EQ predicate on the "comment" field.
+func CommentEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldComment, v))
+}
+
+// CommentNEQ applies the NEQ predicate on the "comment" field.
+func CommentNEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNEQ(FieldComment, v))
+}
+
+// CommentIn applies the In predicate on the "comment" field.
+func CommentIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldIn(FieldComment, vs...))
+}
+
+// CommentNotIn applies the NotIn predicate on the "comment" field.
+func CommentNotIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotIn(FieldComment, vs...))
+}
+
+// CommentGT applies the GT predicate on the "comment" field.
+func CommentGT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGT(FieldComment, v))
+}
+
+// CommentGTE applies the GTE predicate on the "comment" field.
+func CommentGTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGTE(FieldComment, v))
+}
+
+// CommentLT applies the LT predicate on the "comment" field.
+func CommentLT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLT(FieldComment, v))
+}
+
+// CommentLTE applies the LTE predicate on the "comment" field.
+func CommentLTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLTE(FieldComment, v))
+}
+
+// CommentContains applies the Contains predicate on the "comment" field.
+func CommentContains(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContains(FieldComment, v))
+}
+
+// CommentHasPrefix applies the HasPrefix predicate on the "comment" field.
+func CommentHasPrefix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasPrefix(FieldComment, v))
+}
+
+// CommentHasSuffix applies the HasSuffix predicate on the "comment" field.
+func CommentHasSuffix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasSuffix(FieldComment, v))
+}
+
+// CommentIsNil applies the IsNil predicate on the "comment" field.
+func CommentIsNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldIsNull(FieldComment))
+}
+
+// CommentNotNil applies the NotNil predicate on the "comment" field.
+func CommentNotNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotNull(FieldComment))
+}
+
+// CommentEqualFold applies the EqualFold predicate on the "comment" field.
+func CommentEqualFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEqualFold(FieldComment, v))
+}
+
+// CommentContainsFold applies the ContainsFold predicate on the "comment" field.
+func CommentContainsFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContainsFold(FieldComment, v))
+}
+
+// PageEQ applies the EQ predicate on the "page" field.
+func PageEQ(v int) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldPage, v))
+}
+
+// PageNEQ applies the NEQ predicate on the "page" field.
+func PageNEQ(v int) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNEQ(FieldPage, v))
+}
+
+// PageIn applies the In predicate on the "page" field.
+func PageIn(vs ...int) predicate.BookNote {
+	return predicate.BookNote(sql.FieldIn(FieldPage, vs...))
+}
+
+// PageNotIn applies the NotIn predicate on the "page" field.
+func PageNotIn(vs ...int) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotIn(FieldPage, vs...))
+}
+
+// PageGT applies the GT predicate on the "page" field.
+func PageGT(v int) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGT(FieldPage, v))
+}
+
+// PageGTE applies the GTE predicate on the "page" field.
+func PageGTE(v int) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGTE(FieldPage, v))
+}
+
+// PageLT applies the LT predicate on the "page" field.
+func PageLT(v int) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLT(FieldPage, v))
+}
+
+// PageLTE applies the LTE predicate on the "page" field.
+func PageLTE(v int) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLTE(FieldPage, v))
+}
+
+// PageIsNil applies the IsNil predicate on the "page" field.
+func PageIsNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldIsNull(FieldPage))
+}
+
+// PageNotNil applies the NotNil predicate on the "page" field.
+func PageNotNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotNull(FieldPage))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// ColorEQ applies the EQ predicate on the "color" field.
+func ColorEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldColor, v))
+}
+
+// ColorNEQ applies the NEQ predicate on the "color" field.
+func ColorNEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNEQ(FieldColor, v))
+}
+
+// ColorIn applies the In predicate on the "color" field.
+func ColorIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldIn(FieldColor, vs...))
+}
+
+// ColorNotIn applies the NotIn predicate on the "color" field.
+func ColorNotIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotIn(FieldColor, vs...))
+}
+
+// ColorGT applies the GT predicate on the "color" field.
+func ColorGT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGT(FieldColor, v))
+}
+
+// ColorGTE applies the GTE predicate on the "color" field.
+func ColorGTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGTE(FieldColor, v))
+}
+
+// ColorLT applies the LT predicate on the "color" field.
+func ColorLT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLT(FieldColor, v))
+}
+
+// ColorLTE applies the LTE predicate on the "color" field.
+func ColorLTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLTE(FieldColor, v))
+}
+
+// ColorContains applies the Contains predicate on the "color" field.
+func ColorContains(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContains(FieldColor, v))
+}
+
+// ColorHasPrefix applies the HasPrefix predicate on the "color" field.
+func ColorHasPrefix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasPrefix(FieldColor, v))
+}
+
+// ColorHasSuffix applies the HasSuffix predicate on the "color" field.
+func ColorHasSuffix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasSuffix(FieldColor, v))
+}
+
+// ColorIsNil applies the IsNil predicate on the "color" field.
+func ColorIsNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldIsNull(FieldColor))
+}
+
+// ColorNotNil applies the NotNil predicate on the "color" field.
+func ColorNotNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotNull(FieldColor))
+}
+
+// ColorEqualFold applies the EqualFold predicate on the "color" field.
+func ColorEqualFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEqualFold(FieldColor, v))
+}
+
+// ColorContainsFold applies the ContainsFold predicate on the "color" field.
+func ColorContainsFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContainsFold(FieldColor, v))
+}
+
+// LabelEQ applies the EQ predicate on the "label" field.
+func LabelEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldLabel, v))
+}
+
+// LabelNEQ applies the NEQ predicate on the "label" field.
+func LabelNEQ(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNEQ(FieldLabel, v))
+}
+
+// LabelIn applies the In predicate on the "label" field.
+func LabelIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldIn(FieldLabel, vs...))
+}
+
+// LabelNotIn applies the NotIn predicate on the "label" field.
+func LabelNotIn(vs ...string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotIn(FieldLabel, vs...))
+}
+
+// LabelGT applies the GT predicate on the "label" field.
+func LabelGT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGT(FieldLabel, v))
+}
+
+// LabelGTE applies the GTE predicate on the "label" field.
+func LabelGTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGTE(FieldLabel, v))
+}
+
+// LabelLT applies the LT predicate on the "label" field.
+func LabelLT(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLT(FieldLabel, v))
+}
+
+// LabelLTE applies the LTE predicate on the "label" field.
+func LabelLTE(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLTE(FieldLabel, v))
+}
+
+// LabelContains applies the Contains predicate on the "label" field.
+func LabelContains(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContains(FieldLabel, v))
+}
+
+// LabelHasPrefix applies the HasPrefix predicate on the "label" field.
+func LabelHasPrefix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasPrefix(FieldLabel, v))
+}
+
+// LabelHasSuffix applies the HasSuffix predicate on the "label" field.
+func LabelHasSuffix(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldHasSuffix(FieldLabel, v))
+}
+
+// LabelIsNil applies the IsNil predicate on the "label" field.
+func LabelIsNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldIsNull(FieldLabel))
+}
+
+// LabelNotNil applies the NotNil predicate on the "label" field.
+func LabelNotNil() predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotNull(FieldLabel))
+}
+
+// LabelEqualFold applies the EqualFold predicate on the "label" field.
+func LabelEqualFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEqualFold(FieldLabel, v))
+}
+
+// LabelContainsFold applies the ContainsFold predicate on the "label" field.
+func LabelContainsFold(v string) predicate.BookNote {
+	return predicate.BookNote(sql.FieldContainsFold(FieldLabel, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BookNote {
+	return predicate.BookNote(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.BookNote {
+	return predicate.BookNote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.BookNote {
+	return predicate.BookNote(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBook applies the HasEdge predicate on the "book" edge.
+func HasBook() predicate.BookNote {
+	return predicate.BookNote(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BookTable, BookColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookWith applies the HasEdge predicate on the "book" edge with a given conditions (other predicates).
+func HasBookWith(preds ...predicate.Book) predicate.BookNote {
+	return predicate.BookNote(func(s *sql.Selector) {
+		step := newBookStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BookNote) predicate.BookNote {
+	return predicate.BookNote(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BookNote) predicate.BookNote {
+	return predicate.BookNote(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BookNote) predicate.BookNote {
+	return predicate.BookNote(sql.NotPredicates(p))
+}
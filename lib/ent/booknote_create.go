@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// BookNoteCreate is the builder for creating a BookNote entity.
+type BookNoteCreate struct {
+	config
+	mutation *BookNoteMutation
+	hooks    []Hook
+}
+
+// SetQuote sets the "quote" field.
+func (_c *BookNoteCreate) SetQuote(v string) *BookNoteCreate {
+	_c.mutation.SetQuote(v)
+	return _c
+}
+
+// SetNillableQuote sets the "quote" field if the given value is not nil.
+func (_c *BookNoteCreate) SetNillableQuote(v *string) *BookNoteCreate {
+	if v != nil {
+		_c.SetQuote(*v)
+	}
+	return _c
+}
+
+// SetComment sets the "comment" field.
+func (_c *BookNoteCreate) SetComment(v string) *BookNoteCreate {
+	_c.mutation.SetComment(v)
+	return _c
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_c *BookNoteCreate) SetNillableComment(v *string) *BookNoteCreate {
+	if v != nil {
+		_c.SetComment(*v)
+	}
+	return _c
+}
+
+// SetPage sets the "page" field.
+func (_c *BookNoteCreate) SetPage(v int) *BookNoteCreate {
+	_c.mutation.SetPage(v)
+	return _c
+}
+
+// SetNillablePage sets the "page" field if the given value is not nil.
+func (_c *BookNoteCreate) SetNillablePage(v *int) *BookNoteCreate {
+	if v != nil {
+		_c.SetPage(*v)
+	}
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *BookNoteCreate) SetLocation(v string) *BookNoteCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_c *BookNoteCreate) SetNillableLocation(v *string) *BookNoteCreate {
+	if v != nil {
+		_c.SetLocation(*v)
+	}
+	return _c
+}
+
+// SetColor sets the "color" field.
+func (_c *BookNoteCreate) SetColor(v string) *BookNoteCreate {
+	_c.mutation.SetColor(v)
+	return _c
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_c *BookNoteCreate) SetNillableColor(v *string) *BookNoteCreate {
+	if v != nil {
+		_c.SetColor(*v)
+	}
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *BookNoteCreate) SetLabel(v string) *BookNoteCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_c *BookNoteCreate) SetNillableLabel(v *string) *BookNoteCreate {
+	if v != nil {
+		_c.SetLabel(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BookNoteCreate) SetCreatedAt(v time.Time) *BookNoteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BookNoteCreate) SetNillableCreatedAt(v *time.Time) *BookNoteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BookNoteCreate) SetUpdatedAt(v time.Time) *BookNoteCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BookNoteCreate) SetNillableUpdatedAt(v *time.Time) *BookNoteCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BookNoteCreate) SetID(v uuid.UUID) *BookNoteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BookNoteCreate) SetNillableID(v *uuid.UUID) *BookNoteCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *BookNoteCreate) SetOwnerID(id uuid.UUID) *BookNoteCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *BookNoteCreate) SetOwner(v *User) *BookNoteCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_c *BookNoteCreate) SetBookID(id uuid.UUID) *BookNoteCreate {
+	_c.mutation.SetBookID(id)
+	return _c
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_c *BookNoteCreate) SetBook(v *Book) *BookNoteCreate {
+	return _c.SetBookID(v.ID)
+}
+
+// Mutation returns the BookNoteMutation object of the builder.
+func (_c *BookNoteCreate) Mutation() *BookNoteMutation {
+	return _c.mutation
+}
+
+// Save creates the BookNote in the database.
+func (_c *BookNoteCreate) Save(ctx context.Context) (*BookNote, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BookNoteCreate) SaveX(ctx context.Context) *BookNote {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookNoteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookNoteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BookNoteCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := booknote.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := booknote.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := booknote.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BookNoteCreate) check() error {
+	if v, ok := _c.mutation.Page(); ok {
+		if err := booknote.PageValidator(v); err != nil {
+			return &ValidationError{Name: "page", err: fmt.Errorf(`ent: validator failed for field "BookNote.page": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BookNote.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BookNote.updated_at"`)}
+	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "BookNote.owner"`)}
+	}
+	if len(_c.mutation.BookIDs()) == 0 {
+		return &ValidationError{Name: "book", err: errors.New(`ent: missing required edge "BookNote.book"`)}
+	}
+	return nil
+}
+
+func (_c *BookNoteCreate) sqlSave(ctx context.Context) (*BookNote, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BookNoteCreate) createSpec() (*BookNote, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BookNote{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(booknote.Table, sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Quote(); ok {
+		_spec.SetField(booknote.FieldQuote, field.TypeString, value)
+		_node.Quote = value
+	}
+	if value, ok := _c.mutation.Comment(); ok {
+		_spec.SetField(booknote.FieldComment, field.TypeString, value)
+		_node.Comment = value
+	}
+	if value, ok := _c.mutation.Page(); ok {
+		_spec.SetField(booknote.FieldPage, field.TypeInt, value)
+		_node.Page = &value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(booknote.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := _c.mutation.Color(); ok {
+		_spec.SetField(booknote.FieldColor, field.TypeString, value)
+		_node.Color = value
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(booknote.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(booknote.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(booknote.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.OwnerTable,
+			Columns: []string{booknote.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_book_notes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.BookTable,
+			Columns: []string{booknote.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.book_notes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BookNoteCreateBulk is the builder for creating many BookNote entities in bulk.
+type BookNoteCreateBulk struct {
+	config
+	err      error
+	builders []*BookNoteCreate
+}
+
+// Save creates the BookNote entities in the database.
+func (_c *BookNoteCreateBulk) Save(ctx context.Context) ([]*BookNote, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BookNote, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BookNoteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BookNoteCreateBulk) SaveX(ctx context.Context) []*BookNote {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookNoteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookNoteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
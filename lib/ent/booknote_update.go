@@ -0,0 +1,769 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// BookNoteUpdate is the builder for updating BookNote entities.
+type BookNoteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BookNoteMutation
+}
+
+// Where appends a list predicates to the BookNoteUpdate builder.
+func (_u *BookNoteUpdate) Where(ps ...predicate.BookNote) *BookNoteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetQuote sets the "quote" field.
+func (_u *BookNoteUpdate) SetQuote(v string) *BookNoteUpdate {
+	_u.mutation.SetQuote(v)
+	return _u
+}
+
+// SetNillableQuote sets the "quote" field if the given value is not nil.
+func (_u *BookNoteUpdate) SetNillableQuote(v *string) *BookNoteUpdate {
+	if v != nil {
+		_u.SetQuote(*v)
+	}
+	return _u
+}
+
+// ClearQuote clears the value of the "quote" field.
+func (_u *BookNoteUpdate) ClearQuote() *BookNoteUpdate {
+	_u.mutation.ClearQuote()
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *BookNoteUpdate) SetComment(v string) *BookNoteUpdate {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *BookNoteUpdate) SetNillableComment(v *string) *BookNoteUpdate {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *BookNoteUpdate) ClearComment() *BookNoteUpdate {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetPage sets the "page" field.
+func (_u *BookNoteUpdate) SetPage(v int) *BookNoteUpdate {
+	_u.mutation.ResetPage()
+	_u.mutation.SetPage(v)
+	return _u
+}
+
+// SetNillablePage sets the "page" field if the given value is not nil.
+func (_u *BookNoteUpdate) SetNillablePage(v *int) *BookNoteUpdate {
+	if v != nil {
+		_u.SetPage(*v)
+	}
+	return _u
+}
+
+// AddPage adds value to the "page" field.
+func (_u *BookNoteUpdate) AddPage(v int) *BookNoteUpdate {
+	_u.mutation.AddPage(v)
+	return _u
+}
+
+// ClearPage clears the value of the "page" field.
+func (_u *BookNoteUpdate) ClearPage() *BookNoteUpdate {
+	_u.mutation.ClearPage()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *BookNoteUpdate) SetLocation(v string) *BookNoteUpdate {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *BookNoteUpdate) SetNillableLocation(v *string) *BookNoteUpdate {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *BookNoteUpdate) ClearLocation() *BookNoteUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetColor sets the "color" field.
+func (_u *BookNoteUpdate) SetColor(v string) *BookNoteUpdate {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *BookNoteUpdate) SetNillableColor(v *string) *BookNoteUpdate {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// ClearColor clears the value of the "color" field.
+func (_u *BookNoteUpdate) ClearColor() *BookNoteUpdate {
+	_u.mutation.ClearColor()
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *BookNoteUpdate) SetLabel(v string) *BookNoteUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *BookNoteUpdate) SetNillableLabel(v *string) *BookNoteUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *BookNoteUpdate) ClearLabel() *BookNoteUpdate {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BookNoteUpdate) SetUpdatedAt(v time.Time) *BookNoteUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *BookNoteUpdate) SetOwnerID(id uuid.UUID) *BookNoteUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *BookNoteUpdate) SetOwner(v *User) *BookNoteUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *BookNoteUpdate) SetBookID(id uuid.UUID) *BookNoteUpdate {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *BookNoteUpdate) SetBook(v *Book) *BookNoteUpdate {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the BookNoteMutation object of the builder.
+func (_u *BookNoteUpdate) Mutation() *BookNoteMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *BookNoteUpdate) ClearOwner() *BookNoteUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *BookNoteUpdate) ClearBook() *BookNoteUpdate {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BookNoteUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookNoteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BookNoteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookNoteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BookNoteUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := booknote.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookNoteUpdate) check() error {
+	if v, ok := _u.mutation.Page(); ok {
+		if err := booknote.PageValidator(v); err != nil {
+			return &ValidationError{Name: "page", err: fmt.Errorf(`ent: validator failed for field "BookNote.page": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BookNote.owner"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BookNote.book"`)
+	}
+	return nil
+}
+
+func (_u *BookNoteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(booknote.Table, booknote.Columns, sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quote(); ok {
+		_spec.SetField(booknote.FieldQuote, field.TypeString, value)
+	}
+	if _u.mutation.QuoteCleared() {
+		_spec.ClearField(booknote.FieldQuote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(booknote.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(booknote.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.Page(); ok {
+		_spec.SetField(booknote.FieldPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPage(); ok {
+		_spec.AddField(booknote.FieldPage, field.TypeInt, value)
+	}
+	if _u.mutation.PageCleared() {
+		_spec.ClearField(booknote.FieldPage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(booknote.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(booknote.FieldLocation, field.TypeString)
+	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(booknote.FieldColor, field.TypeString, value)
+	}
+	if _u.mutation.ColorCleared() {
+		_spec.ClearField(booknote.FieldColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(booknote.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(booknote.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(booknote.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.OwnerTable,
+			Columns: []string{booknote.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.OwnerTable,
+			Columns: []string{booknote.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.BookTable,
+			Columns: []string{booknote.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.BookTable,
+			Columns: []string{booknote.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{booknote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BookNoteUpdateOne is the builder for updating a single BookNote entity.
+type BookNoteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BookNoteMutation
+}
+
+// SetQuote sets the "quote" field.
+func (_u *BookNoteUpdateOne) SetQuote(v string) *BookNoteUpdateOne {
+	_u.mutation.SetQuote(v)
+	return _u
+}
+
+// SetNillableQuote sets the "quote" field if the given value is not nil.
+func (_u *BookNoteUpdateOne) SetNillableQuote(v *string) *BookNoteUpdateOne {
+	if v != nil {
+		_u.SetQuote(*v)
+	}
+	return _u
+}
+
+// ClearQuote clears the value of the "quote" field.
+func (_u *BookNoteUpdateOne) ClearQuote() *BookNoteUpdateOne {
+	_u.mutation.ClearQuote()
+	return _u
+}
+
+// SetComment sets the "comment" field.
+func (_u *BookNoteUpdateOne) SetComment(v string) *BookNoteUpdateOne {
+	_u.mutation.SetComment(v)
+	return _u
+}
+
+// SetNillableComment sets the "comment" field if the given value is not nil.
+func (_u *BookNoteUpdateOne) SetNillableComment(v *string) *BookNoteUpdateOne {
+	if v != nil {
+		_u.SetComment(*v)
+	}
+	return _u
+}
+
+// ClearComment clears the value of the "comment" field.
+func (_u *BookNoteUpdateOne) ClearComment() *BookNoteUpdateOne {
+	_u.mutation.ClearComment()
+	return _u
+}
+
+// SetPage sets the "page" field.
+func (_u *BookNoteUpdateOne) SetPage(v int) *BookNoteUpdateOne {
+	_u.mutation.ResetPage()
+	_u.mutation.SetPage(v)
+	return _u
+}
+
+// SetNillablePage sets the "page" field if the given value is not nil.
+func (_u *BookNoteUpdateOne) SetNillablePage(v *int) *BookNoteUpdateOne {
+	if v != nil {
+		_u.SetPage(*v)
+	}
+	return _u
+}
+
+// AddPage adds value to the "page" field.
+func (_u *BookNoteUpdateOne) AddPage(v int) *BookNoteUpdateOne {
+	_u.mutation.AddPage(v)
+	return _u
+}
+
+// ClearPage clears the value of the "page" field.
+func (_u *BookNoteUpdateOne) ClearPage() *BookNoteUpdateOne {
+	_u.mutation.ClearPage()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *BookNoteUpdateOne) SetLocation(v string) *BookNoteUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *BookNoteUpdateOne) SetNillableLocation(v *string) *BookNoteUpdateOne {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *BookNoteUpdateOne) ClearLocation() *BookNoteUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetColor sets the "color" field.
+func (_u *BookNoteUpdateOne) SetColor(v string) *BookNoteUpdateOne {
+	_u.mutation.SetColor(v)
+	return _u
+}
+
+// SetNillableColor sets the "color" field if the given value is not nil.
+func (_u *BookNoteUpdateOne) SetNillableColor(v *string) *BookNoteUpdateOne {
+	if v != nil {
+		_u.SetColor(*v)
+	}
+	return _u
+}
+
+// ClearColor clears the value of the "color" field.
+func (_u *BookNoteUpdateOne) ClearColor() *BookNoteUpdateOne {
+	_u.mutation.ClearColor()
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *BookNoteUpdateOne) SetLabel(v string) *BookNoteUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *BookNoteUpdateOne) SetNillableLabel(v *string) *BookNoteUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// ClearLabel clears the value of the "label" field.
+func (_u *BookNoteUpdateOne) ClearLabel() *BookNoteUpdateOne {
+	_u.mutation.ClearLabel()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BookNoteUpdateOne) SetUpdatedAt(v time.Time) *BookNoteUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *BookNoteUpdateOne) SetOwnerID(id uuid.UUID) *BookNoteUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *BookNoteUpdateOne) SetOwner(v *User) *BookNoteUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *BookNoteUpdateOne) SetBookID(id uuid.UUID) *BookNoteUpdateOne {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *BookNoteUpdateOne) SetBook(v *Book) *BookNoteUpdateOne {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the BookNoteMutation object of the builder.
+func (_u *BookNoteUpdateOne) Mutation() *BookNoteMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *BookNoteUpdateOne) ClearOwner() *BookNoteUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *BookNoteUpdateOne) ClearBook() *BookNoteUpdateOne {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Where appends a list predicates to the BookNoteUpdate builder.
+func (_u *BookNoteUpdateOne) Where(ps ...predicate.BookNote) *BookNoteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BookNoteUpdateOne) Select(field string, fields ...string) *BookNoteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BookNote entity.
+func (_u *BookNoteUpdateOne) Save(ctx context.Context) (*BookNote, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookNoteUpdateOne) SaveX(ctx context.Context) *BookNote {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BookNoteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookNoteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BookNoteUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := booknote.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookNoteUpdateOne) check() error {
+	if v, ok := _u.mutation.Page(); ok {
+		if err := booknote.PageValidator(v); err != nil {
+			return &ValidationError{Name: "page", err: fmt.Errorf(`ent: validator failed for field "BookNote.page": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BookNote.owner"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BookNote.book"`)
+	}
+	return nil
+}
+
+func (_u *BookNoteUpdateOne) sqlSave(ctx context.Context) (_node *BookNote, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(booknote.Table, booknote.Columns, sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BookNote.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, booknote.FieldID)
+		for _, f := range fields {
+			if !booknote.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != booknote.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Quote(); ok {
+		_spec.SetField(booknote.FieldQuote, field.TypeString, value)
+	}
+	if _u.mutation.QuoteCleared() {
+		_spec.ClearField(booknote.FieldQuote, field.TypeString)
+	}
+	if value, ok := _u.mutation.Comment(); ok {
+		_spec.SetField(booknote.FieldComment, field.TypeString, value)
+	}
+	if _u.mutation.CommentCleared() {
+		_spec.ClearField(booknote.FieldComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.Page(); ok {
+		_spec.SetField(booknote.FieldPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPage(); ok {
+		_spec.AddField(booknote.FieldPage, field.TypeInt, value)
+	}
+	if _u.mutation.PageCleared() {
+		_spec.ClearField(booknote.FieldPage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(booknote.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(booknote.FieldLocation, field.TypeString)
+	}
+	if value, ok := _u.mutation.Color(); ok {
+		_spec.SetField(booknote.FieldColor, field.TypeString, value)
+	}
+	if _u.mutation.ColorCleared() {
+		_spec.ClearField(booknote.FieldColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(booknote.FieldLabel, field.TypeString, value)
+	}
+	if _u.mutation.LabelCleared() {
+		_spec.ClearField(booknote.FieldLabel, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(booknote.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.OwnerTable,
+			Columns: []string{booknote.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.OwnerTable,
+			Columns: []string{booknote.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.BookTable,
+			Columns: []string{booknote.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   booknote.BookTable,
+			Columns: []string{booknote.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BookNote{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{booknote.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/google/uuid"
+)
+
+// BookStatusHistory is the model entity for the BookStatusHistory schema.
+type BookStatusHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 변경 전 읽기 상태 (책 등록 시 null)
+	FromStatus *bookstatushistory.FromStatus `json:"from_status,omitempty"`
+	// 변경 후 읽기 상태
+	ToStatus bookstatushistory.ToStatus `json:"to_status,omitempty"`
+	// 상태 변경 시간
+	ChangedAt time.Time `json:"changed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BookStatusHistoryQuery when eager-loading is set.
+	Edges                 BookStatusHistoryEdges `json:"edges"`
+	book_status_histories *uuid.UUID
+	selectValues          sql.SelectValues
+}
+
+// BookStatusHistoryEdges holds the relations/edges for other nodes in the graph.
+type BookStatusHistoryEdges struct {
+	// Book holds the value of the book edge.
+	Book *Book `json:"book,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BookOrErr returns the Book value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BookStatusHistoryEdges) BookOrErr() (*Book, error) {
+	if e.Book != nil {
+		return e.Book, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: book.Label}
+	}
+	return nil, &NotLoadedError{edge: "book"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BookStatusHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case bookstatushistory.FieldFromStatus, bookstatushistory.FieldToStatus:
+			values[i] = new(sql.NullString)
+		case bookstatushistory.FieldChangedAt:
+			values[i] = new(sql.NullTime)
+		case bookstatushistory.FieldID:
+			values[i] = new(uuid.UUID)
+		case bookstatushistory.ForeignKeys[0]: // book_status_histories
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BookStatusHistory fields.
+func (_m *BookStatusHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case bookstatushistory.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case bookstatushistory.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				_m.FromStatus = new(bookstatushistory.FromStatus)
+				*_m.FromStatus = bookstatushistory.FromStatus(value.String)
+			}
+		case bookstatushistory.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				_m.ToStatus = bookstatushistory.ToStatus(value.String)
+			}
+		case bookstatushistory.FieldChangedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_at", values[i])
+			} else if value.Valid {
+				_m.ChangedAt = value.Time
+			}
+		case bookstatushistory.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field book_status_histories", values[i])
+			} else if value.Valid {
+				_m.book_status_histories = new(uuid.UUID)
+				*_m.book_status_histories = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BookStatusHistory.
+// This includes values selected through modifiers, order, etc.
+func (_m *BookStatusHistory) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBook queries the "book" edge of the BookStatusHistory entity.
+func (_m *BookStatusHistory) QueryBook() *BookQuery {
+	return NewBookStatusHistoryClient(_m.config).QueryBook(_m)
+}
+
+// Update returns a builder for updating this BookStatusHistory.
+// Note that you need to call BookStatusHistory.Unwrap() before calling this method if this BookStatusHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BookStatusHistory) Update() *BookStatusHistoryUpdateOne {
+	return NewBookStatusHistoryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BookStatusHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BookStatusHistory) Unwrap() *BookStatusHistory {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BookStatusHistory is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BookStatusHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("BookStatusHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.FromStatus; v != nil {
+		builder.WriteString("from_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("changed_at=")
+	builder.WriteString(_m.ChangedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BookStatusHistories is a parsable slice of BookStatusHistory.
+type BookStatusHistories []*BookStatusHistory
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bookstatushistory
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the bookstatushistory type in the database.
+	Label = "book_status_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldChangedAt holds the string denoting the changed_at field in the database.
+	FieldChangedAt = "changed_at"
+	// EdgeBook holds the string denoting the book edge name in mutations.
+	EdgeBook = "book"
+	// Table holds the table name of the bookstatushistory in the database.
+	Table = "book_status_histories"
+	// BookTable is the table that holds the book relation/edge.
+	BookTable = "book_status_histories"
+	// BookInverseTable is the table name for the Book entity.
+	// It exists in this package in order to avoid circular dependency with the "book" package.
+	BookInverseTable = "books"
+	// BookColumn is the table column denoting the book relation/edge.
+	BookColumn = "book_status_histories"
+)
+
+// Columns holds all SQL columns for bookstatushistory fields.
+var Columns = []string{
+	FieldID,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldChangedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "book_status_histories"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"book_status_histories",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultChangedAt holds the default value on creation for the "changed_at" field.
+	DefaultChangedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// FromStatus defines the type for the "from_status" enum field.
+type FromStatus string
+
+// FromStatus values.
+const (
+	FromStatusUnread    FromStatus = "unread"
+	FromStatusReading   FromStatus = "reading"
+	FromStatusFinished  FromStatus = "finished"
+	FromStatusPaused    FromStatus = "paused"
+	FromStatusAbandoned FromStatus = "abandoned"
+)
+
+func (fs FromStatus) String() string {
+	return string(fs)
+}
+
+// FromStatusValidator is a validator for the "from_status" field enum values. It is called by the builders before save.
+func FromStatusValidator(fs FromStatus) error {
+	switch fs {
+	case FromStatusUnread, FromStatusReading, FromStatusFinished, FromStatusPaused, FromStatusAbandoned:
+		return nil
+	default:
+		return fmt.Errorf("bookstatushistory: invalid enum value for from_status field: %q", fs)
+	}
+}
+
+// ToStatus defines the type for the "to_status" enum field.
+type ToStatus string
+
+// ToStatus values.
+const (
+	ToStatusUnread    ToStatus = "unread"
+	ToStatusReading   ToStatus = "reading"
+	ToStatusFinished  ToStatus = "finished"
+	ToStatusPaused    ToStatus = "paused"
+	ToStatusAbandoned ToStatus = "abandoned"
+)
+
+func (ts ToStatus) String() string {
+	return string(ts)
+}
+
+// ToStatusValidator is a validator for the "to_status" field enum values. It is called by the builders before save.
+func ToStatusValidator(ts ToStatus) error {
+	switch ts {
+	case ToStatusUnread, ToStatusReading, ToStatusFinished, ToStatusPaused, ToStatusAbandoned:
+		return nil
+	default:
+		return fmt.Errorf("bookstatushistory: invalid enum value for to_status field: %q", ts)
+	}
+}
+
+// OrderOption defines the ordering options for the BookStatusHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByChangedAt orders the results by the changed_at field.
+func ByChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedAt, opts...).ToFunc()
+}
+
+// ByBookField orders the results by book field.
+func ByBookField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBookStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBookStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BookInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BookTable, BookColumn),
+	)
+}
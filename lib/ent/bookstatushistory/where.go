@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package bookstatushistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldLTE(FieldID, id))
+}
+
+// ChangedAt applies equality check predicate on the "changed_at" field. It's identical to ChangedAtEQ.
+func ChangedAt(v time.Time) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// FromStatusEQ applies the EQ predicate on the "from_status" field.
+func FromStatusEQ(v FromStatus) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldEQ(FieldFromStatus, v))
+}
+
+// FromStatusNEQ applies the NEQ predicate on the "from_status" field.
+func FromStatusNEQ(v FromStatus) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldNEQ(FieldFromStatus, v))
+}
+
+// FromStatusIn applies the In predicate on the "from_status" field.
+func FromStatusIn(vs ...FromStatus) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldIn(FieldFromStatus, vs...))
+}
+
+// FromStatusNotIn applies the NotIn predicate on the "from_status" field.
+func FromStatusNotIn(vs ...FromStatus) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldNotIn(FieldFromStatus, vs...))
+}
+
+// FromStatusIsNil applies the IsNil predicate on the "from_status" field.
+func FromStatusIsNil() predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldIsNull(FieldFromStatus))
+}
+
+// FromStatusNotNil applies the NotNil predicate on the "from_status" field.
+func FromStatusNotNil() predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldNotNull(FieldFromStatus))
+}
+
+// ToStatusEQ applies the EQ predicate on the "to_status" field.
+func ToStatusEQ(v ToStatus) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldEQ(FieldToStatus, v))
+}
+
+// ToStatusNEQ applies the NEQ predicate on the "to_status" field.
+func ToStatusNEQ(v ToStatus) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldNEQ(FieldToStatus, v))
+}
+
+// ToStatusIn applies the In predicate on the "to_status" field.
+func ToStatusIn(vs ...ToStatus) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldIn(FieldToStatus, vs...))
+}
+
+// ToStatusNotIn applies the NotIn predicate on the "to_status" field.
+func ToStatusNotIn(vs ...ToStatus) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldNotIn(FieldToStatus, vs...))
+}
+
+// ChangedAtEQ applies the EQ predicate on the "changed_at" field.
+func ChangedAtEQ(v time.Time) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldEQ(FieldChangedAt, v))
+}
+
+// ChangedAtNEQ applies the NEQ predicate on the "changed_at" field.
+func ChangedAtNEQ(v time.Time) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldNEQ(FieldChangedAt, v))
+}
+
+// ChangedAtIn applies the In predicate on the "changed_at" field.
+func ChangedAtIn(vs ...time.Time) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtNotIn applies the NotIn predicate on the "changed_at" field.
+func ChangedAtNotIn(vs ...time.Time) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldNotIn(FieldChangedAt, vs...))
+}
+
+// ChangedAtGT applies the GT predicate on the "changed_at" field.
+func ChangedAtGT(v time.Time) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldGT(FieldChangedAt, v))
+}
+
+// ChangedAtGTE applies the GTE predicate on the "changed_at" field.
+func ChangedAtGTE(v time.Time) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldGTE(FieldChangedAt, v))
+}
+
+// ChangedAtLT applies the LT predicate on the "changed_at" field.
+func ChangedAtLT(v time.Time) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldLT(FieldChangedAt, v))
+}
+
+// ChangedAtLTE applies the LTE predicate on the "changed_at" field.
+func ChangedAtLTE(v time.Time) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.FieldLTE(FieldChangedAt, v))
+}
+
+// HasBook applies the HasEdge predicate on the "book" edge.
+func HasBook() predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BookTable, BookColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookWith applies the HasEdge predicate on the "book" edge with a given conditions (other predicates).
+func HasBookWith(preds ...predicate.Book) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(func(s *sql.Selector) {
+		step := newBookStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BookStatusHistory) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BookStatusHistory) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BookStatusHistory) predicate.BookStatusHistory {
+	return predicate.BookStatusHistory(sql.NotPredicates(p))
+}
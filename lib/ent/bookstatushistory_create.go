@@ -0,0 +1,299 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/google/uuid"
+)
+
+// BookStatusHistoryCreate is the builder for creating a BookStatusHistory entity.
+type BookStatusHistoryCreate struct {
+	config
+	mutation *BookStatusHistoryMutation
+	hooks    []Hook
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_c *BookStatusHistoryCreate) SetFromStatus(v bookstatushistory.FromStatus) *BookStatusHistoryCreate {
+	_c.mutation.SetFromStatus(v)
+	return _c
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_c *BookStatusHistoryCreate) SetNillableFromStatus(v *bookstatushistory.FromStatus) *BookStatusHistoryCreate {
+	if v != nil {
+		_c.SetFromStatus(*v)
+	}
+	return _c
+}
+
+// SetToStatus sets the "to_status" field.
+func (_c *BookStatusHistoryCreate) SetToStatus(v bookstatushistory.ToStatus) *BookStatusHistoryCreate {
+	_c.mutation.SetToStatus(v)
+	return _c
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (_c *BookStatusHistoryCreate) SetChangedAt(v time.Time) *BookStatusHistoryCreate {
+	_c.mutation.SetChangedAt(v)
+	return _c
+}
+
+// SetNillableChangedAt sets the "changed_at" field if the given value is not nil.
+func (_c *BookStatusHistoryCreate) SetNillableChangedAt(v *time.Time) *BookStatusHistoryCreate {
+	if v != nil {
+		_c.SetChangedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BookStatusHistoryCreate) SetID(v uuid.UUID) *BookStatusHistoryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BookStatusHistoryCreate) SetNillableID(v *uuid.UUID) *BookStatusHistoryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_c *BookStatusHistoryCreate) SetBookID(id uuid.UUID) *BookStatusHistoryCreate {
+	_c.mutation.SetBookID(id)
+	return _c
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_c *BookStatusHistoryCreate) SetBook(v *Book) *BookStatusHistoryCreate {
+	return _c.SetBookID(v.ID)
+}
+
+// Mutation returns the BookStatusHistoryMutation object of the builder.
+func (_c *BookStatusHistoryCreate) Mutation() *BookStatusHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the BookStatusHistory in the database.
+func (_c *BookStatusHistoryCreate) Save(ctx context.Context) (*BookStatusHistory, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BookStatusHistoryCreate) SaveX(ctx context.Context) *BookStatusHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookStatusHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookStatusHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BookStatusHistoryCreate) defaults() {
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		v := bookstatushistory.DefaultChangedAt()
+		_c.mutation.SetChangedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := bookstatushistory.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BookStatusHistoryCreate) check() error {
+	if v, ok := _c.mutation.FromStatus(); ok {
+		if err := bookstatushistory.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "BookStatusHistory.from_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "BookStatusHistory.to_status"`)}
+	}
+	if v, ok := _c.mutation.ToStatus(); ok {
+		if err := bookstatushistory.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "BookStatusHistory.to_status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ChangedAt(); !ok {
+		return &ValidationError{Name: "changed_at", err: errors.New(`ent: missing required field "BookStatusHistory.changed_at"`)}
+	}
+	if len(_c.mutation.BookIDs()) == 0 {
+		return &ValidationError{Name: "book", err: errors.New(`ent: missing required edge "BookStatusHistory.book"`)}
+	}
+	return nil
+}
+
+func (_c *BookStatusHistoryCreate) sqlSave(ctx context.Context) (*BookStatusHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BookStatusHistoryCreate) createSpec() (*BookStatusHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BookStatusHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(bookstatushistory.Table, sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.FromStatus(); ok {
+		_spec.SetField(bookstatushistory.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = &value
+	}
+	if value, ok := _c.mutation.ToStatus(); ok {
+		_spec.SetField(bookstatushistory.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := _c.mutation.ChangedAt(); ok {
+		_spec.SetField(bookstatushistory.FieldChangedAt, field.TypeTime, value)
+		_node.ChangedAt = value
+	}
+	if nodes := _c.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookstatushistory.BookTable,
+			Columns: []string{bookstatushistory.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.book_status_histories = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BookStatusHistoryCreateBulk is the builder for creating many BookStatusHistory entities in bulk.
+type BookStatusHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*BookStatusHistoryCreate
+}
+
+// Save creates the BookStatusHistory entities in the database.
+func (_c *BookStatusHistoryCreateBulk) Save(ctx context.Context) ([]*BookStatusHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BookStatusHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BookStatusHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BookStatusHistoryCreateBulk) SaveX(ctx context.Context) []*BookStatusHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BookStatusHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BookStatusHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
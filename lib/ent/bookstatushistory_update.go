@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// BookStatusHistoryUpdate is the builder for updating BookStatusHistory entities.
+type BookStatusHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BookStatusHistoryMutation
+}
+
+// Where appends a list predicates to the BookStatusHistoryUpdate builder.
+func (_u *BookStatusHistoryUpdate) Where(ps ...predicate.BookStatusHistory) *BookStatusHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *BookStatusHistoryUpdate) SetFromStatus(v bookstatushistory.FromStatus) *BookStatusHistoryUpdate {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *BookStatusHistoryUpdate) SetNillableFromStatus(v *bookstatushistory.FromStatus) *BookStatusHistoryUpdate {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (_u *BookStatusHistoryUpdate) ClearFromStatus() *BookStatusHistoryUpdate {
+	_u.mutation.ClearFromStatus()
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *BookStatusHistoryUpdate) SetToStatus(v bookstatushistory.ToStatus) *BookStatusHistoryUpdate {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *BookStatusHistoryUpdate) SetNillableToStatus(v *bookstatushistory.ToStatus) *BookStatusHistoryUpdate {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *BookStatusHistoryUpdate) SetBookID(id uuid.UUID) *BookStatusHistoryUpdate {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *BookStatusHistoryUpdate) SetBook(v *Book) *BookStatusHistoryUpdate {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the BookStatusHistoryMutation object of the builder.
+func (_u *BookStatusHistoryUpdate) Mutation() *BookStatusHistoryMutation {
+	return _u.mutation
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *BookStatusHistoryUpdate) ClearBook() *BookStatusHistoryUpdate {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BookStatusHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookStatusHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BookStatusHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookStatusHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookStatusHistoryUpdate) check() error {
+	if v, ok := _u.mutation.FromStatus(); ok {
+		if err := bookstatushistory.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "BookStatusHistory.from_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToStatus(); ok {
+		if err := bookstatushistory.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "BookStatusHistory.to_status": %w`, err)}
+		}
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BookStatusHistory.book"`)
+	}
+	return nil
+}
+
+func (_u *BookStatusHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bookstatushistory.Table, bookstatushistory.Columns, sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(bookstatushistory.FieldFromStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(bookstatushistory.FieldFromStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(bookstatushistory.FieldToStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookstatushistory.BookTable,
+			Columns: []string{bookstatushistory.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookstatushistory.BookTable,
+			Columns: []string{bookstatushistory.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookstatushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BookStatusHistoryUpdateOne is the builder for updating a single BookStatusHistory entity.
+type BookStatusHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BookStatusHistoryMutation
+}
+
+// SetFromStatus sets the "from_status" field.
+func (_u *BookStatusHistoryUpdateOne) SetFromStatus(v bookstatushistory.FromStatus) *BookStatusHistoryUpdateOne {
+	_u.mutation.SetFromStatus(v)
+	return _u
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (_u *BookStatusHistoryUpdateOne) SetNillableFromStatus(v *bookstatushistory.FromStatus) *BookStatusHistoryUpdateOne {
+	if v != nil {
+		_u.SetFromStatus(*v)
+	}
+	return _u
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (_u *BookStatusHistoryUpdateOne) ClearFromStatus() *BookStatusHistoryUpdateOne {
+	_u.mutation.ClearFromStatus()
+	return _u
+}
+
+// SetToStatus sets the "to_status" field.
+func (_u *BookStatusHistoryUpdateOne) SetToStatus(v bookstatushistory.ToStatus) *BookStatusHistoryUpdateOne {
+	_u.mutation.SetToStatus(v)
+	return _u
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (_u *BookStatusHistoryUpdateOne) SetNillableToStatus(v *bookstatushistory.ToStatus) *BookStatusHistoryUpdateOne {
+	if v != nil {
+		_u.SetToStatus(*v)
+	}
+	return _u
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *BookStatusHistoryUpdateOne) SetBookID(id uuid.UUID) *BookStatusHistoryUpdateOne {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *BookStatusHistoryUpdateOne) SetBook(v *Book) *BookStatusHistoryUpdateOne {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the BookStatusHistoryMutation object of the builder.
+func (_u *BookStatusHistoryUpdateOne) Mutation() *BookStatusHistoryMutation {
+	return _u.mutation
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *BookStatusHistoryUpdateOne) ClearBook() *BookStatusHistoryUpdateOne {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Where appends a list predicates to the BookStatusHistoryUpdate builder.
+func (_u *BookStatusHistoryUpdateOne) Where(ps ...predicate.BookStatusHistory) *BookStatusHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BookStatusHistoryUpdateOne) Select(field string, fields ...string) *BookStatusHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BookStatusHistory entity.
+func (_u *BookStatusHistoryUpdateOne) Save(ctx context.Context) (*BookStatusHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BookStatusHistoryUpdateOne) SaveX(ctx context.Context) *BookStatusHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BookStatusHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BookStatusHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BookStatusHistoryUpdateOne) check() error {
+	if v, ok := _u.mutation.FromStatus(); ok {
+		if err := bookstatushistory.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "BookStatusHistory.from_status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ToStatus(); ok {
+		if err := bookstatushistory.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "BookStatusHistory.to_status": %w`, err)}
+		}
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BookStatusHistory.book"`)
+	}
+	return nil
+}
+
+func (_u *BookStatusHistoryUpdateOne) sqlSave(ctx context.Context) (_node *BookStatusHistory, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(bookstatushistory.Table, bookstatushistory.Columns, sqlgraph.NewFieldSpec(bookstatushistory.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BookStatusHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, bookstatushistory.FieldID)
+		for _, f := range fields {
+			if !bookstatushistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != bookstatushistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.FromStatus(); ok {
+		_spec.SetField(bookstatushistory.FieldFromStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.FromStatusCleared() {
+		_spec.ClearField(bookstatushistory.FieldFromStatus, field.TypeEnum)
+	}
+	if value, ok := _u.mutation.ToStatus(); ok {
+		_spec.SetField(bookstatushistory.FieldToStatus, field.TypeEnum, value)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookstatushistory.BookTable,
+			Columns: []string{bookstatushistory.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   bookstatushistory.BookTable,
+			Columns: []string{bookstatushistory.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BookStatusHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{bookstatushistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,332 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// BorrowRequest is the model entity for the BorrowRequest schema.
+type BorrowRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 요청 상태
+	Status borrowrequest.Status `json:"status,omitempty"`
+	// 요청한 사람이 남긴 메시지
+	Message string `json:"message,omitempty"`
+	// 책 주인이 수락/거절하며 남긴 메시지
+	ResponseMessage string `json:"response_message,omitempty"`
+	// 반납 예정일
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// 수락/거절한 시간
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// 책을 건네준 시간
+	HandedOverAt *time.Time `json:"handed_over_at,omitempty"`
+	// 책을 돌려받은 시간
+	ReturnedAt *time.Time `json:"returned_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BorrowRequestQuery when eager-loading is set.
+	Edges                         BorrowRequestEdges `json:"edges"`
+	book_borrow_requests          *uuid.UUID
+	borrow_request_loan           *uuid.UUID
+	user_sent_borrow_requests     *uuid.UUID
+	user_received_borrow_requests *uuid.UUID
+	selectValues                  sql.SelectValues
+}
+
+// BorrowRequestEdges holds the relations/edges for other nodes in the graph.
+type BorrowRequestEdges struct {
+	// Requester holds the value of the requester edge.
+	Requester *User `json:"requester,omitempty"`
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Book holds the value of the book edge.
+	Book *Book `json:"book,omitempty"`
+	// Loan holds the value of the loan edge.
+	Loan *Loan `json:"loan,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// RequesterOrErr returns the Requester value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BorrowRequestEdges) RequesterOrErr() (*User, error) {
+	if e.Requester != nil {
+		return e.Requester, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "requester"}
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BorrowRequestEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// BookOrErr returns the Book value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BorrowRequestEdges) BookOrErr() (*Book, error) {
+	if e.Book != nil {
+		return e.Book, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: book.Label}
+	}
+	return nil, &NotLoadedError{edge: "book"}
+}
+
+// LoanOrErr returns the Loan value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BorrowRequestEdges) LoanOrErr() (*Loan, error) {
+	if e.Loan != nil {
+		return e.Loan, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: loan.Label}
+	}
+	return nil, &NotLoadedError{edge: "loan"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BorrowRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case borrowrequest.FieldStatus, borrowrequest.FieldMessage, borrowrequest.FieldResponseMessage:
+			values[i] = new(sql.NullString)
+		case borrowrequest.FieldDueAt, borrowrequest.FieldRespondedAt, borrowrequest.FieldHandedOverAt, borrowrequest.FieldReturnedAt, borrowrequest.FieldCreatedAt, borrowrequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case borrowrequest.FieldID:
+			values[i] = new(uuid.UUID)
+		case borrowrequest.ForeignKeys[0]: // book_borrow_requests
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case borrowrequest.ForeignKeys[1]: // borrow_request_loan
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case borrowrequest.ForeignKeys[2]: // user_sent_borrow_requests
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case borrowrequest.ForeignKeys[3]: // user_received_borrow_requests
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BorrowRequest fields.
+func (_m *BorrowRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case borrowrequest.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case borrowrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = borrowrequest.Status(value.String)
+			}
+		case borrowrequest.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				_m.Message = value.String
+			}
+		case borrowrequest.FieldResponseMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field response_message", values[i])
+			} else if value.Valid {
+				_m.ResponseMessage = value.String
+			}
+		case borrowrequest.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				_m.DueAt = new(time.Time)
+				*_m.DueAt = value.Time
+			}
+		case borrowrequest.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				_m.RespondedAt = new(time.Time)
+				*_m.RespondedAt = value.Time
+			}
+		case borrowrequest.FieldHandedOverAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field handed_over_at", values[i])
+			} else if value.Valid {
+				_m.HandedOverAt = new(time.Time)
+				*_m.HandedOverAt = value.Time
+			}
+		case borrowrequest.FieldReturnedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field returned_at", values[i])
+			} else if value.Valid {
+				_m.ReturnedAt = new(time.Time)
+				*_m.ReturnedAt = value.Time
+			}
+		case borrowrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case borrowrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case borrowrequest.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field book_borrow_requests", values[i])
+			} else if value.Valid {
+				_m.book_borrow_requests = new(uuid.UUID)
+				*_m.book_borrow_requests = *value.S.(*uuid.UUID)
+			}
+		case borrowrequest.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field borrow_request_loan", values[i])
+			} else if value.Valid {
+				_m.borrow_request_loan = new(uuid.UUID)
+				*_m.borrow_request_loan = *value.S.(*uuid.UUID)
+			}
+		case borrowrequest.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_sent_borrow_requests", values[i])
+			} else if value.Valid {
+				_m.user_sent_borrow_requests = new(uuid.UUID)
+				*_m.user_sent_borrow_requests = *value.S.(*uuid.UUID)
+			}
+		case borrowrequest.ForeignKeys[3]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_received_borrow_requests", values[i])
+			} else if value.Valid {
+				_m.user_received_borrow_requests = new(uuid.UUID)
+				*_m.user_received_borrow_requests = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BorrowRequest.
+// This includes values selected through modifiers, order, etc.
+func (_m *BorrowRequest) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRequester queries the "requester" edge of the BorrowRequest entity.
+func (_m *BorrowRequest) QueryRequester() *UserQuery {
+	return NewBorrowRequestClient(_m.config).QueryRequester(_m)
+}
+
+// QueryOwner queries the "owner" edge of the BorrowRequest entity.
+func (_m *BorrowRequest) QueryOwner() *UserQuery {
+	return NewBorrowRequestClient(_m.config).QueryOwner(_m)
+}
+
+// QueryBook queries the "book" edge of the BorrowRequest entity.
+func (_m *BorrowRequest) QueryBook() *BookQuery {
+	return NewBorrowRequestClient(_m.config).QueryBook(_m)
+}
+
+// QueryLoan queries the "loan" edge of the BorrowRequest entity.
+func (_m *BorrowRequest) QueryLoan() *LoanQuery {
+	return NewBorrowRequestClient(_m.config).QueryLoan(_m)
+}
+
+// Update returns a builder for updating this BorrowRequest.
+// Note that you need to call BorrowRequest.Unwrap() before calling this method if this BorrowRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BorrowRequest) Update() *BorrowRequestUpdateOne {
+	return NewBorrowRequestClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BorrowRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BorrowRequest) Unwrap() *BorrowRequest {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BorrowRequest is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BorrowRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("BorrowRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(_m.Message)
+	builder.WriteString(", ")
+	builder.WriteString("response_message=")
+	builder.WriteString(_m.ResponseMessage)
+	builder.WriteString(", ")
+	if v := _m.DueAt; v != nil {
+		builder.WriteString("due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RespondedAt; v != nil {
+		builder.WriteString("responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.HandedOverAt; v != nil {
+		builder.WriteString("handed_over_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReturnedAt; v != nil {
+		builder.WriteString("returned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BorrowRequests is a parsable slice of BorrowRequest.
+type BorrowRequests []*BorrowRequest
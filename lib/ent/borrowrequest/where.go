@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package borrowrequest
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLTE(FieldID, id))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldMessage, v))
+}
+
+// ResponseMessage applies equality check predicate on the "response_message" field. It's identical to ResponseMessageEQ.
+func ResponseMessage(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldResponseMessage, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldDueAt, v))
+}
+
+// RespondedAt applies equality check predicate on the "responded_at" field. It's identical to RespondedAtEQ.
+func RespondedAt(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// HandedOverAt applies equality check predicate on the "handed_over_at" field. It's identical to HandedOverAtEQ.
+func HandedOverAt(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldHandedOverAt, v))
+}
+
+// ReturnedAt applies equality check predicate on the "returned_at" field. It's identical to ReturnedAtEQ.
+func ReturnedAt(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldReturnedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageIsNil applies the IsNil predicate on the "message" field.
+func MessageIsNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIsNull(FieldMessage))
+}
+
+// MessageNotNil applies the NotNil predicate on the "message" field.
+func MessageNotNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotNull(FieldMessage))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// ResponseMessageEQ applies the EQ predicate on the "response_message" field.
+func ResponseMessageEQ(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldResponseMessage, v))
+}
+
+// ResponseMessageNEQ applies the NEQ predicate on the "response_message" field.
+func ResponseMessageNEQ(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldResponseMessage, v))
+}
+
+// ResponseMessageIn applies the In predicate on the "response_message" field.
+func ResponseMessageIn(vs ...string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldResponseMessage, vs...))
+}
+
+// ResponseMessageNotIn applies the NotIn predicate on the "response_message" field.
+func ResponseMessageNotIn(vs ...string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldResponseMessage, vs...))
+}
+
+// ResponseMessageGT applies the GT predicate on the "response_message" field.
+func ResponseMessageGT(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGT(FieldResponseMessage, v))
+}
+
+// ResponseMessageGTE applies the GTE predicate on the "response_message" field.
+func ResponseMessageGTE(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGTE(FieldResponseMessage, v))
+}
+
+// ResponseMessageLT applies the LT predicate on the "response_message" field.
+func ResponseMessageLT(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLT(FieldResponseMessage, v))
+}
+
+// ResponseMessageLTE applies the LTE predicate on the "response_message" field.
+func ResponseMessageLTE(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLTE(FieldResponseMessage, v))
+}
+
+// ResponseMessageContains applies the Contains predicate on the "response_message" field.
+func ResponseMessageContains(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldContains(FieldResponseMessage, v))
+}
+
+// ResponseMessageHasPrefix applies the HasPrefix predicate on the "response_message" field.
+func ResponseMessageHasPrefix(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldHasPrefix(FieldResponseMessage, v))
+}
+
+// ResponseMessageHasSuffix applies the HasSuffix predicate on the "response_message" field.
+func ResponseMessageHasSuffix(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldHasSuffix(FieldResponseMessage, v))
+}
+
+// ResponseMessageIsNil applies the IsNil predicate on the "response_message" field.
+func ResponseMessageIsNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIsNull(FieldResponseMessage))
+}
+
+// ResponseMessageNotNil applies the NotNil predicate on the "response_message" field.
+func ResponseMessageNotNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotNull(FieldResponseMessage))
+}
+
+// ResponseMessageEqualFold applies the EqualFold predicate on the "response_message" field.
+func ResponseMessageEqualFold(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEqualFold(FieldResponseMessage, v))
+}
+
+// ResponseMessageContainsFold applies the ContainsFold predicate on the "response_message" field.
+func ResponseMessageContainsFold(v string) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldContainsFold(FieldResponseMessage, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLTE(FieldDueAt, v))
+}
+
+// DueAtIsNil applies the IsNil predicate on the "due_at" field.
+func DueAtIsNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIsNull(FieldDueAt))
+}
+
+// DueAtNotNil applies the NotNil predicate on the "due_at" field.
+func DueAtNotNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotNull(FieldDueAt))
+}
+
+// RespondedAtEQ applies the EQ predicate on the "responded_at" field.
+func RespondedAtEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtNEQ applies the NEQ predicate on the "responded_at" field.
+func RespondedAtNEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtIn applies the In predicate on the "responded_at" field.
+func RespondedAtIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtNotIn applies the NotIn predicate on the "responded_at" field.
+func RespondedAtNotIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtGT applies the GT predicate on the "responded_at" field.
+func RespondedAtGT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGT(FieldRespondedAt, v))
+}
+
+// RespondedAtGTE applies the GTE predicate on the "responded_at" field.
+func RespondedAtGTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGTE(FieldRespondedAt, v))
+}
+
+// RespondedAtLT applies the LT predicate on the "responded_at" field.
+func RespondedAtLT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLT(FieldRespondedAt, v))
+}
+
+// RespondedAtLTE applies the LTE predicate on the "responded_at" field.
+func RespondedAtLTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLTE(FieldRespondedAt, v))
+}
+
+// RespondedAtIsNil applies the IsNil predicate on the "responded_at" field.
+func RespondedAtIsNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIsNull(FieldRespondedAt))
+}
+
+// RespondedAtNotNil applies the NotNil predicate on the "responded_at" field.
+func RespondedAtNotNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotNull(FieldRespondedAt))
+}
+
+// HandedOverAtEQ applies the EQ predicate on the "handed_over_at" field.
+func HandedOverAtEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldHandedOverAt, v))
+}
+
+// HandedOverAtNEQ applies the NEQ predicate on the "handed_over_at" field.
+func HandedOverAtNEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldHandedOverAt, v))
+}
+
+// HandedOverAtIn applies the In predicate on the "handed_over_at" field.
+func HandedOverAtIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldHandedOverAt, vs...))
+}
+
+// HandedOverAtNotIn applies the NotIn predicate on the "handed_over_at" field.
+func HandedOverAtNotIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldHandedOverAt, vs...))
+}
+
+// HandedOverAtGT applies the GT predicate on the "handed_over_at" field.
+func HandedOverAtGT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGT(FieldHandedOverAt, v))
+}
+
+// HandedOverAtGTE applies the GTE predicate on the "handed_over_at" field.
+func HandedOverAtGTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGTE(FieldHandedOverAt, v))
+}
+
+// HandedOverAtLT applies the LT predicate on the "handed_over_at" field.
+func HandedOverAtLT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLT(FieldHandedOverAt, v))
+}
+
+// HandedOverAtLTE applies the LTE predicate on the "handed_over_at" field.
+func HandedOverAtLTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLTE(FieldHandedOverAt, v))
+}
+
+// HandedOverAtIsNil applies the IsNil predicate on the "handed_over_at" field.
+func HandedOverAtIsNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIsNull(FieldHandedOverAt))
+}
+
+// HandedOverAtNotNil applies the NotNil predicate on the "handed_over_at" field.
+func HandedOverAtNotNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotNull(FieldHandedOverAt))
+}
+
+// ReturnedAtEQ applies the EQ predicate on the "returned_at" field.
+func ReturnedAtEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldReturnedAt, v))
+}
+
+// ReturnedAtNEQ applies the NEQ predicate on the "returned_at" field.
+func ReturnedAtNEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldReturnedAt, v))
+}
+
+// ReturnedAtIn applies the In predicate on the "returned_at" field.
+func ReturnedAtIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldReturnedAt, vs...))
+}
+
+// ReturnedAtNotIn applies the NotIn predicate on the "returned_at" field.
+func ReturnedAtNotIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldReturnedAt, vs...))
+}
+
+// ReturnedAtGT applies the GT predicate on the "returned_at" field.
+func ReturnedAtGT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGT(FieldReturnedAt, v))
+}
+
+// ReturnedAtGTE applies the GTE predicate on the "returned_at" field.
+func ReturnedAtGTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGTE(FieldReturnedAt, v))
+}
+
+// ReturnedAtLT applies the LT predicate on the "returned_at" field.
+func ReturnedAtLT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLT(FieldReturnedAt, v))
+}
+
+// ReturnedAtLTE applies the LTE predicate on the "returned_at" field.
+func ReturnedAtLTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLTE(FieldReturnedAt, v))
+}
+
+// ReturnedAtIsNil applies the IsNil predicate on the "returned_at" field.
+func ReturnedAtIsNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIsNull(FieldReturnedAt))
+}
+
+// ReturnedAtNotNil applies the NotNil predicate on the "returned_at" field.
+func ReturnedAtNotNil() predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotNull(FieldReturnedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasRequester applies the HasEdge predicate on the "requester" edge.
+func HasRequester() predicate.BorrowRequest {
+	return predicate.BorrowRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RequesterTable, RequesterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRequesterWith applies the HasEdge predicate on the "requester" edge with a given conditions (other predicates).
+func HasRequesterWith(preds ...predicate.User) predicate.BorrowRequest {
+	return predicate.BorrowRequest(func(s *sql.Selector) {
+		step := newRequesterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.BorrowRequest {
+	return predicate.BorrowRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.BorrowRequest {
+	return predicate.BorrowRequest(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBook applies the HasEdge predicate on the "book" edge.
+func HasBook() predicate.BorrowRequest {
+	return predicate.BorrowRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BookTable, BookColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookWith applies the HasEdge predicate on the "book" edge with a given conditions (other predicates).
+func HasBookWith(preds ...predicate.Book) predicate.BorrowRequest {
+	return predicate.BorrowRequest(func(s *sql.Selector) {
+		step := newBookStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLoan applies the HasEdge predicate on the "loan" edge.
+func HasLoan() predicate.BorrowRequest {
+	return predicate.BorrowRequest(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, LoanTable, LoanColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoanWith applies the HasEdge predicate on the "loan" edge with a given conditions (other predicates).
+func HasLoanWith(preds ...predicate.Loan) predicate.BorrowRequest {
+	return predicate.BorrowRequest(func(s *sql.Selector) {
+		step := newLoanStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BorrowRequest) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BorrowRequest) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BorrowRequest) predicate.BorrowRequest {
+	return predicate.BorrowRequest(sql.NotPredicates(p))
+}
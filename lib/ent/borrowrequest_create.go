@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// BorrowRequestCreate is the builder for creating a BorrowRequest entity.
+type BorrowRequestCreate struct {
+	config
+	mutation *BorrowRequestMutation
+	hooks    []Hook
+}
+
+// SetStatus sets the "status" field.
+func (_c *BorrowRequestCreate) SetStatus(v borrowrequest.Status) *BorrowRequestCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableStatus(v *borrowrequest.Status) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetMessage sets the "message" field.
+func (_c *BorrowRequestCreate) SetMessage(v string) *BorrowRequestCreate {
+	_c.mutation.SetMessage(v)
+	return _c
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableMessage(v *string) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetMessage(*v)
+	}
+	return _c
+}
+
+// SetResponseMessage sets the "response_message" field.
+func (_c *BorrowRequestCreate) SetResponseMessage(v string) *BorrowRequestCreate {
+	_c.mutation.SetResponseMessage(v)
+	return _c
+}
+
+// SetNillableResponseMessage sets the "response_message" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableResponseMessage(v *string) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetResponseMessage(*v)
+	}
+	return _c
+}
+
+// SetDueAt sets the "due_at" field.
+func (_c *BorrowRequestCreate) SetDueAt(v time.Time) *BorrowRequestCreate {
+	_c.mutation.SetDueAt(v)
+	return _c
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableDueAt(v *time.Time) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetDueAt(*v)
+	}
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *BorrowRequestCreate) SetRespondedAt(v time.Time) *BorrowRequestCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableRespondedAt(v *time.Time) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetHandedOverAt sets the "handed_over_at" field.
+func (_c *BorrowRequestCreate) SetHandedOverAt(v time.Time) *BorrowRequestCreate {
+	_c.mutation.SetHandedOverAt(v)
+	return _c
+}
+
+// SetNillableHandedOverAt sets the "handed_over_at" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableHandedOverAt(v *time.Time) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetHandedOverAt(*v)
+	}
+	return _c
+}
+
+// SetReturnedAt sets the "returned_at" field.
+func (_c *BorrowRequestCreate) SetReturnedAt(v time.Time) *BorrowRequestCreate {
+	_c.mutation.SetReturnedAt(v)
+	return _c
+}
+
+// SetNillableReturnedAt sets the "returned_at" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableReturnedAt(v *time.Time) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetReturnedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BorrowRequestCreate) SetCreatedAt(v time.Time) *BorrowRequestCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableCreatedAt(v *time.Time) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BorrowRequestCreate) SetUpdatedAt(v time.Time) *BorrowRequestCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableUpdatedAt(v *time.Time) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BorrowRequestCreate) SetID(v uuid.UUID) *BorrowRequestCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableID(v *uuid.UUID) *BorrowRequestCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetRequesterID sets the "requester" edge to the User entity by ID.
+func (_c *BorrowRequestCreate) SetRequesterID(id uuid.UUID) *BorrowRequestCreate {
+	_c.mutation.SetRequesterID(id)
+	return _c
+}
+
+// SetRequester sets the "requester" edge to the User entity.
+func (_c *BorrowRequestCreate) SetRequester(v *User) *BorrowRequestCreate {
+	return _c.SetRequesterID(v.ID)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *BorrowRequestCreate) SetOwnerID(id uuid.UUID) *BorrowRequestCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *BorrowRequestCreate) SetOwner(v *User) *BorrowRequestCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_c *BorrowRequestCreate) SetBookID(id uuid.UUID) *BorrowRequestCreate {
+	_c.mutation.SetBookID(id)
+	return _c
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_c *BorrowRequestCreate) SetBook(v *Book) *BorrowRequestCreate {
+	return _c.SetBookID(v.ID)
+}
+
+// SetLoanID sets the "loan" edge to the Loan entity by ID.
+func (_c *BorrowRequestCreate) SetLoanID(id uuid.UUID) *BorrowRequestCreate {
+	_c.mutation.SetLoanID(id)
+	return _c
+}
+
+// SetNillableLoanID sets the "loan" edge to the Loan entity by ID if the given value is not nil.
+func (_c *BorrowRequestCreate) SetNillableLoanID(id *uuid.UUID) *BorrowRequestCreate {
+	if id != nil {
+		_c = _c.SetLoanID(*id)
+	}
+	return _c
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (_c *BorrowRequestCreate) SetLoan(v *Loan) *BorrowRequestCreate {
+	return _c.SetLoanID(v.ID)
+}
+
+// Mutation returns the BorrowRequestMutation object of the builder.
+func (_c *BorrowRequestCreate) Mutation() *BorrowRequestMutation {
+	return _c.mutation
+}
+
+// Save creates the BorrowRequest in the database.
+func (_c *BorrowRequestCreate) Save(ctx context.Context) (*BorrowRequest, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BorrowRequestCreate) SaveX(ctx context.Context) *BorrowRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BorrowRequestCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BorrowRequestCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BorrowRequestCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := borrowrequest.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := borrowrequest.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := borrowrequest.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := borrowrequest.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BorrowRequestCreate) check() error {
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "BorrowRequest.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := borrowrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BorrowRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "BorrowRequest.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "BorrowRequest.updated_at"`)}
+	}
+	if len(_c.mutation.RequesterIDs()) == 0 {
+		return &ValidationError{Name: "requester", err: errors.New(`ent: missing required edge "BorrowRequest.requester"`)}
+	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "BorrowRequest.owner"`)}
+	}
+	if len(_c.mutation.BookIDs()) == 0 {
+		return &ValidationError{Name: "book", err: errors.New(`ent: missing required edge "BorrowRequest.book"`)}
+	}
+	return nil
+}
+
+func (_c *BorrowRequestCreate) sqlSave(ctx context.Context) (*BorrowRequest, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BorrowRequestCreate) createSpec() (*BorrowRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BorrowRequest{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(borrowrequest.Table, sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(borrowrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Message(); ok {
+		_spec.SetField(borrowrequest.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := _c.mutation.ResponseMessage(); ok {
+		_spec.SetField(borrowrequest.FieldResponseMessage, field.TypeString, value)
+		_node.ResponseMessage = value
+	}
+	if value, ok := _c.mutation.DueAt(); ok {
+		_spec.SetField(borrowrequest.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(borrowrequest.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if value, ok := _c.mutation.HandedOverAt(); ok {
+		_spec.SetField(borrowrequest.FieldHandedOverAt, field.TypeTime, value)
+		_node.HandedOverAt = &value
+	}
+	if value, ok := _c.mutation.ReturnedAt(); ok {
+		_spec.SetField(borrowrequest.FieldReturnedAt, field.TypeTime, value)
+		_node.ReturnedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(borrowrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(borrowrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.RequesterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.RequesterTable,
+			Columns: []string{borrowrequest.RequesterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_sent_borrow_requests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.OwnerTable,
+			Columns: []string{borrowrequest.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_received_borrow_requests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.BookTable,
+			Columns: []string{borrowrequest.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.book_borrow_requests = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   borrowrequest.LoanTable,
+			Columns: []string{borrowrequest.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.borrow_request_loan = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BorrowRequestCreateBulk is the builder for creating many BorrowRequest entities in bulk.
+type BorrowRequestCreateBulk struct {
+	config
+	err      error
+	builders []*BorrowRequestCreate
+}
+
+// Save creates the BorrowRequest entities in the database.
+func (_c *BorrowRequestCreateBulk) Save(ctx context.Context) ([]*BorrowRequest, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BorrowRequest, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BorrowRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BorrowRequestCreateBulk) SaveX(ctx context.Context) []*BorrowRequest {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BorrowRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BorrowRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
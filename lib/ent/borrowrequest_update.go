@@ -0,0 +1,990 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// BorrowRequestUpdate is the builder for updating BorrowRequest entities.
+type BorrowRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BorrowRequestMutation
+}
+
+// Where appends a list predicates to the BorrowRequestUpdate builder.
+func (_u *BorrowRequestUpdate) Where(ps ...predicate.BorrowRequest) *BorrowRequestUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *BorrowRequestUpdate) SetStatus(v borrowrequest.Status) *BorrowRequestUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BorrowRequestUpdate) SetNillableStatus(v *borrowrequest.Status) *BorrowRequestUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *BorrowRequestUpdate) SetMessage(v string) *BorrowRequestUpdate {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *BorrowRequestUpdate) SetNillableMessage(v *string) *BorrowRequestUpdate {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *BorrowRequestUpdate) ClearMessage() *BorrowRequestUpdate {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetResponseMessage sets the "response_message" field.
+func (_u *BorrowRequestUpdate) SetResponseMessage(v string) *BorrowRequestUpdate {
+	_u.mutation.SetResponseMessage(v)
+	return _u
+}
+
+// SetNillableResponseMessage sets the "response_message" field if the given value is not nil.
+func (_u *BorrowRequestUpdate) SetNillableResponseMessage(v *string) *BorrowRequestUpdate {
+	if v != nil {
+		_u.SetResponseMessage(*v)
+	}
+	return _u
+}
+
+// ClearResponseMessage clears the value of the "response_message" field.
+func (_u *BorrowRequestUpdate) ClearResponseMessage() *BorrowRequestUpdate {
+	_u.mutation.ClearResponseMessage()
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *BorrowRequestUpdate) SetDueAt(v time.Time) *BorrowRequestUpdate {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *BorrowRequestUpdate) SetNillableDueAt(v *time.Time) *BorrowRequestUpdate {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *BorrowRequestUpdate) ClearDueAt() *BorrowRequestUpdate {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *BorrowRequestUpdate) SetRespondedAt(v time.Time) *BorrowRequestUpdate {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *BorrowRequestUpdate) SetNillableRespondedAt(v *time.Time) *BorrowRequestUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *BorrowRequestUpdate) ClearRespondedAt() *BorrowRequestUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetHandedOverAt sets the "handed_over_at" field.
+func (_u *BorrowRequestUpdate) SetHandedOverAt(v time.Time) *BorrowRequestUpdate {
+	_u.mutation.SetHandedOverAt(v)
+	return _u
+}
+
+// SetNillableHandedOverAt sets the "handed_over_at" field if the given value is not nil.
+func (_u *BorrowRequestUpdate) SetNillableHandedOverAt(v *time.Time) *BorrowRequestUpdate {
+	if v != nil {
+		_u.SetHandedOverAt(*v)
+	}
+	return _u
+}
+
+// ClearHandedOverAt clears the value of the "handed_over_at" field.
+func (_u *BorrowRequestUpdate) ClearHandedOverAt() *BorrowRequestUpdate {
+	_u.mutation.ClearHandedOverAt()
+	return _u
+}
+
+// SetReturnedAt sets the "returned_at" field.
+func (_u *BorrowRequestUpdate) SetReturnedAt(v time.Time) *BorrowRequestUpdate {
+	_u.mutation.SetReturnedAt(v)
+	return _u
+}
+
+// SetNillableReturnedAt sets the "returned_at" field if the given value is not nil.
+func (_u *BorrowRequestUpdate) SetNillableReturnedAt(v *time.Time) *BorrowRequestUpdate {
+	if v != nil {
+		_u.SetReturnedAt(*v)
+	}
+	return _u
+}
+
+// ClearReturnedAt clears the value of the "returned_at" field.
+func (_u *BorrowRequestUpdate) ClearReturnedAt() *BorrowRequestUpdate {
+	_u.mutation.ClearReturnedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BorrowRequestUpdate) SetUpdatedAt(v time.Time) *BorrowRequestUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRequesterID sets the "requester" edge to the User entity by ID.
+func (_u *BorrowRequestUpdate) SetRequesterID(id uuid.UUID) *BorrowRequestUpdate {
+	_u.mutation.SetRequesterID(id)
+	return _u
+}
+
+// SetRequester sets the "requester" edge to the User entity.
+func (_u *BorrowRequestUpdate) SetRequester(v *User) *BorrowRequestUpdate {
+	return _u.SetRequesterID(v.ID)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *BorrowRequestUpdate) SetOwnerID(id uuid.UUID) *BorrowRequestUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *BorrowRequestUpdate) SetOwner(v *User) *BorrowRequestUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *BorrowRequestUpdate) SetBookID(id uuid.UUID) *BorrowRequestUpdate {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *BorrowRequestUpdate) SetBook(v *Book) *BorrowRequestUpdate {
+	return _u.SetBookID(v.ID)
+}
+
+// SetLoanID sets the "loan" edge to the Loan entity by ID.
+func (_u *BorrowRequestUpdate) SetLoanID(id uuid.UUID) *BorrowRequestUpdate {
+	_u.mutation.SetLoanID(id)
+	return _u
+}
+
+// SetNillableLoanID sets the "loan" edge to the Loan entity by ID if the given value is not nil.
+func (_u *BorrowRequestUpdate) SetNillableLoanID(id *uuid.UUID) *BorrowRequestUpdate {
+	if id != nil {
+		_u = _u.SetLoanID(*id)
+	}
+	return _u
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (_u *BorrowRequestUpdate) SetLoan(v *Loan) *BorrowRequestUpdate {
+	return _u.SetLoanID(v.ID)
+}
+
+// Mutation returns the BorrowRequestMutation object of the builder.
+func (_u *BorrowRequestUpdate) Mutation() *BorrowRequestMutation {
+	return _u.mutation
+}
+
+// ClearRequester clears the "requester" edge to the User entity.
+func (_u *BorrowRequestUpdate) ClearRequester() *BorrowRequestUpdate {
+	_u.mutation.ClearRequester()
+	return _u
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *BorrowRequestUpdate) ClearOwner() *BorrowRequestUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *BorrowRequestUpdate) ClearBook() *BorrowRequestUpdate {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (_u *BorrowRequestUpdate) ClearLoan() *BorrowRequestUpdate {
+	_u.mutation.ClearLoan()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BorrowRequestUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BorrowRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BorrowRequestUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BorrowRequestUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BorrowRequestUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := borrowrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BorrowRequestUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := borrowrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BorrowRequest.status": %w`, err)}
+		}
+	}
+	if _u.mutation.RequesterCleared() && len(_u.mutation.RequesterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BorrowRequest.requester"`)
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BorrowRequest.owner"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BorrowRequest.book"`)
+	}
+	return nil
+}
+
+func (_u *BorrowRequestUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(borrowrequest.Table, borrowrequest.Columns, sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(borrowrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(borrowrequest.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(borrowrequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResponseMessage(); ok {
+		_spec.SetField(borrowrequest.FieldResponseMessage, field.TypeString, value)
+	}
+	if _u.mutation.ResponseMessageCleared() {
+		_spec.ClearField(borrowrequest.FieldResponseMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(borrowrequest.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(borrowrequest.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(borrowrequest.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(borrowrequest.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HandedOverAt(); ok {
+		_spec.SetField(borrowrequest.FieldHandedOverAt, field.TypeTime, value)
+	}
+	if _u.mutation.HandedOverAtCleared() {
+		_spec.ClearField(borrowrequest.FieldHandedOverAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReturnedAt(); ok {
+		_spec.SetField(borrowrequest.FieldReturnedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReturnedAtCleared() {
+		_spec.ClearField(borrowrequest.FieldReturnedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(borrowrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RequesterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.RequesterTable,
+			Columns: []string{borrowrequest.RequesterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequesterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.RequesterTable,
+			Columns: []string{borrowrequest.RequesterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.OwnerTable,
+			Columns: []string{borrowrequest.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.OwnerTable,
+			Columns: []string{borrowrequest.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.BookTable,
+			Columns: []string{borrowrequest.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.BookTable,
+			Columns: []string{borrowrequest.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   borrowrequest.LoanTable,
+			Columns: []string{borrowrequest.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   borrowrequest.LoanTable,
+			Columns: []string{borrowrequest.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{borrowrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BorrowRequestUpdateOne is the builder for updating a single BorrowRequest entity.
+type BorrowRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BorrowRequestMutation
+}
+
+// SetStatus sets the "status" field.
+func (_u *BorrowRequestUpdateOne) SetStatus(v borrowrequest.Status) *BorrowRequestUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *BorrowRequestUpdateOne) SetNillableStatus(v *borrowrequest.Status) *BorrowRequestUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetMessage sets the "message" field.
+func (_u *BorrowRequestUpdateOne) SetMessage(v string) *BorrowRequestUpdateOne {
+	_u.mutation.SetMessage(v)
+	return _u
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (_u *BorrowRequestUpdateOne) SetNillableMessage(v *string) *BorrowRequestUpdateOne {
+	if v != nil {
+		_u.SetMessage(*v)
+	}
+	return _u
+}
+
+// ClearMessage clears the value of the "message" field.
+func (_u *BorrowRequestUpdateOne) ClearMessage() *BorrowRequestUpdateOne {
+	_u.mutation.ClearMessage()
+	return _u
+}
+
+// SetResponseMessage sets the "response_message" field.
+func (_u *BorrowRequestUpdateOne) SetResponseMessage(v string) *BorrowRequestUpdateOne {
+	_u.mutation.SetResponseMessage(v)
+	return _u
+}
+
+// SetNillableResponseMessage sets the "response_message" field if the given value is not nil.
+func (_u *BorrowRequestUpdateOne) SetNillableResponseMessage(v *string) *BorrowRequestUpdateOne {
+	if v != nil {
+		_u.SetResponseMessage(*v)
+	}
+	return _u
+}
+
+// ClearResponseMessage clears the value of the "response_message" field.
+func (_u *BorrowRequestUpdateOne) ClearResponseMessage() *BorrowRequestUpdateOne {
+	_u.mutation.ClearResponseMessage()
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *BorrowRequestUpdateOne) SetDueAt(v time.Time) *BorrowRequestUpdateOne {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *BorrowRequestUpdateOne) SetNillableDueAt(v *time.Time) *BorrowRequestUpdateOne {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *BorrowRequestUpdateOne) ClearDueAt() *BorrowRequestUpdateOne {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *BorrowRequestUpdateOne) SetRespondedAt(v time.Time) *BorrowRequestUpdateOne {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *BorrowRequestUpdateOne) SetNillableRespondedAt(v *time.Time) *BorrowRequestUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *BorrowRequestUpdateOne) ClearRespondedAt() *BorrowRequestUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetHandedOverAt sets the "handed_over_at" field.
+func (_u *BorrowRequestUpdateOne) SetHandedOverAt(v time.Time) *BorrowRequestUpdateOne {
+	_u.mutation.SetHandedOverAt(v)
+	return _u
+}
+
+// SetNillableHandedOverAt sets the "handed_over_at" field if the given value is not nil.
+func (_u *BorrowRequestUpdateOne) SetNillableHandedOverAt(v *time.Time) *BorrowRequestUpdateOne {
+	if v != nil {
+		_u.SetHandedOverAt(*v)
+	}
+	return _u
+}
+
+// ClearHandedOverAt clears the value of the "handed_over_at" field.
+func (_u *BorrowRequestUpdateOne) ClearHandedOverAt() *BorrowRequestUpdateOne {
+	_u.mutation.ClearHandedOverAt()
+	return _u
+}
+
+// SetReturnedAt sets the "returned_at" field.
+func (_u *BorrowRequestUpdateOne) SetReturnedAt(v time.Time) *BorrowRequestUpdateOne {
+	_u.mutation.SetReturnedAt(v)
+	return _u
+}
+
+// SetNillableReturnedAt sets the "returned_at" field if the given value is not nil.
+func (_u *BorrowRequestUpdateOne) SetNillableReturnedAt(v *time.Time) *BorrowRequestUpdateOne {
+	if v != nil {
+		_u.SetReturnedAt(*v)
+	}
+	return _u
+}
+
+// ClearReturnedAt clears the value of the "returned_at" field.
+func (_u *BorrowRequestUpdateOne) ClearReturnedAt() *BorrowRequestUpdateOne {
+	_u.mutation.ClearReturnedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BorrowRequestUpdateOne) SetUpdatedAt(v time.Time) *BorrowRequestUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetRequesterID sets the "requester" edge to the User entity by ID.
+func (_u *BorrowRequestUpdateOne) SetRequesterID(id uuid.UUID) *BorrowRequestUpdateOne {
+	_u.mutation.SetRequesterID(id)
+	return _u
+}
+
+// SetRequester sets the "requester" edge to the User entity.
+func (_u *BorrowRequestUpdateOne) SetRequester(v *User) *BorrowRequestUpdateOne {
+	return _u.SetRequesterID(v.ID)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *BorrowRequestUpdateOne) SetOwnerID(id uuid.UUID) *BorrowRequestUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *BorrowRequestUpdateOne) SetOwner(v *User) *BorrowRequestUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *BorrowRequestUpdateOne) SetBookID(id uuid.UUID) *BorrowRequestUpdateOne {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *BorrowRequestUpdateOne) SetBook(v *Book) *BorrowRequestUpdateOne {
+	return _u.SetBookID(v.ID)
+}
+
+// SetLoanID sets the "loan" edge to the Loan entity by ID.
+func (_u *BorrowRequestUpdateOne) SetLoanID(id uuid.UUID) *BorrowRequestUpdateOne {
+	_u.mutation.SetLoanID(id)
+	return _u
+}
+
+// SetNillableLoanID sets the "loan" edge to the Loan entity by ID if the given value is not nil.
+func (_u *BorrowRequestUpdateOne) SetNillableLoanID(id *uuid.UUID) *BorrowRequestUpdateOne {
+	if id != nil {
+		_u = _u.SetLoanID(*id)
+	}
+	return _u
+}
+
+// SetLoan sets the "loan" edge to the Loan entity.
+func (_u *BorrowRequestUpdateOne) SetLoan(v *Loan) *BorrowRequestUpdateOne {
+	return _u.SetLoanID(v.ID)
+}
+
+// Mutation returns the BorrowRequestMutation object of the builder.
+func (_u *BorrowRequestUpdateOne) Mutation() *BorrowRequestMutation {
+	return _u.mutation
+}
+
+// ClearRequester clears the "requester" edge to the User entity.
+func (_u *BorrowRequestUpdateOne) ClearRequester() *BorrowRequestUpdateOne {
+	_u.mutation.ClearRequester()
+	return _u
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *BorrowRequestUpdateOne) ClearOwner() *BorrowRequestUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *BorrowRequestUpdateOne) ClearBook() *BorrowRequestUpdateOne {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (_u *BorrowRequestUpdateOne) ClearLoan() *BorrowRequestUpdateOne {
+	_u.mutation.ClearLoan()
+	return _u
+}
+
+// Where appends a list predicates to the BorrowRequestUpdate builder.
+func (_u *BorrowRequestUpdateOne) Where(ps ...predicate.BorrowRequest) *BorrowRequestUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BorrowRequestUpdateOne) Select(field string, fields ...string) *BorrowRequestUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BorrowRequest entity.
+func (_u *BorrowRequestUpdateOne) Save(ctx context.Context) (*BorrowRequest, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BorrowRequestUpdateOne) SaveX(ctx context.Context) *BorrowRequest {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BorrowRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BorrowRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BorrowRequestUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := borrowrequest.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BorrowRequestUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := borrowrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "BorrowRequest.status": %w`, err)}
+		}
+	}
+	if _u.mutation.RequesterCleared() && len(_u.mutation.RequesterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BorrowRequest.requester"`)
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BorrowRequest.owner"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BorrowRequest.book"`)
+	}
+	return nil
+}
+
+func (_u *BorrowRequestUpdateOne) sqlSave(ctx context.Context) (_node *BorrowRequest, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(borrowrequest.Table, borrowrequest.Columns, sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BorrowRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, borrowrequest.FieldID)
+		for _, f := range fields {
+			if !borrowrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != borrowrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(borrowrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Message(); ok {
+		_spec.SetField(borrowrequest.FieldMessage, field.TypeString, value)
+	}
+	if _u.mutation.MessageCleared() {
+		_spec.ClearField(borrowrequest.FieldMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.ResponseMessage(); ok {
+		_spec.SetField(borrowrequest.FieldResponseMessage, field.TypeString, value)
+	}
+	if _u.mutation.ResponseMessageCleared() {
+		_spec.ClearField(borrowrequest.FieldResponseMessage, field.TypeString)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(borrowrequest.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(borrowrequest.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(borrowrequest.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(borrowrequest.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.HandedOverAt(); ok {
+		_spec.SetField(borrowrequest.FieldHandedOverAt, field.TypeTime, value)
+	}
+	if _u.mutation.HandedOverAtCleared() {
+		_spec.ClearField(borrowrequest.FieldHandedOverAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReturnedAt(); ok {
+		_spec.SetField(borrowrequest.FieldReturnedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReturnedAtCleared() {
+		_spec.ClearField(borrowrequest.FieldReturnedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(borrowrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RequesterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.RequesterTable,
+			Columns: []string{borrowrequest.RequesterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RequesterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.RequesterTable,
+			Columns: []string{borrowrequest.RequesterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.OwnerTable,
+			Columns: []string{borrowrequest.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.OwnerTable,
+			Columns: []string{borrowrequest.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.BookTable,
+			Columns: []string{borrowrequest.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   borrowrequest.BookTable,
+			Columns: []string{borrowrequest.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LoanCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   borrowrequest.LoanTable,
+			Columns: []string{borrowrequest.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LoanIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   borrowrequest.LoanTable,
+			Columns: []string{borrowrequest.LoanColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BorrowRequest{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{borrowrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
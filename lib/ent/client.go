@@ -18,11 +18,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/adminapikey"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/datamigration"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/emailverification"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingreminder"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
 )
 
 // Client is the client that holds all ent builders.
@@ -34,16 +49,46 @@ type Client struct {
 	AdminAPIKey *AdminAPIKeyClient
 	// Book is the client for interacting with the Book builders.
 	Book *BookClient
+	// BookCatalog is the client for interacting with the BookCatalog builders.
+	BookCatalog *BookCatalogClient
+	// BookNote is the client for interacting with the BookNote builders.
+	BookNote *BookNoteClient
+	// BookStatusHistory is the client for interacting with the BookStatusHistory builders.
+	BookStatusHistory *BookStatusHistoryClient
 	// Bookmark is the client for interacting with the Bookmark builders.
 	Bookmark *BookmarkClient
+	// BorrowRequest is the client for interacting with the BorrowRequest builders.
+	BorrowRequest *BorrowRequestClient
+	// DataMigration is the client for interacting with the DataMigration builders.
+	DataMigration *DataMigrationClient
 	// EmailVerification is the client for interacting with the EmailVerification builders.
 	EmailVerification *EmailVerificationClient
+	// Library is the client for interacting with the Library builders.
+	Library *LibraryClient
+	// LibraryInvitation is the client for interacting with the LibraryInvitation builders.
+	LibraryInvitation *LibraryInvitationClient
+	// LibraryMember is the client for interacting with the LibraryMember builders.
+	LibraryMember *LibraryMemberClient
+	// Loan is the client for interacting with the Loan builders.
+	Loan *LoanClient
+	// ReadingGoal is the client for interacting with the ReadingGoal builders.
+	ReadingGoal *ReadingGoalClient
 	// ReadingReminder is the client for interacting with the ReadingReminder builders.
 	ReadingReminder *ReadingReminderClient
+	// ReadingSession is the client for interacting with the ReadingSession builders.
+	ReadingSession *ReadingSessionClient
 	// Review is the client for interacting with the Review builders.
 	Review *ReviewClient
+	// Shelf is the client for interacting with the Shelf builders.
+	Shelf *ShelfClient
+	// ShelfBook is the client for interacting with the ShelfBook builders.
+	ShelfBook *ShelfBookClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WishlistItem is the client for interacting with the WishlistItem builders.
+	WishlistItem *WishlistItemClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -57,11 +102,26 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AdminAPIKey = NewAdminAPIKeyClient(c.config)
 	c.Book = NewBookClient(c.config)
+	c.BookCatalog = NewBookCatalogClient(c.config)
+	c.BookNote = NewBookNoteClient(c.config)
+	c.BookStatusHistory = NewBookStatusHistoryClient(c.config)
 	c.Bookmark = NewBookmarkClient(c.config)
+	c.BorrowRequest = NewBorrowRequestClient(c.config)
+	c.DataMigration = NewDataMigrationClient(c.config)
 	c.EmailVerification = NewEmailVerificationClient(c.config)
+	c.Library = NewLibraryClient(c.config)
+	c.LibraryInvitation = NewLibraryInvitationClient(c.config)
+	c.LibraryMember = NewLibraryMemberClient(c.config)
+	c.Loan = NewLoanClient(c.config)
+	c.ReadingGoal = NewReadingGoalClient(c.config)
 	c.ReadingReminder = NewReadingReminderClient(c.config)
+	c.ReadingSession = NewReadingSessionClient(c.config)
 	c.Review = NewReviewClient(c.config)
+	c.Shelf = NewShelfClient(c.config)
+	c.ShelfBook = NewShelfBookClient(c.config)
+	c.Tag = NewTagClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.WishlistItem = NewWishlistItemClient(c.config)
 }
 
 type (
@@ -156,11 +216,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		config:            cfg,
 		AdminAPIKey:       NewAdminAPIKeyClient(cfg),
 		Book:              NewBookClient(cfg),
+		BookCatalog:       NewBookCatalogClient(cfg),
+		BookNote:          NewBookNoteClient(cfg),
+		BookStatusHistory: NewBookStatusHistoryClient(cfg),
 		Bookmark:          NewBookmarkClient(cfg),
+		BorrowRequest:     NewBorrowRequestClient(cfg),
+		DataMigration:     NewDataMigrationClient(cfg),
 		EmailVerification: NewEmailVerificationClient(cfg),
+		Library:           NewLibraryClient(cfg),
+		LibraryInvitation: NewLibraryInvitationClient(cfg),
+		LibraryMember:     NewLibraryMemberClient(cfg),
+		Loan:              NewLoanClient(cfg),
+		ReadingGoal:       NewReadingGoalClient(cfg),
 		ReadingReminder:   NewReadingReminderClient(cfg),
+		ReadingSession:    NewReadingSessionClient(cfg),
 		Review:            NewReviewClient(cfg),
+		Shelf:             NewShelfClient(cfg),
+		ShelfBook:         NewShelfBookClient(cfg),
+		Tag:               NewTagClient(cfg),
 		User:              NewUserClient(cfg),
+		WishlistItem:      NewWishlistItemClient(cfg),
 	}, nil
 }
 
@@ -182,11 +257,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		config:            cfg,
 		AdminAPIKey:       NewAdminAPIKeyClient(cfg),
 		Book:              NewBookClient(cfg),
+		BookCatalog:       NewBookCatalogClient(cfg),
+		BookNote:          NewBookNoteClient(cfg),
+		BookStatusHistory: NewBookStatusHistoryClient(cfg),
 		Bookmark:          NewBookmarkClient(cfg),
+		BorrowRequest:     NewBorrowRequestClient(cfg),
+		DataMigration:     NewDataMigrationClient(cfg),
 		EmailVerification: NewEmailVerificationClient(cfg),
+		Library:           NewLibraryClient(cfg),
+		LibraryInvitation: NewLibraryInvitationClient(cfg),
+		LibraryMember:     NewLibraryMemberClient(cfg),
+		Loan:              NewLoanClient(cfg),
+		ReadingGoal:       NewReadingGoalClient(cfg),
 		ReadingReminder:   NewReadingReminderClient(cfg),
+		ReadingSession:    NewReadingSessionClient(cfg),
 		Review:            NewReviewClient(cfg),
+		Shelf:             NewShelfClient(cfg),
+		ShelfBook:         NewShelfBookClient(cfg),
+		Tag:               NewTagClient(cfg),
 		User:              NewUserClient(cfg),
+		WishlistItem:      NewWishlistItemClient(cfg),
 	}, nil
 }
 
@@ -216,8 +306,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.AdminAPIKey, c.Book, c.Bookmark, c.EmailVerification, c.ReadingReminder,
-		c.Review, c.User,
+		c.AdminAPIKey, c.Book, c.BookCatalog, c.BookNote, c.BookStatusHistory,
+		c.Bookmark, c.BorrowRequest, c.DataMigration, c.EmailVerification, c.Library,
+		c.LibraryInvitation, c.LibraryMember, c.Loan, c.ReadingGoal, c.ReadingReminder,
+		c.ReadingSession, c.Review, c.Shelf, c.ShelfBook, c.Tag, c.User,
+		c.WishlistItem,
 	} {
 		n.Use(hooks...)
 	}
@@ -227,8 +320,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.AdminAPIKey, c.Book, c.Bookmark, c.EmailVerification, c.ReadingReminder,
-		c.Review, c.User,
+		c.AdminAPIKey, c.Book, c.BookCatalog, c.BookNote, c.BookStatusHistory,
+		c.Bookmark, c.BorrowRequest, c.DataMigration, c.EmailVerification, c.Library,
+		c.LibraryInvitation, c.LibraryMember, c.Loan, c.ReadingGoal, c.ReadingReminder,
+		c.ReadingSession, c.Review, c.Shelf, c.ShelfBook, c.Tag, c.User,
+		c.WishlistItem,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -241,16 +337,46 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AdminAPIKey.mutate(ctx, m)
 	case *BookMutation:
 		return c.Book.mutate(ctx, m)
+	case *BookCatalogMutation:
+		return c.BookCatalog.mutate(ctx, m)
+	case *BookNoteMutation:
+		return c.BookNote.mutate(ctx, m)
+	case *BookStatusHistoryMutation:
+		return c.BookStatusHistory.mutate(ctx, m)
 	case *BookmarkMutation:
 		return c.Bookmark.mutate(ctx, m)
+	case *BorrowRequestMutation:
+		return c.BorrowRequest.mutate(ctx, m)
+	case *DataMigrationMutation:
+		return c.DataMigration.mutate(ctx, m)
 	case *EmailVerificationMutation:
 		return c.EmailVerification.mutate(ctx, m)
+	case *LibraryMutation:
+		return c.Library.mutate(ctx, m)
+	case *LibraryInvitationMutation:
+		return c.LibraryInvitation.mutate(ctx, m)
+	case *LibraryMemberMutation:
+		return c.LibraryMember.mutate(ctx, m)
+	case *LoanMutation:
+		return c.Loan.mutate(ctx, m)
+	case *ReadingGoalMutation:
+		return c.ReadingGoal.mutate(ctx, m)
 	case *ReadingReminderMutation:
 		return c.ReadingReminder.mutate(ctx, m)
+	case *ReadingSessionMutation:
+		return c.ReadingSession.mutate(ctx, m)
 	case *ReviewMutation:
 		return c.Review.mutate(ctx, m)
+	case *ShelfMutation:
+		return c.Shelf.mutate(ctx, m)
+	case *ShelfBookMutation:
+		return c.ShelfBook.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *WishlistItemMutation:
+		return c.WishlistItem.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -513,6 +639,38 @@ func (c *BookClient) QueryOwner(_m *Book) *UserQuery {
 	return query
 }
 
+// QueryCatalog queries the catalog edge of a Book.
+func (c *BookClient) QueryCatalog(_m *Book) *BookCatalogQuery {
+	query := (&BookCatalogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(bookcatalog.Table, bookcatalog.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, book.CatalogTable, book.CatalogColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLibrary queries the library edge of a Book.
+func (c *BookClient) QueryLibrary(_m *Book) *LibraryQuery {
+	query := (&LibraryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(library.Table, library.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, book.LibraryTable, book.LibraryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryReviews queries the reviews edge of a Book.
 func (c *BookClient) QueryReviews(_m *Book) *ReviewQuery {
 	query := (&ReviewClient{config: c.config}).Query()
@@ -545,6 +703,134 @@ func (c *BookClient) QueryBookmarks(_m *Book) *BookmarkQuery {
 	return query
 }
 
+// QueryReadingSessions queries the reading_sessions edge of a Book.
+func (c *BookClient) QueryReadingSessions(_m *Book) *ReadingSessionQuery {
+	query := (&ReadingSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(readingsession.Table, readingsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.ReadingSessionsTable, book.ReadingSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStatusHistories queries the status_histories edge of a Book.
+func (c *BookClient) QueryStatusHistories(_m *Book) *BookStatusHistoryQuery {
+	query := (&BookStatusHistoryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(bookstatushistory.Table, bookstatushistory.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.StatusHistoriesTable, book.StatusHistoriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShelves queries the shelves edge of a Book.
+func (c *BookClient) QueryShelves(_m *Book) *ShelfQuery {
+	query := (&ShelfClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(shelf.Table, shelf.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, book.ShelvesTable, book.ShelvesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Book.
+func (c *BookClient) QueryTags(_m *Book) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, book.TagsTable, book.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryNotes queries the notes edge of a Book.
+func (c *BookClient) QueryNotes(_m *Book) *BookNoteQuery {
+	query := (&BookNoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(booknote.Table, booknote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.NotesTable, book.NotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLoans queries the loans edge of a Book.
+func (c *BookClient) QueryLoans(_m *Book) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.LoansTable, book.LoansColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBorrowRequests queries the borrow_requests edge of a Book.
+func (c *BookClient) QueryBorrowRequests(_m *Book) *BorrowRequestQuery {
+	query := (&BorrowRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(borrowrequest.Table, borrowrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, book.BorrowRequestsTable, book.BorrowRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShelfBooks queries the shelf_books edge of a Book.
+func (c *BookClient) QueryShelfBooks(_m *Book) *ShelfBookQuery {
+	query := (&ShelfBookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(book.Table, book.FieldID, id),
+			sqlgraph.To(shelfbook.Table, shelfbook.BookColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, book.ShelfBooksTable, book.ShelfBooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *BookClient) Hooks() []Hook {
 	return c.hooks.Book
@@ -570,107 +856,107 @@ func (c *BookClient) mutate(ctx context.Context, m *BookMutation) (Value, error)
 	}
 }
 
-// BookmarkClient is a client for the Bookmark schema.
-type BookmarkClient struct {
+// BookCatalogClient is a client for the BookCatalog schema.
+type BookCatalogClient struct {
 	config
 }
 
-// NewBookmarkClient returns a client for the Bookmark from the given config.
-func NewBookmarkClient(c config) *BookmarkClient {
-	return &BookmarkClient{config: c}
+// NewBookCatalogClient returns a client for the BookCatalog from the given config.
+func NewBookCatalogClient(c config) *BookCatalogClient {
+	return &BookCatalogClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `bookmark.Hooks(f(g(h())))`.
-func (c *BookmarkClient) Use(hooks ...Hook) {
-	c.hooks.Bookmark = append(c.hooks.Bookmark, hooks...)
+// A call to `Use(f, g, h)` equals to `bookcatalog.Hooks(f(g(h())))`.
+func (c *BookCatalogClient) Use(hooks ...Hook) {
+	c.hooks.BookCatalog = append(c.hooks.BookCatalog, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `bookmark.Intercept(f(g(h())))`.
-func (c *BookmarkClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Bookmark = append(c.inters.Bookmark, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `bookcatalog.Intercept(f(g(h())))`.
+func (c *BookCatalogClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BookCatalog = append(c.inters.BookCatalog, interceptors...)
 }
 
-// Create returns a builder for creating a Bookmark entity.
-func (c *BookmarkClient) Create() *BookmarkCreate {
-	mutation := newBookmarkMutation(c.config, OpCreate)
-	return &BookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BookCatalog entity.
+func (c *BookCatalogClient) Create() *BookCatalogCreate {
+	mutation := newBookCatalogMutation(c.config, OpCreate)
+	return &BookCatalogCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Bookmark entities.
-func (c *BookmarkClient) CreateBulk(builders ...*BookmarkCreate) *BookmarkCreateBulk {
-	return &BookmarkCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BookCatalog entities.
+func (c *BookCatalogClient) CreateBulk(builders ...*BookCatalogCreate) *BookCatalogCreateBulk {
+	return &BookCatalogCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BookmarkClient) MapCreateBulk(slice any, setFunc func(*BookmarkCreate, int)) *BookmarkCreateBulk {
+func (c *BookCatalogClient) MapCreateBulk(slice any, setFunc func(*BookCatalogCreate, int)) *BookCatalogCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BookmarkCreateBulk{err: fmt.Errorf("calling to BookmarkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BookCatalogCreateBulk{err: fmt.Errorf("calling to BookCatalogClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BookmarkCreate, rv.Len())
+	builders := make([]*BookCatalogCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BookmarkCreateBulk{config: c.config, builders: builders}
+	return &BookCatalogCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Bookmark.
-func (c *BookmarkClient) Update() *BookmarkUpdate {
-	mutation := newBookmarkMutation(c.config, OpUpdate)
-	return &BookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BookCatalog.
+func (c *BookCatalogClient) Update() *BookCatalogUpdate {
+	mutation := newBookCatalogMutation(c.config, OpUpdate)
+	return &BookCatalogUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BookmarkClient) UpdateOne(_m *Bookmark) *BookmarkUpdateOne {
-	mutation := newBookmarkMutation(c.config, OpUpdateOne, withBookmark(_m))
-	return &BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BookCatalogClient) UpdateOne(_m *BookCatalog) *BookCatalogUpdateOne {
+	mutation := newBookCatalogMutation(c.config, OpUpdateOne, withBookCatalog(_m))
+	return &BookCatalogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BookmarkClient) UpdateOneID(id uuid.UUID) *BookmarkUpdateOne {
-	mutation := newBookmarkMutation(c.config, OpUpdateOne, withBookmarkID(id))
-	return &BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BookCatalogClient) UpdateOneID(id uuid.UUID) *BookCatalogUpdateOne {
+	mutation := newBookCatalogMutation(c.config, OpUpdateOne, withBookCatalogID(id))
+	return &BookCatalogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Bookmark.
-func (c *BookmarkClient) Delete() *BookmarkDelete {
-	mutation := newBookmarkMutation(c.config, OpDelete)
-	return &BookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BookCatalog.
+func (c *BookCatalogClient) Delete() *BookCatalogDelete {
+	mutation := newBookCatalogMutation(c.config, OpDelete)
+	return &BookCatalogDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BookmarkClient) DeleteOne(_m *Bookmark) *BookmarkDeleteOne {
+func (c *BookCatalogClient) DeleteOne(_m *BookCatalog) *BookCatalogDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BookmarkClient) DeleteOneID(id uuid.UUID) *BookmarkDeleteOne {
-	builder := c.Delete().Where(bookmark.ID(id))
+func (c *BookCatalogClient) DeleteOneID(id uuid.UUID) *BookCatalogDeleteOne {
+	builder := c.Delete().Where(bookcatalog.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BookmarkDeleteOne{builder}
+	return &BookCatalogDeleteOne{builder}
 }
 
-// Query returns a query builder for Bookmark.
-func (c *BookmarkClient) Query() *BookmarkQuery {
-	return &BookmarkQuery{
+// Query returns a query builder for BookCatalog.
+func (c *BookCatalogClient) Query() *BookCatalogQuery {
+	return &BookCatalogQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBookmark},
+		ctx:    &QueryContext{Type: TypeBookCatalog},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Bookmark entity by its id.
-func (c *BookmarkClient) Get(ctx context.Context, id uuid.UUID) (*Bookmark, error) {
-	return c.Query().Where(bookmark.ID(id)).Only(ctx)
+// Get returns a BookCatalog entity by its id.
+func (c *BookCatalogClient) Get(ctx context.Context, id uuid.UUID) (*BookCatalog, error) {
+	return c.Query().Where(bookcatalog.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BookmarkClient) GetX(ctx context.Context, id uuid.UUID) *Bookmark {
+func (c *BookCatalogClient) GetX(ctx context.Context, id uuid.UUID) *BookCatalog {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -678,15 +964,15 @@ func (c *BookmarkClient) GetX(ctx context.Context, id uuid.UUID) *Bookmark {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Bookmark.
-func (c *BookmarkClient) QueryOwner(_m *Bookmark) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryCopies queries the copies edge of a BookCatalog.
+func (c *BookCatalogClient) QueryCopies(_m *BookCatalog) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(bookmark.Table, bookmark.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, bookmark.OwnerTable, bookmark.OwnerColumn),
+			sqlgraph.From(bookcatalog.Table, bookcatalog.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bookcatalog.CopiesTable, bookcatalog.CopiesColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -694,15 +980,15 @@ func (c *BookmarkClient) QueryOwner(_m *Bookmark) *UserQuery {
 	return query
 }
 
-// QueryBook queries the book edge of a Bookmark.
-func (c *BookmarkClient) QueryBook(_m *Bookmark) *BookQuery {
-	query := (&BookClient{config: c.config}).Query()
+// QueryReviews queries the reviews edge of a BookCatalog.
+func (c *BookCatalogClient) QueryReviews(_m *BookCatalog) *ReviewQuery {
+	query := (&ReviewClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(bookmark.Table, bookmark.FieldID, id),
-			sqlgraph.To(book.Table, book.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, bookmark.BookTable, bookmark.BookColumn),
+			sqlgraph.From(bookcatalog.Table, bookcatalog.FieldID, id),
+			sqlgraph.To(review.Table, review.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, bookcatalog.ReviewsTable, bookcatalog.ReviewsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -711,131 +997,131 @@ func (c *BookmarkClient) QueryBook(_m *Bookmark) *BookQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *BookmarkClient) Hooks() []Hook {
-	return c.hooks.Bookmark
+func (c *BookCatalogClient) Hooks() []Hook {
+	return c.hooks.BookCatalog
 }
 
 // Interceptors returns the client interceptors.
-func (c *BookmarkClient) Interceptors() []Interceptor {
-	return c.inters.Bookmark
+func (c *BookCatalogClient) Interceptors() []Interceptor {
+	return c.inters.BookCatalog
 }
 
-func (c *BookmarkClient) mutate(ctx context.Context, m *BookmarkMutation) (Value, error) {
+func (c *BookCatalogClient) mutate(ctx context.Context, m *BookCatalogMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BookCatalogCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BookCatalogUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BookCatalogUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BookCatalogDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Bookmark mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BookCatalog mutation op: %q", m.Op())
 	}
 }
 
-// EmailVerificationClient is a client for the EmailVerification schema.
-type EmailVerificationClient struct {
+// BookNoteClient is a client for the BookNote schema.
+type BookNoteClient struct {
 	config
 }
 
-// NewEmailVerificationClient returns a client for the EmailVerification from the given config.
-func NewEmailVerificationClient(c config) *EmailVerificationClient {
-	return &EmailVerificationClient{config: c}
+// NewBookNoteClient returns a client for the BookNote from the given config.
+func NewBookNoteClient(c config) *BookNoteClient {
+	return &BookNoteClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emailverification.Hooks(f(g(h())))`.
-func (c *EmailVerificationClient) Use(hooks ...Hook) {
-	c.hooks.EmailVerification = append(c.hooks.EmailVerification, hooks...)
+// A call to `Use(f, g, h)` equals to `booknote.Hooks(f(g(h())))`.
+func (c *BookNoteClient) Use(hooks ...Hook) {
+	c.hooks.BookNote = append(c.hooks.BookNote, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emailverification.Intercept(f(g(h())))`.
-func (c *EmailVerificationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailVerification = append(c.inters.EmailVerification, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `booknote.Intercept(f(g(h())))`.
+func (c *BookNoteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BookNote = append(c.inters.BookNote, interceptors...)
 }
 
-// Create returns a builder for creating a EmailVerification entity.
-func (c *EmailVerificationClient) Create() *EmailVerificationCreate {
-	mutation := newEmailVerificationMutation(c.config, OpCreate)
-	return &EmailVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BookNote entity.
+func (c *BookNoteClient) Create() *BookNoteCreate {
+	mutation := newBookNoteMutation(c.config, OpCreate)
+	return &BookNoteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailVerification entities.
-func (c *EmailVerificationClient) CreateBulk(builders ...*EmailVerificationCreate) *EmailVerificationCreateBulk {
-	return &EmailVerificationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BookNote entities.
+func (c *BookNoteClient) CreateBulk(builders ...*BookNoteCreate) *BookNoteCreateBulk {
+	return &BookNoteCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailVerificationClient) MapCreateBulk(slice any, setFunc func(*EmailVerificationCreate, int)) *EmailVerificationCreateBulk {
+func (c *BookNoteClient) MapCreateBulk(slice any, setFunc func(*BookNoteCreate, int)) *BookNoteCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailVerificationCreateBulk{err: fmt.Errorf("calling to EmailVerificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BookNoteCreateBulk{err: fmt.Errorf("calling to BookNoteClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailVerificationCreate, rv.Len())
+	builders := make([]*BookNoteCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailVerificationCreateBulk{config: c.config, builders: builders}
+	return &BookNoteCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailVerification.
-func (c *EmailVerificationClient) Update() *EmailVerificationUpdate {
-	mutation := newEmailVerificationMutation(c.config, OpUpdate)
-	return &EmailVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BookNote.
+func (c *BookNoteClient) Update() *BookNoteUpdate {
+	mutation := newBookNoteMutation(c.config, OpUpdate)
+	return &BookNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailVerificationClient) UpdateOne(_m *EmailVerification) *EmailVerificationUpdateOne {
-	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerification(_m))
-	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BookNoteClient) UpdateOne(_m *BookNote) *BookNoteUpdateOne {
+	mutation := newBookNoteMutation(c.config, OpUpdateOne, withBookNote(_m))
+	return &BookNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailVerificationClient) UpdateOneID(id uuid.UUID) *EmailVerificationUpdateOne {
-	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerificationID(id))
-	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BookNoteClient) UpdateOneID(id uuid.UUID) *BookNoteUpdateOne {
+	mutation := newBookNoteMutation(c.config, OpUpdateOne, withBookNoteID(id))
+	return &BookNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailVerification.
-func (c *EmailVerificationClient) Delete() *EmailVerificationDelete {
-	mutation := newEmailVerificationMutation(c.config, OpDelete)
-	return &EmailVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BookNote.
+func (c *BookNoteClient) Delete() *BookNoteDelete {
+	mutation := newBookNoteMutation(c.config, OpDelete)
+	return &BookNoteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailVerificationClient) DeleteOne(_m *EmailVerification) *EmailVerificationDeleteOne {
+func (c *BookNoteClient) DeleteOne(_m *BookNote) *BookNoteDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailVerificationClient) DeleteOneID(id uuid.UUID) *EmailVerificationDeleteOne {
-	builder := c.Delete().Where(emailverification.ID(id))
+func (c *BookNoteClient) DeleteOneID(id uuid.UUID) *BookNoteDeleteOne {
+	builder := c.Delete().Where(booknote.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailVerificationDeleteOne{builder}
+	return &BookNoteDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailVerification.
-func (c *EmailVerificationClient) Query() *EmailVerificationQuery {
-	return &EmailVerificationQuery{
+// Query returns a query builder for BookNote.
+func (c *BookNoteClient) Query() *BookNoteQuery {
+	return &BookNoteQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailVerification},
+		ctx:    &QueryContext{Type: TypeBookNote},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailVerification entity by its id.
-func (c *EmailVerificationClient) Get(ctx context.Context, id uuid.UUID) (*EmailVerification, error) {
-	return c.Query().Where(emailverification.ID(id)).Only(ctx)
+// Get returns a BookNote entity by its id.
+func (c *BookNoteClient) Get(ctx context.Context, id uuid.UUID) (*BookNote, error) {
+	return c.Query().Where(booknote.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailVerificationClient) GetX(ctx context.Context, id uuid.UUID) *EmailVerification {
+func (c *BookNoteClient) GetX(ctx context.Context, id uuid.UUID) *BookNote {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -843,132 +1129,2277 @@ func (c *EmailVerificationClient) GetX(ctx context.Context, id uuid.UUID) *Email
 	return obj
 }
 
-// Hooks returns the client hooks.
-func (c *EmailVerificationClient) Hooks() []Hook {
-	return c.hooks.EmailVerification
-}
-
-// Interceptors returns the client interceptors.
-func (c *EmailVerificationClient) Interceptors() []Interceptor {
-	return c.inters.EmailVerification
+// QueryOwner queries the owner edge of a BookNote.
+func (c *BookNoteClient) QueryOwner(_m *BookNote) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booknote.Table, booknote.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, booknote.OwnerTable, booknote.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
 }
 
-func (c *EmailVerificationClient) mutate(ctx context.Context, m *EmailVerificationMutation) (Value, error) {
-	switch m.Op() {
-	case OpCreate:
-		return (&EmailVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
-	case OpUpdate:
-		return (&EmailVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+// QueryBook queries the book edge of a BookNote.
+func (c *BookNoteClient) QueryBook(_m *BookNote) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(booknote.Table, booknote.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, booknote.BookTable, booknote.BookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BookNoteClient) Hooks() []Hook {
+	return c.hooks.BookNote
+}
+
+// Interceptors returns the client interceptors.
+func (c *BookNoteClient) Interceptors() []Interceptor {
+	return c.inters.BookNote
+}
+
+func (c *BookNoteClient) mutate(ctx context.Context, m *BookNoteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BookNoteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BookNoteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BookNoteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BookNoteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BookNote mutation op: %q", m.Op())
+	}
+}
+
+// BookStatusHistoryClient is a client for the BookStatusHistory schema.
+type BookStatusHistoryClient struct {
+	config
+}
+
+// NewBookStatusHistoryClient returns a client for the BookStatusHistory from the given config.
+func NewBookStatusHistoryClient(c config) *BookStatusHistoryClient {
+	return &BookStatusHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bookstatushistory.Hooks(f(g(h())))`.
+func (c *BookStatusHistoryClient) Use(hooks ...Hook) {
+	c.hooks.BookStatusHistory = append(c.hooks.BookStatusHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bookstatushistory.Intercept(f(g(h())))`.
+func (c *BookStatusHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BookStatusHistory = append(c.inters.BookStatusHistory, interceptors...)
+}
+
+// Create returns a builder for creating a BookStatusHistory entity.
+func (c *BookStatusHistoryClient) Create() *BookStatusHistoryCreate {
+	mutation := newBookStatusHistoryMutation(c.config, OpCreate)
+	return &BookStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BookStatusHistory entities.
+func (c *BookStatusHistoryClient) CreateBulk(builders ...*BookStatusHistoryCreate) *BookStatusHistoryCreateBulk {
+	return &BookStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BookStatusHistoryClient) MapCreateBulk(slice any, setFunc func(*BookStatusHistoryCreate, int)) *BookStatusHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BookStatusHistoryCreateBulk{err: fmt.Errorf("calling to BookStatusHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BookStatusHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BookStatusHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BookStatusHistory.
+func (c *BookStatusHistoryClient) Update() *BookStatusHistoryUpdate {
+	mutation := newBookStatusHistoryMutation(c.config, OpUpdate)
+	return &BookStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BookStatusHistoryClient) UpdateOne(_m *BookStatusHistory) *BookStatusHistoryUpdateOne {
+	mutation := newBookStatusHistoryMutation(c.config, OpUpdateOne, withBookStatusHistory(_m))
+	return &BookStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BookStatusHistoryClient) UpdateOneID(id uuid.UUID) *BookStatusHistoryUpdateOne {
+	mutation := newBookStatusHistoryMutation(c.config, OpUpdateOne, withBookStatusHistoryID(id))
+	return &BookStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BookStatusHistory.
+func (c *BookStatusHistoryClient) Delete() *BookStatusHistoryDelete {
+	mutation := newBookStatusHistoryMutation(c.config, OpDelete)
+	return &BookStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BookStatusHistoryClient) DeleteOne(_m *BookStatusHistory) *BookStatusHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BookStatusHistoryClient) DeleteOneID(id uuid.UUID) *BookStatusHistoryDeleteOne {
+	builder := c.Delete().Where(bookstatushistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BookStatusHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for BookStatusHistory.
+func (c *BookStatusHistoryClient) Query() *BookStatusHistoryQuery {
+	return &BookStatusHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBookStatusHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BookStatusHistory entity by its id.
+func (c *BookStatusHistoryClient) Get(ctx context.Context, id uuid.UUID) (*BookStatusHistory, error) {
+	return c.Query().Where(bookstatushistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BookStatusHistoryClient) GetX(ctx context.Context, id uuid.UUID) *BookStatusHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBook queries the book edge of a BookStatusHistory.
+func (c *BookStatusHistoryClient) QueryBook(_m *BookStatusHistory) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bookstatushistory.Table, bookstatushistory.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bookstatushistory.BookTable, bookstatushistory.BookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BookStatusHistoryClient) Hooks() []Hook {
+	return c.hooks.BookStatusHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *BookStatusHistoryClient) Interceptors() []Interceptor {
+	return c.inters.BookStatusHistory
+}
+
+func (c *BookStatusHistoryClient) mutate(ctx context.Context, m *BookStatusHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BookStatusHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BookStatusHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BookStatusHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BookStatusHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BookStatusHistory mutation op: %q", m.Op())
+	}
+}
+
+// BookmarkClient is a client for the Bookmark schema.
+type BookmarkClient struct {
+	config
+}
+
+// NewBookmarkClient returns a client for the Bookmark from the given config.
+func NewBookmarkClient(c config) *BookmarkClient {
+	return &BookmarkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `bookmark.Hooks(f(g(h())))`.
+func (c *BookmarkClient) Use(hooks ...Hook) {
+	c.hooks.Bookmark = append(c.hooks.Bookmark, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `bookmark.Intercept(f(g(h())))`.
+func (c *BookmarkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Bookmark = append(c.inters.Bookmark, interceptors...)
+}
+
+// Create returns a builder for creating a Bookmark entity.
+func (c *BookmarkClient) Create() *BookmarkCreate {
+	mutation := newBookmarkMutation(c.config, OpCreate)
+	return &BookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Bookmark entities.
+func (c *BookmarkClient) CreateBulk(builders ...*BookmarkCreate) *BookmarkCreateBulk {
+	return &BookmarkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BookmarkClient) MapCreateBulk(slice any, setFunc func(*BookmarkCreate, int)) *BookmarkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BookmarkCreateBulk{err: fmt.Errorf("calling to BookmarkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BookmarkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BookmarkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Bookmark.
+func (c *BookmarkClient) Update() *BookmarkUpdate {
+	mutation := newBookmarkMutation(c.config, OpUpdate)
+	return &BookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BookmarkClient) UpdateOne(_m *Bookmark) *BookmarkUpdateOne {
+	mutation := newBookmarkMutation(c.config, OpUpdateOne, withBookmark(_m))
+	return &BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BookmarkClient) UpdateOneID(id uuid.UUID) *BookmarkUpdateOne {
+	mutation := newBookmarkMutation(c.config, OpUpdateOne, withBookmarkID(id))
+	return &BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Bookmark.
+func (c *BookmarkClient) Delete() *BookmarkDelete {
+	mutation := newBookmarkMutation(c.config, OpDelete)
+	return &BookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BookmarkClient) DeleteOne(_m *Bookmark) *BookmarkDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BookmarkClient) DeleteOneID(id uuid.UUID) *BookmarkDeleteOne {
+	builder := c.Delete().Where(bookmark.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BookmarkDeleteOne{builder}
+}
+
+// Query returns a query builder for Bookmark.
+func (c *BookmarkClient) Query() *BookmarkQuery {
+	return &BookmarkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBookmark},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Bookmark entity by its id.
+func (c *BookmarkClient) Get(ctx context.Context, id uuid.UUID) (*Bookmark, error) {
+	return c.Query().Where(bookmark.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BookmarkClient) GetX(ctx context.Context, id uuid.UUID) *Bookmark {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Bookmark.
+func (c *BookmarkClient) QueryOwner(_m *Bookmark) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bookmark.Table, bookmark.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bookmark.OwnerTable, bookmark.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBook queries the book edge of a Bookmark.
+func (c *BookmarkClient) QueryBook(_m *Bookmark) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(bookmark.Table, bookmark.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, bookmark.BookTable, bookmark.BookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BookmarkClient) Hooks() []Hook {
+	return c.hooks.Bookmark
+}
+
+// Interceptors returns the client interceptors.
+func (c *BookmarkClient) Interceptors() []Interceptor {
+	return c.inters.Bookmark
+}
+
+func (c *BookmarkClient) mutate(ctx context.Context, m *BookmarkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BookmarkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BookmarkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BookmarkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BookmarkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Bookmark mutation op: %q", m.Op())
+	}
+}
+
+// BorrowRequestClient is a client for the BorrowRequest schema.
+type BorrowRequestClient struct {
+	config
+}
+
+// NewBorrowRequestClient returns a client for the BorrowRequest from the given config.
+func NewBorrowRequestClient(c config) *BorrowRequestClient {
+	return &BorrowRequestClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `borrowrequest.Hooks(f(g(h())))`.
+func (c *BorrowRequestClient) Use(hooks ...Hook) {
+	c.hooks.BorrowRequest = append(c.hooks.BorrowRequest, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `borrowrequest.Intercept(f(g(h())))`.
+func (c *BorrowRequestClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BorrowRequest = append(c.inters.BorrowRequest, interceptors...)
+}
+
+// Create returns a builder for creating a BorrowRequest entity.
+func (c *BorrowRequestClient) Create() *BorrowRequestCreate {
+	mutation := newBorrowRequestMutation(c.config, OpCreate)
+	return &BorrowRequestCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BorrowRequest entities.
+func (c *BorrowRequestClient) CreateBulk(builders ...*BorrowRequestCreate) *BorrowRequestCreateBulk {
+	return &BorrowRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BorrowRequestClient) MapCreateBulk(slice any, setFunc func(*BorrowRequestCreate, int)) *BorrowRequestCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BorrowRequestCreateBulk{err: fmt.Errorf("calling to BorrowRequestClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BorrowRequestCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BorrowRequestCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BorrowRequest.
+func (c *BorrowRequestClient) Update() *BorrowRequestUpdate {
+	mutation := newBorrowRequestMutation(c.config, OpUpdate)
+	return &BorrowRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BorrowRequestClient) UpdateOne(_m *BorrowRequest) *BorrowRequestUpdateOne {
+	mutation := newBorrowRequestMutation(c.config, OpUpdateOne, withBorrowRequest(_m))
+	return &BorrowRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BorrowRequestClient) UpdateOneID(id uuid.UUID) *BorrowRequestUpdateOne {
+	mutation := newBorrowRequestMutation(c.config, OpUpdateOne, withBorrowRequestID(id))
+	return &BorrowRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BorrowRequest.
+func (c *BorrowRequestClient) Delete() *BorrowRequestDelete {
+	mutation := newBorrowRequestMutation(c.config, OpDelete)
+	return &BorrowRequestDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BorrowRequestClient) DeleteOne(_m *BorrowRequest) *BorrowRequestDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BorrowRequestClient) DeleteOneID(id uuid.UUID) *BorrowRequestDeleteOne {
+	builder := c.Delete().Where(borrowrequest.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BorrowRequestDeleteOne{builder}
+}
+
+// Query returns a query builder for BorrowRequest.
+func (c *BorrowRequestClient) Query() *BorrowRequestQuery {
+	return &BorrowRequestQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBorrowRequest},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BorrowRequest entity by its id.
+func (c *BorrowRequestClient) Get(ctx context.Context, id uuid.UUID) (*BorrowRequest, error) {
+	return c.Query().Where(borrowrequest.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BorrowRequestClient) GetX(ctx context.Context, id uuid.UUID) *BorrowRequest {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRequester queries the requester edge of a BorrowRequest.
+func (c *BorrowRequestClient) QueryRequester(_m *BorrowRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(borrowrequest.Table, borrowrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, borrowrequest.RequesterTable, borrowrequest.RequesterColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOwner queries the owner edge of a BorrowRequest.
+func (c *BorrowRequestClient) QueryOwner(_m *BorrowRequest) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(borrowrequest.Table, borrowrequest.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, borrowrequest.OwnerTable, borrowrequest.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBook queries the book edge of a BorrowRequest.
+func (c *BorrowRequestClient) QueryBook(_m *BorrowRequest) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(borrowrequest.Table, borrowrequest.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, borrowrequest.BookTable, borrowrequest.BookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLoan queries the loan edge of a BorrowRequest.
+func (c *BorrowRequestClient) QueryLoan(_m *BorrowRequest) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(borrowrequest.Table, borrowrequest.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, borrowrequest.LoanTable, borrowrequest.LoanColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BorrowRequestClient) Hooks() []Hook {
+	return c.hooks.BorrowRequest
+}
+
+// Interceptors returns the client interceptors.
+func (c *BorrowRequestClient) Interceptors() []Interceptor {
+	return c.inters.BorrowRequest
+}
+
+func (c *BorrowRequestClient) mutate(ctx context.Context, m *BorrowRequestMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BorrowRequestCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BorrowRequestUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BorrowRequestUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BorrowRequestDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BorrowRequest mutation op: %q", m.Op())
+	}
+}
+
+// DataMigrationClient is a client for the DataMigration schema.
+type DataMigrationClient struct {
+	config
+}
+
+// NewDataMigrationClient returns a client for the DataMigration from the given config.
+func NewDataMigrationClient(c config) *DataMigrationClient {
+	return &DataMigrationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `datamigration.Hooks(f(g(h())))`.
+func (c *DataMigrationClient) Use(hooks ...Hook) {
+	c.hooks.DataMigration = append(c.hooks.DataMigration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `datamigration.Intercept(f(g(h())))`.
+func (c *DataMigrationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DataMigration = append(c.inters.DataMigration, interceptors...)
+}
+
+// Create returns a builder for creating a DataMigration entity.
+func (c *DataMigrationClient) Create() *DataMigrationCreate {
+	mutation := newDataMigrationMutation(c.config, OpCreate)
+	return &DataMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DataMigration entities.
+func (c *DataMigrationClient) CreateBulk(builders ...*DataMigrationCreate) *DataMigrationCreateBulk {
+	return &DataMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DataMigrationClient) MapCreateBulk(slice any, setFunc func(*DataMigrationCreate, int)) *DataMigrationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DataMigrationCreateBulk{err: fmt.Errorf("calling to DataMigrationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DataMigrationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DataMigrationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DataMigration.
+func (c *DataMigrationClient) Update() *DataMigrationUpdate {
+	mutation := newDataMigrationMutation(c.config, OpUpdate)
+	return &DataMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DataMigrationClient) UpdateOne(_m *DataMigration) *DataMigrationUpdateOne {
+	mutation := newDataMigrationMutation(c.config, OpUpdateOne, withDataMigration(_m))
+	return &DataMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DataMigrationClient) UpdateOneID(id uuid.UUID) *DataMigrationUpdateOne {
+	mutation := newDataMigrationMutation(c.config, OpUpdateOne, withDataMigrationID(id))
+	return &DataMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DataMigration.
+func (c *DataMigrationClient) Delete() *DataMigrationDelete {
+	mutation := newDataMigrationMutation(c.config, OpDelete)
+	return &DataMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DataMigrationClient) DeleteOne(_m *DataMigration) *DataMigrationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DataMigrationClient) DeleteOneID(id uuid.UUID) *DataMigrationDeleteOne {
+	builder := c.Delete().Where(datamigration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DataMigrationDeleteOne{builder}
+}
+
+// Query returns a query builder for DataMigration.
+func (c *DataMigrationClient) Query() *DataMigrationQuery {
+	return &DataMigrationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDataMigration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DataMigration entity by its id.
+func (c *DataMigrationClient) Get(ctx context.Context, id uuid.UUID) (*DataMigration, error) {
+	return c.Query().Where(datamigration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DataMigrationClient) GetX(ctx context.Context, id uuid.UUID) *DataMigration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DataMigrationClient) Hooks() []Hook {
+	return c.hooks.DataMigration
+}
+
+// Interceptors returns the client interceptors.
+func (c *DataMigrationClient) Interceptors() []Interceptor {
+	return c.inters.DataMigration
+}
+
+func (c *DataMigrationClient) mutate(ctx context.Context, m *DataMigrationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DataMigrationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DataMigrationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DataMigrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DataMigrationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DataMigration mutation op: %q", m.Op())
+	}
+}
+
+// EmailVerificationClient is a client for the EmailVerification schema.
+type EmailVerificationClient struct {
+	config
+}
+
+// NewEmailVerificationClient returns a client for the EmailVerification from the given config.
+func NewEmailVerificationClient(c config) *EmailVerificationClient {
+	return &EmailVerificationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `emailverification.Hooks(f(g(h())))`.
+func (c *EmailVerificationClient) Use(hooks ...Hook) {
+	c.hooks.EmailVerification = append(c.hooks.EmailVerification, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `emailverification.Intercept(f(g(h())))`.
+func (c *EmailVerificationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailVerification = append(c.inters.EmailVerification, interceptors...)
+}
+
+// Create returns a builder for creating a EmailVerification entity.
+func (c *EmailVerificationClient) Create() *EmailVerificationCreate {
+	mutation := newEmailVerificationMutation(c.config, OpCreate)
+	return &EmailVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EmailVerification entities.
+func (c *EmailVerificationClient) CreateBulk(builders ...*EmailVerificationCreate) *EmailVerificationCreateBulk {
+	return &EmailVerificationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EmailVerificationClient) MapCreateBulk(slice any, setFunc func(*EmailVerificationCreate, int)) *EmailVerificationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EmailVerificationCreateBulk{err: fmt.Errorf("calling to EmailVerificationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EmailVerificationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EmailVerificationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EmailVerification.
+func (c *EmailVerificationClient) Update() *EmailVerificationUpdate {
+	mutation := newEmailVerificationMutation(c.config, OpUpdate)
+	return &EmailVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EmailVerificationClient) UpdateOne(_m *EmailVerification) *EmailVerificationUpdateOne {
+	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerification(_m))
+	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EmailVerificationClient) UpdateOneID(id uuid.UUID) *EmailVerificationUpdateOne {
+	mutation := newEmailVerificationMutation(c.config, OpUpdateOne, withEmailVerificationID(id))
+	return &EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EmailVerification.
+func (c *EmailVerificationClient) Delete() *EmailVerificationDelete {
+	mutation := newEmailVerificationMutation(c.config, OpDelete)
+	return &EmailVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EmailVerificationClient) DeleteOne(_m *EmailVerification) *EmailVerificationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EmailVerificationClient) DeleteOneID(id uuid.UUID) *EmailVerificationDeleteOne {
+	builder := c.Delete().Where(emailverification.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EmailVerificationDeleteOne{builder}
+}
+
+// Query returns a query builder for EmailVerification.
+func (c *EmailVerificationClient) Query() *EmailVerificationQuery {
+	return &EmailVerificationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEmailVerification},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EmailVerification entity by its id.
+func (c *EmailVerificationClient) Get(ctx context.Context, id uuid.UUID) (*EmailVerification, error) {
+	return c.Query().Where(emailverification.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EmailVerificationClient) GetX(ctx context.Context, id uuid.UUID) *EmailVerification {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EmailVerificationClient) Hooks() []Hook {
+	return c.hooks.EmailVerification
+}
+
+// Interceptors returns the client interceptors.
+func (c *EmailVerificationClient) Interceptors() []Interceptor {
+	return c.inters.EmailVerification
+}
+
+func (c *EmailVerificationClient) mutate(ctx context.Context, m *EmailVerificationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EmailVerificationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EmailVerificationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EmailVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EmailVerification mutation op: %q", m.Op())
+	}
+}
+
+// LibraryClient is a client for the Library schema.
+type LibraryClient struct {
+	config
+}
+
+// NewLibraryClient returns a client for the Library from the given config.
+func NewLibraryClient(c config) *LibraryClient {
+	return &LibraryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `library.Hooks(f(g(h())))`.
+func (c *LibraryClient) Use(hooks ...Hook) {
+	c.hooks.Library = append(c.hooks.Library, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `library.Intercept(f(g(h())))`.
+func (c *LibraryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Library = append(c.inters.Library, interceptors...)
+}
+
+// Create returns a builder for creating a Library entity.
+func (c *LibraryClient) Create() *LibraryCreate {
+	mutation := newLibraryMutation(c.config, OpCreate)
+	return &LibraryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Library entities.
+func (c *LibraryClient) CreateBulk(builders ...*LibraryCreate) *LibraryCreateBulk {
+	return &LibraryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LibraryClient) MapCreateBulk(slice any, setFunc func(*LibraryCreate, int)) *LibraryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LibraryCreateBulk{err: fmt.Errorf("calling to LibraryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LibraryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LibraryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Library.
+func (c *LibraryClient) Update() *LibraryUpdate {
+	mutation := newLibraryMutation(c.config, OpUpdate)
+	return &LibraryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LibraryClient) UpdateOne(_m *Library) *LibraryUpdateOne {
+	mutation := newLibraryMutation(c.config, OpUpdateOne, withLibrary(_m))
+	return &LibraryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LibraryClient) UpdateOneID(id uuid.UUID) *LibraryUpdateOne {
+	mutation := newLibraryMutation(c.config, OpUpdateOne, withLibraryID(id))
+	return &LibraryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Library.
+func (c *LibraryClient) Delete() *LibraryDelete {
+	mutation := newLibraryMutation(c.config, OpDelete)
+	return &LibraryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LibraryClient) DeleteOne(_m *Library) *LibraryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LibraryClient) DeleteOneID(id uuid.UUID) *LibraryDeleteOne {
+	builder := c.Delete().Where(library.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LibraryDeleteOne{builder}
+}
+
+// Query returns a query builder for Library.
+func (c *LibraryClient) Query() *LibraryQuery {
+	return &LibraryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLibrary},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Library entity by its id.
+func (c *LibraryClient) Get(ctx context.Context, id uuid.UUID) (*Library, error) {
+	return c.Query().Where(library.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LibraryClient) GetX(ctx context.Context, id uuid.UUID) *Library {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryMembers queries the members edge of a Library.
+func (c *LibraryClient) QueryMembers(_m *Library) *LibraryMemberQuery {
+	query := (&LibraryMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(library.Table, library.FieldID, id),
+			sqlgraph.To(librarymember.Table, librarymember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, library.MembersTable, library.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a Library.
+func (c *LibraryClient) QueryInvitations(_m *Library) *LibraryInvitationQuery {
+	query := (&LibraryInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(library.Table, library.FieldID, id),
+			sqlgraph.To(libraryinvitation.Table, libraryinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, library.InvitationsTable, library.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBooks queries the books edge of a Library.
+func (c *LibraryClient) QueryBooks(_m *Library) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(library.Table, library.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, library.BooksTable, library.BooksColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LibraryClient) Hooks() []Hook {
+	return c.hooks.Library
+}
+
+// Interceptors returns the client interceptors.
+func (c *LibraryClient) Interceptors() []Interceptor {
+	return c.inters.Library
+}
+
+func (c *LibraryClient) mutate(ctx context.Context, m *LibraryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LibraryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LibraryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LibraryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LibraryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Library mutation op: %q", m.Op())
+	}
+}
+
+// LibraryInvitationClient is a client for the LibraryInvitation schema.
+type LibraryInvitationClient struct {
+	config
+}
+
+// NewLibraryInvitationClient returns a client for the LibraryInvitation from the given config.
+func NewLibraryInvitationClient(c config) *LibraryInvitationClient {
+	return &LibraryInvitationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `libraryinvitation.Hooks(f(g(h())))`.
+func (c *LibraryInvitationClient) Use(hooks ...Hook) {
+	c.hooks.LibraryInvitation = append(c.hooks.LibraryInvitation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `libraryinvitation.Intercept(f(g(h())))`.
+func (c *LibraryInvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LibraryInvitation = append(c.inters.LibraryInvitation, interceptors...)
+}
+
+// Create returns a builder for creating a LibraryInvitation entity.
+func (c *LibraryInvitationClient) Create() *LibraryInvitationCreate {
+	mutation := newLibraryInvitationMutation(c.config, OpCreate)
+	return &LibraryInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LibraryInvitation entities.
+func (c *LibraryInvitationClient) CreateBulk(builders ...*LibraryInvitationCreate) *LibraryInvitationCreateBulk {
+	return &LibraryInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LibraryInvitationClient) MapCreateBulk(slice any, setFunc func(*LibraryInvitationCreate, int)) *LibraryInvitationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LibraryInvitationCreateBulk{err: fmt.Errorf("calling to LibraryInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LibraryInvitationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LibraryInvitationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LibraryInvitation.
+func (c *LibraryInvitationClient) Update() *LibraryInvitationUpdate {
+	mutation := newLibraryInvitationMutation(c.config, OpUpdate)
+	return &LibraryInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LibraryInvitationClient) UpdateOne(_m *LibraryInvitation) *LibraryInvitationUpdateOne {
+	mutation := newLibraryInvitationMutation(c.config, OpUpdateOne, withLibraryInvitation(_m))
+	return &LibraryInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LibraryInvitationClient) UpdateOneID(id uuid.UUID) *LibraryInvitationUpdateOne {
+	mutation := newLibraryInvitationMutation(c.config, OpUpdateOne, withLibraryInvitationID(id))
+	return &LibraryInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LibraryInvitation.
+func (c *LibraryInvitationClient) Delete() *LibraryInvitationDelete {
+	mutation := newLibraryInvitationMutation(c.config, OpDelete)
+	return &LibraryInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LibraryInvitationClient) DeleteOne(_m *LibraryInvitation) *LibraryInvitationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LibraryInvitationClient) DeleteOneID(id uuid.UUID) *LibraryInvitationDeleteOne {
+	builder := c.Delete().Where(libraryinvitation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LibraryInvitationDeleteOne{builder}
+}
+
+// Query returns a query builder for LibraryInvitation.
+func (c *LibraryInvitationClient) Query() *LibraryInvitationQuery {
+	return &LibraryInvitationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLibraryInvitation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LibraryInvitation entity by its id.
+func (c *LibraryInvitationClient) Get(ctx context.Context, id uuid.UUID) (*LibraryInvitation, error) {
+	return c.Query().Where(libraryinvitation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LibraryInvitationClient) GetX(ctx context.Context, id uuid.UUID) *LibraryInvitation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLibrary queries the library edge of a LibraryInvitation.
+func (c *LibraryInvitationClient) QueryLibrary(_m *LibraryInvitation) *LibraryQuery {
+	query := (&LibraryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(libraryinvitation.Table, libraryinvitation.FieldID, id),
+			sqlgraph.To(library.Table, library.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, libraryinvitation.LibraryTable, libraryinvitation.LibraryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInviter queries the inviter edge of a LibraryInvitation.
+func (c *LibraryInvitationClient) QueryInviter(_m *LibraryInvitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(libraryinvitation.Table, libraryinvitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, libraryinvitation.InviterTable, libraryinvitation.InviterColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitee queries the invitee edge of a LibraryInvitation.
+func (c *LibraryInvitationClient) QueryInvitee(_m *LibraryInvitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(libraryinvitation.Table, libraryinvitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, libraryinvitation.InviteeTable, libraryinvitation.InviteeColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LibraryInvitationClient) Hooks() []Hook {
+	return c.hooks.LibraryInvitation
+}
+
+// Interceptors returns the client interceptors.
+func (c *LibraryInvitationClient) Interceptors() []Interceptor {
+	return c.inters.LibraryInvitation
+}
+
+func (c *LibraryInvitationClient) mutate(ctx context.Context, m *LibraryInvitationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LibraryInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LibraryInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LibraryInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LibraryInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LibraryInvitation mutation op: %q", m.Op())
+	}
+}
+
+// LibraryMemberClient is a client for the LibraryMember schema.
+type LibraryMemberClient struct {
+	config
+}
+
+// NewLibraryMemberClient returns a client for the LibraryMember from the given config.
+func NewLibraryMemberClient(c config) *LibraryMemberClient {
+	return &LibraryMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `librarymember.Hooks(f(g(h())))`.
+func (c *LibraryMemberClient) Use(hooks ...Hook) {
+	c.hooks.LibraryMember = append(c.hooks.LibraryMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `librarymember.Intercept(f(g(h())))`.
+func (c *LibraryMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LibraryMember = append(c.inters.LibraryMember, interceptors...)
+}
+
+// Create returns a builder for creating a LibraryMember entity.
+func (c *LibraryMemberClient) Create() *LibraryMemberCreate {
+	mutation := newLibraryMemberMutation(c.config, OpCreate)
+	return &LibraryMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LibraryMember entities.
+func (c *LibraryMemberClient) CreateBulk(builders ...*LibraryMemberCreate) *LibraryMemberCreateBulk {
+	return &LibraryMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LibraryMemberClient) MapCreateBulk(slice any, setFunc func(*LibraryMemberCreate, int)) *LibraryMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LibraryMemberCreateBulk{err: fmt.Errorf("calling to LibraryMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LibraryMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LibraryMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LibraryMember.
+func (c *LibraryMemberClient) Update() *LibraryMemberUpdate {
+	mutation := newLibraryMemberMutation(c.config, OpUpdate)
+	return &LibraryMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LibraryMemberClient) UpdateOne(_m *LibraryMember) *LibraryMemberUpdateOne {
+	mutation := newLibraryMemberMutation(c.config, OpUpdateOne, withLibraryMember(_m))
+	return &LibraryMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LibraryMemberClient) UpdateOneID(id uuid.UUID) *LibraryMemberUpdateOne {
+	mutation := newLibraryMemberMutation(c.config, OpUpdateOne, withLibraryMemberID(id))
+	return &LibraryMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LibraryMember.
+func (c *LibraryMemberClient) Delete() *LibraryMemberDelete {
+	mutation := newLibraryMemberMutation(c.config, OpDelete)
+	return &LibraryMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LibraryMemberClient) DeleteOne(_m *LibraryMember) *LibraryMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LibraryMemberClient) DeleteOneID(id uuid.UUID) *LibraryMemberDeleteOne {
+	builder := c.Delete().Where(librarymember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LibraryMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for LibraryMember.
+func (c *LibraryMemberClient) Query() *LibraryMemberQuery {
+	return &LibraryMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLibraryMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LibraryMember entity by its id.
+func (c *LibraryMemberClient) Get(ctx context.Context, id uuid.UUID) (*LibraryMember, error) {
+	return c.Query().Where(librarymember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LibraryMemberClient) GetX(ctx context.Context, id uuid.UUID) *LibraryMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryLibrary queries the library edge of a LibraryMember.
+func (c *LibraryMemberClient) QueryLibrary(_m *LibraryMember) *LibraryQuery {
+	query := (&LibraryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(librarymember.Table, librarymember.FieldID, id),
+			sqlgraph.To(library.Table, library.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, librarymember.LibraryTable, librarymember.LibraryColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a LibraryMember.
+func (c *LibraryMemberClient) QueryUser(_m *LibraryMember) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(librarymember.Table, librarymember.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, librarymember.UserTable, librarymember.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LibraryMemberClient) Hooks() []Hook {
+	return c.hooks.LibraryMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *LibraryMemberClient) Interceptors() []Interceptor {
+	return c.inters.LibraryMember
+}
+
+func (c *LibraryMemberClient) mutate(ctx context.Context, m *LibraryMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LibraryMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LibraryMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LibraryMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LibraryMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LibraryMember mutation op: %q", m.Op())
+	}
+}
+
+// LoanClient is a client for the Loan schema.
+type LoanClient struct {
+	config
+}
+
+// NewLoanClient returns a client for the Loan from the given config.
+func NewLoanClient(c config) *LoanClient {
+	return &LoanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `loan.Hooks(f(g(h())))`.
+func (c *LoanClient) Use(hooks ...Hook) {
+	c.hooks.Loan = append(c.hooks.Loan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `loan.Intercept(f(g(h())))`.
+func (c *LoanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Loan = append(c.inters.Loan, interceptors...)
+}
+
+// Create returns a builder for creating a Loan entity.
+func (c *LoanClient) Create() *LoanCreate {
+	mutation := newLoanMutation(c.config, OpCreate)
+	return &LoanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Loan entities.
+func (c *LoanClient) CreateBulk(builders ...*LoanCreate) *LoanCreateBulk {
+	return &LoanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LoanClient) MapCreateBulk(slice any, setFunc func(*LoanCreate, int)) *LoanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LoanCreateBulk{err: fmt.Errorf("calling to LoanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LoanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LoanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Loan.
+func (c *LoanClient) Update() *LoanUpdate {
+	mutation := newLoanMutation(c.config, OpUpdate)
+	return &LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LoanClient) UpdateOne(_m *Loan) *LoanUpdateOne {
+	mutation := newLoanMutation(c.config, OpUpdateOne, withLoan(_m))
+	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LoanClient) UpdateOneID(id uuid.UUID) *LoanUpdateOne {
+	mutation := newLoanMutation(c.config, OpUpdateOne, withLoanID(id))
+	return &LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Loan.
+func (c *LoanClient) Delete() *LoanDelete {
+	mutation := newLoanMutation(c.config, OpDelete)
+	return &LoanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LoanClient) DeleteOne(_m *Loan) *LoanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LoanClient) DeleteOneID(id uuid.UUID) *LoanDeleteOne {
+	builder := c.Delete().Where(loan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LoanDeleteOne{builder}
+}
+
+// Query returns a query builder for Loan.
+func (c *LoanClient) Query() *LoanQuery {
+	return &LoanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLoan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Loan entity by its id.
+func (c *LoanClient) Get(ctx context.Context, id uuid.UUID) (*Loan, error) {
+	return c.Query().Where(loan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LoanClient) GetX(ctx context.Context, id uuid.UUID) *Loan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Loan.
+func (c *LoanClient) QueryOwner(_m *Loan) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.OwnerTable, loan.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBook queries the book edge of a Loan.
+func (c *LoanClient) QueryBook(_m *Loan) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(loan.Table, loan.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, loan.BookTable, loan.BookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LoanClient) Hooks() []Hook {
+	return c.hooks.Loan
+}
+
+// Interceptors returns the client interceptors.
+func (c *LoanClient) Interceptors() []Interceptor {
+	return c.inters.Loan
+}
+
+func (c *LoanClient) mutate(ctx context.Context, m *LoanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LoanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LoanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LoanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LoanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Loan mutation op: %q", m.Op())
+	}
+}
+
+// ReadingGoalClient is a client for the ReadingGoal schema.
+type ReadingGoalClient struct {
+	config
+}
+
+// NewReadingGoalClient returns a client for the ReadingGoal from the given config.
+func NewReadingGoalClient(c config) *ReadingGoalClient {
+	return &ReadingGoalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `readinggoal.Hooks(f(g(h())))`.
+func (c *ReadingGoalClient) Use(hooks ...Hook) {
+	c.hooks.ReadingGoal = append(c.hooks.ReadingGoal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `readinggoal.Intercept(f(g(h())))`.
+func (c *ReadingGoalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReadingGoal = append(c.inters.ReadingGoal, interceptors...)
+}
+
+// Create returns a builder for creating a ReadingGoal entity.
+func (c *ReadingGoalClient) Create() *ReadingGoalCreate {
+	mutation := newReadingGoalMutation(c.config, OpCreate)
+	return &ReadingGoalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReadingGoal entities.
+func (c *ReadingGoalClient) CreateBulk(builders ...*ReadingGoalCreate) *ReadingGoalCreateBulk {
+	return &ReadingGoalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReadingGoalClient) MapCreateBulk(slice any, setFunc func(*ReadingGoalCreate, int)) *ReadingGoalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReadingGoalCreateBulk{err: fmt.Errorf("calling to ReadingGoalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReadingGoalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReadingGoalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReadingGoal.
+func (c *ReadingGoalClient) Update() *ReadingGoalUpdate {
+	mutation := newReadingGoalMutation(c.config, OpUpdate)
+	return &ReadingGoalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReadingGoalClient) UpdateOne(_m *ReadingGoal) *ReadingGoalUpdateOne {
+	mutation := newReadingGoalMutation(c.config, OpUpdateOne, withReadingGoal(_m))
+	return &ReadingGoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReadingGoalClient) UpdateOneID(id uuid.UUID) *ReadingGoalUpdateOne {
+	mutation := newReadingGoalMutation(c.config, OpUpdateOne, withReadingGoalID(id))
+	return &ReadingGoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReadingGoal.
+func (c *ReadingGoalClient) Delete() *ReadingGoalDelete {
+	mutation := newReadingGoalMutation(c.config, OpDelete)
+	return &ReadingGoalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReadingGoalClient) DeleteOne(_m *ReadingGoal) *ReadingGoalDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReadingGoalClient) DeleteOneID(id uuid.UUID) *ReadingGoalDeleteOne {
+	builder := c.Delete().Where(readinggoal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReadingGoalDeleteOne{builder}
+}
+
+// Query returns a query builder for ReadingGoal.
+func (c *ReadingGoalClient) Query() *ReadingGoalQuery {
+	return &ReadingGoalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReadingGoal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReadingGoal entity by its id.
+func (c *ReadingGoalClient) Get(ctx context.Context, id uuid.UUID) (*ReadingGoal, error) {
+	return c.Query().Where(readinggoal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReadingGoalClient) GetX(ctx context.Context, id uuid.UUID) *ReadingGoal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a ReadingGoal.
+func (c *ReadingGoalClient) QueryOwner(_m *ReadingGoal) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(readinggoal.Table, readinggoal.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, readinggoal.OwnerTable, readinggoal.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReadingGoalClient) Hooks() []Hook {
+	return c.hooks.ReadingGoal
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReadingGoalClient) Interceptors() []Interceptor {
+	return c.inters.ReadingGoal
+}
+
+func (c *ReadingGoalClient) mutate(ctx context.Context, m *ReadingGoalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReadingGoalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReadingGoalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReadingGoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReadingGoalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReadingGoal mutation op: %q", m.Op())
+	}
+}
+
+// ReadingReminderClient is a client for the ReadingReminder schema.
+type ReadingReminderClient struct {
+	config
+}
+
+// NewReadingReminderClient returns a client for the ReadingReminder from the given config.
+func NewReadingReminderClient(c config) *ReadingReminderClient {
+	return &ReadingReminderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `readingreminder.Hooks(f(g(h())))`.
+func (c *ReadingReminderClient) Use(hooks ...Hook) {
+	c.hooks.ReadingReminder = append(c.hooks.ReadingReminder, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `readingreminder.Intercept(f(g(h())))`.
+func (c *ReadingReminderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReadingReminder = append(c.inters.ReadingReminder, interceptors...)
+}
+
+// Create returns a builder for creating a ReadingReminder entity.
+func (c *ReadingReminderClient) Create() *ReadingReminderCreate {
+	mutation := newReadingReminderMutation(c.config, OpCreate)
+	return &ReadingReminderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReadingReminder entities.
+func (c *ReadingReminderClient) CreateBulk(builders ...*ReadingReminderCreate) *ReadingReminderCreateBulk {
+	return &ReadingReminderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReadingReminderClient) MapCreateBulk(slice any, setFunc func(*ReadingReminderCreate, int)) *ReadingReminderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReadingReminderCreateBulk{err: fmt.Errorf("calling to ReadingReminderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReadingReminderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReadingReminderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReadingReminder.
+func (c *ReadingReminderClient) Update() *ReadingReminderUpdate {
+	mutation := newReadingReminderMutation(c.config, OpUpdate)
+	return &ReadingReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReadingReminderClient) UpdateOne(_m *ReadingReminder) *ReadingReminderUpdateOne {
+	mutation := newReadingReminderMutation(c.config, OpUpdateOne, withReadingReminder(_m))
+	return &ReadingReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReadingReminderClient) UpdateOneID(id uuid.UUID) *ReadingReminderUpdateOne {
+	mutation := newReadingReminderMutation(c.config, OpUpdateOne, withReadingReminderID(id))
+	return &ReadingReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReadingReminder.
+func (c *ReadingReminderClient) Delete() *ReadingReminderDelete {
+	mutation := newReadingReminderMutation(c.config, OpDelete)
+	return &ReadingReminderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReadingReminderClient) DeleteOne(_m *ReadingReminder) *ReadingReminderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReadingReminderClient) DeleteOneID(id uuid.UUID) *ReadingReminderDeleteOne {
+	builder := c.Delete().Where(readingreminder.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReadingReminderDeleteOne{builder}
+}
+
+// Query returns a query builder for ReadingReminder.
+func (c *ReadingReminderClient) Query() *ReadingReminderQuery {
+	return &ReadingReminderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReadingReminder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReadingReminder entity by its id.
+func (c *ReadingReminderClient) Get(ctx context.Context, id uuid.UUID) (*ReadingReminder, error) {
+	return c.Query().Where(readingreminder.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReadingReminderClient) GetX(ctx context.Context, id uuid.UUID) *ReadingReminder {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a ReadingReminder.
+func (c *ReadingReminderClient) QueryOwner(_m *ReadingReminder) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(readingreminder.Table, readingreminder.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, readingreminder.OwnerTable, readingreminder.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReadingReminderClient) Hooks() []Hook {
+	return c.hooks.ReadingReminder
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReadingReminderClient) Interceptors() []Interceptor {
+	return c.inters.ReadingReminder
+}
+
+func (c *ReadingReminderClient) mutate(ctx context.Context, m *ReadingReminderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReadingReminderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReadingReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReadingReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReadingReminderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReadingReminder mutation op: %q", m.Op())
+	}
+}
+
+// ReadingSessionClient is a client for the ReadingSession schema.
+type ReadingSessionClient struct {
+	config
+}
+
+// NewReadingSessionClient returns a client for the ReadingSession from the given config.
+func NewReadingSessionClient(c config) *ReadingSessionClient {
+	return &ReadingSessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `readingsession.Hooks(f(g(h())))`.
+func (c *ReadingSessionClient) Use(hooks ...Hook) {
+	c.hooks.ReadingSession = append(c.hooks.ReadingSession, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `readingsession.Intercept(f(g(h())))`.
+func (c *ReadingSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ReadingSession = append(c.inters.ReadingSession, interceptors...)
+}
+
+// Create returns a builder for creating a ReadingSession entity.
+func (c *ReadingSessionClient) Create() *ReadingSessionCreate {
+	mutation := newReadingSessionMutation(c.config, OpCreate)
+	return &ReadingSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ReadingSession entities.
+func (c *ReadingSessionClient) CreateBulk(builders ...*ReadingSessionCreate) *ReadingSessionCreateBulk {
+	return &ReadingSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReadingSessionClient) MapCreateBulk(slice any, setFunc func(*ReadingSessionCreate, int)) *ReadingSessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReadingSessionCreateBulk{err: fmt.Errorf("calling to ReadingSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReadingSessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReadingSessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ReadingSession.
+func (c *ReadingSessionClient) Update() *ReadingSessionUpdate {
+	mutation := newReadingSessionMutation(c.config, OpUpdate)
+	return &ReadingSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReadingSessionClient) UpdateOne(_m *ReadingSession) *ReadingSessionUpdateOne {
+	mutation := newReadingSessionMutation(c.config, OpUpdateOne, withReadingSession(_m))
+	return &ReadingSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReadingSessionClient) UpdateOneID(id uuid.UUID) *ReadingSessionUpdateOne {
+	mutation := newReadingSessionMutation(c.config, OpUpdateOne, withReadingSessionID(id))
+	return &ReadingSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ReadingSession.
+func (c *ReadingSessionClient) Delete() *ReadingSessionDelete {
+	mutation := newReadingSessionMutation(c.config, OpDelete)
+	return &ReadingSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReadingSessionClient) DeleteOne(_m *ReadingSession) *ReadingSessionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReadingSessionClient) DeleteOneID(id uuid.UUID) *ReadingSessionDeleteOne {
+	builder := c.Delete().Where(readingsession.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReadingSessionDeleteOne{builder}
+}
+
+// Query returns a query builder for ReadingSession.
+func (c *ReadingSessionClient) Query() *ReadingSessionQuery {
+	return &ReadingSessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReadingSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ReadingSession entity by its id.
+func (c *ReadingSessionClient) Get(ctx context.Context, id uuid.UUID) (*ReadingSession, error) {
+	return c.Query().Where(readingsession.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReadingSessionClient) GetX(ctx context.Context, id uuid.UUID) *ReadingSession {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a ReadingSession.
+func (c *ReadingSessionClient) QueryOwner(_m *ReadingSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(readingsession.Table, readingsession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, readingsession.OwnerTable, readingsession.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBook queries the book edge of a ReadingSession.
+func (c *ReadingSessionClient) QueryBook(_m *ReadingSession) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(readingsession.Table, readingsession.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, readingsession.BookTable, readingsession.BookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReadingSessionClient) Hooks() []Hook {
+	return c.hooks.ReadingSession
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReadingSessionClient) Interceptors() []Interceptor {
+	return c.inters.ReadingSession
+}
+
+func (c *ReadingSessionClient) mutate(ctx context.Context, m *ReadingSessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReadingSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReadingSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReadingSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReadingSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ReadingSession mutation op: %q", m.Op())
+	}
+}
+
+// ReviewClient is a client for the Review schema.
+type ReviewClient struct {
+	config
+}
+
+// NewReviewClient returns a client for the Review from the given config.
+func NewReviewClient(c config) *ReviewClient {
+	return &ReviewClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `review.Hooks(f(g(h())))`.
+func (c *ReviewClient) Use(hooks ...Hook) {
+	c.hooks.Review = append(c.hooks.Review, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `review.Intercept(f(g(h())))`.
+func (c *ReviewClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Review = append(c.inters.Review, interceptors...)
+}
+
+// Create returns a builder for creating a Review entity.
+func (c *ReviewClient) Create() *ReviewCreate {
+	mutation := newReviewMutation(c.config, OpCreate)
+	return &ReviewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Review entities.
+func (c *ReviewClient) CreateBulk(builders ...*ReviewCreate) *ReviewCreateBulk {
+	return &ReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReviewClient) MapCreateBulk(slice any, setFunc func(*ReviewCreate, int)) *ReviewCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReviewCreateBulk{err: fmt.Errorf("calling to ReviewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReviewCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReviewCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Review.
+func (c *ReviewClient) Update() *ReviewUpdate {
+	mutation := newReviewMutation(c.config, OpUpdate)
+	return &ReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReviewClient) UpdateOne(_m *Review) *ReviewUpdateOne {
+	mutation := newReviewMutation(c.config, OpUpdateOne, withReview(_m))
+	return &ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReviewClient) UpdateOneID(id uuid.UUID) *ReviewUpdateOne {
+	mutation := newReviewMutation(c.config, OpUpdateOne, withReviewID(id))
+	return &ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Review.
+func (c *ReviewClient) Delete() *ReviewDelete {
+	mutation := newReviewMutation(c.config, OpDelete)
+	return &ReviewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReviewClient) DeleteOne(_m *Review) *ReviewDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReviewClient) DeleteOneID(id uuid.UUID) *ReviewDeleteOne {
+	builder := c.Delete().Where(review.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReviewDeleteOne{builder}
+}
+
+// Query returns a query builder for Review.
+func (c *ReviewClient) Query() *ReviewQuery {
+	return &ReviewQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReview},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Review entity by its id.
+func (c *ReviewClient) Get(ctx context.Context, id uuid.UUID) (*Review, error) {
+	return c.Query().Where(review.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReviewClient) GetX(ctx context.Context, id uuid.UUID) *Review {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a Review.
+func (c *ReviewClient) QueryOwner(_m *Review) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, review.OwnerTable, review.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBook queries the book edge of a Review.
+func (c *ReviewClient) QueryBook(_m *Review) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, review.BookTable, review.BookColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCatalog queries the catalog edge of a Review.
+func (c *ReviewClient) QueryCatalog(_m *Review) *BookCatalogQuery {
+	query := (&BookCatalogClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, id),
+			sqlgraph.To(bookcatalog.Table, bookcatalog.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, review.CatalogTable, review.CatalogColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ReviewClient) Hooks() []Hook {
+	return c.hooks.Review
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReviewClient) Interceptors() []Interceptor {
+	return c.inters.Review
+}
+
+func (c *ReviewClient) mutate(ctx context.Context, m *ReviewMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReviewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailVerificationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailVerificationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ReviewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailVerification mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Review mutation op: %q", m.Op())
 	}
 }
 
-// ReadingReminderClient is a client for the ReadingReminder schema.
-type ReadingReminderClient struct {
+// ShelfClient is a client for the Shelf schema.
+type ShelfClient struct {
 	config
 }
 
-// NewReadingReminderClient returns a client for the ReadingReminder from the given config.
-func NewReadingReminderClient(c config) *ReadingReminderClient {
-	return &ReadingReminderClient{config: c}
+// NewShelfClient returns a client for the Shelf from the given config.
+func NewShelfClient(c config) *ShelfClient {
+	return &ShelfClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `readingreminder.Hooks(f(g(h())))`.
-func (c *ReadingReminderClient) Use(hooks ...Hook) {
-	c.hooks.ReadingReminder = append(c.hooks.ReadingReminder, hooks...)
+// A call to `Use(f, g, h)` equals to `shelf.Hooks(f(g(h())))`.
+func (c *ShelfClient) Use(hooks ...Hook) {
+	c.hooks.Shelf = append(c.hooks.Shelf, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `readingreminder.Intercept(f(g(h())))`.
-func (c *ReadingReminderClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ReadingReminder = append(c.inters.ReadingReminder, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `shelf.Intercept(f(g(h())))`.
+func (c *ShelfClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Shelf = append(c.inters.Shelf, interceptors...)
 }
 
-// Create returns a builder for creating a ReadingReminder entity.
-func (c *ReadingReminderClient) Create() *ReadingReminderCreate {
-	mutation := newReadingReminderMutation(c.config, OpCreate)
-	return &ReadingReminderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Shelf entity.
+func (c *ShelfClient) Create() *ShelfCreate {
+	mutation := newShelfMutation(c.config, OpCreate)
+	return &ShelfCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ReadingReminder entities.
-func (c *ReadingReminderClient) CreateBulk(builders ...*ReadingReminderCreate) *ReadingReminderCreateBulk {
-	return &ReadingReminderCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Shelf entities.
+func (c *ShelfClient) CreateBulk(builders ...*ShelfCreate) *ShelfCreateBulk {
+	return &ShelfCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReadingReminderClient) MapCreateBulk(slice any, setFunc func(*ReadingReminderCreate, int)) *ReadingReminderCreateBulk {
+func (c *ShelfClient) MapCreateBulk(slice any, setFunc func(*ShelfCreate, int)) *ShelfCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReadingReminderCreateBulk{err: fmt.Errorf("calling to ReadingReminderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ShelfCreateBulk{err: fmt.Errorf("calling to ShelfClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReadingReminderCreate, rv.Len())
+	builders := make([]*ShelfCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReadingReminderCreateBulk{config: c.config, builders: builders}
+	return &ShelfCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ReadingReminder.
-func (c *ReadingReminderClient) Update() *ReadingReminderUpdate {
-	mutation := newReadingReminderMutation(c.config, OpUpdate)
-	return &ReadingReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Shelf.
+func (c *ShelfClient) Update() *ShelfUpdate {
+	mutation := newShelfMutation(c.config, OpUpdate)
+	return &ShelfUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReadingReminderClient) UpdateOne(_m *ReadingReminder) *ReadingReminderUpdateOne {
-	mutation := newReadingReminderMutation(c.config, OpUpdateOne, withReadingReminder(_m))
-	return &ReadingReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ShelfClient) UpdateOne(_m *Shelf) *ShelfUpdateOne {
+	mutation := newShelfMutation(c.config, OpUpdateOne, withShelf(_m))
+	return &ShelfUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReadingReminderClient) UpdateOneID(id uuid.UUID) *ReadingReminderUpdateOne {
-	mutation := newReadingReminderMutation(c.config, OpUpdateOne, withReadingReminderID(id))
-	return &ReadingReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ShelfClient) UpdateOneID(id uuid.UUID) *ShelfUpdateOne {
+	mutation := newShelfMutation(c.config, OpUpdateOne, withShelfID(id))
+	return &ShelfUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ReadingReminder.
-func (c *ReadingReminderClient) Delete() *ReadingReminderDelete {
-	mutation := newReadingReminderMutation(c.config, OpDelete)
-	return &ReadingReminderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Shelf.
+func (c *ShelfClient) Delete() *ShelfDelete {
+	mutation := newShelfMutation(c.config, OpDelete)
+	return &ShelfDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReadingReminderClient) DeleteOne(_m *ReadingReminder) *ReadingReminderDeleteOne {
+func (c *ShelfClient) DeleteOne(_m *Shelf) *ShelfDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReadingReminderClient) DeleteOneID(id uuid.UUID) *ReadingReminderDeleteOne {
-	builder := c.Delete().Where(readingreminder.ID(id))
+func (c *ShelfClient) DeleteOneID(id uuid.UUID) *ShelfDeleteOne {
+	builder := c.Delete().Where(shelf.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReadingReminderDeleteOne{builder}
+	return &ShelfDeleteOne{builder}
 }
 
-// Query returns a query builder for ReadingReminder.
-func (c *ReadingReminderClient) Query() *ReadingReminderQuery {
-	return &ReadingReminderQuery{
+// Query returns a query builder for Shelf.
+func (c *ShelfClient) Query() *ShelfQuery {
+	return &ShelfQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReadingReminder},
+		ctx:    &QueryContext{Type: TypeShelf},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ReadingReminder entity by its id.
-func (c *ReadingReminderClient) Get(ctx context.Context, id uuid.UUID) (*ReadingReminder, error) {
-	return c.Query().Where(readingreminder.ID(id)).Only(ctx)
+// Get returns a Shelf entity by its id.
+func (c *ShelfClient) Get(ctx context.Context, id uuid.UUID) (*Shelf, error) {
+	return c.Query().Where(shelf.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReadingReminderClient) GetX(ctx context.Context, id uuid.UUID) *ReadingReminder {
+func (c *ShelfClient) GetX(ctx context.Context, id uuid.UUID) *Shelf {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -976,15 +3407,47 @@ func (c *ReadingReminderClient) GetX(ctx context.Context, id uuid.UUID) *Reading
 	return obj
 }
 
-// QueryOwner queries the owner edge of a ReadingReminder.
-func (c *ReadingReminderClient) QueryOwner(_m *ReadingReminder) *UserQuery {
+// QueryOwner queries the owner edge of a Shelf.
+func (c *ShelfClient) QueryOwner(_m *Shelf) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(readingreminder.Table, readingreminder.FieldID, id),
+			sqlgraph.From(shelf.Table, shelf.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, readingreminder.OwnerTable, readingreminder.OwnerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, shelf.OwnerTable, shelf.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBooks queries the books edge of a Shelf.
+func (c *ShelfClient) QueryBooks(_m *Shelf) *BookQuery {
+	query := (&BookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shelf.Table, shelf.FieldID, id),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, shelf.BooksTable, shelf.BooksPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShelfBooks queries the shelf_books edge of a Shelf.
+func (c *ShelfClient) QueryShelfBooks(_m *Shelf) *ShelfBookQuery {
+	query := (&ShelfBookClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shelf.Table, shelf.FieldID, id),
+			sqlgraph.To(shelfbook.Table, shelfbook.ShelfColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, shelf.ShelfBooksTable, shelf.ShelfBooksColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -993,131 +3456,247 @@ func (c *ReadingReminderClient) QueryOwner(_m *ReadingReminder) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ReadingReminderClient) Hooks() []Hook {
-	return c.hooks.ReadingReminder
+func (c *ShelfClient) Hooks() []Hook {
+	return c.hooks.Shelf
 }
 
 // Interceptors returns the client interceptors.
-func (c *ReadingReminderClient) Interceptors() []Interceptor {
-	return c.inters.ReadingReminder
+func (c *ShelfClient) Interceptors() []Interceptor {
+	return c.inters.Shelf
 }
 
-func (c *ReadingReminderClient) mutate(ctx context.Context, m *ReadingReminderMutation) (Value, error) {
+func (c *ShelfClient) mutate(ctx context.Context, m *ShelfMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ReadingReminderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShelfCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ReadingReminderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShelfUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ReadingReminderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ShelfUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ReadingReminderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ShelfDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ReadingReminder mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Shelf mutation op: %q", m.Op())
 	}
 }
 
-// ReviewClient is a client for the Review schema.
-type ReviewClient struct {
+// ShelfBookClient is a client for the ShelfBook schema.
+type ShelfBookClient struct {
 	config
 }
 
-// NewReviewClient returns a client for the Review from the given config.
-func NewReviewClient(c config) *ReviewClient {
-	return &ReviewClient{config: c}
+// NewShelfBookClient returns a client for the ShelfBook from the given config.
+func NewShelfBookClient(c config) *ShelfBookClient {
+	return &ShelfBookClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `review.Hooks(f(g(h())))`.
-func (c *ReviewClient) Use(hooks ...Hook) {
-	c.hooks.Review = append(c.hooks.Review, hooks...)
+// A call to `Use(f, g, h)` equals to `shelfbook.Hooks(f(g(h())))`.
+func (c *ShelfBookClient) Use(hooks ...Hook) {
+	c.hooks.ShelfBook = append(c.hooks.ShelfBook, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `review.Intercept(f(g(h())))`.
-func (c *ReviewClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Review = append(c.inters.Review, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `shelfbook.Intercept(f(g(h())))`.
+func (c *ShelfBookClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShelfBook = append(c.inters.ShelfBook, interceptors...)
 }
 
-// Create returns a builder for creating a Review entity.
-func (c *ReviewClient) Create() *ReviewCreate {
-	mutation := newReviewMutation(c.config, OpCreate)
-	return &ReviewCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ShelfBook entity.
+func (c *ShelfBookClient) Create() *ShelfBookCreate {
+	mutation := newShelfBookMutation(c.config, OpCreate)
+	return &ShelfBookCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Review entities.
-func (c *ReviewClient) CreateBulk(builders ...*ReviewCreate) *ReviewCreateBulk {
-	return &ReviewCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ShelfBook entities.
+func (c *ShelfBookClient) CreateBulk(builders ...*ShelfBookCreate) *ShelfBookCreateBulk {
+	return &ShelfBookCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ReviewClient) MapCreateBulk(slice any, setFunc func(*ReviewCreate, int)) *ReviewCreateBulk {
+func (c *ShelfBookClient) MapCreateBulk(slice any, setFunc func(*ShelfBookCreate, int)) *ShelfBookCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ReviewCreateBulk{err: fmt.Errorf("calling to ReviewClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ShelfBookCreateBulk{err: fmt.Errorf("calling to ShelfBookClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ReviewCreate, rv.Len())
+	builders := make([]*ShelfBookCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ReviewCreateBulk{config: c.config, builders: builders}
+	return &ShelfBookCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Review.
-func (c *ReviewClient) Update() *ReviewUpdate {
-	mutation := newReviewMutation(c.config, OpUpdate)
-	return &ReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ShelfBook.
+func (c *ShelfBookClient) Update() *ShelfBookUpdate {
+	mutation := newShelfBookMutation(c.config, OpUpdate)
+	return &ShelfBookUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ReviewClient) UpdateOne(_m *Review) *ReviewUpdateOne {
-	mutation := newReviewMutation(c.config, OpUpdateOne, withReview(_m))
-	return &ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ShelfBookClient) UpdateOne(_m *ShelfBook) *ShelfBookUpdateOne {
+	mutation := newShelfBookMutation(c.config, OpUpdateOne)
+	mutation.shelf = &_m.ShelfID
+	mutation.book = &_m.BookID
+	return &ShelfBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShelfBook.
+func (c *ShelfBookClient) Delete() *ShelfBookDelete {
+	mutation := newShelfBookMutation(c.config, OpDelete)
+	return &ShelfBookDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Query returns a query builder for ShelfBook.
+func (c *ShelfBookClient) Query() *ShelfBookQuery {
+	return &ShelfBookQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShelfBook},
+		inters: c.Interceptors(),
+	}
+}
+
+// QueryShelf queries the shelf edge of a ShelfBook.
+func (c *ShelfBookClient) QueryShelf(_m *ShelfBook) *ShelfQuery {
+	return c.Query().
+		Where(shelfbook.ShelfID(_m.ShelfID), shelfbook.BookID(_m.BookID)).
+		QueryShelf()
+}
+
+// QueryBook queries the book edge of a ShelfBook.
+func (c *ShelfBookClient) QueryBook(_m *ShelfBook) *BookQuery {
+	return c.Query().
+		Where(shelfbook.ShelfID(_m.ShelfID), shelfbook.BookID(_m.BookID)).
+		QueryBook()
+}
+
+// Hooks returns the client hooks.
+func (c *ShelfBookClient) Hooks() []Hook {
+	return c.hooks.ShelfBook
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShelfBookClient) Interceptors() []Interceptor {
+	return c.inters.ShelfBook
+}
+
+func (c *ShelfBookClient) mutate(ctx context.Context, m *ShelfBookMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShelfBookCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShelfBookUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShelfBookUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShelfBookDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShelfBook mutation op: %q", m.Op())
+	}
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
+}
+
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(_m *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(_m))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ReviewClient) UpdateOneID(id uuid.UUID) *ReviewUpdateOne {
-	mutation := newReviewMutation(c.config, OpUpdateOne, withReviewID(id))
-	return &ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *TagClient) UpdateOneID(id uuid.UUID) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Review.
-func (c *ReviewClient) Delete() *ReviewDelete {
-	mutation := newReviewMutation(c.config, OpDelete)
-	return &ReviewDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ReviewClient) DeleteOne(_m *Review) *ReviewDeleteOne {
+func (c *TagClient) DeleteOne(_m *Tag) *TagDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ReviewClient) DeleteOneID(id uuid.UUID) *ReviewDeleteOne {
-	builder := c.Delete().Where(review.ID(id))
+func (c *TagClient) DeleteOneID(id uuid.UUID) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ReviewDeleteOne{builder}
+	return &TagDeleteOne{builder}
 }
 
-// Query returns a query builder for Review.
-func (c *ReviewClient) Query() *ReviewQuery {
-	return &ReviewQuery{
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeReview},
+		ctx:    &QueryContext{Type: TypeTag},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Review entity by its id.
-func (c *ReviewClient) Get(ctx context.Context, id uuid.UUID) (*Review, error) {
-	return c.Query().Where(review.ID(id)).Only(ctx)
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id uuid.UUID) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ReviewClient) GetX(ctx context.Context, id uuid.UUID) *Review {
+func (c *TagClient) GetX(ctx context.Context, id uuid.UUID) *Tag {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1125,15 +3704,15 @@ func (c *ReviewClient) GetX(ctx context.Context, id uuid.UUID) *Review {
 	return obj
 }
 
-// QueryOwner queries the owner edge of a Review.
-func (c *ReviewClient) QueryOwner(_m *Review) *UserQuery {
+// QueryOwner queries the owner edge of a Tag.
+func (c *TagClient) QueryOwner(_m *Tag) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(review.Table, review.FieldID, id),
+			sqlgraph.From(tag.Table, tag.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, review.OwnerTable, review.OwnerColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, tag.OwnerTable, tag.OwnerColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1141,15 +3720,15 @@ func (c *ReviewClient) QueryOwner(_m *Review) *UserQuery {
 	return query
 }
 
-// QueryBook queries the book edge of a Review.
-func (c *ReviewClient) QueryBook(_m *Review) *BookQuery {
+// QueryBooks queries the books edge of a Tag.
+func (c *TagClient) QueryBooks(_m *Tag) *BookQuery {
 	query := (&BookClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(review.Table, review.FieldID, id),
+			sqlgraph.From(tag.Table, tag.FieldID, id),
 			sqlgraph.To(book.Table, book.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, review.BookTable, review.BookColumn),
+			sqlgraph.Edge(sqlgraph.M2M, false, tag.BooksTable, tag.BooksPrimaryKey...),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1158,27 +3737,27 @@ func (c *ReviewClient) QueryBook(_m *Review) *BookQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ReviewClient) Hooks() []Hook {
-	return c.hooks.Review
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
 }
 
 // Interceptors returns the client interceptors.
-func (c *ReviewClient) Interceptors() []Interceptor {
-	return c.inters.Review
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
 }
 
-func (c *ReviewClient) mutate(ctx context.Context, m *ReviewMutation) (Value, error) {
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ReviewCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ReviewUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ReviewUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ReviewDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Review mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
 	}
 }
 
@@ -1354,6 +3933,198 @@ func (c *UserClient) QueryReadingReminders(_m *User) *ReadingReminderQuery {
 	return query
 }
 
+// QueryReadingSessions queries the reading_sessions edge of a User.
+func (c *UserClient) QueryReadingSessions(_m *User) *ReadingSessionQuery {
+	query := (&ReadingSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(readingsession.Table, readingsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReadingSessionsTable, user.ReadingSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShelves queries the shelves edge of a User.
+func (c *UserClient) QueryShelves(_m *User) *ShelfQuery {
+	query := (&ShelfClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(shelf.Table, shelf.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShelvesTable, user.ShelvesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a User.
+func (c *UserClient) QueryTags(_m *User) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TagsTable, user.TagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBookNotes queries the book_notes edge of a User.
+func (c *UserClient) QueryBookNotes(_m *User) *BookNoteQuery {
+	query := (&BookNoteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(booknote.Table, booknote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BookNotesTable, user.BookNotesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLoans queries the loans edge of a User.
+func (c *UserClient) QueryLoans(_m *User) *LoanQuery {
+	query := (&LoanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LoansTable, user.LoansColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentBorrowRequests queries the sent_borrow_requests edge of a User.
+func (c *UserClient) QuerySentBorrowRequests(_m *User) *BorrowRequestQuery {
+	query := (&BorrowRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(borrowrequest.Table, borrowrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentBorrowRequestsTable, user.SentBorrowRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceivedBorrowRequests queries the received_borrow_requests edge of a User.
+func (c *UserClient) QueryReceivedBorrowRequests(_m *User) *BorrowRequestQuery {
+	query := (&BorrowRequestClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(borrowrequest.Table, borrowrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReceivedBorrowRequestsTable, user.ReceivedBorrowRequestsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWishlistItems queries the wishlist_items edge of a User.
+func (c *UserClient) QueryWishlistItems(_m *User) *WishlistItemQuery {
+	query := (&WishlistItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(wishlistitem.Table, wishlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WishlistItemsTable, user.WishlistItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLibraryMemberships queries the library_memberships edge of a User.
+func (c *UserClient) QueryLibraryMemberships(_m *User) *LibraryMemberQuery {
+	query := (&LibraryMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(librarymember.Table, librarymember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LibraryMembershipsTable, user.LibraryMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentLibraryInvitations queries the sent_library_invitations edge of a User.
+func (c *UserClient) QuerySentLibraryInvitations(_m *User) *LibraryInvitationQuery {
+	query := (&LibraryInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(libraryinvitation.Table, libraryinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentLibraryInvitationsTable, user.SentLibraryInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReceivedLibraryInvitations queries the received_library_invitations edge of a User.
+func (c *UserClient) QueryReceivedLibraryInvitations(_m *User) *LibraryInvitationQuery {
+	query := (&LibraryInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(libraryinvitation.Table, libraryinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReceivedLibraryInvitationsTable, user.ReceivedLibraryInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryReadingGoals queries the reading_goals edge of a User.
+func (c *UserClient) QueryReadingGoals(_m *User) *ReadingGoalQuery {
+	query := (&ReadingGoalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(readinggoal.Table, readinggoal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReadingGoalsTable, user.ReadingGoalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1379,14 +4150,167 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// WishlistItemClient is a client for the WishlistItem schema.
+type WishlistItemClient struct {
+	config
+}
+
+// NewWishlistItemClient returns a client for the WishlistItem from the given config.
+func NewWishlistItemClient(c config) *WishlistItemClient {
+	return &WishlistItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wishlistitem.Hooks(f(g(h())))`.
+func (c *WishlistItemClient) Use(hooks ...Hook) {
+	c.hooks.WishlistItem = append(c.hooks.WishlistItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wishlistitem.Intercept(f(g(h())))`.
+func (c *WishlistItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WishlistItem = append(c.inters.WishlistItem, interceptors...)
+}
+
+// Create returns a builder for creating a WishlistItem entity.
+func (c *WishlistItemClient) Create() *WishlistItemCreate {
+	mutation := newWishlistItemMutation(c.config, OpCreate)
+	return &WishlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WishlistItem entities.
+func (c *WishlistItemClient) CreateBulk(builders ...*WishlistItemCreate) *WishlistItemCreateBulk {
+	return &WishlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WishlistItemClient) MapCreateBulk(slice any, setFunc func(*WishlistItemCreate, int)) *WishlistItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WishlistItemCreateBulk{err: fmt.Errorf("calling to WishlistItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WishlistItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WishlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WishlistItem.
+func (c *WishlistItemClient) Update() *WishlistItemUpdate {
+	mutation := newWishlistItemMutation(c.config, OpUpdate)
+	return &WishlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WishlistItemClient) UpdateOne(_m *WishlistItem) *WishlistItemUpdateOne {
+	mutation := newWishlistItemMutation(c.config, OpUpdateOne, withWishlistItem(_m))
+	return &WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WishlistItemClient) UpdateOneID(id uuid.UUID) *WishlistItemUpdateOne {
+	mutation := newWishlistItemMutation(c.config, OpUpdateOne, withWishlistItemID(id))
+	return &WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WishlistItem.
+func (c *WishlistItemClient) Delete() *WishlistItemDelete {
+	mutation := newWishlistItemMutation(c.config, OpDelete)
+	return &WishlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WishlistItemClient) DeleteOne(_m *WishlistItem) *WishlistItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WishlistItemClient) DeleteOneID(id uuid.UUID) *WishlistItemDeleteOne {
+	builder := c.Delete().Where(wishlistitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WishlistItemDeleteOne{builder}
+}
+
+// Query returns a query builder for WishlistItem.
+func (c *WishlistItemClient) Query() *WishlistItemQuery {
+	return &WishlistItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWishlistItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WishlistItem entity by its id.
+func (c *WishlistItemClient) Get(ctx context.Context, id uuid.UUID) (*WishlistItem, error) {
+	return c.Query().Where(wishlistitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WishlistItemClient) GetX(ctx context.Context, id uuid.UUID) *WishlistItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a WishlistItem.
+func (c *WishlistItemClient) QueryOwner(_m *WishlistItem) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wishlistitem.Table, wishlistitem.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wishlistitem.OwnerTable, wishlistitem.OwnerColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WishlistItemClient) Hooks() []Hook {
+	return c.hooks.WishlistItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *WishlistItemClient) Interceptors() []Interceptor {
+	return c.inters.WishlistItem
+}
+
+func (c *WishlistItemClient) mutate(ctx context.Context, m *WishlistItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WishlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WishlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WishlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WishlistItem mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AdminAPIKey, Book, Bookmark, EmailVerification, ReadingReminder, Review,
-		User []ent.Hook
+		AdminAPIKey, Book, BookCatalog, BookNote, BookStatusHistory, Bookmark,
+		BorrowRequest, DataMigration, EmailVerification, Library, LibraryInvitation,
+		LibraryMember, Loan, ReadingGoal, ReadingReminder, ReadingSession, Review,
+		Shelf, ShelfBook, Tag, User, WishlistItem []ent.Hook
 	}
 	inters struct {
-		AdminAPIKey, Book, Bookmark, EmailVerification, ReadingReminder, Review,
-		User []ent.Interceptor
+		AdminAPIKey, Book, BookCatalog, BookNote, BookStatusHistory, Bookmark,
+		BorrowRequest, DataMigration, EmailVerification, Library, LibraryInvitation,
+		LibraryMember, Loan, ReadingGoal, ReadingReminder, ReadingSession, Review,
+		Shelf, ShelfBook, Tag, User, WishlistItem []ent.Interceptor
 	}
 )
@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/datamigration"
+	"github.com/google/uuid"
+)
+
+// DataMigration is the model entity for the DataMigration schema.
+type DataMigration struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 적용된 데이터 마이그레이션 이름
+	Name string `json:"name,omitempty"`
+	// 적용 시간
+	AppliedAt    time.Time `json:"applied_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DataMigration) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case datamigration.FieldName:
+			values[i] = new(sql.NullString)
+		case datamigration.FieldAppliedAt:
+			values[i] = new(sql.NullTime)
+		case datamigration.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DataMigration fields.
+func (_m *DataMigration) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case datamigration.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case datamigration.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case datamigration.FieldAppliedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field applied_at", values[i])
+			} else if value.Valid {
+				_m.AppliedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DataMigration.
+// This includes values selected through modifiers, order, etc.
+func (_m *DataMigration) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DataMigration.
+// Note that you need to call DataMigration.Unwrap() before calling this method if this DataMigration
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DataMigration) Update() *DataMigrationUpdateOne {
+	return NewDataMigrationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DataMigration entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DataMigration) Unwrap() *DataMigration {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DataMigration is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DataMigration) String() string {
+	var builder strings.Builder
+	builder.WriteString("DataMigration(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("applied_at=")
+	builder.WriteString(_m.AppliedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DataMigrations is a parsable slice of DataMigration.
+type DataMigrations []*DataMigration
@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/datamigration"
+	"github.com/google/uuid"
+)
+
+// DataMigrationCreate is the builder for creating a DataMigration entity.
+type DataMigrationCreate struct {
+	config
+	mutation *DataMigrationMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *DataMigrationCreate) SetName(v string) *DataMigrationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (_c *DataMigrationCreate) SetAppliedAt(v time.Time) *DataMigrationCreate {
+	_c.mutation.SetAppliedAt(v)
+	return _c
+}
+
+// SetNillableAppliedAt sets the "applied_at" field if the given value is not nil.
+func (_c *DataMigrationCreate) SetNillableAppliedAt(v *time.Time) *DataMigrationCreate {
+	if v != nil {
+		_c.SetAppliedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DataMigrationCreate) SetID(v uuid.UUID) *DataMigrationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DataMigrationCreate) SetNillableID(v *uuid.UUID) *DataMigrationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DataMigrationMutation object of the builder.
+func (_c *DataMigrationCreate) Mutation() *DataMigrationMutation {
+	return _c.mutation
+}
+
+// Save creates the DataMigration in the database.
+func (_c *DataMigrationCreate) Save(ctx context.Context) (*DataMigration, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DataMigrationCreate) SaveX(ctx context.Context) *DataMigration {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataMigrationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataMigrationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DataMigrationCreate) defaults() {
+	if _, ok := _c.mutation.AppliedAt(); !ok {
+		v := datamigration.DefaultAppliedAt()
+		_c.mutation.SetAppliedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := datamigration.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DataMigrationCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DataMigration.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := datamigration.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DataMigration.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AppliedAt(); !ok {
+		return &ValidationError{Name: "applied_at", err: errors.New(`ent: missing required field "DataMigration.applied_at"`)}
+	}
+	return nil
+}
+
+func (_c *DataMigrationCreate) sqlSave(ctx context.Context) (*DataMigration, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DataMigrationCreate) createSpec() (*DataMigration, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DataMigration{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(datamigration.Table, sqlgraph.NewFieldSpec(datamigration.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(datamigration.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.AppliedAt(); ok {
+		_spec.SetField(datamigration.FieldAppliedAt, field.TypeTime, value)
+		_node.AppliedAt = value
+	}
+	return _node, _spec
+}
+
+// DataMigrationCreateBulk is the builder for creating many DataMigration entities in bulk.
+type DataMigrationCreateBulk struct {
+	config
+	err      error
+	builders []*DataMigrationCreate
+}
+
+// Save creates the DataMigration entities in the database.
+func (_c *DataMigrationCreateBulk) Save(ctx context.Context) ([]*DataMigration, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DataMigration, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DataMigrationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DataMigrationCreateBulk) SaveX(ctx context.Context) []*DataMigration {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DataMigrationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DataMigrationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
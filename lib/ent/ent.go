@@ -14,11 +14,26 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/adminapikey"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/datamigration"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/emailverification"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingreminder"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -81,11 +96,26 @@ func checkColumn(t, c string) error {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			adminapikey.Table:       adminapikey.ValidColumn,
 			book.Table:              book.ValidColumn,
+			bookcatalog.Table:       bookcatalog.ValidColumn,
+			booknote.Table:          booknote.ValidColumn,
+			bookstatushistory.Table: bookstatushistory.ValidColumn,
 			bookmark.Table:          bookmark.ValidColumn,
+			borrowrequest.Table:     borrowrequest.ValidColumn,
+			datamigration.Table:     datamigration.ValidColumn,
 			emailverification.Table: emailverification.ValidColumn,
+			library.Table:           library.ValidColumn,
+			libraryinvitation.Table: libraryinvitation.ValidColumn,
+			librarymember.Table:     librarymember.ValidColumn,
+			loan.Table:              loan.ValidColumn,
+			readinggoal.Table:       readinggoal.ValidColumn,
 			readingreminder.Table:   readingreminder.ValidColumn,
+			readingsession.Table:    readingsession.ValidColumn,
 			review.Table:            review.ValidColumn,
+			shelf.Table:             shelf.ValidColumn,
+			shelfbook.Table:         shelfbook.ValidColumn,
+			tag.Table:               tag.ValidColumn,
 			user.Table:              user.ValidColumn,
+			wishlistitem.Table:      wishlistitem.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
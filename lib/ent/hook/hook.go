@@ -33,6 +33,42 @@ func (f BookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BookMutation", m)
 }
 
+// The BookCatalogFunc type is an adapter to allow the use of ordinary
+// function as BookCatalog mutator.
+type BookCatalogFunc func(context.Context, *ent.BookCatalogMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BookCatalogFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BookCatalogMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BookCatalogMutation", m)
+}
+
+// The BookNoteFunc type is an adapter to allow the use of ordinary
+// function as BookNote mutator.
+type BookNoteFunc func(context.Context, *ent.BookNoteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BookNoteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BookNoteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BookNoteMutation", m)
+}
+
+// The BookStatusHistoryFunc type is an adapter to allow the use of ordinary
+// function as BookStatusHistory mutator.
+type BookStatusHistoryFunc func(context.Context, *ent.BookStatusHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BookStatusHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BookStatusHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BookStatusHistoryMutation", m)
+}
+
 // The BookmarkFunc type is an adapter to allow the use of ordinary
 // function as Bookmark mutator.
 type BookmarkFunc func(context.Context, *ent.BookmarkMutation) (ent.Value, error)
@@ -45,6 +81,30 @@ func (f BookmarkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BookmarkMutation", m)
 }
 
+// The BorrowRequestFunc type is an adapter to allow the use of ordinary
+// function as BorrowRequest mutator.
+type BorrowRequestFunc func(context.Context, *ent.BorrowRequestMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BorrowRequestFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BorrowRequestMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BorrowRequestMutation", m)
+}
+
+// The DataMigrationFunc type is an adapter to allow the use of ordinary
+// function as DataMigration mutator.
+type DataMigrationFunc func(context.Context, *ent.DataMigrationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DataMigrationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DataMigrationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DataMigrationMutation", m)
+}
+
 // The EmailVerificationFunc type is an adapter to allow the use of ordinary
 // function as EmailVerification mutator.
 type EmailVerificationFunc func(context.Context, *ent.EmailVerificationMutation) (ent.Value, error)
@@ -57,6 +117,66 @@ func (f EmailVerificationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EmailVerificationMutation", m)
 }
 
+// The LibraryFunc type is an adapter to allow the use of ordinary
+// function as Library mutator.
+type LibraryFunc func(context.Context, *ent.LibraryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LibraryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LibraryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LibraryMutation", m)
+}
+
+// The LibraryInvitationFunc type is an adapter to allow the use of ordinary
+// function as LibraryInvitation mutator.
+type LibraryInvitationFunc func(context.Context, *ent.LibraryInvitationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LibraryInvitationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LibraryInvitationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LibraryInvitationMutation", m)
+}
+
+// The LibraryMemberFunc type is an adapter to allow the use of ordinary
+// function as LibraryMember mutator.
+type LibraryMemberFunc func(context.Context, *ent.LibraryMemberMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LibraryMemberFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LibraryMemberMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LibraryMemberMutation", m)
+}
+
+// The LoanFunc type is an adapter to allow the use of ordinary
+// function as Loan mutator.
+type LoanFunc func(context.Context, *ent.LoanMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LoanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LoanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LoanMutation", m)
+}
+
+// The ReadingGoalFunc type is an adapter to allow the use of ordinary
+// function as ReadingGoal mutator.
+type ReadingGoalFunc func(context.Context, *ent.ReadingGoalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReadingGoalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReadingGoalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReadingGoalMutation", m)
+}
+
 // The ReadingReminderFunc type is an adapter to allow the use of ordinary
 // function as ReadingReminder mutator.
 type ReadingReminderFunc func(context.Context, *ent.ReadingReminderMutation) (ent.Value, error)
@@ -69,6 +189,18 @@ func (f ReadingReminderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReadingReminderMutation", m)
 }
 
+// The ReadingSessionFunc type is an adapter to allow the use of ordinary
+// function as ReadingSession mutator.
+type ReadingSessionFunc func(context.Context, *ent.ReadingSessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReadingSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReadingSessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReadingSessionMutation", m)
+}
+
 // The ReviewFunc type is an adapter to allow the use of ordinary
 // function as Review mutator.
 type ReviewFunc func(context.Context, *ent.ReviewMutation) (ent.Value, error)
@@ -81,6 +213,42 @@ func (f ReviewFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReviewMutation", m)
 }
 
+// The ShelfFunc type is an adapter to allow the use of ordinary
+// function as Shelf mutator.
+type ShelfFunc func(context.Context, *ent.ShelfMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShelfFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShelfMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShelfMutation", m)
+}
+
+// The ShelfBookFunc type is an adapter to allow the use of ordinary
+// function as ShelfBook mutator.
+type ShelfBookFunc func(context.Context, *ent.ShelfBookMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ShelfBookFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ShelfBookMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ShelfBookMutation", m)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary
+// function as Tag mutator.
+type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -93,6 +261,18 @@ func (f UserFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserMutation", m)
 }
 
+// The WishlistItemFunc type is an adapter to allow the use of ordinary
+// function as WishlistItem mutator.
+type WishlistItemFunc func(context.Context, *ent.WishlistItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WishlistItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WishlistItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WishlistItemMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/google/uuid"
+)
+
+// Library is the model entity for the Library schema.
+type Library struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 공유 서재 이름
+	Name string `json:"name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LibraryQuery when eager-loading is set.
+	Edges        LibraryEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LibraryEdges holds the relations/edges for other nodes in the graph.
+type LibraryEdges struct {
+	// Members holds the value of the members edge.
+	Members []*LibraryMember `json:"members,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*LibraryInvitation `json:"invitations,omitempty"`
+	// Books holds the value of the books edge.
+	Books []*Book `json:"books,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// MembersOrErr returns the Members value or an error if the edge
+// was not loaded in eager-loading.
+func (e LibraryEdges) MembersOrErr() ([]*LibraryMember, error) {
+	if e.loadedTypes[0] {
+		return e.Members, nil
+	}
+	return nil, &NotLoadedError{edge: "members"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e LibraryEdges) InvitationsOrErr() ([]*LibraryInvitation, error) {
+	if e.loadedTypes[1] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// BooksOrErr returns the Books value or an error if the edge
+// was not loaded in eager-loading.
+func (e LibraryEdges) BooksOrErr() ([]*Book, error) {
+	if e.loadedTypes[2] {
+		return e.Books, nil
+	}
+	return nil, &NotLoadedError{edge: "books"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Library) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case library.FieldName:
+			values[i] = new(sql.NullString)
+		case library.FieldCreatedAt, library.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case library.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Library fields.
+func (_m *Library) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case library.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case library.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case library.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case library.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Library.
+// This includes values selected through modifiers, order, etc.
+func (_m *Library) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryMembers queries the "members" edge of the Library entity.
+func (_m *Library) QueryMembers() *LibraryMemberQuery {
+	return NewLibraryClient(_m.config).QueryMembers(_m)
+}
+
+// QueryInvitations queries the "invitations" edge of the Library entity.
+func (_m *Library) QueryInvitations() *LibraryInvitationQuery {
+	return NewLibraryClient(_m.config).QueryInvitations(_m)
+}
+
+// QueryBooks queries the "books" edge of the Library entity.
+func (_m *Library) QueryBooks() *BookQuery {
+	return NewLibraryClient(_m.config).QueryBooks(_m)
+}
+
+// Update returns a builder for updating this Library.
+// Note that you need to call Library.Unwrap() before calling this method if this Library
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Library) Update() *LibraryUpdateOne {
+	return NewLibraryClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Library entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Library) Unwrap() *Library {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Library is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Library) String() string {
+	var builder strings.Builder
+	builder.WriteString("Library(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Libraries is a parsable slice of Library.
+type Libraries []*Library
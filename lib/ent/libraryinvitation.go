@@ -0,0 +1,250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// LibraryInvitation is the model entity for the LibraryInvitation schema.
+type LibraryInvitation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 초대를 수락하면 받을 역할
+	Role libraryinvitation.Role `json:"role,omitempty"`
+	// 초대 상태
+	Status libraryinvitation.Status `json:"status,omitempty"`
+	// 수락/거절/취소한 시간
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LibraryInvitationQuery when eager-loading is set.
+	Edges                             LibraryInvitationEdges `json:"edges"`
+	library_invitations               *uuid.UUID
+	user_sent_library_invitations     *uuid.UUID
+	user_received_library_invitations *uuid.UUID
+	selectValues                      sql.SelectValues
+}
+
+// LibraryInvitationEdges holds the relations/edges for other nodes in the graph.
+type LibraryInvitationEdges struct {
+	// Library holds the value of the library edge.
+	Library *Library `json:"library,omitempty"`
+	// Inviter holds the value of the inviter edge.
+	Inviter *User `json:"inviter,omitempty"`
+	// Invitee holds the value of the invitee edge.
+	Invitee *User `json:"invitee,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// LibraryOrErr returns the Library value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LibraryInvitationEdges) LibraryOrErr() (*Library, error) {
+	if e.Library != nil {
+		return e.Library, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: library.Label}
+	}
+	return nil, &NotLoadedError{edge: "library"}
+}
+
+// InviterOrErr returns the Inviter value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LibraryInvitationEdges) InviterOrErr() (*User, error) {
+	if e.Inviter != nil {
+		return e.Inviter, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "inviter"}
+}
+
+// InviteeOrErr returns the Invitee value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LibraryInvitationEdges) InviteeOrErr() (*User, error) {
+	if e.Invitee != nil {
+		return e.Invitee, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "invitee"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LibraryInvitation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case libraryinvitation.FieldRole, libraryinvitation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case libraryinvitation.FieldRespondedAt, libraryinvitation.FieldCreatedAt, libraryinvitation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case libraryinvitation.FieldID:
+			values[i] = new(uuid.UUID)
+		case libraryinvitation.ForeignKeys[0]: // library_invitations
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case libraryinvitation.ForeignKeys[1]: // user_sent_library_invitations
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case libraryinvitation.ForeignKeys[2]: // user_received_library_invitations
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LibraryInvitation fields.
+func (_m *LibraryInvitation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case libraryinvitation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case libraryinvitation.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = libraryinvitation.Role(value.String)
+			}
+		case libraryinvitation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = libraryinvitation.Status(value.String)
+			}
+		case libraryinvitation.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				_m.RespondedAt = new(time.Time)
+				*_m.RespondedAt = value.Time
+			}
+		case libraryinvitation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case libraryinvitation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case libraryinvitation.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field library_invitations", values[i])
+			} else if value.Valid {
+				_m.library_invitations = new(uuid.UUID)
+				*_m.library_invitations = *value.S.(*uuid.UUID)
+			}
+		case libraryinvitation.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_sent_library_invitations", values[i])
+			} else if value.Valid {
+				_m.user_sent_library_invitations = new(uuid.UUID)
+				*_m.user_sent_library_invitations = *value.S.(*uuid.UUID)
+			}
+		case libraryinvitation.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_received_library_invitations", values[i])
+			} else if value.Valid {
+				_m.user_received_library_invitations = new(uuid.UUID)
+				*_m.user_received_library_invitations = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LibraryInvitation.
+// This includes values selected through modifiers, order, etc.
+func (_m *LibraryInvitation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLibrary queries the "library" edge of the LibraryInvitation entity.
+func (_m *LibraryInvitation) QueryLibrary() *LibraryQuery {
+	return NewLibraryInvitationClient(_m.config).QueryLibrary(_m)
+}
+
+// QueryInviter queries the "inviter" edge of the LibraryInvitation entity.
+func (_m *LibraryInvitation) QueryInviter() *UserQuery {
+	return NewLibraryInvitationClient(_m.config).QueryInviter(_m)
+}
+
+// QueryInvitee queries the "invitee" edge of the LibraryInvitation entity.
+func (_m *LibraryInvitation) QueryInvitee() *UserQuery {
+	return NewLibraryInvitationClient(_m.config).QueryInvitee(_m)
+}
+
+// Update returns a builder for updating this LibraryInvitation.
+// Note that you need to call LibraryInvitation.Unwrap() before calling this method if this LibraryInvitation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LibraryInvitation) Update() *LibraryInvitationUpdateOne {
+	return NewLibraryInvitationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LibraryInvitation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LibraryInvitation) Unwrap() *LibraryInvitation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LibraryInvitation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LibraryInvitation) String() string {
+	var builder strings.Builder
+	builder.WriteString("LibraryInvitation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.RespondedAt; v != nil {
+		builder.WriteString("responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LibraryInvitations is a parsable slice of LibraryInvitation.
+type LibraryInvitations []*LibraryInvitation
@@ -0,0 +1,231 @@
+// Code generated by ent, DO NOT EDIT.
+
+package libraryinvitation
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the libraryinvitation type in the database.
+	Label = "library_invitation"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRole holds the string denoting the role field in the database.
+	FieldRole = "role"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRespondedAt holds the string denoting the responded_at field in the database.
+	FieldRespondedAt = "responded_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeLibrary holds the string denoting the library edge name in mutations.
+	EdgeLibrary = "library"
+	// EdgeInviter holds the string denoting the inviter edge name in mutations.
+	EdgeInviter = "inviter"
+	// EdgeInvitee holds the string denoting the invitee edge name in mutations.
+	EdgeInvitee = "invitee"
+	// Table holds the table name of the libraryinvitation in the database.
+	Table = "library_invitations"
+	// LibraryTable is the table that holds the library relation/edge.
+	LibraryTable = "library_invitations"
+	// LibraryInverseTable is the table name for the Library entity.
+	// It exists in this package in order to avoid circular dependency with the "library" package.
+	LibraryInverseTable = "libraries"
+	// LibraryColumn is the table column denoting the library relation/edge.
+	LibraryColumn = "library_invitations"
+	// InviterTable is the table that holds the inviter relation/edge.
+	InviterTable = "library_invitations"
+	// InviterInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	InviterInverseTable = "users"
+	// InviterColumn is the table column denoting the inviter relation/edge.
+	InviterColumn = "user_sent_library_invitations"
+	// InviteeTable is the table that holds the invitee relation/edge.
+	InviteeTable = "library_invitations"
+	// InviteeInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	InviteeInverseTable = "users"
+	// InviteeColumn is the table column denoting the invitee relation/edge.
+	InviteeColumn = "user_received_library_invitations"
+)
+
+// Columns holds all SQL columns for libraryinvitation fields.
+var Columns = []string{
+	FieldID,
+	FieldRole,
+	FieldStatus,
+	FieldRespondedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "library_invitations"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"library_invitations",
+	"user_sent_library_invitations",
+	"user_received_library_invitations",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Role defines the type for the "role" enum field.
+type Role string
+
+// RoleViewer is the default value of the Role enum.
+const DefaultRole = RoleViewer
+
+// Role values.
+const (
+	RoleEditor Role = "editor"
+	RoleViewer Role = "viewer"
+)
+
+func (r Role) String() string {
+	return string(r)
+}
+
+// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
+func RoleValidator(r Role) error {
+	switch r {
+	case RoleEditor, RoleViewer:
+		return nil
+	default:
+		return fmt.Errorf("libraryinvitation: invalid enum value for role field: %q", r)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending  Status = "pending"
+	StatusAccepted Status = "accepted"
+	StatusDeclined Status = "declined"
+	StatusCanceled Status = "canceled"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusAccepted, StatusDeclined, StatusCanceled:
+		return nil
+	default:
+		return fmt.Errorf("libraryinvitation: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the LibraryInvitation queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRole orders the results by the role field.
+func ByRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRole, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRespondedAt orders the results by the responded_at field.
+func ByRespondedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRespondedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByLibraryField orders the results by library field.
+func ByLibraryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLibraryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInviterField orders the results by inviter field.
+func ByInviterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInviterStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByInviteeField orders the results by invitee field.
+func ByInviteeField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newInviteeStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLibraryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LibraryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LibraryTable, LibraryColumn),
+	)
+}
+func newInviterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InviterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, InviterTable, InviterColumn),
+	)
+}
+func newInviteeStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(InviteeInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, InviteeTable, InviteeColumn),
+	)
+}
@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// LibraryInvitationCreate is the builder for creating a LibraryInvitation entity.
+type LibraryInvitationCreate struct {
+	config
+	mutation *LibraryInvitationMutation
+	hooks    []Hook
+}
+
+// SetRole sets the "role" field.
+func (_c *LibraryInvitationCreate) SetRole(v libraryinvitation.Role) *LibraryInvitationCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_c *LibraryInvitationCreate) SetNillableRole(v *libraryinvitation.Role) *LibraryInvitationCreate {
+	if v != nil {
+		_c.SetRole(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *LibraryInvitationCreate) SetStatus(v libraryinvitation.Status) *LibraryInvitationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *LibraryInvitationCreate) SetNillableStatus(v *libraryinvitation.Status) *LibraryInvitationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *LibraryInvitationCreate) SetRespondedAt(v time.Time) *LibraryInvitationCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *LibraryInvitationCreate) SetNillableRespondedAt(v *time.Time) *LibraryInvitationCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LibraryInvitationCreate) SetCreatedAt(v time.Time) *LibraryInvitationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LibraryInvitationCreate) SetNillableCreatedAt(v *time.Time) *LibraryInvitationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LibraryInvitationCreate) SetUpdatedAt(v time.Time) *LibraryInvitationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LibraryInvitationCreate) SetNillableUpdatedAt(v *time.Time) *LibraryInvitationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LibraryInvitationCreate) SetID(v uuid.UUID) *LibraryInvitationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LibraryInvitationCreate) SetNillableID(v *uuid.UUID) *LibraryInvitationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetLibraryID sets the "library" edge to the Library entity by ID.
+func (_c *LibraryInvitationCreate) SetLibraryID(id uuid.UUID) *LibraryInvitationCreate {
+	_c.mutation.SetLibraryID(id)
+	return _c
+}
+
+// SetLibrary sets the "library" edge to the Library entity.
+func (_c *LibraryInvitationCreate) SetLibrary(v *Library) *LibraryInvitationCreate {
+	return _c.SetLibraryID(v.ID)
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by ID.
+func (_c *LibraryInvitationCreate) SetInviterID(id uuid.UUID) *LibraryInvitationCreate {
+	_c.mutation.SetInviterID(id)
+	return _c
+}
+
+// SetInviter sets the "inviter" edge to the User entity.
+func (_c *LibraryInvitationCreate) SetInviter(v *User) *LibraryInvitationCreate {
+	return _c.SetInviterID(v.ID)
+}
+
+// SetInviteeID sets the "invitee" edge to the User entity by ID.
+func (_c *LibraryInvitationCreate) SetInviteeID(id uuid.UUID) *LibraryInvitationCreate {
+	_c.mutation.SetInviteeID(id)
+	return _c
+}
+
+// SetInvitee sets the "invitee" edge to the User entity.
+func (_c *LibraryInvitationCreate) SetInvitee(v *User) *LibraryInvitationCreate {
+	return _c.SetInviteeID(v.ID)
+}
+
+// Mutation returns the LibraryInvitationMutation object of the builder.
+func (_c *LibraryInvitationCreate) Mutation() *LibraryInvitationMutation {
+	return _c.mutation
+}
+
+// Save creates the LibraryInvitation in the database.
+func (_c *LibraryInvitationCreate) Save(ctx context.Context) (*LibraryInvitation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LibraryInvitationCreate) SaveX(ctx context.Context) *LibraryInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LibraryInvitationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LibraryInvitationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LibraryInvitationCreate) defaults() {
+	if _, ok := _c.mutation.Role(); !ok {
+		v := libraryinvitation.DefaultRole
+		_c.mutation.SetRole(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := libraryinvitation.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := libraryinvitation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := libraryinvitation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := libraryinvitation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LibraryInvitationCreate) check() error {
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "LibraryInvitation.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := libraryinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LibraryInvitation.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "LibraryInvitation.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := libraryinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LibraryInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LibraryInvitation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "LibraryInvitation.updated_at"`)}
+	}
+	if len(_c.mutation.LibraryIDs()) == 0 {
+		return &ValidationError{Name: "library", err: errors.New(`ent: missing required edge "LibraryInvitation.library"`)}
+	}
+	if len(_c.mutation.InviterIDs()) == 0 {
+		return &ValidationError{Name: "inviter", err: errors.New(`ent: missing required edge "LibraryInvitation.inviter"`)}
+	}
+	if len(_c.mutation.InviteeIDs()) == 0 {
+		return &ValidationError{Name: "invitee", err: errors.New(`ent: missing required edge "LibraryInvitation.invitee"`)}
+	}
+	return nil
+}
+
+func (_c *LibraryInvitationCreate) sqlSave(ctx context.Context) (*LibraryInvitation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LibraryInvitationCreate) createSpec() (*LibraryInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LibraryInvitation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(libraryinvitation.Table, sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(libraryinvitation.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(libraryinvitation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(libraryinvitation.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(libraryinvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(libraryinvitation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.LibraryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.LibraryTable,
+			Columns: []string{libraryinvitation.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.library_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InviterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviterTable,
+			Columns: []string{libraryinvitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_sent_library_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviteeTable,
+			Columns: []string{libraryinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_received_library_invitations = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LibraryInvitationCreateBulk is the builder for creating many LibraryInvitation entities in bulk.
+type LibraryInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*LibraryInvitationCreate
+}
+
+// Save creates the LibraryInvitation entities in the database.
+func (_c *LibraryInvitationCreateBulk) Save(ctx context.Context) ([]*LibraryInvitation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LibraryInvitation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LibraryInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LibraryInvitationCreateBulk) SaveX(ctx context.Context) []*LibraryInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LibraryInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LibraryInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,764 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// LibraryInvitationQuery is the builder for querying LibraryInvitation entities.
+type LibraryInvitationQuery struct {
+	config
+	ctx         *QueryContext
+	order       []libraryinvitation.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.LibraryInvitation
+	withLibrary *LibraryQuery
+	withInviter *UserQuery
+	withInvitee *UserQuery
+	withFKs     bool
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the LibraryInvitationQuery builder.
+func (_q *LibraryInvitationQuery) Where(ps ...predicate.LibraryInvitation) *LibraryInvitationQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *LibraryInvitationQuery) Limit(limit int) *LibraryInvitationQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *LibraryInvitationQuery) Offset(offset int) *LibraryInvitationQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *LibraryInvitationQuery) Unique(unique bool) *LibraryInvitationQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *LibraryInvitationQuery) Order(o ...libraryinvitation.OrderOption) *LibraryInvitationQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryLibrary chains the current query on the "library" edge.
+func (_q *LibraryInvitationQuery) QueryLibrary() *LibraryQuery {
+	query := (&LibraryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(libraryinvitation.Table, libraryinvitation.FieldID, selector),
+			sqlgraph.To(library.Table, library.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, libraryinvitation.LibraryTable, libraryinvitation.LibraryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInviter chains the current query on the "inviter" edge.
+func (_q *LibraryInvitationQuery) QueryInviter() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(libraryinvitation.Table, libraryinvitation.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, libraryinvitation.InviterTable, libraryinvitation.InviterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryInvitee chains the current query on the "invitee" edge.
+func (_q *LibraryInvitationQuery) QueryInvitee() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(libraryinvitation.Table, libraryinvitation.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, libraryinvitation.InviteeTable, libraryinvitation.InviteeColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first LibraryInvitation entity from the query.
+// Returns a *NotFoundError when no LibraryInvitation was found.
+func (_q *LibraryInvitationQuery) First(ctx context.Context) (*LibraryInvitation, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{libraryinvitation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *LibraryInvitationQuery) FirstX(ctx context.Context) *LibraryInvitation {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first LibraryInvitation ID from the query.
+// Returns a *NotFoundError when no LibraryInvitation ID was found.
+func (_q *LibraryInvitationQuery) FirstID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(1).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{libraryinvitation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (_q *LibraryInvitationQuery) FirstIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single LibraryInvitation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one LibraryInvitation entity is found.
+// Returns a *NotFoundError when no LibraryInvitation entities are found.
+func (_q *LibraryInvitationQuery) Only(ctx context.Context) (*LibraryInvitation, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{libraryinvitation.Label}
+	default:
+		return nil, &NotSingularError{libraryinvitation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *LibraryInvitationQuery) OnlyX(ctx context.Context) *LibraryInvitation {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only LibraryInvitation ID in the query.
+// Returns a *NotSingularError when more than one LibraryInvitation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (_q *LibraryInvitationQuery) OnlyID(ctx context.Context) (id uuid.UUID, err error) {
+	var ids []uuid.UUID
+	if ids, err = _q.Limit(2).IDs(setContextOp(ctx, _q.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{libraryinvitation.Label}
+	default:
+		err = &NotSingularError{libraryinvitation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (_q *LibraryInvitationQuery) OnlyIDX(ctx context.Context) uuid.UUID {
+	id, err := _q.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of LibraryInvitations.
+func (_q *LibraryInvitationQuery) All(ctx context.Context) ([]*LibraryInvitation, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*LibraryInvitation, *LibraryInvitationQuery]()
+	return withInterceptors[[]*LibraryInvitation](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *LibraryInvitationQuery) AllX(ctx context.Context) []*LibraryInvitation {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of LibraryInvitation IDs.
+func (_q *LibraryInvitationQuery) IDs(ctx context.Context) (ids []uuid.UUID, err error) {
+	if _q.ctx.Unique == nil && _q.path != nil {
+		_q.Unique(true)
+	}
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryIDs)
+	if err = _q.Select(libraryinvitation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (_q *LibraryInvitationQuery) IDsX(ctx context.Context) []uuid.UUID {
+	ids, err := _q.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (_q *LibraryInvitationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*LibraryInvitationQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *LibraryInvitationQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *LibraryInvitationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *LibraryInvitationQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the LibraryInvitationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *LibraryInvitationQuery) Clone() *LibraryInvitationQuery {
+	if _q == nil {
+		return nil
+	}
+	return &LibraryInvitationQuery{
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]libraryinvitation.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.LibraryInvitation{}, _q.predicates...),
+		withLibrary: _q.withLibrary.Clone(),
+		withInviter: _q.withInviter.Clone(),
+		withInvitee: _q.withInvitee.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithLibrary tells the query-builder to eager-load the nodes that are connected to
+// the "library" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LibraryInvitationQuery) WithLibrary(opts ...func(*LibraryQuery)) *LibraryInvitationQuery {
+	query := (&LibraryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLibrary = query
+	return _q
+}
+
+// WithInviter tells the query-builder to eager-load the nodes that are connected to
+// the "inviter" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LibraryInvitationQuery) WithInviter(opts ...func(*UserQuery)) *LibraryInvitationQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInviter = query
+	return _q
+}
+
+// WithInvitee tells the query-builder to eager-load the nodes that are connected to
+// the "invitee" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *LibraryInvitationQuery) WithInvitee(opts ...func(*UserQuery)) *LibraryInvitationQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withInvitee = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Role libraryinvitation.Role `json:"role,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.LibraryInvitation.Query().
+//		GroupBy(libraryinvitation.FieldRole).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *LibraryInvitationQuery) GroupBy(field string, fields ...string) *LibraryInvitationGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &LibraryInvitationGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = libraryinvitation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Role libraryinvitation.Role `json:"role,omitempty"`
+//	}
+//
+//	client.LibraryInvitation.Query().
+//		Select(libraryinvitation.FieldRole).
+//		Scan(ctx, &v)
+func (_q *LibraryInvitationQuery) Select(fields ...string) *LibraryInvitationSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &LibraryInvitationSelect{LibraryInvitationQuery: _q}
+	sbuild.label = libraryinvitation.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a LibraryInvitationSelect configured with the given aggregations.
+func (_q *LibraryInvitationQuery) Aggregate(fns ...AggregateFunc) *LibraryInvitationSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *LibraryInvitationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !libraryinvitation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *LibraryInvitationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*LibraryInvitation, error) {
+	var (
+		nodes       = []*LibraryInvitation{}
+		withFKs     = _q.withFKs
+		_spec       = _q.querySpec()
+		loadedTypes = [3]bool{
+			_q.withLibrary != nil,
+			_q.withInviter != nil,
+			_q.withInvitee != nil,
+		}
+	)
+	if _q.withLibrary != nil || _q.withInviter != nil || _q.withInvitee != nil {
+		withFKs = true
+	}
+	if withFKs {
+		_spec.Node.Columns = append(_spec.Node.Columns, libraryinvitation.ForeignKeys...)
+	}
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*LibraryInvitation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &LibraryInvitation{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withLibrary; query != nil {
+		if err := _q.loadLibrary(ctx, query, nodes, nil,
+			func(n *LibraryInvitation, e *Library) { n.Edges.Library = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInviter; query != nil {
+		if err := _q.loadInviter(ctx, query, nodes, nil,
+			func(n *LibraryInvitation, e *User) { n.Edges.Inviter = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withInvitee; query != nil {
+		if err := _q.loadInvitee(ctx, query, nodes, nil,
+			func(n *LibraryInvitation, e *User) { n.Edges.Invitee = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *LibraryInvitationQuery) loadLibrary(ctx context.Context, query *LibraryQuery, nodes []*LibraryInvitation, init func(*LibraryInvitation), assign func(*LibraryInvitation, *Library)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LibraryInvitation)
+	for i := range nodes {
+		if nodes[i].library_invitations == nil {
+			continue
+		}
+		fk := *nodes[i].library_invitations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(library.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "library_invitations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *LibraryInvitationQuery) loadInviter(ctx context.Context, query *UserQuery, nodes []*LibraryInvitation, init func(*LibraryInvitation), assign func(*LibraryInvitation, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LibraryInvitation)
+	for i := range nodes {
+		if nodes[i].user_sent_library_invitations == nil {
+			continue
+		}
+		fk := *nodes[i].user_sent_library_invitations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_sent_library_invitations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *LibraryInvitationQuery) loadInvitee(ctx context.Context, query *UserQuery, nodes []*LibraryInvitation, init func(*LibraryInvitation), assign func(*LibraryInvitation, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*LibraryInvitation)
+	for i := range nodes {
+		if nodes[i].user_received_library_invitations == nil {
+			continue
+		}
+		fk := *nodes[i].user_received_library_invitations
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_received_library_invitations" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *LibraryInvitationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Node.Columns = _q.ctx.Fields
+	if len(_q.ctx.Fields) > 0 {
+		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *LibraryInvitationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(libraryinvitation.Table, libraryinvitation.Columns, sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID))
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, libraryinvitation.FieldID)
+		for i := range fields {
+			if fields[i] != libraryinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *LibraryInvitationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(libraryinvitation.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = libraryinvitation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// LibraryInvitationGroupBy is the group-by builder for LibraryInvitation entities.
+type LibraryInvitationGroupBy struct {
+	selector
+	build *LibraryInvitationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *LibraryInvitationGroupBy) Aggregate(fns ...AggregateFunc) *LibraryInvitationGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *LibraryInvitationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LibraryInvitationQuery, *LibraryInvitationGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *LibraryInvitationGroupBy) sqlScan(ctx context.Context, root *LibraryInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// LibraryInvitationSelect is the builder for selecting fields of LibraryInvitation entities.
+type LibraryInvitationSelect struct {
+	*LibraryInvitationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *LibraryInvitationSelect) Aggregate(fns ...AggregateFunc) *LibraryInvitationSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *LibraryInvitationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*LibraryInvitationQuery, *LibraryInvitationSelect](ctx, _s.LibraryInvitationQuery, _s, _s.inters, v)
+}
+
+func (_s *LibraryInvitationSelect) sqlScan(ctx context.Context, root *LibraryInvitationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
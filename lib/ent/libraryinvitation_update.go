@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// LibraryInvitationUpdate is the builder for updating LibraryInvitation entities.
+type LibraryInvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LibraryInvitationMutation
+}
+
+// Where appends a list predicates to the LibraryInvitationUpdate builder.
+func (_u *LibraryInvitationUpdate) Where(ps ...predicate.LibraryInvitation) *LibraryInvitationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *LibraryInvitationUpdate) SetRole(v libraryinvitation.Role) *LibraryInvitationUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *LibraryInvitationUpdate) SetNillableRole(v *libraryinvitation.Role) *LibraryInvitationUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LibraryInvitationUpdate) SetStatus(v libraryinvitation.Status) *LibraryInvitationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LibraryInvitationUpdate) SetNillableStatus(v *libraryinvitation.Status) *LibraryInvitationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *LibraryInvitationUpdate) SetRespondedAt(v time.Time) *LibraryInvitationUpdate {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *LibraryInvitationUpdate) SetNillableRespondedAt(v *time.Time) *LibraryInvitationUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *LibraryInvitationUpdate) ClearRespondedAt() *LibraryInvitationUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LibraryInvitationUpdate) SetUpdatedAt(v time.Time) *LibraryInvitationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLibraryID sets the "library" edge to the Library entity by ID.
+func (_u *LibraryInvitationUpdate) SetLibraryID(id uuid.UUID) *LibraryInvitationUpdate {
+	_u.mutation.SetLibraryID(id)
+	return _u
+}
+
+// SetLibrary sets the "library" edge to the Library entity.
+func (_u *LibraryInvitationUpdate) SetLibrary(v *Library) *LibraryInvitationUpdate {
+	return _u.SetLibraryID(v.ID)
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by ID.
+func (_u *LibraryInvitationUpdate) SetInviterID(id uuid.UUID) *LibraryInvitationUpdate {
+	_u.mutation.SetInviterID(id)
+	return _u
+}
+
+// SetInviter sets the "inviter" edge to the User entity.
+func (_u *LibraryInvitationUpdate) SetInviter(v *User) *LibraryInvitationUpdate {
+	return _u.SetInviterID(v.ID)
+}
+
+// SetInviteeID sets the "invitee" edge to the User entity by ID.
+func (_u *LibraryInvitationUpdate) SetInviteeID(id uuid.UUID) *LibraryInvitationUpdate {
+	_u.mutation.SetInviteeID(id)
+	return _u
+}
+
+// SetInvitee sets the "invitee" edge to the User entity.
+func (_u *LibraryInvitationUpdate) SetInvitee(v *User) *LibraryInvitationUpdate {
+	return _u.SetInviteeID(v.ID)
+}
+
+// Mutation returns the LibraryInvitationMutation object of the builder.
+func (_u *LibraryInvitationUpdate) Mutation() *LibraryInvitationMutation {
+	return _u.mutation
+}
+
+// ClearLibrary clears the "library" edge to the Library entity.
+func (_u *LibraryInvitationUpdate) ClearLibrary() *LibraryInvitationUpdate {
+	_u.mutation.ClearLibrary()
+	return _u
+}
+
+// ClearInviter clears the "inviter" edge to the User entity.
+func (_u *LibraryInvitationUpdate) ClearInviter() *LibraryInvitationUpdate {
+	_u.mutation.ClearInviter()
+	return _u
+}
+
+// ClearInvitee clears the "invitee" edge to the User entity.
+func (_u *LibraryInvitationUpdate) ClearInvitee() *LibraryInvitationUpdate {
+	_u.mutation.ClearInvitee()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LibraryInvitationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LibraryInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LibraryInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LibraryInvitationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LibraryInvitationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := libraryinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LibraryInvitationUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := libraryinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LibraryInvitation.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := libraryinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LibraryInvitation.status": %w`, err)}
+		}
+	}
+	if _u.mutation.LibraryCleared() && len(_u.mutation.LibraryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryInvitation.library"`)
+	}
+	if _u.mutation.InviterCleared() && len(_u.mutation.InviterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryInvitation.inviter"`)
+	}
+	if _u.mutation.InviteeCleared() && len(_u.mutation.InviteeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryInvitation.invitee"`)
+	}
+	return nil
+}
+
+func (_u *LibraryInvitationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(libraryinvitation.Table, libraryinvitation.Columns, sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(libraryinvitation.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(libraryinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(libraryinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(libraryinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(libraryinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LibraryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.LibraryTable,
+			Columns: []string{libraryinvitation.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LibraryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.LibraryTable,
+			Columns: []string{libraryinvitation.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InviterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviterTable,
+			Columns: []string{libraryinvitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviterTable,
+			Columns: []string{libraryinvitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviteeTable,
+			Columns: []string{libraryinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviteeTable,
+			Columns: []string{libraryinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{libraryinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LibraryInvitationUpdateOne is the builder for updating a single LibraryInvitation entity.
+type LibraryInvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LibraryInvitationMutation
+}
+
+// SetRole sets the "role" field.
+func (_u *LibraryInvitationUpdateOne) SetRole(v libraryinvitation.Role) *LibraryInvitationUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *LibraryInvitationUpdateOne) SetNillableRole(v *libraryinvitation.Role) *LibraryInvitationUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *LibraryInvitationUpdateOne) SetStatus(v libraryinvitation.Status) *LibraryInvitationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *LibraryInvitationUpdateOne) SetNillableStatus(v *libraryinvitation.Status) *LibraryInvitationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *LibraryInvitationUpdateOne) SetRespondedAt(v time.Time) *LibraryInvitationUpdateOne {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *LibraryInvitationUpdateOne) SetNillableRespondedAt(v *time.Time) *LibraryInvitationUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *LibraryInvitationUpdateOne) ClearRespondedAt() *LibraryInvitationUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LibraryInvitationUpdateOne) SetUpdatedAt(v time.Time) *LibraryInvitationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLibraryID sets the "library" edge to the Library entity by ID.
+func (_u *LibraryInvitationUpdateOne) SetLibraryID(id uuid.UUID) *LibraryInvitationUpdateOne {
+	_u.mutation.SetLibraryID(id)
+	return _u
+}
+
+// SetLibrary sets the "library" edge to the Library entity.
+func (_u *LibraryInvitationUpdateOne) SetLibrary(v *Library) *LibraryInvitationUpdateOne {
+	return _u.SetLibraryID(v.ID)
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by ID.
+func (_u *LibraryInvitationUpdateOne) SetInviterID(id uuid.UUID) *LibraryInvitationUpdateOne {
+	_u.mutation.SetInviterID(id)
+	return _u
+}
+
+// SetInviter sets the "inviter" edge to the User entity.
+func (_u *LibraryInvitationUpdateOne) SetInviter(v *User) *LibraryInvitationUpdateOne {
+	return _u.SetInviterID(v.ID)
+}
+
+// SetInviteeID sets the "invitee" edge to the User entity by ID.
+func (_u *LibraryInvitationUpdateOne) SetInviteeID(id uuid.UUID) *LibraryInvitationUpdateOne {
+	_u.mutation.SetInviteeID(id)
+	return _u
+}
+
+// SetInvitee sets the "invitee" edge to the User entity.
+func (_u *LibraryInvitationUpdateOne) SetInvitee(v *User) *LibraryInvitationUpdateOne {
+	return _u.SetInviteeID(v.ID)
+}
+
+// Mutation returns the LibraryInvitationMutation object of the builder.
+func (_u *LibraryInvitationUpdateOne) Mutation() *LibraryInvitationMutation {
+	return _u.mutation
+}
+
+// ClearLibrary clears the "library" edge to the Library entity.
+func (_u *LibraryInvitationUpdateOne) ClearLibrary() *LibraryInvitationUpdateOne {
+	_u.mutation.ClearLibrary()
+	return _u
+}
+
+// ClearInviter clears the "inviter" edge to the User entity.
+func (_u *LibraryInvitationUpdateOne) ClearInviter() *LibraryInvitationUpdateOne {
+	_u.mutation.ClearInviter()
+	return _u
+}
+
+// ClearInvitee clears the "invitee" edge to the User entity.
+func (_u *LibraryInvitationUpdateOne) ClearInvitee() *LibraryInvitationUpdateOne {
+	_u.mutation.ClearInvitee()
+	return _u
+}
+
+// Where appends a list predicates to the LibraryInvitationUpdate builder.
+func (_u *LibraryInvitationUpdateOne) Where(ps ...predicate.LibraryInvitation) *LibraryInvitationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LibraryInvitationUpdateOne) Select(field string, fields ...string) *LibraryInvitationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LibraryInvitation entity.
+func (_u *LibraryInvitationUpdateOne) Save(ctx context.Context) (*LibraryInvitation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LibraryInvitationUpdateOne) SaveX(ctx context.Context) *LibraryInvitation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LibraryInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LibraryInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LibraryInvitationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := libraryinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LibraryInvitationUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := libraryinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LibraryInvitation.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := libraryinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "LibraryInvitation.status": %w`, err)}
+		}
+	}
+	if _u.mutation.LibraryCleared() && len(_u.mutation.LibraryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryInvitation.library"`)
+	}
+	if _u.mutation.InviterCleared() && len(_u.mutation.InviterIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryInvitation.inviter"`)
+	}
+	if _u.mutation.InviteeCleared() && len(_u.mutation.InviteeIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryInvitation.invitee"`)
+	}
+	return nil
+}
+
+func (_u *LibraryInvitationUpdateOne) sqlSave(ctx context.Context) (_node *LibraryInvitation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(libraryinvitation.Table, libraryinvitation.Columns, sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LibraryInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, libraryinvitation.FieldID)
+		for _, f := range fields {
+			if !libraryinvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != libraryinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(libraryinvitation.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(libraryinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(libraryinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(libraryinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(libraryinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LibraryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.LibraryTable,
+			Columns: []string{libraryinvitation.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LibraryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.LibraryTable,
+			Columns: []string{libraryinvitation.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InviterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviterTable,
+			Columns: []string{libraryinvitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviterTable,
+			Columns: []string{libraryinvitation.InviterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.InviteeCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviteeTable,
+			Columns: []string{libraryinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.InviteeIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   libraryinvitation.InviteeTable,
+			Columns: []string{libraryinvitation.InviteeColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LibraryInvitation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{libraryinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
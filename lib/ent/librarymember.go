@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// LibraryMember is the model entity for the LibraryMember schema.
+type LibraryMember struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 구성원 역할 (owner: 관리, editor: 책 수정, viewer: 보기만)
+	Role librarymember.Role `json:"role,omitempty"`
+	// 서재에 참여한 시간
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LibraryMemberQuery when eager-loading is set.
+	Edges                    LibraryMemberEdges `json:"edges"`
+	library_members          *uuid.UUID
+	user_library_memberships *uuid.UUID
+	selectValues             sql.SelectValues
+}
+
+// LibraryMemberEdges holds the relations/edges for other nodes in the graph.
+type LibraryMemberEdges struct {
+	// Library holds the value of the library edge.
+	Library *Library `json:"library,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// LibraryOrErr returns the Library value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LibraryMemberEdges) LibraryOrErr() (*Library, error) {
+	if e.Library != nil {
+		return e.Library, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: library.Label}
+	}
+	return nil, &NotLoadedError{edge: "library"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LibraryMemberEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LibraryMember) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case librarymember.FieldRole:
+			values[i] = new(sql.NullString)
+		case librarymember.FieldCreatedAt, librarymember.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case librarymember.FieldID:
+			values[i] = new(uuid.UUID)
+		case librarymember.ForeignKeys[0]: // library_members
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case librarymember.ForeignKeys[1]: // user_library_memberships
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LibraryMember fields.
+func (_m *LibraryMember) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case librarymember.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case librarymember.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = librarymember.Role(value.String)
+			}
+		case librarymember.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case librarymember.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case librarymember.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field library_members", values[i])
+			} else if value.Valid {
+				_m.library_members = new(uuid.UUID)
+				*_m.library_members = *value.S.(*uuid.UUID)
+			}
+		case librarymember.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_library_memberships", values[i])
+			} else if value.Valid {
+				_m.user_library_memberships = new(uuid.UUID)
+				*_m.user_library_memberships = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LibraryMember.
+// This includes values selected through modifiers, order, etc.
+func (_m *LibraryMember) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryLibrary queries the "library" edge of the LibraryMember entity.
+func (_m *LibraryMember) QueryLibrary() *LibraryQuery {
+	return NewLibraryMemberClient(_m.config).QueryLibrary(_m)
+}
+
+// QueryUser queries the "user" edge of the LibraryMember entity.
+func (_m *LibraryMember) QueryUser() *UserQuery {
+	return NewLibraryMemberClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this LibraryMember.
+// Note that you need to call LibraryMember.Unwrap() before calling this method if this LibraryMember
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LibraryMember) Update() *LibraryMemberUpdateOne {
+	return NewLibraryMemberClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LibraryMember entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LibraryMember) Unwrap() *LibraryMember {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LibraryMember is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LibraryMember) String() string {
+	var builder strings.Builder
+	builder.WriteString("LibraryMember(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LibraryMembers is a parsable slice of LibraryMember.
+type LibraryMembers []*LibraryMember
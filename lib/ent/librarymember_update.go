@@ -0,0 +1,471 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// LibraryMemberUpdate is the builder for updating LibraryMember entities.
+type LibraryMemberUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LibraryMemberMutation
+}
+
+// Where appends a list predicates to the LibraryMemberUpdate builder.
+func (_u *LibraryMemberUpdate) Where(ps ...predicate.LibraryMember) *LibraryMemberUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *LibraryMemberUpdate) SetRole(v librarymember.Role) *LibraryMemberUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *LibraryMemberUpdate) SetNillableRole(v *librarymember.Role) *LibraryMemberUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LibraryMemberUpdate) SetUpdatedAt(v time.Time) *LibraryMemberUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLibraryID sets the "library" edge to the Library entity by ID.
+func (_u *LibraryMemberUpdate) SetLibraryID(id uuid.UUID) *LibraryMemberUpdate {
+	_u.mutation.SetLibraryID(id)
+	return _u
+}
+
+// SetLibrary sets the "library" edge to the Library entity.
+func (_u *LibraryMemberUpdate) SetLibrary(v *Library) *LibraryMemberUpdate {
+	return _u.SetLibraryID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *LibraryMemberUpdate) SetUserID(id uuid.UUID) *LibraryMemberUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LibraryMemberUpdate) SetUser(v *User) *LibraryMemberUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LibraryMemberMutation object of the builder.
+func (_u *LibraryMemberUpdate) Mutation() *LibraryMemberMutation {
+	return _u.mutation
+}
+
+// ClearLibrary clears the "library" edge to the Library entity.
+func (_u *LibraryMemberUpdate) ClearLibrary() *LibraryMemberUpdate {
+	_u.mutation.ClearLibrary()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LibraryMemberUpdate) ClearUser() *LibraryMemberUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LibraryMemberUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LibraryMemberUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LibraryMemberUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LibraryMemberUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LibraryMemberUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := librarymember.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LibraryMemberUpdate) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := librarymember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LibraryMember.role": %w`, err)}
+		}
+	}
+	if _u.mutation.LibraryCleared() && len(_u.mutation.LibraryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryMember.library"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryMember.user"`)
+	}
+	return nil
+}
+
+func (_u *LibraryMemberUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(librarymember.Table, librarymember.Columns, sqlgraph.NewFieldSpec(librarymember.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(librarymember.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(librarymember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LibraryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   librarymember.LibraryTable,
+			Columns: []string{librarymember.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LibraryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   librarymember.LibraryTable,
+			Columns: []string{librarymember.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   librarymember.UserTable,
+			Columns: []string{librarymember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   librarymember.UserTable,
+			Columns: []string{librarymember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{librarymember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LibraryMemberUpdateOne is the builder for updating a single LibraryMember entity.
+type LibraryMemberUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LibraryMemberMutation
+}
+
+// SetRole sets the "role" field.
+func (_u *LibraryMemberUpdateOne) SetRole(v librarymember.Role) *LibraryMemberUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *LibraryMemberUpdateOne) SetNillableRole(v *librarymember.Role) *LibraryMemberUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LibraryMemberUpdateOne) SetUpdatedAt(v time.Time) *LibraryMemberUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLibraryID sets the "library" edge to the Library entity by ID.
+func (_u *LibraryMemberUpdateOne) SetLibraryID(id uuid.UUID) *LibraryMemberUpdateOne {
+	_u.mutation.SetLibraryID(id)
+	return _u
+}
+
+// SetLibrary sets the "library" edge to the Library entity.
+func (_u *LibraryMemberUpdateOne) SetLibrary(v *Library) *LibraryMemberUpdateOne {
+	return _u.SetLibraryID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *LibraryMemberUpdateOne) SetUserID(id uuid.UUID) *LibraryMemberUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *LibraryMemberUpdateOne) SetUser(v *User) *LibraryMemberUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the LibraryMemberMutation object of the builder.
+func (_u *LibraryMemberUpdateOne) Mutation() *LibraryMemberMutation {
+	return _u.mutation
+}
+
+// ClearLibrary clears the "library" edge to the Library entity.
+func (_u *LibraryMemberUpdateOne) ClearLibrary() *LibraryMemberUpdateOne {
+	_u.mutation.ClearLibrary()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *LibraryMemberUpdateOne) ClearUser() *LibraryMemberUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the LibraryMemberUpdate builder.
+func (_u *LibraryMemberUpdateOne) Where(ps ...predicate.LibraryMember) *LibraryMemberUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LibraryMemberUpdateOne) Select(field string, fields ...string) *LibraryMemberUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LibraryMember entity.
+func (_u *LibraryMemberUpdateOne) Save(ctx context.Context) (*LibraryMember, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LibraryMemberUpdateOne) SaveX(ctx context.Context) *LibraryMember {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LibraryMemberUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LibraryMemberUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LibraryMemberUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := librarymember.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LibraryMemberUpdateOne) check() error {
+	if v, ok := _u.mutation.Role(); ok {
+		if err := librarymember.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "LibraryMember.role": %w`, err)}
+		}
+	}
+	if _u.mutation.LibraryCleared() && len(_u.mutation.LibraryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryMember.library"`)
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "LibraryMember.user"`)
+	}
+	return nil
+}
+
+func (_u *LibraryMemberUpdateOne) sqlSave(ctx context.Context) (_node *LibraryMember, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(librarymember.Table, librarymember.Columns, sqlgraph.NewFieldSpec(librarymember.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LibraryMember.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, librarymember.FieldID)
+		for _, f := range fields {
+			if !librarymember.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != librarymember.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(librarymember.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(librarymember.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LibraryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   librarymember.LibraryTable,
+			Columns: []string{librarymember.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LibraryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   librarymember.LibraryTable,
+			Columns: []string{librarymember.LibraryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(library.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   librarymember.UserTable,
+			Columns: []string{librarymember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   librarymember.UserTable,
+			Columns: []string{librarymember.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &LibraryMember{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{librarymember.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// Loan is the model entity for the Loan schema.
+type Loan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 빌린 사람 이름
+	BorrowerName string `json:"borrower_name,omitempty"`
+	// 빌린 사람 연락처
+	BorrowerContact string `json:"borrower_contact,omitempty"`
+	// 빌려준 날짜
+	LentAt time.Time `json:"lent_at,omitempty"`
+	// 반납 예정일
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// 반납한 날짜 (nil이면 대여 중)
+	ReturnedAt *time.Time `json:"returned_at,omitempty"`
+	// 메모
+	Notes string `json:"notes,omitempty"`
+	// 반납 예정 알림을 보낸 시간
+	ReminderSentAt *time.Time `json:"reminder_sent_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LoanQuery when eager-loading is set.
+	Edges        LoanEdges `json:"edges"`
+	book_loans   *uuid.UUID
+	user_loans   *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// LoanEdges holds the relations/edges for other nodes in the graph.
+type LoanEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Book holds the value of the book edge.
+	Book *Book `json:"book,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// BookOrErr returns the Book value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LoanEdges) BookOrErr() (*Book, error) {
+	if e.Book != nil {
+		return e.Book, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: book.Label}
+	}
+	return nil, &NotLoadedError{edge: "book"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Loan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loan.FieldBorrowerName, loan.FieldBorrowerContact, loan.FieldNotes:
+			values[i] = new(sql.NullString)
+		case loan.FieldLentAt, loan.FieldDueAt, loan.FieldReturnedAt, loan.FieldReminderSentAt, loan.FieldCreatedAt, loan.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case loan.FieldID:
+			values[i] = new(uuid.UUID)
+		case loan.ForeignKeys[0]: // book_loans
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case loan.ForeignKeys[1]: // user_loans
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Loan fields.
+func (_m *Loan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loan.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case loan.FieldBorrowerName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field borrower_name", values[i])
+			} else if value.Valid {
+				_m.BorrowerName = value.String
+			}
+		case loan.FieldBorrowerContact:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field borrower_contact", values[i])
+			} else if value.Valid {
+				_m.BorrowerContact = value.String
+			}
+		case loan.FieldLentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field lent_at", values[i])
+			} else if value.Valid {
+				_m.LentAt = value.Time
+			}
+		case loan.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				_m.DueAt = new(time.Time)
+				*_m.DueAt = value.Time
+			}
+		case loan.FieldReturnedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field returned_at", values[i])
+			} else if value.Valid {
+				_m.ReturnedAt = new(time.Time)
+				*_m.ReturnedAt = value.Time
+			}
+		case loan.FieldNotes:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field notes", values[i])
+			} else if value.Valid {
+				_m.Notes = value.String
+			}
+		case loan.FieldReminderSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reminder_sent_at", values[i])
+			} else if value.Valid {
+				_m.ReminderSentAt = new(time.Time)
+				*_m.ReminderSentAt = value.Time
+			}
+		case loan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case loan.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case loan.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field book_loans", values[i])
+			} else if value.Valid {
+				_m.book_loans = new(uuid.UUID)
+				*_m.book_loans = *value.S.(*uuid.UUID)
+			}
+		case loan.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_loans", values[i])
+			} else if value.Valid {
+				_m.user_loans = new(uuid.UUID)
+				*_m.user_loans = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Loan.
+// This includes values selected through modifiers, order, etc.
+func (_m *Loan) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Loan entity.
+func (_m *Loan) QueryOwner() *UserQuery {
+	return NewLoanClient(_m.config).QueryOwner(_m)
+}
+
+// QueryBook queries the "book" edge of the Loan entity.
+func (_m *Loan) QueryBook() *BookQuery {
+	return NewLoanClient(_m.config).QueryBook(_m)
+}
+
+// Update returns a builder for updating this Loan.
+// Note that you need to call Loan.Unwrap() before calling this method if this Loan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Loan) Update() *LoanUpdateOne {
+	return NewLoanClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Loan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Loan) Unwrap() *Loan {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Loan is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Loan) String() string {
+	var builder strings.Builder
+	builder.WriteString("Loan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("borrower_name=")
+	builder.WriteString(_m.BorrowerName)
+	builder.WriteString(", ")
+	builder.WriteString("borrower_contact=")
+	builder.WriteString(_m.BorrowerContact)
+	builder.WriteString(", ")
+	builder.WriteString("lent_at=")
+	builder.WriteString(_m.LentAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DueAt; v != nil {
+		builder.WriteString("due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReturnedAt; v != nil {
+		builder.WriteString("returned_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("notes=")
+	builder.WriteString(_m.Notes)
+	builder.WriteString(", ")
+	if v := _m.ReminderSentAt; v != nil {
+		builder.WriteString("reminder_sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Loans is a parsable slice of Loan.
+type Loans []*Loan
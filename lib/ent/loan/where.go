@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package loan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldID, id))
+}
+
+// BorrowerName applies equality check predicate on the "borrower_name" field. It's identical to BorrowerNameEQ.
+func BorrowerName(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerName, v))
+}
+
+// BorrowerContact applies equality check predicate on the "borrower_contact" field. It's identical to BorrowerContactEQ.
+func BorrowerContact(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerContact, v))
+}
+
+// LentAt applies equality check predicate on the "lent_at" field. It's identical to LentAtEQ.
+func LentAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldLentAt, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldDueAt, v))
+}
+
+// ReturnedAt applies equality check predicate on the "returned_at" field. It's identical to ReturnedAtEQ.
+func ReturnedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldReturnedAt, v))
+}
+
+// Notes applies equality check predicate on the "notes" field. It's identical to NotesEQ.
+func Notes(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldNotes, v))
+}
+
+// ReminderSentAt applies equality check predicate on the "reminder_sent_at" field. It's identical to ReminderSentAtEQ.
+func ReminderSentAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldReminderSentAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// BorrowerNameEQ applies the EQ predicate on the "borrower_name" field.
+func BorrowerNameEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerName, v))
+}
+
+// BorrowerNameNEQ applies the NEQ predicate on the "borrower_name" field.
+func BorrowerNameNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldBorrowerName, v))
+}
+
+// BorrowerNameIn applies the In predicate on the "borrower_name" field.
+func BorrowerNameIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldBorrowerName, vs...))
+}
+
+// BorrowerNameNotIn applies the NotIn predicate on the "borrower_name" field.
+func BorrowerNameNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldBorrowerName, vs...))
+}
+
+// BorrowerNameGT applies the GT predicate on the "borrower_name" field.
+func BorrowerNameGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldBorrowerName, v))
+}
+
+// BorrowerNameGTE applies the GTE predicate on the "borrower_name" field.
+func BorrowerNameGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldBorrowerName, v))
+}
+
+// BorrowerNameLT applies the LT predicate on the "borrower_name" field.
+func BorrowerNameLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldBorrowerName, v))
+}
+
+// BorrowerNameLTE applies the LTE predicate on the "borrower_name" field.
+func BorrowerNameLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldBorrowerName, v))
+}
+
+// BorrowerNameContains applies the Contains predicate on the "borrower_name" field.
+func BorrowerNameContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldBorrowerName, v))
+}
+
+// BorrowerNameHasPrefix applies the HasPrefix predicate on the "borrower_name" field.
+func BorrowerNameHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldBorrowerName, v))
+}
+
+// BorrowerNameHasSuffix applies the HasSuffix predicate on the "borrower_name" field.
+func BorrowerNameHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldBorrowerName, v))
+}
+
+// BorrowerNameEqualFold applies the EqualFold predicate on the "borrower_name" field.
+func BorrowerNameEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldBorrowerName, v))
+}
+
+// BorrowerNameContainsFold applies the ContainsFold predicate on the "borrower_name" field.
+func BorrowerNameContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldBorrowerName, v))
+}
+
+// BorrowerContactEQ applies the EQ predicate on the "borrower_contact" field.
+func BorrowerContactEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldBorrowerContact, v))
+}
+
+// BorrowerContactNEQ applies the NEQ predicate on the "borrower_contact" field.
+func BorrowerContactNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldBorrowerContact, v))
+}
+
+// BorrowerContactIn applies the In predicate on the "borrower_contact" field.
+func BorrowerContactIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldBorrowerContact, vs...))
+}
+
+// BorrowerContactNotIn applies the NotIn predicate on the "borrower_contact" field.
+func BorrowerContactNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldBorrowerContact, vs...))
+}
+
+// BorrowerContactGT applies the GT predicate on the "borrower_contact" field.
+func BorrowerContactGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldBorrowerContact, v))
+}
+
+// BorrowerContactGTE applies the GTE predicate on the "borrower_contact" field.
+func BorrowerContactGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldBorrowerContact, v))
+}
+
+// BorrowerContactLT applies the LT predicate on the "borrower_contact" field.
+func BorrowerContactLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldBorrowerContact, v))
+}
+
+// BorrowerContactLTE applies the LTE predicate on the "borrower_contact" field.
+func BorrowerContactLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldBorrowerContact, v))
+}
+
+// BorrowerContactContains applies the Contains predicate on the "borrower_contact" field.
+func BorrowerContactContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldBorrowerContact, v))
+}
+
+// BorrowerContactHasPrefix applies the HasPrefix predicate on the "borrower_contact" field.
+func BorrowerContactHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldBorrowerContact, v))
+}
+
+// BorrowerContactHasSuffix applies the HasSuffix predicate on the "borrower_contact" field.
+func BorrowerContactHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldBorrowerContact, v))
+}
+
+// BorrowerContactIsNil applies the IsNil predicate on the "borrower_contact" field.
+func BorrowerContactIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldBorrowerContact))
+}
+
+// BorrowerContactNotNil applies the NotNil predicate on the "borrower_contact" field.
+func BorrowerContactNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldBorrowerContact))
+}
+
+// BorrowerContactEqualFold applies the EqualFold predicate on the "borrower_contact" field.
+func BorrowerContactEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldBorrowerContact, v))
+}
+
+// BorrowerContactContainsFold applies the ContainsFold predicate on the "borrower_contact" field.
+func BorrowerContactContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldBorrowerContact, v))
+}
+
+// LentAtEQ applies the EQ predicate on the "lent_at" field.
+func LentAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldLentAt, v))
+}
+
+// LentAtNEQ applies the NEQ predicate on the "lent_at" field.
+func LentAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldLentAt, v))
+}
+
+// LentAtIn applies the In predicate on the "lent_at" field.
+func LentAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldLentAt, vs...))
+}
+
+// LentAtNotIn applies the NotIn predicate on the "lent_at" field.
+func LentAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldLentAt, vs...))
+}
+
+// LentAtGT applies the GT predicate on the "lent_at" field.
+func LentAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldLentAt, v))
+}
+
+// LentAtGTE applies the GTE predicate on the "lent_at" field.
+func LentAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldLentAt, v))
+}
+
+// LentAtLT applies the LT predicate on the "lent_at" field.
+func LentAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldLentAt, v))
+}
+
+// LentAtLTE applies the LTE predicate on the "lent_at" field.
+func LentAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldLentAt, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldDueAt, v))
+}
+
+// DueAtIsNil applies the IsNil predicate on the "due_at" field.
+func DueAtIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldDueAt))
+}
+
+// DueAtNotNil applies the NotNil predicate on the "due_at" field.
+func DueAtNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldDueAt))
+}
+
+// ReturnedAtEQ applies the EQ predicate on the "returned_at" field.
+func ReturnedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldReturnedAt, v))
+}
+
+// ReturnedAtNEQ applies the NEQ predicate on the "returned_at" field.
+func ReturnedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldReturnedAt, v))
+}
+
+// ReturnedAtIn applies the In predicate on the "returned_at" field.
+func ReturnedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldReturnedAt, vs...))
+}
+
+// ReturnedAtNotIn applies the NotIn predicate on the "returned_at" field.
+func ReturnedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldReturnedAt, vs...))
+}
+
+// ReturnedAtGT applies the GT predicate on the "returned_at" field.
+func ReturnedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldReturnedAt, v))
+}
+
+// ReturnedAtGTE applies the GTE predicate on the "returned_at" field.
+func ReturnedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldReturnedAt, v))
+}
+
+// ReturnedAtLT applies the LT predicate on the "returned_at" field.
+func ReturnedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldReturnedAt, v))
+}
+
+// ReturnedAtLTE applies the LTE predicate on the "returned_at" field.
+func ReturnedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldReturnedAt, v))
+}
+
+// ReturnedAtIsNil applies the IsNil predicate on the "returned_at" field.
+func ReturnedAtIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldReturnedAt))
+}
+
+// ReturnedAtNotNil applies the NotNil predicate on the "returned_at" field.
+func ReturnedAtNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldReturnedAt))
+}
+
+// NotesEQ applies the EQ predicate on the "notes" field.
+func NotesEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldNotes, v))
+}
+
+// NotesNEQ applies the NEQ predicate on the "notes" field.
+func NotesNEQ(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldNotes, v))
+}
+
+// NotesIn applies the In predicate on the "notes" field.
+func NotesIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldNotes, vs...))
+}
+
+// NotesNotIn applies the NotIn predicate on the "notes" field.
+func NotesNotIn(vs ...string) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldNotes, vs...))
+}
+
+// NotesGT applies the GT predicate on the "notes" field.
+func NotesGT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldNotes, v))
+}
+
+// NotesGTE applies the GTE predicate on the "notes" field.
+func NotesGTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldNotes, v))
+}
+
+// NotesLT applies the LT predicate on the "notes" field.
+func NotesLT(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldNotes, v))
+}
+
+// NotesLTE applies the LTE predicate on the "notes" field.
+func NotesLTE(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldNotes, v))
+}
+
+// NotesContains applies the Contains predicate on the "notes" field.
+func NotesContains(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContains(FieldNotes, v))
+}
+
+// NotesHasPrefix applies the HasPrefix predicate on the "notes" field.
+func NotesHasPrefix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasPrefix(FieldNotes, v))
+}
+
+// NotesHasSuffix applies the HasSuffix predicate on the "notes" field.
+func NotesHasSuffix(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldHasSuffix(FieldNotes, v))
+}
+
+// NotesIsNil applies the IsNil predicate on the "notes" field.
+func NotesIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldNotes))
+}
+
+// NotesNotNil applies the NotNil predicate on the "notes" field.
+func NotesNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldNotes))
+}
+
+// NotesEqualFold applies the EqualFold predicate on the "notes" field.
+func NotesEqualFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldEqualFold(FieldNotes, v))
+}
+
+// NotesContainsFold applies the ContainsFold predicate on the "notes" field.
+func NotesContainsFold(v string) predicate.Loan {
+	return predicate.Loan(sql.FieldContainsFold(FieldNotes, v))
+}
+
+// ReminderSentAtEQ applies the EQ predicate on the "reminder_sent_at" field.
+func ReminderSentAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtNEQ applies the NEQ predicate on the "reminder_sent_at" field.
+func ReminderSentAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtIn applies the In predicate on the "reminder_sent_at" field.
+func ReminderSentAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldReminderSentAt, vs...))
+}
+
+// ReminderSentAtNotIn applies the NotIn predicate on the "reminder_sent_at" field.
+func ReminderSentAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldReminderSentAt, vs...))
+}
+
+// ReminderSentAtGT applies the GT predicate on the "reminder_sent_at" field.
+func ReminderSentAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtGTE applies the GTE predicate on the "reminder_sent_at" field.
+func ReminderSentAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtLT applies the LT predicate on the "reminder_sent_at" field.
+func ReminderSentAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtLTE applies the LTE predicate on the "reminder_sent_at" field.
+func ReminderSentAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtIsNil applies the IsNil predicate on the "reminder_sent_at" field.
+func ReminderSentAtIsNil() predicate.Loan {
+	return predicate.Loan(sql.FieldIsNull(FieldReminderSentAt))
+}
+
+// ReminderSentAtNotNil applies the NotNil predicate on the "reminder_sent_at" field.
+func ReminderSentAtNotNil() predicate.Loan {
+	return predicate.Loan(sql.FieldNotNull(FieldReminderSentAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Loan {
+	return predicate.Loan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBook applies the HasEdge predicate on the "book" edge.
+func HasBook() predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BookTable, BookColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookWith applies the HasEdge predicate on the "book" edge with a given conditions (other predicates).
+func HasBookWith(preds ...predicate.Book) predicate.Loan {
+	return predicate.Loan(func(s *sql.Selector) {
+		step := newBookStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Loan) predicate.Loan {
+	return predicate.Loan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Loan) predicate.Loan {
+	return predicate.Loan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Loan) predicate.Loan {
+	return predicate.Loan(sql.NotPredicates(p))
+}
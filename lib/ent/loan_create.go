@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// LoanCreate is the builder for creating a Loan entity.
+type LoanCreate struct {
+	config
+	mutation *LoanMutation
+	hooks    []Hook
+}
+
+// SetBorrowerName sets the "borrower_name" field.
+func (_c *LoanCreate) SetBorrowerName(v string) *LoanCreate {
+	_c.mutation.SetBorrowerName(v)
+	return _c
+}
+
+// SetBorrowerContact sets the "borrower_contact" field.
+func (_c *LoanCreate) SetBorrowerContact(v string) *LoanCreate {
+	_c.mutation.SetBorrowerContact(v)
+	return _c
+}
+
+// SetNillableBorrowerContact sets the "borrower_contact" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableBorrowerContact(v *string) *LoanCreate {
+	if v != nil {
+		_c.SetBorrowerContact(*v)
+	}
+	return _c
+}
+
+// SetLentAt sets the "lent_at" field.
+func (_c *LoanCreate) SetLentAt(v time.Time) *LoanCreate {
+	_c.mutation.SetLentAt(v)
+	return _c
+}
+
+// SetDueAt sets the "due_at" field.
+func (_c *LoanCreate) SetDueAt(v time.Time) *LoanCreate {
+	_c.mutation.SetDueAt(v)
+	return _c
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableDueAt(v *time.Time) *LoanCreate {
+	if v != nil {
+		_c.SetDueAt(*v)
+	}
+	return _c
+}
+
+// SetReturnedAt sets the "returned_at" field.
+func (_c *LoanCreate) SetReturnedAt(v time.Time) *LoanCreate {
+	_c.mutation.SetReturnedAt(v)
+	return _c
+}
+
+// SetNillableReturnedAt sets the "returned_at" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableReturnedAt(v *time.Time) *LoanCreate {
+	if v != nil {
+		_c.SetReturnedAt(*v)
+	}
+	return _c
+}
+
+// SetNotes sets the "notes" field.
+func (_c *LoanCreate) SetNotes(v string) *LoanCreate {
+	_c.mutation.SetNotes(v)
+	return _c
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableNotes(v *string) *LoanCreate {
+	if v != nil {
+		_c.SetNotes(*v)
+	}
+	return _c
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (_c *LoanCreate) SetReminderSentAt(v time.Time) *LoanCreate {
+	_c.mutation.SetReminderSentAt(v)
+	return _c
+}
+
+// SetNillableReminderSentAt sets the "reminder_sent_at" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableReminderSentAt(v *time.Time) *LoanCreate {
+	if v != nil {
+		_c.SetReminderSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *LoanCreate) SetCreatedAt(v time.Time) *LoanCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableCreatedAt(v *time.Time) *LoanCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *LoanCreate) SetUpdatedAt(v time.Time) *LoanCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableUpdatedAt(v *time.Time) *LoanCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LoanCreate) SetID(v uuid.UUID) *LoanCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LoanCreate) SetNillableID(v *uuid.UUID) *LoanCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *LoanCreate) SetOwnerID(id uuid.UUID) *LoanCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *LoanCreate) SetOwner(v *User) *LoanCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_c *LoanCreate) SetBookID(id uuid.UUID) *LoanCreate {
+	_c.mutation.SetBookID(id)
+	return _c
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_c *LoanCreate) SetBook(v *Book) *LoanCreate {
+	return _c.SetBookID(v.ID)
+}
+
+// Mutation returns the LoanMutation object of the builder.
+func (_c *LoanCreate) Mutation() *LoanMutation {
+	return _c.mutation
+}
+
+// Save creates the Loan in the database.
+func (_c *LoanCreate) Save(ctx context.Context) (*Loan, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LoanCreate) SaveX(ctx context.Context) *Loan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoanCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoanCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LoanCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := loan.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := loan.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := loan.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LoanCreate) check() error {
+	if _, ok := _c.mutation.BorrowerName(); !ok {
+		return &ValidationError{Name: "borrower_name", err: errors.New(`ent: missing required field "Loan.borrower_name"`)}
+	}
+	if v, ok := _c.mutation.BorrowerName(); ok {
+		if err := loan.BorrowerNameValidator(v); err != nil {
+			return &ValidationError{Name: "borrower_name", err: fmt.Errorf(`ent: validator failed for field "Loan.borrower_name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LentAt(); !ok {
+		return &ValidationError{Name: "lent_at", err: errors.New(`ent: missing required field "Loan.lent_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Loan.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Loan.updated_at"`)}
+	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "Loan.owner"`)}
+	}
+	if len(_c.mutation.BookIDs()) == 0 {
+		return &ValidationError{Name: "book", err: errors.New(`ent: missing required edge "Loan.book"`)}
+	}
+	return nil
+}
+
+func (_c *LoanCreate) sqlSave(ctx context.Context) (*Loan, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LoanCreate) createSpec() (*Loan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Loan{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(loan.Table, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.BorrowerName(); ok {
+		_spec.SetField(loan.FieldBorrowerName, field.TypeString, value)
+		_node.BorrowerName = value
+	}
+	if value, ok := _c.mutation.BorrowerContact(); ok {
+		_spec.SetField(loan.FieldBorrowerContact, field.TypeString, value)
+		_node.BorrowerContact = value
+	}
+	if value, ok := _c.mutation.LentAt(); ok {
+		_spec.SetField(loan.FieldLentAt, field.TypeTime, value)
+		_node.LentAt = value
+	}
+	if value, ok := _c.mutation.DueAt(); ok {
+		_spec.SetField(loan.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
+	if value, ok := _c.mutation.ReturnedAt(); ok {
+		_spec.SetField(loan.FieldReturnedAt, field.TypeTime, value)
+		_node.ReturnedAt = &value
+	}
+	if value, ok := _c.mutation.Notes(); ok {
+		_spec.SetField(loan.FieldNotes, field.TypeString, value)
+		_node.Notes = value
+	}
+	if value, ok := _c.mutation.ReminderSentAt(); ok {
+		_spec.SetField(loan.FieldReminderSentAt, field.TypeTime, value)
+		_node.ReminderSentAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(loan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(loan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.OwnerTable,
+			Columns: []string{loan.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_loans = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.BookTable,
+			Columns: []string{loan.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.book_loans = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// LoanCreateBulk is the builder for creating many Loan entities in bulk.
+type LoanCreateBulk struct {
+	config
+	err      error
+	builders []*LoanCreate
+}
+
+// Save creates the Loan entities in the database.
+func (_c *LoanCreateBulk) Save(ctx context.Context) ([]*Loan, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Loan, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LoanCreateBulk) SaveX(ctx context.Context) []*Loan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LoanCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LoanCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,765 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// LoanUpdate is the builder for updating Loan entities.
+type LoanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LoanMutation
+}
+
+// Where appends a list predicates to the LoanUpdate builder.
+func (_u *LoanUpdate) Where(ps ...predicate.Loan) *LoanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBorrowerName sets the "borrower_name" field.
+func (_u *LoanUpdate) SetBorrowerName(v string) *LoanUpdate {
+	_u.mutation.SetBorrowerName(v)
+	return _u
+}
+
+// SetNillableBorrowerName sets the "borrower_name" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableBorrowerName(v *string) *LoanUpdate {
+	if v != nil {
+		_u.SetBorrowerName(*v)
+	}
+	return _u
+}
+
+// SetBorrowerContact sets the "borrower_contact" field.
+func (_u *LoanUpdate) SetBorrowerContact(v string) *LoanUpdate {
+	_u.mutation.SetBorrowerContact(v)
+	return _u
+}
+
+// SetNillableBorrowerContact sets the "borrower_contact" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableBorrowerContact(v *string) *LoanUpdate {
+	if v != nil {
+		_u.SetBorrowerContact(*v)
+	}
+	return _u
+}
+
+// ClearBorrowerContact clears the value of the "borrower_contact" field.
+func (_u *LoanUpdate) ClearBorrowerContact() *LoanUpdate {
+	_u.mutation.ClearBorrowerContact()
+	return _u
+}
+
+// SetLentAt sets the "lent_at" field.
+func (_u *LoanUpdate) SetLentAt(v time.Time) *LoanUpdate {
+	_u.mutation.SetLentAt(v)
+	return _u
+}
+
+// SetNillableLentAt sets the "lent_at" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableLentAt(v *time.Time) *LoanUpdate {
+	if v != nil {
+		_u.SetLentAt(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *LoanUpdate) SetDueAt(v time.Time) *LoanUpdate {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableDueAt(v *time.Time) *LoanUpdate {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *LoanUpdate) ClearDueAt() *LoanUpdate {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
+// SetReturnedAt sets the "returned_at" field.
+func (_u *LoanUpdate) SetReturnedAt(v time.Time) *LoanUpdate {
+	_u.mutation.SetReturnedAt(v)
+	return _u
+}
+
+// SetNillableReturnedAt sets the "returned_at" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableReturnedAt(v *time.Time) *LoanUpdate {
+	if v != nil {
+		_u.SetReturnedAt(*v)
+	}
+	return _u
+}
+
+// ClearReturnedAt clears the value of the "returned_at" field.
+func (_u *LoanUpdate) ClearReturnedAt() *LoanUpdate {
+	_u.mutation.ClearReturnedAt()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *LoanUpdate) SetNotes(v string) *LoanUpdate {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableNotes(v *string) *LoanUpdate {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *LoanUpdate) ClearNotes() *LoanUpdate {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (_u *LoanUpdate) SetReminderSentAt(v time.Time) *LoanUpdate {
+	_u.mutation.SetReminderSentAt(v)
+	return _u
+}
+
+// SetNillableReminderSentAt sets the "reminder_sent_at" field if the given value is not nil.
+func (_u *LoanUpdate) SetNillableReminderSentAt(v *time.Time) *LoanUpdate {
+	if v != nil {
+		_u.SetReminderSentAt(*v)
+	}
+	return _u
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (_u *LoanUpdate) ClearReminderSentAt() *LoanUpdate {
+	_u.mutation.ClearReminderSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoanUpdate) SetUpdatedAt(v time.Time) *LoanUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *LoanUpdate) SetOwnerID(id uuid.UUID) *LoanUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *LoanUpdate) SetOwner(v *User) *LoanUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *LoanUpdate) SetBookID(id uuid.UUID) *LoanUpdate {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *LoanUpdate) SetBook(v *Book) *LoanUpdate {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the LoanMutation object of the builder.
+func (_u *LoanUpdate) Mutation() *LoanMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *LoanUpdate) ClearOwner() *LoanUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *LoanUpdate) ClearBook() *LoanUpdate {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LoanUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LoanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoanUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoanUpdate) check() error {
+	if v, ok := _u.mutation.BorrowerName(); ok {
+		if err := loan.BorrowerNameValidator(v); err != nil {
+			return &ValidationError{Name: "borrower_name", err: fmt.Errorf(`ent: validator failed for field "Loan.borrower_name": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Loan.owner"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Loan.book"`)
+	}
+	return nil
+}
+
+func (_u *LoanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loan.Table, loan.Columns, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BorrowerName(); ok {
+		_spec.SetField(loan.FieldBorrowerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BorrowerContact(); ok {
+		_spec.SetField(loan.FieldBorrowerContact, field.TypeString, value)
+	}
+	if _u.mutation.BorrowerContactCleared() {
+		_spec.ClearField(loan.FieldBorrowerContact, field.TypeString)
+	}
+	if value, ok := _u.mutation.LentAt(); ok {
+		_spec.SetField(loan.FieldLentAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(loan.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(loan.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReturnedAt(); ok {
+		_spec.SetField(loan.FieldReturnedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReturnedAtCleared() {
+		_spec.ClearField(loan.FieldReturnedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(loan.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(loan.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReminderSentAt(); ok {
+		_spec.SetField(loan.FieldReminderSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReminderSentAtCleared() {
+		_spec.ClearField(loan.FieldReminderSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.OwnerTable,
+			Columns: []string{loan.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.OwnerTable,
+			Columns: []string{loan.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.BookTable,
+			Columns: []string{loan.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.BookTable,
+			Columns: []string{loan.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LoanUpdateOne is the builder for updating a single Loan entity.
+type LoanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LoanMutation
+}
+
+// SetBorrowerName sets the "borrower_name" field.
+func (_u *LoanUpdateOne) SetBorrowerName(v string) *LoanUpdateOne {
+	_u.mutation.SetBorrowerName(v)
+	return _u
+}
+
+// SetNillableBorrowerName sets the "borrower_name" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableBorrowerName(v *string) *LoanUpdateOne {
+	if v != nil {
+		_u.SetBorrowerName(*v)
+	}
+	return _u
+}
+
+// SetBorrowerContact sets the "borrower_contact" field.
+func (_u *LoanUpdateOne) SetBorrowerContact(v string) *LoanUpdateOne {
+	_u.mutation.SetBorrowerContact(v)
+	return _u
+}
+
+// SetNillableBorrowerContact sets the "borrower_contact" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableBorrowerContact(v *string) *LoanUpdateOne {
+	if v != nil {
+		_u.SetBorrowerContact(*v)
+	}
+	return _u
+}
+
+// ClearBorrowerContact clears the value of the "borrower_contact" field.
+func (_u *LoanUpdateOne) ClearBorrowerContact() *LoanUpdateOne {
+	_u.mutation.ClearBorrowerContact()
+	return _u
+}
+
+// SetLentAt sets the "lent_at" field.
+func (_u *LoanUpdateOne) SetLentAt(v time.Time) *LoanUpdateOne {
+	_u.mutation.SetLentAt(v)
+	return _u
+}
+
+// SetNillableLentAt sets the "lent_at" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableLentAt(v *time.Time) *LoanUpdateOne {
+	if v != nil {
+		_u.SetLentAt(*v)
+	}
+	return _u
+}
+
+// SetDueAt sets the "due_at" field.
+func (_u *LoanUpdateOne) SetDueAt(v time.Time) *LoanUpdateOne {
+	_u.mutation.SetDueAt(v)
+	return _u
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableDueAt(v *time.Time) *LoanUpdateOne {
+	if v != nil {
+		_u.SetDueAt(*v)
+	}
+	return _u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (_u *LoanUpdateOne) ClearDueAt() *LoanUpdateOne {
+	_u.mutation.ClearDueAt()
+	return _u
+}
+
+// SetReturnedAt sets the "returned_at" field.
+func (_u *LoanUpdateOne) SetReturnedAt(v time.Time) *LoanUpdateOne {
+	_u.mutation.SetReturnedAt(v)
+	return _u
+}
+
+// SetNillableReturnedAt sets the "returned_at" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableReturnedAt(v *time.Time) *LoanUpdateOne {
+	if v != nil {
+		_u.SetReturnedAt(*v)
+	}
+	return _u
+}
+
+// ClearReturnedAt clears the value of the "returned_at" field.
+func (_u *LoanUpdateOne) ClearReturnedAt() *LoanUpdateOne {
+	_u.mutation.ClearReturnedAt()
+	return _u
+}
+
+// SetNotes sets the "notes" field.
+func (_u *LoanUpdateOne) SetNotes(v string) *LoanUpdateOne {
+	_u.mutation.SetNotes(v)
+	return _u
+}
+
+// SetNillableNotes sets the "notes" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableNotes(v *string) *LoanUpdateOne {
+	if v != nil {
+		_u.SetNotes(*v)
+	}
+	return _u
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (_u *LoanUpdateOne) ClearNotes() *LoanUpdateOne {
+	_u.mutation.ClearNotes()
+	return _u
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (_u *LoanUpdateOne) SetReminderSentAt(v time.Time) *LoanUpdateOne {
+	_u.mutation.SetReminderSentAt(v)
+	return _u
+}
+
+// SetNillableReminderSentAt sets the "reminder_sent_at" field if the given value is not nil.
+func (_u *LoanUpdateOne) SetNillableReminderSentAt(v *time.Time) *LoanUpdateOne {
+	if v != nil {
+		_u.SetReminderSentAt(*v)
+	}
+	return _u
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (_u *LoanUpdateOne) ClearReminderSentAt() *LoanUpdateOne {
+	_u.mutation.ClearReminderSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *LoanUpdateOne) SetUpdatedAt(v time.Time) *LoanUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *LoanUpdateOne) SetOwnerID(id uuid.UUID) *LoanUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *LoanUpdateOne) SetOwner(v *User) *LoanUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *LoanUpdateOne) SetBookID(id uuid.UUID) *LoanUpdateOne {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *LoanUpdateOne) SetBook(v *Book) *LoanUpdateOne {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the LoanMutation object of the builder.
+func (_u *LoanUpdateOne) Mutation() *LoanMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *LoanUpdateOne) ClearOwner() *LoanUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *LoanUpdateOne) ClearBook() *LoanUpdateOne {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Where appends a list predicates to the LoanUpdate builder.
+func (_u *LoanUpdateOne) Where(ps ...predicate.Loan) *LoanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LoanUpdateOne) Select(field string, fields ...string) *LoanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Loan entity.
+func (_u *LoanUpdateOne) Save(ctx context.Context) (*Loan, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LoanUpdateOne) SaveX(ctx context.Context) *Loan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LoanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LoanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LoanUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := loan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LoanUpdateOne) check() error {
+	if v, ok := _u.mutation.BorrowerName(); ok {
+		if err := loan.BorrowerNameValidator(v); err != nil {
+			return &ValidationError{Name: "borrower_name", err: fmt.Errorf(`ent: validator failed for field "Loan.borrower_name": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Loan.owner"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Loan.book"`)
+	}
+	return nil
+}
+
+func (_u *LoanUpdateOne) sqlSave(ctx context.Context) (_node *Loan, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(loan.Table, loan.Columns, sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Loan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, loan.FieldID)
+		for _, f := range fields {
+			if !loan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != loan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.BorrowerName(); ok {
+		_spec.SetField(loan.FieldBorrowerName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BorrowerContact(); ok {
+		_spec.SetField(loan.FieldBorrowerContact, field.TypeString, value)
+	}
+	if _u.mutation.BorrowerContactCleared() {
+		_spec.ClearField(loan.FieldBorrowerContact, field.TypeString)
+	}
+	if value, ok := _u.mutation.LentAt(); ok {
+		_spec.SetField(loan.FieldLentAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DueAt(); ok {
+		_spec.SetField(loan.FieldDueAt, field.TypeTime, value)
+	}
+	if _u.mutation.DueAtCleared() {
+		_spec.ClearField(loan.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReturnedAt(); ok {
+		_spec.SetField(loan.FieldReturnedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReturnedAtCleared() {
+		_spec.ClearField(loan.FieldReturnedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Notes(); ok {
+		_spec.SetField(loan.FieldNotes, field.TypeString, value)
+	}
+	if _u.mutation.NotesCleared() {
+		_spec.ClearField(loan.FieldNotes, field.TypeString)
+	}
+	if value, ok := _u.mutation.ReminderSentAt(); ok {
+		_spec.SetField(loan.FieldReminderSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReminderSentAtCleared() {
+		_spec.ClearField(loan.FieldReminderSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(loan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.OwnerTable,
+			Columns: []string{loan.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.OwnerTable,
+			Columns: []string{loan.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.BookTable,
+			Columns: []string{loan.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   loan.BookTable,
+			Columns: []string{loan.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Loan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{loan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -29,13 +29,33 @@ var (
 	// BooksColumns holds the columns for the "books" table.
 	BooksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "book_title", Type: field.TypeString},
-		{Name: "author", Type: field.TypeString},
-		{Name: "book_isbn", Type: field.TypeString, Nullable: true},
-		{Name: "thumbnail_url", Type: field.TypeString, Nullable: true},
-		{Name: "status", Type: field.TypeInt, Default: 0},
+		{Name: "reading_status", Type: field.TypeEnum, Enums: []string{"unread", "reading", "finished", "paused", "abandoned"}, Default: "unread"},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "current_page", Type: field.TypeInt, Default: 0},
+		{Name: "total_pages", Type: field.TypeInt, Default: 0},
+		{Name: "title_override", Type: field.TypeString, Nullable: true},
+		{Name: "author_override", Type: field.TypeString, Nullable: true},
+		{Name: "publisher_override", Type: field.TypeString, Nullable: true},
+		{Name: "published_date_override", Type: field.TypeString, Nullable: true},
+		{Name: "thumbnail_url_override", Type: field.TypeString, Nullable: true},
+		{Name: "location_room", Type: field.TypeString, Default: ""},
+		{Name: "location_bookcase", Type: field.TypeString, Default: ""},
+		{Name: "location_shelf", Type: field.TypeString, Default: ""},
+		{Name: "condition", Type: field.TypeEnum, Nullable: true, Enums: []string{"new", "like_new", "good", "fair", "poor"}},
+		{Name: "format", Type: field.TypeEnum, Nullable: true, Enums: []string{"hardcover", "paperback", "ebook", "audiobook"}},
+		{Name: "purchased_at", Type: field.TypeTime, Nullable: true},
+		{Name: "price", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(12,2)"}},
+		{Name: "currency", Type: field.TypeString, Default: ""},
+		{Name: "acquired_from", Type: field.TypeString, Default: ""},
+		{Name: "live", Type: field.TypeBool, Nullable: true},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"inherit", "public", "private"}, Default: "inherit"},
+		{Name: "book_catalog_copies", Type: field.TypeUUID, Nullable: true},
+		{Name: "library_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_books", Type: field.TypeUUID},
 	}
 	// BooksTable holds the schema information for the "books" table.
@@ -44,14 +64,123 @@ var (
 		Columns:    BooksColumns,
 		PrimaryKey: []*schema.Column{BooksColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "books_book_catalogs_copies",
+				Columns:    []*schema.Column{BooksColumns[26]},
+				RefColumns: []*schema.Column{BookCatalogsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "books_libraries_books",
+				Columns:    []*schema.Column{BooksColumns[27]},
+				RefColumns: []*schema.Column{LibrariesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "books_users_books",
-				Columns:    []*schema.Column{BooksColumns[8]},
+				Columns:    []*schema.Column{BooksColumns[28]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "book_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{BooksColumns[24]},
+			},
+			{
+				Name:    "book_live_user_books_book_catalog_copies",
+				Unique:  true,
+				Columns: []*schema.Column{BooksColumns[20], BooksColumns[28], BooksColumns[26]},
+			},
+		},
+	}
+	// BookCatalogsColumns holds the columns for the "book_catalogs" table.
+	BookCatalogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "isbn", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString},
+		{Name: "publisher", Type: field.TypeString, Nullable: true},
+		{Name: "published_date", Type: field.TypeString, Nullable: true},
+		{Name: "thumbnail_url", Type: field.TypeString, Nullable: true},
+		{Name: "series_name", Type: field.TypeString, Nullable: true},
+		{Name: "series_volume", Type: field.TypeInt, Nullable: true},
+		{Name: "series_total_volumes", Type: field.TypeInt, Nullable: true},
+		{Name: "source", Type: field.TypeString, Default: "manual"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// BookCatalogsTable holds the schema information for the "book_catalogs" table.
+	BookCatalogsTable = &schema.Table{
+		Name:       "book_catalogs",
+		Columns:    BookCatalogsColumns,
+		PrimaryKey: []*schema.Column{BookCatalogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "bookcatalog_series_name",
+				Unique:  false,
+				Columns: []*schema.Column{BookCatalogsColumns[7]},
+			},
+		},
+	}
+	// BookNotesColumns holds the columns for the "book_notes" table.
+	BookNotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "quote", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "page", Type: field.TypeInt, Nullable: true},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "color", Type: field.TypeString, Nullable: true},
+		{Name: "label", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "book_notes", Type: field.TypeUUID},
+		{Name: "user_book_notes", Type: field.TypeUUID},
+	}
+	// BookNotesTable holds the schema information for the "book_notes" table.
+	BookNotesTable = &schema.Table{
+		Name:       "book_notes",
+		Columns:    BookNotesColumns,
+		PrimaryKey: []*schema.Column{BookNotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "book_notes_books_notes",
+				Columns:    []*schema.Column{BookNotesColumns[9]},
+				RefColumns: []*schema.Column{BooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "book_notes_users_book_notes",
+				Columns:    []*schema.Column{BookNotesColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
 	}
+	// BookStatusHistoriesColumns holds the columns for the "book_status_histories" table.
+	BookStatusHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "from_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"unread", "reading", "finished", "paused", "abandoned"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"unread", "reading", "finished", "paused", "abandoned"}},
+		{Name: "changed_at", Type: field.TypeTime},
+		{Name: "book_status_histories", Type: field.TypeUUID},
+	}
+	// BookStatusHistoriesTable holds the schema information for the "book_status_histories" table.
+	BookStatusHistoriesTable = &schema.Table{
+		Name:       "book_status_histories",
+		Columns:    BookStatusHistoriesColumns,
+		PrimaryKey: []*schema.Column{BookStatusHistoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "book_status_histories_books_status_histories",
+				Columns:    []*schema.Column{BookStatusHistoriesColumns[4]},
+				RefColumns: []*schema.Column{BooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// BookmarksColumns holds the columns for the "bookmarks" table.
 	BookmarksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -79,6 +208,74 @@ var (
 			},
 		},
 	}
+	// BorrowRequestsColumns holds the columns for the "borrow_requests" table.
+	BorrowRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "canceled", "handed_over", "returned"}, Default: "pending"},
+		{Name: "message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "response_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "handed_over_at", Type: field.TypeTime, Nullable: true},
+		{Name: "returned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "book_borrow_requests", Type: field.TypeUUID},
+		{Name: "borrow_request_loan", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_sent_borrow_requests", Type: field.TypeUUID},
+		{Name: "user_received_borrow_requests", Type: field.TypeUUID},
+	}
+	// BorrowRequestsTable holds the schema information for the "borrow_requests" table.
+	BorrowRequestsTable = &schema.Table{
+		Name:       "borrow_requests",
+		Columns:    BorrowRequestsColumns,
+		PrimaryKey: []*schema.Column{BorrowRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "borrow_requests_books_borrow_requests",
+				Columns:    []*schema.Column{BorrowRequestsColumns[10]},
+				RefColumns: []*schema.Column{BooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "borrow_requests_loans_loan",
+				Columns:    []*schema.Column{BorrowRequestsColumns[11]},
+				RefColumns: []*schema.Column{LoansColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "borrow_requests_users_sent_borrow_requests",
+				Columns:    []*schema.Column{BorrowRequestsColumns[12]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "borrow_requests_users_received_borrow_requests",
+				Columns:    []*schema.Column{BorrowRequestsColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "borrowrequest_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BorrowRequestsColumns[1], BorrowRequestsColumns[8]},
+			},
+		},
+	}
+	// DataMigrationsColumns holds the columns for the "data_migrations" table.
+	DataMigrationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "applied_at", Type: field.TypeTime},
+	}
+	// DataMigrationsTable holds the schema information for the "data_migrations" table.
+	DataMigrationsTable = &schema.Table{
+		Name:       "data_migrations",
+		Columns:    DataMigrationsColumns,
+		PrimaryKey: []*schema.Column{DataMigrationsColumns[0]},
+	}
 	// EmailVerificationsColumns holds the columns for the "email_verifications" table.
 	EmailVerificationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -94,6 +291,175 @@ var (
 		Columns:    EmailVerificationsColumns,
 		PrimaryKey: []*schema.Column{EmailVerificationsColumns[0]},
 	}
+	// LibrariesColumns holds the columns for the "libraries" table.
+	LibrariesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// LibrariesTable holds the schema information for the "libraries" table.
+	LibrariesTable = &schema.Table{
+		Name:       "libraries",
+		Columns:    LibrariesColumns,
+		PrimaryKey: []*schema.Column{LibrariesColumns[0]},
+	}
+	// LibraryInvitationsColumns holds the columns for the "library_invitations" table.
+	LibraryInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"editor", "viewer"}, Default: "viewer"},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "canceled"}, Default: "pending"},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "library_invitations", Type: field.TypeUUID},
+		{Name: "user_sent_library_invitations", Type: field.TypeUUID},
+		{Name: "user_received_library_invitations", Type: field.TypeUUID},
+	}
+	// LibraryInvitationsTable holds the schema information for the "library_invitations" table.
+	LibraryInvitationsTable = &schema.Table{
+		Name:       "library_invitations",
+		Columns:    LibraryInvitationsColumns,
+		PrimaryKey: []*schema.Column{LibraryInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "library_invitations_libraries_invitations",
+				Columns:    []*schema.Column{LibraryInvitationsColumns[6]},
+				RefColumns: []*schema.Column{LibrariesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "library_invitations_users_sent_library_invitations",
+				Columns:    []*schema.Column{LibraryInvitationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "library_invitations_users_received_library_invitations",
+				Columns:    []*schema.Column{LibraryInvitationsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "libraryinvitation_status_user_received_library_invitations",
+				Unique:  false,
+				Columns: []*schema.Column{LibraryInvitationsColumns[2], LibraryInvitationsColumns[8]},
+			},
+		},
+	}
+	// LibraryMembersColumns holds the columns for the "library_members" table.
+	LibraryMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "editor", "viewer"}, Default: "viewer"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "library_members", Type: field.TypeUUID},
+		{Name: "user_library_memberships", Type: field.TypeUUID},
+	}
+	// LibraryMembersTable holds the schema information for the "library_members" table.
+	LibraryMembersTable = &schema.Table{
+		Name:       "library_members",
+		Columns:    LibraryMembersColumns,
+		PrimaryKey: []*schema.Column{LibraryMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "library_members_libraries_members",
+				Columns:    []*schema.Column{LibraryMembersColumns[4]},
+				RefColumns: []*schema.Column{LibrariesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "library_members_users_library_memberships",
+				Columns:    []*schema.Column{LibraryMembersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "librarymember_library_members_user_library_memberships",
+				Unique:  true,
+				Columns: []*schema.Column{LibraryMembersColumns[4], LibraryMembersColumns[5]},
+			},
+		},
+	}
+	// LoansColumns holds the columns for the "loans" table.
+	LoansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "borrower_name", Type: field.TypeString},
+		{Name: "borrower_contact", Type: field.TypeString, Nullable: true},
+		{Name: "lent_at", Type: field.TypeTime},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "returned_at", Type: field.TypeTime, Nullable: true},
+		{Name: "notes", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "reminder_sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "book_loans", Type: field.TypeUUID},
+		{Name: "user_loans", Type: field.TypeUUID},
+	}
+	// LoansTable holds the schema information for the "loans" table.
+	LoansTable = &schema.Table{
+		Name:       "loans",
+		Columns:    LoansColumns,
+		PrimaryKey: []*schema.Column{LoansColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "loans_books_loans",
+				Columns:    []*schema.Column{LoansColumns[10]},
+				RefColumns: []*schema.Column{BooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "loans_users_loans",
+				Columns:    []*schema.Column{LoansColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loan_returned_at_due_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoansColumns[5], LoansColumns[4]},
+			},
+		},
+	}
+	// ReadingGoalsColumns holds the columns for the "reading_goals" table.
+	ReadingGoalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "year", Type: field.TypeInt},
+		{Name: "goal_type", Type: field.TypeEnum, Enums: []string{"books", "pages"}},
+		{Name: "target", Type: field.TypeInt},
+		{Name: "pace_reminder", Type: field.TypeBool, Default: true},
+		{Name: "reminder_sent_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_reading_goals", Type: field.TypeUUID},
+	}
+	// ReadingGoalsTable holds the schema information for the "reading_goals" table.
+	ReadingGoalsTable = &schema.Table{
+		Name:       "reading_goals",
+		Columns:    ReadingGoalsColumns,
+		PrimaryKey: []*schema.Column{ReadingGoalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reading_goals_users_reading_goals",
+				Columns:    []*schema.Column{ReadingGoalsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "readinggoal_year_goal_type_user_reading_goals",
+				Unique:  true,
+				Columns: []*schema.Column{ReadingGoalsColumns[1], ReadingGoalsColumns[2], ReadingGoalsColumns[8]},
+			},
+		},
+	}
 	// ReadingRemindersColumns holds the columns for the "reading_reminders" table.
 	ReadingRemindersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -101,6 +467,7 @@ var (
 		{Name: "day_of_week", Type: field.TypeEnum, Enums: []string{"everyday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}, Default: "everyday"},
 		{Name: "is_enabled", Type: field.TypeBool, Default: true},
 		{Name: "message", Type: field.TypeString, Default: "책 읽을 시간이에요!"},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_reading_reminders", Type: field.TypeUUID},
@@ -113,7 +480,38 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "reading_reminders_users_reading_reminders",
-				Columns:    []*schema.Column{ReadingRemindersColumns[7]},
+				Columns:    []*schema.Column{ReadingRemindersColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// ReadingSessionsColumns holds the columns for the "reading_sessions" table.
+	ReadingSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "start_page", Type: field.TypeInt, Default: 0},
+		{Name: "end_page", Type: field.TypeInt, Nullable: true},
+		{Name: "pages_read", Type: field.TypeInt, Default: 0},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "ended_at", Type: field.TypeTime, Nullable: true},
+		{Name: "book_reading_sessions", Type: field.TypeUUID},
+		{Name: "user_reading_sessions", Type: field.TypeUUID},
+	}
+	// ReadingSessionsTable holds the schema information for the "reading_sessions" table.
+	ReadingSessionsTable = &schema.Table{
+		Name:       "reading_sessions",
+		Columns:    ReadingSessionsColumns,
+		PrimaryKey: []*schema.Column{ReadingSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reading_sessions_books_reading_sessions",
+				Columns:    []*schema.Column{ReadingSessionsColumns[6]},
+				RefColumns: []*schema.Column{BooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "reading_sessions_users_reading_sessions",
+				Columns:    []*schema.Column{ReadingSessionsColumns[7]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -126,9 +524,12 @@ var (
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
 		{Name: "rating", Type: field.TypeInt},
 		{Name: "is_public", Type: field.TypeBool, Default: false},
+		{Name: "version", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "book_reviews", Type: field.TypeUUID, Nullable: true},
+		{Name: "book_catalog_reviews", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_reviews", Type: field.TypeUUID},
 	}
 	// ReviewsTable holds the schema information for the "reviews" table.
@@ -139,17 +540,117 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "reviews_books_reviews",
-				Columns:    []*schema.Column{ReviewsColumns[7]},
+				Columns:    []*schema.Column{ReviewsColumns[9]},
 				RefColumns: []*schema.Column{BooksColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
+			{
+				Symbol:     "reviews_book_catalogs_reviews",
+				Columns:    []*schema.Column{ReviewsColumns[10]},
+				RefColumns: []*schema.Column{BookCatalogsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "reviews_users_reviews",
-				Columns:    []*schema.Column{ReviewsColumns[8]},
+				Columns:    []*schema.Column{ReviewsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "review_deleted_at",
+				Unique:  false,
+				Columns: []*schema.Column{ReviewsColumns[8]},
+			},
+		},
+	}
+	// ShelvesColumns holds the columns for the "shelves" table.
+	ShelvesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "public"}, Default: "private"},
+		{Name: "hide_books", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_shelves", Type: field.TypeUUID},
+	}
+	// ShelvesTable holds the schema information for the "shelves" table.
+	ShelvesTable = &schema.Table{
+		Name:       "shelves",
+		Columns:    ShelvesColumns,
+		PrimaryKey: []*schema.Column{ShelvesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shelves_users_shelves",
+				Columns:    []*schema.Column{ShelvesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "shelf_name_user_shelves",
+				Unique:  true,
+				Columns: []*schema.Column{ShelvesColumns[1], ShelvesColumns[7]},
+			},
+		},
+	}
+	// ShelfBooksColumns holds the columns for the "shelf_books" table.
+	ShelfBooksColumns = []*schema.Column{
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "added_at", Type: field.TypeTime},
+		{Name: "shelf_id", Type: field.TypeUUID},
+		{Name: "book_id", Type: field.TypeUUID},
+	}
+	// ShelfBooksTable holds the schema information for the "shelf_books" table.
+	ShelfBooksTable = &schema.Table{
+		Name:       "shelf_books",
+		Columns:    ShelfBooksColumns,
+		PrimaryKey: []*schema.Column{ShelfBooksColumns[2], ShelfBooksColumns[3]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shelf_books_shelves_shelf",
+				Columns:    []*schema.Column{ShelfBooksColumns[2]},
+				RefColumns: []*schema.Column{ShelvesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "shelf_books_books_book",
+				Columns:    []*schema.Column{ShelfBooksColumns[3]},
+				RefColumns: []*schema.Column{BooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_tags", Type: field.TypeUUID},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_users_tags",
+				Columns:    []*schema.Column{TagsColumns[3]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_name_user_tags",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[1], TagsColumns[3]},
+			},
+		},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
@@ -162,6 +663,7 @@ var (
 		{Name: "is_privacy_agreed", Type: field.TypeBool, Default: false},
 		{Name: "fcm_token", Type: field.TypeString, Nullable: true},
 		{Name: "timezone", Type: field.TypeString, Default: "Asia/Seoul"},
+		{Name: "wishlist_share_token", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -171,23 +673,131 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WishlistItemsColumns holds the columns for the "wishlist_items" table.
+	WishlistItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "title", Type: field.TypeString},
+		{Name: "author", Type: field.TypeString, Default: ""},
+		{Name: "isbn", Type: field.TypeString, Nullable: true},
+		{Name: "publisher", Type: field.TypeString, Default: ""},
+		{Name: "thumbnail_url", Type: field.TypeString, Default: ""},
+		{Name: "priority", Type: field.TypeEnum, Enums: []string{"low", "medium", "high"}, Default: "medium"},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "target_price", Type: field.TypeFloat64, Nullable: true, SchemaType: map[string]string{"mysql": "decimal(12,2)"}},
+		{Name: "currency", Type: field.TypeString, Default: ""},
+		{Name: "claimed_by", Type: field.TypeString, Default: ""},
+		{Name: "claim_code", Type: field.TypeString, Default: ""},
+		{Name: "claimed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_wishlist_items", Type: field.TypeUUID},
+	}
+	// WishlistItemsTable holds the schema information for the "wishlist_items" table.
+	WishlistItemsTable = &schema.Table{
+		Name:       "wishlist_items",
+		Columns:    WishlistItemsColumns,
+		PrimaryKey: []*schema.Column{WishlistItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wishlist_items_users_wishlist_items",
+				Columns:    []*schema.Column{WishlistItemsColumns[15]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wishlistitem_isbn",
+				Unique:  false,
+				Columns: []*schema.Column{WishlistItemsColumns[3]},
+			},
+		},
+	}
+	// TagBooksColumns holds the columns for the "tag_books" table.
+	TagBooksColumns = []*schema.Column{
+		{Name: "tag_id", Type: field.TypeUUID},
+		{Name: "book_id", Type: field.TypeUUID},
+	}
+	// TagBooksTable holds the schema information for the "tag_books" table.
+	TagBooksTable = &schema.Table{
+		Name:       "tag_books",
+		Columns:    TagBooksColumns,
+		PrimaryKey: []*schema.Column{TagBooksColumns[0], TagBooksColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tag_books_tag_id",
+				Columns:    []*schema.Column{TagBooksColumns[0]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "tag_books_book_id",
+				Columns:    []*schema.Column{TagBooksColumns[1]},
+				RefColumns: []*schema.Column{BooksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AdminAPIKeysTable,
 		BooksTable,
+		BookCatalogsTable,
+		BookNotesTable,
+		BookStatusHistoriesTable,
 		BookmarksTable,
+		BorrowRequestsTable,
+		DataMigrationsTable,
 		EmailVerificationsTable,
+		LibrariesTable,
+		LibraryInvitationsTable,
+		LibraryMembersTable,
+		LoansTable,
+		ReadingGoalsTable,
 		ReadingRemindersTable,
+		ReadingSessionsTable,
 		ReviewsTable,
+		ShelvesTable,
+		ShelfBooksTable,
+		TagsTable,
 		UsersTable,
+		WishlistItemsTable,
+		TagBooksTable,
 	}
 )
 
 func init() {
-	BooksTable.ForeignKeys[0].RefTable = UsersTable
+	BooksTable.ForeignKeys[0].RefTable = BookCatalogsTable
+	BooksTable.ForeignKeys[1].RefTable = LibrariesTable
+	BooksTable.ForeignKeys[2].RefTable = UsersTable
+	BookNotesTable.ForeignKeys[0].RefTable = BooksTable
+	BookNotesTable.ForeignKeys[1].RefTable = UsersTable
+	BookStatusHistoriesTable.ForeignKeys[0].RefTable = BooksTable
 	BookmarksTable.ForeignKeys[0].RefTable = BooksTable
 	BookmarksTable.ForeignKeys[1].RefTable = UsersTable
+	BorrowRequestsTable.ForeignKeys[0].RefTable = BooksTable
+	BorrowRequestsTable.ForeignKeys[1].RefTable = LoansTable
+	BorrowRequestsTable.ForeignKeys[2].RefTable = UsersTable
+	BorrowRequestsTable.ForeignKeys[3].RefTable = UsersTable
+	LibraryInvitationsTable.ForeignKeys[0].RefTable = LibrariesTable
+	LibraryInvitationsTable.ForeignKeys[1].RefTable = UsersTable
+	LibraryInvitationsTable.ForeignKeys[2].RefTable = UsersTable
+	LibraryMembersTable.ForeignKeys[0].RefTable = LibrariesTable
+	LibraryMembersTable.ForeignKeys[1].RefTable = UsersTable
+	LoansTable.ForeignKeys[0].RefTable = BooksTable
+	LoansTable.ForeignKeys[1].RefTable = UsersTable
+	ReadingGoalsTable.ForeignKeys[0].RefTable = UsersTable
 	ReadingRemindersTable.ForeignKeys[0].RefTable = UsersTable
+	ReadingSessionsTable.ForeignKeys[0].RefTable = BooksTable
+	ReadingSessionsTable.ForeignKeys[1].RefTable = UsersTable
 	ReviewsTable.ForeignKeys[0].RefTable = BooksTable
-	ReviewsTable.ForeignKeys[1].RefTable = UsersTable
+	ReviewsTable.ForeignKeys[1].RefTable = BookCatalogsTable
+	ReviewsTable.ForeignKeys[2].RefTable = UsersTable
+	ShelvesTable.ForeignKeys[0].RefTable = UsersTable
+	ShelfBooksTable.ForeignKeys[0].RefTable = ShelvesTable
+	ShelfBooksTable.ForeignKeys[1].RefTable = BooksTable
+	TagsTable.ForeignKeys[0].RefTable = UsersTable
+	WishlistItemsTable.ForeignKeys[0].RefTable = UsersTable
+	TagBooksTable.ForeignKeys[0].RefTable = TagsTable
+	TagBooksTable.ForeignKeys[1].RefTable = BooksTable
 }
@@ -13,12 +13,27 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/adminapikey"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/datamigration"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/emailverification"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingreminder"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
 	"github.com/google/uuid"
 )
 
@@ -33,11 +48,26 @@ const (
 	// Node types.
 	TypeAdminAPIKey       = "AdminAPIKey"
 	TypeBook              = "Book"
+	TypeBookCatalog       = "BookCatalog"
+	TypeBookNote          = "BookNote"
+	TypeBookStatusHistory = "BookStatusHistory"
 	TypeBookmark          = "Bookmark"
+	TypeBorrowRequest     = "BorrowRequest"
+	TypeDataMigration     = "DataMigration"
 	TypeEmailVerification = "EmailVerification"
+	TypeLibrary           = "Library"
+	TypeLibraryInvitation = "LibraryInvitation"
+	TypeLibraryMember     = "LibraryMember"
+	TypeLoan              = "Loan"
+	TypeReadingGoal       = "ReadingGoal"
 	TypeReadingReminder   = "ReadingReminder"
+	TypeReadingSession    = "ReadingSession"
 	TypeReview            = "Review"
+	TypeShelf             = "Shelf"
+	TypeShelfBook         = "ShelfBook"
+	TypeTag               = "Tag"
 	TypeUser              = "User"
+	TypeWishlistItem      = "WishlistItem"
 )
 
 // AdminAPIKeyMutation represents an operation that mutates the AdminAPIKey nodes in the graph.
@@ -794,29 +824,75 @@ func (m *AdminAPIKeyMutation) ResetEdge(name string) error {
 // BookMutation represents an operation that mutates the Book nodes in the graph.
 type BookMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	book_title       *string
-	author           *string
-	book_isbn        *string
-	thumbnail_url    *string
-	status           *int
-	addstatus        *int
-	created_at       *time.Time
-	updated_at       *time.Time
-	clearedFields    map[string]struct{}
-	owner            *uuid.UUID
-	clearedowner     bool
-	reviews          map[uuid.UUID]struct{}
-	removedreviews   map[uuid.UUID]struct{}
-	clearedreviews   bool
-	bookmarks        map[uuid.UUID]struct{}
-	removedbookmarks map[uuid.UUID]struct{}
-	clearedbookmarks bool
-	done             bool
-	oldValue         func(context.Context) (*Book, error)
-	predicates       []predicate.Book
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	reading_status          *book.ReadingStatus
+	started_at              *time.Time
+	finished_at             *time.Time
+	current_page            *int
+	addcurrent_page         *int
+	total_pages             *int
+	addtotal_pages          *int
+	title_override          *string
+	author_override         *string
+	publisher_override      *string
+	published_date_override *string
+	thumbnail_url_override  *string
+	location_room           *string
+	location_bookcase       *string
+	location_shelf          *string
+	condition               *book.Condition
+	format                  *book.Format
+	purchased_at            *time.Time
+	price                   *float64
+	addprice                *float64
+	currency                *string
+	acquired_from           *string
+	live                    *bool
+	version                 *int
+	addversion              *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	deleted_at              *time.Time
+	visibility              *book.Visibility
+	clearedFields           map[string]struct{}
+	owner                   *uuid.UUID
+	clearedowner            bool
+	catalog                 *uuid.UUID
+	clearedcatalog          bool
+	library                 *uuid.UUID
+	clearedlibrary          bool
+	reviews                 map[uuid.UUID]struct{}
+	removedreviews          map[uuid.UUID]struct{}
+	clearedreviews          bool
+	bookmarks               map[uuid.UUID]struct{}
+	removedbookmarks        map[uuid.UUID]struct{}
+	clearedbookmarks        bool
+	reading_sessions        map[uuid.UUID]struct{}
+	removedreading_sessions map[uuid.UUID]struct{}
+	clearedreading_sessions bool
+	status_histories        map[uuid.UUID]struct{}
+	removedstatus_histories map[uuid.UUID]struct{}
+	clearedstatus_histories bool
+	shelves                 map[uuid.UUID]struct{}
+	removedshelves          map[uuid.UUID]struct{}
+	clearedshelves          bool
+	tags                    map[uuid.UUID]struct{}
+	removedtags             map[uuid.UUID]struct{}
+	clearedtags             bool
+	notes                   map[uuid.UUID]struct{}
+	removednotes            map[uuid.UUID]struct{}
+	clearednotes            bool
+	loans                   map[uuid.UUID]struct{}
+	removedloans            map[uuid.UUID]struct{}
+	clearedloans            bool
+	borrow_requests         map[uuid.UUID]struct{}
+	removedborrow_requests  map[uuid.UUID]struct{}
+	clearedborrow_requests  bool
+	done                    bool
+	oldValue                func(context.Context) (*Book, error)
+	predicates              []predicate.Book
 }
 
 var _ ent.Mutation = (*BookMutation)(nil)
@@ -923,2123 +999,16910 @@ func (m *BookMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	}
 }
 
-// SetBookTitle sets the "book_title" field.
-func (m *BookMutation) SetBookTitle(s string) {
-	m.book_title = &s
+// SetReadingStatus sets the "reading_status" field.
+func (m *BookMutation) SetReadingStatus(bs book.ReadingStatus) {
+	m.reading_status = &bs
 }
 
-// BookTitle returns the value of the "book_title" field in the mutation.
-func (m *BookMutation) BookTitle() (r string, exists bool) {
-	v := m.book_title
+// ReadingStatus returns the value of the "reading_status" field in the mutation.
+func (m *BookMutation) ReadingStatus() (r book.ReadingStatus, exists bool) {
+	v := m.reading_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBookTitle returns the old "book_title" field's value of the Book entity.
+// OldReadingStatus returns the old "reading_status" field's value of the Book entity.
 // If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldBookTitle(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldReadingStatus(ctx context.Context) (v book.ReadingStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBookTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldReadingStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBookTitle requires an ID field in the mutation")
+		return v, errors.New("OldReadingStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBookTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldReadingStatus: %w", err)
 	}
-	return oldValue.BookTitle, nil
+	return oldValue.ReadingStatus, nil
 }
 
-// ResetBookTitle resets all changes to the "book_title" field.
-func (m *BookMutation) ResetBookTitle() {
-	m.book_title = nil
+// ResetReadingStatus resets all changes to the "reading_status" field.
+func (m *BookMutation) ResetReadingStatus() {
+	m.reading_status = nil
 }
 
-// SetAuthor sets the "author" field.
-func (m *BookMutation) SetAuthor(s string) {
-	m.author = &s
+// SetStartedAt sets the "started_at" field.
+func (m *BookMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// Author returns the value of the "author" field in the mutation.
-func (m *BookMutation) Author() (r string, exists bool) {
-	v := m.author
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *BookMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthor returns the old "author" field's value of the Book entity.
+// OldStartedAt returns the old "started_at" field's value of the Book entity.
 // If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldAuthor(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldStartedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthor requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.Author, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetAuthor resets all changes to the "author" field.
-func (m *BookMutation) ResetAuthor() {
-	m.author = nil
+// ClearStartedAt clears the value of the "started_at" field.
+func (m *BookMutation) ClearStartedAt() {
+	m.started_at = nil
+	m.clearedFields[book.FieldStartedAt] = struct{}{}
 }
 
-// SetBookIsbn sets the "book_isbn" field.
-func (m *BookMutation) SetBookIsbn(s string) {
-	m.book_isbn = &s
+// StartedAtCleared returns if the "started_at" field was cleared in this mutation.
+func (m *BookMutation) StartedAtCleared() bool {
+	_, ok := m.clearedFields[book.FieldStartedAt]
+	return ok
 }
 
-// BookIsbn returns the value of the "book_isbn" field in the mutation.
-func (m *BookMutation) BookIsbn() (r string, exists bool) {
-	v := m.book_isbn
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *BookMutation) ResetStartedAt() {
+	m.started_at = nil
+	delete(m.clearedFields, book.FieldStartedAt)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *BookMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *BookMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBookIsbn returns the old "book_isbn" field's value of the Book entity.
+// OldFinishedAt returns the old "finished_at" field's value of the Book entity.
 // If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldBookIsbn(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBookIsbn is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBookIsbn requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBookIsbn: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.BookIsbn, nil
+	return oldValue.FinishedAt, nil
 }
 
-// ClearBookIsbn clears the value of the "book_isbn" field.
-func (m *BookMutation) ClearBookIsbn() {
-	m.book_isbn = nil
-	m.clearedFields[book.FieldBookIsbn] = struct{}{}
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *BookMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[book.FieldFinishedAt] = struct{}{}
 }
 
-// BookIsbnCleared returns if the "book_isbn" field was cleared in this mutation.
-func (m *BookMutation) BookIsbnCleared() bool {
-	_, ok := m.clearedFields[book.FieldBookIsbn]
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *BookMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[book.FieldFinishedAt]
 	return ok
 }
 
-// ResetBookIsbn resets all changes to the "book_isbn" field.
-func (m *BookMutation) ResetBookIsbn() {
-	m.book_isbn = nil
-	delete(m.clearedFields, book.FieldBookIsbn)
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *BookMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, book.FieldFinishedAt)
 }
 
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (m *BookMutation) SetThumbnailURL(s string) {
-	m.thumbnail_url = &s
+// SetCurrentPage sets the "current_page" field.
+func (m *BookMutation) SetCurrentPage(i int) {
+	m.current_page = &i
+	m.addcurrent_page = nil
 }
 
-// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
-func (m *BookMutation) ThumbnailURL() (r string, exists bool) {
-	v := m.thumbnail_url
+// CurrentPage returns the value of the "current_page" field in the mutation.
+func (m *BookMutation) CurrentPage() (r int, exists bool) {
+	v := m.current_page
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldThumbnailURL returns the old "thumbnail_url" field's value of the Book entity.
+// OldCurrentPage returns the old "current_page" field's value of the Book entity.
 // If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+func (m *BookMutation) OldCurrentPage(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrentPage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+		return v, errors.New("OldCurrentPage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrentPage: %w", err)
 	}
-	return oldValue.ThumbnailURL, nil
+	return oldValue.CurrentPage, nil
 }
 
-// ClearThumbnailURL clears the value of the "thumbnail_url" field.
-func (m *BookMutation) ClearThumbnailURL() {
-	m.thumbnail_url = nil
-	m.clearedFields[book.FieldThumbnailURL] = struct{}{}
+// AddCurrentPage adds i to the "current_page" field.
+func (m *BookMutation) AddCurrentPage(i int) {
+	if m.addcurrent_page != nil {
+		*m.addcurrent_page += i
+	} else {
+		m.addcurrent_page = &i
+	}
 }
 
-// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
-func (m *BookMutation) ThumbnailURLCleared() bool {
-	_, ok := m.clearedFields[book.FieldThumbnailURL]
-	return ok
+// AddedCurrentPage returns the value that was added to the "current_page" field in this mutation.
+func (m *BookMutation) AddedCurrentPage() (r int, exists bool) {
+	v := m.addcurrent_page
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
-func (m *BookMutation) ResetThumbnailURL() {
-	m.thumbnail_url = nil
-	delete(m.clearedFields, book.FieldThumbnailURL)
+// ResetCurrentPage resets all changes to the "current_page" field.
+func (m *BookMutation) ResetCurrentPage() {
+	m.current_page = nil
+	m.addcurrent_page = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *BookMutation) SetStatus(i int) {
-	m.status = &i
-	m.addstatus = nil
+// SetTotalPages sets the "total_pages" field.
+func (m *BookMutation) SetTotalPages(i int) {
+	m.total_pages = &i
+	m.addtotal_pages = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *BookMutation) Status() (r int, exists bool) {
-	v := m.status
+// TotalPages returns the value of the "total_pages" field in the mutation.
+func (m *BookMutation) TotalPages() (r int, exists bool) {
+	v := m.total_pages
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Book entity.
+// OldTotalPages returns the old "total_pages" field's value of the Book entity.
 // If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldStatus(ctx context.Context) (v int, err error) {
+func (m *BookMutation) OldTotalPages(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalPages is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldTotalPages requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalPages: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.TotalPages, nil
 }
 
-// AddStatus adds i to the "status" field.
-func (m *BookMutation) AddStatus(i int) {
-	if m.addstatus != nil {
-		*m.addstatus += i
+// AddTotalPages adds i to the "total_pages" field.
+func (m *BookMutation) AddTotalPages(i int) {
+	if m.addtotal_pages != nil {
+		*m.addtotal_pages += i
 	} else {
-		m.addstatus = &i
+		m.addtotal_pages = &i
 	}
 }
 
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *BookMutation) AddedStatus() (r int, exists bool) {
-	v := m.addstatus
+// AddedTotalPages returns the value that was added to the "total_pages" field in this mutation.
+func (m *BookMutation) AddedTotalPages() (r int, exists bool) {
+	v := m.addtotal_pages
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *BookMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
+// ResetTotalPages resets all changes to the "total_pages" field.
+func (m *BookMutation) ResetTotalPages() {
+	m.total_pages = nil
+	m.addtotal_pages = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *BookMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTitleOverride sets the "title_override" field.
+func (m *BookMutation) SetTitleOverride(s string) {
+	m.title_override = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BookMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// TitleOverride returns the value of the "title_override" field in the mutation.
+func (m *BookMutation) TitleOverride() (r string, exists bool) {
+	v := m.title_override
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Book entity.
+// OldTitleOverride returns the old "title_override" field's value of the Book entity.
 // If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookMutation) OldTitleOverride(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitleOverride is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTitleOverride requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitleOverride: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.TitleOverride, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BookMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearTitleOverride clears the value of the "title_override" field.
+func (m *BookMutation) ClearTitleOverride() {
+	m.title_override = nil
+	m.clearedFields[book.FieldTitleOverride] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *BookMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// TitleOverrideCleared returns if the "title_override" field was cleared in this mutation.
+func (m *BookMutation) TitleOverrideCleared() bool {
+	_, ok := m.clearedFields[book.FieldTitleOverride]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *BookMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetTitleOverride resets all changes to the "title_override" field.
+func (m *BookMutation) ResetTitleOverride() {
+	m.title_override = nil
+	delete(m.clearedFields, book.FieldTitleOverride)
+}
+
+// SetAuthorOverride sets the "author_override" field.
+func (m *BookMutation) SetAuthorOverride(s string) {
+	m.author_override = &s
+}
+
+// AuthorOverride returns the value of the "author_override" field in the mutation.
+func (m *BookMutation) AuthorOverride() (r string, exists bool) {
+	v := m.author_override
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Book entity.
+// OldAuthorOverride returns the old "author_override" field's value of the Book entity.
 // If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookMutation) OldAuthorOverride(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthorOverride is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAuthorOverride requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthorOverride: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.AuthorOverride, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *BookMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearAuthorOverride clears the value of the "author_override" field.
+func (m *BookMutation) ClearAuthorOverride() {
+	m.author_override = nil
+	m.clearedFields[book.FieldAuthorOverride] = struct{}{}
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *BookMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
+// AuthorOverrideCleared returns if the "author_override" field was cleared in this mutation.
+func (m *BookMutation) AuthorOverrideCleared() bool {
+	_, ok := m.clearedFields[book.FieldAuthorOverride]
+	return ok
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *BookMutation) ClearOwner() {
-	m.clearedowner = true
+// ResetAuthorOverride resets all changes to the "author_override" field.
+func (m *BookMutation) ResetAuthorOverride() {
+	m.author_override = nil
+	delete(m.clearedFields, book.FieldAuthorOverride)
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *BookMutation) OwnerCleared() bool {
-	return m.clearedowner
+// SetPublisherOverride sets the "publisher_override" field.
+func (m *BookMutation) SetPublisherOverride(s string) {
+	m.publisher_override = &s
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *BookMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// PublisherOverride returns the value of the "publisher_override" field in the mutation.
+func (m *BookMutation) PublisherOverride() (r string, exists bool) {
+	v := m.publisher_override
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *BookMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldPublisherOverride returns the old "publisher_override" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldPublisherOverride(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublisherOverride is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublisherOverride requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublisherOverride: %w", err)
+	}
+	return oldValue.PublisherOverride, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *BookMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ClearPublisherOverride clears the value of the "publisher_override" field.
+func (m *BookMutation) ClearPublisherOverride() {
+	m.publisher_override = nil
+	m.clearedFields[book.FieldPublisherOverride] = struct{}{}
 }
 
-// AddReviewIDs adds the "reviews" edge to the Review entity by ids.
-func (m *BookMutation) AddReviewIDs(ids ...uuid.UUID) {
-	if m.reviews == nil {
-		m.reviews = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.reviews[ids[i]] = struct{}{}
-	}
+// PublisherOverrideCleared returns if the "publisher_override" field was cleared in this mutation.
+func (m *BookMutation) PublisherOverrideCleared() bool {
+	_, ok := m.clearedFields[book.FieldPublisherOverride]
+	return ok
 }
 
-// ClearReviews clears the "reviews" edge to the Review entity.
-func (m *BookMutation) ClearReviews() {
-	m.clearedreviews = true
+// ResetPublisherOverride resets all changes to the "publisher_override" field.
+func (m *BookMutation) ResetPublisherOverride() {
+	m.publisher_override = nil
+	delete(m.clearedFields, book.FieldPublisherOverride)
 }
 
-// ReviewsCleared reports if the "reviews" edge to the Review entity was cleared.
-func (m *BookMutation) ReviewsCleared() bool {
-	return m.clearedreviews
+// SetPublishedDateOverride sets the "published_date_override" field.
+func (m *BookMutation) SetPublishedDateOverride(s string) {
+	m.published_date_override = &s
 }
 
-// RemoveReviewIDs removes the "reviews" edge to the Review entity by IDs.
-func (m *BookMutation) RemoveReviewIDs(ids ...uuid.UUID) {
-	if m.removedreviews == nil {
-		m.removedreviews = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.reviews, ids[i])
-		m.removedreviews[ids[i]] = struct{}{}
+// PublishedDateOverride returns the value of the "published_date_override" field in the mutation.
+func (m *BookMutation) PublishedDateOverride() (r string, exists bool) {
+	v := m.published_date_override
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedReviews returns the removed IDs of the "reviews" edge to the Review entity.
-func (m *BookMutation) RemovedReviewsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreviews {
-		ids = append(ids, id)
+// OldPublishedDateOverride returns the old "published_date_override" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldPublishedDateOverride(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedDateOverride is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ReviewsIDs returns the "reviews" edge IDs in the mutation.
-func (m *BookMutation) ReviewsIDs() (ids []uuid.UUID) {
-	for id := range m.reviews {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedDateOverride requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedDateOverride: %w", err)
+	}
+	return oldValue.PublishedDateOverride, nil
 }
 
-// ResetReviews resets all changes to the "reviews" edge.
-func (m *BookMutation) ResetReviews() {
-	m.reviews = nil
-	m.clearedreviews = false
-	m.removedreviews = nil
+// ClearPublishedDateOverride clears the value of the "published_date_override" field.
+func (m *BookMutation) ClearPublishedDateOverride() {
+	m.published_date_override = nil
+	m.clearedFields[book.FieldPublishedDateOverride] = struct{}{}
 }
 
-// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by ids.
-func (m *BookMutation) AddBookmarkIDs(ids ...uuid.UUID) {
-	if m.bookmarks == nil {
-		m.bookmarks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.bookmarks[ids[i]] = struct{}{}
-	}
+// PublishedDateOverrideCleared returns if the "published_date_override" field was cleared in this mutation.
+func (m *BookMutation) PublishedDateOverrideCleared() bool {
+	_, ok := m.clearedFields[book.FieldPublishedDateOverride]
+	return ok
 }
 
-// ClearBookmarks clears the "bookmarks" edge to the Bookmark entity.
-func (m *BookMutation) ClearBookmarks() {
-	m.clearedbookmarks = true
+// ResetPublishedDateOverride resets all changes to the "published_date_override" field.
+func (m *BookMutation) ResetPublishedDateOverride() {
+	m.published_date_override = nil
+	delete(m.clearedFields, book.FieldPublishedDateOverride)
 }
 
-// BookmarksCleared reports if the "bookmarks" edge to the Bookmark entity was cleared.
-func (m *BookMutation) BookmarksCleared() bool {
-	return m.clearedbookmarks
+// SetThumbnailURLOverride sets the "thumbnail_url_override" field.
+func (m *BookMutation) SetThumbnailURLOverride(s string) {
+	m.thumbnail_url_override = &s
 }
 
-// RemoveBookmarkIDs removes the "bookmarks" edge to the Bookmark entity by IDs.
-func (m *BookMutation) RemoveBookmarkIDs(ids ...uuid.UUID) {
-	if m.removedbookmarks == nil {
-		m.removedbookmarks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.bookmarks, ids[i])
-		m.removedbookmarks[ids[i]] = struct{}{}
+// ThumbnailURLOverride returns the value of the "thumbnail_url_override" field in the mutation.
+func (m *BookMutation) ThumbnailURLOverride() (r string, exists bool) {
+	v := m.thumbnail_url_override
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedBookmarks returns the removed IDs of the "bookmarks" edge to the Bookmark entity.
-func (m *BookMutation) RemovedBookmarksIDs() (ids []uuid.UUID) {
-	for id := range m.removedbookmarks {
-		ids = append(ids, id)
+// OldThumbnailURLOverride returns the old "thumbnail_url_override" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldThumbnailURLOverride(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURLOverride is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURLOverride requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURLOverride: %w", err)
+	}
+	return oldValue.ThumbnailURLOverride, nil
 }
 
-// BookmarksIDs returns the "bookmarks" edge IDs in the mutation.
-func (m *BookMutation) BookmarksIDs() (ids []uuid.UUID) {
-	for id := range m.bookmarks {
-		ids = append(ids, id)
-	}
-	return
+// ClearThumbnailURLOverride clears the value of the "thumbnail_url_override" field.
+func (m *BookMutation) ClearThumbnailURLOverride() {
+	m.thumbnail_url_override = nil
+	m.clearedFields[book.FieldThumbnailURLOverride] = struct{}{}
 }
 
-// ResetBookmarks resets all changes to the "bookmarks" edge.
-func (m *BookMutation) ResetBookmarks() {
-	m.bookmarks = nil
-	m.clearedbookmarks = false
-	m.removedbookmarks = nil
+// ThumbnailURLOverrideCleared returns if the "thumbnail_url_override" field was cleared in this mutation.
+func (m *BookMutation) ThumbnailURLOverrideCleared() bool {
+	_, ok := m.clearedFields[book.FieldThumbnailURLOverride]
+	return ok
 }
 
-// Where appends a list predicates to the BookMutation builder.
-func (m *BookMutation) Where(ps ...predicate.Book) {
-	m.predicates = append(m.predicates, ps...)
+// ResetThumbnailURLOverride resets all changes to the "thumbnail_url_override" field.
+func (m *BookMutation) ResetThumbnailURLOverride() {
+	m.thumbnail_url_override = nil
+	delete(m.clearedFields, book.FieldThumbnailURLOverride)
 }
 
-// WhereP appends storage-level predicates to the BookMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BookMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Book, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// SetLocationRoom sets the "location_room" field.
+func (m *BookMutation) SetLocationRoom(s string) {
+	m.location_room = &s
 }
 
-// Op returns the operation name.
-func (m *BookMutation) Op() Op {
-	return m.op
+// LocationRoom returns the value of the "location_room" field in the mutation.
+func (m *BookMutation) LocationRoom() (r string, exists bool) {
+	v := m.location_room
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *BookMutation) SetOp(op Op) {
-	m.op = op
+// OldLocationRoom returns the old "location_room" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldLocationRoom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationRoom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationRoom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationRoom: %w", err)
+	}
+	return oldValue.LocationRoom, nil
 }
 
-// Type returns the node type of this mutation (Book).
-func (m *BookMutation) Type() string {
-	return m.typ
+// ResetLocationRoom resets all changes to the "location_room" field.
+func (m *BookMutation) ResetLocationRoom() {
+	m.location_room = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BookMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.book_title != nil {
-		fields = append(fields, book.FieldBookTitle)
+// SetLocationBookcase sets the "location_bookcase" field.
+func (m *BookMutation) SetLocationBookcase(s string) {
+	m.location_bookcase = &s
+}
+
+// LocationBookcase returns the value of the "location_bookcase" field in the mutation.
+func (m *BookMutation) LocationBookcase() (r string, exists bool) {
+	v := m.location_bookcase
+	if v == nil {
+		return
 	}
-	if m.author != nil {
-		fields = append(fields, book.FieldAuthor)
+	return *v, true
+}
+
+// OldLocationBookcase returns the old "location_bookcase" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldLocationBookcase(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationBookcase is only allowed on UpdateOne operations")
 	}
-	if m.book_isbn != nil {
-		fields = append(fields, book.FieldBookIsbn)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationBookcase requires an ID field in the mutation")
 	}
-	if m.thumbnail_url != nil {
-		fields = append(fields, book.FieldThumbnailURL)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationBookcase: %w", err)
 	}
-	if m.status != nil {
-		fields = append(fields, book.FieldStatus)
+	return oldValue.LocationBookcase, nil
+}
+
+// ResetLocationBookcase resets all changes to the "location_bookcase" field.
+func (m *BookMutation) ResetLocationBookcase() {
+	m.location_bookcase = nil
+}
+
+// SetLocationShelf sets the "location_shelf" field.
+func (m *BookMutation) SetLocationShelf(s string) {
+	m.location_shelf = &s
+}
+
+// LocationShelf returns the value of the "location_shelf" field in the mutation.
+func (m *BookMutation) LocationShelf() (r string, exists bool) {
+	v := m.location_shelf
+	if v == nil {
+		return
 	}
-	if m.created_at != nil {
-		fields = append(fields, book.FieldCreatedAt)
+	return *v, true
+}
+
+// OldLocationShelf returns the old "location_shelf" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldLocationShelf(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationShelf is only allowed on UpdateOne operations")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, book.FieldUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationShelf requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationShelf: %w", err)
+	}
+	return oldValue.LocationShelf, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *BookMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case book.FieldBookTitle:
-		return m.BookTitle()
-	case book.FieldAuthor:
-		return m.Author()
-	case book.FieldBookIsbn:
-		return m.BookIsbn()
-	case book.FieldThumbnailURL:
-		return m.ThumbnailURL()
-	case book.FieldStatus:
-		return m.Status()
-	case book.FieldCreatedAt:
-		return m.CreatedAt()
-	case book.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// ResetLocationShelf resets all changes to the "location_shelf" field.
+func (m *BookMutation) ResetLocationShelf() {
+	m.location_shelf = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *BookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case book.FieldBookTitle:
-		return m.OldBookTitle(ctx)
-	case book.FieldAuthor:
-		return m.OldAuthor(ctx)
-	case book.FieldBookIsbn:
-		return m.OldBookIsbn(ctx)
-	case book.FieldThumbnailURL:
-		return m.OldThumbnailURL(ctx)
-	case book.FieldStatus:
-		return m.OldStatus(ctx)
-	case book.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case book.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Book field %s", name)
+// SetCondition sets the "condition" field.
+func (m *BookMutation) SetCondition(b book.Condition) {
+	m.condition = &b
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BookMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case book.FieldBookTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBookTitle(v)
-		return nil
-	case book.FieldAuthor:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAuthor(v)
-		return nil
-	case book.FieldBookIsbn:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBookIsbn(v)
-		return nil
-	case book.FieldThumbnailURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetThumbnailURL(v)
-		return nil
-	case book.FieldStatus:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case book.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case book.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// Condition returns the value of the "condition" field in the mutation.
+func (m *BookMutation) Condition() (r book.Condition, exists bool) {
+	v := m.condition
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Book field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *BookMutation) AddedFields() []string {
-	var fields []string
-	if m.addstatus != nil {
-		fields = append(fields, book.FieldStatus)
+// OldCondition returns the old "condition" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldCondition(ctx context.Context) (v *book.Condition, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCondition is only allowed on UpdateOne operations")
 	}
-	return fields
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCondition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCondition: %w", err)
+	}
+	return oldValue.Condition, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *BookMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case book.FieldStatus:
-		return m.AddedStatus()
-	}
-	return nil, false
+// ClearCondition clears the value of the "condition" field.
+func (m *BookMutation) ClearCondition() {
+	m.condition = nil
+	m.clearedFields[book.FieldCondition] = struct{}{}
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BookMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case book.FieldStatus:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStatus(v)
-		return nil
+// ConditionCleared returns if the "condition" field was cleared in this mutation.
+func (m *BookMutation) ConditionCleared() bool {
+	_, ok := m.clearedFields[book.FieldCondition]
+	return ok
+}
+
+// ResetCondition resets all changes to the "condition" field.
+func (m *BookMutation) ResetCondition() {
+	m.condition = nil
+	delete(m.clearedFields, book.FieldCondition)
+}
+
+// SetFormat sets the "format" field.
+func (m *BookMutation) SetFormat(b book.Format) {
+	m.format = &b
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *BookMutation) Format() (r book.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Book numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *BookMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(book.FieldBookIsbn) {
-		fields = append(fields, book.FieldBookIsbn)
+// OldFormat returns the old "format" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldFormat(ctx context.Context) (v *book.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(book.FieldThumbnailURL) {
-		fields = append(fields, book.FieldThumbnailURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *BookMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearFormat clears the value of the "format" field.
+func (m *BookMutation) ClearFormat() {
+	m.format = nil
+	m.clearedFields[book.FieldFormat] = struct{}{}
+}
+
+// FormatCleared returns if the "format" field was cleared in this mutation.
+func (m *BookMutation) FormatCleared() bool {
+	_, ok := m.clearedFields[book.FieldFormat]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *BookMutation) ClearField(name string) error {
-	switch name {
-	case book.FieldBookIsbn:
-		m.ClearBookIsbn()
-		return nil
-	case book.FieldThumbnailURL:
-		m.ClearThumbnailURL()
-		return nil
-	}
-	return fmt.Errorf("unknown Book nullable field %s", name)
+// ResetFormat resets all changes to the "format" field.
+func (m *BookMutation) ResetFormat() {
+	m.format = nil
+	delete(m.clearedFields, book.FieldFormat)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *BookMutation) ResetField(name string) error {
-	switch name {
-	case book.FieldBookTitle:
-		m.ResetBookTitle()
-		return nil
-	case book.FieldAuthor:
-		m.ResetAuthor()
-		return nil
-	case book.FieldBookIsbn:
-		m.ResetBookIsbn()
-		return nil
-	case book.FieldThumbnailURL:
-		m.ResetThumbnailURL()
-		return nil
-	case book.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case book.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case book.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// SetPurchasedAt sets the "purchased_at" field.
+func (m *BookMutation) SetPurchasedAt(t time.Time) {
+	m.purchased_at = &t
+}
+
+// PurchasedAt returns the value of the "purchased_at" field in the mutation.
+func (m *BookMutation) PurchasedAt() (r time.Time, exists bool) {
+	v := m.purchased_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Book field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BookMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.owner != nil {
-		edges = append(edges, book.EdgeOwner)
+// OldPurchasedAt returns the old "purchased_at" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldPurchasedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPurchasedAt is only allowed on UpdateOne operations")
 	}
-	if m.reviews != nil {
-		edges = append(edges, book.EdgeReviews)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPurchasedAt requires an ID field in the mutation")
 	}
-	if m.bookmarks != nil {
-		edges = append(edges, book.EdgeBookmarks)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPurchasedAt: %w", err)
 	}
-	return edges
+	return oldValue.PurchasedAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *BookMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case book.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	case book.EdgeReviews:
-		ids := make([]ent.Value, 0, len(m.reviews))
-		for id := range m.reviews {
-			ids = append(ids, id)
-		}
-		return ids
-	case book.EdgeBookmarks:
-		ids := make([]ent.Value, 0, len(m.bookmarks))
-		for id := range m.bookmarks {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearPurchasedAt clears the value of the "purchased_at" field.
+func (m *BookMutation) ClearPurchasedAt() {
+	m.purchased_at = nil
+	m.clearedFields[book.FieldPurchasedAt] = struct{}{}
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BookMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedreviews != nil {
-		edges = append(edges, book.EdgeReviews)
-	}
-	if m.removedbookmarks != nil {
-		edges = append(edges, book.EdgeBookmarks)
-	}
-	return edges
+// PurchasedAtCleared returns if the "purchased_at" field was cleared in this mutation.
+func (m *BookMutation) PurchasedAtCleared() bool {
+	_, ok := m.clearedFields[book.FieldPurchasedAt]
+	return ok
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *BookMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case book.EdgeReviews:
-		ids := make([]ent.Value, 0, len(m.removedreviews))
-		for id := range m.removedreviews {
-			ids = append(ids, id)
-		}
-		return ids
-	case book.EdgeBookmarks:
-		ids := make([]ent.Value, 0, len(m.removedbookmarks))
-		for id := range m.removedbookmarks {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetPurchasedAt resets all changes to the "purchased_at" field.
+func (m *BookMutation) ResetPurchasedAt() {
+	m.purchased_at = nil
+	delete(m.clearedFields, book.FieldPurchasedAt)
+}
+
+// SetPrice sets the "price" field.
+func (m *BookMutation) SetPrice(f float64) {
+	m.price = &f
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *BookMutation) Price() (r float64, exists bool) {
+	v := m.price
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BookMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedowner {
-		edges = append(edges, book.EdgeOwner)
+// OldPrice returns the old "price" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldPrice(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
 	}
-	if m.clearedreviews {
-		edges = append(edges, book.EdgeReviews)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
 	}
-	if m.clearedbookmarks {
-		edges = append(edges, book.EdgeBookmarks)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
 	}
-	return edges
+	return oldValue.Price, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *BookMutation) EdgeCleared(name string) bool {
-	switch name {
-	case book.EdgeOwner:
-		return m.clearedowner
-	case book.EdgeReviews:
-		return m.clearedreviews
-	case book.EdgeBookmarks:
-		return m.clearedbookmarks
+// AddPrice adds f to the "price" field.
+func (m *BookMutation) AddPrice(f float64) {
+	if m.addprice != nil {
+		*m.addprice += f
+	} else {
+		m.addprice = &f
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *BookMutation) ClearEdge(name string) error {
-	switch name {
-	case book.EdgeOwner:
-		m.ClearOwner()
-		return nil
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *BookMutation) AddedPrice() (r float64, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Book unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *BookMutation) ResetEdge(name string) error {
-	switch name {
-	case book.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case book.EdgeReviews:
-		m.ResetReviews()
-		return nil
-	case book.EdgeBookmarks:
-		m.ResetBookmarks()
-		return nil
-	}
-	return fmt.Errorf("unknown Book edge %s", name)
+// ClearPrice clears the value of the "price" field.
+func (m *BookMutation) ClearPrice() {
+	m.price = nil
+	m.addprice = nil
+	m.clearedFields[book.FieldPrice] = struct{}{}
 }
 
-// BookmarkMutation represents an operation that mutates the Bookmark nodes in the graph.
-type BookmarkMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	owner         *uuid.UUID
-	clearedowner  bool
-	book          *uuid.UUID
-	clearedbook   bool
-	done          bool
-	oldValue      func(context.Context) (*Bookmark, error)
-	predicates    []predicate.Bookmark
+// PriceCleared returns if the "price" field was cleared in this mutation.
+func (m *BookMutation) PriceCleared() bool {
+	_, ok := m.clearedFields[book.FieldPrice]
+	return ok
 }
 
-var _ ent.Mutation = (*BookmarkMutation)(nil)
-
-// bookmarkOption allows management of the mutation configuration using functional options.
-type bookmarkOption func(*BookmarkMutation)
-
-// newBookmarkMutation creates new mutation for the Bookmark entity.
-func newBookmarkMutation(c config, op Op, opts ...bookmarkOption) *BookmarkMutation {
-	m := &BookmarkMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeBookmark,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// ResetPrice resets all changes to the "price" field.
+func (m *BookMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+	delete(m.clearedFields, book.FieldPrice)
 }
 
-// withBookmarkID sets the ID field of the mutation.
-func withBookmarkID(id uuid.UUID) bookmarkOption {
-	return func(m *BookmarkMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Bookmark
-		)
-		m.oldValue = func(ctx context.Context) (*Bookmark, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Bookmark.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// SetCurrency sets the "currency" field.
+func (m *BookMutation) SetCurrency(s string) {
+	m.currency = &s
 }
 
-// withBookmark sets the old Bookmark of the mutation.
-func withBookmark(node *Bookmark) bookmarkOption {
-	return func(m *BookmarkMutation) {
-		m.oldValue = func(context.Context) (*Bookmark, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// Currency returns the value of the "currency" field in the mutation.
+func (m *BookMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m BookmarkMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// OldCurrency returns the old "currency" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m BookmarkMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetCurrency resets all changes to the "currency" field.
+func (m *BookMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Bookmark entities.
-func (m *BookmarkMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetAcquiredFrom sets the "acquired_from" field.
+func (m *BookMutation) SetAcquiredFrom(s string) {
+	m.acquired_from = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *BookmarkMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// AcquiredFrom returns the value of the "acquired_from" field in the mutation.
+func (m *BookMutation) AcquiredFrom() (r string, exists bool) {
+	v := m.acquired_from
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *BookmarkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Bookmark.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldAcquiredFrom returns the old "acquired_from" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldAcquiredFrom(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcquiredFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcquiredFrom requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcquiredFrom: %w", err)
+	}
+	return oldValue.AcquiredFrom, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *BookmarkMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetAcquiredFrom resets all changes to the "acquired_from" field.
+func (m *BookMutation) ResetAcquiredFrom() {
+	m.acquired_from = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *BookmarkMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetLive sets the "live" field.
+func (m *BookMutation) SetLive(b bool) {
+	m.live = &b
+}
+
+// Live returns the value of the "live" field in the mutation.
+func (m *BookMutation) Live() (r bool, exists bool) {
+	v := m.live
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Bookmark entity.
-// If the Bookmark object wasn't provided to the builder, the object is fetched from the database.
+// OldLive returns the old "live" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *BookmarkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BookMutation) OldLive(ctx context.Context) (v *bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLive: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Live, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *BookmarkMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearLive clears the value of the "live" field.
+func (m *BookMutation) ClearLive() {
+	m.live = nil
+	m.clearedFields[book.FieldLive] = struct{}{}
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *BookmarkMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
+// LiveCleared returns if the "live" field was cleared in this mutation.
+func (m *BookMutation) LiveCleared() bool {
+	_, ok := m.clearedFields[book.FieldLive]
+	return ok
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *BookmarkMutation) ClearOwner() {
-	m.clearedowner = true
+// ResetLive resets all changes to the "live" field.
+func (m *BookMutation) ResetLive() {
+	m.live = nil
+	delete(m.clearedFields, book.FieldLive)
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *BookmarkMutation) OwnerCleared() bool {
-	return m.clearedowner
+// SetVersion sets the "version" field.
+func (m *BookMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *BookmarkMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// Version returns the value of the "version" field in the mutation.
+func (m *BookMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *BookmarkMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// OldVersion returns the old "version" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *BookmarkMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// AddVersion adds i to the "version" field.
+func (m *BookMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
 }
 
-// SetBookID sets the "book" edge to the Book entity by id.
-func (m *BookmarkMutation) SetBookID(id uuid.UUID) {
-	m.book = &id
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *BookMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearBook clears the "book" edge to the Book entity.
-func (m *BookmarkMutation) ClearBook() {
-	m.clearedbook = true
+// ResetVersion resets all changes to the "version" field.
+func (m *BookMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
 }
 
-// BookCleared reports if the "book" edge to the Book entity was cleared.
-func (m *BookmarkMutation) BookCleared() bool {
-	return m.clearedbook
+// SetCreatedAt sets the "created_at" field.
+func (m *BookMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// BookID returns the "book" edge ID in the mutation.
-func (m *BookmarkMutation) BookID() (id uuid.UUID, exists bool) {
-	if m.book != nil {
-		return *m.book, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BookMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// BookIDs returns the "book" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BookID instead. It exists only for internal usage by the builders.
-func (m *BookmarkMutation) BookIDs() (ids []uuid.UUID) {
-	if id := m.book; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetBook resets all changes to the "book" edge.
-func (m *BookmarkMutation) ResetBook() {
-	m.book = nil
-	m.clearedbook = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BookMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the BookmarkMutation builder.
-func (m *BookmarkMutation) Where(ps ...predicate.Bookmark) {
-	m.predicates = append(m.predicates, ps...)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BookMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// WhereP appends storage-level predicates to the BookmarkMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BookmarkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Bookmark, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BookMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *BookmarkMutation) Op() Op {
-	return m.op
+// OldUpdatedAt returns the old "updated_at" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *BookmarkMutation) SetOp(op Op) {
-	m.op = op
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BookMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// Type returns the node type of this mutation (Bookmark).
-func (m *BookmarkMutation) Type() string {
-	return m.typ
+// SetDeletedAt sets the "deleted_at" field.
+func (m *BookMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BookmarkMutation) Fields() []string {
-	fields := make([]string, 0, 1)
-	if m.created_at != nil {
-		fields = append(fields, bookmark.FieldCreatedAt)
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *BookMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *BookmarkMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case bookmark.FieldCreatedAt:
-		return m.CreatedAt()
+// OldDeletedAt returns the old "deleted_at" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *BookmarkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case bookmark.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown Bookmark field %s", name)
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *BookMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[book.FieldDeletedAt] = struct{}{}
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BookmarkMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case bookmark.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Bookmark field %s", name)
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *BookMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[book.FieldDeletedAt]
+	return ok
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *BookmarkMutation) AddedFields() []string {
-	return nil
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *BookMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, book.FieldDeletedAt)
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *BookmarkMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetLibraryID sets the "library_id" field.
+func (m *BookMutation) SetLibraryID(u uuid.UUID) {
+	m.library = &u
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *BookmarkMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// LibraryID returns the value of the "library_id" field in the mutation.
+func (m *BookMutation) LibraryID() (r uuid.UUID, exists bool) {
+	v := m.library
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Bookmark numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *BookmarkMutation) ClearedFields() []string {
-	return nil
+// OldLibraryID returns the old "library_id" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldLibraryID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLibraryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLibraryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLibraryID: %w", err)
+	}
+	return oldValue.LibraryID, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *BookmarkMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearLibraryID clears the value of the "library_id" field.
+func (m *BookMutation) ClearLibraryID() {
+	m.library = nil
+	m.clearedFields[book.FieldLibraryID] = struct{}{}
+}
+
+// LibraryIDCleared returns if the "library_id" field was cleared in this mutation.
+func (m *BookMutation) LibraryIDCleared() bool {
+	_, ok := m.clearedFields[book.FieldLibraryID]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *BookmarkMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Bookmark nullable field %s", name)
+// ResetLibraryID resets all changes to the "library_id" field.
+func (m *BookMutation) ResetLibraryID() {
+	m.library = nil
+	delete(m.clearedFields, book.FieldLibraryID)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *BookmarkMutation) ResetField(name string) error {
-	switch name {
-	case bookmark.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// SetVisibility sets the "visibility" field.
+func (m *BookMutation) SetVisibility(b book.Visibility) {
+	m.visibility = &b
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *BookMutation) Visibility() (r book.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Bookmark field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BookmarkMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.owner != nil {
-		edges = append(edges, bookmark.EdgeOwner)
+// OldVisibility returns the old "visibility" field's value of the Book entity.
+// If the Book object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookMutation) OldVisibility(ctx context.Context) (v book.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
 	}
-	if m.book != nil {
-		edges = append(edges, bookmark.EdgeBook)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *BookmarkMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case bookmark.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
-	case bookmark.EdgeBook:
-		if id := m.book; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *BookMutation) ResetVisibility() {
+	m.visibility = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BookmarkMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *BookMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *BookmarkMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *BookMutation) ClearOwner() {
+	m.clearedowner = true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BookmarkMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedowner {
-		edges = append(edges, bookmark.EdgeOwner)
-	}
-	if m.clearedbook {
-		edges = append(edges, bookmark.EdgeBook)
-	}
-	return edges
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *BookMutation) OwnerCleared() bool {
+	return m.clearedowner
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *BookmarkMutation) EdgeCleared(name string) bool {
-	switch name {
-	case bookmark.EdgeOwner:
-		return m.clearedowner
-	case bookmark.EdgeBook:
-		return m.clearedbook
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *BookMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *BookmarkMutation) ClearEdge(name string) error {
-	switch name {
-	case bookmark.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	case bookmark.EdgeBook:
-		m.ClearBook()
-		return nil
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *BookMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
-	return fmt.Errorf("unknown Bookmark unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *BookmarkMutation) ResetEdge(name string) error {
-	switch name {
-	case bookmark.EdgeOwner:
-		m.ResetOwner()
-		return nil
-	case bookmark.EdgeBook:
-		m.ResetBook()
-		return nil
-	}
-	return fmt.Errorf("unknown Bookmark edge %s", name)
+// ResetOwner resets all changes to the "owner" edge.
+func (m *BookMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// EmailVerificationMutation represents an operation that mutates the EmailVerification nodes in the graph.
-type EmailVerificationMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	email         *string
-	code          *string
-	expires_at    *time.Time
-	is_verified   *bool
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*EmailVerification, error)
-	predicates    []predicate.EmailVerification
+// SetCatalogID sets the "catalog" edge to the BookCatalog entity by id.
+func (m *BookMutation) SetCatalogID(id uuid.UUID) {
+	m.catalog = &id
 }
 
-var _ ent.Mutation = (*EmailVerificationMutation)(nil)
-
-// emailverificationOption allows management of the mutation configuration using functional options.
-type emailverificationOption func(*EmailVerificationMutation)
+// ClearCatalog clears the "catalog" edge to the BookCatalog entity.
+func (m *BookMutation) ClearCatalog() {
+	m.clearedcatalog = true
+}
 
-// newEmailVerificationMutation creates new mutation for the EmailVerification entity.
-func newEmailVerificationMutation(c config, op Op, opts ...emailverificationOption) *EmailVerificationMutation {
-	m := &EmailVerificationMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeEmailVerification,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// CatalogCleared reports if the "catalog" edge to the BookCatalog entity was cleared.
+func (m *BookMutation) CatalogCleared() bool {
+	return m.clearedcatalog
 }
 
-// withEmailVerificationID sets the ID field of the mutation.
-func withEmailVerificationID(id uuid.UUID) emailverificationOption {
-	return func(m *EmailVerificationMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *EmailVerification
-		)
-		m.oldValue = func(ctx context.Context) (*EmailVerification, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().EmailVerification.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// CatalogID returns the "catalog" edge ID in the mutation.
+func (m *BookMutation) CatalogID() (id uuid.UUID, exists bool) {
+	if m.catalog != nil {
+		return *m.catalog, true
 	}
+	return
 }
 
-// withEmailVerification sets the old EmailVerification of the mutation.
-func withEmailVerification(node *EmailVerification) emailverificationOption {
-	return func(m *EmailVerificationMutation) {
-		m.oldValue = func(context.Context) (*EmailVerification, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// CatalogIDs returns the "catalog" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CatalogID instead. It exists only for internal usage by the builders.
+func (m *BookMutation) CatalogIDs() (ids []uuid.UUID) {
+	if id := m.catalog; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EmailVerificationMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetCatalog resets all changes to the "catalog" edge.
+func (m *BookMutation) ResetCatalog() {
+	m.catalog = nil
+	m.clearedcatalog = false
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m EmailVerificationMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearLibrary clears the "library" edge to the Library entity.
+func (m *BookMutation) ClearLibrary() {
+	m.clearedlibrary = true
+	m.clearedFields[book.FieldLibraryID] = struct{}{}
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of EmailVerification entities.
-func (m *EmailVerificationMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// LibraryCleared reports if the "library" edge to the Library entity was cleared.
+func (m *BookMutation) LibraryCleared() bool {
+	return m.LibraryIDCleared() || m.clearedlibrary
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *EmailVerificationMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// LibraryIDs returns the "library" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LibraryID instead. It exists only for internal usage by the builders.
+func (m *BookMutation) LibraryIDs() (ids []uuid.UUID) {
+	if id := m.library; id != nil {
+		ids = append(ids, *id)
 	}
-	return *m.id, true
+	return
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *EmailVerificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().EmailVerification.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// ResetLibrary resets all changes to the "library" edge.
+func (m *BookMutation) ResetLibrary() {
+	m.library = nil
+	m.clearedlibrary = false
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by ids.
+func (m *BookMutation) AddReviewIDs(ids ...uuid.UUID) {
+	if m.reviews == nil {
+		m.reviews = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reviews[ids[i]] = struct{}{}
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *EmailVerificationMutation) SetEmail(s string) {
-	m.email = &s
+// ClearReviews clears the "reviews" edge to the Review entity.
+func (m *BookMutation) ClearReviews() {
+	m.clearedreviews = true
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *EmailVerificationMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
+// ReviewsCleared reports if the "reviews" edge to the Review entity was cleared.
+func (m *BookMutation) ReviewsCleared() bool {
+	return m.clearedreviews
 }
 
-// OldEmail returns the old "email" field's value of the EmailVerification entity.
-// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+// RemoveReviewIDs removes the "reviews" edge to the Review entity by IDs.
+func (m *BookMutation) RemoveReviewIDs(ids ...uuid.UUID) {
+	if m.removedreviews == nil {
+		m.removedreviews = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	for i := range ids {
+		delete(m.reviews, ids[i])
+		m.removedreviews[ids[i]] = struct{}{}
 	}
-	return oldValue.Email, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *EmailVerificationMutation) ResetEmail() {
-	m.email = nil
+// RemovedReviews returns the removed IDs of the "reviews" edge to the Review entity.
+func (m *BookMutation) RemovedReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreviews {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetCode sets the "code" field.
-func (m *EmailVerificationMutation) SetCode(s string) {
-	m.code = &s
+// ReviewsIDs returns the "reviews" edge IDs in the mutation.
+func (m *BookMutation) ReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.reviews {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *EmailVerificationMutation) Code() (r string, exists bool) {
-	v := m.code
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetReviews resets all changes to the "reviews" edge.
+func (m *BookMutation) ResetReviews() {
+	m.reviews = nil
+	m.clearedreviews = false
+	m.removedreviews = nil
 }
 
-// OldCode returns the old "code" field's value of the EmailVerification entity.
-// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationMutation) OldCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by ids.
+func (m *BookMutation) AddBookmarkIDs(ids ...uuid.UUID) {
+	if m.bookmarks == nil {
+		m.bookmarks = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	for i := range ids {
+		m.bookmarks[ids[i]] = struct{}{}
 	}
-	return oldValue.Code, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *EmailVerificationMutation) ResetCode() {
-	m.code = nil
+// ClearBookmarks clears the "bookmarks" edge to the Bookmark entity.
+func (m *BookMutation) ClearBookmarks() {
+	m.clearedbookmarks = true
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *EmailVerificationMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// BookmarksCleared reports if the "bookmarks" edge to the Bookmark entity was cleared.
+func (m *BookMutation) BookmarksCleared() bool {
+	return m.clearedbookmarks
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *EmailVerificationMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
-	if v == nil {
-		return
+// RemoveBookmarkIDs removes the "bookmarks" edge to the Bookmark entity by IDs.
+func (m *BookMutation) RemoveBookmarkIDs(ids ...uuid.UUID) {
+	if m.removedbookmarks == nil {
+		m.removedbookmarks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.bookmarks, ids[i])
+		m.removedbookmarks[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the EmailVerification entity.
-// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+// RemovedBookmarks returns the removed IDs of the "bookmarks" edge to the Bookmark entity.
+func (m *BookMutation) RemovedBookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbookmarks {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	return
+}
+
+// BookmarksIDs returns the "bookmarks" edge IDs in the mutation.
+func (m *BookMutation) BookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.bookmarks {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	return
+}
+
+// ResetBookmarks resets all changes to the "bookmarks" edge.
+func (m *BookMutation) ResetBookmarks() {
+	m.bookmarks = nil
+	m.clearedbookmarks = false
+	m.removedbookmarks = nil
+}
+
+// AddReadingSessionIDs adds the "reading_sessions" edge to the ReadingSession entity by ids.
+func (m *BookMutation) AddReadingSessionIDs(ids ...uuid.UUID) {
+	if m.reading_sessions == nil {
+		m.reading_sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reading_sessions[ids[i]] = struct{}{}
 	}
-	return oldValue.ExpiresAt, nil
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *EmailVerificationMutation) ResetExpiresAt() {
-	m.expires_at = nil
+// ClearReadingSessions clears the "reading_sessions" edge to the ReadingSession entity.
+func (m *BookMutation) ClearReadingSessions() {
+	m.clearedreading_sessions = true
 }
 
-// SetIsVerified sets the "is_verified" field.
-func (m *EmailVerificationMutation) SetIsVerified(b bool) {
-	m.is_verified = &b
+// ReadingSessionsCleared reports if the "reading_sessions" edge to the ReadingSession entity was cleared.
+func (m *BookMutation) ReadingSessionsCleared() bool {
+	return m.clearedreading_sessions
 }
 
-// IsVerified returns the value of the "is_verified" field in the mutation.
-func (m *EmailVerificationMutation) IsVerified() (r bool, exists bool) {
-	v := m.is_verified
-	if v == nil {
-		return
+// RemoveReadingSessionIDs removes the "reading_sessions" edge to the ReadingSession entity by IDs.
+func (m *BookMutation) RemoveReadingSessionIDs(ids ...uuid.UUID) {
+	if m.removedreading_sessions == nil {
+		m.removedreading_sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reading_sessions, ids[i])
+		m.removedreading_sessions[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldIsVerified returns the old "is_verified" field's value of the EmailVerification entity.
-// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationMutation) OldIsVerified(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVerified is only allowed on UpdateOne operations")
+// RemovedReadingSessions returns the removed IDs of the "reading_sessions" edge to the ReadingSession entity.
+func (m *BookMutation) RemovedReadingSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreading_sessions {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVerified requires an ID field in the mutation")
+	return
+}
+
+// ReadingSessionsIDs returns the "reading_sessions" edge IDs in the mutation.
+func (m *BookMutation) ReadingSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.reading_sessions {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVerified: %w", err)
+	return
+}
+
+// ResetReadingSessions resets all changes to the "reading_sessions" edge.
+func (m *BookMutation) ResetReadingSessions() {
+	m.reading_sessions = nil
+	m.clearedreading_sessions = false
+	m.removedreading_sessions = nil
+}
+
+// AddStatusHistoryIDs adds the "status_histories" edge to the BookStatusHistory entity by ids.
+func (m *BookMutation) AddStatusHistoryIDs(ids ...uuid.UUID) {
+	if m.status_histories == nil {
+		m.status_histories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.status_histories[ids[i]] = struct{}{}
 	}
-	return oldValue.IsVerified, nil
 }
 
-// ResetIsVerified resets all changes to the "is_verified" field.
-func (m *EmailVerificationMutation) ResetIsVerified() {
-	m.is_verified = nil
+// ClearStatusHistories clears the "status_histories" edge to the BookStatusHistory entity.
+func (m *BookMutation) ClearStatusHistories() {
+	m.clearedstatus_histories = true
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EmailVerificationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// StatusHistoriesCleared reports if the "status_histories" edge to the BookStatusHistory entity was cleared.
+func (m *BookMutation) StatusHistoriesCleared() bool {
+	return m.clearedstatus_histories
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EmailVerificationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// RemoveStatusHistoryIDs removes the "status_histories" edge to the BookStatusHistory entity by IDs.
+func (m *BookMutation) RemoveStatusHistoryIDs(ids ...uuid.UUID) {
+	if m.removedstatus_histories == nil {
+		m.removedstatus_histories = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.status_histories, ids[i])
+		m.removedstatus_histories[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the EmailVerification entity.
-// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EmailVerificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// RemovedStatusHistories returns the removed IDs of the "status_histories" edge to the BookStatusHistory entity.
+func (m *BookMutation) RemovedStatusHistoriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedstatus_histories {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	return
+}
+
+// StatusHistoriesIDs returns the "status_histories" edge IDs in the mutation.
+func (m *BookMutation) StatusHistoriesIDs() (ids []uuid.UUID) {
+	for id := range m.status_histories {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	return
+}
+
+// ResetStatusHistories resets all changes to the "status_histories" edge.
+func (m *BookMutation) ResetStatusHistories() {
+	m.status_histories = nil
+	m.clearedstatus_histories = false
+	m.removedstatus_histories = nil
+}
+
+// AddShelfIDs adds the "shelves" edge to the Shelf entity by ids.
+func (m *BookMutation) AddShelfIDs(ids ...uuid.UUID) {
+	if m.shelves == nil {
+		m.shelves = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.shelves[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EmailVerificationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearShelves clears the "shelves" edge to the Shelf entity.
+func (m *BookMutation) ClearShelves() {
+	m.clearedshelves = true
 }
 
-// Where appends a list predicates to the EmailVerificationMutation builder.
-func (m *EmailVerificationMutation) Where(ps ...predicate.EmailVerification) {
-	m.predicates = append(m.predicates, ps...)
+// ShelvesCleared reports if the "shelves" edge to the Shelf entity was cleared.
+func (m *BookMutation) ShelvesCleared() bool {
+	return m.clearedshelves
 }
 
-// WhereP appends storage-level predicates to the EmailVerificationMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EmailVerificationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.EmailVerification, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// RemoveShelfIDs removes the "shelves" edge to the Shelf entity by IDs.
+func (m *BookMutation) RemoveShelfIDs(ids ...uuid.UUID) {
+	if m.removedshelves == nil {
+		m.removedshelves = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.shelves, ids[i])
+		m.removedshelves[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *EmailVerificationMutation) Op() Op {
-	return m.op
+// RemovedShelves returns the removed IDs of the "shelves" edge to the Shelf entity.
+func (m *BookMutation) RemovedShelvesIDs() (ids []uuid.UUID) {
+	for id := range m.removedshelves {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EmailVerificationMutation) SetOp(op Op) {
-	m.op = op
+// ShelvesIDs returns the "shelves" edge IDs in the mutation.
+func (m *BookMutation) ShelvesIDs() (ids []uuid.UUID) {
+	for id := range m.shelves {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Type returns the node type of this mutation (EmailVerification).
-func (m *EmailVerificationMutation) Type() string {
-	return m.typ
+// ResetShelves resets all changes to the "shelves" edge.
+func (m *BookMutation) ResetShelves() {
+	m.shelves = nil
+	m.clearedshelves = false
+	m.removedshelves = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EmailVerificationMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.email != nil {
-		fields = append(fields, emailverification.FieldEmail)
-	}
-	if m.code != nil {
-		fields = append(fields, emailverification.FieldCode)
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *BookMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
 	}
-	if m.expires_at != nil {
-		fields = append(fields, emailverification.FieldExpiresAt)
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
 	}
-	if m.is_verified != nil {
-		fields = append(fields, emailverification.FieldIsVerified)
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *BookMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *BookMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *BookMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
 	}
-	if m.created_at != nil {
-		fields = append(fields, emailverification.FieldCreatedAt)
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *EmailVerificationMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case emailverification.FieldEmail:
-		return m.Email()
-	case emailverification.FieldCode:
-		return m.Code()
-	case emailverification.FieldExpiresAt:
-		return m.ExpiresAt()
-	case emailverification.FieldIsVerified:
-		return m.IsVerified()
-	case emailverification.FieldCreatedAt:
-		return m.CreatedAt()
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *BookMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *EmailVerificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case emailverification.FieldEmail:
-		return m.OldEmail(ctx)
-	case emailverification.FieldCode:
-		return m.OldCode(ctx)
-	case emailverification.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
-	case emailverification.FieldIsVerified:
-		return m.OldIsVerified(ctx)
-	case emailverification.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *BookMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
 	}
-	return nil, fmt.Errorf("unknown EmailVerification field %s", name)
+	return
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
+// ResetTags resets all changes to the "tags" edge.
+func (m *BookMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddNoteIDs adds the "notes" edge to the BookNote entity by ids.
+func (m *BookMutation) AddNoteIDs(ids ...uuid.UUID) {
+	if m.notes == nil {
+		m.notes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.notes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearNotes clears the "notes" edge to the BookNote entity.
+func (m *BookMutation) ClearNotes() {
+	m.clearednotes = true
+}
+
+// NotesCleared reports if the "notes" edge to the BookNote entity was cleared.
+func (m *BookMutation) NotesCleared() bool {
+	return m.clearednotes
+}
+
+// RemoveNoteIDs removes the "notes" edge to the BookNote entity by IDs.
+func (m *BookMutation) RemoveNoteIDs(ids ...uuid.UUID) {
+	if m.removednotes == nil {
+		m.removednotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.notes, ids[i])
+		m.removednotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedNotes returns the removed IDs of the "notes" edge to the BookNote entity.
+func (m *BookMutation) RemovedNotesIDs() (ids []uuid.UUID) {
+	for id := range m.removednotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// NotesIDs returns the "notes" edge IDs in the mutation.
+func (m *BookMutation) NotesIDs() (ids []uuid.UUID) {
+	for id := range m.notes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetNotes resets all changes to the "notes" edge.
+func (m *BookMutation) ResetNotes() {
+	m.notes = nil
+	m.clearednotes = false
+	m.removednotes = nil
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by ids.
+func (m *BookMutation) AddLoanIDs(ids ...uuid.UUID) {
+	if m.loans == nil {
+		m.loans = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.loans[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLoans clears the "loans" edge to the Loan entity.
+func (m *BookMutation) ClearLoans() {
+	m.clearedloans = true
+}
+
+// LoansCleared reports if the "loans" edge to the Loan entity was cleared.
+func (m *BookMutation) LoansCleared() bool {
+	return m.clearedloans
+}
+
+// RemoveLoanIDs removes the "loans" edge to the Loan entity by IDs.
+func (m *BookMutation) RemoveLoanIDs(ids ...uuid.UUID) {
+	if m.removedloans == nil {
+		m.removedloans = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.loans, ids[i])
+		m.removedloans[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLoans returns the removed IDs of the "loans" edge to the Loan entity.
+func (m *BookMutation) RemovedLoansIDs() (ids []uuid.UUID) {
+	for id := range m.removedloans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LoansIDs returns the "loans" edge IDs in the mutation.
+func (m *BookMutation) LoansIDs() (ids []uuid.UUID) {
+	for id := range m.loans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLoans resets all changes to the "loans" edge.
+func (m *BookMutation) ResetLoans() {
+	m.loans = nil
+	m.clearedloans = false
+	m.removedloans = nil
+}
+
+// AddBorrowRequestIDs adds the "borrow_requests" edge to the BorrowRequest entity by ids.
+func (m *BookMutation) AddBorrowRequestIDs(ids ...uuid.UUID) {
+	if m.borrow_requests == nil {
+		m.borrow_requests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.borrow_requests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBorrowRequests clears the "borrow_requests" edge to the BorrowRequest entity.
+func (m *BookMutation) ClearBorrowRequests() {
+	m.clearedborrow_requests = true
+}
+
+// BorrowRequestsCleared reports if the "borrow_requests" edge to the BorrowRequest entity was cleared.
+func (m *BookMutation) BorrowRequestsCleared() bool {
+	return m.clearedborrow_requests
+}
+
+// RemoveBorrowRequestIDs removes the "borrow_requests" edge to the BorrowRequest entity by IDs.
+func (m *BookMutation) RemoveBorrowRequestIDs(ids ...uuid.UUID) {
+	if m.removedborrow_requests == nil {
+		m.removedborrow_requests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.borrow_requests, ids[i])
+		m.removedborrow_requests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBorrowRequests returns the removed IDs of the "borrow_requests" edge to the BorrowRequest entity.
+func (m *BookMutation) RemovedBorrowRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.removedborrow_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BorrowRequestsIDs returns the "borrow_requests" edge IDs in the mutation.
+func (m *BookMutation) BorrowRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.borrow_requests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBorrowRequests resets all changes to the "borrow_requests" edge.
+func (m *BookMutation) ResetBorrowRequests() {
+	m.borrow_requests = nil
+	m.clearedborrow_requests = false
+	m.removedborrow_requests = nil
+}
+
+// Where appends a list predicates to the BookMutation builder.
+func (m *BookMutation) Where(ps ...predicate.Book) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Book, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Book).
+func (m *BookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BookMutation) Fields() []string {
+	fields := make([]string, 0, 26)
+	if m.reading_status != nil {
+		fields = append(fields, book.FieldReadingStatus)
+	}
+	if m.started_at != nil {
+		fields = append(fields, book.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, book.FieldFinishedAt)
+	}
+	if m.current_page != nil {
+		fields = append(fields, book.FieldCurrentPage)
+	}
+	if m.total_pages != nil {
+		fields = append(fields, book.FieldTotalPages)
+	}
+	if m.title_override != nil {
+		fields = append(fields, book.FieldTitleOverride)
+	}
+	if m.author_override != nil {
+		fields = append(fields, book.FieldAuthorOverride)
+	}
+	if m.publisher_override != nil {
+		fields = append(fields, book.FieldPublisherOverride)
+	}
+	if m.published_date_override != nil {
+		fields = append(fields, book.FieldPublishedDateOverride)
+	}
+	if m.thumbnail_url_override != nil {
+		fields = append(fields, book.FieldThumbnailURLOverride)
+	}
+	if m.location_room != nil {
+		fields = append(fields, book.FieldLocationRoom)
+	}
+	if m.location_bookcase != nil {
+		fields = append(fields, book.FieldLocationBookcase)
+	}
+	if m.location_shelf != nil {
+		fields = append(fields, book.FieldLocationShelf)
+	}
+	if m.condition != nil {
+		fields = append(fields, book.FieldCondition)
+	}
+	if m.format != nil {
+		fields = append(fields, book.FieldFormat)
+	}
+	if m.purchased_at != nil {
+		fields = append(fields, book.FieldPurchasedAt)
+	}
+	if m.price != nil {
+		fields = append(fields, book.FieldPrice)
+	}
+	if m.currency != nil {
+		fields = append(fields, book.FieldCurrency)
+	}
+	if m.acquired_from != nil {
+		fields = append(fields, book.FieldAcquiredFrom)
+	}
+	if m.live != nil {
+		fields = append(fields, book.FieldLive)
+	}
+	if m.version != nil {
+		fields = append(fields, book.FieldVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, book.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, book.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, book.FieldDeletedAt)
+	}
+	if m.library != nil {
+		fields = append(fields, book.FieldLibraryID)
+	}
+	if m.visibility != nil {
+		fields = append(fields, book.FieldVisibility)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case book.FieldReadingStatus:
+		return m.ReadingStatus()
+	case book.FieldStartedAt:
+		return m.StartedAt()
+	case book.FieldFinishedAt:
+		return m.FinishedAt()
+	case book.FieldCurrentPage:
+		return m.CurrentPage()
+	case book.FieldTotalPages:
+		return m.TotalPages()
+	case book.FieldTitleOverride:
+		return m.TitleOverride()
+	case book.FieldAuthorOverride:
+		return m.AuthorOverride()
+	case book.FieldPublisherOverride:
+		return m.PublisherOverride()
+	case book.FieldPublishedDateOverride:
+		return m.PublishedDateOverride()
+	case book.FieldThumbnailURLOverride:
+		return m.ThumbnailURLOverride()
+	case book.FieldLocationRoom:
+		return m.LocationRoom()
+	case book.FieldLocationBookcase:
+		return m.LocationBookcase()
+	case book.FieldLocationShelf:
+		return m.LocationShelf()
+	case book.FieldCondition:
+		return m.Condition()
+	case book.FieldFormat:
+		return m.Format()
+	case book.FieldPurchasedAt:
+		return m.PurchasedAt()
+	case book.FieldPrice:
+		return m.Price()
+	case book.FieldCurrency:
+		return m.Currency()
+	case book.FieldAcquiredFrom:
+		return m.AcquiredFrom()
+	case book.FieldLive:
+		return m.Live()
+	case book.FieldVersion:
+		return m.Version()
+	case book.FieldCreatedAt:
+		return m.CreatedAt()
+	case book.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case book.FieldDeletedAt:
+		return m.DeletedAt()
+	case book.FieldLibraryID:
+		return m.LibraryID()
+	case book.FieldVisibility:
+		return m.Visibility()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case book.FieldReadingStatus:
+		return m.OldReadingStatus(ctx)
+	case book.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case book.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case book.FieldCurrentPage:
+		return m.OldCurrentPage(ctx)
+	case book.FieldTotalPages:
+		return m.OldTotalPages(ctx)
+	case book.FieldTitleOverride:
+		return m.OldTitleOverride(ctx)
+	case book.FieldAuthorOverride:
+		return m.OldAuthorOverride(ctx)
+	case book.FieldPublisherOverride:
+		return m.OldPublisherOverride(ctx)
+	case book.FieldPublishedDateOverride:
+		return m.OldPublishedDateOverride(ctx)
+	case book.FieldThumbnailURLOverride:
+		return m.OldThumbnailURLOverride(ctx)
+	case book.FieldLocationRoom:
+		return m.OldLocationRoom(ctx)
+	case book.FieldLocationBookcase:
+		return m.OldLocationBookcase(ctx)
+	case book.FieldLocationShelf:
+		return m.OldLocationShelf(ctx)
+	case book.FieldCondition:
+		return m.OldCondition(ctx)
+	case book.FieldFormat:
+		return m.OldFormat(ctx)
+	case book.FieldPurchasedAt:
+		return m.OldPurchasedAt(ctx)
+	case book.FieldPrice:
+		return m.OldPrice(ctx)
+	case book.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case book.FieldAcquiredFrom:
+		return m.OldAcquiredFrom(ctx)
+	case book.FieldLive:
+		return m.OldLive(ctx)
+	case book.FieldVersion:
+		return m.OldVersion(ctx)
+	case book.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case book.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case book.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case book.FieldLibraryID:
+		return m.OldLibraryID(ctx)
+	case book.FieldVisibility:
+		return m.OldVisibility(ctx)
+	}
+	return nil, fmt.Errorf("unknown Book field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EmailVerificationMutation) SetField(name string, value ent.Value) error {
+func (m *BookMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case emailverification.FieldEmail:
+	case book.FieldReadingStatus:
+		v, ok := value.(book.ReadingStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadingStatus(v)
+		return nil
+	case book.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case book.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case book.FieldCurrentPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentPage(v)
+		return nil
+	case book.FieldTotalPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalPages(v)
+		return nil
+	case book.FieldTitleOverride:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetTitleOverride(v)
 		return nil
-	case emailverification.FieldCode:
+	case book.FieldAuthorOverride:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetAuthorOverride(v)
 		return nil
-	case emailverification.FieldExpiresAt:
+	case book.FieldPublisherOverride:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublisherOverride(v)
+		return nil
+	case book.FieldPublishedDateOverride:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedDateOverride(v)
+		return nil
+	case book.FieldThumbnailURLOverride:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailURLOverride(v)
+		return nil
+	case book.FieldLocationRoom:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationRoom(v)
+		return nil
+	case book.FieldLocationBookcase:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationBookcase(v)
+		return nil
+	case book.FieldLocationShelf:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocationShelf(v)
+		return nil
+	case book.FieldCondition:
+		v, ok := value.(book.Condition)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCondition(v)
+		return nil
+	case book.FieldFormat:
+		v, ok := value.(book.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case book.FieldPurchasedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetPurchasedAt(v)
 		return nil
-	case emailverification.FieldIsVerified:
-		v, ok := value.(bool)
+	case book.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case book.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case book.FieldAcquiredFrom:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsVerified(v)
-		return nil
-	case emailverification.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		m.SetAcquiredFrom(v)
+		return nil
+	case book.FieldLive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLive(v)
+		return nil
+	case book.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case book.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case book.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case book.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case book.FieldLibraryID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLibraryID(v)
+		return nil
+	case book.FieldVisibility:
+		v, ok := value.(book.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Book field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BookMutation) AddedFields() []string {
+	var fields []string
+	if m.addcurrent_page != nil {
+		fields = append(fields, book.FieldCurrentPage)
+	}
+	if m.addtotal_pages != nil {
+		fields = append(fields, book.FieldTotalPages)
+	}
+	if m.addprice != nil {
+		fields = append(fields, book.FieldPrice)
+	}
+	if m.addversion != nil {
+		fields = append(fields, book.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case book.FieldCurrentPage:
+		return m.AddedCurrentPage()
+	case book.FieldTotalPages:
+		return m.AddedTotalPages()
+	case book.FieldPrice:
+		return m.AddedPrice()
+	case book.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case book.FieldCurrentPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentPage(v)
+		return nil
+	case book.FieldTotalPages:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalPages(v)
+		return nil
+	case book.FieldPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case book.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Book numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BookMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(book.FieldStartedAt) {
+		fields = append(fields, book.FieldStartedAt)
+	}
+	if m.FieldCleared(book.FieldFinishedAt) {
+		fields = append(fields, book.FieldFinishedAt)
+	}
+	if m.FieldCleared(book.FieldTitleOverride) {
+		fields = append(fields, book.FieldTitleOverride)
+	}
+	if m.FieldCleared(book.FieldAuthorOverride) {
+		fields = append(fields, book.FieldAuthorOverride)
+	}
+	if m.FieldCleared(book.FieldPublisherOverride) {
+		fields = append(fields, book.FieldPublisherOverride)
+	}
+	if m.FieldCleared(book.FieldPublishedDateOverride) {
+		fields = append(fields, book.FieldPublishedDateOverride)
+	}
+	if m.FieldCleared(book.FieldThumbnailURLOverride) {
+		fields = append(fields, book.FieldThumbnailURLOverride)
+	}
+	if m.FieldCleared(book.FieldCondition) {
+		fields = append(fields, book.FieldCondition)
+	}
+	if m.FieldCleared(book.FieldFormat) {
+		fields = append(fields, book.FieldFormat)
+	}
+	if m.FieldCleared(book.FieldPurchasedAt) {
+		fields = append(fields, book.FieldPurchasedAt)
+	}
+	if m.FieldCleared(book.FieldPrice) {
+		fields = append(fields, book.FieldPrice)
+	}
+	if m.FieldCleared(book.FieldLive) {
+		fields = append(fields, book.FieldLive)
+	}
+	if m.FieldCleared(book.FieldDeletedAt) {
+		fields = append(fields, book.FieldDeletedAt)
+	}
+	if m.FieldCleared(book.FieldLibraryID) {
+		fields = append(fields, book.FieldLibraryID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BookMutation) ClearField(name string) error {
+	switch name {
+	case book.FieldStartedAt:
+		m.ClearStartedAt()
+		return nil
+	case book.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case book.FieldTitleOverride:
+		m.ClearTitleOverride()
+		return nil
+	case book.FieldAuthorOverride:
+		m.ClearAuthorOverride()
+		return nil
+	case book.FieldPublisherOverride:
+		m.ClearPublisherOverride()
+		return nil
+	case book.FieldPublishedDateOverride:
+		m.ClearPublishedDateOverride()
+		return nil
+	case book.FieldThumbnailURLOverride:
+		m.ClearThumbnailURLOverride()
+		return nil
+	case book.FieldCondition:
+		m.ClearCondition()
+		return nil
+	case book.FieldFormat:
+		m.ClearFormat()
+		return nil
+	case book.FieldPurchasedAt:
+		m.ClearPurchasedAt()
+		return nil
+	case book.FieldPrice:
+		m.ClearPrice()
+		return nil
+	case book.FieldLive:
+		m.ClearLive()
+		return nil
+	case book.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case book.FieldLibraryID:
+		m.ClearLibraryID()
+		return nil
+	}
+	return fmt.Errorf("unknown Book nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BookMutation) ResetField(name string) error {
+	switch name {
+	case book.FieldReadingStatus:
+		m.ResetReadingStatus()
+		return nil
+	case book.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case book.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case book.FieldCurrentPage:
+		m.ResetCurrentPage()
+		return nil
+	case book.FieldTotalPages:
+		m.ResetTotalPages()
+		return nil
+	case book.FieldTitleOverride:
+		m.ResetTitleOverride()
+		return nil
+	case book.FieldAuthorOverride:
+		m.ResetAuthorOverride()
+		return nil
+	case book.FieldPublisherOverride:
+		m.ResetPublisherOverride()
+		return nil
+	case book.FieldPublishedDateOverride:
+		m.ResetPublishedDateOverride()
+		return nil
+	case book.FieldThumbnailURLOverride:
+		m.ResetThumbnailURLOverride()
+		return nil
+	case book.FieldLocationRoom:
+		m.ResetLocationRoom()
+		return nil
+	case book.FieldLocationBookcase:
+		m.ResetLocationBookcase()
+		return nil
+	case book.FieldLocationShelf:
+		m.ResetLocationShelf()
+		return nil
+	case book.FieldCondition:
+		m.ResetCondition()
+		return nil
+	case book.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case book.FieldPurchasedAt:
+		m.ResetPurchasedAt()
+		return nil
+	case book.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case book.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case book.FieldAcquiredFrom:
+		m.ResetAcquiredFrom()
+		return nil
+	case book.FieldLive:
+		m.ResetLive()
+		return nil
+	case book.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case book.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case book.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case book.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case book.FieldLibraryID:
+		m.ResetLibraryID()
+		return nil
+	case book.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	}
+	return fmt.Errorf("unknown Book field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.owner != nil {
+		edges = append(edges, book.EdgeOwner)
+	}
+	if m.catalog != nil {
+		edges = append(edges, book.EdgeCatalog)
+	}
+	if m.library != nil {
+		edges = append(edges, book.EdgeLibrary)
+	}
+	if m.reviews != nil {
+		edges = append(edges, book.EdgeReviews)
+	}
+	if m.bookmarks != nil {
+		edges = append(edges, book.EdgeBookmarks)
+	}
+	if m.reading_sessions != nil {
+		edges = append(edges, book.EdgeReadingSessions)
+	}
+	if m.status_histories != nil {
+		edges = append(edges, book.EdgeStatusHistories)
+	}
+	if m.shelves != nil {
+		edges = append(edges, book.EdgeShelves)
+	}
+	if m.tags != nil {
+		edges = append(edges, book.EdgeTags)
+	}
+	if m.notes != nil {
+		edges = append(edges, book.EdgeNotes)
+	}
+	if m.loans != nil {
+		edges = append(edges, book.EdgeLoans)
+	}
+	if m.borrow_requests != nil {
+		edges = append(edges, book.EdgeBorrowRequests)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case book.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case book.EdgeCatalog:
+		if id := m.catalog; id != nil {
+			return []ent.Value{*id}
+		}
+	case book.EdgeLibrary:
+		if id := m.library; id != nil {
+			return []ent.Value{*id}
+		}
+	case book.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.reviews))
+		for id := range m.reviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.bookmarks))
+		for id := range m.bookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeReadingSessions:
+		ids := make([]ent.Value, 0, len(m.reading_sessions))
+		for id := range m.reading_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeStatusHistories:
+		ids := make([]ent.Value, 0, len(m.status_histories))
+		for id := range m.status_histories {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeShelves:
+		ids := make([]ent.Value, 0, len(m.shelves))
+		for id := range m.shelves {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.notes))
+		for id := range m.notes {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeLoans:
+		ids := make([]ent.Value, 0, len(m.loans))
+		for id := range m.loans {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeBorrowRequests:
+		ids := make([]ent.Value, 0, len(m.borrow_requests))
+		for id := range m.borrow_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.removedreviews != nil {
+		edges = append(edges, book.EdgeReviews)
+	}
+	if m.removedbookmarks != nil {
+		edges = append(edges, book.EdgeBookmarks)
+	}
+	if m.removedreading_sessions != nil {
+		edges = append(edges, book.EdgeReadingSessions)
+	}
+	if m.removedstatus_histories != nil {
+		edges = append(edges, book.EdgeStatusHistories)
+	}
+	if m.removedshelves != nil {
+		edges = append(edges, book.EdgeShelves)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, book.EdgeTags)
+	}
+	if m.removednotes != nil {
+		edges = append(edges, book.EdgeNotes)
+	}
+	if m.removedloans != nil {
+		edges = append(edges, book.EdgeLoans)
+	}
+	if m.removedborrow_requests != nil {
+		edges = append(edges, book.EdgeBorrowRequests)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BookMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case book.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.removedreviews))
+		for id := range m.removedreviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.removedbookmarks))
+		for id := range m.removedbookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeReadingSessions:
+		ids := make([]ent.Value, 0, len(m.removedreading_sessions))
+		for id := range m.removedreading_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeStatusHistories:
+		ids := make([]ent.Value, 0, len(m.removedstatus_histories))
+		for id := range m.removedstatus_histories {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeShelves:
+		ids := make([]ent.Value, 0, len(m.removedshelves))
+		for id := range m.removedshelves {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeNotes:
+		ids := make([]ent.Value, 0, len(m.removednotes))
+		for id := range m.removednotes {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeLoans:
+		ids := make([]ent.Value, 0, len(m.removedloans))
+		for id := range m.removedloans {
+			ids = append(ids, id)
+		}
+		return ids
+	case book.EdgeBorrowRequests:
+		ids := make([]ent.Value, 0, len(m.removedborrow_requests))
+		for id := range m.removedborrow_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 12)
+	if m.clearedowner {
+		edges = append(edges, book.EdgeOwner)
+	}
+	if m.clearedcatalog {
+		edges = append(edges, book.EdgeCatalog)
+	}
+	if m.clearedlibrary {
+		edges = append(edges, book.EdgeLibrary)
+	}
+	if m.clearedreviews {
+		edges = append(edges, book.EdgeReviews)
+	}
+	if m.clearedbookmarks {
+		edges = append(edges, book.EdgeBookmarks)
+	}
+	if m.clearedreading_sessions {
+		edges = append(edges, book.EdgeReadingSessions)
+	}
+	if m.clearedstatus_histories {
+		edges = append(edges, book.EdgeStatusHistories)
+	}
+	if m.clearedshelves {
+		edges = append(edges, book.EdgeShelves)
+	}
+	if m.clearedtags {
+		edges = append(edges, book.EdgeTags)
+	}
+	if m.clearednotes {
+		edges = append(edges, book.EdgeNotes)
+	}
+	if m.clearedloans {
+		edges = append(edges, book.EdgeLoans)
+	}
+	if m.clearedborrow_requests {
+		edges = append(edges, book.EdgeBorrowRequests)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case book.EdgeOwner:
+		return m.clearedowner
+	case book.EdgeCatalog:
+		return m.clearedcatalog
+	case book.EdgeLibrary:
+		return m.clearedlibrary
+	case book.EdgeReviews:
+		return m.clearedreviews
+	case book.EdgeBookmarks:
+		return m.clearedbookmarks
+	case book.EdgeReadingSessions:
+		return m.clearedreading_sessions
+	case book.EdgeStatusHistories:
+		return m.clearedstatus_histories
+	case book.EdgeShelves:
+		return m.clearedshelves
+	case book.EdgeTags:
+		return m.clearedtags
+	case book.EdgeNotes:
+		return m.clearednotes
+	case book.EdgeLoans:
+		return m.clearedloans
+	case book.EdgeBorrowRequests:
+		return m.clearedborrow_requests
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BookMutation) ClearEdge(name string) error {
+	switch name {
+	case book.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case book.EdgeCatalog:
+		m.ClearCatalog()
+		return nil
+	case book.EdgeLibrary:
+		m.ClearLibrary()
+		return nil
+	}
+	return fmt.Errorf("unknown Book unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BookMutation) ResetEdge(name string) error {
+	switch name {
+	case book.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case book.EdgeCatalog:
+		m.ResetCatalog()
+		return nil
+	case book.EdgeLibrary:
+		m.ResetLibrary()
+		return nil
+	case book.EdgeReviews:
+		m.ResetReviews()
+		return nil
+	case book.EdgeBookmarks:
+		m.ResetBookmarks()
+		return nil
+	case book.EdgeReadingSessions:
+		m.ResetReadingSessions()
+		return nil
+	case book.EdgeStatusHistories:
+		m.ResetStatusHistories()
+		return nil
+	case book.EdgeShelves:
+		m.ResetShelves()
+		return nil
+	case book.EdgeTags:
+		m.ResetTags()
+		return nil
+	case book.EdgeNotes:
+		m.ResetNotes()
+		return nil
+	case book.EdgeLoans:
+		m.ResetLoans()
+		return nil
+	case book.EdgeBorrowRequests:
+		m.ResetBorrowRequests()
+		return nil
+	}
+	return fmt.Errorf("unknown Book edge %s", name)
+}
+
+// BookCatalogMutation represents an operation that mutates the BookCatalog nodes in the graph.
+type BookCatalogMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	isbn                    *string
+	title                   *string
+	author                  *string
+	publisher               *string
+	published_date          *string
+	thumbnail_url           *string
+	series_name             *string
+	series_volume           *int
+	addseries_volume        *int
+	series_total_volumes    *int
+	addseries_total_volumes *int
+	source                  *string
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	copies                  map[uuid.UUID]struct{}
+	removedcopies           map[uuid.UUID]struct{}
+	clearedcopies           bool
+	reviews                 map[uuid.UUID]struct{}
+	removedreviews          map[uuid.UUID]struct{}
+	clearedreviews          bool
+	done                    bool
+	oldValue                func(context.Context) (*BookCatalog, error)
+	predicates              []predicate.BookCatalog
+}
+
+var _ ent.Mutation = (*BookCatalogMutation)(nil)
+
+// bookcatalogOption allows management of the mutation configuration using functional options.
+type bookcatalogOption func(*BookCatalogMutation)
+
+// newBookCatalogMutation creates new mutation for the BookCatalog entity.
+func newBookCatalogMutation(c config, op Op, opts ...bookcatalogOption) *BookCatalogMutation {
+	m := &BookCatalogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBookCatalog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBookCatalogID sets the ID field of the mutation.
+func withBookCatalogID(id uuid.UUID) bookcatalogOption {
+	return func(m *BookCatalogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BookCatalog
+		)
+		m.oldValue = func(ctx context.Context) (*BookCatalog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BookCatalog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBookCatalog sets the old BookCatalog of the mutation.
+func withBookCatalog(node *BookCatalog) bookcatalogOption {
+	return func(m *BookCatalogMutation) {
+		m.oldValue = func(context.Context) (*BookCatalog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BookCatalogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BookCatalogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BookCatalog entities.
+func (m *BookCatalogMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BookCatalogMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BookCatalogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BookCatalog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetIsbn sets the "isbn" field.
+func (m *BookCatalogMutation) SetIsbn(s string) {
+	m.isbn = &s
+}
+
+// Isbn returns the value of the "isbn" field in the mutation.
+func (m *BookCatalogMutation) Isbn() (r string, exists bool) {
+	v := m.isbn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsbn returns the old "isbn" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldIsbn(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsbn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsbn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsbn: %w", err)
+	}
+	return oldValue.Isbn, nil
+}
+
+// ClearIsbn clears the value of the "isbn" field.
+func (m *BookCatalogMutation) ClearIsbn() {
+	m.isbn = nil
+	m.clearedFields[bookcatalog.FieldIsbn] = struct{}{}
+}
+
+// IsbnCleared returns if the "isbn" field was cleared in this mutation.
+func (m *BookCatalogMutation) IsbnCleared() bool {
+	_, ok := m.clearedFields[bookcatalog.FieldIsbn]
+	return ok
+}
+
+// ResetIsbn resets all changes to the "isbn" field.
+func (m *BookCatalogMutation) ResetIsbn() {
+	m.isbn = nil
+	delete(m.clearedFields, bookcatalog.FieldIsbn)
+}
+
+// SetTitle sets the "title" field.
+func (m *BookCatalogMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *BookCatalogMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *BookCatalogMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetAuthor sets the "author" field.
+func (m *BookCatalogMutation) SetAuthor(s string) {
+	m.author = &s
+}
+
+// Author returns the value of the "author" field in the mutation.
+func (m *BookCatalogMutation) Author() (r string, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthor returns the old "author" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldAuthor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
+	}
+	return oldValue.Author, nil
+}
+
+// ResetAuthor resets all changes to the "author" field.
+func (m *BookCatalogMutation) ResetAuthor() {
+	m.author = nil
+}
+
+// SetPublisher sets the "publisher" field.
+func (m *BookCatalogMutation) SetPublisher(s string) {
+	m.publisher = &s
+}
+
+// Publisher returns the value of the "publisher" field in the mutation.
+func (m *BookCatalogMutation) Publisher() (r string, exists bool) {
+	v := m.publisher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublisher returns the old "publisher" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldPublisher(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublisher is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublisher requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublisher: %w", err)
+	}
+	return oldValue.Publisher, nil
+}
+
+// ClearPublisher clears the value of the "publisher" field.
+func (m *BookCatalogMutation) ClearPublisher() {
+	m.publisher = nil
+	m.clearedFields[bookcatalog.FieldPublisher] = struct{}{}
+}
+
+// PublisherCleared returns if the "publisher" field was cleared in this mutation.
+func (m *BookCatalogMutation) PublisherCleared() bool {
+	_, ok := m.clearedFields[bookcatalog.FieldPublisher]
+	return ok
+}
+
+// ResetPublisher resets all changes to the "publisher" field.
+func (m *BookCatalogMutation) ResetPublisher() {
+	m.publisher = nil
+	delete(m.clearedFields, bookcatalog.FieldPublisher)
+}
+
+// SetPublishedDate sets the "published_date" field.
+func (m *BookCatalogMutation) SetPublishedDate(s string) {
+	m.published_date = &s
+}
+
+// PublishedDate returns the value of the "published_date" field in the mutation.
+func (m *BookCatalogMutation) PublishedDate() (r string, exists bool) {
+	v := m.published_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedDate returns the old "published_date" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldPublishedDate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedDate: %w", err)
+	}
+	return oldValue.PublishedDate, nil
+}
+
+// ClearPublishedDate clears the value of the "published_date" field.
+func (m *BookCatalogMutation) ClearPublishedDate() {
+	m.published_date = nil
+	m.clearedFields[bookcatalog.FieldPublishedDate] = struct{}{}
+}
+
+// PublishedDateCleared returns if the "published_date" field was cleared in this mutation.
+func (m *BookCatalogMutation) PublishedDateCleared() bool {
+	_, ok := m.clearedFields[bookcatalog.FieldPublishedDate]
+	return ok
+}
+
+// ResetPublishedDate resets all changes to the "published_date" field.
+func (m *BookCatalogMutation) ResetPublishedDate() {
+	m.published_date = nil
+	delete(m.clearedFields, bookcatalog.FieldPublishedDate)
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *BookCatalogMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
+}
+
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *BookCatalogMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+	}
+	return oldValue.ThumbnailURL, nil
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (m *BookCatalogMutation) ClearThumbnailURL() {
+	m.thumbnail_url = nil
+	m.clearedFields[bookcatalog.FieldThumbnailURL] = struct{}{}
+}
+
+// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
+func (m *BookCatalogMutation) ThumbnailURLCleared() bool {
+	_, ok := m.clearedFields[bookcatalog.FieldThumbnailURL]
+	return ok
+}
+
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *BookCatalogMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
+	delete(m.clearedFields, bookcatalog.FieldThumbnailURL)
+}
+
+// SetSeriesName sets the "series_name" field.
+func (m *BookCatalogMutation) SetSeriesName(s string) {
+	m.series_name = &s
+}
+
+// SeriesName returns the value of the "series_name" field in the mutation.
+func (m *BookCatalogMutation) SeriesName() (r string, exists bool) {
+	v := m.series_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesName returns the old "series_name" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldSeriesName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesName: %w", err)
+	}
+	return oldValue.SeriesName, nil
+}
+
+// ClearSeriesName clears the value of the "series_name" field.
+func (m *BookCatalogMutation) ClearSeriesName() {
+	m.series_name = nil
+	m.clearedFields[bookcatalog.FieldSeriesName] = struct{}{}
+}
+
+// SeriesNameCleared returns if the "series_name" field was cleared in this mutation.
+func (m *BookCatalogMutation) SeriesNameCleared() bool {
+	_, ok := m.clearedFields[bookcatalog.FieldSeriesName]
+	return ok
+}
+
+// ResetSeriesName resets all changes to the "series_name" field.
+func (m *BookCatalogMutation) ResetSeriesName() {
+	m.series_name = nil
+	delete(m.clearedFields, bookcatalog.FieldSeriesName)
+}
+
+// SetSeriesVolume sets the "series_volume" field.
+func (m *BookCatalogMutation) SetSeriesVolume(i int) {
+	m.series_volume = &i
+	m.addseries_volume = nil
+}
+
+// SeriesVolume returns the value of the "series_volume" field in the mutation.
+func (m *BookCatalogMutation) SeriesVolume() (r int, exists bool) {
+	v := m.series_volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesVolume returns the old "series_volume" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldSeriesVolume(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesVolume: %w", err)
+	}
+	return oldValue.SeriesVolume, nil
+}
+
+// AddSeriesVolume adds i to the "series_volume" field.
+func (m *BookCatalogMutation) AddSeriesVolume(i int) {
+	if m.addseries_volume != nil {
+		*m.addseries_volume += i
+	} else {
+		m.addseries_volume = &i
+	}
+}
+
+// AddedSeriesVolume returns the value that was added to the "series_volume" field in this mutation.
+func (m *BookCatalogMutation) AddedSeriesVolume() (r int, exists bool) {
+	v := m.addseries_volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSeriesVolume clears the value of the "series_volume" field.
+func (m *BookCatalogMutation) ClearSeriesVolume() {
+	m.series_volume = nil
+	m.addseries_volume = nil
+	m.clearedFields[bookcatalog.FieldSeriesVolume] = struct{}{}
+}
+
+// SeriesVolumeCleared returns if the "series_volume" field was cleared in this mutation.
+func (m *BookCatalogMutation) SeriesVolumeCleared() bool {
+	_, ok := m.clearedFields[bookcatalog.FieldSeriesVolume]
+	return ok
+}
+
+// ResetSeriesVolume resets all changes to the "series_volume" field.
+func (m *BookCatalogMutation) ResetSeriesVolume() {
+	m.series_volume = nil
+	m.addseries_volume = nil
+	delete(m.clearedFields, bookcatalog.FieldSeriesVolume)
+}
+
+// SetSeriesTotalVolumes sets the "series_total_volumes" field.
+func (m *BookCatalogMutation) SetSeriesTotalVolumes(i int) {
+	m.series_total_volumes = &i
+	m.addseries_total_volumes = nil
+}
+
+// SeriesTotalVolumes returns the value of the "series_total_volumes" field in the mutation.
+func (m *BookCatalogMutation) SeriesTotalVolumes() (r int, exists bool) {
+	v := m.series_total_volumes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesTotalVolumes returns the old "series_total_volumes" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldSeriesTotalVolumes(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesTotalVolumes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesTotalVolumes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesTotalVolumes: %w", err)
+	}
+	return oldValue.SeriesTotalVolumes, nil
+}
+
+// AddSeriesTotalVolumes adds i to the "series_total_volumes" field.
+func (m *BookCatalogMutation) AddSeriesTotalVolumes(i int) {
+	if m.addseries_total_volumes != nil {
+		*m.addseries_total_volumes += i
+	} else {
+		m.addseries_total_volumes = &i
+	}
+}
+
+// AddedSeriesTotalVolumes returns the value that was added to the "series_total_volumes" field in this mutation.
+func (m *BookCatalogMutation) AddedSeriesTotalVolumes() (r int, exists bool) {
+	v := m.addseries_total_volumes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSeriesTotalVolumes clears the value of the "series_total_volumes" field.
+func (m *BookCatalogMutation) ClearSeriesTotalVolumes() {
+	m.series_total_volumes = nil
+	m.addseries_total_volumes = nil
+	m.clearedFields[bookcatalog.FieldSeriesTotalVolumes] = struct{}{}
+}
+
+// SeriesTotalVolumesCleared returns if the "series_total_volumes" field was cleared in this mutation.
+func (m *BookCatalogMutation) SeriesTotalVolumesCleared() bool {
+	_, ok := m.clearedFields[bookcatalog.FieldSeriesTotalVolumes]
+	return ok
+}
+
+// ResetSeriesTotalVolumes resets all changes to the "series_total_volumes" field.
+func (m *BookCatalogMutation) ResetSeriesTotalVolumes() {
+	m.series_total_volumes = nil
+	m.addseries_total_volumes = nil
+	delete(m.clearedFields, bookcatalog.FieldSeriesTotalVolumes)
+}
+
+// SetSource sets the "source" field.
+func (m *BookCatalogMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *BookCatalogMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *BookCatalogMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BookCatalogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BookCatalogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BookCatalogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BookCatalogMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BookCatalogMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the BookCatalog entity.
+// If the BookCatalog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookCatalogMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BookCatalogMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddCopyIDs adds the "copies" edge to the Book entity by ids.
+func (m *BookCatalogMutation) AddCopyIDs(ids ...uuid.UUID) {
+	if m.copies == nil {
+		m.copies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.copies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCopies clears the "copies" edge to the Book entity.
+func (m *BookCatalogMutation) ClearCopies() {
+	m.clearedcopies = true
+}
+
+// CopiesCleared reports if the "copies" edge to the Book entity was cleared.
+func (m *BookCatalogMutation) CopiesCleared() bool {
+	return m.clearedcopies
+}
+
+// RemoveCopyIDs removes the "copies" edge to the Book entity by IDs.
+func (m *BookCatalogMutation) RemoveCopyIDs(ids ...uuid.UUID) {
+	if m.removedcopies == nil {
+		m.removedcopies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.copies, ids[i])
+		m.removedcopies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCopies returns the removed IDs of the "copies" edge to the Book entity.
+func (m *BookCatalogMutation) RemovedCopiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedcopies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CopiesIDs returns the "copies" edge IDs in the mutation.
+func (m *BookCatalogMutation) CopiesIDs() (ids []uuid.UUID) {
+	for id := range m.copies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCopies resets all changes to the "copies" edge.
+func (m *BookCatalogMutation) ResetCopies() {
+	m.copies = nil
+	m.clearedcopies = false
+	m.removedcopies = nil
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by ids.
+func (m *BookCatalogMutation) AddReviewIDs(ids ...uuid.UUID) {
+	if m.reviews == nil {
+		m.reviews = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reviews[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReviews clears the "reviews" edge to the Review entity.
+func (m *BookCatalogMutation) ClearReviews() {
+	m.clearedreviews = true
+}
+
+// ReviewsCleared reports if the "reviews" edge to the Review entity was cleared.
+func (m *BookCatalogMutation) ReviewsCleared() bool {
+	return m.clearedreviews
+}
+
+// RemoveReviewIDs removes the "reviews" edge to the Review entity by IDs.
+func (m *BookCatalogMutation) RemoveReviewIDs(ids ...uuid.UUID) {
+	if m.removedreviews == nil {
+		m.removedreviews = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reviews, ids[i])
+		m.removedreviews[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReviews returns the removed IDs of the "reviews" edge to the Review entity.
+func (m *BookCatalogMutation) RemovedReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReviewsIDs returns the "reviews" edge IDs in the mutation.
+func (m *BookCatalogMutation) ReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.reviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReviews resets all changes to the "reviews" edge.
+func (m *BookCatalogMutation) ResetReviews() {
+	m.reviews = nil
+	m.clearedreviews = false
+	m.removedreviews = nil
+}
+
+// Where appends a list predicates to the BookCatalogMutation builder.
+func (m *BookCatalogMutation) Where(ps ...predicate.BookCatalog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BookCatalogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BookCatalogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BookCatalog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BookCatalogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BookCatalogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BookCatalog).
+func (m *BookCatalogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BookCatalogMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.isbn != nil {
+		fields = append(fields, bookcatalog.FieldIsbn)
+	}
+	if m.title != nil {
+		fields = append(fields, bookcatalog.FieldTitle)
+	}
+	if m.author != nil {
+		fields = append(fields, bookcatalog.FieldAuthor)
+	}
+	if m.publisher != nil {
+		fields = append(fields, bookcatalog.FieldPublisher)
+	}
+	if m.published_date != nil {
+		fields = append(fields, bookcatalog.FieldPublishedDate)
+	}
+	if m.thumbnail_url != nil {
+		fields = append(fields, bookcatalog.FieldThumbnailURL)
+	}
+	if m.series_name != nil {
+		fields = append(fields, bookcatalog.FieldSeriesName)
+	}
+	if m.series_volume != nil {
+		fields = append(fields, bookcatalog.FieldSeriesVolume)
+	}
+	if m.series_total_volumes != nil {
+		fields = append(fields, bookcatalog.FieldSeriesTotalVolumes)
+	}
+	if m.source != nil {
+		fields = append(fields, bookcatalog.FieldSource)
+	}
+	if m.created_at != nil {
+		fields = append(fields, bookcatalog.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, bookcatalog.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BookCatalogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bookcatalog.FieldIsbn:
+		return m.Isbn()
+	case bookcatalog.FieldTitle:
+		return m.Title()
+	case bookcatalog.FieldAuthor:
+		return m.Author()
+	case bookcatalog.FieldPublisher:
+		return m.Publisher()
+	case bookcatalog.FieldPublishedDate:
+		return m.PublishedDate()
+	case bookcatalog.FieldThumbnailURL:
+		return m.ThumbnailURL()
+	case bookcatalog.FieldSeriesName:
+		return m.SeriesName()
+	case bookcatalog.FieldSeriesVolume:
+		return m.SeriesVolume()
+	case bookcatalog.FieldSeriesTotalVolumes:
+		return m.SeriesTotalVolumes()
+	case bookcatalog.FieldSource:
+		return m.Source()
+	case bookcatalog.FieldCreatedAt:
+		return m.CreatedAt()
+	case bookcatalog.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BookCatalogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bookcatalog.FieldIsbn:
+		return m.OldIsbn(ctx)
+	case bookcatalog.FieldTitle:
+		return m.OldTitle(ctx)
+	case bookcatalog.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case bookcatalog.FieldPublisher:
+		return m.OldPublisher(ctx)
+	case bookcatalog.FieldPublishedDate:
+		return m.OldPublishedDate(ctx)
+	case bookcatalog.FieldThumbnailURL:
+		return m.OldThumbnailURL(ctx)
+	case bookcatalog.FieldSeriesName:
+		return m.OldSeriesName(ctx)
+	case bookcatalog.FieldSeriesVolume:
+		return m.OldSeriesVolume(ctx)
+	case bookcatalog.FieldSeriesTotalVolumes:
+		return m.OldSeriesTotalVolumes(ctx)
+	case bookcatalog.FieldSource:
+		return m.OldSource(ctx)
+	case bookcatalog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case bookcatalog.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BookCatalog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookCatalogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bookcatalog.FieldIsbn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsbn(v)
+		return nil
+	case bookcatalog.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case bookcatalog.FieldAuthor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthor(v)
+		return nil
+	case bookcatalog.FieldPublisher:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublisher(v)
+		return nil
+	case bookcatalog.FieldPublishedDate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedDate(v)
+		return nil
+	case bookcatalog.FieldThumbnailURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailURL(v)
+		return nil
+	case bookcatalog.FieldSeriesName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesName(v)
+		return nil
+	case bookcatalog.FieldSeriesVolume:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesVolume(v)
+		return nil
+	case bookcatalog.FieldSeriesTotalVolumes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesTotalVolumes(v)
+		return nil
+	case bookcatalog.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case bookcatalog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case bookcatalog.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BookCatalog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BookCatalogMutation) AddedFields() []string {
+	var fields []string
+	if m.addseries_volume != nil {
+		fields = append(fields, bookcatalog.FieldSeriesVolume)
+	}
+	if m.addseries_total_volumes != nil {
+		fields = append(fields, bookcatalog.FieldSeriesTotalVolumes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BookCatalogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case bookcatalog.FieldSeriesVolume:
+		return m.AddedSeriesVolume()
+	case bookcatalog.FieldSeriesTotalVolumes:
+		return m.AddedSeriesTotalVolumes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookCatalogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case bookcatalog.FieldSeriesVolume:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeriesVolume(v)
+		return nil
+	case bookcatalog.FieldSeriesTotalVolumes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSeriesTotalVolumes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BookCatalog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BookCatalogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bookcatalog.FieldIsbn) {
+		fields = append(fields, bookcatalog.FieldIsbn)
+	}
+	if m.FieldCleared(bookcatalog.FieldPublisher) {
+		fields = append(fields, bookcatalog.FieldPublisher)
+	}
+	if m.FieldCleared(bookcatalog.FieldPublishedDate) {
+		fields = append(fields, bookcatalog.FieldPublishedDate)
+	}
+	if m.FieldCleared(bookcatalog.FieldThumbnailURL) {
+		fields = append(fields, bookcatalog.FieldThumbnailURL)
+	}
+	if m.FieldCleared(bookcatalog.FieldSeriesName) {
+		fields = append(fields, bookcatalog.FieldSeriesName)
+	}
+	if m.FieldCleared(bookcatalog.FieldSeriesVolume) {
+		fields = append(fields, bookcatalog.FieldSeriesVolume)
+	}
+	if m.FieldCleared(bookcatalog.FieldSeriesTotalVolumes) {
+		fields = append(fields, bookcatalog.FieldSeriesTotalVolumes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BookCatalogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BookCatalogMutation) ClearField(name string) error {
+	switch name {
+	case bookcatalog.FieldIsbn:
+		m.ClearIsbn()
+		return nil
+	case bookcatalog.FieldPublisher:
+		m.ClearPublisher()
+		return nil
+	case bookcatalog.FieldPublishedDate:
+		m.ClearPublishedDate()
+		return nil
+	case bookcatalog.FieldThumbnailURL:
+		m.ClearThumbnailURL()
+		return nil
+	case bookcatalog.FieldSeriesName:
+		m.ClearSeriesName()
+		return nil
+	case bookcatalog.FieldSeriesVolume:
+		m.ClearSeriesVolume()
+		return nil
+	case bookcatalog.FieldSeriesTotalVolumes:
+		m.ClearSeriesTotalVolumes()
+		return nil
+	}
+	return fmt.Errorf("unknown BookCatalog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BookCatalogMutation) ResetField(name string) error {
+	switch name {
+	case bookcatalog.FieldIsbn:
+		m.ResetIsbn()
+		return nil
+	case bookcatalog.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case bookcatalog.FieldAuthor:
+		m.ResetAuthor()
+		return nil
+	case bookcatalog.FieldPublisher:
+		m.ResetPublisher()
+		return nil
+	case bookcatalog.FieldPublishedDate:
+		m.ResetPublishedDate()
+		return nil
+	case bookcatalog.FieldThumbnailURL:
+		m.ResetThumbnailURL()
+		return nil
+	case bookcatalog.FieldSeriesName:
+		m.ResetSeriesName()
+		return nil
+	case bookcatalog.FieldSeriesVolume:
+		m.ResetSeriesVolume()
+		return nil
+	case bookcatalog.FieldSeriesTotalVolumes:
+		m.ResetSeriesTotalVolumes()
+		return nil
+	case bookcatalog.FieldSource:
+		m.ResetSource()
+		return nil
+	case bookcatalog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case bookcatalog.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BookCatalog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BookCatalogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.copies != nil {
+		edges = append(edges, bookcatalog.EdgeCopies)
+	}
+	if m.reviews != nil {
+		edges = append(edges, bookcatalog.EdgeReviews)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BookCatalogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case bookcatalog.EdgeCopies:
+		ids := make([]ent.Value, 0, len(m.copies))
+		for id := range m.copies {
+			ids = append(ids, id)
+		}
+		return ids
+	case bookcatalog.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.reviews))
+		for id := range m.reviews {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BookCatalogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcopies != nil {
+		edges = append(edges, bookcatalog.EdgeCopies)
+	}
+	if m.removedreviews != nil {
+		edges = append(edges, bookcatalog.EdgeReviews)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BookCatalogMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case bookcatalog.EdgeCopies:
+		ids := make([]ent.Value, 0, len(m.removedcopies))
+		for id := range m.removedcopies {
+			ids = append(ids, id)
+		}
+		return ids
+	case bookcatalog.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.removedreviews))
+		for id := range m.removedreviews {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BookCatalogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedcopies {
+		edges = append(edges, bookcatalog.EdgeCopies)
+	}
+	if m.clearedreviews {
+		edges = append(edges, bookcatalog.EdgeReviews)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BookCatalogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case bookcatalog.EdgeCopies:
+		return m.clearedcopies
+	case bookcatalog.EdgeReviews:
+		return m.clearedreviews
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BookCatalogMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BookCatalog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BookCatalogMutation) ResetEdge(name string) error {
+	switch name {
+	case bookcatalog.EdgeCopies:
+		m.ResetCopies()
+		return nil
+	case bookcatalog.EdgeReviews:
+		m.ResetReviews()
+		return nil
+	}
+	return fmt.Errorf("unknown BookCatalog edge %s", name)
+}
+
+// BookNoteMutation represents an operation that mutates the BookNote nodes in the graph.
+type BookNoteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	quote         *string
+	comment       *string
+	page          *int
+	addpage       *int
+	location      *string
+	color         *string
+	label         *string
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	book          *uuid.UUID
+	clearedbook   bool
+	done          bool
+	oldValue      func(context.Context) (*BookNote, error)
+	predicates    []predicate.BookNote
+}
+
+var _ ent.Mutation = (*BookNoteMutation)(nil)
+
+// booknoteOption allows management of the mutation configuration using functional options.
+type booknoteOption func(*BookNoteMutation)
+
+// newBookNoteMutation creates new mutation for the BookNote entity.
+func newBookNoteMutation(c config, op Op, opts ...booknoteOption) *BookNoteMutation {
+	m := &BookNoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBookNote,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBookNoteID sets the ID field of the mutation.
+func withBookNoteID(id uuid.UUID) booknoteOption {
+	return func(m *BookNoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BookNote
+		)
+		m.oldValue = func(ctx context.Context) (*BookNote, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BookNote.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBookNote sets the old BookNote of the mutation.
+func withBookNote(node *BookNote) booknoteOption {
+	return func(m *BookNoteMutation) {
+		m.oldValue = func(context.Context) (*BookNote, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BookNoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BookNoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BookNote entities.
+func (m *BookNoteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BookNoteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BookNoteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BookNote.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuote sets the "quote" field.
+func (m *BookNoteMutation) SetQuote(s string) {
+	m.quote = &s
+}
+
+// Quote returns the value of the "quote" field in the mutation.
+func (m *BookNoteMutation) Quote() (r string, exists bool) {
+	v := m.quote
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuote returns the old "quote" field's value of the BookNote entity.
+// If the BookNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookNoteMutation) OldQuote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuote: %w", err)
+	}
+	return oldValue.Quote, nil
+}
+
+// ClearQuote clears the value of the "quote" field.
+func (m *BookNoteMutation) ClearQuote() {
+	m.quote = nil
+	m.clearedFields[booknote.FieldQuote] = struct{}{}
+}
+
+// QuoteCleared returns if the "quote" field was cleared in this mutation.
+func (m *BookNoteMutation) QuoteCleared() bool {
+	_, ok := m.clearedFields[booknote.FieldQuote]
+	return ok
+}
+
+// ResetQuote resets all changes to the "quote" field.
+func (m *BookNoteMutation) ResetQuote() {
+	m.quote = nil
+	delete(m.clearedFields, booknote.FieldQuote)
+}
+
+// SetComment sets the "comment" field.
+func (m *BookNoteMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *BookNoteMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the BookNote entity.
+// If the BookNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookNoteMutation) OldComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ClearComment clears the value of the "comment" field.
+func (m *BookNoteMutation) ClearComment() {
+	m.comment = nil
+	m.clearedFields[booknote.FieldComment] = struct{}{}
+}
+
+// CommentCleared returns if the "comment" field was cleared in this mutation.
+func (m *BookNoteMutation) CommentCleared() bool {
+	_, ok := m.clearedFields[booknote.FieldComment]
+	return ok
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *BookNoteMutation) ResetComment() {
+	m.comment = nil
+	delete(m.clearedFields, booknote.FieldComment)
+}
+
+// SetPage sets the "page" field.
+func (m *BookNoteMutation) SetPage(i int) {
+	m.page = &i
+	m.addpage = nil
+}
+
+// Page returns the value of the "page" field in the mutation.
+func (m *BookNoteMutation) Page() (r int, exists bool) {
+	v := m.page
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPage returns the old "page" field's value of the BookNote entity.
+// If the BookNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookNoteMutation) OldPage(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPage: %w", err)
+	}
+	return oldValue.Page, nil
+}
+
+// AddPage adds i to the "page" field.
+func (m *BookNoteMutation) AddPage(i int) {
+	if m.addpage != nil {
+		*m.addpage += i
+	} else {
+		m.addpage = &i
+	}
+}
+
+// AddedPage returns the value that was added to the "page" field in this mutation.
+func (m *BookNoteMutation) AddedPage() (r int, exists bool) {
+	v := m.addpage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPage clears the value of the "page" field.
+func (m *BookNoteMutation) ClearPage() {
+	m.page = nil
+	m.addpage = nil
+	m.clearedFields[booknote.FieldPage] = struct{}{}
+}
+
+// PageCleared returns if the "page" field was cleared in this mutation.
+func (m *BookNoteMutation) PageCleared() bool {
+	_, ok := m.clearedFields[booknote.FieldPage]
+	return ok
+}
+
+// ResetPage resets all changes to the "page" field.
+func (m *BookNoteMutation) ResetPage() {
+	m.page = nil
+	m.addpage = nil
+	delete(m.clearedFields, booknote.FieldPage)
+}
+
+// SetLocation sets the "location" field.
+func (m *BookNoteMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *BookNoteMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the BookNote entity.
+// If the BookNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookNoteMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *BookNoteMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[booknote.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *BookNoteMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[booknote.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *BookNoteMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, booknote.FieldLocation)
+}
+
+// SetColor sets the "color" field.
+func (m *BookNoteMutation) SetColor(s string) {
+	m.color = &s
+}
+
+// Color returns the value of the "color" field in the mutation.
+func (m *BookNoteMutation) Color() (r string, exists bool) {
+	v := m.color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldColor returns the old "color" field's value of the BookNote entity.
+// If the BookNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookNoteMutation) OldColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldColor: %w", err)
+	}
+	return oldValue.Color, nil
+}
+
+// ClearColor clears the value of the "color" field.
+func (m *BookNoteMutation) ClearColor() {
+	m.color = nil
+	m.clearedFields[booknote.FieldColor] = struct{}{}
+}
+
+// ColorCleared returns if the "color" field was cleared in this mutation.
+func (m *BookNoteMutation) ColorCleared() bool {
+	_, ok := m.clearedFields[booknote.FieldColor]
+	return ok
+}
+
+// ResetColor resets all changes to the "color" field.
+func (m *BookNoteMutation) ResetColor() {
+	m.color = nil
+	delete(m.clearedFields, booknote.FieldColor)
+}
+
+// SetLabel sets the "label" field.
+func (m *BookNoteMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *BookNoteMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the BookNote entity.
+// If the BookNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookNoteMutation) OldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ClearLabel clears the value of the "label" field.
+func (m *BookNoteMutation) ClearLabel() {
+	m.label = nil
+	m.clearedFields[booknote.FieldLabel] = struct{}{}
+}
+
+// LabelCleared returns if the "label" field was cleared in this mutation.
+func (m *BookNoteMutation) LabelCleared() bool {
+	_, ok := m.clearedFields[booknote.FieldLabel]
+	return ok
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *BookNoteMutation) ResetLabel() {
+	m.label = nil
+	delete(m.clearedFields, booknote.FieldLabel)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BookNoteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BookNoteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BookNote entity.
+// If the BookNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookNoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BookNoteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BookNoteMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BookNoteMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the BookNote entity.
+// If the BookNote object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookNoteMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BookNoteMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *BookNoteMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *BookNoteMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *BookNoteMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *BookNoteMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *BookNoteMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *BookNoteMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetBookID sets the "book" edge to the Book entity by id.
+func (m *BookNoteMutation) SetBookID(id uuid.UUID) {
+	m.book = &id
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *BookNoteMutation) ClearBook() {
+	m.clearedbook = true
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *BookNoteMutation) BookCleared() bool {
+	return m.clearedbook
+}
+
+// BookID returns the "book" edge ID in the mutation.
+func (m *BookNoteMutation) BookID() (id uuid.UUID, exists bool) {
+	if m.book != nil {
+		return *m.book, true
+	}
+	return
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *BookNoteMutation) BookIDs() (ids []uuid.UUID) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *BookNoteMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// Where appends a list predicates to the BookNoteMutation builder.
+func (m *BookNoteMutation) Where(ps ...predicate.BookNote) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BookNoteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BookNoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BookNote, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BookNoteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BookNoteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BookNote).
+func (m *BookNoteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BookNoteMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.quote != nil {
+		fields = append(fields, booknote.FieldQuote)
+	}
+	if m.comment != nil {
+		fields = append(fields, booknote.FieldComment)
+	}
+	if m.page != nil {
+		fields = append(fields, booknote.FieldPage)
+	}
+	if m.location != nil {
+		fields = append(fields, booknote.FieldLocation)
+	}
+	if m.color != nil {
+		fields = append(fields, booknote.FieldColor)
+	}
+	if m.label != nil {
+		fields = append(fields, booknote.FieldLabel)
+	}
+	if m.created_at != nil {
+		fields = append(fields, booknote.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, booknote.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BookNoteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case booknote.FieldQuote:
+		return m.Quote()
+	case booknote.FieldComment:
+		return m.Comment()
+	case booknote.FieldPage:
+		return m.Page()
+	case booknote.FieldLocation:
+		return m.Location()
+	case booknote.FieldColor:
+		return m.Color()
+	case booknote.FieldLabel:
+		return m.Label()
+	case booknote.FieldCreatedAt:
+		return m.CreatedAt()
+	case booknote.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BookNoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case booknote.FieldQuote:
+		return m.OldQuote(ctx)
+	case booknote.FieldComment:
+		return m.OldComment(ctx)
+	case booknote.FieldPage:
+		return m.OldPage(ctx)
+	case booknote.FieldLocation:
+		return m.OldLocation(ctx)
+	case booknote.FieldColor:
+		return m.OldColor(ctx)
+	case booknote.FieldLabel:
+		return m.OldLabel(ctx)
+	case booknote.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case booknote.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BookNote field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookNoteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case booknote.FieldQuote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuote(v)
+		return nil
+	case booknote.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	case booknote.FieldPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPage(v)
+		return nil
+	case booknote.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case booknote.FieldColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetColor(v)
+		return nil
+	case booknote.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case booknote.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case booknote.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BookNote field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BookNoteMutation) AddedFields() []string {
+	var fields []string
+	if m.addpage != nil {
+		fields = append(fields, booknote.FieldPage)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BookNoteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case booknote.FieldPage:
+		return m.AddedPage()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookNoteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case booknote.FieldPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BookNote numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BookNoteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(booknote.FieldQuote) {
+		fields = append(fields, booknote.FieldQuote)
+	}
+	if m.FieldCleared(booknote.FieldComment) {
+		fields = append(fields, booknote.FieldComment)
+	}
+	if m.FieldCleared(booknote.FieldPage) {
+		fields = append(fields, booknote.FieldPage)
+	}
+	if m.FieldCleared(booknote.FieldLocation) {
+		fields = append(fields, booknote.FieldLocation)
+	}
+	if m.FieldCleared(booknote.FieldColor) {
+		fields = append(fields, booknote.FieldColor)
+	}
+	if m.FieldCleared(booknote.FieldLabel) {
+		fields = append(fields, booknote.FieldLabel)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BookNoteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BookNoteMutation) ClearField(name string) error {
+	switch name {
+	case booknote.FieldQuote:
+		m.ClearQuote()
+		return nil
+	case booknote.FieldComment:
+		m.ClearComment()
+		return nil
+	case booknote.FieldPage:
+		m.ClearPage()
+		return nil
+	case booknote.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case booknote.FieldColor:
+		m.ClearColor()
+		return nil
+	case booknote.FieldLabel:
+		m.ClearLabel()
+		return nil
+	}
+	return fmt.Errorf("unknown BookNote nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BookNoteMutation) ResetField(name string) error {
+	switch name {
+	case booknote.FieldQuote:
+		m.ResetQuote()
+		return nil
+	case booknote.FieldComment:
+		m.ResetComment()
+		return nil
+	case booknote.FieldPage:
+		m.ResetPage()
+		return nil
+	case booknote.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case booknote.FieldColor:
+		m.ResetColor()
+		return nil
+	case booknote.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case booknote.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case booknote.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BookNote field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BookNoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, booknote.EdgeOwner)
+	}
+	if m.book != nil {
+		edges = append(edges, booknote.EdgeBook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BookNoteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case booknote.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case booknote.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BookNoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BookNoteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BookNoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, booknote.EdgeOwner)
+	}
+	if m.clearedbook {
+		edges = append(edges, booknote.EdgeBook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BookNoteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case booknote.EdgeOwner:
+		return m.clearedowner
+	case booknote.EdgeBook:
+		return m.clearedbook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BookNoteMutation) ClearEdge(name string) error {
+	switch name {
+	case booknote.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case booknote.EdgeBook:
+		m.ClearBook()
+		return nil
+	}
+	return fmt.Errorf("unknown BookNote unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BookNoteMutation) ResetEdge(name string) error {
+	switch name {
+	case booknote.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case booknote.EdgeBook:
+		m.ResetBook()
+		return nil
+	}
+	return fmt.Errorf("unknown BookNote edge %s", name)
+}
+
+// BookStatusHistoryMutation represents an operation that mutates the BookStatusHistory nodes in the graph.
+type BookStatusHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	from_status   *bookstatushistory.FromStatus
+	to_status     *bookstatushistory.ToStatus
+	changed_at    *time.Time
+	clearedFields map[string]struct{}
+	book          *uuid.UUID
+	clearedbook   bool
+	done          bool
+	oldValue      func(context.Context) (*BookStatusHistory, error)
+	predicates    []predicate.BookStatusHistory
+}
+
+var _ ent.Mutation = (*BookStatusHistoryMutation)(nil)
+
+// bookstatushistoryOption allows management of the mutation configuration using functional options.
+type bookstatushistoryOption func(*BookStatusHistoryMutation)
+
+// newBookStatusHistoryMutation creates new mutation for the BookStatusHistory entity.
+func newBookStatusHistoryMutation(c config, op Op, opts ...bookstatushistoryOption) *BookStatusHistoryMutation {
+	m := &BookStatusHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBookStatusHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBookStatusHistoryID sets the ID field of the mutation.
+func withBookStatusHistoryID(id uuid.UUID) bookstatushistoryOption {
+	return func(m *BookStatusHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BookStatusHistory
+		)
+		m.oldValue = func(ctx context.Context) (*BookStatusHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BookStatusHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBookStatusHistory sets the old BookStatusHistory of the mutation.
+func withBookStatusHistory(node *BookStatusHistory) bookstatushistoryOption {
+	return func(m *BookStatusHistoryMutation) {
+		m.oldValue = func(context.Context) (*BookStatusHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BookStatusHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BookStatusHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BookStatusHistory entities.
+func (m *BookStatusHistoryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BookStatusHistoryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BookStatusHistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BookStatusHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFromStatus sets the "from_status" field.
+func (m *BookStatusHistoryMutation) SetFromStatus(bs bookstatushistory.FromStatus) {
+	m.from_status = &bs
+}
+
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *BookStatusHistoryMutation) FromStatus() (r bookstatushistory.FromStatus, exists bool) {
+	v := m.from_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromStatus returns the old "from_status" field's value of the BookStatusHistory entity.
+// If the BookStatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookStatusHistoryMutation) OldFromStatus(ctx context.Context) (v *bookstatushistory.FromStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
+	}
+	return oldValue.FromStatus, nil
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (m *BookStatusHistoryMutation) ClearFromStatus() {
+	m.from_status = nil
+	m.clearedFields[bookstatushistory.FieldFromStatus] = struct{}{}
+}
+
+// FromStatusCleared returns if the "from_status" field was cleared in this mutation.
+func (m *BookStatusHistoryMutation) FromStatusCleared() bool {
+	_, ok := m.clearedFields[bookstatushistory.FieldFromStatus]
+	return ok
+}
+
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *BookStatusHistoryMutation) ResetFromStatus() {
+	m.from_status = nil
+	delete(m.clearedFields, bookstatushistory.FieldFromStatus)
+}
+
+// SetToStatus sets the "to_status" field.
+func (m *BookStatusHistoryMutation) SetToStatus(bs bookstatushistory.ToStatus) {
+	m.to_status = &bs
+}
+
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *BookStatusHistoryMutation) ToStatus() (r bookstatushistory.ToStatus, exists bool) {
+	v := m.to_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToStatus returns the old "to_status" field's value of the BookStatusHistory entity.
+// If the BookStatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookStatusHistoryMutation) OldToStatus(ctx context.Context) (v bookstatushistory.ToStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
+}
+
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *BookStatusHistoryMutation) ResetToStatus() {
+	m.to_status = nil
+}
+
+// SetChangedAt sets the "changed_at" field.
+func (m *BookStatusHistoryMutation) SetChangedAt(t time.Time) {
+	m.changed_at = &t
+}
+
+// ChangedAt returns the value of the "changed_at" field in the mutation.
+func (m *BookStatusHistoryMutation) ChangedAt() (r time.Time, exists bool) {
+	v := m.changed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedAt returns the old "changed_at" field's value of the BookStatusHistory entity.
+// If the BookStatusHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookStatusHistoryMutation) OldChangedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedAt: %w", err)
+	}
+	return oldValue.ChangedAt, nil
+}
+
+// ResetChangedAt resets all changes to the "changed_at" field.
+func (m *BookStatusHistoryMutation) ResetChangedAt() {
+	m.changed_at = nil
+}
+
+// SetBookID sets the "book" edge to the Book entity by id.
+func (m *BookStatusHistoryMutation) SetBookID(id uuid.UUID) {
+	m.book = &id
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *BookStatusHistoryMutation) ClearBook() {
+	m.clearedbook = true
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *BookStatusHistoryMutation) BookCleared() bool {
+	return m.clearedbook
+}
+
+// BookID returns the "book" edge ID in the mutation.
+func (m *BookStatusHistoryMutation) BookID() (id uuid.UUID, exists bool) {
+	if m.book != nil {
+		return *m.book, true
+	}
+	return
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *BookStatusHistoryMutation) BookIDs() (ids []uuid.UUID) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *BookStatusHistoryMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// Where appends a list predicates to the BookStatusHistoryMutation builder.
+func (m *BookStatusHistoryMutation) Where(ps ...predicate.BookStatusHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BookStatusHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BookStatusHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BookStatusHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BookStatusHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BookStatusHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BookStatusHistory).
+func (m *BookStatusHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BookStatusHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.from_status != nil {
+		fields = append(fields, bookstatushistory.FieldFromStatus)
+	}
+	if m.to_status != nil {
+		fields = append(fields, bookstatushistory.FieldToStatus)
+	}
+	if m.changed_at != nil {
+		fields = append(fields, bookstatushistory.FieldChangedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BookStatusHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bookstatushistory.FieldFromStatus:
+		return m.FromStatus()
+	case bookstatushistory.FieldToStatus:
+		return m.ToStatus()
+	case bookstatushistory.FieldChangedAt:
+		return m.ChangedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BookStatusHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bookstatushistory.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case bookstatushistory.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case bookstatushistory.FieldChangedAt:
+		return m.OldChangedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BookStatusHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookStatusHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bookstatushistory.FieldFromStatus:
+		v, ok := value.(bookstatushistory.FromStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromStatus(v)
+		return nil
+	case bookstatushistory.FieldToStatus:
+		v, ok := value.(bookstatushistory.ToStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToStatus(v)
+		return nil
+	case bookstatushistory.FieldChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BookStatusHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BookStatusHistoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BookStatusHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookStatusHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BookStatusHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BookStatusHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(bookstatushistory.FieldFromStatus) {
+		fields = append(fields, bookstatushistory.FieldFromStatus)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BookStatusHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BookStatusHistoryMutation) ClearField(name string) error {
+	switch name {
+	case bookstatushistory.FieldFromStatus:
+		m.ClearFromStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown BookStatusHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BookStatusHistoryMutation) ResetField(name string) error {
+	switch name {
+	case bookstatushistory.FieldFromStatus:
+		m.ResetFromStatus()
+		return nil
+	case bookstatushistory.FieldToStatus:
+		m.ResetToStatus()
+		return nil
+	case bookstatushistory.FieldChangedAt:
+		m.ResetChangedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BookStatusHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BookStatusHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.book != nil {
+		edges = append(edges, bookstatushistory.EdgeBook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BookStatusHistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case bookstatushistory.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BookStatusHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BookStatusHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BookStatusHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbook {
+		edges = append(edges, bookstatushistory.EdgeBook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BookStatusHistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case bookstatushistory.EdgeBook:
+		return m.clearedbook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BookStatusHistoryMutation) ClearEdge(name string) error {
+	switch name {
+	case bookstatushistory.EdgeBook:
+		m.ClearBook()
+		return nil
+	}
+	return fmt.Errorf("unknown BookStatusHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BookStatusHistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case bookstatushistory.EdgeBook:
+		m.ResetBook()
+		return nil
+	}
+	return fmt.Errorf("unknown BookStatusHistory edge %s", name)
+}
+
+// BookmarkMutation represents an operation that mutates the Bookmark nodes in the graph.
+type BookmarkMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	book          *uuid.UUID
+	clearedbook   bool
+	done          bool
+	oldValue      func(context.Context) (*Bookmark, error)
+	predicates    []predicate.Bookmark
+}
+
+var _ ent.Mutation = (*BookmarkMutation)(nil)
+
+// bookmarkOption allows management of the mutation configuration using functional options.
+type bookmarkOption func(*BookmarkMutation)
+
+// newBookmarkMutation creates new mutation for the Bookmark entity.
+func newBookmarkMutation(c config, op Op, opts ...bookmarkOption) *BookmarkMutation {
+	m := &BookmarkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBookmark,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBookmarkID sets the ID field of the mutation.
+func withBookmarkID(id uuid.UUID) bookmarkOption {
+	return func(m *BookmarkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Bookmark
+		)
+		m.oldValue = func(ctx context.Context) (*Bookmark, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Bookmark.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBookmark sets the old Bookmark of the mutation.
+func withBookmark(node *Bookmark) bookmarkOption {
+	return func(m *BookmarkMutation) {
+		m.oldValue = func(context.Context) (*Bookmark, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BookmarkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BookmarkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Bookmark entities.
+func (m *BookmarkMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BookmarkMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BookmarkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Bookmark.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BookmarkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BookmarkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Bookmark entity.
+// If the Bookmark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BookmarkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BookmarkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *BookmarkMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *BookmarkMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *BookmarkMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *BookmarkMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *BookmarkMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *BookmarkMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetBookID sets the "book" edge to the Book entity by id.
+func (m *BookmarkMutation) SetBookID(id uuid.UUID) {
+	m.book = &id
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *BookmarkMutation) ClearBook() {
+	m.clearedbook = true
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *BookmarkMutation) BookCleared() bool {
+	return m.clearedbook
+}
+
+// BookID returns the "book" edge ID in the mutation.
+func (m *BookmarkMutation) BookID() (id uuid.UUID, exists bool) {
+	if m.book != nil {
+		return *m.book, true
+	}
+	return
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *BookmarkMutation) BookIDs() (ids []uuid.UUID) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *BookmarkMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// Where appends a list predicates to the BookmarkMutation builder.
+func (m *BookmarkMutation) Where(ps ...predicate.Bookmark) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BookmarkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BookmarkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Bookmark, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BookmarkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BookmarkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Bookmark).
+func (m *BookmarkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BookmarkMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, bookmark.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BookmarkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case bookmark.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BookmarkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case bookmark.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Bookmark field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookmarkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case bookmark.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Bookmark field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BookmarkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BookmarkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BookmarkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Bookmark numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BookmarkMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BookmarkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BookmarkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Bookmark nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BookmarkMutation) ResetField(name string) error {
+	switch name {
+	case bookmark.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Bookmark field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BookmarkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, bookmark.EdgeOwner)
+	}
+	if m.book != nil {
+		edges = append(edges, bookmark.EdgeBook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BookmarkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case bookmark.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case bookmark.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BookmarkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BookmarkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BookmarkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, bookmark.EdgeOwner)
+	}
+	if m.clearedbook {
+		edges = append(edges, bookmark.EdgeBook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BookmarkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case bookmark.EdgeOwner:
+		return m.clearedowner
+	case bookmark.EdgeBook:
+		return m.clearedbook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BookmarkMutation) ClearEdge(name string) error {
+	switch name {
+	case bookmark.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case bookmark.EdgeBook:
+		m.ClearBook()
+		return nil
+	}
+	return fmt.Errorf("unknown Bookmark unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BookmarkMutation) ResetEdge(name string) error {
+	switch name {
+	case bookmark.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case bookmark.EdgeBook:
+		m.ResetBook()
+		return nil
+	}
+	return fmt.Errorf("unknown Bookmark edge %s", name)
+}
+
+// BorrowRequestMutation represents an operation that mutates the BorrowRequest nodes in the graph.
+type BorrowRequestMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	status           *borrowrequest.Status
+	message          *string
+	response_message *string
+	due_at           *time.Time
+	responded_at     *time.Time
+	handed_over_at   *time.Time
+	returned_at      *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	requester        *uuid.UUID
+	clearedrequester bool
+	owner            *uuid.UUID
+	clearedowner     bool
+	book             *uuid.UUID
+	clearedbook      bool
+	loan             *uuid.UUID
+	clearedloan      bool
+	done             bool
+	oldValue         func(context.Context) (*BorrowRequest, error)
+	predicates       []predicate.BorrowRequest
+}
+
+var _ ent.Mutation = (*BorrowRequestMutation)(nil)
+
+// borrowrequestOption allows management of the mutation configuration using functional options.
+type borrowrequestOption func(*BorrowRequestMutation)
+
+// newBorrowRequestMutation creates new mutation for the BorrowRequest entity.
+func newBorrowRequestMutation(c config, op Op, opts ...borrowrequestOption) *BorrowRequestMutation {
+	m := &BorrowRequestMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBorrowRequest,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBorrowRequestID sets the ID field of the mutation.
+func withBorrowRequestID(id uuid.UUID) borrowrequestOption {
+	return func(m *BorrowRequestMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BorrowRequest
+		)
+		m.oldValue = func(ctx context.Context) (*BorrowRequest, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BorrowRequest.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBorrowRequest sets the old BorrowRequest of the mutation.
+func withBorrowRequest(node *BorrowRequest) borrowrequestOption {
+	return func(m *BorrowRequestMutation) {
+		m.oldValue = func(context.Context) (*BorrowRequest, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BorrowRequestMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BorrowRequestMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BorrowRequest entities.
+func (m *BorrowRequestMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BorrowRequestMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BorrowRequestMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BorrowRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStatus sets the "status" field.
+func (m *BorrowRequestMutation) SetStatus(b borrowrequest.Status) {
+	m.status = &b
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *BorrowRequestMutation) Status() (r borrowrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the BorrowRequest entity.
+// If the BorrowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BorrowRequestMutation) OldStatus(ctx context.Context) (v borrowrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *BorrowRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *BorrowRequestMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *BorrowRequestMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the BorrowRequest entity.
+// If the BorrowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BorrowRequestMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ClearMessage clears the value of the "message" field.
+func (m *BorrowRequestMutation) ClearMessage() {
+	m.message = nil
+	m.clearedFields[borrowrequest.FieldMessage] = struct{}{}
+}
+
+// MessageCleared returns if the "message" field was cleared in this mutation.
+func (m *BorrowRequestMutation) MessageCleared() bool {
+	_, ok := m.clearedFields[borrowrequest.FieldMessage]
+	return ok
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *BorrowRequestMutation) ResetMessage() {
+	m.message = nil
+	delete(m.clearedFields, borrowrequest.FieldMessage)
+}
+
+// SetResponseMessage sets the "response_message" field.
+func (m *BorrowRequestMutation) SetResponseMessage(s string) {
+	m.response_message = &s
+}
+
+// ResponseMessage returns the value of the "response_message" field in the mutation.
+func (m *BorrowRequestMutation) ResponseMessage() (r string, exists bool) {
+	v := m.response_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResponseMessage returns the old "response_message" field's value of the BorrowRequest entity.
+// If the BorrowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BorrowRequestMutation) OldResponseMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseMessage: %w", err)
+	}
+	return oldValue.ResponseMessage, nil
+}
+
+// ClearResponseMessage clears the value of the "response_message" field.
+func (m *BorrowRequestMutation) ClearResponseMessage() {
+	m.response_message = nil
+	m.clearedFields[borrowrequest.FieldResponseMessage] = struct{}{}
+}
+
+// ResponseMessageCleared returns if the "response_message" field was cleared in this mutation.
+func (m *BorrowRequestMutation) ResponseMessageCleared() bool {
+	_, ok := m.clearedFields[borrowrequest.FieldResponseMessage]
+	return ok
+}
+
+// ResetResponseMessage resets all changes to the "response_message" field.
+func (m *BorrowRequestMutation) ResetResponseMessage() {
+	m.response_message = nil
+	delete(m.clearedFields, borrowrequest.FieldResponseMessage)
+}
+
+// SetDueAt sets the "due_at" field.
+func (m *BorrowRequestMutation) SetDueAt(t time.Time) {
+	m.due_at = &t
+}
+
+// DueAt returns the value of the "due_at" field in the mutation.
+func (m *BorrowRequestMutation) DueAt() (r time.Time, exists bool) {
+	v := m.due_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueAt returns the old "due_at" field's value of the BorrowRequest entity.
+// If the BorrowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BorrowRequestMutation) OldDueAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+	}
+	return oldValue.DueAt, nil
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (m *BorrowRequestMutation) ClearDueAt() {
+	m.due_at = nil
+	m.clearedFields[borrowrequest.FieldDueAt] = struct{}{}
+}
+
+// DueAtCleared returns if the "due_at" field was cleared in this mutation.
+func (m *BorrowRequestMutation) DueAtCleared() bool {
+	_, ok := m.clearedFields[borrowrequest.FieldDueAt]
+	return ok
+}
+
+// ResetDueAt resets all changes to the "due_at" field.
+func (m *BorrowRequestMutation) ResetDueAt() {
+	m.due_at = nil
+	delete(m.clearedFields, borrowrequest.FieldDueAt)
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (m *BorrowRequestMutation) SetRespondedAt(t time.Time) {
+	m.responded_at = &t
+}
+
+// RespondedAt returns the value of the "responded_at" field in the mutation.
+func (m *BorrowRequestMutation) RespondedAt() (r time.Time, exists bool) {
+	v := m.responded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRespondedAt returns the old "responded_at" field's value of the BorrowRequest entity.
+// If the BorrowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BorrowRequestMutation) OldRespondedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRespondedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRespondedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRespondedAt: %w", err)
+	}
+	return oldValue.RespondedAt, nil
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (m *BorrowRequestMutation) ClearRespondedAt() {
+	m.responded_at = nil
+	m.clearedFields[borrowrequest.FieldRespondedAt] = struct{}{}
+}
+
+// RespondedAtCleared returns if the "responded_at" field was cleared in this mutation.
+func (m *BorrowRequestMutation) RespondedAtCleared() bool {
+	_, ok := m.clearedFields[borrowrequest.FieldRespondedAt]
+	return ok
+}
+
+// ResetRespondedAt resets all changes to the "responded_at" field.
+func (m *BorrowRequestMutation) ResetRespondedAt() {
+	m.responded_at = nil
+	delete(m.clearedFields, borrowrequest.FieldRespondedAt)
+}
+
+// SetHandedOverAt sets the "handed_over_at" field.
+func (m *BorrowRequestMutation) SetHandedOverAt(t time.Time) {
+	m.handed_over_at = &t
+}
+
+// HandedOverAt returns the value of the "handed_over_at" field in the mutation.
+func (m *BorrowRequestMutation) HandedOverAt() (r time.Time, exists bool) {
+	v := m.handed_over_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHandedOverAt returns the old "handed_over_at" field's value of the BorrowRequest entity.
+// If the BorrowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BorrowRequestMutation) OldHandedOverAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHandedOverAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHandedOverAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHandedOverAt: %w", err)
+	}
+	return oldValue.HandedOverAt, nil
+}
+
+// ClearHandedOverAt clears the value of the "handed_over_at" field.
+func (m *BorrowRequestMutation) ClearHandedOverAt() {
+	m.handed_over_at = nil
+	m.clearedFields[borrowrequest.FieldHandedOverAt] = struct{}{}
+}
+
+// HandedOverAtCleared returns if the "handed_over_at" field was cleared in this mutation.
+func (m *BorrowRequestMutation) HandedOverAtCleared() bool {
+	_, ok := m.clearedFields[borrowrequest.FieldHandedOverAt]
+	return ok
+}
+
+// ResetHandedOverAt resets all changes to the "handed_over_at" field.
+func (m *BorrowRequestMutation) ResetHandedOverAt() {
+	m.handed_over_at = nil
+	delete(m.clearedFields, borrowrequest.FieldHandedOverAt)
+}
+
+// SetReturnedAt sets the "returned_at" field.
+func (m *BorrowRequestMutation) SetReturnedAt(t time.Time) {
+	m.returned_at = &t
+}
+
+// ReturnedAt returns the value of the "returned_at" field in the mutation.
+func (m *BorrowRequestMutation) ReturnedAt() (r time.Time, exists bool) {
+	v := m.returned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReturnedAt returns the old "returned_at" field's value of the BorrowRequest entity.
+// If the BorrowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BorrowRequestMutation) OldReturnedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReturnedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReturnedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReturnedAt: %w", err)
+	}
+	return oldValue.ReturnedAt, nil
+}
+
+// ClearReturnedAt clears the value of the "returned_at" field.
+func (m *BorrowRequestMutation) ClearReturnedAt() {
+	m.returned_at = nil
+	m.clearedFields[borrowrequest.FieldReturnedAt] = struct{}{}
+}
+
+// ReturnedAtCleared returns if the "returned_at" field was cleared in this mutation.
+func (m *BorrowRequestMutation) ReturnedAtCleared() bool {
+	_, ok := m.clearedFields[borrowrequest.FieldReturnedAt]
+	return ok
+}
+
+// ResetReturnedAt resets all changes to the "returned_at" field.
+func (m *BorrowRequestMutation) ResetReturnedAt() {
+	m.returned_at = nil
+	delete(m.clearedFields, borrowrequest.FieldReturnedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BorrowRequestMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BorrowRequestMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BorrowRequest entity.
+// If the BorrowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BorrowRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BorrowRequestMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BorrowRequestMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BorrowRequestMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the BorrowRequest entity.
+// If the BorrowRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BorrowRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BorrowRequestMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRequesterID sets the "requester" edge to the User entity by id.
+func (m *BorrowRequestMutation) SetRequesterID(id uuid.UUID) {
+	m.requester = &id
+}
+
+// ClearRequester clears the "requester" edge to the User entity.
+func (m *BorrowRequestMutation) ClearRequester() {
+	m.clearedrequester = true
+}
+
+// RequesterCleared reports if the "requester" edge to the User entity was cleared.
+func (m *BorrowRequestMutation) RequesterCleared() bool {
+	return m.clearedrequester
+}
+
+// RequesterID returns the "requester" edge ID in the mutation.
+func (m *BorrowRequestMutation) RequesterID() (id uuid.UUID, exists bool) {
+	if m.requester != nil {
+		return *m.requester, true
+	}
+	return
+}
+
+// RequesterIDs returns the "requester" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RequesterID instead. It exists only for internal usage by the builders.
+func (m *BorrowRequestMutation) RequesterIDs() (ids []uuid.UUID) {
+	if id := m.requester; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRequester resets all changes to the "requester" edge.
+func (m *BorrowRequestMutation) ResetRequester() {
+	m.requester = nil
+	m.clearedrequester = false
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *BorrowRequestMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *BorrowRequestMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *BorrowRequestMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *BorrowRequestMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *BorrowRequestMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *BorrowRequestMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetBookID sets the "book" edge to the Book entity by id.
+func (m *BorrowRequestMutation) SetBookID(id uuid.UUID) {
+	m.book = &id
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *BorrowRequestMutation) ClearBook() {
+	m.clearedbook = true
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *BorrowRequestMutation) BookCleared() bool {
+	return m.clearedbook
+}
+
+// BookID returns the "book" edge ID in the mutation.
+func (m *BorrowRequestMutation) BookID() (id uuid.UUID, exists bool) {
+	if m.book != nil {
+		return *m.book, true
+	}
+	return
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *BorrowRequestMutation) BookIDs() (ids []uuid.UUID) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *BorrowRequestMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// SetLoanID sets the "loan" edge to the Loan entity by id.
+func (m *BorrowRequestMutation) SetLoanID(id uuid.UUID) {
+	m.loan = &id
+}
+
+// ClearLoan clears the "loan" edge to the Loan entity.
+func (m *BorrowRequestMutation) ClearLoan() {
+	m.clearedloan = true
+}
+
+// LoanCleared reports if the "loan" edge to the Loan entity was cleared.
+func (m *BorrowRequestMutation) LoanCleared() bool {
+	return m.clearedloan
+}
+
+// LoanID returns the "loan" edge ID in the mutation.
+func (m *BorrowRequestMutation) LoanID() (id uuid.UUID, exists bool) {
+	if m.loan != nil {
+		return *m.loan, true
+	}
+	return
+}
+
+// LoanIDs returns the "loan" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LoanID instead. It exists only for internal usage by the builders.
+func (m *BorrowRequestMutation) LoanIDs() (ids []uuid.UUID) {
+	if id := m.loan; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLoan resets all changes to the "loan" edge.
+func (m *BorrowRequestMutation) ResetLoan() {
+	m.loan = nil
+	m.clearedloan = false
+}
+
+// Where appends a list predicates to the BorrowRequestMutation builder.
+func (m *BorrowRequestMutation) Where(ps ...predicate.BorrowRequest) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BorrowRequestMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BorrowRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BorrowRequest, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BorrowRequestMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BorrowRequestMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BorrowRequest).
+func (m *BorrowRequestMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BorrowRequestMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.status != nil {
+		fields = append(fields, borrowrequest.FieldStatus)
+	}
+	if m.message != nil {
+		fields = append(fields, borrowrequest.FieldMessage)
+	}
+	if m.response_message != nil {
+		fields = append(fields, borrowrequest.FieldResponseMessage)
+	}
+	if m.due_at != nil {
+		fields = append(fields, borrowrequest.FieldDueAt)
+	}
+	if m.responded_at != nil {
+		fields = append(fields, borrowrequest.FieldRespondedAt)
+	}
+	if m.handed_over_at != nil {
+		fields = append(fields, borrowrequest.FieldHandedOverAt)
+	}
+	if m.returned_at != nil {
+		fields = append(fields, borrowrequest.FieldReturnedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, borrowrequest.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, borrowrequest.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BorrowRequestMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case borrowrequest.FieldStatus:
+		return m.Status()
+	case borrowrequest.FieldMessage:
+		return m.Message()
+	case borrowrequest.FieldResponseMessage:
+		return m.ResponseMessage()
+	case borrowrequest.FieldDueAt:
+		return m.DueAt()
+	case borrowrequest.FieldRespondedAt:
+		return m.RespondedAt()
+	case borrowrequest.FieldHandedOverAt:
+		return m.HandedOverAt()
+	case borrowrequest.FieldReturnedAt:
+		return m.ReturnedAt()
+	case borrowrequest.FieldCreatedAt:
+		return m.CreatedAt()
+	case borrowrequest.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BorrowRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case borrowrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case borrowrequest.FieldMessage:
+		return m.OldMessage(ctx)
+	case borrowrequest.FieldResponseMessage:
+		return m.OldResponseMessage(ctx)
+	case borrowrequest.FieldDueAt:
+		return m.OldDueAt(ctx)
+	case borrowrequest.FieldRespondedAt:
+		return m.OldRespondedAt(ctx)
+	case borrowrequest.FieldHandedOverAt:
+		return m.OldHandedOverAt(ctx)
+	case borrowrequest.FieldReturnedAt:
+		return m.OldReturnedAt(ctx)
+	case borrowrequest.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case borrowrequest.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BorrowRequest field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BorrowRequestMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case borrowrequest.FieldStatus:
+		v, ok := value.(borrowrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case borrowrequest.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case borrowrequest.FieldResponseMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseMessage(v)
+		return nil
+	case borrowrequest.FieldDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueAt(v)
+		return nil
+	case borrowrequest.FieldRespondedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRespondedAt(v)
+		return nil
+	case borrowrequest.FieldHandedOverAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHandedOverAt(v)
+		return nil
+	case borrowrequest.FieldReturnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReturnedAt(v)
+		return nil
+	case borrowrequest.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case borrowrequest.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BorrowRequest field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BorrowRequestMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BorrowRequestMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BorrowRequestMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BorrowRequest numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BorrowRequestMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(borrowrequest.FieldMessage) {
+		fields = append(fields, borrowrequest.FieldMessage)
+	}
+	if m.FieldCleared(borrowrequest.FieldResponseMessage) {
+		fields = append(fields, borrowrequest.FieldResponseMessage)
+	}
+	if m.FieldCleared(borrowrequest.FieldDueAt) {
+		fields = append(fields, borrowrequest.FieldDueAt)
+	}
+	if m.FieldCleared(borrowrequest.FieldRespondedAt) {
+		fields = append(fields, borrowrequest.FieldRespondedAt)
+	}
+	if m.FieldCleared(borrowrequest.FieldHandedOverAt) {
+		fields = append(fields, borrowrequest.FieldHandedOverAt)
+	}
+	if m.FieldCleared(borrowrequest.FieldReturnedAt) {
+		fields = append(fields, borrowrequest.FieldReturnedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BorrowRequestMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BorrowRequestMutation) ClearField(name string) error {
+	switch name {
+	case borrowrequest.FieldMessage:
+		m.ClearMessage()
+		return nil
+	case borrowrequest.FieldResponseMessage:
+		m.ClearResponseMessage()
+		return nil
+	case borrowrequest.FieldDueAt:
+		m.ClearDueAt()
+		return nil
+	case borrowrequest.FieldRespondedAt:
+		m.ClearRespondedAt()
+		return nil
+	case borrowrequest.FieldHandedOverAt:
+		m.ClearHandedOverAt()
+		return nil
+	case borrowrequest.FieldReturnedAt:
+		m.ClearReturnedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BorrowRequest nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BorrowRequestMutation) ResetField(name string) error {
+	switch name {
+	case borrowrequest.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case borrowrequest.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case borrowrequest.FieldResponseMessage:
+		m.ResetResponseMessage()
+		return nil
+	case borrowrequest.FieldDueAt:
+		m.ResetDueAt()
+		return nil
+	case borrowrequest.FieldRespondedAt:
+		m.ResetRespondedAt()
+		return nil
+	case borrowrequest.FieldHandedOverAt:
+		m.ResetHandedOverAt()
+		return nil
+	case borrowrequest.FieldReturnedAt:
+		m.ResetReturnedAt()
+		return nil
+	case borrowrequest.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case borrowrequest.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BorrowRequest field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BorrowRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.requester != nil {
+		edges = append(edges, borrowrequest.EdgeRequester)
+	}
+	if m.owner != nil {
+		edges = append(edges, borrowrequest.EdgeOwner)
+	}
+	if m.book != nil {
+		edges = append(edges, borrowrequest.EdgeBook)
+	}
+	if m.loan != nil {
+		edges = append(edges, borrowrequest.EdgeLoan)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BorrowRequestMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case borrowrequest.EdgeRequester:
+		if id := m.requester; id != nil {
+			return []ent.Value{*id}
+		}
+	case borrowrequest.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case borrowrequest.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	case borrowrequest.EdgeLoan:
+		if id := m.loan; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BorrowRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BorrowRequestMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BorrowRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedrequester {
+		edges = append(edges, borrowrequest.EdgeRequester)
+	}
+	if m.clearedowner {
+		edges = append(edges, borrowrequest.EdgeOwner)
+	}
+	if m.clearedbook {
+		edges = append(edges, borrowrequest.EdgeBook)
+	}
+	if m.clearedloan {
+		edges = append(edges, borrowrequest.EdgeLoan)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BorrowRequestMutation) EdgeCleared(name string) bool {
+	switch name {
+	case borrowrequest.EdgeRequester:
+		return m.clearedrequester
+	case borrowrequest.EdgeOwner:
+		return m.clearedowner
+	case borrowrequest.EdgeBook:
+		return m.clearedbook
+	case borrowrequest.EdgeLoan:
+		return m.clearedloan
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BorrowRequestMutation) ClearEdge(name string) error {
+	switch name {
+	case borrowrequest.EdgeRequester:
+		m.ClearRequester()
+		return nil
+	case borrowrequest.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case borrowrequest.EdgeBook:
+		m.ClearBook()
+		return nil
+	case borrowrequest.EdgeLoan:
+		m.ClearLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown BorrowRequest unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BorrowRequestMutation) ResetEdge(name string) error {
+	switch name {
+	case borrowrequest.EdgeRequester:
+		m.ResetRequester()
+		return nil
+	case borrowrequest.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case borrowrequest.EdgeBook:
+		m.ResetBook()
+		return nil
+	case borrowrequest.EdgeLoan:
+		m.ResetLoan()
+		return nil
+	}
+	return fmt.Errorf("unknown BorrowRequest edge %s", name)
+}
+
+// DataMigrationMutation represents an operation that mutates the DataMigration nodes in the graph.
+type DataMigrationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	applied_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DataMigration, error)
+	predicates    []predicate.DataMigration
+}
+
+var _ ent.Mutation = (*DataMigrationMutation)(nil)
+
+// datamigrationOption allows management of the mutation configuration using functional options.
+type datamigrationOption func(*DataMigrationMutation)
+
+// newDataMigrationMutation creates new mutation for the DataMigration entity.
+func newDataMigrationMutation(c config, op Op, opts ...datamigrationOption) *DataMigrationMutation {
+	m := &DataMigrationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDataMigration,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDataMigrationID sets the ID field of the mutation.
+func withDataMigrationID(id uuid.UUID) datamigrationOption {
+	return func(m *DataMigrationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DataMigration
+		)
+		m.oldValue = func(ctx context.Context) (*DataMigration, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DataMigration.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDataMigration sets the old DataMigration of the mutation.
+func withDataMigration(node *DataMigration) datamigrationOption {
+	return func(m *DataMigrationMutation) {
+		m.oldValue = func(context.Context) (*DataMigration, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DataMigrationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DataMigrationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DataMigration entities.
+func (m *DataMigrationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DataMigrationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DataMigrationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DataMigration.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *DataMigrationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DataMigrationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DataMigrationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DataMigrationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetAppliedAt sets the "applied_at" field.
+func (m *DataMigrationMutation) SetAppliedAt(t time.Time) {
+	m.applied_at = &t
+}
+
+// AppliedAt returns the value of the "applied_at" field in the mutation.
+func (m *DataMigrationMutation) AppliedAt() (r time.Time, exists bool) {
+	v := m.applied_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppliedAt returns the old "applied_at" field's value of the DataMigration entity.
+// If the DataMigration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DataMigrationMutation) OldAppliedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppliedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppliedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppliedAt: %w", err)
+	}
+	return oldValue.AppliedAt, nil
+}
+
+// ResetAppliedAt resets all changes to the "applied_at" field.
+func (m *DataMigrationMutation) ResetAppliedAt() {
+	m.applied_at = nil
+}
+
+// Where appends a list predicates to the DataMigrationMutation builder.
+func (m *DataMigrationMutation) Where(ps ...predicate.DataMigration) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DataMigrationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DataMigrationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DataMigration, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DataMigrationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DataMigrationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DataMigration).
+func (m *DataMigrationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DataMigrationMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, datamigration.FieldName)
+	}
+	if m.applied_at != nil {
+		fields = append(fields, datamigration.FieldAppliedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DataMigrationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case datamigration.FieldName:
+		return m.Name()
+	case datamigration.FieldAppliedAt:
+		return m.AppliedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DataMigrationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case datamigration.FieldName:
+		return m.OldName(ctx)
+	case datamigration.FieldAppliedAt:
+		return m.OldAppliedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown DataMigration field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DataMigrationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case datamigration.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case datamigration.FieldAppliedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppliedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DataMigration field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DataMigrationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DataMigrationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DataMigrationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DataMigration numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DataMigrationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DataMigrationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DataMigrationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DataMigration nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DataMigrationMutation) ResetField(name string) error {
+	switch name {
+	case datamigration.FieldName:
+		m.ResetName()
+		return nil
+	case datamigration.FieldAppliedAt:
+		m.ResetAppliedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DataMigration field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DataMigrationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DataMigrationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DataMigrationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DataMigrationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DataMigrationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DataMigrationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DataMigrationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DataMigration unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DataMigrationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DataMigration edge %s", name)
+}
+
+// EmailVerificationMutation represents an operation that mutates the EmailVerification nodes in the graph.
+type EmailVerificationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	email         *string
+	code          *string
+	expires_at    *time.Time
+	is_verified   *bool
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EmailVerification, error)
+	predicates    []predicate.EmailVerification
+}
+
+var _ ent.Mutation = (*EmailVerificationMutation)(nil)
+
+// emailverificationOption allows management of the mutation configuration using functional options.
+type emailverificationOption func(*EmailVerificationMutation)
+
+// newEmailVerificationMutation creates new mutation for the EmailVerification entity.
+func newEmailVerificationMutation(c config, op Op, opts ...emailverificationOption) *EmailVerificationMutation {
+	m := &EmailVerificationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEmailVerification,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEmailVerificationID sets the ID field of the mutation.
+func withEmailVerificationID(id uuid.UUID) emailverificationOption {
+	return func(m *EmailVerificationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EmailVerification
+		)
+		m.oldValue = func(ctx context.Context) (*EmailVerification, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EmailVerification.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEmailVerification sets the old EmailVerification of the mutation.
+func withEmailVerification(node *EmailVerification) emailverificationOption {
+	return func(m *EmailVerificationMutation) {
+		m.oldValue = func(context.Context) (*EmailVerification, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EmailVerificationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EmailVerificationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EmailVerification entities.
+func (m *EmailVerificationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EmailVerificationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EmailVerificationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EmailVerification.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *EmailVerificationMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *EmailVerificationMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *EmailVerificationMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetCode sets the "code" field.
+func (m *EmailVerificationMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *EmailVerificationMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *EmailVerificationMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *EmailVerificationMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *EmailVerificationMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *EmailVerificationMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetIsVerified sets the "is_verified" field.
+func (m *EmailVerificationMutation) SetIsVerified(b bool) {
+	m.is_verified = &b
+}
+
+// IsVerified returns the value of the "is_verified" field in the mutation.
+func (m *EmailVerificationMutation) IsVerified() (r bool, exists bool) {
+	v := m.is_verified
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVerified returns the old "is_verified" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldIsVerified(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVerified is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVerified requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVerified: %w", err)
+	}
+	return oldValue.IsVerified, nil
+}
+
+// ResetIsVerified resets all changes to the "is_verified" field.
+func (m *EmailVerificationMutation) ResetIsVerified() {
+	m.is_verified = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EmailVerificationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EmailVerificationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EmailVerification entity.
+// If the EmailVerification object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EmailVerificationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EmailVerificationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EmailVerificationMutation builder.
+func (m *EmailVerificationMutation) Where(ps ...predicate.EmailVerification) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EmailVerificationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EmailVerificationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EmailVerification, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EmailVerificationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EmailVerificationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EmailVerification).
+func (m *EmailVerificationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EmailVerificationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.email != nil {
+		fields = append(fields, emailverification.FieldEmail)
+	}
+	if m.code != nil {
+		fields = append(fields, emailverification.FieldCode)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, emailverification.FieldExpiresAt)
+	}
+	if m.is_verified != nil {
+		fields = append(fields, emailverification.FieldIsVerified)
+	}
+	if m.created_at != nil {
+		fields = append(fields, emailverification.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EmailVerificationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case emailverification.FieldEmail:
+		return m.Email()
+	case emailverification.FieldCode:
+		return m.Code()
+	case emailverification.FieldExpiresAt:
+		return m.ExpiresAt()
+	case emailverification.FieldIsVerified:
+		return m.IsVerified()
+	case emailverification.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EmailVerificationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case emailverification.FieldEmail:
+		return m.OldEmail(ctx)
+	case emailverification.FieldCode:
+		return m.OldCode(ctx)
+	case emailverification.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case emailverification.FieldIsVerified:
+		return m.OldIsVerified(ctx)
+	case emailverification.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case emailverification.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case emailverification.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case emailverification.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case emailverification.FieldIsVerified:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVerified(v)
+		return nil
+	case emailverification.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EmailVerificationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EmailVerificationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EmailVerificationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EmailVerification numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EmailVerificationMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EmailVerificationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EmailVerificationMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EmailVerification nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EmailVerificationMutation) ResetField(name string) error {
+	switch name {
+	case emailverification.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case emailverification.FieldCode:
+		m.ResetCode()
+		return nil
+	case emailverification.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case emailverification.FieldIsVerified:
+		m.ResetIsVerified()
+		return nil
+	case emailverification.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EmailVerification field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EmailVerificationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EmailVerificationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EmailVerificationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EmailVerificationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EmailVerificationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EmailVerificationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EmailVerificationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EmailVerification unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EmailVerificationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EmailVerification edge %s", name)
+}
+
+// LibraryMutation represents an operation that mutates the Library nodes in the graph.
+type LibraryMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	name               *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	members            map[uuid.UUID]struct{}
+	removedmembers     map[uuid.UUID]struct{}
+	clearedmembers     bool
+	invitations        map[uuid.UUID]struct{}
+	removedinvitations map[uuid.UUID]struct{}
+	clearedinvitations bool
+	books              map[uuid.UUID]struct{}
+	removedbooks       map[uuid.UUID]struct{}
+	clearedbooks       bool
+	done               bool
+	oldValue           func(context.Context) (*Library, error)
+	predicates         []predicate.Library
+}
+
+var _ ent.Mutation = (*LibraryMutation)(nil)
+
+// libraryOption allows management of the mutation configuration using functional options.
+type libraryOption func(*LibraryMutation)
+
+// newLibraryMutation creates new mutation for the Library entity.
+func newLibraryMutation(c config, op Op, opts ...libraryOption) *LibraryMutation {
+	m := &LibraryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLibrary,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLibraryID sets the ID field of the mutation.
+func withLibraryID(id uuid.UUID) libraryOption {
+	return func(m *LibraryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Library
+		)
+		m.oldValue = func(ctx context.Context) (*Library, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Library.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLibrary sets the old Library of the mutation.
+func withLibrary(node *Library) libraryOption {
+	return func(m *LibraryMutation) {
+		m.oldValue = func(context.Context) (*Library, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LibraryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LibraryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Library entities.
+func (m *LibraryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LibraryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LibraryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Library.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *LibraryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LibraryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Library entity.
+// If the Library object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LibraryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LibraryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LibraryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Library entity.
+// If the Library object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LibraryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LibraryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LibraryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Library entity.
+// If the Library object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LibraryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddMemberIDs adds the "members" edge to the LibraryMember entity by ids.
+func (m *LibraryMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the LibraryMember entity.
+func (m *LibraryMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the LibraryMember entity was cleared.
+func (m *LibraryMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the LibraryMember entity by IDs.
+func (m *LibraryMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the LibraryMember entity.
+func (m *LibraryMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *LibraryMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *LibraryMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddInvitationIDs adds the "invitations" edge to the LibraryInvitation entity by ids.
+func (m *LibraryMutation) AddInvitationIDs(ids ...uuid.UUID) {
+	if m.invitations == nil {
+		m.invitations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.invitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvitations clears the "invitations" edge to the LibraryInvitation entity.
+func (m *LibraryMutation) ClearInvitations() {
+	m.clearedinvitations = true
+}
+
+// InvitationsCleared reports if the "invitations" edge to the LibraryInvitation entity was cleared.
+func (m *LibraryMutation) InvitationsCleared() bool {
+	return m.clearedinvitations
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to the LibraryInvitation entity by IDs.
+func (m *LibraryMutation) RemoveInvitationIDs(ids ...uuid.UUID) {
+	if m.removedinvitations == nil {
+		m.removedinvitations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.invitations, ids[i])
+		m.removedinvitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvitations returns the removed IDs of the "invitations" edge to the LibraryInvitation entity.
+func (m *LibraryMutation) RemovedInvitationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedinvitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitationsIDs returns the "invitations" edge IDs in the mutation.
+func (m *LibraryMutation) InvitationsIDs() (ids []uuid.UUID) {
+	for id := range m.invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvitations resets all changes to the "invitations" edge.
+func (m *LibraryMutation) ResetInvitations() {
+	m.invitations = nil
+	m.clearedinvitations = false
+	m.removedinvitations = nil
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by ids.
+func (m *LibraryMutation) AddBookIDs(ids ...uuid.UUID) {
+	if m.books == nil {
+		m.books = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.books[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBooks clears the "books" edge to the Book entity.
+func (m *LibraryMutation) ClearBooks() {
+	m.clearedbooks = true
+}
+
+// BooksCleared reports if the "books" edge to the Book entity was cleared.
+func (m *LibraryMutation) BooksCleared() bool {
+	return m.clearedbooks
+}
+
+// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
+func (m *LibraryMutation) RemoveBookIDs(ids ...uuid.UUID) {
+	if m.removedbooks == nil {
+		m.removedbooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.books, ids[i])
+		m.removedbooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
+func (m *LibraryMutation) RemovedBooksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BooksIDs returns the "books" edge IDs in the mutation.
+func (m *LibraryMutation) BooksIDs() (ids []uuid.UUID) {
+	for id := range m.books {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBooks resets all changes to the "books" edge.
+func (m *LibraryMutation) ResetBooks() {
+	m.books = nil
+	m.clearedbooks = false
+	m.removedbooks = nil
+}
+
+// Where appends a list predicates to the LibraryMutation builder.
+func (m *LibraryMutation) Where(ps ...predicate.Library) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LibraryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LibraryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Library, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LibraryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LibraryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Library).
+func (m *LibraryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LibraryMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, library.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, library.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, library.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LibraryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case library.FieldName:
+		return m.Name()
+	case library.FieldCreatedAt:
+		return m.CreatedAt()
+	case library.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LibraryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case library.FieldName:
+		return m.OldName(ctx)
+	case library.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case library.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Library field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LibraryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case library.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case library.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case library.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Library field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LibraryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LibraryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LibraryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Library numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LibraryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LibraryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LibraryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Library nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LibraryMutation) ResetField(name string) error {
+	switch name {
+	case library.FieldName:
+		m.ResetName()
+		return nil
+	case library.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case library.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Library field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LibraryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.members != nil {
+		edges = append(edges, library.EdgeMembers)
+	}
+	if m.invitations != nil {
+		edges = append(edges, library.EdgeInvitations)
+	}
+	if m.books != nil {
+		edges = append(edges, library.EdgeBooks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LibraryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case library.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case library.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.invitations))
+		for id := range m.invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case library.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.books))
+		for id := range m.books {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LibraryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedmembers != nil {
+		edges = append(edges, library.EdgeMembers)
+	}
+	if m.removedinvitations != nil {
+		edges = append(edges, library.EdgeInvitations)
+	}
+	if m.removedbooks != nil {
+		edges = append(edges, library.EdgeBooks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LibraryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case library.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case library.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.removedinvitations))
+		for id := range m.removedinvitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case library.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.removedbooks))
+		for id := range m.removedbooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LibraryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedmembers {
+		edges = append(edges, library.EdgeMembers)
+	}
+	if m.clearedinvitations {
+		edges = append(edges, library.EdgeInvitations)
+	}
+	if m.clearedbooks {
+		edges = append(edges, library.EdgeBooks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LibraryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case library.EdgeMembers:
+		return m.clearedmembers
+	case library.EdgeInvitations:
+		return m.clearedinvitations
+	case library.EdgeBooks:
+		return m.clearedbooks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LibraryMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Library unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LibraryMutation) ResetEdge(name string) error {
+	switch name {
+	case library.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case library.EdgeInvitations:
+		m.ResetInvitations()
+		return nil
+	case library.EdgeBooks:
+		m.ResetBooks()
+		return nil
+	}
+	return fmt.Errorf("unknown Library edge %s", name)
+}
+
+// LibraryInvitationMutation represents an operation that mutates the LibraryInvitation nodes in the graph.
+type LibraryInvitationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	role           *libraryinvitation.Role
+	status         *libraryinvitation.Status
+	responded_at   *time.Time
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	library        *uuid.UUID
+	clearedlibrary bool
+	inviter        *uuid.UUID
+	clearedinviter bool
+	invitee        *uuid.UUID
+	clearedinvitee bool
+	done           bool
+	oldValue       func(context.Context) (*LibraryInvitation, error)
+	predicates     []predicate.LibraryInvitation
+}
+
+var _ ent.Mutation = (*LibraryInvitationMutation)(nil)
+
+// libraryinvitationOption allows management of the mutation configuration using functional options.
+type libraryinvitationOption func(*LibraryInvitationMutation)
+
+// newLibraryInvitationMutation creates new mutation for the LibraryInvitation entity.
+func newLibraryInvitationMutation(c config, op Op, opts ...libraryinvitationOption) *LibraryInvitationMutation {
+	m := &LibraryInvitationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLibraryInvitation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLibraryInvitationID sets the ID field of the mutation.
+func withLibraryInvitationID(id uuid.UUID) libraryinvitationOption {
+	return func(m *LibraryInvitationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LibraryInvitation
+		)
+		m.oldValue = func(ctx context.Context) (*LibraryInvitation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LibraryInvitation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLibraryInvitation sets the old LibraryInvitation of the mutation.
+func withLibraryInvitation(node *LibraryInvitation) libraryinvitationOption {
+	return func(m *LibraryInvitationMutation) {
+		m.oldValue = func(context.Context) (*LibraryInvitation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LibraryInvitationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LibraryInvitationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LibraryInvitation entities.
+func (m *LibraryInvitationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LibraryInvitationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LibraryInvitationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LibraryInvitation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRole sets the "role" field.
+func (m *LibraryInvitationMutation) SetRole(l libraryinvitation.Role) {
+	m.role = &l
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *LibraryInvitationMutation) Role() (r libraryinvitation.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the LibraryInvitation entity.
+// If the LibraryInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryInvitationMutation) OldRole(ctx context.Context) (v libraryinvitation.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *LibraryInvitationMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *LibraryInvitationMutation) SetStatus(l libraryinvitation.Status) {
+	m.status = &l
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *LibraryInvitationMutation) Status() (r libraryinvitation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the LibraryInvitation entity.
+// If the LibraryInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryInvitationMutation) OldStatus(ctx context.Context) (v libraryinvitation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *LibraryInvitationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (m *LibraryInvitationMutation) SetRespondedAt(t time.Time) {
+	m.responded_at = &t
+}
+
+// RespondedAt returns the value of the "responded_at" field in the mutation.
+func (m *LibraryInvitationMutation) RespondedAt() (r time.Time, exists bool) {
+	v := m.responded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRespondedAt returns the old "responded_at" field's value of the LibraryInvitation entity.
+// If the LibraryInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryInvitationMutation) OldRespondedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRespondedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRespondedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRespondedAt: %w", err)
+	}
+	return oldValue.RespondedAt, nil
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (m *LibraryInvitationMutation) ClearRespondedAt() {
+	m.responded_at = nil
+	m.clearedFields[libraryinvitation.FieldRespondedAt] = struct{}{}
+}
+
+// RespondedAtCleared returns if the "responded_at" field was cleared in this mutation.
+func (m *LibraryInvitationMutation) RespondedAtCleared() bool {
+	_, ok := m.clearedFields[libraryinvitation.FieldRespondedAt]
+	return ok
+}
+
+// ResetRespondedAt resets all changes to the "responded_at" field.
+func (m *LibraryInvitationMutation) ResetRespondedAt() {
+	m.responded_at = nil
+	delete(m.clearedFields, libraryinvitation.FieldRespondedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LibraryInvitationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LibraryInvitationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LibraryInvitation entity.
+// If the LibraryInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryInvitationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LibraryInvitationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LibraryInvitationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LibraryInvitationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LibraryInvitation entity.
+// If the LibraryInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryInvitationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LibraryInvitationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLibraryID sets the "library" edge to the Library entity by id.
+func (m *LibraryInvitationMutation) SetLibraryID(id uuid.UUID) {
+	m.library = &id
+}
+
+// ClearLibrary clears the "library" edge to the Library entity.
+func (m *LibraryInvitationMutation) ClearLibrary() {
+	m.clearedlibrary = true
+}
+
+// LibraryCleared reports if the "library" edge to the Library entity was cleared.
+func (m *LibraryInvitationMutation) LibraryCleared() bool {
+	return m.clearedlibrary
+}
+
+// LibraryID returns the "library" edge ID in the mutation.
+func (m *LibraryInvitationMutation) LibraryID() (id uuid.UUID, exists bool) {
+	if m.library != nil {
+		return *m.library, true
+	}
+	return
+}
+
+// LibraryIDs returns the "library" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LibraryID instead. It exists only for internal usage by the builders.
+func (m *LibraryInvitationMutation) LibraryIDs() (ids []uuid.UUID) {
+	if id := m.library; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLibrary resets all changes to the "library" edge.
+func (m *LibraryInvitationMutation) ResetLibrary() {
+	m.library = nil
+	m.clearedlibrary = false
+}
+
+// SetInviterID sets the "inviter" edge to the User entity by id.
+func (m *LibraryInvitationMutation) SetInviterID(id uuid.UUID) {
+	m.inviter = &id
+}
+
+// ClearInviter clears the "inviter" edge to the User entity.
+func (m *LibraryInvitationMutation) ClearInviter() {
+	m.clearedinviter = true
+}
+
+// InviterCleared reports if the "inviter" edge to the User entity was cleared.
+func (m *LibraryInvitationMutation) InviterCleared() bool {
+	return m.clearedinviter
+}
+
+// InviterID returns the "inviter" edge ID in the mutation.
+func (m *LibraryInvitationMutation) InviterID() (id uuid.UUID, exists bool) {
+	if m.inviter != nil {
+		return *m.inviter, true
+	}
+	return
+}
+
+// InviterIDs returns the "inviter" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InviterID instead. It exists only for internal usage by the builders.
+func (m *LibraryInvitationMutation) InviterIDs() (ids []uuid.UUID) {
+	if id := m.inviter; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInviter resets all changes to the "inviter" edge.
+func (m *LibraryInvitationMutation) ResetInviter() {
+	m.inviter = nil
+	m.clearedinviter = false
+}
+
+// SetInviteeID sets the "invitee" edge to the User entity by id.
+func (m *LibraryInvitationMutation) SetInviteeID(id uuid.UUID) {
+	m.invitee = &id
+}
+
+// ClearInvitee clears the "invitee" edge to the User entity.
+func (m *LibraryInvitationMutation) ClearInvitee() {
+	m.clearedinvitee = true
+}
+
+// InviteeCleared reports if the "invitee" edge to the User entity was cleared.
+func (m *LibraryInvitationMutation) InviteeCleared() bool {
+	return m.clearedinvitee
+}
+
+// InviteeID returns the "invitee" edge ID in the mutation.
+func (m *LibraryInvitationMutation) InviteeID() (id uuid.UUID, exists bool) {
+	if m.invitee != nil {
+		return *m.invitee, true
+	}
+	return
+}
+
+// InviteeIDs returns the "invitee" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// InviteeID instead. It exists only for internal usage by the builders.
+func (m *LibraryInvitationMutation) InviteeIDs() (ids []uuid.UUID) {
+	if id := m.invitee; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetInvitee resets all changes to the "invitee" edge.
+func (m *LibraryInvitationMutation) ResetInvitee() {
+	m.invitee = nil
+	m.clearedinvitee = false
+}
+
+// Where appends a list predicates to the LibraryInvitationMutation builder.
+func (m *LibraryInvitationMutation) Where(ps ...predicate.LibraryInvitation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LibraryInvitationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LibraryInvitationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LibraryInvitation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LibraryInvitationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LibraryInvitationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LibraryInvitation).
+func (m *LibraryInvitationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LibraryInvitationMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.role != nil {
+		fields = append(fields, libraryinvitation.FieldRole)
+	}
+	if m.status != nil {
+		fields = append(fields, libraryinvitation.FieldStatus)
+	}
+	if m.responded_at != nil {
+		fields = append(fields, libraryinvitation.FieldRespondedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, libraryinvitation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, libraryinvitation.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LibraryInvitationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case libraryinvitation.FieldRole:
+		return m.Role()
+	case libraryinvitation.FieldStatus:
+		return m.Status()
+	case libraryinvitation.FieldRespondedAt:
+		return m.RespondedAt()
+	case libraryinvitation.FieldCreatedAt:
+		return m.CreatedAt()
+	case libraryinvitation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LibraryInvitationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case libraryinvitation.FieldRole:
+		return m.OldRole(ctx)
+	case libraryinvitation.FieldStatus:
+		return m.OldStatus(ctx)
+	case libraryinvitation.FieldRespondedAt:
+		return m.OldRespondedAt(ctx)
+	case libraryinvitation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case libraryinvitation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LibraryInvitation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LibraryInvitationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case libraryinvitation.FieldRole:
+		v, ok := value.(libraryinvitation.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case libraryinvitation.FieldStatus:
+		v, ok := value.(libraryinvitation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case libraryinvitation.FieldRespondedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRespondedAt(v)
+		return nil
+	case libraryinvitation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case libraryinvitation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LibraryInvitation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LibraryInvitationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LibraryInvitationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LibraryInvitationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LibraryInvitation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LibraryInvitationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(libraryinvitation.FieldRespondedAt) {
+		fields = append(fields, libraryinvitation.FieldRespondedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LibraryInvitationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LibraryInvitationMutation) ClearField(name string) error {
+	switch name {
+	case libraryinvitation.FieldRespondedAt:
+		m.ClearRespondedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LibraryInvitation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LibraryInvitationMutation) ResetField(name string) error {
+	switch name {
+	case libraryinvitation.FieldRole:
+		m.ResetRole()
+		return nil
+	case libraryinvitation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case libraryinvitation.FieldRespondedAt:
+		m.ResetRespondedAt()
+		return nil
+	case libraryinvitation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case libraryinvitation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LibraryInvitation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LibraryInvitationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.library != nil {
+		edges = append(edges, libraryinvitation.EdgeLibrary)
+	}
+	if m.inviter != nil {
+		edges = append(edges, libraryinvitation.EdgeInviter)
+	}
+	if m.invitee != nil {
+		edges = append(edges, libraryinvitation.EdgeInvitee)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LibraryInvitationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case libraryinvitation.EdgeLibrary:
+		if id := m.library; id != nil {
+			return []ent.Value{*id}
+		}
+	case libraryinvitation.EdgeInviter:
+		if id := m.inviter; id != nil {
+			return []ent.Value{*id}
+		}
+	case libraryinvitation.EdgeInvitee:
+		if id := m.invitee; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LibraryInvitationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LibraryInvitationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LibraryInvitationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedlibrary {
+		edges = append(edges, libraryinvitation.EdgeLibrary)
+	}
+	if m.clearedinviter {
+		edges = append(edges, libraryinvitation.EdgeInviter)
+	}
+	if m.clearedinvitee {
+		edges = append(edges, libraryinvitation.EdgeInvitee)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LibraryInvitationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case libraryinvitation.EdgeLibrary:
+		return m.clearedlibrary
+	case libraryinvitation.EdgeInviter:
+		return m.clearedinviter
+	case libraryinvitation.EdgeInvitee:
+		return m.clearedinvitee
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LibraryInvitationMutation) ClearEdge(name string) error {
+	switch name {
+	case libraryinvitation.EdgeLibrary:
+		m.ClearLibrary()
+		return nil
+	case libraryinvitation.EdgeInviter:
+		m.ClearInviter()
+		return nil
+	case libraryinvitation.EdgeInvitee:
+		m.ClearInvitee()
+		return nil
+	}
+	return fmt.Errorf("unknown LibraryInvitation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LibraryInvitationMutation) ResetEdge(name string) error {
+	switch name {
+	case libraryinvitation.EdgeLibrary:
+		m.ResetLibrary()
+		return nil
+	case libraryinvitation.EdgeInviter:
+		m.ResetInviter()
+		return nil
+	case libraryinvitation.EdgeInvitee:
+		m.ResetInvitee()
+		return nil
+	}
+	return fmt.Errorf("unknown LibraryInvitation edge %s", name)
+}
+
+// LibraryMemberMutation represents an operation that mutates the LibraryMember nodes in the graph.
+type LibraryMemberMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	role           *librarymember.Role
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	library        *uuid.UUID
+	clearedlibrary bool
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*LibraryMember, error)
+	predicates     []predicate.LibraryMember
+}
+
+var _ ent.Mutation = (*LibraryMemberMutation)(nil)
+
+// librarymemberOption allows management of the mutation configuration using functional options.
+type librarymemberOption func(*LibraryMemberMutation)
+
+// newLibraryMemberMutation creates new mutation for the LibraryMember entity.
+func newLibraryMemberMutation(c config, op Op, opts ...librarymemberOption) *LibraryMemberMutation {
+	m := &LibraryMemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLibraryMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLibraryMemberID sets the ID field of the mutation.
+func withLibraryMemberID(id uuid.UUID) librarymemberOption {
+	return func(m *LibraryMemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LibraryMember
+		)
+		m.oldValue = func(ctx context.Context) (*LibraryMember, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LibraryMember.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLibraryMember sets the old LibraryMember of the mutation.
+func withLibraryMember(node *LibraryMember) librarymemberOption {
+	return func(m *LibraryMemberMutation) {
+		m.oldValue = func(context.Context) (*LibraryMember, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LibraryMemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LibraryMemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LibraryMember entities.
+func (m *LibraryMemberMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LibraryMemberMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LibraryMemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LibraryMember.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRole sets the "role" field.
+func (m *LibraryMemberMutation) SetRole(l librarymember.Role) {
+	m.role = &l
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *LibraryMemberMutation) Role() (r librarymember.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the LibraryMember entity.
+// If the LibraryMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryMemberMutation) OldRole(ctx context.Context) (v librarymember.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *LibraryMemberMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LibraryMemberMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LibraryMemberMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LibraryMember entity.
+// If the LibraryMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryMemberMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LibraryMemberMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LibraryMemberMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LibraryMemberMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LibraryMember entity.
+// If the LibraryMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LibraryMemberMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LibraryMemberMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLibraryID sets the "library" edge to the Library entity by id.
+func (m *LibraryMemberMutation) SetLibraryID(id uuid.UUID) {
+	m.library = &id
+}
+
+// ClearLibrary clears the "library" edge to the Library entity.
+func (m *LibraryMemberMutation) ClearLibrary() {
+	m.clearedlibrary = true
+}
+
+// LibraryCleared reports if the "library" edge to the Library entity was cleared.
+func (m *LibraryMemberMutation) LibraryCleared() bool {
+	return m.clearedlibrary
+}
+
+// LibraryID returns the "library" edge ID in the mutation.
+func (m *LibraryMemberMutation) LibraryID() (id uuid.UUID, exists bool) {
+	if m.library != nil {
+		return *m.library, true
+	}
+	return
+}
+
+// LibraryIDs returns the "library" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LibraryID instead. It exists only for internal usage by the builders.
+func (m *LibraryMemberMutation) LibraryIDs() (ids []uuid.UUID) {
+	if id := m.library; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLibrary resets all changes to the "library" edge.
+func (m *LibraryMemberMutation) ResetLibrary() {
+	m.library = nil
+	m.clearedlibrary = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *LibraryMemberMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *LibraryMemberMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *LibraryMemberMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *LibraryMemberMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *LibraryMemberMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *LibraryMemberMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the LibraryMemberMutation builder.
+func (m *LibraryMemberMutation) Where(ps ...predicate.LibraryMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LibraryMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LibraryMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LibraryMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LibraryMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LibraryMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LibraryMember).
+func (m *LibraryMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LibraryMemberMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.role != nil {
+		fields = append(fields, librarymember.FieldRole)
+	}
+	if m.created_at != nil {
+		fields = append(fields, librarymember.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, librarymember.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LibraryMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case librarymember.FieldRole:
+		return m.Role()
+	case librarymember.FieldCreatedAt:
+		return m.CreatedAt()
+	case librarymember.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LibraryMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case librarymember.FieldRole:
+		return m.OldRole(ctx)
+	case librarymember.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case librarymember.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LibraryMember field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LibraryMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case librarymember.FieldRole:
+		v, ok := value.(librarymember.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case librarymember.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case librarymember.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LibraryMember field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LibraryMemberMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LibraryMemberMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LibraryMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LibraryMember numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LibraryMemberMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LibraryMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LibraryMemberMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LibraryMember nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LibraryMemberMutation) ResetField(name string) error {
+	switch name {
+	case librarymember.FieldRole:
+		m.ResetRole()
+		return nil
+	case librarymember.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case librarymember.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LibraryMember field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LibraryMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.library != nil {
+		edges = append(edges, librarymember.EdgeLibrary)
+	}
+	if m.user != nil {
+		edges = append(edges, librarymember.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LibraryMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case librarymember.EdgeLibrary:
+		if id := m.library; id != nil {
+			return []ent.Value{*id}
+		}
+	case librarymember.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LibraryMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LibraryMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LibraryMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedlibrary {
+		edges = append(edges, librarymember.EdgeLibrary)
+	}
+	if m.cleareduser {
+		edges = append(edges, librarymember.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LibraryMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case librarymember.EdgeLibrary:
+		return m.clearedlibrary
+	case librarymember.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LibraryMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case librarymember.EdgeLibrary:
+		m.ClearLibrary()
+		return nil
+	case librarymember.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LibraryMember unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LibraryMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case librarymember.EdgeLibrary:
+		m.ResetLibrary()
+		return nil
+	case librarymember.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown LibraryMember edge %s", name)
+}
+
+// LoanMutation represents an operation that mutates the Loan nodes in the graph.
+type LoanMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	borrower_name    *string
+	borrower_contact *string
+	lent_at          *time.Time
+	due_at           *time.Time
+	returned_at      *time.Time
+	notes            *string
+	reminder_sent_at *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	owner            *uuid.UUID
+	clearedowner     bool
+	book             *uuid.UUID
+	clearedbook      bool
+	done             bool
+	oldValue         func(context.Context) (*Loan, error)
+	predicates       []predicate.Loan
+}
+
+var _ ent.Mutation = (*LoanMutation)(nil)
+
+// loanOption allows management of the mutation configuration using functional options.
+type loanOption func(*LoanMutation)
+
+// newLoanMutation creates new mutation for the Loan entity.
+func newLoanMutation(c config, op Op, opts ...loanOption) *LoanMutation {
+	m := &LoanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoanID sets the ID field of the mutation.
+func withLoanID(id uuid.UUID) loanOption {
+	return func(m *LoanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Loan
+		)
+		m.oldValue = func(ctx context.Context) (*Loan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Loan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoan sets the old Loan of the mutation.
+func withLoan(node *Loan) loanOption {
+	return func(m *LoanMutation) {
+		m.oldValue = func(context.Context) (*Loan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Loan entities.
+func (m *LoanMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoanMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoanMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Loan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBorrowerName sets the "borrower_name" field.
+func (m *LoanMutation) SetBorrowerName(s string) {
+	m.borrower_name = &s
+}
+
+// BorrowerName returns the value of the "borrower_name" field in the mutation.
+func (m *LoanMutation) BorrowerName() (r string, exists bool) {
+	v := m.borrower_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBorrowerName returns the old "borrower_name" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldBorrowerName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBorrowerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBorrowerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBorrowerName: %w", err)
+	}
+	return oldValue.BorrowerName, nil
+}
+
+// ResetBorrowerName resets all changes to the "borrower_name" field.
+func (m *LoanMutation) ResetBorrowerName() {
+	m.borrower_name = nil
+}
+
+// SetBorrowerContact sets the "borrower_contact" field.
+func (m *LoanMutation) SetBorrowerContact(s string) {
+	m.borrower_contact = &s
+}
+
+// BorrowerContact returns the value of the "borrower_contact" field in the mutation.
+func (m *LoanMutation) BorrowerContact() (r string, exists bool) {
+	v := m.borrower_contact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBorrowerContact returns the old "borrower_contact" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldBorrowerContact(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBorrowerContact is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBorrowerContact requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBorrowerContact: %w", err)
+	}
+	return oldValue.BorrowerContact, nil
+}
+
+// ClearBorrowerContact clears the value of the "borrower_contact" field.
+func (m *LoanMutation) ClearBorrowerContact() {
+	m.borrower_contact = nil
+	m.clearedFields[loan.FieldBorrowerContact] = struct{}{}
+}
+
+// BorrowerContactCleared returns if the "borrower_contact" field was cleared in this mutation.
+func (m *LoanMutation) BorrowerContactCleared() bool {
+	_, ok := m.clearedFields[loan.FieldBorrowerContact]
+	return ok
+}
+
+// ResetBorrowerContact resets all changes to the "borrower_contact" field.
+func (m *LoanMutation) ResetBorrowerContact() {
+	m.borrower_contact = nil
+	delete(m.clearedFields, loan.FieldBorrowerContact)
+}
+
+// SetLentAt sets the "lent_at" field.
+func (m *LoanMutation) SetLentAt(t time.Time) {
+	m.lent_at = &t
+}
+
+// LentAt returns the value of the "lent_at" field in the mutation.
+func (m *LoanMutation) LentAt() (r time.Time, exists bool) {
+	v := m.lent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLentAt returns the old "lent_at" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldLentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLentAt: %w", err)
+	}
+	return oldValue.LentAt, nil
+}
+
+// ResetLentAt resets all changes to the "lent_at" field.
+func (m *LoanMutation) ResetLentAt() {
+	m.lent_at = nil
+}
+
+// SetDueAt sets the "due_at" field.
+func (m *LoanMutation) SetDueAt(t time.Time) {
+	m.due_at = &t
+}
+
+// DueAt returns the value of the "due_at" field in the mutation.
+func (m *LoanMutation) DueAt() (r time.Time, exists bool) {
+	v := m.due_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueAt returns the old "due_at" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldDueAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+	}
+	return oldValue.DueAt, nil
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (m *LoanMutation) ClearDueAt() {
+	m.due_at = nil
+	m.clearedFields[loan.FieldDueAt] = struct{}{}
+}
+
+// DueAtCleared returns if the "due_at" field was cleared in this mutation.
+func (m *LoanMutation) DueAtCleared() bool {
+	_, ok := m.clearedFields[loan.FieldDueAt]
+	return ok
+}
+
+// ResetDueAt resets all changes to the "due_at" field.
+func (m *LoanMutation) ResetDueAt() {
+	m.due_at = nil
+	delete(m.clearedFields, loan.FieldDueAt)
+}
+
+// SetReturnedAt sets the "returned_at" field.
+func (m *LoanMutation) SetReturnedAt(t time.Time) {
+	m.returned_at = &t
+}
+
+// ReturnedAt returns the value of the "returned_at" field in the mutation.
+func (m *LoanMutation) ReturnedAt() (r time.Time, exists bool) {
+	v := m.returned_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReturnedAt returns the old "returned_at" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldReturnedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReturnedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReturnedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReturnedAt: %w", err)
+	}
+	return oldValue.ReturnedAt, nil
+}
+
+// ClearReturnedAt clears the value of the "returned_at" field.
+func (m *LoanMutation) ClearReturnedAt() {
+	m.returned_at = nil
+	m.clearedFields[loan.FieldReturnedAt] = struct{}{}
+}
+
+// ReturnedAtCleared returns if the "returned_at" field was cleared in this mutation.
+func (m *LoanMutation) ReturnedAtCleared() bool {
+	_, ok := m.clearedFields[loan.FieldReturnedAt]
+	return ok
+}
+
+// ResetReturnedAt resets all changes to the "returned_at" field.
+func (m *LoanMutation) ResetReturnedAt() {
+	m.returned_at = nil
+	delete(m.clearedFields, loan.FieldReturnedAt)
+}
+
+// SetNotes sets the "notes" field.
+func (m *LoanMutation) SetNotes(s string) {
+	m.notes = &s
+}
+
+// Notes returns the value of the "notes" field in the mutation.
+func (m *LoanMutation) Notes() (r string, exists bool) {
+	v := m.notes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotes returns the old "notes" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldNotes(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotes: %w", err)
+	}
+	return oldValue.Notes, nil
+}
+
+// ClearNotes clears the value of the "notes" field.
+func (m *LoanMutation) ClearNotes() {
+	m.notes = nil
+	m.clearedFields[loan.FieldNotes] = struct{}{}
+}
+
+// NotesCleared returns if the "notes" field was cleared in this mutation.
+func (m *LoanMutation) NotesCleared() bool {
+	_, ok := m.clearedFields[loan.FieldNotes]
+	return ok
+}
+
+// ResetNotes resets all changes to the "notes" field.
+func (m *LoanMutation) ResetNotes() {
+	m.notes = nil
+	delete(m.clearedFields, loan.FieldNotes)
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (m *LoanMutation) SetReminderSentAt(t time.Time) {
+	m.reminder_sent_at = &t
+}
+
+// ReminderSentAt returns the value of the "reminder_sent_at" field in the mutation.
+func (m *LoanMutation) ReminderSentAt() (r time.Time, exists bool) {
+	v := m.reminder_sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReminderSentAt returns the old "reminder_sent_at" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldReminderSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReminderSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReminderSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReminderSentAt: %w", err)
+	}
+	return oldValue.ReminderSentAt, nil
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (m *LoanMutation) ClearReminderSentAt() {
+	m.reminder_sent_at = nil
+	m.clearedFields[loan.FieldReminderSentAt] = struct{}{}
+}
+
+// ReminderSentAtCleared returns if the "reminder_sent_at" field was cleared in this mutation.
+func (m *LoanMutation) ReminderSentAtCleared() bool {
+	_, ok := m.clearedFields[loan.FieldReminderSentAt]
+	return ok
+}
+
+// ResetReminderSentAt resets all changes to the "reminder_sent_at" field.
+func (m *LoanMutation) ResetReminderSentAt() {
+	m.reminder_sent_at = nil
+	delete(m.clearedFields, loan.FieldReminderSentAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LoanMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LoanMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Loan entity.
+// If the Loan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoanMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LoanMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *LoanMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *LoanMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *LoanMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *LoanMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *LoanMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *LoanMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetBookID sets the "book" edge to the Book entity by id.
+func (m *LoanMutation) SetBookID(id uuid.UUID) {
+	m.book = &id
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *LoanMutation) ClearBook() {
+	m.clearedbook = true
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *LoanMutation) BookCleared() bool {
+	return m.clearedbook
+}
+
+// BookID returns the "book" edge ID in the mutation.
+func (m *LoanMutation) BookID() (id uuid.UUID, exists bool) {
+	if m.book != nil {
+		return *m.book, true
+	}
+	return
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *LoanMutation) BookIDs() (ids []uuid.UUID) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *LoanMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// Where appends a list predicates to the LoanMutation builder.
+func (m *LoanMutation) Where(ps ...predicate.Loan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Loan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Loan).
+func (m *LoanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoanMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.borrower_name != nil {
+		fields = append(fields, loan.FieldBorrowerName)
+	}
+	if m.borrower_contact != nil {
+		fields = append(fields, loan.FieldBorrowerContact)
+	}
+	if m.lent_at != nil {
+		fields = append(fields, loan.FieldLentAt)
+	}
+	if m.due_at != nil {
+		fields = append(fields, loan.FieldDueAt)
+	}
+	if m.returned_at != nil {
+		fields = append(fields, loan.FieldReturnedAt)
+	}
+	if m.notes != nil {
+		fields = append(fields, loan.FieldNotes)
+	}
+	if m.reminder_sent_at != nil {
+		fields = append(fields, loan.FieldReminderSentAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, loan.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, loan.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loan.FieldBorrowerName:
+		return m.BorrowerName()
+	case loan.FieldBorrowerContact:
+		return m.BorrowerContact()
+	case loan.FieldLentAt:
+		return m.LentAt()
+	case loan.FieldDueAt:
+		return m.DueAt()
+	case loan.FieldReturnedAt:
+		return m.ReturnedAt()
+	case loan.FieldNotes:
+		return m.Notes()
+	case loan.FieldReminderSentAt:
+		return m.ReminderSentAt()
+	case loan.FieldCreatedAt:
+		return m.CreatedAt()
+	case loan.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loan.FieldBorrowerName:
+		return m.OldBorrowerName(ctx)
+	case loan.FieldBorrowerContact:
+		return m.OldBorrowerContact(ctx)
+	case loan.FieldLentAt:
+		return m.OldLentAt(ctx)
+	case loan.FieldDueAt:
+		return m.OldDueAt(ctx)
+	case loan.FieldReturnedAt:
+		return m.OldReturnedAt(ctx)
+	case loan.FieldNotes:
+		return m.OldNotes(ctx)
+	case loan.FieldReminderSentAt:
+		return m.OldReminderSentAt(ctx)
+	case loan.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case loan.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Loan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loan.FieldBorrowerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBorrowerName(v)
+		return nil
+	case loan.FieldBorrowerContact:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBorrowerContact(v)
+		return nil
+	case loan.FieldLentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLentAt(v)
+		return nil
+	case loan.FieldDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueAt(v)
+		return nil
+	case loan.FieldReturnedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReturnedAt(v)
+		return nil
+	case loan.FieldNotes:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotes(v)
+		return nil
+	case loan.FieldReminderSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReminderSentAt(v)
+		return nil
+	case loan.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case loan.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Loan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoanMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoanMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Loan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(loan.FieldBorrowerContact) {
+		fields = append(fields, loan.FieldBorrowerContact)
+	}
+	if m.FieldCleared(loan.FieldDueAt) {
+		fields = append(fields, loan.FieldDueAt)
+	}
+	if m.FieldCleared(loan.FieldReturnedAt) {
+		fields = append(fields, loan.FieldReturnedAt)
+	}
+	if m.FieldCleared(loan.FieldNotes) {
+		fields = append(fields, loan.FieldNotes)
+	}
+	if m.FieldCleared(loan.FieldReminderSentAt) {
+		fields = append(fields, loan.FieldReminderSentAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoanMutation) ClearField(name string) error {
+	switch name {
+	case loan.FieldBorrowerContact:
+		m.ClearBorrowerContact()
+		return nil
+	case loan.FieldDueAt:
+		m.ClearDueAt()
+		return nil
+	case loan.FieldReturnedAt:
+		m.ClearReturnedAt()
+		return nil
+	case loan.FieldNotes:
+		m.ClearNotes()
+		return nil
+	case loan.FieldReminderSentAt:
+		m.ClearReminderSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Loan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoanMutation) ResetField(name string) error {
+	switch name {
+	case loan.FieldBorrowerName:
+		m.ResetBorrowerName()
+		return nil
+	case loan.FieldBorrowerContact:
+		m.ResetBorrowerContact()
+		return nil
+	case loan.FieldLentAt:
+		m.ResetLentAt()
+		return nil
+	case loan.FieldDueAt:
+		m.ResetDueAt()
+		return nil
+	case loan.FieldReturnedAt:
+		m.ResetReturnedAt()
+		return nil
+	case loan.FieldNotes:
+		m.ResetNotes()
+		return nil
+	case loan.FieldReminderSentAt:
+		m.ResetReminderSentAt()
+		return nil
+	case loan.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case loan.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Loan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, loan.EdgeOwner)
+	}
+	if m.book != nil {
+		edges = append(edges, loan.EdgeBook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case loan.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case loan.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoanMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, loan.EdgeOwner)
+	}
+	if m.clearedbook {
+		edges = append(edges, loan.EdgeBook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case loan.EdgeOwner:
+		return m.clearedowner
+	case loan.EdgeBook:
+		return m.clearedbook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoanMutation) ClearEdge(name string) error {
+	switch name {
+	case loan.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case loan.EdgeBook:
+		m.ClearBook()
+		return nil
+	}
+	return fmt.Errorf("unknown Loan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoanMutation) ResetEdge(name string) error {
+	switch name {
+	case loan.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case loan.EdgeBook:
+		m.ResetBook()
+		return nil
+	}
+	return fmt.Errorf("unknown Loan edge %s", name)
+}
+
+// ReadingGoalMutation represents an operation that mutates the ReadingGoal nodes in the graph.
+type ReadingGoalMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	year             *int
+	addyear          *int
+	goal_type        *readinggoal.GoalType
+	target           *int
+	addtarget        *int
+	pace_reminder    *bool
+	reminder_sent_at *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	owner            *uuid.UUID
+	clearedowner     bool
+	done             bool
+	oldValue         func(context.Context) (*ReadingGoal, error)
+	predicates       []predicate.ReadingGoal
+}
+
+var _ ent.Mutation = (*ReadingGoalMutation)(nil)
+
+// readinggoalOption allows management of the mutation configuration using functional options.
+type readinggoalOption func(*ReadingGoalMutation)
+
+// newReadingGoalMutation creates new mutation for the ReadingGoal entity.
+func newReadingGoalMutation(c config, op Op, opts ...readinggoalOption) *ReadingGoalMutation {
+	m := &ReadingGoalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReadingGoal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReadingGoalID sets the ID field of the mutation.
+func withReadingGoalID(id uuid.UUID) readinggoalOption {
+	return func(m *ReadingGoalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReadingGoal
+		)
+		m.oldValue = func(ctx context.Context) (*ReadingGoal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReadingGoal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReadingGoal sets the old ReadingGoal of the mutation.
+func withReadingGoal(node *ReadingGoal) readinggoalOption {
+	return func(m *ReadingGoalMutation) {
+		m.oldValue = func(context.Context) (*ReadingGoal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReadingGoalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReadingGoalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReadingGoal entities.
+func (m *ReadingGoalMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReadingGoalMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReadingGoalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReadingGoal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetYear sets the "year" field.
+func (m *ReadingGoalMutation) SetYear(i int) {
+	m.year = &i
+	m.addyear = nil
+}
+
+// Year returns the value of the "year" field in the mutation.
+func (m *ReadingGoalMutation) Year() (r int, exists bool) {
+	v := m.year
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldYear returns the old "year" field's value of the ReadingGoal entity.
+// If the ReadingGoal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingGoalMutation) OldYear(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldYear is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldYear requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldYear: %w", err)
+	}
+	return oldValue.Year, nil
+}
+
+// AddYear adds i to the "year" field.
+func (m *ReadingGoalMutation) AddYear(i int) {
+	if m.addyear != nil {
+		*m.addyear += i
+	} else {
+		m.addyear = &i
+	}
+}
+
+// AddedYear returns the value that was added to the "year" field in this mutation.
+func (m *ReadingGoalMutation) AddedYear() (r int, exists bool) {
+	v := m.addyear
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetYear resets all changes to the "year" field.
+func (m *ReadingGoalMutation) ResetYear() {
+	m.year = nil
+	m.addyear = nil
+}
+
+// SetGoalType sets the "goal_type" field.
+func (m *ReadingGoalMutation) SetGoalType(rt readinggoal.GoalType) {
+	m.goal_type = &rt
+}
+
+// GoalType returns the value of the "goal_type" field in the mutation.
+func (m *ReadingGoalMutation) GoalType() (r readinggoal.GoalType, exists bool) {
+	v := m.goal_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGoalType returns the old "goal_type" field's value of the ReadingGoal entity.
+// If the ReadingGoal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingGoalMutation) OldGoalType(ctx context.Context) (v readinggoal.GoalType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGoalType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGoalType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGoalType: %w", err)
+	}
+	return oldValue.GoalType, nil
+}
+
+// ResetGoalType resets all changes to the "goal_type" field.
+func (m *ReadingGoalMutation) ResetGoalType() {
+	m.goal_type = nil
+}
+
+// SetTarget sets the "target" field.
+func (m *ReadingGoalMutation) SetTarget(i int) {
+	m.target = &i
+	m.addtarget = nil
+}
+
+// Target returns the value of the "target" field in the mutation.
+func (m *ReadingGoalMutation) Target() (r int, exists bool) {
+	v := m.target
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTarget returns the old "target" field's value of the ReadingGoal entity.
+// If the ReadingGoal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingGoalMutation) OldTarget(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTarget is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTarget requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTarget: %w", err)
+	}
+	return oldValue.Target, nil
+}
+
+// AddTarget adds i to the "target" field.
+func (m *ReadingGoalMutation) AddTarget(i int) {
+	if m.addtarget != nil {
+		*m.addtarget += i
+	} else {
+		m.addtarget = &i
+	}
+}
+
+// AddedTarget returns the value that was added to the "target" field in this mutation.
+func (m *ReadingGoalMutation) AddedTarget() (r int, exists bool) {
+	v := m.addtarget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTarget resets all changes to the "target" field.
+func (m *ReadingGoalMutation) ResetTarget() {
+	m.target = nil
+	m.addtarget = nil
+}
+
+// SetPaceReminder sets the "pace_reminder" field.
+func (m *ReadingGoalMutation) SetPaceReminder(b bool) {
+	m.pace_reminder = &b
+}
+
+// PaceReminder returns the value of the "pace_reminder" field in the mutation.
+func (m *ReadingGoalMutation) PaceReminder() (r bool, exists bool) {
+	v := m.pace_reminder
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaceReminder returns the old "pace_reminder" field's value of the ReadingGoal entity.
+// If the ReadingGoal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingGoalMutation) OldPaceReminder(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaceReminder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaceReminder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaceReminder: %w", err)
+	}
+	return oldValue.PaceReminder, nil
+}
+
+// ResetPaceReminder resets all changes to the "pace_reminder" field.
+func (m *ReadingGoalMutation) ResetPaceReminder() {
+	m.pace_reminder = nil
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (m *ReadingGoalMutation) SetReminderSentAt(t time.Time) {
+	m.reminder_sent_at = &t
+}
+
+// ReminderSentAt returns the value of the "reminder_sent_at" field in the mutation.
+func (m *ReadingGoalMutation) ReminderSentAt() (r time.Time, exists bool) {
+	v := m.reminder_sent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReminderSentAt returns the old "reminder_sent_at" field's value of the ReadingGoal entity.
+// If the ReadingGoal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingGoalMutation) OldReminderSentAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReminderSentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReminderSentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReminderSentAt: %w", err)
+	}
+	return oldValue.ReminderSentAt, nil
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (m *ReadingGoalMutation) ClearReminderSentAt() {
+	m.reminder_sent_at = nil
+	m.clearedFields[readinggoal.FieldReminderSentAt] = struct{}{}
+}
+
+// ReminderSentAtCleared returns if the "reminder_sent_at" field was cleared in this mutation.
+func (m *ReadingGoalMutation) ReminderSentAtCleared() bool {
+	_, ok := m.clearedFields[readinggoal.FieldReminderSentAt]
+	return ok
+}
+
+// ResetReminderSentAt resets all changes to the "reminder_sent_at" field.
+func (m *ReadingGoalMutation) ResetReminderSentAt() {
+	m.reminder_sent_at = nil
+	delete(m.clearedFields, readinggoal.FieldReminderSentAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReadingGoalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReadingGoalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReadingGoal entity.
+// If the ReadingGoal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingGoalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReadingGoalMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReadingGoalMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReadingGoalMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ReadingGoal entity.
+// If the ReadingGoal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingGoalMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReadingGoalMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ReadingGoalMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ReadingGoalMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ReadingGoalMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ReadingGoalMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ReadingGoalMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ReadingGoalMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the ReadingGoalMutation builder.
+func (m *ReadingGoalMutation) Where(ps ...predicate.ReadingGoal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReadingGoalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReadingGoalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReadingGoal, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReadingGoalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReadingGoalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReadingGoal).
+func (m *ReadingGoalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReadingGoalMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.year != nil {
+		fields = append(fields, readinggoal.FieldYear)
+	}
+	if m.goal_type != nil {
+		fields = append(fields, readinggoal.FieldGoalType)
+	}
+	if m.target != nil {
+		fields = append(fields, readinggoal.FieldTarget)
+	}
+	if m.pace_reminder != nil {
+		fields = append(fields, readinggoal.FieldPaceReminder)
+	}
+	if m.reminder_sent_at != nil {
+		fields = append(fields, readinggoal.FieldReminderSentAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, readinggoal.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, readinggoal.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReadingGoalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case readinggoal.FieldYear:
+		return m.Year()
+	case readinggoal.FieldGoalType:
+		return m.GoalType()
+	case readinggoal.FieldTarget:
+		return m.Target()
+	case readinggoal.FieldPaceReminder:
+		return m.PaceReminder()
+	case readinggoal.FieldReminderSentAt:
+		return m.ReminderSentAt()
+	case readinggoal.FieldCreatedAt:
+		return m.CreatedAt()
+	case readinggoal.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReadingGoalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case readinggoal.FieldYear:
+		return m.OldYear(ctx)
+	case readinggoal.FieldGoalType:
+		return m.OldGoalType(ctx)
+	case readinggoal.FieldTarget:
+		return m.OldTarget(ctx)
+	case readinggoal.FieldPaceReminder:
+		return m.OldPaceReminder(ctx)
+	case readinggoal.FieldReminderSentAt:
+		return m.OldReminderSentAt(ctx)
+	case readinggoal.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case readinggoal.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReadingGoal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadingGoalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case readinggoal.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetYear(v)
+		return nil
+	case readinggoal.FieldGoalType:
+		v, ok := value.(readinggoal.GoalType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGoalType(v)
+		return nil
+	case readinggoal.FieldTarget:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTarget(v)
+		return nil
+	case readinggoal.FieldPaceReminder:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaceReminder(v)
+		return nil
+	case readinggoal.FieldReminderSentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReminderSentAt(v)
+		return nil
+	case readinggoal.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case readinggoal.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingGoal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReadingGoalMutation) AddedFields() []string {
+	var fields []string
+	if m.addyear != nil {
+		fields = append(fields, readinggoal.FieldYear)
+	}
+	if m.addtarget != nil {
+		fields = append(fields, readinggoal.FieldTarget)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReadingGoalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case readinggoal.FieldYear:
+		return m.AddedYear()
+	case readinggoal.FieldTarget:
+		return m.AddedTarget()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadingGoalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case readinggoal.FieldYear:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddYear(v)
+		return nil
+	case readinggoal.FieldTarget:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTarget(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingGoal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReadingGoalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(readinggoal.FieldReminderSentAt) {
+		fields = append(fields, readinggoal.FieldReminderSentAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReadingGoalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReadingGoalMutation) ClearField(name string) error {
+	switch name {
+	case readinggoal.FieldReminderSentAt:
+		m.ClearReminderSentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingGoal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReadingGoalMutation) ResetField(name string) error {
+	switch name {
+	case readinggoal.FieldYear:
+		m.ResetYear()
+		return nil
+	case readinggoal.FieldGoalType:
+		m.ResetGoalType()
+		return nil
+	case readinggoal.FieldTarget:
+		m.ResetTarget()
+		return nil
+	case readinggoal.FieldPaceReminder:
+		m.ResetPaceReminder()
+		return nil
+	case readinggoal.FieldReminderSentAt:
+		m.ResetReminderSentAt()
+		return nil
+	case readinggoal.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case readinggoal.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingGoal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReadingGoalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, readinggoal.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReadingGoalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case readinggoal.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReadingGoalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReadingGoalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReadingGoalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, readinggoal.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReadingGoalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case readinggoal.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReadingGoalMutation) ClearEdge(name string) error {
+	switch name {
+	case readinggoal.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingGoal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReadingGoalMutation) ResetEdge(name string) error {
+	switch name {
+	case readinggoal.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingGoal edge %s", name)
+}
+
+// ReadingReminderMutation represents an operation that mutates the ReadingReminder nodes in the graph.
+type ReadingReminderMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	reminder_time *string
+	day_of_week   *readingreminder.DayOfWeek
+	is_enabled    *bool
+	message       *string
+	version       *int
+	addversion    *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	done          bool
+	oldValue      func(context.Context) (*ReadingReminder, error)
+	predicates    []predicate.ReadingReminder
+}
+
+var _ ent.Mutation = (*ReadingReminderMutation)(nil)
+
+// readingreminderOption allows management of the mutation configuration using functional options.
+type readingreminderOption func(*ReadingReminderMutation)
+
+// newReadingReminderMutation creates new mutation for the ReadingReminder entity.
+func newReadingReminderMutation(c config, op Op, opts ...readingreminderOption) *ReadingReminderMutation {
+	m := &ReadingReminderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReadingReminder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReadingReminderID sets the ID field of the mutation.
+func withReadingReminderID(id uuid.UUID) readingreminderOption {
+	return func(m *ReadingReminderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReadingReminder
+		)
+		m.oldValue = func(ctx context.Context) (*ReadingReminder, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReadingReminder.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReadingReminder sets the old ReadingReminder of the mutation.
+func withReadingReminder(node *ReadingReminder) readingreminderOption {
+	return func(m *ReadingReminderMutation) {
+		m.oldValue = func(context.Context) (*ReadingReminder, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReadingReminderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReadingReminderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReadingReminder entities.
+func (m *ReadingReminderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReadingReminderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReadingReminderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReadingReminder.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetReminderTime sets the "reminder_time" field.
+func (m *ReadingReminderMutation) SetReminderTime(s string) {
+	m.reminder_time = &s
+}
+
+// ReminderTime returns the value of the "reminder_time" field in the mutation.
+func (m *ReadingReminderMutation) ReminderTime() (r string, exists bool) {
+	v := m.reminder_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReminderTime returns the old "reminder_time" field's value of the ReadingReminder entity.
+// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingReminderMutation) OldReminderTime(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReminderTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReminderTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReminderTime: %w", err)
+	}
+	return oldValue.ReminderTime, nil
+}
+
+// ResetReminderTime resets all changes to the "reminder_time" field.
+func (m *ReadingReminderMutation) ResetReminderTime() {
+	m.reminder_time = nil
+}
+
+// SetDayOfWeek sets the "day_of_week" field.
+func (m *ReadingReminderMutation) SetDayOfWeek(row readingreminder.DayOfWeek) {
+	m.day_of_week = &row
+}
+
+// DayOfWeek returns the value of the "day_of_week" field in the mutation.
+func (m *ReadingReminderMutation) DayOfWeek() (r readingreminder.DayOfWeek, exists bool) {
+	v := m.day_of_week
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDayOfWeek returns the old "day_of_week" field's value of the ReadingReminder entity.
+// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingReminderMutation) OldDayOfWeek(ctx context.Context) (v readingreminder.DayOfWeek, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDayOfWeek is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDayOfWeek requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDayOfWeek: %w", err)
+	}
+	return oldValue.DayOfWeek, nil
+}
+
+// ResetDayOfWeek resets all changes to the "day_of_week" field.
+func (m *ReadingReminderMutation) ResetDayOfWeek() {
+	m.day_of_week = nil
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (m *ReadingReminderMutation) SetIsEnabled(b bool) {
+	m.is_enabled = &b
+}
+
+// IsEnabled returns the value of the "is_enabled" field in the mutation.
+func (m *ReadingReminderMutation) IsEnabled() (r bool, exists bool) {
+	v := m.is_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEnabled returns the old "is_enabled" field's value of the ReadingReminder entity.
+// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingReminderMutation) OldIsEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnabled: %w", err)
+	}
+	return oldValue.IsEnabled, nil
+}
+
+// ResetIsEnabled resets all changes to the "is_enabled" field.
+func (m *ReadingReminderMutation) ResetIsEnabled() {
+	m.is_enabled = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *ReadingReminderMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *ReadingReminderMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the ReadingReminder entity.
+// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingReminderMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *ReadingReminderMutation) ResetMessage() {
+	m.message = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *ReadingReminderMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ReadingReminderMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the ReadingReminder entity.
+// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingReminderMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *ReadingReminderMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ReadingReminderMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ReadingReminderMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReadingReminderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReadingReminderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ReadingReminder entity.
+// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingReminderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReadingReminderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReadingReminderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReadingReminderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ReadingReminder entity.
+// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingReminderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReadingReminderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ReadingReminderMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ReadingReminderMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ReadingReminderMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ReadingReminderMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ReadingReminderMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ReadingReminderMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the ReadingReminderMutation builder.
+func (m *ReadingReminderMutation) Where(ps ...predicate.ReadingReminder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReadingReminderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReadingReminderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReadingReminder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReadingReminderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReadingReminderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReadingReminder).
+func (m *ReadingReminderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReadingReminderMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.reminder_time != nil {
+		fields = append(fields, readingreminder.FieldReminderTime)
+	}
+	if m.day_of_week != nil {
+		fields = append(fields, readingreminder.FieldDayOfWeek)
+	}
+	if m.is_enabled != nil {
+		fields = append(fields, readingreminder.FieldIsEnabled)
+	}
+	if m.message != nil {
+		fields = append(fields, readingreminder.FieldMessage)
+	}
+	if m.version != nil {
+		fields = append(fields, readingreminder.FieldVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, readingreminder.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, readingreminder.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReadingReminderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case readingreminder.FieldReminderTime:
+		return m.ReminderTime()
+	case readingreminder.FieldDayOfWeek:
+		return m.DayOfWeek()
+	case readingreminder.FieldIsEnabled:
+		return m.IsEnabled()
+	case readingreminder.FieldMessage:
+		return m.Message()
+	case readingreminder.FieldVersion:
+		return m.Version()
+	case readingreminder.FieldCreatedAt:
+		return m.CreatedAt()
+	case readingreminder.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReadingReminderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case readingreminder.FieldReminderTime:
+		return m.OldReminderTime(ctx)
+	case readingreminder.FieldDayOfWeek:
+		return m.OldDayOfWeek(ctx)
+	case readingreminder.FieldIsEnabled:
+		return m.OldIsEnabled(ctx)
+	case readingreminder.FieldMessage:
+		return m.OldMessage(ctx)
+	case readingreminder.FieldVersion:
+		return m.OldVersion(ctx)
+	case readingreminder.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case readingreminder.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReadingReminder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadingReminderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case readingreminder.FieldReminderTime:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReminderTime(v)
+		return nil
+	case readingreminder.FieldDayOfWeek:
+		v, ok := value.(readingreminder.DayOfWeek)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDayOfWeek(v)
+		return nil
+	case readingreminder.FieldIsEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnabled(v)
+		return nil
+	case readingreminder.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case readingreminder.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case readingreminder.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case readingreminder.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingReminder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReadingReminderMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, readingreminder.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReadingReminderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case readingreminder.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadingReminderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case readingreminder.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingReminder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReadingReminderMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReadingReminderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReadingReminderMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ReadingReminder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReadingReminderMutation) ResetField(name string) error {
+	switch name {
+	case readingreminder.FieldReminderTime:
+		m.ResetReminderTime()
+		return nil
+	case readingreminder.FieldDayOfWeek:
+		m.ResetDayOfWeek()
+		return nil
+	case readingreminder.FieldIsEnabled:
+		m.ResetIsEnabled()
+		return nil
+	case readingreminder.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case readingreminder.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case readingreminder.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case readingreminder.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingReminder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReadingReminderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, readingreminder.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReadingReminderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case readingreminder.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReadingReminderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReadingReminderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReadingReminderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, readingreminder.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReadingReminderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case readingreminder.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReadingReminderMutation) ClearEdge(name string) error {
+	switch name {
+	case readingreminder.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingReminder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReadingReminderMutation) ResetEdge(name string) error {
+	switch name {
+	case readingreminder.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingReminder edge %s", name)
+}
+
+// ReadingSessionMutation represents an operation that mutates the ReadingSession nodes in the graph.
+type ReadingSessionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	start_page    *int
+	addstart_page *int
+	end_page      *int
+	addend_page   *int
+	pages_read    *int
+	addpages_read *int
+	started_at    *time.Time
+	ended_at      *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	book          *uuid.UUID
+	clearedbook   bool
+	done          bool
+	oldValue      func(context.Context) (*ReadingSession, error)
+	predicates    []predicate.ReadingSession
+}
+
+var _ ent.Mutation = (*ReadingSessionMutation)(nil)
+
+// readingsessionOption allows management of the mutation configuration using functional options.
+type readingsessionOption func(*ReadingSessionMutation)
+
+// newReadingSessionMutation creates new mutation for the ReadingSession entity.
+func newReadingSessionMutation(c config, op Op, opts ...readingsessionOption) *ReadingSessionMutation {
+	m := &ReadingSessionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReadingSession,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReadingSessionID sets the ID field of the mutation.
+func withReadingSessionID(id uuid.UUID) readingsessionOption {
+	return func(m *ReadingSessionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ReadingSession
+		)
+		m.oldValue = func(ctx context.Context) (*ReadingSession, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ReadingSession.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReadingSession sets the old ReadingSession of the mutation.
+func withReadingSession(node *ReadingSession) readingsessionOption {
+	return func(m *ReadingSessionMutation) {
+		m.oldValue = func(context.Context) (*ReadingSession, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReadingSessionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReadingSessionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ReadingSession entities.
+func (m *ReadingSessionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReadingSessionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReadingSessionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReadingSession.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStartPage sets the "start_page" field.
+func (m *ReadingSessionMutation) SetStartPage(i int) {
+	m.start_page = &i
+	m.addstart_page = nil
+}
+
+// StartPage returns the value of the "start_page" field in the mutation.
+func (m *ReadingSessionMutation) StartPage() (r int, exists bool) {
+	v := m.start_page
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartPage returns the old "start_page" field's value of the ReadingSession entity.
+// If the ReadingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingSessionMutation) OldStartPage(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartPage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartPage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartPage: %w", err)
+	}
+	return oldValue.StartPage, nil
+}
+
+// AddStartPage adds i to the "start_page" field.
+func (m *ReadingSessionMutation) AddStartPage(i int) {
+	if m.addstart_page != nil {
+		*m.addstart_page += i
+	} else {
+		m.addstart_page = &i
+	}
+}
+
+// AddedStartPage returns the value that was added to the "start_page" field in this mutation.
+func (m *ReadingSessionMutation) AddedStartPage() (r int, exists bool) {
+	v := m.addstart_page
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStartPage resets all changes to the "start_page" field.
+func (m *ReadingSessionMutation) ResetStartPage() {
+	m.start_page = nil
+	m.addstart_page = nil
+}
+
+// SetEndPage sets the "end_page" field.
+func (m *ReadingSessionMutation) SetEndPage(i int) {
+	m.end_page = &i
+	m.addend_page = nil
+}
+
+// EndPage returns the value of the "end_page" field in the mutation.
+func (m *ReadingSessionMutation) EndPage() (r int, exists bool) {
+	v := m.end_page
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndPage returns the old "end_page" field's value of the ReadingSession entity.
+// If the ReadingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingSessionMutation) OldEndPage(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndPage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndPage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndPage: %w", err)
+	}
+	return oldValue.EndPage, nil
+}
+
+// AddEndPage adds i to the "end_page" field.
+func (m *ReadingSessionMutation) AddEndPage(i int) {
+	if m.addend_page != nil {
+		*m.addend_page += i
+	} else {
+		m.addend_page = &i
+	}
+}
+
+// AddedEndPage returns the value that was added to the "end_page" field in this mutation.
+func (m *ReadingSessionMutation) AddedEndPage() (r int, exists bool) {
+	v := m.addend_page
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearEndPage clears the value of the "end_page" field.
+func (m *ReadingSessionMutation) ClearEndPage() {
+	m.end_page = nil
+	m.addend_page = nil
+	m.clearedFields[readingsession.FieldEndPage] = struct{}{}
+}
+
+// EndPageCleared returns if the "end_page" field was cleared in this mutation.
+func (m *ReadingSessionMutation) EndPageCleared() bool {
+	_, ok := m.clearedFields[readingsession.FieldEndPage]
+	return ok
+}
+
+// ResetEndPage resets all changes to the "end_page" field.
+func (m *ReadingSessionMutation) ResetEndPage() {
+	m.end_page = nil
+	m.addend_page = nil
+	delete(m.clearedFields, readingsession.FieldEndPage)
+}
+
+// SetPagesRead sets the "pages_read" field.
+func (m *ReadingSessionMutation) SetPagesRead(i int) {
+	m.pages_read = &i
+	m.addpages_read = nil
+}
+
+// PagesRead returns the value of the "pages_read" field in the mutation.
+func (m *ReadingSessionMutation) PagesRead() (r int, exists bool) {
+	v := m.pages_read
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPagesRead returns the old "pages_read" field's value of the ReadingSession entity.
+// If the ReadingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingSessionMutation) OldPagesRead(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPagesRead is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPagesRead requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPagesRead: %w", err)
+	}
+	return oldValue.PagesRead, nil
+}
+
+// AddPagesRead adds i to the "pages_read" field.
+func (m *ReadingSessionMutation) AddPagesRead(i int) {
+	if m.addpages_read != nil {
+		*m.addpages_read += i
+	} else {
+		m.addpages_read = &i
+	}
+}
+
+// AddedPagesRead returns the value that was added to the "pages_read" field in this mutation.
+func (m *ReadingSessionMutation) AddedPagesRead() (r int, exists bool) {
+	v := m.addpages_read
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPagesRead resets all changes to the "pages_read" field.
+func (m *ReadingSessionMutation) ResetPagesRead() {
+	m.pages_read = nil
+	m.addpages_read = nil
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *ReadingSessionMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *ReadingSessionMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartedAt returns the old "started_at" field's value of the ReadingSession entity.
+// If the ReadingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingSessionMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *ReadingSessionMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (m *ReadingSessionMutation) SetEndedAt(t time.Time) {
+	m.ended_at = &t
+}
+
+// EndedAt returns the value of the "ended_at" field in the mutation.
+func (m *ReadingSessionMutation) EndedAt() (r time.Time, exists bool) {
+	v := m.ended_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndedAt returns the old "ended_at" field's value of the ReadingSession entity.
+// If the ReadingSession object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReadingSessionMutation) OldEndedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndedAt: %w", err)
+	}
+	return oldValue.EndedAt, nil
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (m *ReadingSessionMutation) ClearEndedAt() {
+	m.ended_at = nil
+	m.clearedFields[readingsession.FieldEndedAt] = struct{}{}
+}
+
+// EndedAtCleared returns if the "ended_at" field was cleared in this mutation.
+func (m *ReadingSessionMutation) EndedAtCleared() bool {
+	_, ok := m.clearedFields[readingsession.FieldEndedAt]
+	return ok
+}
+
+// ResetEndedAt resets all changes to the "ended_at" field.
+func (m *ReadingSessionMutation) ResetEndedAt() {
+	m.ended_at = nil
+	delete(m.clearedFields, readingsession.FieldEndedAt)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ReadingSessionMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ReadingSessionMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ReadingSessionMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ReadingSessionMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ReadingSessionMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ReadingSessionMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetBookID sets the "book" edge to the Book entity by id.
+func (m *ReadingSessionMutation) SetBookID(id uuid.UUID) {
+	m.book = &id
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *ReadingSessionMutation) ClearBook() {
+	m.clearedbook = true
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *ReadingSessionMutation) BookCleared() bool {
+	return m.clearedbook
+}
+
+// BookID returns the "book" edge ID in the mutation.
+func (m *ReadingSessionMutation) BookID() (id uuid.UUID, exists bool) {
+	if m.book != nil {
+		return *m.book, true
+	}
+	return
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *ReadingSessionMutation) BookIDs() (ids []uuid.UUID) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *ReadingSessionMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// Where appends a list predicates to the ReadingSessionMutation builder.
+func (m *ReadingSessionMutation) Where(ps ...predicate.ReadingSession) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReadingSessionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReadingSessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReadingSession, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReadingSessionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReadingSessionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ReadingSession).
+func (m *ReadingSessionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReadingSessionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.start_page != nil {
+		fields = append(fields, readingsession.FieldStartPage)
+	}
+	if m.end_page != nil {
+		fields = append(fields, readingsession.FieldEndPage)
+	}
+	if m.pages_read != nil {
+		fields = append(fields, readingsession.FieldPagesRead)
+	}
+	if m.started_at != nil {
+		fields = append(fields, readingsession.FieldStartedAt)
+	}
+	if m.ended_at != nil {
+		fields = append(fields, readingsession.FieldEndedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReadingSessionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case readingsession.FieldStartPage:
+		return m.StartPage()
+	case readingsession.FieldEndPage:
+		return m.EndPage()
+	case readingsession.FieldPagesRead:
+		return m.PagesRead()
+	case readingsession.FieldStartedAt:
+		return m.StartedAt()
+	case readingsession.FieldEndedAt:
+		return m.EndedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReadingSessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case readingsession.FieldStartPage:
+		return m.OldStartPage(ctx)
+	case readingsession.FieldEndPage:
+		return m.OldEndPage(ctx)
+	case readingsession.FieldPagesRead:
+		return m.OldPagesRead(ctx)
+	case readingsession.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case readingsession.FieldEndedAt:
+		return m.OldEndedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ReadingSession field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadingSessionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case readingsession.FieldStartPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartPage(v)
+		return nil
+	case readingsession.FieldEndPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndPage(v)
+		return nil
+	case readingsession.FieldPagesRead:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPagesRead(v)
+		return nil
+	case readingsession.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case readingsession.FieldEndedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingSession field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReadingSessionMutation) AddedFields() []string {
+	var fields []string
+	if m.addstart_page != nil {
+		fields = append(fields, readingsession.FieldStartPage)
+	}
+	if m.addend_page != nil {
+		fields = append(fields, readingsession.FieldEndPage)
+	}
+	if m.addpages_read != nil {
+		fields = append(fields, readingsession.FieldPagesRead)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReadingSessionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case readingsession.FieldStartPage:
+		return m.AddedStartPage()
+	case readingsession.FieldEndPage:
+		return m.AddedEndPage()
+	case readingsession.FieldPagesRead:
+		return m.AddedPagesRead()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReadingSessionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case readingsession.FieldStartPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStartPage(v)
+		return nil
+	case readingsession.FieldEndPage:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEndPage(v)
+		return nil
+	case readingsession.FieldPagesRead:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPagesRead(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingSession numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReadingSessionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(readingsession.FieldEndPage) {
+		fields = append(fields, readingsession.FieldEndPage)
+	}
+	if m.FieldCleared(readingsession.FieldEndedAt) {
+		fields = append(fields, readingsession.FieldEndedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReadingSessionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReadingSessionMutation) ClearField(name string) error {
+	switch name {
+	case readingsession.FieldEndPage:
+		m.ClearEndPage()
+		return nil
+	case readingsession.FieldEndedAt:
+		m.ClearEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingSession nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReadingSessionMutation) ResetField(name string) error {
+	switch name {
+	case readingsession.FieldStartPage:
+		m.ResetStartPage()
+		return nil
+	case readingsession.FieldEndPage:
+		m.ResetEndPage()
+		return nil
+	case readingsession.FieldPagesRead:
+		m.ResetPagesRead()
+		return nil
+	case readingsession.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case readingsession.FieldEndedAt:
+		m.ResetEndedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingSession field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReadingSessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, readingsession.EdgeOwner)
+	}
+	if m.book != nil {
+		edges = append(edges, readingsession.EdgeBook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReadingSessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case readingsession.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case readingsession.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReadingSessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReadingSessionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReadingSessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, readingsession.EdgeOwner)
+	}
+	if m.clearedbook {
+		edges = append(edges, readingsession.EdgeBook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReadingSessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case readingsession.EdgeOwner:
+		return m.clearedowner
+	case readingsession.EdgeBook:
+		return m.clearedbook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReadingSessionMutation) ClearEdge(name string) error {
+	switch name {
+	case readingsession.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case readingsession.EdgeBook:
+		m.ClearBook()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingSession unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReadingSessionMutation) ResetEdge(name string) error {
+	switch name {
+	case readingsession.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case readingsession.EdgeBook:
+		m.ResetBook()
+		return nil
+	}
+	return fmt.Errorf("unknown ReadingSession edge %s", name)
+}
+
+// ReviewMutation represents an operation that mutates the Review nodes in the graph.
+type ReviewMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	book_isbn      *string
+	content        *string
+	rating         *int
+	addrating      *int
+	is_public      *bool
+	version        *int
+	addversion     *int
+	created_at     *time.Time
+	updated_at     *time.Time
+	deleted_at     *time.Time
+	clearedFields  map[string]struct{}
+	owner          *uuid.UUID
+	clearedowner   bool
+	book           *uuid.UUID
+	clearedbook    bool
+	catalog        *uuid.UUID
+	clearedcatalog bool
+	done           bool
+	oldValue       func(context.Context) (*Review, error)
+	predicates     []predicate.Review
+}
+
+var _ ent.Mutation = (*ReviewMutation)(nil)
+
+// reviewOption allows management of the mutation configuration using functional options.
+type reviewOption func(*ReviewMutation)
+
+// newReviewMutation creates new mutation for the Review entity.
+func newReviewMutation(c config, op Op, opts ...reviewOption) *ReviewMutation {
+	m := &ReviewMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeReview,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withReviewID sets the ID field of the mutation.
+func withReviewID(id uuid.UUID) reviewOption {
+	return func(m *ReviewMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Review
+		)
+		m.oldValue = func(ctx context.Context) (*Review, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Review.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withReview sets the old Review of the mutation.
+func withReview(node *Review) reviewOption {
+	return func(m *ReviewMutation) {
+		m.oldValue = func(context.Context) (*Review, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ReviewMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ReviewMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Review entities.
+func (m *ReviewMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReviewMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Review.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBookIsbn sets the "book_isbn" field.
+func (m *ReviewMutation) SetBookIsbn(s string) {
+	m.book_isbn = &s
+}
+
+// BookIsbn returns the value of the "book_isbn" field in the mutation.
+func (m *ReviewMutation) BookIsbn() (r string, exists bool) {
+	v := m.book_isbn
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBookIsbn returns the old "book_isbn" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldBookIsbn(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBookIsbn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBookIsbn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBookIsbn: %w", err)
+	}
+	return oldValue.BookIsbn, nil
+}
+
+// ResetBookIsbn resets all changes to the "book_isbn" field.
+func (m *ReviewMutation) ResetBookIsbn() {
+	m.book_isbn = nil
+}
+
+// SetContent sets the "content" field.
+func (m *ReviewMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *ReviewMutation) Content() (r string, exists bool) {
+	v := m.content
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContent returns the old "content" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldContent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	}
+	return oldValue.Content, nil
+}
+
+// ResetContent resets all changes to the "content" field.
+func (m *ReviewMutation) ResetContent() {
+	m.content = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *ReviewMutation) SetRating(i int) {
+	m.rating = &i
+	m.addrating = nil
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *ReviewMutation) Rating() (r int, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldRating(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// AddRating adds i to the "rating" field.
+func (m *ReviewMutation) AddRating(i int) {
+	if m.addrating != nil {
+		*m.addrating += i
+	} else {
+		m.addrating = &i
+	}
+}
+
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *ReviewMutation) AddedRating() (r int, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *ReviewMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetIsPublic sets the "is_public" field.
+func (m *ReviewMutation) SetIsPublic(b bool) {
+	m.is_public = &b
+}
+
+// IsPublic returns the value of the "is_public" field in the mutation.
+func (m *ReviewMutation) IsPublic() (r bool, exists bool) {
+	v := m.is_public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPublic returns the old "is_public" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
+}
+
+// ResetIsPublic resets all changes to the "is_public" field.
+func (m *ReviewMutation) ResetIsPublic() {
+	m.is_public = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *ReviewMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *ReviewMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *ReviewMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *ReviewMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *ReviewMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ReviewMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ReviewMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ReviewMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReviewMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReviewMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReviewMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ReviewMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ReviewMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Review entity.
+// If the Review object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReviewMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ReviewMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[review.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ReviewMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[review.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ReviewMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, review.FieldDeletedAt)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ReviewMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ReviewMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ReviewMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ReviewMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ReviewMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ReviewMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// SetBookID sets the "book" edge to the Book entity by id.
+func (m *ReviewMutation) SetBookID(id uuid.UUID) {
+	m.book = &id
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *ReviewMutation) ClearBook() {
+	m.clearedbook = true
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *ReviewMutation) BookCleared() bool {
+	return m.clearedbook
+}
+
+// BookID returns the "book" edge ID in the mutation.
+func (m *ReviewMutation) BookID() (id uuid.UUID, exists bool) {
+	if m.book != nil {
+		return *m.book, true
+	}
+	return
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *ReviewMutation) BookIDs() (ids []uuid.UUID) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *ReviewMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// SetCatalogID sets the "catalog" edge to the BookCatalog entity by id.
+func (m *ReviewMutation) SetCatalogID(id uuid.UUID) {
+	m.catalog = &id
+}
+
+// ClearCatalog clears the "catalog" edge to the BookCatalog entity.
+func (m *ReviewMutation) ClearCatalog() {
+	m.clearedcatalog = true
+}
+
+// CatalogCleared reports if the "catalog" edge to the BookCatalog entity was cleared.
+func (m *ReviewMutation) CatalogCleared() bool {
+	return m.clearedcatalog
+}
+
+// CatalogID returns the "catalog" edge ID in the mutation.
+func (m *ReviewMutation) CatalogID() (id uuid.UUID, exists bool) {
+	if m.catalog != nil {
+		return *m.catalog, true
+	}
+	return
+}
+
+// CatalogIDs returns the "catalog" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CatalogID instead. It exists only for internal usage by the builders.
+func (m *ReviewMutation) CatalogIDs() (ids []uuid.UUID) {
+	if id := m.catalog; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCatalog resets all changes to the "catalog" edge.
+func (m *ReviewMutation) ResetCatalog() {
+	m.catalog = nil
+	m.clearedcatalog = false
+}
+
+// Where appends a list predicates to the ReviewMutation builder.
+func (m *ReviewMutation) Where(ps ...predicate.Review) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ReviewMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ReviewMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Review, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ReviewMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ReviewMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Review).
+func (m *ReviewMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ReviewMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.book_isbn != nil {
+		fields = append(fields, review.FieldBookIsbn)
+	}
+	if m.content != nil {
+		fields = append(fields, review.FieldContent)
+	}
+	if m.rating != nil {
+		fields = append(fields, review.FieldRating)
+	}
+	if m.is_public != nil {
+		fields = append(fields, review.FieldIsPublic)
+	}
+	if m.version != nil {
+		fields = append(fields, review.FieldVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, review.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, review.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, review.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case review.FieldBookIsbn:
+		return m.BookIsbn()
+	case review.FieldContent:
+		return m.Content()
+	case review.FieldRating:
+		return m.Rating()
+	case review.FieldIsPublic:
+		return m.IsPublic()
+	case review.FieldVersion:
+		return m.Version()
+	case review.FieldCreatedAt:
+		return m.CreatedAt()
+	case review.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case review.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case review.FieldBookIsbn:
+		return m.OldBookIsbn(ctx)
+	case review.FieldContent:
+		return m.OldContent(ctx)
+	case review.FieldRating:
+		return m.OldRating(ctx)
+	case review.FieldIsPublic:
+		return m.OldIsPublic(ctx)
+	case review.FieldVersion:
+		return m.OldVersion(ctx)
+	case review.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case review.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case review.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Review field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case review.FieldBookIsbn:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookIsbn(v)
+		return nil
+	case review.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case review.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case review.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
+		return nil
+	case review.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case review.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case review.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case review.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Review field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ReviewMutation) AddedFields() []string {
+	var fields []string
+	if m.addrating != nil {
+		fields = append(fields, review.FieldRating)
+	}
+	if m.addversion != nil {
+		fields = append(fields, review.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ReviewMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case review.FieldRating:
+		return m.AddedRating()
+	case review.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ReviewMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case review.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	case review.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Review numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ReviewMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(review.FieldDeletedAt) {
+		fields = append(fields, review.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ReviewMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ReviewMutation) ClearField(name string) error {
+	switch name {
+	case review.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Review nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ReviewMutation) ResetField(name string) error {
+	switch name {
+	case review.FieldBookIsbn:
+		m.ResetBookIsbn()
+		return nil
+	case review.FieldContent:
+		m.ResetContent()
+		return nil
+	case review.FieldRating:
+		m.ResetRating()
+		return nil
+	case review.FieldIsPublic:
+		m.ResetIsPublic()
+		return nil
+	case review.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case review.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case review.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case review.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Review field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReviewMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.owner != nil {
+		edges = append(edges, review.EdgeOwner)
+	}
+	if m.book != nil {
+		edges = append(edges, review.EdgeBook)
+	}
+	if m.catalog != nil {
+		edges = append(edges, review.EdgeCatalog)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ReviewMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case review.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case review.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	case review.EdgeCatalog:
+		if id := m.catalog; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ReviewMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ReviewMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ReviewMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedowner {
+		edges = append(edges, review.EdgeOwner)
+	}
+	if m.clearedbook {
+		edges = append(edges, review.EdgeBook)
+	}
+	if m.clearedcatalog {
+		edges = append(edges, review.EdgeCatalog)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ReviewMutation) EdgeCleared(name string) bool {
+	switch name {
+	case review.EdgeOwner:
+		return m.clearedowner
+	case review.EdgeBook:
+		return m.clearedbook
+	case review.EdgeCatalog:
+		return m.clearedcatalog
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ReviewMutation) ClearEdge(name string) error {
+	switch name {
+	case review.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	case review.EdgeBook:
+		m.ClearBook()
+		return nil
+	case review.EdgeCatalog:
+		m.ClearCatalog()
+		return nil
+	}
+	return fmt.Errorf("unknown Review unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReviewMutation) ResetEdge(name string) error {
+	switch name {
+	case review.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case review.EdgeBook:
+		m.ResetBook()
+		return nil
+	case review.EdgeCatalog:
+		m.ResetCatalog()
+		return nil
+	}
+	return fmt.Errorf("unknown Review edge %s", name)
+}
+
+// ShelfMutation represents an operation that mutates the Shelf nodes in the graph.
+type ShelfMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	description   *string
+	visibility    *shelf.Visibility
+	hide_books    *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	books         map[uuid.UUID]struct{}
+	removedbooks  map[uuid.UUID]struct{}
+	clearedbooks  bool
+	done          bool
+	oldValue      func(context.Context) (*Shelf, error)
+	predicates    []predicate.Shelf
+}
+
+var _ ent.Mutation = (*ShelfMutation)(nil)
+
+// shelfOption allows management of the mutation configuration using functional options.
+type shelfOption func(*ShelfMutation)
+
+// newShelfMutation creates new mutation for the Shelf entity.
+func newShelfMutation(c config, op Op, opts ...shelfOption) *ShelfMutation {
+	m := &ShelfMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShelf,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withShelfID sets the ID field of the mutation.
+func withShelfID(id uuid.UUID) shelfOption {
+	return func(m *ShelfMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Shelf
+		)
+		m.oldValue = func(ctx context.Context) (*Shelf, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Shelf.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withShelf sets the old Shelf of the mutation.
+func withShelf(node *Shelf) shelfOption {
+	return func(m *ShelfMutation) {
+		m.oldValue = func(context.Context) (*Shelf, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShelfMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShelfMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Shelf entities.
+func (m *ShelfMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ShelfMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ShelfMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Shelf.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ShelfMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ShelfMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Shelf entity.
+// If the Shelf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShelfMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ShelfMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ShelfMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ShelfMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Shelf entity.
+// If the Shelf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShelfMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ShelfMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[shelf.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ShelfMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[shelf.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ShelfMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, shelf.FieldDescription)
+}
+
+// SetVisibility sets the "visibility" field.
+func (m *ShelfMutation) SetVisibility(s shelf.Visibility) {
+	m.visibility = &s
+}
+
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *ShelfMutation) Visibility() (r shelf.Visibility, exists bool) {
+	v := m.visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibility returns the old "visibility" field's value of the Shelf entity.
+// If the Shelf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShelfMutation) OldVisibility(ctx context.Context) (v shelf.Visibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
+	}
+	return oldValue.Visibility, nil
+}
+
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *ShelfMutation) ResetVisibility() {
+	m.visibility = nil
+}
+
+// SetHideBooks sets the "hide_books" field.
+func (m *ShelfMutation) SetHideBooks(b bool) {
+	m.hide_books = &b
+}
+
+// HideBooks returns the value of the "hide_books" field in the mutation.
+func (m *ShelfMutation) HideBooks() (r bool, exists bool) {
+	v := m.hide_books
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHideBooks returns the old "hide_books" field's value of the Shelf entity.
+// If the Shelf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShelfMutation) OldHideBooks(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHideBooks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHideBooks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHideBooks: %w", err)
+	}
+	return oldValue.HideBooks, nil
+}
+
+// ResetHideBooks resets all changes to the "hide_books" field.
+func (m *ShelfMutation) ResetHideBooks() {
+	m.hide_books = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ShelfMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShelfMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Shelf entity.
+// If the Shelf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShelfMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShelfMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ShelfMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ShelfMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Shelf entity.
+// If the Shelf object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShelfMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ShelfMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *ShelfMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *ShelfMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *ShelfMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *ShelfMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *ShelfMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *ShelfMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by ids.
+func (m *ShelfMutation) AddBookIDs(ids ...uuid.UUID) {
+	if m.books == nil {
+		m.books = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.books[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBooks clears the "books" edge to the Book entity.
+func (m *ShelfMutation) ClearBooks() {
+	m.clearedbooks = true
+}
+
+// BooksCleared reports if the "books" edge to the Book entity was cleared.
+func (m *ShelfMutation) BooksCleared() bool {
+	return m.clearedbooks
+}
+
+// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
+func (m *ShelfMutation) RemoveBookIDs(ids ...uuid.UUID) {
+	if m.removedbooks == nil {
+		m.removedbooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.books, ids[i])
+		m.removedbooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
+func (m *ShelfMutation) RemovedBooksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BooksIDs returns the "books" edge IDs in the mutation.
+func (m *ShelfMutation) BooksIDs() (ids []uuid.UUID) {
+	for id := range m.books {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBooks resets all changes to the "books" edge.
+func (m *ShelfMutation) ResetBooks() {
+	m.books = nil
+	m.clearedbooks = false
+	m.removedbooks = nil
+}
+
+// Where appends a list predicates to the ShelfMutation builder.
+func (m *ShelfMutation) Where(ps ...predicate.Shelf) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShelfMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShelfMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Shelf, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShelfMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShelfMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Shelf).
+func (m *ShelfMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShelfMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, shelf.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, shelf.FieldDescription)
+	}
+	if m.visibility != nil {
+		fields = append(fields, shelf.FieldVisibility)
+	}
+	if m.hide_books != nil {
+		fields = append(fields, shelf.FieldHideBooks)
+	}
+	if m.created_at != nil {
+		fields = append(fields, shelf.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, shelf.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShelfMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case shelf.FieldName:
+		return m.Name()
+	case shelf.FieldDescription:
+		return m.Description()
+	case shelf.FieldVisibility:
+		return m.Visibility()
+	case shelf.FieldHideBooks:
+		return m.HideBooks()
+	case shelf.FieldCreatedAt:
+		return m.CreatedAt()
+	case shelf.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShelfMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case shelf.FieldName:
+		return m.OldName(ctx)
+	case shelf.FieldDescription:
+		return m.OldDescription(ctx)
+	case shelf.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case shelf.FieldHideBooks:
+		return m.OldHideBooks(ctx)
+	case shelf.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case shelf.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Shelf field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShelfMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case shelf.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case shelf.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case shelf.FieldVisibility:
+		v, ok := value.(shelf.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case shelf.FieldHideBooks:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHideBooks(v)
+		return nil
+	case shelf.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case shelf.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Shelf field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShelfMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShelfMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShelfMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Shelf numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShelfMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(shelf.FieldDescription) {
+		fields = append(fields, shelf.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShelfMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShelfMutation) ClearField(name string) error {
+	switch name {
+	case shelf.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Shelf nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShelfMutation) ResetField(name string) error {
+	switch name {
+	case shelf.FieldName:
+		m.ResetName()
+		return nil
+	case shelf.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case shelf.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case shelf.FieldHideBooks:
+		m.ResetHideBooks()
+		return nil
+	case shelf.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case shelf.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Shelf field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShelfMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, shelf.EdgeOwner)
+	}
+	if m.books != nil {
+		edges = append(edges, shelf.EdgeBooks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShelfMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case shelf.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case shelf.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.books))
+		for id := range m.books {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShelfMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedbooks != nil {
+		edges = append(edges, shelf.EdgeBooks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShelfMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case shelf.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.removedbooks))
+		for id := range m.removedbooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShelfMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, shelf.EdgeOwner)
+	}
+	if m.clearedbooks {
+		edges = append(edges, shelf.EdgeBooks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShelfMutation) EdgeCleared(name string) bool {
+	switch name {
+	case shelf.EdgeOwner:
+		return m.clearedowner
+	case shelf.EdgeBooks:
+		return m.clearedbooks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShelfMutation) ClearEdge(name string) error {
+	switch name {
+	case shelf.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Shelf unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShelfMutation) ResetEdge(name string) error {
+	switch name {
+	case shelf.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case shelf.EdgeBooks:
+		m.ResetBooks()
+		return nil
+	}
+	return fmt.Errorf("unknown Shelf edge %s", name)
+}
+
+// ShelfBookMutation represents an operation that mutates the ShelfBook nodes in the graph.
+type ShelfBookMutation struct {
+	config
+	op            Op
+	typ           string
+	position      *int
+	addposition   *int
+	added_at      *time.Time
+	clearedFields map[string]struct{}
+	shelf         *uuid.UUID
+	clearedshelf  bool
+	book          *uuid.UUID
+	clearedbook   bool
+	done          bool
+	oldValue      func(context.Context) (*ShelfBook, error)
+	predicates    []predicate.ShelfBook
+}
+
+var _ ent.Mutation = (*ShelfBookMutation)(nil)
+
+// shelfbookOption allows management of the mutation configuration using functional options.
+type shelfbookOption func(*ShelfBookMutation)
+
+// newShelfBookMutation creates new mutation for the ShelfBook entity.
+func newShelfBookMutation(c config, op Op, opts ...shelfbookOption) *ShelfBookMutation {
+	m := &ShelfBookMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeShelfBook,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ShelfBookMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ShelfBookMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetShelfID sets the "shelf_id" field.
+func (m *ShelfBookMutation) SetShelfID(u uuid.UUID) {
+	m.shelf = &u
+}
+
+// ShelfID returns the value of the "shelf_id" field in the mutation.
+func (m *ShelfBookMutation) ShelfID() (r uuid.UUID, exists bool) {
+	v := m.shelf
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetShelfID resets all changes to the "shelf_id" field.
+func (m *ShelfBookMutation) ResetShelfID() {
+	m.shelf = nil
+}
+
+// SetBookID sets the "book_id" field.
+func (m *ShelfBookMutation) SetBookID(u uuid.UUID) {
+	m.book = &u
+}
+
+// BookID returns the value of the "book_id" field in the mutation.
+func (m *ShelfBookMutation) BookID() (r uuid.UUID, exists bool) {
+	v := m.book
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetBookID resets all changes to the "book_id" field.
+func (m *ShelfBookMutation) ResetBookID() {
+	m.book = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *ShelfBookMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *ShelfBookMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// AddPosition adds i to the "position" field.
+func (m *ShelfBookMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *ShelfBookMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *ShelfBookMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetAddedAt sets the "added_at" field.
+func (m *ShelfBookMutation) SetAddedAt(t time.Time) {
+	m.added_at = &t
+}
+
+// AddedAt returns the value of the "added_at" field in the mutation.
+func (m *ShelfBookMutation) AddedAt() (r time.Time, exists bool) {
+	v := m.added_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAddedAt resets all changes to the "added_at" field.
+func (m *ShelfBookMutation) ResetAddedAt() {
+	m.added_at = nil
+}
+
+// ClearShelf clears the "shelf" edge to the Shelf entity.
+func (m *ShelfBookMutation) ClearShelf() {
+	m.clearedshelf = true
+	m.clearedFields[shelfbook.FieldShelfID] = struct{}{}
+}
+
+// ShelfCleared reports if the "shelf" edge to the Shelf entity was cleared.
+func (m *ShelfBookMutation) ShelfCleared() bool {
+	return m.clearedshelf
+}
+
+// ShelfIDs returns the "shelf" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ShelfID instead. It exists only for internal usage by the builders.
+func (m *ShelfBookMutation) ShelfIDs() (ids []uuid.UUID) {
+	if id := m.shelf; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetShelf resets all changes to the "shelf" edge.
+func (m *ShelfBookMutation) ResetShelf() {
+	m.shelf = nil
+	m.clearedshelf = false
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (m *ShelfBookMutation) ClearBook() {
+	m.clearedbook = true
+	m.clearedFields[shelfbook.FieldBookID] = struct{}{}
+}
+
+// BookCleared reports if the "book" edge to the Book entity was cleared.
+func (m *ShelfBookMutation) BookCleared() bool {
+	return m.clearedbook
+}
+
+// BookIDs returns the "book" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BookID instead. It exists only for internal usage by the builders.
+func (m *ShelfBookMutation) BookIDs() (ids []uuid.UUID) {
+	if id := m.book; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBook resets all changes to the "book" edge.
+func (m *ShelfBookMutation) ResetBook() {
+	m.book = nil
+	m.clearedbook = false
+}
+
+// Where appends a list predicates to the ShelfBookMutation builder.
+func (m *ShelfBookMutation) Where(ps ...predicate.ShelfBook) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShelfBookMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShelfBookMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ShelfBook, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ShelfBookMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ShelfBookMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ShelfBook).
+func (m *ShelfBookMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShelfBookMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.shelf != nil {
+		fields = append(fields, shelfbook.FieldShelfID)
+	}
+	if m.book != nil {
+		fields = append(fields, shelfbook.FieldBookID)
+	}
+	if m.position != nil {
+		fields = append(fields, shelfbook.FieldPosition)
+	}
+	if m.added_at != nil {
+		fields = append(fields, shelfbook.FieldAddedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShelfBookMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case shelfbook.FieldShelfID:
+		return m.ShelfID()
+	case shelfbook.FieldBookID:
+		return m.BookID()
+	case shelfbook.FieldPosition:
+		return m.Position()
+	case shelfbook.FieldAddedAt:
+		return m.AddedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShelfBookMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	return nil, errors.New("edge schema ShelfBook does not support getting old values")
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShelfBookMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case shelfbook.FieldShelfID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShelfID(v)
+		return nil
+	case shelfbook.FieldBookID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBookID(v)
+		return nil
+	case shelfbook.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case shelfbook.FieldAddedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShelfBook field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShelfBookMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, shelfbook.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShelfBookMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case shelfbook.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShelfBookMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case shelfbook.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ShelfBook numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShelfBookMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShelfBookMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShelfBookMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ShelfBook nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShelfBookMutation) ResetField(name string) error {
+	switch name {
+	case shelfbook.FieldShelfID:
+		m.ResetShelfID()
+		return nil
+	case shelfbook.FieldBookID:
+		m.ResetBookID()
+		return nil
+	case shelfbook.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case shelfbook.FieldAddedAt:
+		m.ResetAddedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ShelfBook field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShelfBookMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.shelf != nil {
+		edges = append(edges, shelfbook.EdgeShelf)
+	}
+	if m.book != nil {
+		edges = append(edges, shelfbook.EdgeBook)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShelfBookMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case shelfbook.EdgeShelf:
+		if id := m.shelf; id != nil {
+			return []ent.Value{*id}
+		}
+	case shelfbook.EdgeBook:
+		if id := m.book; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShelfBookMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShelfBookMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShelfBookMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedshelf {
+		edges = append(edges, shelfbook.EdgeShelf)
+	}
+	if m.clearedbook {
+		edges = append(edges, shelfbook.EdgeBook)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShelfBookMutation) EdgeCleared(name string) bool {
+	switch name {
+	case shelfbook.EdgeShelf:
+		return m.clearedshelf
+	case shelfbook.EdgeBook:
+		return m.clearedbook
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShelfBookMutation) ClearEdge(name string) error {
+	switch name {
+	case shelfbook.EdgeShelf:
+		m.ClearShelf()
+		return nil
+	case shelfbook.EdgeBook:
+		m.ClearBook()
+		return nil
+	}
+	return fmt.Errorf("unknown ShelfBook unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShelfBookMutation) ResetEdge(name string) error {
+	switch name {
+	case shelfbook.EdgeShelf:
+		m.ResetShelf()
+		return nil
+	case shelfbook.EdgeBook:
+		m.ResetBook()
+		return nil
+	}
+	return fmt.Errorf("unknown ShelfBook edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	name          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	owner         *uuid.UUID
+	clearedowner  bool
+	books         map[uuid.UUID]struct{}
+	removedbooks  map[uuid.UUID]struct{}
+	clearedbooks  bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id uuid.UUID) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tag entities.
+func (m *TagMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *TagMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *TagMutation) ClearOwner() {
+	m.clearedowner = true
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *TagMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *TagMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
+	}
+	return
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *TagMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *TagMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by ids.
+func (m *TagMutation) AddBookIDs(ids ...uuid.UUID) {
+	if m.books == nil {
+		m.books = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.books[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBooks clears the "books" edge to the Book entity.
+func (m *TagMutation) ClearBooks() {
+	m.clearedbooks = true
+}
+
+// BooksCleared reports if the "books" edge to the Book entity was cleared.
+func (m *TagMutation) BooksCleared() bool {
+	return m.clearedbooks
+}
+
+// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
+func (m *TagMutation) RemoveBookIDs(ids ...uuid.UUID) {
+	if m.removedbooks == nil {
+		m.removedbooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.books, ids[i])
+		m.removedbooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
+func (m *TagMutation) RemovedBooksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BooksIDs returns the "books" edge IDs in the mutation.
+func (m *TagMutation) BooksIDs() (ids []uuid.UUID) {
+	for id := range m.books {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBooks resets all changes to the "books" edge.
+func (m *TagMutation) ResetBooks() {
+	m.books = nil
+	m.clearedbooks = false
+	m.removedbooks = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tag.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldName:
+		return m.Name()
+	case tag.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldName:
+		return m.OldName(ctx)
+	case tag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TagMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TagMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TagMutation) ResetField(name string) error {
+	switch name {
+	case tag.FieldName:
+		m.ResetName()
+		return nil
+	case tag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.owner != nil {
+		edges = append(edges, tag.EdgeOwner)
+	}
+	if m.books != nil {
+		edges = append(edges, tag.EdgeBooks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	case tag.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.books))
+		for id := range m.books {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedbooks != nil {
+		edges = append(edges, tag.EdgeBooks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.removedbooks))
+		for id := range m.removedbooks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedowner {
+		edges = append(edges, tag.EdgeOwner)
+	}
+	if m.clearedbooks {
+		edges = append(edges, tag.EdgeBooks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeOwner:
+		return m.clearedowner
+	case tag.EdgeBooks:
+		return m.clearedbooks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	case tag.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	case tag.EdgeBooks:
+		m.ResetBooks()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                                  Op
+	typ                                 string
+	id                                  *uuid.UUID
+	nick_name                           *string
+	email                               *string
+	password                            *string
+	is_published                        *bool
+	is_terms_agreed                     *bool
+	is_privacy_agreed                   *bool
+	fcm_token                           *string
+	timezone                            *string
+	wishlist_share_token                *string
+	created_at                          *time.Time
+	updated_at                          *time.Time
+	clearedFields                       map[string]struct{}
+	books                               map[uuid.UUID]struct{}
+	removedbooks                        map[uuid.UUID]struct{}
+	clearedbooks                        bool
+	reviews                             map[uuid.UUID]struct{}
+	removedreviews                      map[uuid.UUID]struct{}
+	clearedreviews                      bool
+	bookmarks                           map[uuid.UUID]struct{}
+	removedbookmarks                    map[uuid.UUID]struct{}
+	clearedbookmarks                    bool
+	reading_reminders                   map[uuid.UUID]struct{}
+	removedreading_reminders            map[uuid.UUID]struct{}
+	clearedreading_reminders            bool
+	reading_sessions                    map[uuid.UUID]struct{}
+	removedreading_sessions             map[uuid.UUID]struct{}
+	clearedreading_sessions             bool
+	shelves                             map[uuid.UUID]struct{}
+	removedshelves                      map[uuid.UUID]struct{}
+	clearedshelves                      bool
+	tags                                map[uuid.UUID]struct{}
+	removedtags                         map[uuid.UUID]struct{}
+	clearedtags                         bool
+	book_notes                          map[uuid.UUID]struct{}
+	removedbook_notes                   map[uuid.UUID]struct{}
+	clearedbook_notes                   bool
+	loans                               map[uuid.UUID]struct{}
+	removedloans                        map[uuid.UUID]struct{}
+	clearedloans                        bool
+	sent_borrow_requests                map[uuid.UUID]struct{}
+	removedsent_borrow_requests         map[uuid.UUID]struct{}
+	clearedsent_borrow_requests         bool
+	received_borrow_requests            map[uuid.UUID]struct{}
+	removedreceived_borrow_requests     map[uuid.UUID]struct{}
+	clearedreceived_borrow_requests     bool
+	wishlist_items                      map[uuid.UUID]struct{}
+	removedwishlist_items               map[uuid.UUID]struct{}
+	clearedwishlist_items               bool
+	library_memberships                 map[uuid.UUID]struct{}
+	removedlibrary_memberships          map[uuid.UUID]struct{}
+	clearedlibrary_memberships          bool
+	sent_library_invitations            map[uuid.UUID]struct{}
+	removedsent_library_invitations     map[uuid.UUID]struct{}
+	clearedsent_library_invitations     bool
+	received_library_invitations        map[uuid.UUID]struct{}
+	removedreceived_library_invitations map[uuid.UUID]struct{}
+	clearedreceived_library_invitations bool
+	reading_goals                       map[uuid.UUID]struct{}
+	removedreading_goals                map[uuid.UUID]struct{}
+	clearedreading_goals                bool
+	done                                bool
+	oldValue                            func(context.Context) (*User, error)
+	predicates                          []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
 		}
-		m.SetCreatedAt(v)
-		return nil
+		m.id = &node.ID
 	}
-	return fmt.Errorf("unknown EmailVerification field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *EmailVerificationMutation) AddedFields() []string {
-	return nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EmailVerificationMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EmailVerificationMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return fmt.Errorf("unknown EmailVerification numeric field %s", name)
+	return *m.id, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EmailVerificationMutation) ClearedFields() []string {
-	return nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EmailVerificationMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// SetNickName sets the "nick_name" field.
+func (m *UserMutation) SetNickName(s string) {
+	m.nick_name = &s
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EmailVerificationMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown EmailVerification nullable field %s", name)
+// NickName returns the value of the "nick_name" field in the mutation.
+func (m *UserMutation) NickName() (r string, exists bool) {
+	v := m.nick_name
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EmailVerificationMutation) ResetField(name string) error {
-	switch name {
-	case emailverification.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case emailverification.FieldCode:
-		m.ResetCode()
-		return nil
-	case emailverification.FieldExpiresAt:
-		m.ResetExpiresAt()
-		return nil
-	case emailverification.FieldIsVerified:
-		m.ResetIsVerified()
-		return nil
-	case emailverification.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// OldNickName returns the old "nick_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickName is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown EmailVerification field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickName: %w", err)
+	}
+	return oldValue.NickName, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EmailVerificationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetNickName resets all changes to the "nick_name" field.
+func (m *UserMutation) ResetNickName() {
+	m.nick_name = nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EmailVerificationMutation) AddedIDs(name string) []ent.Value {
-	return nil
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EmailVerificationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EmailVerificationMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EmailVerificationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EmailVerificationMutation) EdgeCleared(name string) bool {
-	return false
+// ClearPassword clears the value of the "password" field.
+func (m *UserMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[user.FieldPassword] = struct{}{}
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EmailVerificationMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown EmailVerification unique edge %s", name)
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *UserMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[user.FieldPassword]
+	return ok
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EmailVerificationMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown EmailVerification edge %s", name)
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, user.FieldPassword)
 }
 
-// ReadingReminderMutation represents an operation that mutates the ReadingReminder nodes in the graph.
-type ReadingReminderMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	reminder_time *string
-	day_of_week   *readingreminder.DayOfWeek
-	is_enabled    *bool
-	message       *string
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	owner         *uuid.UUID
-	clearedowner  bool
-	done          bool
-	oldValue      func(context.Context) (*ReadingReminder, error)
-	predicates    []predicate.ReadingReminder
+// SetIsPublished sets the "is_published" field.
+func (m *UserMutation) SetIsPublished(b bool) {
+	m.is_published = &b
 }
 
-var _ ent.Mutation = (*ReadingReminderMutation)(nil)
-
-// readingreminderOption allows management of the mutation configuration using functional options.
-type readingreminderOption func(*ReadingReminderMutation)
-
-// newReadingReminderMutation creates new mutation for the ReadingReminder entity.
-func newReadingReminderMutation(c config, op Op, opts ...readingreminderOption) *ReadingReminderMutation {
-	m := &ReadingReminderMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeReadingReminder,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// IsPublished returns the value of the "is_published" field in the mutation.
+func (m *UserMutation) IsPublished() (r bool, exists bool) {
+	v := m.is_published
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withReadingReminderID sets the ID field of the mutation.
-func withReadingReminderID(id uuid.UUID) readingreminderOption {
-	return func(m *ReadingReminderMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *ReadingReminder
-		)
-		m.oldValue = func(ctx context.Context) (*ReadingReminder, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().ReadingReminder.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldIsPublished returns the old "is_published" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsPublished(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublished is only allowed on UpdateOne operations")
 	}
-}
-
-// withReadingReminder sets the old ReadingReminder of the mutation.
-func withReadingReminder(node *ReadingReminder) readingreminderOption {
-	return func(m *ReadingReminderMutation) {
-		m.oldValue = func(context.Context) (*ReadingReminder, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublished requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublished: %w", err)
+	}
+	return oldValue.IsPublished, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReadingReminderMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
-}
-
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ReadingReminderMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetIsPublished resets all changes to the "is_published" field.
+func (m *UserMutation) ResetIsPublished() {
+	m.is_published = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of ReadingReminder entities.
-func (m *ReadingReminderMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetIsTermsAgreed sets the "is_terms_agreed" field.
+func (m *UserMutation) SetIsTermsAgreed(b bool) {
+	m.is_terms_agreed = &b
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ReadingReminderMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// IsTermsAgreed returns the value of the "is_terms_agreed" field in the mutation.
+func (m *UserMutation) IsTermsAgreed() (r bool, exists bool) {
+	v := m.is_terms_agreed
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ReadingReminderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ReadingReminder.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldIsTermsAgreed returns the old "is_terms_agreed" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsTermsAgreed(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsTermsAgreed is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsTermsAgreed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsTermsAgreed: %w", err)
+	}
+	return oldValue.IsTermsAgreed, nil
 }
 
-// SetReminderTime sets the "reminder_time" field.
-func (m *ReadingReminderMutation) SetReminderTime(s string) {
-	m.reminder_time = &s
+// ResetIsTermsAgreed resets all changes to the "is_terms_agreed" field.
+func (m *UserMutation) ResetIsTermsAgreed() {
+	m.is_terms_agreed = nil
 }
 
-// ReminderTime returns the value of the "reminder_time" field in the mutation.
-func (m *ReadingReminderMutation) ReminderTime() (r string, exists bool) {
-	v := m.reminder_time
+// SetIsPrivacyAgreed sets the "is_privacy_agreed" field.
+func (m *UserMutation) SetIsPrivacyAgreed(b bool) {
+	m.is_privacy_agreed = &b
+}
+
+// IsPrivacyAgreed returns the value of the "is_privacy_agreed" field in the mutation.
+func (m *UserMutation) IsPrivacyAgreed() (r bool, exists bool) {
+	v := m.is_privacy_agreed
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReminderTime returns the old "reminder_time" field's value of the ReadingReminder entity.
-// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// OldIsPrivacyAgreed returns the old "is_privacy_agreed" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReadingReminderMutation) OldReminderTime(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldIsPrivacyAgreed(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReminderTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsPrivacyAgreed is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReminderTime requires an ID field in the mutation")
+		return v, errors.New("OldIsPrivacyAgreed requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReminderTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsPrivacyAgreed: %w", err)
 	}
-	return oldValue.ReminderTime, nil
+	return oldValue.IsPrivacyAgreed, nil
 }
 
-// ResetReminderTime resets all changes to the "reminder_time" field.
-func (m *ReadingReminderMutation) ResetReminderTime() {
-	m.reminder_time = nil
+// ResetIsPrivacyAgreed resets all changes to the "is_privacy_agreed" field.
+func (m *UserMutation) ResetIsPrivacyAgreed() {
+	m.is_privacy_agreed = nil
 }
 
-// SetDayOfWeek sets the "day_of_week" field.
-func (m *ReadingReminderMutation) SetDayOfWeek(row readingreminder.DayOfWeek) {
-	m.day_of_week = &row
+// SetFcmToken sets the "fcm_token" field.
+func (m *UserMutation) SetFcmToken(s string) {
+	m.fcm_token = &s
 }
 
-// DayOfWeek returns the value of the "day_of_week" field in the mutation.
-func (m *ReadingReminderMutation) DayOfWeek() (r readingreminder.DayOfWeek, exists bool) {
-	v := m.day_of_week
+// FcmToken returns the value of the "fcm_token" field in the mutation.
+func (m *UserMutation) FcmToken() (r string, exists bool) {
+	v := m.fcm_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDayOfWeek returns the old "day_of_week" field's value of the ReadingReminder entity.
-// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// OldFcmToken returns the old "fcm_token" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReadingReminderMutation) OldDayOfWeek(ctx context.Context) (v readingreminder.DayOfWeek, err error) {
+func (m *UserMutation) OldFcmToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDayOfWeek is only allowed on UpdateOne operations")
+		return v, errors.New("OldFcmToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDayOfWeek requires an ID field in the mutation")
+		return v, errors.New("OldFcmToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDayOfWeek: %w", err)
+		return v, fmt.Errorf("querying old value for OldFcmToken: %w", err)
 	}
-	return oldValue.DayOfWeek, nil
+	return oldValue.FcmToken, nil
 }
 
-// ResetDayOfWeek resets all changes to the "day_of_week" field.
-func (m *ReadingReminderMutation) ResetDayOfWeek() {
-	m.day_of_week = nil
+// ClearFcmToken clears the value of the "fcm_token" field.
+func (m *UserMutation) ClearFcmToken() {
+	m.fcm_token = nil
+	m.clearedFields[user.FieldFcmToken] = struct{}{}
+}
+
+// FcmTokenCleared returns if the "fcm_token" field was cleared in this mutation.
+func (m *UserMutation) FcmTokenCleared() bool {
+	_, ok := m.clearedFields[user.FieldFcmToken]
+	return ok
+}
+
+// ResetFcmToken resets all changes to the "fcm_token" field.
+func (m *UserMutation) ResetFcmToken() {
+	m.fcm_token = nil
+	delete(m.clearedFields, user.FieldFcmToken)
 }
 
-// SetIsEnabled sets the "is_enabled" field.
-func (m *ReadingReminderMutation) SetIsEnabled(b bool) {
-	m.is_enabled = &b
+// SetTimezone sets the "timezone" field.
+func (m *UserMutation) SetTimezone(s string) {
+	m.timezone = &s
 }
 
-// IsEnabled returns the value of the "is_enabled" field in the mutation.
-func (m *ReadingReminderMutation) IsEnabled() (r bool, exists bool) {
-	v := m.is_enabled
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *UserMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsEnabled returns the old "is_enabled" field's value of the ReadingReminder entity.
-// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// OldTimezone returns the old "timezone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReadingReminderMutation) OldIsEnabled(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldTimezone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsEnabled requires an ID field in the mutation")
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
 	}
-	return oldValue.IsEnabled, nil
+	return oldValue.Timezone, nil
 }
 
-// ResetIsEnabled resets all changes to the "is_enabled" field.
-func (m *ReadingReminderMutation) ResetIsEnabled() {
-	m.is_enabled = nil
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *UserMutation) ResetTimezone() {
+	m.timezone = nil
 }
 
-// SetMessage sets the "message" field.
-func (m *ReadingReminderMutation) SetMessage(s string) {
-	m.message = &s
+// SetWishlistShareToken sets the "wishlist_share_token" field.
+func (m *UserMutation) SetWishlistShareToken(s string) {
+	m.wishlist_share_token = &s
 }
 
-// Message returns the value of the "message" field in the mutation.
-func (m *ReadingReminderMutation) Message() (r string, exists bool) {
-	v := m.message
+// WishlistShareToken returns the value of the "wishlist_share_token" field in the mutation.
+func (m *UserMutation) WishlistShareToken() (r string, exists bool) {
+	v := m.wishlist_share_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMessage returns the old "message" field's value of the ReadingReminder entity.
-// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// OldWishlistShareToken returns the old "wishlist_share_token" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReadingReminderMutation) OldMessage(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldWishlistShareToken(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldWishlistShareToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMessage requires an ID field in the mutation")
+		return v, errors.New("OldWishlistShareToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldWishlistShareToken: %w", err)
 	}
-	return oldValue.Message, nil
+	return oldValue.WishlistShareToken, nil
 }
 
-// ResetMessage resets all changes to the "message" field.
-func (m *ReadingReminderMutation) ResetMessage() {
-	m.message = nil
+// ClearWishlistShareToken clears the value of the "wishlist_share_token" field.
+func (m *UserMutation) ClearWishlistShareToken() {
+	m.wishlist_share_token = nil
+	m.clearedFields[user.FieldWishlistShareToken] = struct{}{}
+}
+
+// WishlistShareTokenCleared returns if the "wishlist_share_token" field was cleared in this mutation.
+func (m *UserMutation) WishlistShareTokenCleared() bool {
+	_, ok := m.clearedFields[user.FieldWishlistShareToken]
+	return ok
+}
+
+// ResetWishlistShareToken resets all changes to the "wishlist_share_token" field.
+func (m *UserMutation) ResetWishlistShareToken() {
+	m.wishlist_share_token = nil
+	delete(m.clearedFields, user.FieldWishlistShareToken)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ReadingReminderMutation) SetCreatedAt(t time.Time) {
+func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ReadingReminderMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3047,10 +17910,10 @@ func (m *ReadingReminderMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the ReadingReminder entity.
-// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReadingReminderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3065,17 +17928,17 @@ func (m *ReadingReminderMutation) OldCreatedAt(ctx context.Context) (v time.Time
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ReadingReminderMutation) ResetCreatedAt() {
+func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ReadingReminderMutation) SetUpdatedAt(t time.Time) {
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ReadingReminderMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3083,10 +17946,10 @@ func (m *ReadingReminderMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the ReadingReminder entity.
-// If the ReadingReminder object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReadingReminderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3097,793 +17960,887 @@ func (m *ReadingReminderMutation) OldUpdatedAt(ctx context.Context) (v time.Time
 	if err != nil {
 		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ReadingReminderMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by ids.
+func (m *UserMutation) AddBookIDs(ids ...uuid.UUID) {
+	if m.books == nil {
+		m.books = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.books[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBooks clears the "books" edge to the Book entity.
+func (m *UserMutation) ClearBooks() {
+	m.clearedbooks = true
+}
+
+// BooksCleared reports if the "books" edge to the Book entity was cleared.
+func (m *UserMutation) BooksCleared() bool {
+	return m.clearedbooks
+}
+
+// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
+func (m *UserMutation) RemoveBookIDs(ids ...uuid.UUID) {
+	if m.removedbooks == nil {
+		m.removedbooks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.books, ids[i])
+		m.removedbooks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
+func (m *UserMutation) RemovedBooksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbooks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BooksIDs returns the "books" edge IDs in the mutation.
+func (m *UserMutation) BooksIDs() (ids []uuid.UUID) {
+	for id := range m.books {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBooks resets all changes to the "books" edge.
+func (m *UserMutation) ResetBooks() {
+	m.books = nil
+	m.clearedbooks = false
+	m.removedbooks = nil
+}
+
+// AddReviewIDs adds the "reviews" edge to the Review entity by ids.
+func (m *UserMutation) AddReviewIDs(ids ...uuid.UUID) {
+	if m.reviews == nil {
+		m.reviews = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reviews[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReviews clears the "reviews" edge to the Review entity.
+func (m *UserMutation) ClearReviews() {
+	m.clearedreviews = true
+}
+
+// ReviewsCleared reports if the "reviews" edge to the Review entity was cleared.
+func (m *UserMutation) ReviewsCleared() bool {
+	return m.clearedreviews
+}
+
+// RemoveReviewIDs removes the "reviews" edge to the Review entity by IDs.
+func (m *UserMutation) RemoveReviewIDs(ids ...uuid.UUID) {
+	if m.removedreviews == nil {
+		m.removedreviews = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reviews, ids[i])
+		m.removedreviews[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReviews returns the removed IDs of the "reviews" edge to the Review entity.
+func (m *UserMutation) RemovedReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReviewsIDs returns the "reviews" edge IDs in the mutation.
+func (m *UserMutation) ReviewsIDs() (ids []uuid.UUID) {
+	for id := range m.reviews {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReviews resets all changes to the "reviews" edge.
+func (m *UserMutation) ResetReviews() {
+	m.reviews = nil
+	m.clearedreviews = false
+	m.removedreviews = nil
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by ids.
+func (m *UserMutation) AddBookmarkIDs(ids ...uuid.UUID) {
+	if m.bookmarks == nil {
+		m.bookmarks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.bookmarks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBookmarks clears the "bookmarks" edge to the Bookmark entity.
+func (m *UserMutation) ClearBookmarks() {
+	m.clearedbookmarks = true
+}
+
+// BookmarksCleared reports if the "bookmarks" edge to the Bookmark entity was cleared.
+func (m *UserMutation) BookmarksCleared() bool {
+	return m.clearedbookmarks
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to the Bookmark entity by IDs.
+func (m *UserMutation) RemoveBookmarkIDs(ids ...uuid.UUID) {
+	if m.removedbookmarks == nil {
+		m.removedbookmarks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.bookmarks, ids[i])
+		m.removedbookmarks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBookmarks returns the removed IDs of the "bookmarks" edge to the Bookmark entity.
+func (m *UserMutation) RemovedBookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbookmarks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BookmarksIDs returns the "bookmarks" edge IDs in the mutation.
+func (m *UserMutation) BookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.bookmarks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBookmarks resets all changes to the "bookmarks" edge.
+func (m *UserMutation) ResetBookmarks() {
+	m.bookmarks = nil
+	m.clearedbookmarks = false
+	m.removedbookmarks = nil
+}
+
+// AddReadingReminderIDs adds the "reading_reminders" edge to the ReadingReminder entity by ids.
+func (m *UserMutation) AddReadingReminderIDs(ids ...uuid.UUID) {
+	if m.reading_reminders == nil {
+		m.reading_reminders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reading_reminders[ids[i]] = struct{}{}
+	}
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *ReadingReminderMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
+// ClearReadingReminders clears the "reading_reminders" edge to the ReadingReminder entity.
+func (m *UserMutation) ClearReadingReminders() {
+	m.clearedreading_reminders = true
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *ReadingReminderMutation) ClearOwner() {
-	m.clearedowner = true
+// ReadingRemindersCleared reports if the "reading_reminders" edge to the ReadingReminder entity was cleared.
+func (m *UserMutation) ReadingRemindersCleared() bool {
+	return m.clearedreading_reminders
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *ReadingReminderMutation) OwnerCleared() bool {
-	return m.clearedowner
+// RemoveReadingReminderIDs removes the "reading_reminders" edge to the ReadingReminder entity by IDs.
+func (m *UserMutation) RemoveReadingReminderIDs(ids ...uuid.UUID) {
+	if m.removedreading_reminders == nil {
+		m.removedreading_reminders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reading_reminders, ids[i])
+		m.removedreading_reminders[ids[i]] = struct{}{}
+	}
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *ReadingReminderMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// RemovedReadingReminders returns the removed IDs of the "reading_reminders" edge to the ReadingReminder entity.
+func (m *UserMutation) RemovedReadingRemindersIDs() (ids []uuid.UUID) {
+	for id := range m.removedreading_reminders {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *ReadingReminderMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// ReadingRemindersIDs returns the "reading_reminders" edge IDs in the mutation.
+func (m *UserMutation) ReadingRemindersIDs() (ids []uuid.UUID) {
+	for id := range m.reading_reminders {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *ReadingReminderMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
-}
-
-// Where appends a list predicates to the ReadingReminderMutation builder.
-func (m *ReadingReminderMutation) Where(ps ...predicate.ReadingReminder) {
-	m.predicates = append(m.predicates, ps...)
+// ResetReadingReminders resets all changes to the "reading_reminders" edge.
+func (m *UserMutation) ResetReadingReminders() {
+	m.reading_reminders = nil
+	m.clearedreading_reminders = false
+	m.removedreading_reminders = nil
 }
 
-// WhereP appends storage-level predicates to the ReadingReminderMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ReadingReminderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ReadingReminder, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddReadingSessionIDs adds the "reading_sessions" edge to the ReadingSession entity by ids.
+func (m *UserMutation) AddReadingSessionIDs(ids ...uuid.UUID) {
+	if m.reading_sessions == nil {
+		m.reading_sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reading_sessions[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *ReadingReminderMutation) Op() Op {
-	return m.op
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ReadingReminderMutation) SetOp(op Op) {
-	m.op = op
+// ClearReadingSessions clears the "reading_sessions" edge to the ReadingSession entity.
+func (m *UserMutation) ClearReadingSessions() {
+	m.clearedreading_sessions = true
 }
 
-// Type returns the node type of this mutation (ReadingReminder).
-func (m *ReadingReminderMutation) Type() string {
-	return m.typ
+// ReadingSessionsCleared reports if the "reading_sessions" edge to the ReadingSession entity was cleared.
+func (m *UserMutation) ReadingSessionsCleared() bool {
+	return m.clearedreading_sessions
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ReadingReminderMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.reminder_time != nil {
-		fields = append(fields, readingreminder.FieldReminderTime)
-	}
-	if m.day_of_week != nil {
-		fields = append(fields, readingreminder.FieldDayOfWeek)
-	}
-	if m.is_enabled != nil {
-		fields = append(fields, readingreminder.FieldIsEnabled)
-	}
-	if m.message != nil {
-		fields = append(fields, readingreminder.FieldMessage)
-	}
-	if m.created_at != nil {
-		fields = append(fields, readingreminder.FieldCreatedAt)
+// RemoveReadingSessionIDs removes the "reading_sessions" edge to the ReadingSession entity by IDs.
+func (m *UserMutation) RemoveReadingSessionIDs(ids ...uuid.UUID) {
+	if m.removedreading_sessions == nil {
+		m.removedreading_sessions = make(map[uuid.UUID]struct{})
 	}
-	if m.updated_at != nil {
-		fields = append(fields, readingreminder.FieldUpdatedAt)
+	for i := range ids {
+		delete(m.reading_sessions, ids[i])
+		m.removedreading_sessions[ids[i]] = struct{}{}
 	}
-	return fields
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *ReadingReminderMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case readingreminder.FieldReminderTime:
-		return m.ReminderTime()
-	case readingreminder.FieldDayOfWeek:
-		return m.DayOfWeek()
-	case readingreminder.FieldIsEnabled:
-		return m.IsEnabled()
-	case readingreminder.FieldMessage:
-		return m.Message()
-	case readingreminder.FieldCreatedAt:
-		return m.CreatedAt()
-	case readingreminder.FieldUpdatedAt:
-		return m.UpdatedAt()
+// RemovedReadingSessions returns the removed IDs of the "reading_sessions" edge to the ReadingSession entity.
+func (m *UserMutation) RemovedReadingSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreading_sessions {
+		ids = append(ids, id)
 	}
-	return nil, false
+	return
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *ReadingReminderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case readingreminder.FieldReminderTime:
-		return m.OldReminderTime(ctx)
-	case readingreminder.FieldDayOfWeek:
-		return m.OldDayOfWeek(ctx)
-	case readingreminder.FieldIsEnabled:
-		return m.OldIsEnabled(ctx)
-	case readingreminder.FieldMessage:
-		return m.OldMessage(ctx)
-	case readingreminder.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case readingreminder.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// ReadingSessionsIDs returns the "reading_sessions" edge IDs in the mutation.
+func (m *UserMutation) ReadingSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.reading_sessions {
+		ids = append(ids, id)
 	}
-	return nil, fmt.Errorf("unknown ReadingReminder field %s", name)
+	return
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ReadingReminderMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case readingreminder.FieldReminderTime:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetReminderTime(v)
-		return nil
-	case readingreminder.FieldDayOfWeek:
-		v, ok := value.(readingreminder.DayOfWeek)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDayOfWeek(v)
-		return nil
-	case readingreminder.FieldIsEnabled:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsEnabled(v)
-		return nil
-	case readingreminder.FieldMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMessage(v)
-		return nil
-	case readingreminder.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case readingreminder.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// ResetReadingSessions resets all changes to the "reading_sessions" edge.
+func (m *UserMutation) ResetReadingSessions() {
+	m.reading_sessions = nil
+	m.clearedreading_sessions = false
+	m.removedreading_sessions = nil
+}
+
+// AddShelfIDs adds the "shelves" edge to the Shelf entity by ids.
+func (m *UserMutation) AddShelfIDs(ids ...uuid.UUID) {
+	if m.shelves == nil {
+		m.shelves = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.shelves[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown ReadingReminder field %s", name)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *ReadingReminderMutation) AddedFields() []string {
-	return nil
+// ClearShelves clears the "shelves" edge to the Shelf entity.
+func (m *UserMutation) ClearShelves() {
+	m.clearedshelves = true
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *ReadingReminderMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// ShelvesCleared reports if the "shelves" edge to the Shelf entity was cleared.
+func (m *UserMutation) ShelvesCleared() bool {
+	return m.clearedshelves
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *ReadingReminderMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// RemoveShelfIDs removes the "shelves" edge to the Shelf entity by IDs.
+func (m *UserMutation) RemoveShelfIDs(ids ...uuid.UUID) {
+	if m.removedshelves == nil {
+		m.removedshelves = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.shelves, ids[i])
+		m.removedshelves[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown ReadingReminder numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *ReadingReminderMutation) ClearedFields() []string {
-	return nil
+// RemovedShelves returns the removed IDs of the "shelves" edge to the Shelf entity.
+func (m *UserMutation) RemovedShelvesIDs() (ids []uuid.UUID) {
+	for id := range m.removedshelves {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *ReadingReminderMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ShelvesIDs returns the "shelves" edge IDs in the mutation.
+func (m *UserMutation) ShelvesIDs() (ids []uuid.UUID) {
+	for id := range m.shelves {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *ReadingReminderMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown ReadingReminder nullable field %s", name)
+// ResetShelves resets all changes to the "shelves" edge.
+func (m *UserMutation) ResetShelves() {
+	m.shelves = nil
+	m.clearedshelves = false
+	m.removedshelves = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *ReadingReminderMutation) ResetField(name string) error {
-	switch name {
-	case readingreminder.FieldReminderTime:
-		m.ResetReminderTime()
-		return nil
-	case readingreminder.FieldDayOfWeek:
-		m.ResetDayOfWeek()
-		return nil
-	case readingreminder.FieldIsEnabled:
-		m.ResetIsEnabled()
-		return nil
-	case readingreminder.FieldMessage:
-		m.ResetMessage()
-		return nil
-	case readingreminder.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case readingreminder.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *UserMutation) AddTagIDs(ids ...uuid.UUID) {
+	if m.tags == nil {
+		m.tags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown ReadingReminder field %s", name)
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReadingReminderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.owner != nil {
-		edges = append(edges, readingreminder.EdgeOwner)
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *UserMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *UserMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *UserMutation) RemoveTagIDs(ids ...uuid.UUID) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *ReadingReminderMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case readingreminder.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *UserMutation) RemovedTagsIDs() (ids []uuid.UUID) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
 	}
-	return nil
+	return
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReadingReminderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *UserMutation) TagsIDs() (ids []uuid.UUID) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *ReadingReminderMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// ResetTags resets all changes to the "tags" edge.
+func (m *UserMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReadingReminderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedowner {
-		edges = append(edges, readingreminder.EdgeOwner)
+// AddBookNoteIDs adds the "book_notes" edge to the BookNote entity by ids.
+func (m *UserMutation) AddBookNoteIDs(ids ...uuid.UUID) {
+	if m.book_notes == nil {
+		m.book_notes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.book_notes[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *ReadingReminderMutation) EdgeCleared(name string) bool {
-	switch name {
-	case readingreminder.EdgeOwner:
-		return m.clearedowner
+// ClearBookNotes clears the "book_notes" edge to the BookNote entity.
+func (m *UserMutation) ClearBookNotes() {
+	m.clearedbook_notes = true
+}
+
+// BookNotesCleared reports if the "book_notes" edge to the BookNote entity was cleared.
+func (m *UserMutation) BookNotesCleared() bool {
+	return m.clearedbook_notes
+}
+
+// RemoveBookNoteIDs removes the "book_notes" edge to the BookNote entity by IDs.
+func (m *UserMutation) RemoveBookNoteIDs(ids ...uuid.UUID) {
+	if m.removedbook_notes == nil {
+		m.removedbook_notes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.book_notes, ids[i])
+		m.removedbook_notes[ids[i]] = struct{}{}
 	}
-	return false
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *ReadingReminderMutation) ClearEdge(name string) error {
-	switch name {
-	case readingreminder.EdgeOwner:
-		m.ClearOwner()
-		return nil
+// RemovedBookNotes returns the removed IDs of the "book_notes" edge to the BookNote entity.
+func (m *UserMutation) RemovedBookNotesIDs() (ids []uuid.UUID) {
+	for id := range m.removedbook_notes {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown ReadingReminder unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *ReadingReminderMutation) ResetEdge(name string) error {
-	switch name {
-	case readingreminder.EdgeOwner:
-		m.ResetOwner()
-		return nil
+// BookNotesIDs returns the "book_notes" edge IDs in the mutation.
+func (m *UserMutation) BookNotesIDs() (ids []uuid.UUID) {
+	for id := range m.book_notes {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown ReadingReminder edge %s", name)
+	return
 }
 
-// ReviewMutation represents an operation that mutates the Review nodes in the graph.
-type ReviewMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	book_isbn     *string
-	content       *string
-	rating        *int
-	addrating     *int
-	is_public     *bool
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	owner         *uuid.UUID
-	clearedowner  bool
-	book          *uuid.UUID
-	clearedbook   bool
-	done          bool
-	oldValue      func(context.Context) (*Review, error)
-	predicates    []predicate.Review
+// ResetBookNotes resets all changes to the "book_notes" edge.
+func (m *UserMutation) ResetBookNotes() {
+	m.book_notes = nil
+	m.clearedbook_notes = false
+	m.removedbook_notes = nil
 }
 
-var _ ent.Mutation = (*ReviewMutation)(nil)
+// AddLoanIDs adds the "loans" edge to the Loan entity by ids.
+func (m *UserMutation) AddLoanIDs(ids ...uuid.UUID) {
+	if m.loans == nil {
+		m.loans = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.loans[ids[i]] = struct{}{}
+	}
+}
 
-// reviewOption allows management of the mutation configuration using functional options.
-type reviewOption func(*ReviewMutation)
+// ClearLoans clears the "loans" edge to the Loan entity.
+func (m *UserMutation) ClearLoans() {
+	m.clearedloans = true
+}
 
-// newReviewMutation creates new mutation for the Review entity.
-func newReviewMutation(c config, op Op, opts ...reviewOption) *ReviewMutation {
-	m := &ReviewMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeReview,
-		clearedFields: make(map[string]struct{}),
+// LoansCleared reports if the "loans" edge to the Loan entity was cleared.
+func (m *UserMutation) LoansCleared() bool {
+	return m.clearedloans
+}
+
+// RemoveLoanIDs removes the "loans" edge to the Loan entity by IDs.
+func (m *UserMutation) RemoveLoanIDs(ids ...uuid.UUID) {
+	if m.removedloans == nil {
+		m.removedloans = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.loans, ids[i])
+		m.removedloans[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withReviewID sets the ID field of the mutation.
-func withReviewID(id uuid.UUID) reviewOption {
-	return func(m *ReviewMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Review
-		)
-		m.oldValue = func(ctx context.Context) (*Review, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Review.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedLoans returns the removed IDs of the "loans" edge to the Loan entity.
+func (m *UserMutation) RemovedLoansIDs() (ids []uuid.UUID) {
+	for id := range m.removedloans {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withReview sets the old Review of the mutation.
-func withReview(node *Review) reviewOption {
-	return func(m *ReviewMutation) {
-		m.oldValue = func(context.Context) (*Review, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// LoansIDs returns the "loans" edge IDs in the mutation.
+func (m *UserMutation) LoansIDs() (ids []uuid.UUID) {
+	for id := range m.loans {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ReviewMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetLoans resets all changes to the "loans" edge.
+func (m *UserMutation) ResetLoans() {
+	m.loans = nil
+	m.clearedloans = false
+	m.removedloans = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m ReviewMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddSentBorrowRequestIDs adds the "sent_borrow_requests" edge to the BorrowRequest entity by ids.
+func (m *UserMutation) AddSentBorrowRequestIDs(ids ...uuid.UUID) {
+	if m.sent_borrow_requests == nil {
+		m.sent_borrow_requests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sent_borrow_requests[ids[i]] = struct{}{}
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Review entities.
-func (m *ReviewMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearSentBorrowRequests clears the "sent_borrow_requests" edge to the BorrowRequest entity.
+func (m *UserMutation) ClearSentBorrowRequests() {
+	m.clearedsent_borrow_requests = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *ReviewMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// SentBorrowRequestsCleared reports if the "sent_borrow_requests" edge to the BorrowRequest entity was cleared.
+func (m *UserMutation) SentBorrowRequestsCleared() bool {
+	return m.clearedsent_borrow_requests
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *ReviewMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Review.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// RemoveSentBorrowRequestIDs removes the "sent_borrow_requests" edge to the BorrowRequest entity by IDs.
+func (m *UserMutation) RemoveSentBorrowRequestIDs(ids ...uuid.UUID) {
+	if m.removedsent_borrow_requests == nil {
+		m.removedsent_borrow_requests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sent_borrow_requests, ids[i])
+		m.removedsent_borrow_requests[ids[i]] = struct{}{}
 	}
 }
 
-// SetBookIsbn sets the "book_isbn" field.
-func (m *ReviewMutation) SetBookIsbn(s string) {
-	m.book_isbn = &s
+// RemovedSentBorrowRequests returns the removed IDs of the "sent_borrow_requests" edge to the BorrowRequest entity.
+func (m *UserMutation) RemovedSentBorrowRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsent_borrow_requests {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// BookIsbn returns the value of the "book_isbn" field in the mutation.
-func (m *ReviewMutation) BookIsbn() (r string, exists bool) {
-	v := m.book_isbn
-	if v == nil {
-		return
+// SentBorrowRequestsIDs returns the "sent_borrow_requests" edge IDs in the mutation.
+func (m *UserMutation) SentBorrowRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.sent_borrow_requests {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldBookIsbn returns the old "book_isbn" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldBookIsbn(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBookIsbn is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBookIsbn requires an ID field in the mutation")
+// ResetSentBorrowRequests resets all changes to the "sent_borrow_requests" edge.
+func (m *UserMutation) ResetSentBorrowRequests() {
+	m.sent_borrow_requests = nil
+	m.clearedsent_borrow_requests = false
+	m.removedsent_borrow_requests = nil
+}
+
+// AddReceivedBorrowRequestIDs adds the "received_borrow_requests" edge to the BorrowRequest entity by ids.
+func (m *UserMutation) AddReceivedBorrowRequestIDs(ids ...uuid.UUID) {
+	if m.received_borrow_requests == nil {
+		m.received_borrow_requests = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBookIsbn: %w", err)
+	for i := range ids {
+		m.received_borrow_requests[ids[i]] = struct{}{}
 	}
-	return oldValue.BookIsbn, nil
 }
 
-// ResetBookIsbn resets all changes to the "book_isbn" field.
-func (m *ReviewMutation) ResetBookIsbn() {
-	m.book_isbn = nil
+// ClearReceivedBorrowRequests clears the "received_borrow_requests" edge to the BorrowRequest entity.
+func (m *UserMutation) ClearReceivedBorrowRequests() {
+	m.clearedreceived_borrow_requests = true
 }
 
-// SetContent sets the "content" field.
-func (m *ReviewMutation) SetContent(s string) {
-	m.content = &s
+// ReceivedBorrowRequestsCleared reports if the "received_borrow_requests" edge to the BorrowRequest entity was cleared.
+func (m *UserMutation) ReceivedBorrowRequestsCleared() bool {
+	return m.clearedreceived_borrow_requests
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *ReviewMutation) Content() (r string, exists bool) {
-	v := m.content
-	if v == nil {
-		return
+// RemoveReceivedBorrowRequestIDs removes the "received_borrow_requests" edge to the BorrowRequest entity by IDs.
+func (m *UserMutation) RemoveReceivedBorrowRequestIDs(ids ...uuid.UUID) {
+	if m.removedreceived_borrow_requests == nil {
+		m.removedreceived_borrow_requests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.received_borrow_requests, ids[i])
+		m.removedreceived_borrow_requests[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldContent(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+// RemovedReceivedBorrowRequests returns the removed IDs of the "received_borrow_requests" edge to the BorrowRequest entity.
+func (m *UserMutation) RemovedReceivedBorrowRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreceived_borrow_requests {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+	return
+}
+
+// ReceivedBorrowRequestsIDs returns the "received_borrow_requests" edge IDs in the mutation.
+func (m *UserMutation) ReceivedBorrowRequestsIDs() (ids []uuid.UUID) {
+	for id := range m.received_borrow_requests {
+		ids = append(ids, id)
 	}
-	return oldValue.Content, nil
+	return
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *ReviewMutation) ResetContent() {
-	m.content = nil
+// ResetReceivedBorrowRequests resets all changes to the "received_borrow_requests" edge.
+func (m *UserMutation) ResetReceivedBorrowRequests() {
+	m.received_borrow_requests = nil
+	m.clearedreceived_borrow_requests = false
+	m.removedreceived_borrow_requests = nil
+}
+
+// AddWishlistItemIDs adds the "wishlist_items" edge to the WishlistItem entity by ids.
+func (m *UserMutation) AddWishlistItemIDs(ids ...uuid.UUID) {
+	if m.wishlist_items == nil {
+		m.wishlist_items = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.wishlist_items[ids[i]] = struct{}{}
+	}
 }
 
-// SetRating sets the "rating" field.
-func (m *ReviewMutation) SetRating(i int) {
-	m.rating = &i
-	m.addrating = nil
+// ClearWishlistItems clears the "wishlist_items" edge to the WishlistItem entity.
+func (m *UserMutation) ClearWishlistItems() {
+	m.clearedwishlist_items = true
 }
 
-// Rating returns the value of the "rating" field in the mutation.
-func (m *ReviewMutation) Rating() (r int, exists bool) {
-	v := m.rating
-	if v == nil {
-		return
-	}
-	return *v, true
+// WishlistItemsCleared reports if the "wishlist_items" edge to the WishlistItem entity was cleared.
+func (m *UserMutation) WishlistItemsCleared() bool {
+	return m.clearedwishlist_items
 }
 
-// OldRating returns the old "rating" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldRating(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRating is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRating requires an ID field in the mutation")
+// RemoveWishlistItemIDs removes the "wishlist_items" edge to the WishlistItem entity by IDs.
+func (m *UserMutation) RemoveWishlistItemIDs(ids ...uuid.UUID) {
+	if m.removedwishlist_items == nil {
+		m.removedwishlist_items = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	for i := range ids {
+		delete(m.wishlist_items, ids[i])
+		m.removedwishlist_items[ids[i]] = struct{}{}
 	}
-	return oldValue.Rating, nil
 }
 
-// AddRating adds i to the "rating" field.
-func (m *ReviewMutation) AddRating(i int) {
-	if m.addrating != nil {
-		*m.addrating += i
-	} else {
-		m.addrating = &i
+// RemovedWishlistItems returns the removed IDs of the "wishlist_items" edge to the WishlistItem entity.
+func (m *UserMutation) RemovedWishlistItemsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwishlist_items {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedRating returns the value that was added to the "rating" field in this mutation.
-func (m *ReviewMutation) AddedRating() (r int, exists bool) {
-	v := m.addrating
-	if v == nil {
-		return
+// WishlistItemsIDs returns the "wishlist_items" edge IDs in the mutation.
+func (m *UserMutation) WishlistItemsIDs() (ids []uuid.UUID) {
+	for id := range m.wishlist_items {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetRating resets all changes to the "rating" field.
-func (m *ReviewMutation) ResetRating() {
-	m.rating = nil
-	m.addrating = nil
+// ResetWishlistItems resets all changes to the "wishlist_items" edge.
+func (m *UserMutation) ResetWishlistItems() {
+	m.wishlist_items = nil
+	m.clearedwishlist_items = false
+	m.removedwishlist_items = nil
 }
 
-// SetIsPublic sets the "is_public" field.
-func (m *ReviewMutation) SetIsPublic(b bool) {
-	m.is_public = &b
+// AddLibraryMembershipIDs adds the "library_memberships" edge to the LibraryMember entity by ids.
+func (m *UserMutation) AddLibraryMembershipIDs(ids ...uuid.UUID) {
+	if m.library_memberships == nil {
+		m.library_memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.library_memberships[ids[i]] = struct{}{}
+	}
 }
 
-// IsPublic returns the value of the "is_public" field in the mutation.
-func (m *ReviewMutation) IsPublic() (r bool, exists bool) {
-	v := m.is_public
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearLibraryMemberships clears the "library_memberships" edge to the LibraryMember entity.
+func (m *UserMutation) ClearLibraryMemberships() {
+	m.clearedlibrary_memberships = true
 }
 
-// OldIsPublic returns the old "is_public" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+// LibraryMembershipsCleared reports if the "library_memberships" edge to the LibraryMember entity was cleared.
+func (m *UserMutation) LibraryMembershipsCleared() bool {
+	return m.clearedlibrary_memberships
+}
+
+// RemoveLibraryMembershipIDs removes the "library_memberships" edge to the LibraryMember entity by IDs.
+func (m *UserMutation) RemoveLibraryMembershipIDs(ids ...uuid.UUID) {
+	if m.removedlibrary_memberships == nil {
+		m.removedlibrary_memberships = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	for i := range ids {
+		delete(m.library_memberships, ids[i])
+		m.removedlibrary_memberships[ids[i]] = struct{}{}
 	}
-	return oldValue.IsPublic, nil
 }
 
-// ResetIsPublic resets all changes to the "is_public" field.
-func (m *ReviewMutation) ResetIsPublic() {
-	m.is_public = nil
+// RemovedLibraryMemberships returns the removed IDs of the "library_memberships" edge to the LibraryMember entity.
+func (m *UserMutation) RemovedLibraryMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removedlibrary_memberships {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ReviewMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// LibraryMembershipsIDs returns the "library_memberships" edge IDs in the mutation.
+func (m *UserMutation) LibraryMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.library_memberships {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ReviewMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetLibraryMemberships resets all changes to the "library_memberships" edge.
+func (m *UserMutation) ResetLibraryMemberships() {
+	m.library_memberships = nil
+	m.clearedlibrary_memberships = false
+	m.removedlibrary_memberships = nil
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// AddSentLibraryInvitationIDs adds the "sent_library_invitations" edge to the LibraryInvitation entity by ids.
+func (m *UserMutation) AddSentLibraryInvitationIDs(ids ...uuid.UUID) {
+	if m.sent_library_invitations == nil {
+		m.sent_library_invitations = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		m.sent_library_invitations[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ReviewMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearSentLibraryInvitations clears the "sent_library_invitations" edge to the LibraryInvitation entity.
+func (m *UserMutation) ClearSentLibraryInvitations() {
+	m.clearedsent_library_invitations = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ReviewMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SentLibraryInvitationsCleared reports if the "sent_library_invitations" edge to the LibraryInvitation entity was cleared.
+func (m *UserMutation) SentLibraryInvitationsCleared() bool {
+	return m.clearedsent_library_invitations
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ReviewMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemoveSentLibraryInvitationIDs removes the "sent_library_invitations" edge to the LibraryInvitation entity by IDs.
+func (m *UserMutation) RemoveSentLibraryInvitationIDs(ids ...uuid.UUID) {
+	if m.removedsent_library_invitations == nil {
+		m.removedsent_library_invitations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sent_library_invitations, ids[i])
+		m.removedsent_library_invitations[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Review entity.
-// If the Review object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ReviewMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// RemovedSentLibraryInvitations returns the removed IDs of the "sent_library_invitations" edge to the LibraryInvitation entity.
+func (m *UserMutation) RemovedSentLibraryInvitationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsent_library_invitations {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// SentLibraryInvitationsIDs returns the "sent_library_invitations" edge IDs in the mutation.
+func (m *UserMutation) SentLibraryInvitationsIDs() (ids []uuid.UUID) {
+	for id := range m.sent_library_invitations {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ReviewMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetSentLibraryInvitations resets all changes to the "sent_library_invitations" edge.
+func (m *UserMutation) ResetSentLibraryInvitations() {
+	m.sent_library_invitations = nil
+	m.clearedsent_library_invitations = false
+	m.removedsent_library_invitations = nil
 }
 
-// SetOwnerID sets the "owner" edge to the User entity by id.
-func (m *ReviewMutation) SetOwnerID(id uuid.UUID) {
-	m.owner = &id
+// AddReceivedLibraryInvitationIDs adds the "received_library_invitations" edge to the LibraryInvitation entity by ids.
+func (m *UserMutation) AddReceivedLibraryInvitationIDs(ids ...uuid.UUID) {
+	if m.received_library_invitations == nil {
+		m.received_library_invitations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.received_library_invitations[ids[i]] = struct{}{}
+	}
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *ReviewMutation) ClearOwner() {
-	m.clearedowner = true
+// ClearReceivedLibraryInvitations clears the "received_library_invitations" edge to the LibraryInvitation entity.
+func (m *UserMutation) ClearReceivedLibraryInvitations() {
+	m.clearedreceived_library_invitations = true
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *ReviewMutation) OwnerCleared() bool {
-	return m.clearedowner
+// ReceivedLibraryInvitationsCleared reports if the "received_library_invitations" edge to the LibraryInvitation entity was cleared.
+func (m *UserMutation) ReceivedLibraryInvitationsCleared() bool {
+	return m.clearedreceived_library_invitations
 }
 
-// OwnerID returns the "owner" edge ID in the mutation.
-func (m *ReviewMutation) OwnerID() (id uuid.UUID, exists bool) {
-	if m.owner != nil {
-		return *m.owner, true
+// RemoveReceivedLibraryInvitationIDs removes the "received_library_invitations" edge to the LibraryInvitation entity by IDs.
+func (m *UserMutation) RemoveReceivedLibraryInvitationIDs(ids ...uuid.UUID) {
+	if m.removedreceived_library_invitations == nil {
+		m.removedreceived_library_invitations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.received_library_invitations, ids[i])
+		m.removedreceived_library_invitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReceivedLibraryInvitations returns the removed IDs of the "received_library_invitations" edge to the LibraryInvitation entity.
+func (m *UserMutation) RemovedReceivedLibraryInvitationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreceived_library_invitations {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *ReviewMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// ReceivedLibraryInvitationsIDs returns the "received_library_invitations" edge IDs in the mutation.
+func (m *UserMutation) ReceivedLibraryInvitationsIDs() (ids []uuid.UUID) {
+	for id := range m.received_library_invitations {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *ReviewMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// ResetReceivedLibraryInvitations resets all changes to the "received_library_invitations" edge.
+func (m *UserMutation) ResetReceivedLibraryInvitations() {
+	m.received_library_invitations = nil
+	m.clearedreceived_library_invitations = false
+	m.removedreceived_library_invitations = nil
 }
 
-// SetBookID sets the "book" edge to the Book entity by id.
-func (m *ReviewMutation) SetBookID(id uuid.UUID) {
-	m.book = &id
+// AddReadingGoalIDs adds the "reading_goals" edge to the ReadingGoal entity by ids.
+func (m *UserMutation) AddReadingGoalIDs(ids ...uuid.UUID) {
+	if m.reading_goals == nil {
+		m.reading_goals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reading_goals[ids[i]] = struct{}{}
+	}
 }
 
-// ClearBook clears the "book" edge to the Book entity.
-func (m *ReviewMutation) ClearBook() {
-	m.clearedbook = true
+// ClearReadingGoals clears the "reading_goals" edge to the ReadingGoal entity.
+func (m *UserMutation) ClearReadingGoals() {
+	m.clearedreading_goals = true
 }
 
-// BookCleared reports if the "book" edge to the Book entity was cleared.
-func (m *ReviewMutation) BookCleared() bool {
-	return m.clearedbook
+// ReadingGoalsCleared reports if the "reading_goals" edge to the ReadingGoal entity was cleared.
+func (m *UserMutation) ReadingGoalsCleared() bool {
+	return m.clearedreading_goals
 }
 
-// BookID returns the "book" edge ID in the mutation.
-func (m *ReviewMutation) BookID() (id uuid.UUID, exists bool) {
-	if m.book != nil {
-		return *m.book, true
+// RemoveReadingGoalIDs removes the "reading_goals" edge to the ReadingGoal entity by IDs.
+func (m *UserMutation) RemoveReadingGoalIDs(ids ...uuid.UUID) {
+	if m.removedreading_goals == nil {
+		m.removedreading_goals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reading_goals, ids[i])
+		m.removedreading_goals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReadingGoals returns the removed IDs of the "reading_goals" edge to the ReadingGoal entity.
+func (m *UserMutation) RemovedReadingGoalsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreading_goals {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// BookIDs returns the "book" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// BookID instead. It exists only for internal usage by the builders.
-func (m *ReviewMutation) BookIDs() (ids []uuid.UUID) {
-	if id := m.book; id != nil {
-		ids = append(ids, *id)
+// ReadingGoalsIDs returns the "reading_goals" edge IDs in the mutation.
+func (m *UserMutation) ReadingGoalsIDs() (ids []uuid.UUID) {
+	for id := range m.reading_goals {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetBook resets all changes to the "book" edge.
-func (m *ReviewMutation) ResetBook() {
-	m.book = nil
-	m.clearedbook = false
+// ResetReadingGoals resets all changes to the "reading_goals" edge.
+func (m *UserMutation) ResetReadingGoals() {
+	m.reading_goals = nil
+	m.clearedreading_goals = false
+	m.removedreading_goals = nil
 }
 
-// Where appends a list predicates to the ReviewMutation builder.
-func (m *ReviewMutation) Where(ps ...predicate.Review) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ReviewMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ReviewMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Review, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3891,42 +18848,57 @@ func (m *ReviewMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ReviewMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ReviewMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Review).
-func (m *ReviewMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ReviewMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.book_isbn != nil {
-		fields = append(fields, review.FieldBookIsbn)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.nick_name != nil {
+		fields = append(fields, user.FieldNickName)
 	}
-	if m.content != nil {
-		fields = append(fields, review.FieldContent)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.rating != nil {
-		fields = append(fields, review.FieldRating)
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.is_published != nil {
+		fields = append(fields, user.FieldIsPublished)
+	}
+	if m.is_terms_agreed != nil {
+		fields = append(fields, user.FieldIsTermsAgreed)
+	}
+	if m.is_privacy_agreed != nil {
+		fields = append(fields, user.FieldIsPrivacyAgreed)
+	}
+	if m.fcm_token != nil {
+		fields = append(fields, user.FieldFcmToken)
 	}
-	if m.is_public != nil {
-		fields = append(fields, review.FieldIsPublic)
+	if m.timezone != nil {
+		fields = append(fields, user.FieldTimezone)
+	}
+	if m.wishlist_share_token != nil {
+		fields = append(fields, user.FieldWishlistShareToken)
 	}
 	if m.created_at != nil {
-		fields = append(fields, review.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, review.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3934,19 +18906,29 @@ func (m *ReviewMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case review.FieldBookIsbn:
-		return m.BookIsbn()
-	case review.FieldContent:
-		return m.Content()
-	case review.FieldRating:
-		return m.Rating()
-	case review.FieldIsPublic:
-		return m.IsPublic()
-	case review.FieldCreatedAt:
+	case user.FieldNickName:
+		return m.NickName()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldIsPublished:
+		return m.IsPublished()
+	case user.FieldIsTermsAgreed:
+		return m.IsTermsAgreed()
+	case user.FieldIsPrivacyAgreed:
+		return m.IsPrivacyAgreed()
+	case user.FieldFcmToken:
+		return m.FcmToken()
+	case user.FieldTimezone:
+		return m.Timezone()
+	case user.FieldWishlistShareToken:
+		return m.WishlistShareToken()
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case review.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3955,65 +18937,110 @@ func (m *ReviewMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ReviewMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case review.FieldBookIsbn:
-		return m.OldBookIsbn(ctx)
-	case review.FieldContent:
-		return m.OldContent(ctx)
-	case review.FieldRating:
-		return m.OldRating(ctx)
-	case review.FieldIsPublic:
-		return m.OldIsPublic(ctx)
-	case review.FieldCreatedAt:
+	case user.FieldNickName:
+		return m.OldNickName(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldIsPublished:
+		return m.OldIsPublished(ctx)
+	case user.FieldIsTermsAgreed:
+		return m.OldIsTermsAgreed(ctx)
+	case user.FieldIsPrivacyAgreed:
+		return m.OldIsPrivacyAgreed(ctx)
+	case user.FieldFcmToken:
+		return m.OldFcmToken(ctx)
+	case user.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case user.FieldWishlistShareToken:
+		return m.OldWishlistShareToken(ctx)
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case review.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Review field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReviewMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case review.FieldBookIsbn:
+	case user.FieldNickName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBookIsbn(v)
+		m.SetNickName(v)
 		return nil
-	case review.FieldContent:
+	case user.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetEmail(v)
 		return nil
-	case review.FieldRating:
-		v, ok := value.(int)
+	case user.FieldPassword:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRating(v)
+		m.SetPassword(v)
 		return nil
-	case review.FieldIsPublic:
+	case user.FieldIsPublished:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsPublic(v)
+		m.SetIsPublished(v)
 		return nil
-	case review.FieldCreatedAt:
+	case user.FieldIsTermsAgreed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsTermsAgreed(v)
+		return nil
+	case user.FieldIsPrivacyAgreed:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPrivacyAgreed(v)
+		return nil
+	case user.FieldFcmToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFcmToken(v)
+		return nil
+	case user.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case user.FieldWishlistShareToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWishlistShareToken(v)
+		return nil
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case review.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4021,228 +19048,626 @@ func (m *ReviewMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Review field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ReviewMutation) AddedFields() []string {
-	var fields []string
-	if m.addrating != nil {
-		fields = append(fields, review.FieldRating)
-	}
-	return fields
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ReviewMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case review.FieldRating:
-		return m.AddedRating()
-	}
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ReviewMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case review.FieldRating:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRating(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Review numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ReviewMutation) ClearedFields() []string {
-	return nil
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldPassword) {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.FieldCleared(user.FieldFcmToken) {
+		fields = append(fields, user.FieldFcmToken)
+	}
+	if m.FieldCleared(user.FieldWishlistShareToken) {
+		fields = append(fields, user.FieldWishlistShareToken)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ReviewMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ReviewMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Review nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case user.FieldFcmToken:
+		m.ClearFcmToken()
+		return nil
+	case user.FieldWishlistShareToken:
+		m.ClearWishlistShareToken()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ReviewMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case review.FieldBookIsbn:
-		m.ResetBookIsbn()
+	case user.FieldNickName:
+		m.ResetNickName()
 		return nil
-	case review.FieldContent:
-		m.ResetContent()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case review.FieldRating:
-		m.ResetRating()
+	case user.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case review.FieldIsPublic:
-		m.ResetIsPublic()
+	case user.FieldIsPublished:
+		m.ResetIsPublished()
 		return nil
-	case review.FieldCreatedAt:
+	case user.FieldIsTermsAgreed:
+		m.ResetIsTermsAgreed()
+		return nil
+	case user.FieldIsPrivacyAgreed:
+		m.ResetIsPrivacyAgreed()
+		return nil
+	case user.FieldFcmToken:
+		m.ResetFcmToken()
+		return nil
+	case user.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case user.FieldWishlistShareToken:
+		m.ResetWishlistShareToken()
+		return nil
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case review.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Review field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ReviewMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.owner != nil {
-		edges = append(edges, review.EdgeOwner)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 16)
+	if m.books != nil {
+		edges = append(edges, user.EdgeBooks)
 	}
-	if m.book != nil {
-		edges = append(edges, review.EdgeBook)
+	if m.reviews != nil {
+		edges = append(edges, user.EdgeReviews)
+	}
+	if m.bookmarks != nil {
+		edges = append(edges, user.EdgeBookmarks)
+	}
+	if m.reading_reminders != nil {
+		edges = append(edges, user.EdgeReadingReminders)
+	}
+	if m.reading_sessions != nil {
+		edges = append(edges, user.EdgeReadingSessions)
+	}
+	if m.shelves != nil {
+		edges = append(edges, user.EdgeShelves)
+	}
+	if m.tags != nil {
+		edges = append(edges, user.EdgeTags)
+	}
+	if m.book_notes != nil {
+		edges = append(edges, user.EdgeBookNotes)
+	}
+	if m.loans != nil {
+		edges = append(edges, user.EdgeLoans)
+	}
+	if m.sent_borrow_requests != nil {
+		edges = append(edges, user.EdgeSentBorrowRequests)
+	}
+	if m.received_borrow_requests != nil {
+		edges = append(edges, user.EdgeReceivedBorrowRequests)
+	}
+	if m.wishlist_items != nil {
+		edges = append(edges, user.EdgeWishlistItems)
+	}
+	if m.library_memberships != nil {
+		edges = append(edges, user.EdgeLibraryMemberships)
+	}
+	if m.sent_library_invitations != nil {
+		edges = append(edges, user.EdgeSentLibraryInvitations)
+	}
+	if m.received_library_invitations != nil {
+		edges = append(edges, user.EdgeReceivedLibraryInvitations)
+	}
+	if m.reading_goals != nil {
+		edges = append(edges, user.EdgeReadingGoals)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ReviewMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case review.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
+	case user.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.books))
+		for id := range m.books {
+			ids = append(ids, id)
 		}
-	case review.EdgeBook:
-		if id := m.book; id != nil {
-			return []ent.Value{*id}
+		return ids
+	case user.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.reviews))
+		for id := range m.reviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.bookmarks))
+		for id := range m.bookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReadingReminders:
+		ids := make([]ent.Value, 0, len(m.reading_reminders))
+		for id := range m.reading_reminders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReadingSessions:
+		ids := make([]ent.Value, 0, len(m.reading_sessions))
+		for id := range m.reading_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShelves:
+		ids := make([]ent.Value, 0, len(m.shelves))
+		for id := range m.shelves {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookNotes:
+		ids := make([]ent.Value, 0, len(m.book_notes))
+		for id := range m.book_notes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLoans:
+		ids := make([]ent.Value, 0, len(m.loans))
+		for id := range m.loans {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentBorrowRequests:
+		ids := make([]ent.Value, 0, len(m.sent_borrow_requests))
+		for id := range m.sent_borrow_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReceivedBorrowRequests:
+		ids := make([]ent.Value, 0, len(m.received_borrow_requests))
+		for id := range m.received_borrow_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWishlistItems:
+		ids := make([]ent.Value, 0, len(m.wishlist_items))
+		for id := range m.wishlist_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLibraryMemberships:
+		ids := make([]ent.Value, 0, len(m.library_memberships))
+		for id := range m.library_memberships {
+			ids = append(ids, id)
 		}
+		return ids
+	case user.EdgeSentLibraryInvitations:
+		ids := make([]ent.Value, 0, len(m.sent_library_invitations))
+		for id := range m.sent_library_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReceivedLibraryInvitations:
+		ids := make([]ent.Value, 0, len(m.received_library_invitations))
+		for id := range m.received_library_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReadingGoals:
+		ids := make([]ent.Value, 0, len(m.reading_goals))
+		for id := range m.reading_goals {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ReviewMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 16)
+	if m.removedbooks != nil {
+		edges = append(edges, user.EdgeBooks)
+	}
+	if m.removedreviews != nil {
+		edges = append(edges, user.EdgeReviews)
+	}
+	if m.removedbookmarks != nil {
+		edges = append(edges, user.EdgeBookmarks)
+	}
+	if m.removedreading_reminders != nil {
+		edges = append(edges, user.EdgeReadingReminders)
+	}
+	if m.removedreading_sessions != nil {
+		edges = append(edges, user.EdgeReadingSessions)
+	}
+	if m.removedshelves != nil {
+		edges = append(edges, user.EdgeShelves)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, user.EdgeTags)
+	}
+	if m.removedbook_notes != nil {
+		edges = append(edges, user.EdgeBookNotes)
+	}
+	if m.removedloans != nil {
+		edges = append(edges, user.EdgeLoans)
+	}
+	if m.removedsent_borrow_requests != nil {
+		edges = append(edges, user.EdgeSentBorrowRequests)
+	}
+	if m.removedreceived_borrow_requests != nil {
+		edges = append(edges, user.EdgeReceivedBorrowRequests)
+	}
+	if m.removedwishlist_items != nil {
+		edges = append(edges, user.EdgeWishlistItems)
+	}
+	if m.removedlibrary_memberships != nil {
+		edges = append(edges, user.EdgeLibraryMemberships)
+	}
+	if m.removedsent_library_invitations != nil {
+		edges = append(edges, user.EdgeSentLibraryInvitations)
+	}
+	if m.removedreceived_library_invitations != nil {
+		edges = append(edges, user.EdgeReceivedLibraryInvitations)
+	}
+	if m.removedreading_goals != nil {
+		edges = append(edges, user.EdgeReadingGoals)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ReviewMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeBooks:
+		ids := make([]ent.Value, 0, len(m.removedbooks))
+		for id := range m.removedbooks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReviews:
+		ids := make([]ent.Value, 0, len(m.removedreviews))
+		for id := range m.removedreviews {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.removedbookmarks))
+		for id := range m.removedbookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReadingReminders:
+		ids := make([]ent.Value, 0, len(m.removedreading_reminders))
+		for id := range m.removedreading_reminders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReadingSessions:
+		ids := make([]ent.Value, 0, len(m.removedreading_sessions))
+		for id := range m.removedreading_sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeShelves:
+		ids := make([]ent.Value, 0, len(m.removedshelves))
+		for id := range m.removedshelves {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBookNotes:
+		ids := make([]ent.Value, 0, len(m.removedbook_notes))
+		for id := range m.removedbook_notes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLoans:
+		ids := make([]ent.Value, 0, len(m.removedloans))
+		for id := range m.removedloans {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentBorrowRequests:
+		ids := make([]ent.Value, 0, len(m.removedsent_borrow_requests))
+		for id := range m.removedsent_borrow_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReceivedBorrowRequests:
+		ids := make([]ent.Value, 0, len(m.removedreceived_borrow_requests))
+		for id := range m.removedreceived_borrow_requests {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWishlistItems:
+		ids := make([]ent.Value, 0, len(m.removedwishlist_items))
+		for id := range m.removedwishlist_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeLibraryMemberships:
+		ids := make([]ent.Value, 0, len(m.removedlibrary_memberships))
+		for id := range m.removedlibrary_memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSentLibraryInvitations:
+		ids := make([]ent.Value, 0, len(m.removedsent_library_invitations))
+		for id := range m.removedsent_library_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReceivedLibraryInvitations:
+		ids := make([]ent.Value, 0, len(m.removedreceived_library_invitations))
+		for id := range m.removedreceived_library_invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeReadingGoals:
+		ids := make([]ent.Value, 0, len(m.removedreading_goals))
+		for id := range m.removedreading_goals {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ReviewMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedowner {
-		edges = append(edges, review.EdgeOwner)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 16)
+	if m.clearedbooks {
+		edges = append(edges, user.EdgeBooks)
 	}
-	if m.clearedbook {
-		edges = append(edges, review.EdgeBook)
+	if m.clearedreviews {
+		edges = append(edges, user.EdgeReviews)
+	}
+	if m.clearedbookmarks {
+		edges = append(edges, user.EdgeBookmarks)
+	}
+	if m.clearedreading_reminders {
+		edges = append(edges, user.EdgeReadingReminders)
+	}
+	if m.clearedreading_sessions {
+		edges = append(edges, user.EdgeReadingSessions)
+	}
+	if m.clearedshelves {
+		edges = append(edges, user.EdgeShelves)
+	}
+	if m.clearedtags {
+		edges = append(edges, user.EdgeTags)
+	}
+	if m.clearedbook_notes {
+		edges = append(edges, user.EdgeBookNotes)
+	}
+	if m.clearedloans {
+		edges = append(edges, user.EdgeLoans)
+	}
+	if m.clearedsent_borrow_requests {
+		edges = append(edges, user.EdgeSentBorrowRequests)
+	}
+	if m.clearedreceived_borrow_requests {
+		edges = append(edges, user.EdgeReceivedBorrowRequests)
+	}
+	if m.clearedwishlist_items {
+		edges = append(edges, user.EdgeWishlistItems)
+	}
+	if m.clearedlibrary_memberships {
+		edges = append(edges, user.EdgeLibraryMemberships)
+	}
+	if m.clearedsent_library_invitations {
+		edges = append(edges, user.EdgeSentLibraryInvitations)
+	}
+	if m.clearedreceived_library_invitations {
+		edges = append(edges, user.EdgeReceivedLibraryInvitations)
+	}
+	if m.clearedreading_goals {
+		edges = append(edges, user.EdgeReadingGoals)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ReviewMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case review.EdgeOwner:
-		return m.clearedowner
-	case review.EdgeBook:
-		return m.clearedbook
+	case user.EdgeBooks:
+		return m.clearedbooks
+	case user.EdgeReviews:
+		return m.clearedreviews
+	case user.EdgeBookmarks:
+		return m.clearedbookmarks
+	case user.EdgeReadingReminders:
+		return m.clearedreading_reminders
+	case user.EdgeReadingSessions:
+		return m.clearedreading_sessions
+	case user.EdgeShelves:
+		return m.clearedshelves
+	case user.EdgeTags:
+		return m.clearedtags
+	case user.EdgeBookNotes:
+		return m.clearedbook_notes
+	case user.EdgeLoans:
+		return m.clearedloans
+	case user.EdgeSentBorrowRequests:
+		return m.clearedsent_borrow_requests
+	case user.EdgeReceivedBorrowRequests:
+		return m.clearedreceived_borrow_requests
+	case user.EdgeWishlistItems:
+		return m.clearedwishlist_items
+	case user.EdgeLibraryMemberships:
+		return m.clearedlibrary_memberships
+	case user.EdgeSentLibraryInvitations:
+		return m.clearedsent_library_invitations
+	case user.EdgeReceivedLibraryInvitations:
+		return m.clearedreceived_library_invitations
+	case user.EdgeReadingGoals:
+		return m.clearedreading_goals
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ReviewMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case review.EdgeOwner:
-		m.ClearOwner()
-		return nil
-	case review.EdgeBook:
-		m.ClearBook()
-		return nil
 	}
-	return fmt.Errorf("unknown Review unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ReviewMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case review.EdgeOwner:
-		m.ResetOwner()
+	case user.EdgeBooks:
+		m.ResetBooks()
 		return nil
-	case review.EdgeBook:
-		m.ResetBook()
+	case user.EdgeReviews:
+		m.ResetReviews()
+		return nil
+	case user.EdgeBookmarks:
+		m.ResetBookmarks()
+		return nil
+	case user.EdgeReadingReminders:
+		m.ResetReadingReminders()
+		return nil
+	case user.EdgeReadingSessions:
+		m.ResetReadingSessions()
+		return nil
+	case user.EdgeShelves:
+		m.ResetShelves()
+		return nil
+	case user.EdgeTags:
+		m.ResetTags()
+		return nil
+	case user.EdgeBookNotes:
+		m.ResetBookNotes()
+		return nil
+	case user.EdgeLoans:
+		m.ResetLoans()
+		return nil
+	case user.EdgeSentBorrowRequests:
+		m.ResetSentBorrowRequests()
+		return nil
+	case user.EdgeReceivedBorrowRequests:
+		m.ResetReceivedBorrowRequests()
+		return nil
+	case user.EdgeWishlistItems:
+		m.ResetWishlistItems()
+		return nil
+	case user.EdgeLibraryMemberships:
+		m.ResetLibraryMemberships()
+		return nil
+	case user.EdgeSentLibraryInvitations:
+		m.ResetSentLibraryInvitations()
+		return nil
+	case user.EdgeReceivedLibraryInvitations:
+		m.ResetReceivedLibraryInvitations()
+		return nil
+	case user.EdgeReadingGoals:
+		m.ResetReadingGoals()
 		return nil
 	}
-	return fmt.Errorf("unknown Review edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WishlistItemMutation represents an operation that mutates the WishlistItem nodes in the graph.
+type WishlistItemMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *uuid.UUID
-	nick_name                *string
-	email                    *string
-	password                 *string
-	is_published             *bool
-	is_terms_agreed          *bool
-	is_privacy_agreed        *bool
-	fcm_token                *string
-	timezone                 *string
-	created_at               *time.Time
-	updated_at               *time.Time
-	clearedFields            map[string]struct{}
-	books                    map[uuid.UUID]struct{}
-	removedbooks             map[uuid.UUID]struct{}
-	clearedbooks             bool
-	reviews                  map[uuid.UUID]struct{}
-	removedreviews           map[uuid.UUID]struct{}
-	clearedreviews           bool
-	bookmarks                map[uuid.UUID]struct{}
-	removedbookmarks         map[uuid.UUID]struct{}
-	clearedbookmarks         bool
-	reading_reminders        map[uuid.UUID]struct{}
-	removedreading_reminders map[uuid.UUID]struct{}
-	clearedreading_reminders bool
-	done                     bool
-	oldValue                 func(context.Context) (*User, error)
-	predicates               []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	title           *string
+	author          *string
+	isbn            *string
+	publisher       *string
+	thumbnail_url   *string
+	priority        *wishlistitem.Priority
+	note            *string
+	target_price    *float64
+	addtarget_price *float64
+	currency        *string
+	claimed_by      *string
+	claim_code      *string
+	claimed_at      *time.Time
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	owner           *uuid.UUID
+	clearedowner    bool
+	done            bool
+	oldValue        func(context.Context) (*WishlistItem, error)
+	predicates      []predicate.WishlistItem
+}
+
+var _ ent.Mutation = (*WishlistItemMutation)(nil)
+
+// wishlistitemOption allows management of the mutation configuration using functional options.
+type wishlistitemOption func(*WishlistItemMutation)
+
+// newWishlistItemMutation creates new mutation for the WishlistItem entity.
+func newWishlistItemMutation(c config, op Op, opts ...wishlistitemOption) *WishlistItemMutation {
+	m := &WishlistItemMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWishlistItem,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4251,20 +19676,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withWishlistItemID sets the ID field of the mutation.
+func withWishlistItemID(id uuid.UUID) wishlistitemOption {
+	return func(m *WishlistItemMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WishlistItem
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WishlistItem, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WishlistItem.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4273,10 +19698,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWishlistItem sets the old WishlistItem of the mutation.
+func withWishlistItem(node *WishlistItem) wishlistitemOption {
+	return func(m *WishlistItemMutation) {
+		m.oldValue = func(context.Context) (*WishlistItem, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4285,7 +19710,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WishlistItemMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4293,7 +19718,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WishlistItemMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4303,14 +19728,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WishlistItem entities.
+func (m *WishlistItemMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WishlistItemMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4321,7 +19746,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WishlistItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4330,623 +19755,637 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WishlistItem.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetNickName sets the "nick_name" field.
-func (m *UserMutation) SetNickName(s string) {
-	m.nick_name = &s
+// SetTitle sets the "title" field.
+func (m *WishlistItemMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// NickName returns the value of the "nick_name" field in the mutation.
-func (m *UserMutation) NickName() (r string, exists bool) {
-	v := m.nick_name
+// Title returns the value of the "title" field in the mutation.
+func (m *WishlistItemMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNickName returns the old "nick_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickName(ctx context.Context) (v string, err error) {
+func (m *WishlistItemMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickName is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickName requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickName: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.NickName, nil
+	return oldValue.Title, nil
 }
 
-// ResetNickName resets all changes to the "nick_name" field.
-func (m *UserMutation) ResetNickName() {
-	m.nick_name = nil
+// ResetTitle resets all changes to the "title" field.
+func (m *WishlistItemMutation) ResetTitle() {
+	m.title = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetAuthor sets the "author" field.
+func (m *WishlistItemMutation) SetAuthor(s string) {
+	m.author = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// Author returns the value of the "author" field in the mutation.
+func (m *WishlistItemMutation) Author() (r string, exists bool) {
+	v := m.author
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldAuthor returns the old "author" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *WishlistItemMutation) OldAuthor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldAuthor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldAuthor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldAuthor: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.Author, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ResetAuthor resets all changes to the "author" field.
+func (m *WishlistItemMutation) ResetAuthor() {
+	m.author = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetIsbn sets the "isbn" field.
+func (m *WishlistItemMutation) SetIsbn(s string) {
+	m.isbn = &s
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// Isbn returns the value of the "isbn" field in the mutation.
+func (m *WishlistItemMutation) Isbn() (r string, exists bool) {
+	v := m.isbn
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldIsbn returns the old "isbn" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+func (m *WishlistItemMutation) OldIsbn(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsbn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldIsbn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsbn: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.Isbn, nil
 }
 
-// ClearPassword clears the value of the "password" field.
-func (m *UserMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[user.FieldPassword] = struct{}{}
+// ClearIsbn clears the value of the "isbn" field.
+func (m *WishlistItemMutation) ClearIsbn() {
+	m.isbn = nil
+	m.clearedFields[wishlistitem.FieldIsbn] = struct{}{}
 }
 
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *UserMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[user.FieldPassword]
+// IsbnCleared returns if the "isbn" field was cleared in this mutation.
+func (m *WishlistItemMutation) IsbnCleared() bool {
+	_, ok := m.clearedFields[wishlistitem.FieldIsbn]
 	return ok
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, user.FieldPassword)
+// ResetIsbn resets all changes to the "isbn" field.
+func (m *WishlistItemMutation) ResetIsbn() {
+	m.isbn = nil
+	delete(m.clearedFields, wishlistitem.FieldIsbn)
 }
 
-// SetIsPublished sets the "is_published" field.
-func (m *UserMutation) SetIsPublished(b bool) {
-	m.is_published = &b
+// SetPublisher sets the "publisher" field.
+func (m *WishlistItemMutation) SetPublisher(s string) {
+	m.publisher = &s
 }
 
-// IsPublished returns the value of the "is_published" field in the mutation.
-func (m *UserMutation) IsPublished() (r bool, exists bool) {
-	v := m.is_published
+// Publisher returns the value of the "publisher" field in the mutation.
+func (m *WishlistItemMutation) Publisher() (r string, exists bool) {
+	v := m.publisher
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPublished returns the old "is_published" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPublisher returns the old "publisher" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsPublished(ctx context.Context) (v bool, err error) {
+func (m *WishlistItemMutation) OldPublisher(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublished is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublisher is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublished requires an ID field in the mutation")
+		return v, errors.New("OldPublisher requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublished: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublisher: %w", err)
 	}
-	return oldValue.IsPublished, nil
+	return oldValue.Publisher, nil
 }
 
-// ResetIsPublished resets all changes to the "is_published" field.
-func (m *UserMutation) ResetIsPublished() {
-	m.is_published = nil
+// ResetPublisher resets all changes to the "publisher" field.
+func (m *WishlistItemMutation) ResetPublisher() {
+	m.publisher = nil
 }
 
-// SetIsTermsAgreed sets the "is_terms_agreed" field.
-func (m *UserMutation) SetIsTermsAgreed(b bool) {
-	m.is_terms_agreed = &b
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *WishlistItemMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
 }
 
-// IsTermsAgreed returns the value of the "is_terms_agreed" field in the mutation.
-func (m *UserMutation) IsTermsAgreed() (r bool, exists bool) {
-	v := m.is_terms_agreed
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *WishlistItemMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsTermsAgreed returns the old "is_terms_agreed" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsTermsAgreed(ctx context.Context) (v bool, err error) {
+func (m *WishlistItemMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsTermsAgreed is only allowed on UpdateOne operations")
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsTermsAgreed requires an ID field in the mutation")
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsTermsAgreed: %w", err)
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
 	}
-	return oldValue.IsTermsAgreed, nil
+	return oldValue.ThumbnailURL, nil
 }
 
-// ResetIsTermsAgreed resets all changes to the "is_terms_agreed" field.
-func (m *UserMutation) ResetIsTermsAgreed() {
-	m.is_terms_agreed = nil
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *WishlistItemMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
 }
 
-// SetIsPrivacyAgreed sets the "is_privacy_agreed" field.
-func (m *UserMutation) SetIsPrivacyAgreed(b bool) {
-	m.is_privacy_agreed = &b
+// SetPriority sets the "priority" field.
+func (m *WishlistItemMutation) SetPriority(w wishlistitem.Priority) {
+	m.priority = &w
 }
 
-// IsPrivacyAgreed returns the value of the "is_privacy_agreed" field in the mutation.
-func (m *UserMutation) IsPrivacyAgreed() (r bool, exists bool) {
-	v := m.is_privacy_agreed
+// Priority returns the value of the "priority" field in the mutation.
+func (m *WishlistItemMutation) Priority() (r wishlistitem.Priority, exists bool) {
+	v := m.priority
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsPrivacyAgreed returns the old "is_privacy_agreed" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldPriority returns the old "priority" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsPrivacyAgreed(ctx context.Context) (v bool, err error) {
+func (m *WishlistItemMutation) OldPriority(ctx context.Context) (v wishlistitem.Priority, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPrivacyAgreed is only allowed on UpdateOne operations")
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPrivacyAgreed requires an ID field in the mutation")
+		return v, errors.New("OldPriority requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPrivacyAgreed: %w", err)
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
 	}
-	return oldValue.IsPrivacyAgreed, nil
+	return oldValue.Priority, nil
 }
 
-// ResetIsPrivacyAgreed resets all changes to the "is_privacy_agreed" field.
-func (m *UserMutation) ResetIsPrivacyAgreed() {
-	m.is_privacy_agreed = nil
+// ResetPriority resets all changes to the "priority" field.
+func (m *WishlistItemMutation) ResetPriority() {
+	m.priority = nil
 }
 
-// SetFcmToken sets the "fcm_token" field.
-func (m *UserMutation) SetFcmToken(s string) {
-	m.fcm_token = &s
+// SetNote sets the "note" field.
+func (m *WishlistItemMutation) SetNote(s string) {
+	m.note = &s
 }
 
-// FcmToken returns the value of the "fcm_token" field in the mutation.
-func (m *UserMutation) FcmToken() (r string, exists bool) {
-	v := m.fcm_token
+// Note returns the value of the "note" field in the mutation.
+func (m *WishlistItemMutation) Note() (r string, exists bool) {
+	v := m.note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFcmToken returns the old "fcm_token" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldNote returns the old "note" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldFcmToken(ctx context.Context) (v string, err error) {
+func (m *WishlistItemMutation) OldNote(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFcmToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFcmToken requires an ID field in the mutation")
+		return v, errors.New("OldNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFcmToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
 	}
-	return oldValue.FcmToken, nil
+	return oldValue.Note, nil
 }
 
-// ClearFcmToken clears the value of the "fcm_token" field.
-func (m *UserMutation) ClearFcmToken() {
-	m.fcm_token = nil
-	m.clearedFields[user.FieldFcmToken] = struct{}{}
+// ClearNote clears the value of the "note" field.
+func (m *WishlistItemMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[wishlistitem.FieldNote] = struct{}{}
 }
 
-// FcmTokenCleared returns if the "fcm_token" field was cleared in this mutation.
-func (m *UserMutation) FcmTokenCleared() bool {
-	_, ok := m.clearedFields[user.FieldFcmToken]
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *WishlistItemMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[wishlistitem.FieldNote]
 	return ok
 }
 
-// ResetFcmToken resets all changes to the "fcm_token" field.
-func (m *UserMutation) ResetFcmToken() {
-	m.fcm_token = nil
-	delete(m.clearedFields, user.FieldFcmToken)
+// ResetNote resets all changes to the "note" field.
+func (m *WishlistItemMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, wishlistitem.FieldNote)
 }
 
-// SetTimezone sets the "timezone" field.
-func (m *UserMutation) SetTimezone(s string) {
-	m.timezone = &s
+// SetTargetPrice sets the "target_price" field.
+func (m *WishlistItemMutation) SetTargetPrice(f float64) {
+	m.target_price = &f
+	m.addtarget_price = nil
 }
 
-// Timezone returns the value of the "timezone" field in the mutation.
-func (m *UserMutation) Timezone() (r string, exists bool) {
-	v := m.timezone
+// TargetPrice returns the value of the "target_price" field in the mutation.
+func (m *WishlistItemMutation) TargetPrice() (r float64, exists bool) {
+	v := m.target_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTimezone returns the old "timezone" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetPrice returns the old "target_price" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldTimezone(ctx context.Context) (v string, err error) {
+func (m *WishlistItemMutation) OldTargetPrice(ctx context.Context) (v *float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetPrice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimezone requires an ID field in the mutation")
+		return v, errors.New("OldTargetPrice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetPrice: %w", err)
 	}
-	return oldValue.Timezone, nil
-}
-
-// ResetTimezone resets all changes to the "timezone" field.
-func (m *UserMutation) ResetTimezone() {
-	m.timezone = nil
+	return oldValue.TargetPrice, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddTargetPrice adds f to the "target_price" field.
+func (m *WishlistItemMutation) AddTargetPrice(f float64) {
+	if m.addtarget_price != nil {
+		*m.addtarget_price += f
+	} else {
+		m.addtarget_price = &f
+	}
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AddedTargetPrice returns the value that was added to the "target_price" field in this mutation.
+func (m *WishlistItemMutation) AddedTargetPrice() (r float64, exists bool) {
+	v := m.addtarget_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// ClearTargetPrice clears the value of the "target_price" field.
+func (m *WishlistItemMutation) ClearTargetPrice() {
+	m.target_price = nil
+	m.addtarget_price = nil
+	m.clearedFields[wishlistitem.FieldTargetPrice] = struct{}{}
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// TargetPriceCleared returns if the "target_price" field was cleared in this mutation.
+func (m *WishlistItemMutation) TargetPriceCleared() bool {
+	_, ok := m.clearedFields[wishlistitem.FieldTargetPrice]
+	return ok
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ResetTargetPrice resets all changes to the "target_price" field.
+func (m *WishlistItemMutation) ResetTargetPrice() {
+	m.target_price = nil
+	m.addtarget_price = nil
+	delete(m.clearedFields, wishlistitem.FieldTargetPrice)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// SetCurrency sets the "currency" field.
+func (m *WishlistItemMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *WishlistItemMutation) Currency() (r string, exists bool) {
+	v := m.currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WishlistItemMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.Currency, nil
 }
 
-// AddBookIDs adds the "books" edge to the Book entity by ids.
-func (m *UserMutation) AddBookIDs(ids ...uuid.UUID) {
-	if m.books == nil {
-		m.books = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.books[ids[i]] = struct{}{}
-	}
+// ResetCurrency resets all changes to the "currency" field.
+func (m *WishlistItemMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-// ClearBooks clears the "books" edge to the Book entity.
-func (m *UserMutation) ClearBooks() {
-	m.clearedbooks = true
+// SetClaimedBy sets the "claimed_by" field.
+func (m *WishlistItemMutation) SetClaimedBy(s string) {
+	m.claimed_by = &s
 }
 
-// BooksCleared reports if the "books" edge to the Book entity was cleared.
-func (m *UserMutation) BooksCleared() bool {
-	return m.clearedbooks
+// ClaimedBy returns the value of the "claimed_by" field in the mutation.
+func (m *WishlistItemMutation) ClaimedBy() (r string, exists bool) {
+	v := m.claimed_by
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveBookIDs removes the "books" edge to the Book entity by IDs.
-func (m *UserMutation) RemoveBookIDs(ids ...uuid.UUID) {
-	if m.removedbooks == nil {
-		m.removedbooks = make(map[uuid.UUID]struct{})
+// OldClaimedBy returns the old "claimed_by" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistItemMutation) OldClaimedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedBy is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.books, ids[i])
-		m.removedbooks[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedBy requires an ID field in the mutation")
 	}
-}
-
-// RemovedBooks returns the removed IDs of the "books" edge to the Book entity.
-func (m *UserMutation) RemovedBooksIDs() (ids []uuid.UUID) {
-	for id := range m.removedbooks {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedBy: %w", err)
 	}
-	return
+	return oldValue.ClaimedBy, nil
 }
 
-// BooksIDs returns the "books" edge IDs in the mutation.
-func (m *UserMutation) BooksIDs() (ids []uuid.UUID) {
-	for id := range m.books {
-		ids = append(ids, id)
-	}
-	return
+// ResetClaimedBy resets all changes to the "claimed_by" field.
+func (m *WishlistItemMutation) ResetClaimedBy() {
+	m.claimed_by = nil
 }
 
-// ResetBooks resets all changes to the "books" edge.
-func (m *UserMutation) ResetBooks() {
-	m.books = nil
-	m.clearedbooks = false
-	m.removedbooks = nil
+// SetClaimCode sets the "claim_code" field.
+func (m *WishlistItemMutation) SetClaimCode(s string) {
+	m.claim_code = &s
 }
 
-// AddReviewIDs adds the "reviews" edge to the Review entity by ids.
-func (m *UserMutation) AddReviewIDs(ids ...uuid.UUID) {
-	if m.reviews == nil {
-		m.reviews = make(map[uuid.UUID]struct{})
+// ClaimCode returns the value of the "claim_code" field in the mutation.
+func (m *WishlistItemMutation) ClaimCode() (r string, exists bool) {
+	v := m.claim_code
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.reviews[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldClaimCode returns the old "claim_code" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistItemMutation) OldClaimCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimCode is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimCode: %w", err)
+	}
+	return oldValue.ClaimCode, nil
 }
 
-// ClearReviews clears the "reviews" edge to the Review entity.
-func (m *UserMutation) ClearReviews() {
-	m.clearedreviews = true
+// ResetClaimCode resets all changes to the "claim_code" field.
+func (m *WishlistItemMutation) ResetClaimCode() {
+	m.claim_code = nil
 }
 
-// ReviewsCleared reports if the "reviews" edge to the Review entity was cleared.
-func (m *UserMutation) ReviewsCleared() bool {
-	return m.clearedreviews
+// SetClaimedAt sets the "claimed_at" field.
+func (m *WishlistItemMutation) SetClaimedAt(t time.Time) {
+	m.claimed_at = &t
 }
 
-// RemoveReviewIDs removes the "reviews" edge to the Review entity by IDs.
-func (m *UserMutation) RemoveReviewIDs(ids ...uuid.UUID) {
-	if m.removedreviews == nil {
-		m.removedreviews = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.reviews, ids[i])
-		m.removedreviews[ids[i]] = struct{}{}
+// ClaimedAt returns the value of the "claimed_at" field in the mutation.
+func (m *WishlistItemMutation) ClaimedAt() (r time.Time, exists bool) {
+	v := m.claimed_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedReviews returns the removed IDs of the "reviews" edge to the Review entity.
-func (m *UserMutation) RemovedReviewsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreviews {
-		ids = append(ids, id)
+// OldClaimedAt returns the old "claimed_at" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistItemMutation) OldClaimedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedAt: %w", err)
+	}
+	return oldValue.ClaimedAt, nil
 }
 
-// ReviewsIDs returns the "reviews" edge IDs in the mutation.
-func (m *UserMutation) ReviewsIDs() (ids []uuid.UUID) {
-	for id := range m.reviews {
-		ids = append(ids, id)
-	}
-	return
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (m *WishlistItemMutation) ClearClaimedAt() {
+	m.claimed_at = nil
+	m.clearedFields[wishlistitem.FieldClaimedAt] = struct{}{}
 }
 
-// ResetReviews resets all changes to the "reviews" edge.
-func (m *UserMutation) ResetReviews() {
-	m.reviews = nil
-	m.clearedreviews = false
-	m.removedreviews = nil
+// ClaimedAtCleared returns if the "claimed_at" field was cleared in this mutation.
+func (m *WishlistItemMutation) ClaimedAtCleared() bool {
+	_, ok := m.clearedFields[wishlistitem.FieldClaimedAt]
+	return ok
 }
 
-// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by ids.
-func (m *UserMutation) AddBookmarkIDs(ids ...uuid.UUID) {
-	if m.bookmarks == nil {
-		m.bookmarks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.bookmarks[ids[i]] = struct{}{}
-	}
+// ResetClaimedAt resets all changes to the "claimed_at" field.
+func (m *WishlistItemMutation) ResetClaimedAt() {
+	m.claimed_at = nil
+	delete(m.clearedFields, wishlistitem.FieldClaimedAt)
 }
 
-// ClearBookmarks clears the "bookmarks" edge to the Bookmark entity.
-func (m *UserMutation) ClearBookmarks() {
-	m.clearedbookmarks = true
+// SetCreatedAt sets the "created_at" field.
+func (m *WishlistItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// BookmarksCleared reports if the "bookmarks" edge to the Bookmark entity was cleared.
-func (m *UserMutation) BookmarksCleared() bool {
-	return m.clearedbookmarks
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WishlistItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveBookmarkIDs removes the "bookmarks" edge to the Bookmark entity by IDs.
-func (m *UserMutation) RemoveBookmarkIDs(ids ...uuid.UUID) {
-	if m.removedbookmarks == nil {
-		m.removedbookmarks = make(map[uuid.UUID]struct{})
+// OldCreatedAt returns the old "created_at" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.bookmarks, ids[i])
-		m.removedbookmarks[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// RemovedBookmarks returns the removed IDs of the "bookmarks" edge to the Bookmark entity.
-func (m *UserMutation) RemovedBookmarksIDs() (ids []uuid.UUID) {
-	for id := range m.removedbookmarks {
-		ids = append(ids, id)
-	}
-	return
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WishlistItemMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// BookmarksIDs returns the "bookmarks" edge IDs in the mutation.
-func (m *UserMutation) BookmarksIDs() (ids []uuid.UUID) {
-	for id := range m.bookmarks {
-		ids = append(ids, id)
-	}
-	return
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WishlistItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ResetBookmarks resets all changes to the "bookmarks" edge.
-func (m *UserMutation) ResetBookmarks() {
-	m.bookmarks = nil
-	m.clearedbookmarks = false
-	m.removedbookmarks = nil
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WishlistItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddReadingReminderIDs adds the "reading_reminders" edge to the ReadingReminder entity by ids.
-func (m *UserMutation) AddReadingReminderIDs(ids ...uuid.UUID) {
-	if m.reading_reminders == nil {
-		m.reading_reminders = make(map[uuid.UUID]struct{})
+// OldUpdatedAt returns the old "updated_at" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.reading_reminders[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearReadingReminders clears the "reading_reminders" edge to the ReadingReminder entity.
-func (m *UserMutation) ClearReadingReminders() {
-	m.clearedreading_reminders = true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WishlistItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ReadingRemindersCleared reports if the "reading_reminders" edge to the ReadingReminder entity was cleared.
-func (m *UserMutation) ReadingRemindersCleared() bool {
-	return m.clearedreading_reminders
+// SetOwnerID sets the "owner" edge to the User entity by id.
+func (m *WishlistItemMutation) SetOwnerID(id uuid.UUID) {
+	m.owner = &id
 }
 
-// RemoveReadingReminderIDs removes the "reading_reminders" edge to the ReadingReminder entity by IDs.
-func (m *UserMutation) RemoveReadingReminderIDs(ids ...uuid.UUID) {
-	if m.removedreading_reminders == nil {
-		m.removedreading_reminders = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.reading_reminders, ids[i])
-		m.removedreading_reminders[ids[i]] = struct{}{}
-	}
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *WishlistItemMutation) ClearOwner() {
+	m.clearedowner = true
 }
 
-// RemovedReadingReminders returns the removed IDs of the "reading_reminders" edge to the ReadingReminder entity.
-func (m *UserMutation) RemovedReadingRemindersIDs() (ids []uuid.UUID) {
-	for id := range m.removedreading_reminders {
-		ids = append(ids, id)
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *WishlistItemMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerID returns the "owner" edge ID in the mutation.
+func (m *WishlistItemMutation) OwnerID() (id uuid.UUID, exists bool) {
+	if m.owner != nil {
+		return *m.owner, true
 	}
 	return
 }
 
-// ReadingRemindersIDs returns the "reading_reminders" edge IDs in the mutation.
-func (m *UserMutation) ReadingRemindersIDs() (ids []uuid.UUID) {
-	for id := range m.reading_reminders {
-		ids = append(ids, id)
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *WishlistItemMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetReadingReminders resets all changes to the "reading_reminders" edge.
-func (m *UserMutation) ResetReadingReminders() {
-	m.reading_reminders = nil
-	m.clearedreading_reminders = false
-	m.removedreading_reminders = nil
+// ResetOwner resets all changes to the "owner" edge.
+func (m *WishlistItemMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WishlistItemMutation builder.
+func (m *WishlistItemMutation) Where(ps ...predicate.WishlistItem) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WishlistItemMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WishlistItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WishlistItem, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4954,82 +20393,102 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WishlistItemMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WishlistItemMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WishlistItem).
+func (m *WishlistItemMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.nick_name != nil {
-		fields = append(fields, user.FieldNickName)
+func (m *WishlistItemMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.title != nil {
+		fields = append(fields, wishlistitem.FieldTitle)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.author != nil {
+		fields = append(fields, wishlistitem.FieldAuthor)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.isbn != nil {
+		fields = append(fields, wishlistitem.FieldIsbn)
 	}
-	if m.is_published != nil {
-		fields = append(fields, user.FieldIsPublished)
+	if m.publisher != nil {
+		fields = append(fields, wishlistitem.FieldPublisher)
 	}
-	if m.is_terms_agreed != nil {
-		fields = append(fields, user.FieldIsTermsAgreed)
+	if m.thumbnail_url != nil {
+		fields = append(fields, wishlistitem.FieldThumbnailURL)
 	}
-	if m.is_privacy_agreed != nil {
-		fields = append(fields, user.FieldIsPrivacyAgreed)
+	if m.priority != nil {
+		fields = append(fields, wishlistitem.FieldPriority)
 	}
-	if m.fcm_token != nil {
-		fields = append(fields, user.FieldFcmToken)
+	if m.note != nil {
+		fields = append(fields, wishlistitem.FieldNote)
 	}
-	if m.timezone != nil {
-		fields = append(fields, user.FieldTimezone)
+	if m.target_price != nil {
+		fields = append(fields, wishlistitem.FieldTargetPrice)
+	}
+	if m.currency != nil {
+		fields = append(fields, wishlistitem.FieldCurrency)
+	}
+	if m.claimed_by != nil {
+		fields = append(fields, wishlistitem.FieldClaimedBy)
+	}
+	if m.claim_code != nil {
+		fields = append(fields, wishlistitem.FieldClaimCode)
+	}
+	if m.claimed_at != nil {
+		fields = append(fields, wishlistitem.FieldClaimedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, wishlistitem.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, wishlistitem.FieldUpdatedAt)
 	}
 	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldNickName:
-		return m.NickName()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldIsPublished:
-		return m.IsPublished()
-	case user.FieldIsTermsAgreed:
-		return m.IsTermsAgreed()
-	case user.FieldIsPrivacyAgreed:
-		return m.IsPrivacyAgreed()
-	case user.FieldFcmToken:
-		return m.FcmToken()
-	case user.FieldTimezone:
-		return m.Timezone()
-	case user.FieldCreatedAt:
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WishlistItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wishlistitem.FieldTitle:
+		return m.Title()
+	case wishlistitem.FieldAuthor:
+		return m.Author()
+	case wishlistitem.FieldIsbn:
+		return m.Isbn()
+	case wishlistitem.FieldPublisher:
+		return m.Publisher()
+	case wishlistitem.FieldThumbnailURL:
+		return m.ThumbnailURL()
+	case wishlistitem.FieldPriority:
+		return m.Priority()
+	case wishlistitem.FieldNote:
+		return m.Note()
+	case wishlistitem.FieldTargetPrice:
+		return m.TargetPrice()
+	case wishlistitem.FieldCurrency:
+		return m.Currency()
+	case wishlistitem.FieldClaimedBy:
+		return m.ClaimedBy()
+	case wishlistitem.FieldClaimCode:
+		return m.ClaimCode()
+	case wishlistitem.FieldClaimedAt:
+		return m.ClaimedAt()
+	case wishlistitem.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case wishlistitem.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -5038,101 +20497,137 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WishlistItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldNickName:
-		return m.OldNickName(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldIsPublished:
-		return m.OldIsPublished(ctx)
-	case user.FieldIsTermsAgreed:
-		return m.OldIsTermsAgreed(ctx)
-	case user.FieldIsPrivacyAgreed:
-		return m.OldIsPrivacyAgreed(ctx)
-	case user.FieldFcmToken:
-		return m.OldFcmToken(ctx)
-	case user.FieldTimezone:
-		return m.OldTimezone(ctx)
-	case user.FieldCreatedAt:
+	case wishlistitem.FieldTitle:
+		return m.OldTitle(ctx)
+	case wishlistitem.FieldAuthor:
+		return m.OldAuthor(ctx)
+	case wishlistitem.FieldIsbn:
+		return m.OldIsbn(ctx)
+	case wishlistitem.FieldPublisher:
+		return m.OldPublisher(ctx)
+	case wishlistitem.FieldThumbnailURL:
+		return m.OldThumbnailURL(ctx)
+	case wishlistitem.FieldPriority:
+		return m.OldPriority(ctx)
+	case wishlistitem.FieldNote:
+		return m.OldNote(ctx)
+	case wishlistitem.FieldTargetPrice:
+		return m.OldTargetPrice(ctx)
+	case wishlistitem.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case wishlistitem.FieldClaimedBy:
+		return m.OldClaimedBy(ctx)
+	case wishlistitem.FieldClaimCode:
+		return m.OldClaimCode(ctx)
+	case wishlistitem.FieldClaimedAt:
+		return m.OldClaimedAt(ctx)
+	case wishlistitem.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case wishlistitem.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown WishlistItem field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WishlistItemMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldNickName:
+	case wishlistitem.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNickName(v)
+		m.SetTitle(v)
 		return nil
-	case user.FieldEmail:
+	case wishlistitem.FieldAuthor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetAuthor(v)
 		return nil
-	case user.FieldPassword:
+	case wishlistitem.FieldIsbn:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetIsbn(v)
 		return nil
-	case user.FieldIsPublished:
-		v, ok := value.(bool)
+	case wishlistitem.FieldPublisher:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsPublished(v)
+		m.SetPublisher(v)
 		return nil
-	case user.FieldIsTermsAgreed:
-		v, ok := value.(bool)
+	case wishlistitem.FieldThumbnailURL:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsTermsAgreed(v)
+		m.SetThumbnailURL(v)
 		return nil
-	case user.FieldIsPrivacyAgreed:
-		v, ok := value.(bool)
+	case wishlistitem.FieldPriority:
+		v, ok := value.(wishlistitem.Priority)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsPrivacyAgreed(v)
+		m.SetPriority(v)
 		return nil
-	case user.FieldFcmToken:
+	case wishlistitem.FieldNote:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFcmToken(v)
+		m.SetNote(v)
 		return nil
-	case user.FieldTimezone:
+	case wishlistitem.FieldTargetPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetPrice(v)
+		return nil
+	case wishlistitem.FieldCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTimezone(v)
+		m.SetCurrency(v)
 		return nil
-	case user.FieldCreatedAt:
+	case wishlistitem.FieldClaimedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedBy(v)
+		return nil
+	case wishlistitem.FieldClaimCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimCode(v)
+		return nil
+	case wishlistitem.FieldClaimedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedAt(v)
+		return nil
+	case wishlistitem.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case wishlistitem.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5140,261 +20635,212 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WishlistItem field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *WishlistItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget_price != nil {
+		fields = append(fields, wishlistitem.FieldTargetPrice)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WishlistItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wishlistitem.FieldTargetPrice:
+		return m.AddedTargetPrice()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WishlistItemMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case wishlistitem.FieldTargetPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetPrice(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WishlistItem numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WishlistItemMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldPassword) {
-		fields = append(fields, user.FieldPassword)
+	if m.FieldCleared(wishlistitem.FieldIsbn) {
+		fields = append(fields, wishlistitem.FieldIsbn)
 	}
-	if m.FieldCleared(user.FieldFcmToken) {
-		fields = append(fields, user.FieldFcmToken)
+	if m.FieldCleared(wishlistitem.FieldNote) {
+		fields = append(fields, wishlistitem.FieldNote)
+	}
+	if m.FieldCleared(wishlistitem.FieldTargetPrice) {
+		fields = append(fields, wishlistitem.FieldTargetPrice)
+	}
+	if m.FieldCleared(wishlistitem.FieldClaimedAt) {
+		fields = append(fields, wishlistitem.FieldClaimedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WishlistItemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *WishlistItemMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldPassword:
-		m.ClearPassword()
+	case wishlistitem.FieldIsbn:
+		m.ClearIsbn()
 		return nil
-	case user.FieldFcmToken:
-		m.ClearFcmToken()
+	case wishlistitem.FieldNote:
+		m.ClearNote()
+		return nil
+	case wishlistitem.FieldTargetPrice:
+		m.ClearTargetPrice()
+		return nil
+	case wishlistitem.FieldClaimedAt:
+		m.ClearClaimedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown WishlistItem nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WishlistItemMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldNickName:
-		m.ResetNickName()
+	case wishlistitem.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case wishlistitem.FieldAuthor:
+		m.ResetAuthor()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case wishlistitem.FieldIsbn:
+		m.ResetIsbn()
 		return nil
-	case user.FieldIsPublished:
-		m.ResetIsPublished()
+	case wishlistitem.FieldPublisher:
+		m.ResetPublisher()
 		return nil
-	case user.FieldIsTermsAgreed:
-		m.ResetIsTermsAgreed()
+	case wishlistitem.FieldThumbnailURL:
+		m.ResetThumbnailURL()
 		return nil
-	case user.FieldIsPrivacyAgreed:
-		m.ResetIsPrivacyAgreed()
+	case wishlistitem.FieldPriority:
+		m.ResetPriority()
 		return nil
-	case user.FieldFcmToken:
-		m.ResetFcmToken()
+	case wishlistitem.FieldNote:
+		m.ResetNote()
 		return nil
-	case user.FieldTimezone:
-		m.ResetTimezone()
+	case wishlistitem.FieldTargetPrice:
+		m.ResetTargetPrice()
 		return nil
-	case user.FieldCreatedAt:
+	case wishlistitem.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case wishlistitem.FieldClaimedBy:
+		m.ResetClaimedBy()
+		return nil
+	case wishlistitem.FieldClaimCode:
+		m.ResetClaimCode()
+		return nil
+	case wishlistitem.FieldClaimedAt:
+		m.ResetClaimedAt()
+		return nil
+	case wishlistitem.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case wishlistitem.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WishlistItem field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.books != nil {
-		edges = append(edges, user.EdgeBooks)
-	}
-	if m.reviews != nil {
-		edges = append(edges, user.EdgeReviews)
-	}
-	if m.bookmarks != nil {
-		edges = append(edges, user.EdgeBookmarks)
-	}
-	if m.reading_reminders != nil {
-		edges = append(edges, user.EdgeReadingReminders)
+func (m *WishlistItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.owner != nil {
+		edges = append(edges, wishlistitem.EdgeOwner)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WishlistItemMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeBooks:
-		ids := make([]ent.Value, 0, len(m.books))
-		for id := range m.books {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeReviews:
-		ids := make([]ent.Value, 0, len(m.reviews))
-		for id := range m.reviews {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeBookmarks:
-		ids := make([]ent.Value, 0, len(m.bookmarks))
-		for id := range m.bookmarks {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeReadingReminders:
-		ids := make([]ent.Value, 0, len(m.reading_reminders))
-		for id := range m.reading_reminders {
-			ids = append(ids, id)
+	case wishlistitem.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedbooks != nil {
-		edges = append(edges, user.EdgeBooks)
-	}
-	if m.removedreviews != nil {
-		edges = append(edges, user.EdgeReviews)
-	}
-	if m.removedbookmarks != nil {
-		edges = append(edges, user.EdgeBookmarks)
-	}
-	if m.removedreading_reminders != nil {
-		edges = append(edges, user.EdgeReadingReminders)
-	}
+func (m *WishlistItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeBooks:
-		ids := make([]ent.Value, 0, len(m.removedbooks))
-		for id := range m.removedbooks {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeReviews:
-		ids := make([]ent.Value, 0, len(m.removedreviews))
-		for id := range m.removedreviews {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeBookmarks:
-		ids := make([]ent.Value, 0, len(m.removedbookmarks))
-		for id := range m.removedbookmarks {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeReadingReminders:
-		ids := make([]ent.Value, 0, len(m.removedreading_reminders))
-		for id := range m.removedreading_reminders {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WishlistItemMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedbooks {
-		edges = append(edges, user.EdgeBooks)
-	}
-	if m.clearedreviews {
-		edges = append(edges, user.EdgeReviews)
-	}
-	if m.clearedbookmarks {
-		edges = append(edges, user.EdgeBookmarks)
-	}
-	if m.clearedreading_reminders {
-		edges = append(edges, user.EdgeReadingReminders)
+func (m *WishlistItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedowner {
+		edges = append(edges, wishlistitem.EdgeOwner)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WishlistItemMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeBooks:
-		return m.clearedbooks
-	case user.EdgeReviews:
-		return m.clearedreviews
-	case user.EdgeBookmarks:
-		return m.clearedbookmarks
-	case user.EdgeReadingReminders:
-		return m.clearedreading_reminders
+	case wishlistitem.EdgeOwner:
+		return m.clearedowner
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WishlistItemMutation) ClearEdge(name string) error {
 	switch name {
+	case wishlistitem.EdgeOwner:
+		m.ClearOwner()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WishlistItem unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WishlistItemMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeBooks:
-		m.ResetBooks()
-		return nil
-	case user.EdgeReviews:
-		m.ResetReviews()
-		return nil
-	case user.EdgeBookmarks:
-		m.ResetBookmarks()
-		return nil
-	case user.EdgeReadingReminders:
-		m.ResetReadingReminders()
+	case wishlistitem.EdgeOwner:
+		m.ResetOwner()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WishlistItem edge %s", name)
 }
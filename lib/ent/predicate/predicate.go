@@ -12,17 +12,62 @@ type AdminAPIKey func(*sql.Selector)
 // Book is the predicate function for book builders.
 type Book func(*sql.Selector)
 
+// BookCatalog is the predicate function for bookcatalog builders.
+type BookCatalog func(*sql.Selector)
+
+// BookNote is the predicate function for booknote builders.
+type BookNote func(*sql.Selector)
+
+// BookStatusHistory is the predicate function for bookstatushistory builders.
+type BookStatusHistory func(*sql.Selector)
+
 // Bookmark is the predicate function for bookmark builders.
 type Bookmark func(*sql.Selector)
 
+// BorrowRequest is the predicate function for borrowrequest builders.
+type BorrowRequest func(*sql.Selector)
+
+// DataMigration is the predicate function for datamigration builders.
+type DataMigration func(*sql.Selector)
+
 // EmailVerification is the predicate function for emailverification builders.
 type EmailVerification func(*sql.Selector)
 
+// Library is the predicate function for library builders.
+type Library func(*sql.Selector)
+
+// LibraryInvitation is the predicate function for libraryinvitation builders.
+type LibraryInvitation func(*sql.Selector)
+
+// LibraryMember is the predicate function for librarymember builders.
+type LibraryMember func(*sql.Selector)
+
+// Loan is the predicate function for loan builders.
+type Loan func(*sql.Selector)
+
+// ReadingGoal is the predicate function for readinggoal builders.
+type ReadingGoal func(*sql.Selector)
+
 // ReadingReminder is the predicate function for readingreminder builders.
 type ReadingReminder func(*sql.Selector)
 
+// ReadingSession is the predicate function for readingsession builders.
+type ReadingSession func(*sql.Selector)
+
 // Review is the predicate function for review builders.
 type Review func(*sql.Selector)
 
+// Shelf is the predicate function for shelf builders.
+type Shelf func(*sql.Selector)
+
+// ShelfBook is the predicate function for shelfbook builders.
+type ShelfBook func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// WishlistItem is the predicate function for wishlistitem builders.
+type WishlistItem func(*sql.Selector)
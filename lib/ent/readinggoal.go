@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// ReadingGoal is the model entity for the ReadingGoal schema.
+type ReadingGoal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 목표 연도 (사용자 타임존 기준)
+	Year int `json:"year,omitempty"`
+	// 목표 단위 (books: 권수, pages: 쪽수)
+	GoalType readinggoal.GoalType `json:"goal_type,omitempty"`
+	// 목표 권수 또는 쪽수
+	Target int `json:"target,omitempty"`
+	// 목표 속도보다 뒤처졌을 때 알림 여부
+	PaceReminder bool `json:"pace_reminder,omitempty"`
+	// 마지막으로 속도 알림을 보낸 시간
+	ReminderSentAt *time.Time `json:"reminder_sent_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReadingGoalQuery when eager-loading is set.
+	Edges              ReadingGoalEdges `json:"edges"`
+	user_reading_goals *uuid.UUID
+	selectValues       sql.SelectValues
+}
+
+// ReadingGoalEdges holds the relations/edges for other nodes in the graph.
+type ReadingGoalEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReadingGoalEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReadingGoal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case readinggoal.FieldPaceReminder:
+			values[i] = new(sql.NullBool)
+		case readinggoal.FieldYear, readinggoal.FieldTarget:
+			values[i] = new(sql.NullInt64)
+		case readinggoal.FieldGoalType:
+			values[i] = new(sql.NullString)
+		case readinggoal.FieldReminderSentAt, readinggoal.FieldCreatedAt, readinggoal.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case readinggoal.FieldID:
+			values[i] = new(uuid.UUID)
+		case readinggoal.ForeignKeys[0]: // user_reading_goals
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReadingGoal fields.
+func (_m *ReadingGoal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case readinggoal.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case readinggoal.FieldYear:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field year", values[i])
+			} else if value.Valid {
+				_m.Year = int(value.Int64)
+			}
+		case readinggoal.FieldGoalType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field goal_type", values[i])
+			} else if value.Valid {
+				_m.GoalType = readinggoal.GoalType(value.String)
+			}
+		case readinggoal.FieldTarget:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target", values[i])
+			} else if value.Valid {
+				_m.Target = int(value.Int64)
+			}
+		case readinggoal.FieldPaceReminder:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field pace_reminder", values[i])
+			} else if value.Valid {
+				_m.PaceReminder = value.Bool
+			}
+		case readinggoal.FieldReminderSentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reminder_sent_at", values[i])
+			} else if value.Valid {
+				_m.ReminderSentAt = new(time.Time)
+				*_m.ReminderSentAt = value.Time
+			}
+		case readinggoal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case readinggoal.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case readinggoal.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_reading_goals", values[i])
+			} else if value.Valid {
+				_m.user_reading_goals = new(uuid.UUID)
+				*_m.user_reading_goals = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReadingGoal.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReadingGoal) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the ReadingGoal entity.
+func (_m *ReadingGoal) QueryOwner() *UserQuery {
+	return NewReadingGoalClient(_m.config).QueryOwner(_m)
+}
+
+// Update returns a builder for updating this ReadingGoal.
+// Note that you need to call ReadingGoal.Unwrap() before calling this method if this ReadingGoal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReadingGoal) Update() *ReadingGoalUpdateOne {
+	return NewReadingGoalClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReadingGoal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReadingGoal) Unwrap() *ReadingGoal {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReadingGoal is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReadingGoal) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReadingGoal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("year=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Year))
+	builder.WriteString(", ")
+	builder.WriteString("goal_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.GoalType))
+	builder.WriteString(", ")
+	builder.WriteString("target=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Target))
+	builder.WriteString(", ")
+	builder.WriteString("pace_reminder=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaceReminder))
+	builder.WriteString(", ")
+	if v := _m.ReminderSentAt; v != nil {
+		builder.WriteString("reminder_sent_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReadingGoals is a parsable slice of ReadingGoal.
+type ReadingGoals []*ReadingGoal
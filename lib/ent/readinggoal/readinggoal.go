@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readinggoal
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the readinggoal type in the database.
+	Label = "reading_goal"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldYear holds the string denoting the year field in the database.
+	FieldYear = "year"
+	// FieldGoalType holds the string denoting the goal_type field in the database.
+	FieldGoalType = "goal_type"
+	// FieldTarget holds the string denoting the target field in the database.
+	FieldTarget = "target"
+	// FieldPaceReminder holds the string denoting the pace_reminder field in the database.
+	FieldPaceReminder = "pace_reminder"
+	// FieldReminderSentAt holds the string denoting the reminder_sent_at field in the database.
+	FieldReminderSentAt = "reminder_sent_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// Table holds the table name of the readinggoal in the database.
+	Table = "reading_goals"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "reading_goals"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "user_reading_goals"
+)
+
+// Columns holds all SQL columns for readinggoal fields.
+var Columns = []string{
+	FieldID,
+	FieldYear,
+	FieldGoalType,
+	FieldTarget,
+	FieldPaceReminder,
+	FieldReminderSentAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "reading_goals"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"user_reading_goals",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	TargetValidator func(int) error
+	// DefaultPaceReminder holds the default value on creation for the "pace_reminder" field.
+	DefaultPaceReminder bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// GoalType defines the type for the "goal_type" enum field.
+type GoalType string
+
+// GoalType values.
+const (
+	GoalTypeBooks GoalType = "books"
+	GoalTypePages GoalType = "pages"
+)
+
+func (gt GoalType) String() string {
+	return string(gt)
+}
+
+// GoalTypeValidator is a validator for the "goal_type" field enum values. It is called by the builders before save.
+func GoalTypeValidator(gt GoalType) error {
+	switch gt {
+	case GoalTypeBooks, GoalTypePages:
+		return nil
+	default:
+		return fmt.Errorf("readinggoal: invalid enum value for goal_type field: %q", gt)
+	}
+}
+
+// OrderOption defines the ordering options for the ReadingGoal queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByYear orders the results by the year field.
+func ByYear(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldYear, opts...).ToFunc()
+}
+
+// ByGoalType orders the results by the goal_type field.
+func ByGoalType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGoalType, opts...).ToFunc()
+}
+
+// ByTarget orders the results by the target field.
+func ByTarget(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTarget, opts...).ToFunc()
+}
+
+// ByPaceReminder orders the results by the pace_reminder field.
+func ByPaceReminder(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaceReminder, opts...).ToFunc()
+}
+
+// ByReminderSentAt orders the results by the reminder_sent_at field.
+func ByReminderSentAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReminderSentAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByOwnerField orders the results by owner field.
+func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OwnerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+	)
+}
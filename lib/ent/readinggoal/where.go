@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readinggoal
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLTE(FieldID, id))
+}
+
+// Year applies equality check predicate on the "year" field. It's identical to YearEQ.
+func Year(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldYear, v))
+}
+
+// Target applies equality check predicate on the "target" field. It's identical to TargetEQ.
+func Target(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldTarget, v))
+}
+
+// PaceReminder applies equality check predicate on the "pace_reminder" field. It's identical to PaceReminderEQ.
+func PaceReminder(v bool) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldPaceReminder, v))
+}
+
+// ReminderSentAt applies equality check predicate on the "reminder_sent_at" field. It's identical to ReminderSentAtEQ.
+func ReminderSentAt(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldReminderSentAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// YearEQ applies the EQ predicate on the "year" field.
+func YearEQ(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldYear, v))
+}
+
+// YearNEQ applies the NEQ predicate on the "year" field.
+func YearNEQ(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNEQ(FieldYear, v))
+}
+
+// YearIn applies the In predicate on the "year" field.
+func YearIn(vs ...int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldIn(FieldYear, vs...))
+}
+
+// YearNotIn applies the NotIn predicate on the "year" field.
+func YearNotIn(vs ...int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNotIn(FieldYear, vs...))
+}
+
+// YearGT applies the GT predicate on the "year" field.
+func YearGT(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGT(FieldYear, v))
+}
+
+// YearGTE applies the GTE predicate on the "year" field.
+func YearGTE(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGTE(FieldYear, v))
+}
+
+// YearLT applies the LT predicate on the "year" field.
+func YearLT(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLT(FieldYear, v))
+}
+
+// YearLTE applies the LTE predicate on the "year" field.
+func YearLTE(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLTE(FieldYear, v))
+}
+
+// GoalTypeEQ applies the EQ predicate on the "goal_type" field.
+func GoalTypeEQ(v GoalType) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldGoalType, v))
+}
+
+// GoalTypeNEQ applies the NEQ predicate on the "goal_type" field.
+func GoalTypeNEQ(v GoalType) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNEQ(FieldGoalType, v))
+}
+
+// GoalTypeIn applies the In predicate on the "goal_type" field.
+func GoalTypeIn(vs ...GoalType) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldIn(FieldGoalType, vs...))
+}
+
+// GoalTypeNotIn applies the NotIn predicate on the "goal_type" field.
+func GoalTypeNotIn(vs ...GoalType) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNotIn(FieldGoalType, vs...))
+}
+
+// TargetEQ applies the EQ predicate on the "target" field.
+func TargetEQ(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldTarget, v))
+}
+
+// TargetNEQ applies the NEQ predicate on the "target" field.
+func TargetNEQ(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNEQ(FieldTarget, v))
+}
+
+// TargetIn applies the In predicate on the "target" field.
+func TargetIn(vs ...int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldIn(FieldTarget, vs...))
+}
+
+// TargetNotIn applies the NotIn predicate on the "target" field.
+func TargetNotIn(vs ...int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNotIn(FieldTarget, vs...))
+}
+
+// TargetGT applies the GT predicate on the "target" field.
+func TargetGT(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGT(FieldTarget, v))
+}
+
+// TargetGTE applies the GTE predicate on the "target" field.
+func TargetGTE(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGTE(FieldTarget, v))
+}
+
+// TargetLT applies the LT predicate on the "target" field.
+func TargetLT(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLT(FieldTarget, v))
+}
+
+// TargetLTE applies the LTE predicate on the "target" field.
+func TargetLTE(v int) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLTE(FieldTarget, v))
+}
+
+// PaceReminderEQ applies the EQ predicate on the "pace_reminder" field.
+func PaceReminderEQ(v bool) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldPaceReminder, v))
+}
+
+// PaceReminderNEQ applies the NEQ predicate on the "pace_reminder" field.
+func PaceReminderNEQ(v bool) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNEQ(FieldPaceReminder, v))
+}
+
+// ReminderSentAtEQ applies the EQ predicate on the "reminder_sent_at" field.
+func ReminderSentAtEQ(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtNEQ applies the NEQ predicate on the "reminder_sent_at" field.
+func ReminderSentAtNEQ(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNEQ(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtIn applies the In predicate on the "reminder_sent_at" field.
+func ReminderSentAtIn(vs ...time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldIn(FieldReminderSentAt, vs...))
+}
+
+// ReminderSentAtNotIn applies the NotIn predicate on the "reminder_sent_at" field.
+func ReminderSentAtNotIn(vs ...time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNotIn(FieldReminderSentAt, vs...))
+}
+
+// ReminderSentAtGT applies the GT predicate on the "reminder_sent_at" field.
+func ReminderSentAtGT(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGT(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtGTE applies the GTE predicate on the "reminder_sent_at" field.
+func ReminderSentAtGTE(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGTE(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtLT applies the LT predicate on the "reminder_sent_at" field.
+func ReminderSentAtLT(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLT(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtLTE applies the LTE predicate on the "reminder_sent_at" field.
+func ReminderSentAtLTE(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLTE(FieldReminderSentAt, v))
+}
+
+// ReminderSentAtIsNil applies the IsNil predicate on the "reminder_sent_at" field.
+func ReminderSentAtIsNil() predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldIsNull(FieldReminderSentAt))
+}
+
+// ReminderSentAtNotNil applies the NotNil predicate on the "reminder_sent_at" field.
+func ReminderSentAtNotNil() predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNotNull(FieldReminderSentAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.ReadingGoal {
+	return predicate.ReadingGoal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.ReadingGoal {
+	return predicate.ReadingGoal(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReadingGoal) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReadingGoal) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReadingGoal) predicate.ReadingGoal {
+	return predicate.ReadingGoal(sql.NotPredicates(p))
+}
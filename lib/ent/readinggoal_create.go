@@ -0,0 +1,375 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// ReadingGoalCreate is the builder for creating a ReadingGoal entity.
+type ReadingGoalCreate struct {
+	config
+	mutation *ReadingGoalMutation
+	hooks    []Hook
+}
+
+// SetYear sets the "year" field.
+func (_c *ReadingGoalCreate) SetYear(v int) *ReadingGoalCreate {
+	_c.mutation.SetYear(v)
+	return _c
+}
+
+// SetGoalType sets the "goal_type" field.
+func (_c *ReadingGoalCreate) SetGoalType(v readinggoal.GoalType) *ReadingGoalCreate {
+	_c.mutation.SetGoalType(v)
+	return _c
+}
+
+// SetTarget sets the "target" field.
+func (_c *ReadingGoalCreate) SetTarget(v int) *ReadingGoalCreate {
+	_c.mutation.SetTarget(v)
+	return _c
+}
+
+// SetPaceReminder sets the "pace_reminder" field.
+func (_c *ReadingGoalCreate) SetPaceReminder(v bool) *ReadingGoalCreate {
+	_c.mutation.SetPaceReminder(v)
+	return _c
+}
+
+// SetNillablePaceReminder sets the "pace_reminder" field if the given value is not nil.
+func (_c *ReadingGoalCreate) SetNillablePaceReminder(v *bool) *ReadingGoalCreate {
+	if v != nil {
+		_c.SetPaceReminder(*v)
+	}
+	return _c
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (_c *ReadingGoalCreate) SetReminderSentAt(v time.Time) *ReadingGoalCreate {
+	_c.mutation.SetReminderSentAt(v)
+	return _c
+}
+
+// SetNillableReminderSentAt sets the "reminder_sent_at" field if the given value is not nil.
+func (_c *ReadingGoalCreate) SetNillableReminderSentAt(v *time.Time) *ReadingGoalCreate {
+	if v != nil {
+		_c.SetReminderSentAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ReadingGoalCreate) SetCreatedAt(v time.Time) *ReadingGoalCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ReadingGoalCreate) SetNillableCreatedAt(v *time.Time) *ReadingGoalCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ReadingGoalCreate) SetUpdatedAt(v time.Time) *ReadingGoalCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ReadingGoalCreate) SetNillableUpdatedAt(v *time.Time) *ReadingGoalCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReadingGoalCreate) SetID(v uuid.UUID) *ReadingGoalCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReadingGoalCreate) SetNillableID(v *uuid.UUID) *ReadingGoalCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *ReadingGoalCreate) SetOwnerID(id uuid.UUID) *ReadingGoalCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *ReadingGoalCreate) SetOwner(v *User) *ReadingGoalCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// Mutation returns the ReadingGoalMutation object of the builder.
+func (_c *ReadingGoalCreate) Mutation() *ReadingGoalMutation {
+	return _c.mutation
+}
+
+// Save creates the ReadingGoal in the database.
+func (_c *ReadingGoalCreate) Save(ctx context.Context) (*ReadingGoal, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReadingGoalCreate) SaveX(ctx context.Context) *ReadingGoal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadingGoalCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadingGoalCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReadingGoalCreate) defaults() {
+	if _, ok := _c.mutation.PaceReminder(); !ok {
+		v := readinggoal.DefaultPaceReminder
+		_c.mutation.SetPaceReminder(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := readinggoal.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := readinggoal.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := readinggoal.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReadingGoalCreate) check() error {
+	if _, ok := _c.mutation.Year(); !ok {
+		return &ValidationError{Name: "year", err: errors.New(`ent: missing required field "ReadingGoal.year"`)}
+	}
+	if _, ok := _c.mutation.GoalType(); !ok {
+		return &ValidationError{Name: "goal_type", err: errors.New(`ent: missing required field "ReadingGoal.goal_type"`)}
+	}
+	if v, ok := _c.mutation.GoalType(); ok {
+		if err := readinggoal.GoalTypeValidator(v); err != nil {
+			return &ValidationError{Name: "goal_type", err: fmt.Errorf(`ent: validator failed for field "ReadingGoal.goal_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Target(); !ok {
+		return &ValidationError{Name: "target", err: errors.New(`ent: missing required field "ReadingGoal.target"`)}
+	}
+	if v, ok := _c.mutation.Target(); ok {
+		if err := readinggoal.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "ReadingGoal.target": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PaceReminder(); !ok {
+		return &ValidationError{Name: "pace_reminder", err: errors.New(`ent: missing required field "ReadingGoal.pace_reminder"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReadingGoal.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReadingGoal.updated_at"`)}
+	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "ReadingGoal.owner"`)}
+	}
+	return nil
+}
+
+func (_c *ReadingGoalCreate) sqlSave(ctx context.Context) (*ReadingGoal, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReadingGoalCreate) createSpec() (*ReadingGoal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReadingGoal{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(readinggoal.Table, sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Year(); ok {
+		_spec.SetField(readinggoal.FieldYear, field.TypeInt, value)
+		_node.Year = value
+	}
+	if value, ok := _c.mutation.GoalType(); ok {
+		_spec.SetField(readinggoal.FieldGoalType, field.TypeEnum, value)
+		_node.GoalType = value
+	}
+	if value, ok := _c.mutation.Target(); ok {
+		_spec.SetField(readinggoal.FieldTarget, field.TypeInt, value)
+		_node.Target = value
+	}
+	if value, ok := _c.mutation.PaceReminder(); ok {
+		_spec.SetField(readinggoal.FieldPaceReminder, field.TypeBool, value)
+		_node.PaceReminder = value
+	}
+	if value, ok := _c.mutation.ReminderSentAt(); ok {
+		_spec.SetField(readinggoal.FieldReminderSentAt, field.TypeTime, value)
+		_node.ReminderSentAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(readinggoal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(readinggoal.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinggoal.OwnerTable,
+			Columns: []string{readinggoal.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_reading_goals = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReadingGoalCreateBulk is the builder for creating many ReadingGoal entities in bulk.
+type ReadingGoalCreateBulk struct {
+	config
+	err      error
+	builders []*ReadingGoalCreate
+}
+
+// Save creates the ReadingGoal entities in the database.
+func (_c *ReadingGoalCreateBulk) Save(ctx context.Context) ([]*ReadingGoal, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReadingGoal, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReadingGoalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReadingGoalCreateBulk) SaveX(ctx context.Context) []*ReadingGoal {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadingGoalCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadingGoalCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,576 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// ReadingGoalUpdate is the builder for updating ReadingGoal entities.
+type ReadingGoalUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReadingGoalMutation
+}
+
+// Where appends a list predicates to the ReadingGoalUpdate builder.
+func (_u *ReadingGoalUpdate) Where(ps ...predicate.ReadingGoal) *ReadingGoalUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetYear sets the "year" field.
+func (_u *ReadingGoalUpdate) SetYear(v int) *ReadingGoalUpdate {
+	_u.mutation.ResetYear()
+	_u.mutation.SetYear(v)
+	return _u
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_u *ReadingGoalUpdate) SetNillableYear(v *int) *ReadingGoalUpdate {
+	if v != nil {
+		_u.SetYear(*v)
+	}
+	return _u
+}
+
+// AddYear adds value to the "year" field.
+func (_u *ReadingGoalUpdate) AddYear(v int) *ReadingGoalUpdate {
+	_u.mutation.AddYear(v)
+	return _u
+}
+
+// SetGoalType sets the "goal_type" field.
+func (_u *ReadingGoalUpdate) SetGoalType(v readinggoal.GoalType) *ReadingGoalUpdate {
+	_u.mutation.SetGoalType(v)
+	return _u
+}
+
+// SetNillableGoalType sets the "goal_type" field if the given value is not nil.
+func (_u *ReadingGoalUpdate) SetNillableGoalType(v *readinggoal.GoalType) *ReadingGoalUpdate {
+	if v != nil {
+		_u.SetGoalType(*v)
+	}
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *ReadingGoalUpdate) SetTarget(v int) *ReadingGoalUpdate {
+	_u.mutation.ResetTarget()
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *ReadingGoalUpdate) SetNillableTarget(v *int) *ReadingGoalUpdate {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// AddTarget adds value to the "target" field.
+func (_u *ReadingGoalUpdate) AddTarget(v int) *ReadingGoalUpdate {
+	_u.mutation.AddTarget(v)
+	return _u
+}
+
+// SetPaceReminder sets the "pace_reminder" field.
+func (_u *ReadingGoalUpdate) SetPaceReminder(v bool) *ReadingGoalUpdate {
+	_u.mutation.SetPaceReminder(v)
+	return _u
+}
+
+// SetNillablePaceReminder sets the "pace_reminder" field if the given value is not nil.
+func (_u *ReadingGoalUpdate) SetNillablePaceReminder(v *bool) *ReadingGoalUpdate {
+	if v != nil {
+		_u.SetPaceReminder(*v)
+	}
+	return _u
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (_u *ReadingGoalUpdate) SetReminderSentAt(v time.Time) *ReadingGoalUpdate {
+	_u.mutation.SetReminderSentAt(v)
+	return _u
+}
+
+// SetNillableReminderSentAt sets the "reminder_sent_at" field if the given value is not nil.
+func (_u *ReadingGoalUpdate) SetNillableReminderSentAt(v *time.Time) *ReadingGoalUpdate {
+	if v != nil {
+		_u.SetReminderSentAt(*v)
+	}
+	return _u
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (_u *ReadingGoalUpdate) ClearReminderSentAt() *ReadingGoalUpdate {
+	_u.mutation.ClearReminderSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReadingGoalUpdate) SetUpdatedAt(v time.Time) *ReadingGoalUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *ReadingGoalUpdate) SetOwnerID(id uuid.UUID) *ReadingGoalUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *ReadingGoalUpdate) SetOwner(v *User) *ReadingGoalUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// Mutation returns the ReadingGoalMutation object of the builder.
+func (_u *ReadingGoalUpdate) Mutation() *ReadingGoalMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *ReadingGoalUpdate) ClearOwner() *ReadingGoalUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReadingGoalUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadingGoalUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReadingGoalUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadingGoalUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReadingGoalUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := readinggoal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReadingGoalUpdate) check() error {
+	if v, ok := _u.mutation.GoalType(); ok {
+		if err := readinggoal.GoalTypeValidator(v); err != nil {
+			return &ValidationError{Name: "goal_type", err: fmt.Errorf(`ent: validator failed for field "ReadingGoal.goal_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := readinggoal.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "ReadingGoal.target": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadingGoal.owner"`)
+	}
+	return nil
+}
+
+func (_u *ReadingGoalUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readinggoal.Table, readinggoal.Columns, sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Year(); ok {
+		_spec.SetField(readinggoal.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYear(); ok {
+		_spec.AddField(readinggoal.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GoalType(); ok {
+		_spec.SetField(readinggoal.FieldGoalType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(readinggoal.FieldTarget, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTarget(); ok {
+		_spec.AddField(readinggoal.FieldTarget, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PaceReminder(); ok {
+		_spec.SetField(readinggoal.FieldPaceReminder, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReminderSentAt(); ok {
+		_spec.SetField(readinggoal.FieldReminderSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReminderSentAtCleared() {
+		_spec.ClearField(readinggoal.FieldReminderSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(readinggoal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinggoal.OwnerTable,
+			Columns: []string{readinggoal.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinggoal.OwnerTable,
+			Columns: []string{readinggoal.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readinggoal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReadingGoalUpdateOne is the builder for updating a single ReadingGoal entity.
+type ReadingGoalUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReadingGoalMutation
+}
+
+// SetYear sets the "year" field.
+func (_u *ReadingGoalUpdateOne) SetYear(v int) *ReadingGoalUpdateOne {
+	_u.mutation.ResetYear()
+	_u.mutation.SetYear(v)
+	return _u
+}
+
+// SetNillableYear sets the "year" field if the given value is not nil.
+func (_u *ReadingGoalUpdateOne) SetNillableYear(v *int) *ReadingGoalUpdateOne {
+	if v != nil {
+		_u.SetYear(*v)
+	}
+	return _u
+}
+
+// AddYear adds value to the "year" field.
+func (_u *ReadingGoalUpdateOne) AddYear(v int) *ReadingGoalUpdateOne {
+	_u.mutation.AddYear(v)
+	return _u
+}
+
+// SetGoalType sets the "goal_type" field.
+func (_u *ReadingGoalUpdateOne) SetGoalType(v readinggoal.GoalType) *ReadingGoalUpdateOne {
+	_u.mutation.SetGoalType(v)
+	return _u
+}
+
+// SetNillableGoalType sets the "goal_type" field if the given value is not nil.
+func (_u *ReadingGoalUpdateOne) SetNillableGoalType(v *readinggoal.GoalType) *ReadingGoalUpdateOne {
+	if v != nil {
+		_u.SetGoalType(*v)
+	}
+	return _u
+}
+
+// SetTarget sets the "target" field.
+func (_u *ReadingGoalUpdateOne) SetTarget(v int) *ReadingGoalUpdateOne {
+	_u.mutation.ResetTarget()
+	_u.mutation.SetTarget(v)
+	return _u
+}
+
+// SetNillableTarget sets the "target" field if the given value is not nil.
+func (_u *ReadingGoalUpdateOne) SetNillableTarget(v *int) *ReadingGoalUpdateOne {
+	if v != nil {
+		_u.SetTarget(*v)
+	}
+	return _u
+}
+
+// AddTarget adds value to the "target" field.
+func (_u *ReadingGoalUpdateOne) AddTarget(v int) *ReadingGoalUpdateOne {
+	_u.mutation.AddTarget(v)
+	return _u
+}
+
+// SetPaceReminder sets the "pace_reminder" field.
+func (_u *ReadingGoalUpdateOne) SetPaceReminder(v bool) *ReadingGoalUpdateOne {
+	_u.mutation.SetPaceReminder(v)
+	return _u
+}
+
+// SetNillablePaceReminder sets the "pace_reminder" field if the given value is not nil.
+func (_u *ReadingGoalUpdateOne) SetNillablePaceReminder(v *bool) *ReadingGoalUpdateOne {
+	if v != nil {
+		_u.SetPaceReminder(*v)
+	}
+	return _u
+}
+
+// SetReminderSentAt sets the "reminder_sent_at" field.
+func (_u *ReadingGoalUpdateOne) SetReminderSentAt(v time.Time) *ReadingGoalUpdateOne {
+	_u.mutation.SetReminderSentAt(v)
+	return _u
+}
+
+// SetNillableReminderSentAt sets the "reminder_sent_at" field if the given value is not nil.
+func (_u *ReadingGoalUpdateOne) SetNillableReminderSentAt(v *time.Time) *ReadingGoalUpdateOne {
+	if v != nil {
+		_u.SetReminderSentAt(*v)
+	}
+	return _u
+}
+
+// ClearReminderSentAt clears the value of the "reminder_sent_at" field.
+func (_u *ReadingGoalUpdateOne) ClearReminderSentAt() *ReadingGoalUpdateOne {
+	_u.mutation.ClearReminderSentAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ReadingGoalUpdateOne) SetUpdatedAt(v time.Time) *ReadingGoalUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *ReadingGoalUpdateOne) SetOwnerID(id uuid.UUID) *ReadingGoalUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *ReadingGoalUpdateOne) SetOwner(v *User) *ReadingGoalUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// Mutation returns the ReadingGoalMutation object of the builder.
+func (_u *ReadingGoalUpdateOne) Mutation() *ReadingGoalMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *ReadingGoalUpdateOne) ClearOwner() *ReadingGoalUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// Where appends a list predicates to the ReadingGoalUpdate builder.
+func (_u *ReadingGoalUpdateOne) Where(ps ...predicate.ReadingGoal) *ReadingGoalUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReadingGoalUpdateOne) Select(field string, fields ...string) *ReadingGoalUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReadingGoal entity.
+func (_u *ReadingGoalUpdateOne) Save(ctx context.Context) (*ReadingGoal, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadingGoalUpdateOne) SaveX(ctx context.Context) *ReadingGoal {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReadingGoalUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadingGoalUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ReadingGoalUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := readinggoal.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReadingGoalUpdateOne) check() error {
+	if v, ok := _u.mutation.GoalType(); ok {
+		if err := readinggoal.GoalTypeValidator(v); err != nil {
+			return &ValidationError{Name: "goal_type", err: fmt.Errorf(`ent: validator failed for field "ReadingGoal.goal_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Target(); ok {
+		if err := readinggoal.TargetValidator(v); err != nil {
+			return &ValidationError{Name: "target", err: fmt.Errorf(`ent: validator failed for field "ReadingGoal.target": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadingGoal.owner"`)
+	}
+	return nil
+}
+
+func (_u *ReadingGoalUpdateOne) sqlSave(ctx context.Context) (_node *ReadingGoal, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readinggoal.Table, readinggoal.Columns, sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReadingGoal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, readinggoal.FieldID)
+		for _, f := range fields {
+			if !readinggoal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != readinggoal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Year(); ok {
+		_spec.SetField(readinggoal.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedYear(); ok {
+		_spec.AddField(readinggoal.FieldYear, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.GoalType(); ok {
+		_spec.SetField(readinggoal.FieldGoalType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Target(); ok {
+		_spec.SetField(readinggoal.FieldTarget, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTarget(); ok {
+		_spec.AddField(readinggoal.FieldTarget, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PaceReminder(); ok {
+		_spec.SetField(readinggoal.FieldPaceReminder, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ReminderSentAt(); ok {
+		_spec.SetField(readinggoal.FieldReminderSentAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReminderSentAtCleared() {
+		_spec.ClearField(readinggoal.FieldReminderSentAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(readinggoal.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinggoal.OwnerTable,
+			Columns: []string{readinggoal.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readinggoal.OwnerTable,
+			Columns: []string{readinggoal.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReadingGoal{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readinggoal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
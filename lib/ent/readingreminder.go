@@ -27,6 +27,8 @@ type ReadingReminder struct {
 	IsEnabled bool `json:"is_enabled,omitempty"`
 	// 알림 메시지
 	Message string `json:"message,omitempty"`
+	// 낙관적 동시성 제어용 버전 (수정할 때마다 1씩 증가)
+	Version int `json:"version,omitempty"`
 	// 생성 시간
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 수정 시간
@@ -65,6 +67,8 @@ func (*ReadingReminder) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case readingreminder.FieldIsEnabled:
 			values[i] = new(sql.NullBool)
+		case readingreminder.FieldVersion:
+			values[i] = new(sql.NullInt64)
 		case readingreminder.FieldReminderTime, readingreminder.FieldDayOfWeek, readingreminder.FieldMessage:
 			values[i] = new(sql.NullString)
 		case readingreminder.FieldCreatedAt, readingreminder.FieldUpdatedAt:
@@ -118,6 +122,12 @@ func (_m *ReadingReminder) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Message = value.String
 			}
+		case readingreminder.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case readingreminder.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -190,6 +200,9 @@ func (_m *ReadingReminder) String() string {
 	builder.WriteString("message=")
 	builder.WriteString(_m.Message)
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -71,6 +71,11 @@ func Message(v string) predicate.ReadingReminder {
 	return predicate.ReadingReminder(sql.FieldEQ(FieldMessage, v))
 }
 
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.ReadingReminder {
+	return predicate.ReadingReminder(sql.FieldEQ(FieldVersion, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.ReadingReminder {
 	return predicate.ReadingReminder(sql.FieldEQ(FieldCreatedAt, v))
@@ -241,6 +246,46 @@ func MessageContainsFold(v string) predicate.ReadingReminder {
 	return predicate.ReadingReminder(sql.FieldContainsFold(FieldMessage, v))
 }
 
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.ReadingReminder {
+	return predicate.ReadingReminder(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.ReadingReminder {
+	return predicate.ReadingReminder(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.ReadingReminder {
+	return predicate.ReadingReminder(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.ReadingReminder {
+	return predicate.ReadingReminder(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.ReadingReminder {
+	return predicate.ReadingReminder(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.ReadingReminder {
+	return predicate.ReadingReminder(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.ReadingReminder {
+	return predicate.ReadingReminder(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.ReadingReminder {
+	return predicate.ReadingReminder(sql.FieldLTE(FieldVersion, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.ReadingReminder {
 	return predicate.ReadingReminder(sql.FieldEQ(FieldCreatedAt, v))
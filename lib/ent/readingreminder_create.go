@@ -70,6 +70,20 @@ func (_c *ReadingReminderCreate) SetNillableMessage(v *string) *ReadingReminderC
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *ReadingReminderCreate) SetVersion(v int) *ReadingReminderCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *ReadingReminderCreate) SetNillableVersion(v *int) *ReadingReminderCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ReadingReminderCreate) SetCreatedAt(v time.Time) *ReadingReminderCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -162,6 +176,10 @@ func (_c *ReadingReminderCreate) defaults() {
 		v := readingreminder.DefaultMessage
 		_c.mutation.SetMessage(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := readingreminder.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := readingreminder.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -196,6 +214,14 @@ func (_c *ReadingReminderCreate) check() error {
 	if _, ok := _c.mutation.Message(); !ok {
 		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "ReadingReminder.message"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "ReadingReminder.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := readingreminder.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ReadingReminder.version": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReadingReminder.created_at"`)}
 	}
@@ -256,6 +282,10 @@ func (_c *ReadingReminderCreate) createSpec() (*ReadingReminder, *sqlgraph.Creat
 		_spec.SetField(readingreminder.FieldMessage, field.TypeString, value)
 		_node.Message = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(readingreminder.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(readingreminder.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -86,6 +86,27 @@ func (_u *ReadingReminderUpdate) SetNillableMessage(v *string) *ReadingReminderU
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *ReadingReminderUpdate) SetVersion(v int) *ReadingReminderUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ReadingReminderUpdate) SetNillableVersion(v *int) *ReadingReminderUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ReadingReminderUpdate) AddVersion(v int) *ReadingReminderUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ReadingReminderUpdate) SetUpdatedAt(v time.Time) *ReadingReminderUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -162,6 +183,11 @@ func (_u *ReadingReminderUpdate) check() error {
 			return &ValidationError{Name: "day_of_week", err: fmt.Errorf(`ent: validator failed for field "ReadingReminder.day_of_week": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := readingreminder.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ReadingReminder.version": %w`, err)}
+		}
+	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ReadingReminder.owner"`)
 	}
@@ -192,6 +218,12 @@ func (_u *ReadingReminderUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(readingreminder.FieldMessage, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(readingreminder.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(readingreminder.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(readingreminder.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -300,6 +332,27 @@ func (_u *ReadingReminderUpdateOne) SetNillableMessage(v *string) *ReadingRemind
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *ReadingReminderUpdateOne) SetVersion(v int) *ReadingReminderUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ReadingReminderUpdateOne) SetNillableVersion(v *int) *ReadingReminderUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ReadingReminderUpdateOne) AddVersion(v int) *ReadingReminderUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ReadingReminderUpdateOne) SetUpdatedAt(v time.Time) *ReadingReminderUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -389,6 +442,11 @@ func (_u *ReadingReminderUpdateOne) check() error {
 			return &ValidationError{Name: "day_of_week", err: fmt.Errorf(`ent: validator failed for field "ReadingReminder.day_of_week": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := readingreminder.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "ReadingReminder.version": %w`, err)}
+		}
+	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "ReadingReminder.owner"`)
 	}
@@ -436,6 +494,12 @@ func (_u *ReadingReminderUpdateOne) sqlSave(ctx context.Context) (_node *Reading
 	if value, ok := _u.mutation.Message(); ok {
 		_spec.SetField(readingreminder.FieldMessage, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(readingreminder.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(readingreminder.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(readingreminder.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// ReadingSession is the model entity for the ReadingSession schema.
+type ReadingSession struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 세션 시작 페이지
+	StartPage int `json:"start_page,omitempty"`
+	// 세션 종료 페이지 (진행 중이면 null)
+	EndPage *int `json:"end_page,omitempty"`
+	// 세션 동안 읽은 페이지 수
+	PagesRead int `json:"pages_read,omitempty"`
+	// 세션 시작 시간
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// 세션 종료 시간 (진행 중이면 null)
+	EndedAt *time.Time `json:"ended_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReadingSessionQuery when eager-loading is set.
+	Edges                 ReadingSessionEdges `json:"edges"`
+	book_reading_sessions *uuid.UUID
+	user_reading_sessions *uuid.UUID
+	selectValues          sql.SelectValues
+}
+
+// ReadingSessionEdges holds the relations/edges for other nodes in the graph.
+type ReadingSessionEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Book holds the value of the book edge.
+	Book *Book `json:"book,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReadingSessionEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// BookOrErr returns the Book value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReadingSessionEdges) BookOrErr() (*Book, error) {
+	if e.Book != nil {
+		return e.Book, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: book.Label}
+	}
+	return nil, &NotLoadedError{edge: "book"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReadingSession) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case readingsession.FieldStartPage, readingsession.FieldEndPage, readingsession.FieldPagesRead:
+			values[i] = new(sql.NullInt64)
+		case readingsession.FieldStartedAt, readingsession.FieldEndedAt:
+			values[i] = new(sql.NullTime)
+		case readingsession.FieldID:
+			values[i] = new(uuid.UUID)
+		case readingsession.ForeignKeys[0]: // book_reading_sessions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case readingsession.ForeignKeys[1]: // user_reading_sessions
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReadingSession fields.
+func (_m *ReadingSession) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case readingsession.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case readingsession.FieldStartPage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field start_page", values[i])
+			} else if value.Valid {
+				_m.StartPage = int(value.Int64)
+			}
+		case readingsession.FieldEndPage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field end_page", values[i])
+			} else if value.Valid {
+				_m.EndPage = new(int)
+				*_m.EndPage = int(value.Int64)
+			}
+		case readingsession.FieldPagesRead:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pages_read", values[i])
+			} else if value.Valid {
+				_m.PagesRead = int(value.Int64)
+			}
+		case readingsession.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				_m.StartedAt = value.Time
+			}
+		case readingsession.FieldEndedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field ended_at", values[i])
+			} else if value.Valid {
+				_m.EndedAt = new(time.Time)
+				*_m.EndedAt = value.Time
+			}
+		case readingsession.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field book_reading_sessions", values[i])
+			} else if value.Valid {
+				_m.book_reading_sessions = new(uuid.UUID)
+				*_m.book_reading_sessions = *value.S.(*uuid.UUID)
+			}
+		case readingsession.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_reading_sessions", values[i])
+			} else if value.Valid {
+				_m.user_reading_sessions = new(uuid.UUID)
+				*_m.user_reading_sessions = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReadingSession.
+// This includes values selected through modifiers, order, etc.
+func (_m *ReadingSession) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the ReadingSession entity.
+func (_m *ReadingSession) QueryOwner() *UserQuery {
+	return NewReadingSessionClient(_m.config).QueryOwner(_m)
+}
+
+// QueryBook queries the "book" edge of the ReadingSession entity.
+func (_m *ReadingSession) QueryBook() *BookQuery {
+	return NewReadingSessionClient(_m.config).QueryBook(_m)
+}
+
+// Update returns a builder for updating this ReadingSession.
+// Note that you need to call ReadingSession.Unwrap() before calling this method if this ReadingSession
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ReadingSession) Update() *ReadingSessionUpdateOne {
+	return NewReadingSessionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ReadingSession entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ReadingSession) Unwrap() *ReadingSession {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReadingSession is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ReadingSession) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReadingSession(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("start_page=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StartPage))
+	builder.WriteString(", ")
+	if v := _m.EndPage; v != nil {
+		builder.WriteString("end_page=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("pages_read=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PagesRead))
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(_m.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndedAt; v != nil {
+		builder.WriteString("ended_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReadingSessions is a parsable slice of ReadingSession.
+type ReadingSessions []*ReadingSession
@@ -0,0 +1,158 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readingsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the readingsession type in the database.
+	Label = "reading_session"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStartPage holds the string denoting the start_page field in the database.
+	FieldStartPage = "start_page"
+	// FieldEndPage holds the string denoting the end_page field in the database.
+	FieldEndPage = "end_page"
+	// FieldPagesRead holds the string denoting the pages_read field in the database.
+	FieldPagesRead = "pages_read"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldEndedAt holds the string denoting the ended_at field in the database.
+	FieldEndedAt = "ended_at"
+	// EdgeOwner holds the string denoting the owner edge name in mutations.
+	EdgeOwner = "owner"
+	// EdgeBook holds the string denoting the book edge name in mutations.
+	EdgeBook = "book"
+	// Table holds the table name of the readingsession in the database.
+	Table = "reading_sessions"
+	// OwnerTable is the table that holds the owner relation/edge.
+	OwnerTable = "reading_sessions"
+	// OwnerInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	OwnerInverseTable = "users"
+	// OwnerColumn is the table column denoting the owner relation/edge.
+	OwnerColumn = "user_reading_sessions"
+	// BookTable is the table that holds the book relation/edge.
+	BookTable = "reading_sessions"
+	// BookInverseTable is the table name for the Book entity.
+	// It exists in this package in order to avoid circular dependency with the "book" package.
+	BookInverseTable = "books"
+	// BookColumn is the table column denoting the book relation/edge.
+	BookColumn = "book_reading_sessions"
+)
+
+// Columns holds all SQL columns for readingsession fields.
+var Columns = []string{
+	FieldID,
+	FieldStartPage,
+	FieldEndPage,
+	FieldPagesRead,
+	FieldStartedAt,
+	FieldEndedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "reading_sessions"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"book_reading_sessions",
+	"user_reading_sessions",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultStartPage holds the default value on creation for the "start_page" field.
+	DefaultStartPage int
+	// StartPageValidator is a validator for the "start_page" field. It is called by the builders before save.
+	StartPageValidator func(int) error
+	// EndPageValidator is a validator for the "end_page" field. It is called by the builders before save.
+	EndPageValidator func(int) error
+	// DefaultPagesRead holds the default value on creation for the "pages_read" field.
+	DefaultPagesRead int
+	// PagesReadValidator is a validator for the "pages_read" field. It is called by the builders before save.
+	PagesReadValidator func(int) error
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the ReadingSession queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStartPage orders the results by the start_page field.
+func ByStartPage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartPage, opts...).ToFunc()
+}
+
+// ByEndPage orders the results by the end_page field.
+func ByEndPage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndPage, opts...).ToFunc()
+}
+
+// ByPagesRead orders the results by the pages_read field.
+func ByPagesRead(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPagesRead, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByEndedAt orders the results by the ended_at field.
+func ByEndedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndedAt, opts...).ToFunc()
+}
+
+// ByOwnerField orders the results by owner field.
+func ByOwnerField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOwnerStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBookField orders the results by book field.
+func ByBookField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBookStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOwnerStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OwnerInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+	)
+}
+func newBookStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BookInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BookTable, BookColumn),
+	)
+}
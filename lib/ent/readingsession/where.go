@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package readingsession
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLTE(FieldID, id))
+}
+
+// StartPage applies equality check predicate on the "start_page" field. It's identical to StartPageEQ.
+func StartPage(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldStartPage, v))
+}
+
+// EndPage applies equality check predicate on the "end_page" field. It's identical to EndPageEQ.
+func EndPage(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldEndPage, v))
+}
+
+// PagesRead applies equality check predicate on the "pages_read" field. It's identical to PagesReadEQ.
+func PagesRead(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldPagesRead, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// EndedAt applies equality check predicate on the "ended_at" field. It's identical to EndedAtEQ.
+func EndedAt(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// StartPageEQ applies the EQ predicate on the "start_page" field.
+func StartPageEQ(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldStartPage, v))
+}
+
+// StartPageNEQ applies the NEQ predicate on the "start_page" field.
+func StartPageNEQ(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNEQ(FieldStartPage, v))
+}
+
+// StartPageIn applies the In predicate on the "start_page" field.
+func StartPageIn(vs ...int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldIn(FieldStartPage, vs...))
+}
+
+// StartPageNotIn applies the NotIn predicate on the "start_page" field.
+func StartPageNotIn(vs ...int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNotIn(FieldStartPage, vs...))
+}
+
+// StartPageGT applies the GT predicate on the "start_page" field.
+func StartPageGT(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGT(FieldStartPage, v))
+}
+
+// StartPageGTE applies the GTE predicate on the "start_page" field.
+func StartPageGTE(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGTE(FieldStartPage, v))
+}
+
+// StartPageLT applies the LT predicate on the "start_page" field.
+func StartPageLT(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLT(FieldStartPage, v))
+}
+
+// StartPageLTE applies the LTE predicate on the "start_page" field.
+func StartPageLTE(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLTE(FieldStartPage, v))
+}
+
+// EndPageEQ applies the EQ predicate on the "end_page" field.
+func EndPageEQ(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldEndPage, v))
+}
+
+// EndPageNEQ applies the NEQ predicate on the "end_page" field.
+func EndPageNEQ(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNEQ(FieldEndPage, v))
+}
+
+// EndPageIn applies the In predicate on the "end_page" field.
+func EndPageIn(vs ...int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldIn(FieldEndPage, vs...))
+}
+
+// EndPageNotIn applies the NotIn predicate on the "end_page" field.
+func EndPageNotIn(vs ...int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNotIn(FieldEndPage, vs...))
+}
+
+// EndPageGT applies the GT predicate on the "end_page" field.
+func EndPageGT(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGT(FieldEndPage, v))
+}
+
+// EndPageGTE applies the GTE predicate on the "end_page" field.
+func EndPageGTE(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGTE(FieldEndPage, v))
+}
+
+// EndPageLT applies the LT predicate on the "end_page" field.
+func EndPageLT(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLT(FieldEndPage, v))
+}
+
+// EndPageLTE applies the LTE predicate on the "end_page" field.
+func EndPageLTE(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLTE(FieldEndPage, v))
+}
+
+// EndPageIsNil applies the IsNil predicate on the "end_page" field.
+func EndPageIsNil() predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldIsNull(FieldEndPage))
+}
+
+// EndPageNotNil applies the NotNil predicate on the "end_page" field.
+func EndPageNotNil() predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNotNull(FieldEndPage))
+}
+
+// PagesReadEQ applies the EQ predicate on the "pages_read" field.
+func PagesReadEQ(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldPagesRead, v))
+}
+
+// PagesReadNEQ applies the NEQ predicate on the "pages_read" field.
+func PagesReadNEQ(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNEQ(FieldPagesRead, v))
+}
+
+// PagesReadIn applies the In predicate on the "pages_read" field.
+func PagesReadIn(vs ...int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldIn(FieldPagesRead, vs...))
+}
+
+// PagesReadNotIn applies the NotIn predicate on the "pages_read" field.
+func PagesReadNotIn(vs ...int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNotIn(FieldPagesRead, vs...))
+}
+
+// PagesReadGT applies the GT predicate on the "pages_read" field.
+func PagesReadGT(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGT(FieldPagesRead, v))
+}
+
+// PagesReadGTE applies the GTE predicate on the "pages_read" field.
+func PagesReadGTE(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGTE(FieldPagesRead, v))
+}
+
+// PagesReadLT applies the LT predicate on the "pages_read" field.
+func PagesReadLT(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLT(FieldPagesRead, v))
+}
+
+// PagesReadLTE applies the LTE predicate on the "pages_read" field.
+func PagesReadLTE(v int) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLTE(FieldPagesRead, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// EndedAtEQ applies the EQ predicate on the "ended_at" field.
+func EndedAtEQ(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldEQ(FieldEndedAt, v))
+}
+
+// EndedAtNEQ applies the NEQ predicate on the "ended_at" field.
+func EndedAtNEQ(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNEQ(FieldEndedAt, v))
+}
+
+// EndedAtIn applies the In predicate on the "ended_at" field.
+func EndedAtIn(vs ...time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldIn(FieldEndedAt, vs...))
+}
+
+// EndedAtNotIn applies the NotIn predicate on the "ended_at" field.
+func EndedAtNotIn(vs ...time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNotIn(FieldEndedAt, vs...))
+}
+
+// EndedAtGT applies the GT predicate on the "ended_at" field.
+func EndedAtGT(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGT(FieldEndedAt, v))
+}
+
+// EndedAtGTE applies the GTE predicate on the "ended_at" field.
+func EndedAtGTE(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldGTE(FieldEndedAt, v))
+}
+
+// EndedAtLT applies the LT predicate on the "ended_at" field.
+func EndedAtLT(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLT(FieldEndedAt, v))
+}
+
+// EndedAtLTE applies the LTE predicate on the "ended_at" field.
+func EndedAtLTE(v time.Time) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldLTE(FieldEndedAt, v))
+}
+
+// EndedAtIsNil applies the IsNil predicate on the "ended_at" field.
+func EndedAtIsNil() predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldIsNull(FieldEndedAt))
+}
+
+// EndedAtNotNil applies the NotNil predicate on the "ended_at" field.
+func EndedAtNotNil() predicate.ReadingSession {
+	return predicate.ReadingSession(sql.FieldNotNull(FieldEndedAt))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.ReadingSession {
+	return predicate.ReadingSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.ReadingSession {
+	return predicate.ReadingSession(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBook applies the HasEdge predicate on the "book" edge.
+func HasBook() predicate.ReadingSession {
+	return predicate.ReadingSession(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BookTable, BookColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookWith applies the HasEdge predicate on the "book" edge with a given conditions (other predicates).
+func HasBookWith(preds ...predicate.Book) predicate.ReadingSession {
+	return predicate.ReadingSession(func(s *sql.Selector) {
+		step := newBookStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReadingSession) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReadingSession) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReadingSession) predicate.ReadingSession {
+	return predicate.ReadingSession(sql.NotPredicates(p))
+}
@@ -0,0 +1,391 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// ReadingSessionCreate is the builder for creating a ReadingSession entity.
+type ReadingSessionCreate struct {
+	config
+	mutation *ReadingSessionMutation
+	hooks    []Hook
+}
+
+// SetStartPage sets the "start_page" field.
+func (_c *ReadingSessionCreate) SetStartPage(v int) *ReadingSessionCreate {
+	_c.mutation.SetStartPage(v)
+	return _c
+}
+
+// SetNillableStartPage sets the "start_page" field if the given value is not nil.
+func (_c *ReadingSessionCreate) SetNillableStartPage(v *int) *ReadingSessionCreate {
+	if v != nil {
+		_c.SetStartPage(*v)
+	}
+	return _c
+}
+
+// SetEndPage sets the "end_page" field.
+func (_c *ReadingSessionCreate) SetEndPage(v int) *ReadingSessionCreate {
+	_c.mutation.SetEndPage(v)
+	return _c
+}
+
+// SetNillableEndPage sets the "end_page" field if the given value is not nil.
+func (_c *ReadingSessionCreate) SetNillableEndPage(v *int) *ReadingSessionCreate {
+	if v != nil {
+		_c.SetEndPage(*v)
+	}
+	return _c
+}
+
+// SetPagesRead sets the "pages_read" field.
+func (_c *ReadingSessionCreate) SetPagesRead(v int) *ReadingSessionCreate {
+	_c.mutation.SetPagesRead(v)
+	return _c
+}
+
+// SetNillablePagesRead sets the "pages_read" field if the given value is not nil.
+func (_c *ReadingSessionCreate) SetNillablePagesRead(v *int) *ReadingSessionCreate {
+	if v != nil {
+		_c.SetPagesRead(*v)
+	}
+	return _c
+}
+
+// SetStartedAt sets the "started_at" field.
+func (_c *ReadingSessionCreate) SetStartedAt(v time.Time) *ReadingSessionCreate {
+	_c.mutation.SetStartedAt(v)
+	return _c
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (_c *ReadingSessionCreate) SetNillableStartedAt(v *time.Time) *ReadingSessionCreate {
+	if v != nil {
+		_c.SetStartedAt(*v)
+	}
+	return _c
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_c *ReadingSessionCreate) SetEndedAt(v time.Time) *ReadingSessionCreate {
+	_c.mutation.SetEndedAt(v)
+	return _c
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_c *ReadingSessionCreate) SetNillableEndedAt(v *time.Time) *ReadingSessionCreate {
+	if v != nil {
+		_c.SetEndedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ReadingSessionCreate) SetID(v uuid.UUID) *ReadingSessionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ReadingSessionCreate) SetNillableID(v *uuid.UUID) *ReadingSessionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *ReadingSessionCreate) SetOwnerID(id uuid.UUID) *ReadingSessionCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *ReadingSessionCreate) SetOwner(v *User) *ReadingSessionCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_c *ReadingSessionCreate) SetBookID(id uuid.UUID) *ReadingSessionCreate {
+	_c.mutation.SetBookID(id)
+	return _c
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_c *ReadingSessionCreate) SetBook(v *Book) *ReadingSessionCreate {
+	return _c.SetBookID(v.ID)
+}
+
+// Mutation returns the ReadingSessionMutation object of the builder.
+func (_c *ReadingSessionCreate) Mutation() *ReadingSessionMutation {
+	return _c.mutation
+}
+
+// Save creates the ReadingSession in the database.
+func (_c *ReadingSessionCreate) Save(ctx context.Context) (*ReadingSession, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ReadingSessionCreate) SaveX(ctx context.Context) *ReadingSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadingSessionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadingSessionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ReadingSessionCreate) defaults() {
+	if _, ok := _c.mutation.StartPage(); !ok {
+		v := readingsession.DefaultStartPage
+		_c.mutation.SetStartPage(v)
+	}
+	if _, ok := _c.mutation.PagesRead(); !ok {
+		v := readingsession.DefaultPagesRead
+		_c.mutation.SetPagesRead(v)
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		v := readingsession.DefaultStartedAt()
+		_c.mutation.SetStartedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := readingsession.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ReadingSessionCreate) check() error {
+	if _, ok := _c.mutation.StartPage(); !ok {
+		return &ValidationError{Name: "start_page", err: errors.New(`ent: missing required field "ReadingSession.start_page"`)}
+	}
+	if v, ok := _c.mutation.StartPage(); ok {
+		if err := readingsession.StartPageValidator(v); err != nil {
+			return &ValidationError{Name: "start_page", err: fmt.Errorf(`ent: validator failed for field "ReadingSession.start_page": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.EndPage(); ok {
+		if err := readingsession.EndPageValidator(v); err != nil {
+			return &ValidationError{Name: "end_page", err: fmt.Errorf(`ent: validator failed for field "ReadingSession.end_page": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PagesRead(); !ok {
+		return &ValidationError{Name: "pages_read", err: errors.New(`ent: missing required field "ReadingSession.pages_read"`)}
+	}
+	if v, ok := _c.mutation.PagesRead(); ok {
+		if err := readingsession.PagesReadValidator(v); err != nil {
+			return &ValidationError{Name: "pages_read", err: fmt.Errorf(`ent: validator failed for field "ReadingSession.pages_read": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "ReadingSession.started_at"`)}
+	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "ReadingSession.owner"`)}
+	}
+	if len(_c.mutation.BookIDs()) == 0 {
+		return &ValidationError{Name: "book", err: errors.New(`ent: missing required edge "ReadingSession.book"`)}
+	}
+	return nil
+}
+
+func (_c *ReadingSessionCreate) sqlSave(ctx context.Context) (*ReadingSession, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ReadingSessionCreate) createSpec() (*ReadingSession, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReadingSession{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(readingsession.Table, sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.StartPage(); ok {
+		_spec.SetField(readingsession.FieldStartPage, field.TypeInt, value)
+		_node.StartPage = value
+	}
+	if value, ok := _c.mutation.EndPage(); ok {
+		_spec.SetField(readingsession.FieldEndPage, field.TypeInt, value)
+		_node.EndPage = &value
+	}
+	if value, ok := _c.mutation.PagesRead(); ok {
+		_spec.SetField(readingsession.FieldPagesRead, field.TypeInt, value)
+		_node.PagesRead = value
+	}
+	if value, ok := _c.mutation.StartedAt(); ok {
+		_spec.SetField(readingsession.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := _c.mutation.EndedAt(); ok {
+		_spec.SetField(readingsession.FieldEndedAt, field.TypeTime, value)
+		_node.EndedAt = &value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.OwnerTable,
+			Columns: []string{readingsession.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_reading_sessions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.BookTable,
+			Columns: []string{readingsession.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.book_reading_sessions = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReadingSessionCreateBulk is the builder for creating many ReadingSession entities in bulk.
+type ReadingSessionCreateBulk struct {
+	config
+	err      error
+	builders []*ReadingSessionCreate
+}
+
+// Save creates the ReadingSession entities in the database.
+func (_c *ReadingSessionCreateBulk) Save(ctx context.Context) ([]*ReadingSession, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ReadingSession, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReadingSessionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ReadingSessionCreateBulk) SaveX(ctx context.Context) []*ReadingSession {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ReadingSessionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ReadingSessionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,653 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// ReadingSessionUpdate is the builder for updating ReadingSession entities.
+type ReadingSessionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReadingSessionMutation
+}
+
+// Where appends a list predicates to the ReadingSessionUpdate builder.
+func (_u *ReadingSessionUpdate) Where(ps ...predicate.ReadingSession) *ReadingSessionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStartPage sets the "start_page" field.
+func (_u *ReadingSessionUpdate) SetStartPage(v int) *ReadingSessionUpdate {
+	_u.mutation.ResetStartPage()
+	_u.mutation.SetStartPage(v)
+	return _u
+}
+
+// SetNillableStartPage sets the "start_page" field if the given value is not nil.
+func (_u *ReadingSessionUpdate) SetNillableStartPage(v *int) *ReadingSessionUpdate {
+	if v != nil {
+		_u.SetStartPage(*v)
+	}
+	return _u
+}
+
+// AddStartPage adds value to the "start_page" field.
+func (_u *ReadingSessionUpdate) AddStartPage(v int) *ReadingSessionUpdate {
+	_u.mutation.AddStartPage(v)
+	return _u
+}
+
+// SetEndPage sets the "end_page" field.
+func (_u *ReadingSessionUpdate) SetEndPage(v int) *ReadingSessionUpdate {
+	_u.mutation.ResetEndPage()
+	_u.mutation.SetEndPage(v)
+	return _u
+}
+
+// SetNillableEndPage sets the "end_page" field if the given value is not nil.
+func (_u *ReadingSessionUpdate) SetNillableEndPage(v *int) *ReadingSessionUpdate {
+	if v != nil {
+		_u.SetEndPage(*v)
+	}
+	return _u
+}
+
+// AddEndPage adds value to the "end_page" field.
+func (_u *ReadingSessionUpdate) AddEndPage(v int) *ReadingSessionUpdate {
+	_u.mutation.AddEndPage(v)
+	return _u
+}
+
+// ClearEndPage clears the value of the "end_page" field.
+func (_u *ReadingSessionUpdate) ClearEndPage() *ReadingSessionUpdate {
+	_u.mutation.ClearEndPage()
+	return _u
+}
+
+// SetPagesRead sets the "pages_read" field.
+func (_u *ReadingSessionUpdate) SetPagesRead(v int) *ReadingSessionUpdate {
+	_u.mutation.ResetPagesRead()
+	_u.mutation.SetPagesRead(v)
+	return _u
+}
+
+// SetNillablePagesRead sets the "pages_read" field if the given value is not nil.
+func (_u *ReadingSessionUpdate) SetNillablePagesRead(v *int) *ReadingSessionUpdate {
+	if v != nil {
+		_u.SetPagesRead(*v)
+	}
+	return _u
+}
+
+// AddPagesRead adds value to the "pages_read" field.
+func (_u *ReadingSessionUpdate) AddPagesRead(v int) *ReadingSessionUpdate {
+	_u.mutation.AddPagesRead(v)
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *ReadingSessionUpdate) SetEndedAt(v time.Time) *ReadingSessionUpdate {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *ReadingSessionUpdate) SetNillableEndedAt(v *time.Time) *ReadingSessionUpdate {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *ReadingSessionUpdate) ClearEndedAt() *ReadingSessionUpdate {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *ReadingSessionUpdate) SetOwnerID(id uuid.UUID) *ReadingSessionUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *ReadingSessionUpdate) SetOwner(v *User) *ReadingSessionUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *ReadingSessionUpdate) SetBookID(id uuid.UUID) *ReadingSessionUpdate {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *ReadingSessionUpdate) SetBook(v *Book) *ReadingSessionUpdate {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the ReadingSessionMutation object of the builder.
+func (_u *ReadingSessionUpdate) Mutation() *ReadingSessionMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *ReadingSessionUpdate) ClearOwner() *ReadingSessionUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *ReadingSessionUpdate) ClearBook() *ReadingSessionUpdate {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ReadingSessionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadingSessionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ReadingSessionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadingSessionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReadingSessionUpdate) check() error {
+	if v, ok := _u.mutation.StartPage(); ok {
+		if err := readingsession.StartPageValidator(v); err != nil {
+			return &ValidationError{Name: "start_page", err: fmt.Errorf(`ent: validator failed for field "ReadingSession.start_page": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndPage(); ok {
+		if err := readingsession.EndPageValidator(v); err != nil {
+			return &ValidationError{Name: "end_page", err: fmt.Errorf(`ent: validator failed for field "ReadingSession.end_page": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PagesRead(); ok {
+		if err := readingsession.PagesReadValidator(v); err != nil {
+			return &ValidationError{Name: "pages_read", err: fmt.Errorf(`ent: validator failed for field "ReadingSession.pages_read": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadingSession.owner"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadingSession.book"`)
+	}
+	return nil
+}
+
+func (_u *ReadingSessionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readingsession.Table, readingsession.Columns, sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StartPage(); ok {
+		_spec.SetField(readingsession.FieldStartPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartPage(); ok {
+		_spec.AddField(readingsession.FieldStartPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndPage(); ok {
+		_spec.SetField(readingsession.FieldEndPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndPage(); ok {
+		_spec.AddField(readingsession.FieldEndPage, field.TypeInt, value)
+	}
+	if _u.mutation.EndPageCleared() {
+		_spec.ClearField(readingsession.FieldEndPage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PagesRead(); ok {
+		_spec.SetField(readingsession.FieldPagesRead, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPagesRead(); ok {
+		_spec.AddField(readingsession.FieldPagesRead, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(readingsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(readingsession.FieldEndedAt, field.TypeTime)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.OwnerTable,
+			Columns: []string{readingsession.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.OwnerTable,
+			Columns: []string{readingsession.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.BookTable,
+			Columns: []string{readingsession.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.BookTable,
+			Columns: []string{readingsession.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readingsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ReadingSessionUpdateOne is the builder for updating a single ReadingSession entity.
+type ReadingSessionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReadingSessionMutation
+}
+
+// SetStartPage sets the "start_page" field.
+func (_u *ReadingSessionUpdateOne) SetStartPage(v int) *ReadingSessionUpdateOne {
+	_u.mutation.ResetStartPage()
+	_u.mutation.SetStartPage(v)
+	return _u
+}
+
+// SetNillableStartPage sets the "start_page" field if the given value is not nil.
+func (_u *ReadingSessionUpdateOne) SetNillableStartPage(v *int) *ReadingSessionUpdateOne {
+	if v != nil {
+		_u.SetStartPage(*v)
+	}
+	return _u
+}
+
+// AddStartPage adds value to the "start_page" field.
+func (_u *ReadingSessionUpdateOne) AddStartPage(v int) *ReadingSessionUpdateOne {
+	_u.mutation.AddStartPage(v)
+	return _u
+}
+
+// SetEndPage sets the "end_page" field.
+func (_u *ReadingSessionUpdateOne) SetEndPage(v int) *ReadingSessionUpdateOne {
+	_u.mutation.ResetEndPage()
+	_u.mutation.SetEndPage(v)
+	return _u
+}
+
+// SetNillableEndPage sets the "end_page" field if the given value is not nil.
+func (_u *ReadingSessionUpdateOne) SetNillableEndPage(v *int) *ReadingSessionUpdateOne {
+	if v != nil {
+		_u.SetEndPage(*v)
+	}
+	return _u
+}
+
+// AddEndPage adds value to the "end_page" field.
+func (_u *ReadingSessionUpdateOne) AddEndPage(v int) *ReadingSessionUpdateOne {
+	_u.mutation.AddEndPage(v)
+	return _u
+}
+
+// ClearEndPage clears the value of the "end_page" field.
+func (_u *ReadingSessionUpdateOne) ClearEndPage() *ReadingSessionUpdateOne {
+	_u.mutation.ClearEndPage()
+	return _u
+}
+
+// SetPagesRead sets the "pages_read" field.
+func (_u *ReadingSessionUpdateOne) SetPagesRead(v int) *ReadingSessionUpdateOne {
+	_u.mutation.ResetPagesRead()
+	_u.mutation.SetPagesRead(v)
+	return _u
+}
+
+// SetNillablePagesRead sets the "pages_read" field if the given value is not nil.
+func (_u *ReadingSessionUpdateOne) SetNillablePagesRead(v *int) *ReadingSessionUpdateOne {
+	if v != nil {
+		_u.SetPagesRead(*v)
+	}
+	return _u
+}
+
+// AddPagesRead adds value to the "pages_read" field.
+func (_u *ReadingSessionUpdateOne) AddPagesRead(v int) *ReadingSessionUpdateOne {
+	_u.mutation.AddPagesRead(v)
+	return _u
+}
+
+// SetEndedAt sets the "ended_at" field.
+func (_u *ReadingSessionUpdateOne) SetEndedAt(v time.Time) *ReadingSessionUpdateOne {
+	_u.mutation.SetEndedAt(v)
+	return _u
+}
+
+// SetNillableEndedAt sets the "ended_at" field if the given value is not nil.
+func (_u *ReadingSessionUpdateOne) SetNillableEndedAt(v *time.Time) *ReadingSessionUpdateOne {
+	if v != nil {
+		_u.SetEndedAt(*v)
+	}
+	return _u
+}
+
+// ClearEndedAt clears the value of the "ended_at" field.
+func (_u *ReadingSessionUpdateOne) ClearEndedAt() *ReadingSessionUpdateOne {
+	_u.mutation.ClearEndedAt()
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *ReadingSessionUpdateOne) SetOwnerID(id uuid.UUID) *ReadingSessionUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *ReadingSessionUpdateOne) SetOwner(v *User) *ReadingSessionUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// SetBookID sets the "book" edge to the Book entity by ID.
+func (_u *ReadingSessionUpdateOne) SetBookID(id uuid.UUID) *ReadingSessionUpdateOne {
+	_u.mutation.SetBookID(id)
+	return _u
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *ReadingSessionUpdateOne) SetBook(v *Book) *ReadingSessionUpdateOne {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the ReadingSessionMutation object of the builder.
+func (_u *ReadingSessionUpdateOne) Mutation() *ReadingSessionMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *ReadingSessionUpdateOne) ClearOwner() *ReadingSessionUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *ReadingSessionUpdateOne) ClearBook() *ReadingSessionUpdateOne {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Where appends a list predicates to the ReadingSessionUpdate builder.
+func (_u *ReadingSessionUpdateOne) Where(ps ...predicate.ReadingSession) *ReadingSessionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ReadingSessionUpdateOne) Select(field string, fields ...string) *ReadingSessionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ReadingSession entity.
+func (_u *ReadingSessionUpdateOne) Save(ctx context.Context) (*ReadingSession, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ReadingSessionUpdateOne) SaveX(ctx context.Context) *ReadingSession {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ReadingSessionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ReadingSessionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ReadingSessionUpdateOne) check() error {
+	if v, ok := _u.mutation.StartPage(); ok {
+		if err := readingsession.StartPageValidator(v); err != nil {
+			return &ValidationError{Name: "start_page", err: fmt.Errorf(`ent: validator failed for field "ReadingSession.start_page": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.EndPage(); ok {
+		if err := readingsession.EndPageValidator(v); err != nil {
+			return &ValidationError{Name: "end_page", err: fmt.Errorf(`ent: validator failed for field "ReadingSession.end_page": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.PagesRead(); ok {
+		if err := readingsession.PagesReadValidator(v); err != nil {
+			return &ValidationError{Name: "pages_read", err: fmt.Errorf(`ent: validator failed for field "ReadingSession.pages_read": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadingSession.owner"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ReadingSession.book"`)
+	}
+	return nil
+}
+
+func (_u *ReadingSessionUpdateOne) sqlSave(ctx context.Context) (_node *ReadingSession, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(readingsession.Table, readingsession.Columns, sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReadingSession.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, readingsession.FieldID)
+		for _, f := range fields {
+			if !readingsession.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != readingsession.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StartPage(); ok {
+		_spec.SetField(readingsession.FieldStartPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStartPage(); ok {
+		_spec.AddField(readingsession.FieldStartPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndPage(); ok {
+		_spec.SetField(readingsession.FieldEndPage, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedEndPage(); ok {
+		_spec.AddField(readingsession.FieldEndPage, field.TypeInt, value)
+	}
+	if _u.mutation.EndPageCleared() {
+		_spec.ClearField(readingsession.FieldEndPage, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PagesRead(); ok {
+		_spec.SetField(readingsession.FieldPagesRead, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPagesRead(); ok {
+		_spec.AddField(readingsession.FieldPagesRead, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EndedAt(); ok {
+		_spec.SetField(readingsession.FieldEndedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndedAtCleared() {
+		_spec.ClearField(readingsession.FieldEndedAt, field.TypeTime)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.OwnerTable,
+			Columns: []string{readingsession.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.OwnerTable,
+			Columns: []string{readingsession.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.BookTable,
+			Columns: []string{readingsession.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   readingsession.BookTable,
+			Columns: []string{readingsession.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReadingSession{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{readingsession.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
 	"github.com/google/uuid"
@@ -28,16 +29,21 @@ type Review struct {
 	Rating int `json:"rating,omitempty"`
 	// Whether the review is public
 	IsPublic bool `json:"is_public,omitempty"`
+	// 낙관적 동시성 제어용 버전 (수정할 때마다 1씩 증가)
+	Version int `json:"version,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// 휴지통으로 옮긴 시간 (null이면 삭제되지 않음)
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ReviewQuery when eager-loading is set.
-	Edges        ReviewEdges `json:"edges"`
-	book_reviews *uuid.UUID
-	user_reviews *uuid.UUID
-	selectValues sql.SelectValues
+	Edges                ReviewEdges `json:"edges"`
+	book_reviews         *uuid.UUID
+	book_catalog_reviews *uuid.UUID
+	user_reviews         *uuid.UUID
+	selectValues         sql.SelectValues
 }
 
 // ReviewEdges holds the relations/edges for other nodes in the graph.
@@ -46,9 +52,11 @@ type ReviewEdges struct {
 	Owner *User `json:"owner,omitempty"`
 	// Book holds the value of the book edge.
 	Book *Book `json:"book,omitempty"`
+	// Catalog holds the value of the catalog edge.
+	Catalog *BookCatalog `json:"catalog,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // OwnerOrErr returns the Owner value or an error if the edge
@@ -73,6 +81,17 @@ func (e ReviewEdges) BookOrErr() (*Book, error) {
 	return nil, &NotLoadedError{edge: "book"}
 }
 
+// CatalogOrErr returns the Catalog value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReviewEdges) CatalogOrErr() (*BookCatalog, error) {
+	if e.Catalog != nil {
+		return e.Catalog, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: bookcatalog.Label}
+	}
+	return nil, &NotLoadedError{edge: "catalog"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Review) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -80,17 +99,19 @@ func (*Review) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case review.FieldIsPublic:
 			values[i] = new(sql.NullBool)
-		case review.FieldRating:
+		case review.FieldRating, review.FieldVersion:
 			values[i] = new(sql.NullInt64)
 		case review.FieldBookIsbn, review.FieldContent:
 			values[i] = new(sql.NullString)
-		case review.FieldCreatedAt, review.FieldUpdatedAt:
+		case review.FieldCreatedAt, review.FieldUpdatedAt, review.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case review.FieldID:
 			values[i] = new(uuid.UUID)
 		case review.ForeignKeys[0]: // book_reviews
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
-		case review.ForeignKeys[1]: // user_reviews
+		case review.ForeignKeys[1]: // book_catalog_reviews
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case review.ForeignKeys[2]: // user_reviews
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
@@ -137,6 +158,12 @@ func (_m *Review) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsPublic = value.Bool
 			}
+		case review.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
 		case review.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -149,6 +176,13 @@ func (_m *Review) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case review.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case review.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field book_reviews", values[i])
@@ -157,6 +191,13 @@ func (_m *Review) assignValues(columns []string, values []any) error {
 				*_m.book_reviews = *value.S.(*uuid.UUID)
 			}
 		case review.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field book_catalog_reviews", values[i])
+			} else if value.Valid {
+				_m.book_catalog_reviews = new(uuid.UUID)
+				*_m.book_catalog_reviews = *value.S.(*uuid.UUID)
+			}
+		case review.ForeignKeys[2]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field user_reviews", values[i])
 			} else if value.Valid {
@@ -186,6 +227,11 @@ func (_m *Review) QueryBook() *BookQuery {
 	return NewReviewClient(_m.config).QueryBook(_m)
 }
 
+// QueryCatalog queries the "catalog" edge of the Review entity.
+func (_m *Review) QueryCatalog() *BookCatalogQuery {
+	return NewReviewClient(_m.config).QueryCatalog(_m)
+}
+
 // Update returns a builder for updating this Review.
 // Note that you need to call Review.Unwrap() before calling this method if this Review
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -221,11 +267,19 @@ func (_m *Review) String() string {
 	builder.WriteString("is_public=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsPublic))
 	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
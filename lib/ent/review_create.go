@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
 	"github.com/google/uuid"
@@ -55,6 +56,20 @@ func (_c *ReviewCreate) SetNillableIsPublic(v *bool) *ReviewCreate {
 	return _c
 }
 
+// SetVersion sets the "version" field.
+func (_c *ReviewCreate) SetVersion(v int) *ReviewCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_c *ReviewCreate) SetNillableVersion(v *int) *ReviewCreate {
+	if v != nil {
+		_c.SetVersion(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ReviewCreate) SetCreatedAt(v time.Time) *ReviewCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -83,6 +98,20 @@ func (_c *ReviewCreate) SetNillableUpdatedAt(v *time.Time) *ReviewCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ReviewCreate) SetDeletedAt(v time.Time) *ReviewCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ReviewCreate) SetNillableDeletedAt(v *time.Time) *ReviewCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *ReviewCreate) SetID(v uuid.UUID) *ReviewCreate {
 	_c.mutation.SetID(v)
@@ -127,6 +156,25 @@ func (_c *ReviewCreate) SetBook(v *Book) *ReviewCreate {
 	return _c.SetBookID(v.ID)
 }
 
+// SetCatalogID sets the "catalog" edge to the BookCatalog entity by ID.
+func (_c *ReviewCreate) SetCatalogID(id uuid.UUID) *ReviewCreate {
+	_c.mutation.SetCatalogID(id)
+	return _c
+}
+
+// SetNillableCatalogID sets the "catalog" edge to the BookCatalog entity by ID if the given value is not nil.
+func (_c *ReviewCreate) SetNillableCatalogID(id *uuid.UUID) *ReviewCreate {
+	if id != nil {
+		_c = _c.SetCatalogID(*id)
+	}
+	return _c
+}
+
+// SetCatalog sets the "catalog" edge to the BookCatalog entity.
+func (_c *ReviewCreate) SetCatalog(v *BookCatalog) *ReviewCreate {
+	return _c.SetCatalogID(v.ID)
+}
+
 // Mutation returns the ReviewMutation object of the builder.
 func (_c *ReviewCreate) Mutation() *ReviewMutation {
 	return _c.mutation
@@ -166,6 +214,10 @@ func (_c *ReviewCreate) defaults() {
 		v := review.DefaultIsPublic
 		_c.mutation.SetIsPublic(v)
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		v := review.DefaultVersion
+		_c.mutation.SetVersion(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := review.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -209,6 +261,14 @@ func (_c *ReviewCreate) check() error {
 	if _, ok := _c.mutation.IsPublic(); !ok {
 		return &ValidationError{Name: "is_public", err: errors.New(`ent: missing required field "Review.is_public"`)}
 	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "Review.version"`)}
+	}
+	if v, ok := _c.mutation.Version(); ok {
+		if err := review.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Review.version": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Review.created_at"`)}
 	}
@@ -269,6 +329,10 @@ func (_c *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 		_spec.SetField(review.FieldIsPublic, field.TypeBool, value)
 		_node.IsPublic = value
 	}
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(review.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(review.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -277,6 +341,10 @@ func (_c *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 		_spec.SetField(review.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(review.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -311,6 +379,23 @@ func (_c *ReviewCreate) createSpec() (*Review, *sqlgraph.CreateSpec) {
 		_node.book_reviews = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.CatalogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.CatalogTable,
+			Columns: []string{review.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.book_catalog_reviews = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
@@ -21,13 +22,14 @@ import (
 // ReviewQuery is the builder for querying Review entities.
 type ReviewQuery struct {
 	config
-	ctx        *QueryContext
-	order      []review.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Review
-	withOwner  *UserQuery
-	withBook   *BookQuery
-	withFKs    bool
+	ctx         *QueryContext
+	order       []review.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.Review
+	withOwner   *UserQuery
+	withBook    *BookQuery
+	withCatalog *BookCatalogQuery
+	withFKs     bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +110,28 @@ func (_q *ReviewQuery) QueryBook() *BookQuery {
 	return query
 }
 
+// QueryCatalog chains the current query on the "catalog" edge.
+func (_q *ReviewQuery) QueryCatalog() *BookCatalogQuery {
+	query := (&BookCatalogClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(review.Table, review.FieldID, selector),
+			sqlgraph.To(bookcatalog.Table, bookcatalog.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, review.CatalogTable, review.CatalogColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Review entity from the query.
 // Returns a *NotFoundError when no Review was found.
 func (_q *ReviewQuery) First(ctx context.Context) (*Review, error) {
@@ -295,13 +319,14 @@ func (_q *ReviewQuery) Clone() *ReviewQuery {
 		return nil
 	}
 	return &ReviewQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]review.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Review{}, _q.predicates...),
-		withOwner:  _q.withOwner.Clone(),
-		withBook:   _q.withBook.Clone(),
+		config:      _q.config,
+		ctx:         _q.ctx.Clone(),
+		order:       append([]review.OrderOption{}, _q.order...),
+		inters:      append([]Interceptor{}, _q.inters...),
+		predicates:  append([]predicate.Review{}, _q.predicates...),
+		withOwner:   _q.withOwner.Clone(),
+		withBook:    _q.withBook.Clone(),
+		withCatalog: _q.withCatalog.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,6 +355,17 @@ func (_q *ReviewQuery) WithBook(opts ...func(*BookQuery)) *ReviewQuery {
 	return _q
 }
 
+// WithCatalog tells the query-builder to eager-load the nodes that are connected to
+// the "catalog" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ReviewQuery) WithCatalog(opts ...func(*BookCatalogQuery)) *ReviewQuery {
+	query := (&BookCatalogClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCatalog = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,12 +445,13 @@ func (_q *ReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Revie
 		nodes       = []*Review{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withOwner != nil,
 			_q.withBook != nil,
+			_q.withCatalog != nil,
 		}
 	)
-	if _q.withOwner != nil || _q.withBook != nil {
+	if _q.withOwner != nil || _q.withBook != nil || _q.withCatalog != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -450,6 +487,12 @@ func (_q *ReviewQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Revie
 			return nil, err
 		}
 	}
+	if query := _q.withCatalog; query != nil {
+		if err := _q.loadCatalog(ctx, query, nodes, nil,
+			func(n *Review, e *BookCatalog) { n.Edges.Catalog = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -517,6 +560,38 @@ func (_q *ReviewQuery) loadBook(ctx context.Context, query *BookQuery, nodes []*
 	}
 	return nil
 }
+func (_q *ReviewQuery) loadCatalog(ctx context.Context, query *BookCatalogQuery, nodes []*Review, init func(*Review), assign func(*Review, *BookCatalog)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Review)
+	for i := range nodes {
+		if nodes[i].book_catalog_reviews == nil {
+			continue
+		}
+		fk := *nodes[i].book_catalog_reviews
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(bookcatalog.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "book_catalog_reviews" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *ReviewQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
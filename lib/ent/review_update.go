@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
@@ -94,12 +95,53 @@ func (_u *ReviewUpdate) SetNillableIsPublic(v *bool) *ReviewUpdate {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *ReviewUpdate) SetVersion(v int) *ReviewUpdate {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ReviewUpdate) SetNillableVersion(v *int) *ReviewUpdate {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ReviewUpdate) AddVersion(v int) *ReviewUpdate {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ReviewUpdate) SetUpdatedAt(v time.Time) *ReviewUpdate {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ReviewUpdate) SetDeletedAt(v time.Time) *ReviewUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ReviewUpdate) SetNillableDeletedAt(v *time.Time) *ReviewUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ReviewUpdate) ClearDeletedAt() *ReviewUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *ReviewUpdate) SetOwnerID(id uuid.UUID) *ReviewUpdate {
 	_u.mutation.SetOwnerID(id)
@@ -130,6 +172,25 @@ func (_u *ReviewUpdate) SetBook(v *Book) *ReviewUpdate {
 	return _u.SetBookID(v.ID)
 }
 
+// SetCatalogID sets the "catalog" edge to the BookCatalog entity by ID.
+func (_u *ReviewUpdate) SetCatalogID(id uuid.UUID) *ReviewUpdate {
+	_u.mutation.SetCatalogID(id)
+	return _u
+}
+
+// SetNillableCatalogID sets the "catalog" edge to the BookCatalog entity by ID if the given value is not nil.
+func (_u *ReviewUpdate) SetNillableCatalogID(id *uuid.UUID) *ReviewUpdate {
+	if id != nil {
+		_u = _u.SetCatalogID(*id)
+	}
+	return _u
+}
+
+// SetCatalog sets the "catalog" edge to the BookCatalog entity.
+func (_u *ReviewUpdate) SetCatalog(v *BookCatalog) *ReviewUpdate {
+	return _u.SetCatalogID(v.ID)
+}
+
 // Mutation returns the ReviewMutation object of the builder.
 func (_u *ReviewUpdate) Mutation() *ReviewMutation {
 	return _u.mutation
@@ -147,6 +208,12 @@ func (_u *ReviewUpdate) ClearBook() *ReviewUpdate {
 	return _u
 }
 
+// ClearCatalog clears the "catalog" edge to the BookCatalog entity.
+func (_u *ReviewUpdate) ClearCatalog() *ReviewUpdate {
+	_u.mutation.ClearCatalog()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ReviewUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -200,6 +267,11 @@ func (_u *ReviewUpdate) check() error {
 			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "Review.rating": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := review.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Review.version": %w`, err)}
+		}
+	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Review.owner"`)
 	}
@@ -233,9 +305,21 @@ func (_u *ReviewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(review.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(review.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(review.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(review.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(review.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(review.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -294,6 +378,35 @@ func (_u *ReviewUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CatalogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.CatalogTable,
+			Columns: []string{review.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CatalogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.CatalogTable,
+			Columns: []string{review.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{review.Label}
@@ -377,12 +490,53 @@ func (_u *ReviewUpdateOne) SetNillableIsPublic(v *bool) *ReviewUpdateOne {
 	return _u
 }
 
+// SetVersion sets the "version" field.
+func (_u *ReviewUpdateOne) SetVersion(v int) *ReviewUpdateOne {
+	_u.mutation.ResetVersion()
+	_u.mutation.SetVersion(v)
+	return _u
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (_u *ReviewUpdateOne) SetNillableVersion(v *int) *ReviewUpdateOne {
+	if v != nil {
+		_u.SetVersion(*v)
+	}
+	return _u
+}
+
+// AddVersion adds value to the "version" field.
+func (_u *ReviewUpdateOne) AddVersion(v int) *ReviewUpdateOne {
+	_u.mutation.AddVersion(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ReviewUpdateOne) SetUpdatedAt(v time.Time) *ReviewUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ReviewUpdateOne) SetDeletedAt(v time.Time) *ReviewUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ReviewUpdateOne) SetNillableDeletedAt(v *time.Time) *ReviewUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ReviewUpdateOne) ClearDeletedAt() *ReviewUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
 // SetOwnerID sets the "owner" edge to the User entity by ID.
 func (_u *ReviewUpdateOne) SetOwnerID(id uuid.UUID) *ReviewUpdateOne {
 	_u.mutation.SetOwnerID(id)
@@ -413,6 +567,25 @@ func (_u *ReviewUpdateOne) SetBook(v *Book) *ReviewUpdateOne {
 	return _u.SetBookID(v.ID)
 }
 
+// SetCatalogID sets the "catalog" edge to the BookCatalog entity by ID.
+func (_u *ReviewUpdateOne) SetCatalogID(id uuid.UUID) *ReviewUpdateOne {
+	_u.mutation.SetCatalogID(id)
+	return _u
+}
+
+// SetNillableCatalogID sets the "catalog" edge to the BookCatalog entity by ID if the given value is not nil.
+func (_u *ReviewUpdateOne) SetNillableCatalogID(id *uuid.UUID) *ReviewUpdateOne {
+	if id != nil {
+		_u = _u.SetCatalogID(*id)
+	}
+	return _u
+}
+
+// SetCatalog sets the "catalog" edge to the BookCatalog entity.
+func (_u *ReviewUpdateOne) SetCatalog(v *BookCatalog) *ReviewUpdateOne {
+	return _u.SetCatalogID(v.ID)
+}
+
 // Mutation returns the ReviewMutation object of the builder.
 func (_u *ReviewUpdateOne) Mutation() *ReviewMutation {
 	return _u.mutation
@@ -430,6 +603,12 @@ func (_u *ReviewUpdateOne) ClearBook() *ReviewUpdateOne {
 	return _u
 }
 
+// ClearCatalog clears the "catalog" edge to the BookCatalog entity.
+func (_u *ReviewUpdateOne) ClearCatalog() *ReviewUpdateOne {
+	_u.mutation.ClearCatalog()
+	return _u
+}
+
 // Where appends a list predicates to the ReviewUpdate builder.
 func (_u *ReviewUpdateOne) Where(ps ...predicate.Review) *ReviewUpdateOne {
 	_u.mutation.Where(ps...)
@@ -496,6 +675,11 @@ func (_u *ReviewUpdateOne) check() error {
 			return &ValidationError{Name: "rating", err: fmt.Errorf(`ent: validator failed for field "Review.rating": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Version(); ok {
+		if err := review.VersionValidator(v); err != nil {
+			return &ValidationError{Name: "version", err: fmt.Errorf(`ent: validator failed for field "Review.version": %w`, err)}
+		}
+	}
 	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Review.owner"`)
 	}
@@ -546,9 +730,21 @@ func (_u *ReviewUpdateOne) sqlSave(ctx context.Context) (_node *Review, err erro
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(review.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Version(); ok {
+		_spec.SetField(review.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedVersion(); ok {
+		_spec.AddField(review.FieldVersion, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(review.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(review.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(review.FieldDeletedAt, field.TypeTime)
+	}
 	if _u.mutation.OwnerCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -607,6 +803,35 @@ func (_u *ReviewUpdateOne) sqlSave(ctx context.Context) (_node *Review, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.CatalogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.CatalogTable,
+			Columns: []string{review.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CatalogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   review.CatalogTable,
+			Columns: []string{review.CatalogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookcatalog.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Review{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
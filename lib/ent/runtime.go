@@ -7,12 +7,27 @@ import (
 
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/adminapikey"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookcatalog"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookstatushistory"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/datamigration"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/emailverification"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/library"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingreminder"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/schema"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
 	"github.com/google/uuid"
 )
 
@@ -50,28 +65,124 @@ func init() {
 	adminapikey.UpdateDefaultUpdatedAt = adminapikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
 	bookFields := schema.Book{}.Fields()
 	_ = bookFields
-	// bookDescBookTitle is the schema descriptor for book_title field.
-	bookDescBookTitle := bookFields[1].Descriptor()
-	// book.BookTitleValidator is a validator for the "book_title" field. It is called by the builders before save.
-	book.BookTitleValidator = bookDescBookTitle.Validators[0].(func(string) error)
-	// bookDescAuthor is the schema descriptor for author field.
-	bookDescAuthor := bookFields[2].Descriptor()
-	// book.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
-	book.AuthorValidator = bookDescAuthor.Validators[0].(func(string) error)
-	// bookDescStatus is the schema descriptor for status field.
-	bookDescStatus := bookFields[5].Descriptor()
-	// book.DefaultStatus holds the default value on creation for the status field.
-	book.DefaultStatus = bookDescStatus.Default.(int)
+	// bookDescCurrentPage is the schema descriptor for current_page field.
+	bookDescCurrentPage := bookFields[4].Descriptor()
+	// book.DefaultCurrentPage holds the default value on creation for the current_page field.
+	book.DefaultCurrentPage = bookDescCurrentPage.Default.(int)
+	// book.CurrentPageValidator is a validator for the "current_page" field. It is called by the builders before save.
+	book.CurrentPageValidator = bookDescCurrentPage.Validators[0].(func(int) error)
+	// bookDescTotalPages is the schema descriptor for total_pages field.
+	bookDescTotalPages := bookFields[5].Descriptor()
+	// book.DefaultTotalPages holds the default value on creation for the total_pages field.
+	book.DefaultTotalPages = bookDescTotalPages.Default.(int)
+	// book.TotalPagesValidator is a validator for the "total_pages" field. It is called by the builders before save.
+	book.TotalPagesValidator = bookDescTotalPages.Validators[0].(func(int) error)
+	// bookDescLocationRoom is the schema descriptor for location_room field.
+	bookDescLocationRoom := bookFields[11].Descriptor()
+	// book.DefaultLocationRoom holds the default value on creation for the location_room field.
+	book.DefaultLocationRoom = bookDescLocationRoom.Default.(string)
+	// bookDescLocationBookcase is the schema descriptor for location_bookcase field.
+	bookDescLocationBookcase := bookFields[12].Descriptor()
+	// book.DefaultLocationBookcase holds the default value on creation for the location_bookcase field.
+	book.DefaultLocationBookcase = bookDescLocationBookcase.Default.(string)
+	// bookDescLocationShelf is the schema descriptor for location_shelf field.
+	bookDescLocationShelf := bookFields[13].Descriptor()
+	// book.DefaultLocationShelf holds the default value on creation for the location_shelf field.
+	book.DefaultLocationShelf = bookDescLocationShelf.Default.(string)
+	// bookDescPrice is the schema descriptor for price field.
+	bookDescPrice := bookFields[17].Descriptor()
+	// book.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	book.PriceValidator = bookDescPrice.Validators[0].(func(float64) error)
+	// bookDescCurrency is the schema descriptor for currency field.
+	bookDescCurrency := bookFields[18].Descriptor()
+	// book.DefaultCurrency holds the default value on creation for the currency field.
+	book.DefaultCurrency = bookDescCurrency.Default.(string)
+	// bookDescAcquiredFrom is the schema descriptor for acquired_from field.
+	bookDescAcquiredFrom := bookFields[19].Descriptor()
+	// book.DefaultAcquiredFrom holds the default value on creation for the acquired_from field.
+	book.DefaultAcquiredFrom = bookDescAcquiredFrom.Default.(string)
+	// bookDescVersion is the schema descriptor for version field.
+	bookDescVersion := bookFields[21].Descriptor()
+	// book.DefaultVersion holds the default value on creation for the version field.
+	book.DefaultVersion = bookDescVersion.Default.(int)
+	// book.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	book.VersionValidator = bookDescVersion.Validators[0].(func(int) error)
 	// bookDescCreatedAt is the schema descriptor for created_at field.
-	bookDescCreatedAt := bookFields[6].Descriptor()
+	bookDescCreatedAt := bookFields[22].Descriptor()
 	// book.DefaultCreatedAt holds the default value on creation for the created_at field.
 	book.DefaultCreatedAt = bookDescCreatedAt.Default.(time.Time)
 	// bookDescUpdatedAt is the schema descriptor for updated_at field.
-	bookDescUpdatedAt := bookFields[7].Descriptor()
+	bookDescUpdatedAt := bookFields[23].Descriptor()
 	// book.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	book.DefaultUpdatedAt = bookDescUpdatedAt.Default.(time.Time)
 	// book.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	book.UpdateDefaultUpdatedAt = bookDescUpdatedAt.UpdateDefault.(func() time.Time)
+	bookcatalogFields := schema.BookCatalog{}.Fields()
+	_ = bookcatalogFields
+	// bookcatalogDescTitle is the schema descriptor for title field.
+	bookcatalogDescTitle := bookcatalogFields[2].Descriptor()
+	// bookcatalog.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	bookcatalog.TitleValidator = bookcatalogDescTitle.Validators[0].(func(string) error)
+	// bookcatalogDescAuthor is the schema descriptor for author field.
+	bookcatalogDescAuthor := bookcatalogFields[3].Descriptor()
+	// bookcatalog.AuthorValidator is a validator for the "author" field. It is called by the builders before save.
+	bookcatalog.AuthorValidator = bookcatalogDescAuthor.Validators[0].(func(string) error)
+	// bookcatalogDescSeriesVolume is the schema descriptor for series_volume field.
+	bookcatalogDescSeriesVolume := bookcatalogFields[8].Descriptor()
+	// bookcatalog.SeriesVolumeValidator is a validator for the "series_volume" field. It is called by the builders before save.
+	bookcatalog.SeriesVolumeValidator = bookcatalogDescSeriesVolume.Validators[0].(func(int) error)
+	// bookcatalogDescSeriesTotalVolumes is the schema descriptor for series_total_volumes field.
+	bookcatalogDescSeriesTotalVolumes := bookcatalogFields[9].Descriptor()
+	// bookcatalog.SeriesTotalVolumesValidator is a validator for the "series_total_volumes" field. It is called by the builders before save.
+	bookcatalog.SeriesTotalVolumesValidator = bookcatalogDescSeriesTotalVolumes.Validators[0].(func(int) error)
+	// bookcatalogDescSource is the schema descriptor for source field.
+	bookcatalogDescSource := bookcatalogFields[10].Descriptor()
+	// bookcatalog.DefaultSource holds the default value on creation for the source field.
+	bookcatalog.DefaultSource = bookcatalogDescSource.Default.(string)
+	// bookcatalogDescCreatedAt is the schema descriptor for created_at field.
+	bookcatalogDescCreatedAt := bookcatalogFields[11].Descriptor()
+	// bookcatalog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	bookcatalog.DefaultCreatedAt = bookcatalogDescCreatedAt.Default.(func() time.Time)
+	// bookcatalogDescUpdatedAt is the schema descriptor for updated_at field.
+	bookcatalogDescUpdatedAt := bookcatalogFields[12].Descriptor()
+	// bookcatalog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	bookcatalog.DefaultUpdatedAt = bookcatalogDescUpdatedAt.Default.(func() time.Time)
+	// bookcatalog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	bookcatalog.UpdateDefaultUpdatedAt = bookcatalogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// bookcatalogDescID is the schema descriptor for id field.
+	bookcatalogDescID := bookcatalogFields[0].Descriptor()
+	// bookcatalog.DefaultID holds the default value on creation for the id field.
+	bookcatalog.DefaultID = bookcatalogDescID.Default.(func() uuid.UUID)
+	booknoteFields := schema.BookNote{}.Fields()
+	_ = booknoteFields
+	// booknoteDescPage is the schema descriptor for page field.
+	booknoteDescPage := booknoteFields[3].Descriptor()
+	// booknote.PageValidator is a validator for the "page" field. It is called by the builders before save.
+	booknote.PageValidator = booknoteDescPage.Validators[0].(func(int) error)
+	// booknoteDescCreatedAt is the schema descriptor for created_at field.
+	booknoteDescCreatedAt := booknoteFields[7].Descriptor()
+	// booknote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	booknote.DefaultCreatedAt = booknoteDescCreatedAt.Default.(func() time.Time)
+	// booknoteDescUpdatedAt is the schema descriptor for updated_at field.
+	booknoteDescUpdatedAt := booknoteFields[8].Descriptor()
+	// booknote.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	booknote.DefaultUpdatedAt = booknoteDescUpdatedAt.Default.(func() time.Time)
+	// booknote.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	booknote.UpdateDefaultUpdatedAt = booknoteDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// booknoteDescID is the schema descriptor for id field.
+	booknoteDescID := booknoteFields[0].Descriptor()
+	// booknote.DefaultID holds the default value on creation for the id field.
+	booknote.DefaultID = booknoteDescID.Default.(func() uuid.UUID)
+	bookstatushistoryFields := schema.BookStatusHistory{}.Fields()
+	_ = bookstatushistoryFields
+	// bookstatushistoryDescChangedAt is the schema descriptor for changed_at field.
+	bookstatushistoryDescChangedAt := bookstatushistoryFields[3].Descriptor()
+	// bookstatushistory.DefaultChangedAt holds the default value on creation for the changed_at field.
+	bookstatushistory.DefaultChangedAt = bookstatushistoryDescChangedAt.Default.(func() time.Time)
+	// bookstatushistoryDescID is the schema descriptor for id field.
+	bookstatushistoryDescID := bookstatushistoryFields[0].Descriptor()
+	// bookstatushistory.DefaultID holds the default value on creation for the id field.
+	bookstatushistory.DefaultID = bookstatushistoryDescID.Default.(func() uuid.UUID)
 	bookmarkFields := schema.Bookmark{}.Fields()
 	_ = bookmarkFields
 	// bookmarkDescCreatedAt is the schema descriptor for created_at field.
@@ -82,6 +193,36 @@ func init() {
 	bookmarkDescID := bookmarkFields[0].Descriptor()
 	// bookmark.DefaultID holds the default value on creation for the id field.
 	bookmark.DefaultID = bookmarkDescID.Default.(func() uuid.UUID)
+	borrowrequestFields := schema.BorrowRequest{}.Fields()
+	_ = borrowrequestFields
+	// borrowrequestDescCreatedAt is the schema descriptor for created_at field.
+	borrowrequestDescCreatedAt := borrowrequestFields[8].Descriptor()
+	// borrowrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	borrowrequest.DefaultCreatedAt = borrowrequestDescCreatedAt.Default.(func() time.Time)
+	// borrowrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	borrowrequestDescUpdatedAt := borrowrequestFields[9].Descriptor()
+	// borrowrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	borrowrequest.DefaultUpdatedAt = borrowrequestDescUpdatedAt.Default.(func() time.Time)
+	// borrowrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	borrowrequest.UpdateDefaultUpdatedAt = borrowrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// borrowrequestDescID is the schema descriptor for id field.
+	borrowrequestDescID := borrowrequestFields[0].Descriptor()
+	// borrowrequest.DefaultID holds the default value on creation for the id field.
+	borrowrequest.DefaultID = borrowrequestDescID.Default.(func() uuid.UUID)
+	datamigrationFields := schema.DataMigration{}.Fields()
+	_ = datamigrationFields
+	// datamigrationDescName is the schema descriptor for name field.
+	datamigrationDescName := datamigrationFields[1].Descriptor()
+	// datamigration.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	datamigration.NameValidator = datamigrationDescName.Validators[0].(func(string) error)
+	// datamigrationDescAppliedAt is the schema descriptor for applied_at field.
+	datamigrationDescAppliedAt := datamigrationFields[2].Descriptor()
+	// datamigration.DefaultAppliedAt holds the default value on creation for the applied_at field.
+	datamigration.DefaultAppliedAt = datamigrationDescAppliedAt.Default.(func() time.Time)
+	// datamigrationDescID is the schema descriptor for id field.
+	datamigrationDescID := datamigrationFields[0].Descriptor()
+	// datamigration.DefaultID holds the default value on creation for the id field.
+	datamigration.DefaultID = datamigrationDescID.Default.(func() uuid.UUID)
 	emailverificationFields := schema.EmailVerification{}.Fields()
 	_ = emailverificationFields
 	// emailverificationDescEmail is the schema descriptor for email field.
@@ -115,6 +256,102 @@ func init() {
 	emailverificationDescCreatedAt := emailverificationFields[5].Descriptor()
 	// emailverification.DefaultCreatedAt holds the default value on creation for the created_at field.
 	emailverification.DefaultCreatedAt = emailverificationDescCreatedAt.Default.(func() time.Time)
+	libraryFields := schema.Library{}.Fields()
+	_ = libraryFields
+	// libraryDescName is the schema descriptor for name field.
+	libraryDescName := libraryFields[1].Descriptor()
+	// library.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	library.NameValidator = libraryDescName.Validators[0].(func(string) error)
+	// libraryDescCreatedAt is the schema descriptor for created_at field.
+	libraryDescCreatedAt := libraryFields[2].Descriptor()
+	// library.DefaultCreatedAt holds the default value on creation for the created_at field.
+	library.DefaultCreatedAt = libraryDescCreatedAt.Default.(func() time.Time)
+	// libraryDescUpdatedAt is the schema descriptor for updated_at field.
+	libraryDescUpdatedAt := libraryFields[3].Descriptor()
+	// library.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	library.DefaultUpdatedAt = libraryDescUpdatedAt.Default.(func() time.Time)
+	// library.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	library.UpdateDefaultUpdatedAt = libraryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// libraryDescID is the schema descriptor for id field.
+	libraryDescID := libraryFields[0].Descriptor()
+	// library.DefaultID holds the default value on creation for the id field.
+	library.DefaultID = libraryDescID.Default.(func() uuid.UUID)
+	libraryinvitationFields := schema.LibraryInvitation{}.Fields()
+	_ = libraryinvitationFields
+	// libraryinvitationDescCreatedAt is the schema descriptor for created_at field.
+	libraryinvitationDescCreatedAt := libraryinvitationFields[4].Descriptor()
+	// libraryinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	libraryinvitation.DefaultCreatedAt = libraryinvitationDescCreatedAt.Default.(func() time.Time)
+	// libraryinvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	libraryinvitationDescUpdatedAt := libraryinvitationFields[5].Descriptor()
+	// libraryinvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	libraryinvitation.DefaultUpdatedAt = libraryinvitationDescUpdatedAt.Default.(func() time.Time)
+	// libraryinvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	libraryinvitation.UpdateDefaultUpdatedAt = libraryinvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// libraryinvitationDescID is the schema descriptor for id field.
+	libraryinvitationDescID := libraryinvitationFields[0].Descriptor()
+	// libraryinvitation.DefaultID holds the default value on creation for the id field.
+	libraryinvitation.DefaultID = libraryinvitationDescID.Default.(func() uuid.UUID)
+	librarymemberFields := schema.LibraryMember{}.Fields()
+	_ = librarymemberFields
+	// librarymemberDescCreatedAt is the schema descriptor for created_at field.
+	librarymemberDescCreatedAt := librarymemberFields[2].Descriptor()
+	// librarymember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	librarymember.DefaultCreatedAt = librarymemberDescCreatedAt.Default.(func() time.Time)
+	// librarymemberDescUpdatedAt is the schema descriptor for updated_at field.
+	librarymemberDescUpdatedAt := librarymemberFields[3].Descriptor()
+	// librarymember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	librarymember.DefaultUpdatedAt = librarymemberDescUpdatedAt.Default.(func() time.Time)
+	// librarymember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	librarymember.UpdateDefaultUpdatedAt = librarymemberDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// librarymemberDescID is the schema descriptor for id field.
+	librarymemberDescID := librarymemberFields[0].Descriptor()
+	// librarymember.DefaultID holds the default value on creation for the id field.
+	librarymember.DefaultID = librarymemberDescID.Default.(func() uuid.UUID)
+	loanFields := schema.Loan{}.Fields()
+	_ = loanFields
+	// loanDescBorrowerName is the schema descriptor for borrower_name field.
+	loanDescBorrowerName := loanFields[1].Descriptor()
+	// loan.BorrowerNameValidator is a validator for the "borrower_name" field. It is called by the builders before save.
+	loan.BorrowerNameValidator = loanDescBorrowerName.Validators[0].(func(string) error)
+	// loanDescCreatedAt is the schema descriptor for created_at field.
+	loanDescCreatedAt := loanFields[8].Descriptor()
+	// loan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loan.DefaultCreatedAt = loanDescCreatedAt.Default.(func() time.Time)
+	// loanDescUpdatedAt is the schema descriptor for updated_at field.
+	loanDescUpdatedAt := loanFields[9].Descriptor()
+	// loan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	loan.DefaultUpdatedAt = loanDescUpdatedAt.Default.(func() time.Time)
+	// loan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	loan.UpdateDefaultUpdatedAt = loanDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loanDescID is the schema descriptor for id field.
+	loanDescID := loanFields[0].Descriptor()
+	// loan.DefaultID holds the default value on creation for the id field.
+	loan.DefaultID = loanDescID.Default.(func() uuid.UUID)
+	readinggoalFields := schema.ReadingGoal{}.Fields()
+	_ = readinggoalFields
+	// readinggoalDescTarget is the schema descriptor for target field.
+	readinggoalDescTarget := readinggoalFields[3].Descriptor()
+	// readinggoal.TargetValidator is a validator for the "target" field. It is called by the builders before save.
+	readinggoal.TargetValidator = readinggoalDescTarget.Validators[0].(func(int) error)
+	// readinggoalDescPaceReminder is the schema descriptor for pace_reminder field.
+	readinggoalDescPaceReminder := readinggoalFields[4].Descriptor()
+	// readinggoal.DefaultPaceReminder holds the default value on creation for the pace_reminder field.
+	readinggoal.DefaultPaceReminder = readinggoalDescPaceReminder.Default.(bool)
+	// readinggoalDescCreatedAt is the schema descriptor for created_at field.
+	readinggoalDescCreatedAt := readinggoalFields[6].Descriptor()
+	// readinggoal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	readinggoal.DefaultCreatedAt = readinggoalDescCreatedAt.Default.(func() time.Time)
+	// readinggoalDescUpdatedAt is the schema descriptor for updated_at field.
+	readinggoalDescUpdatedAt := readinggoalFields[7].Descriptor()
+	// readinggoal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	readinggoal.DefaultUpdatedAt = readinggoalDescUpdatedAt.Default.(func() time.Time)
+	// readinggoal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	readinggoal.UpdateDefaultUpdatedAt = readinggoalDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// readinggoalDescID is the schema descriptor for id field.
+	readinggoalDescID := readinggoalFields[0].Descriptor()
+	// readinggoal.DefaultID holds the default value on creation for the id field.
+	readinggoal.DefaultID = readinggoalDescID.Default.(func() uuid.UUID)
 	readingreminderFields := schema.ReadingReminder{}.Fields()
 	_ = readingreminderFields
 	// readingreminderDescReminderTime is the schema descriptor for reminder_time field.
@@ -129,16 +366,48 @@ func init() {
 	readingreminderDescMessage := readingreminderFields[4].Descriptor()
 	// readingreminder.DefaultMessage holds the default value on creation for the message field.
 	readingreminder.DefaultMessage = readingreminderDescMessage.Default.(string)
+	// readingreminderDescVersion is the schema descriptor for version field.
+	readingreminderDescVersion := readingreminderFields[5].Descriptor()
+	// readingreminder.DefaultVersion holds the default value on creation for the version field.
+	readingreminder.DefaultVersion = readingreminderDescVersion.Default.(int)
+	// readingreminder.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	readingreminder.VersionValidator = readingreminderDescVersion.Validators[0].(func(int) error)
 	// readingreminderDescCreatedAt is the schema descriptor for created_at field.
-	readingreminderDescCreatedAt := readingreminderFields[5].Descriptor()
+	readingreminderDescCreatedAt := readingreminderFields[6].Descriptor()
 	// readingreminder.DefaultCreatedAt holds the default value on creation for the created_at field.
 	readingreminder.DefaultCreatedAt = readingreminderDescCreatedAt.Default.(func() time.Time)
 	// readingreminderDescUpdatedAt is the schema descriptor for updated_at field.
-	readingreminderDescUpdatedAt := readingreminderFields[6].Descriptor()
+	readingreminderDescUpdatedAt := readingreminderFields[7].Descriptor()
 	// readingreminder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	readingreminder.DefaultUpdatedAt = readingreminderDescUpdatedAt.Default.(func() time.Time)
 	// readingreminder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	readingreminder.UpdateDefaultUpdatedAt = readingreminderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	readingsessionFields := schema.ReadingSession{}.Fields()
+	_ = readingsessionFields
+	// readingsessionDescStartPage is the schema descriptor for start_page field.
+	readingsessionDescStartPage := readingsessionFields[1].Descriptor()
+	// readingsession.DefaultStartPage holds the default value on creation for the start_page field.
+	readingsession.DefaultStartPage = readingsessionDescStartPage.Default.(int)
+	// readingsession.StartPageValidator is a validator for the "start_page" field. It is called by the builders before save.
+	readingsession.StartPageValidator = readingsessionDescStartPage.Validators[0].(func(int) error)
+	// readingsessionDescEndPage is the schema descriptor for end_page field.
+	readingsessionDescEndPage := readingsessionFields[2].Descriptor()
+	// readingsession.EndPageValidator is a validator for the "end_page" field. It is called by the builders before save.
+	readingsession.EndPageValidator = readingsessionDescEndPage.Validators[0].(func(int) error)
+	// readingsessionDescPagesRead is the schema descriptor for pages_read field.
+	readingsessionDescPagesRead := readingsessionFields[3].Descriptor()
+	// readingsession.DefaultPagesRead holds the default value on creation for the pages_read field.
+	readingsession.DefaultPagesRead = readingsessionDescPagesRead.Default.(int)
+	// readingsession.PagesReadValidator is a validator for the "pages_read" field. It is called by the builders before save.
+	readingsession.PagesReadValidator = readingsessionDescPagesRead.Validators[0].(func(int) error)
+	// readingsessionDescStartedAt is the schema descriptor for started_at field.
+	readingsessionDescStartedAt := readingsessionFields[4].Descriptor()
+	// readingsession.DefaultStartedAt holds the default value on creation for the started_at field.
+	readingsession.DefaultStartedAt = readingsessionDescStartedAt.Default.(func() time.Time)
+	// readingsessionDescID is the schema descriptor for id field.
+	readingsessionDescID := readingsessionFields[0].Descriptor()
+	// readingsession.DefaultID holds the default value on creation for the id field.
+	readingsession.DefaultID = readingsessionDescID.Default.(func() uuid.UUID)
 	reviewFields := schema.Review{}.Fields()
 	_ = reviewFields
 	// reviewDescBookIsbn is the schema descriptor for book_isbn field.
@@ -171,12 +440,18 @@ func init() {
 	reviewDescIsPublic := reviewFields[4].Descriptor()
 	// review.DefaultIsPublic holds the default value on creation for the is_public field.
 	review.DefaultIsPublic = reviewDescIsPublic.Default.(bool)
+	// reviewDescVersion is the schema descriptor for version field.
+	reviewDescVersion := reviewFields[5].Descriptor()
+	// review.DefaultVersion holds the default value on creation for the version field.
+	review.DefaultVersion = reviewDescVersion.Default.(int)
+	// review.VersionValidator is a validator for the "version" field. It is called by the builders before save.
+	review.VersionValidator = reviewDescVersion.Validators[0].(func(int) error)
 	// reviewDescCreatedAt is the schema descriptor for created_at field.
-	reviewDescCreatedAt := reviewFields[5].Descriptor()
+	reviewDescCreatedAt := reviewFields[6].Descriptor()
 	// review.DefaultCreatedAt holds the default value on creation for the created_at field.
 	review.DefaultCreatedAt = reviewDescCreatedAt.Default.(func() time.Time)
 	// reviewDescUpdatedAt is the schema descriptor for updated_at field.
-	reviewDescUpdatedAt := reviewFields[6].Descriptor()
+	reviewDescUpdatedAt := reviewFields[7].Descriptor()
 	// review.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	review.DefaultUpdatedAt = reviewDescUpdatedAt.Default.(func() time.Time)
 	// review.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -185,6 +460,54 @@ func init() {
 	reviewDescID := reviewFields[0].Descriptor()
 	// review.DefaultID holds the default value on creation for the id field.
 	review.DefaultID = reviewDescID.Default.(func() uuid.UUID)
+	shelfFields := schema.Shelf{}.Fields()
+	_ = shelfFields
+	// shelfDescName is the schema descriptor for name field.
+	shelfDescName := shelfFields[1].Descriptor()
+	// shelf.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	shelf.NameValidator = shelfDescName.Validators[0].(func(string) error)
+	// shelfDescHideBooks is the schema descriptor for hide_books field.
+	shelfDescHideBooks := shelfFields[4].Descriptor()
+	// shelf.DefaultHideBooks holds the default value on creation for the hide_books field.
+	shelf.DefaultHideBooks = shelfDescHideBooks.Default.(bool)
+	// shelfDescCreatedAt is the schema descriptor for created_at field.
+	shelfDescCreatedAt := shelfFields[5].Descriptor()
+	// shelf.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shelf.DefaultCreatedAt = shelfDescCreatedAt.Default.(func() time.Time)
+	// shelfDescUpdatedAt is the schema descriptor for updated_at field.
+	shelfDescUpdatedAt := shelfFields[6].Descriptor()
+	// shelf.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	shelf.DefaultUpdatedAt = shelfDescUpdatedAt.Default.(func() time.Time)
+	// shelf.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	shelf.UpdateDefaultUpdatedAt = shelfDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// shelfDescID is the schema descriptor for id field.
+	shelfDescID := shelfFields[0].Descriptor()
+	// shelf.DefaultID holds the default value on creation for the id field.
+	shelf.DefaultID = shelfDescID.Default.(func() uuid.UUID)
+	shelfbookFields := schema.ShelfBook{}.Fields()
+	_ = shelfbookFields
+	// shelfbookDescPosition is the schema descriptor for position field.
+	shelfbookDescPosition := shelfbookFields[2].Descriptor()
+	// shelfbook.DefaultPosition holds the default value on creation for the position field.
+	shelfbook.DefaultPosition = shelfbookDescPosition.Default.(int)
+	// shelfbookDescAddedAt is the schema descriptor for added_at field.
+	shelfbookDescAddedAt := shelfbookFields[3].Descriptor()
+	// shelfbook.DefaultAddedAt holds the default value on creation for the added_at field.
+	shelfbook.DefaultAddedAt = shelfbookDescAddedAt.Default.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[2].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
+	// tagDescID is the schema descriptor for id field.
+	tagDescID := tagFields[0].Descriptor()
+	// tag.DefaultID holds the default value on creation for the id field.
+	tag.DefaultID = tagDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescNickName is the schema descriptor for nick_name field.
@@ -212,11 +535,59 @@ func init() {
 	// user.DefaultTimezone holds the default value on creation for the timezone field.
 	user.DefaultTimezone = userDescTimezone.Default.(string)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[9].Descriptor()
+	userDescCreatedAt := userFields[10].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[10].Descriptor()
+	userDescUpdatedAt := userFields[11].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	wishlistitemFields := schema.WishlistItem{}.Fields()
+	_ = wishlistitemFields
+	// wishlistitemDescTitle is the schema descriptor for title field.
+	wishlistitemDescTitle := wishlistitemFields[1].Descriptor()
+	// wishlistitem.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	wishlistitem.TitleValidator = wishlistitemDescTitle.Validators[0].(func(string) error)
+	// wishlistitemDescAuthor is the schema descriptor for author field.
+	wishlistitemDescAuthor := wishlistitemFields[2].Descriptor()
+	// wishlistitem.DefaultAuthor holds the default value on creation for the author field.
+	wishlistitem.DefaultAuthor = wishlistitemDescAuthor.Default.(string)
+	// wishlistitemDescPublisher is the schema descriptor for publisher field.
+	wishlistitemDescPublisher := wishlistitemFields[4].Descriptor()
+	// wishlistitem.DefaultPublisher holds the default value on creation for the publisher field.
+	wishlistitem.DefaultPublisher = wishlistitemDescPublisher.Default.(string)
+	// wishlistitemDescThumbnailURL is the schema descriptor for thumbnail_url field.
+	wishlistitemDescThumbnailURL := wishlistitemFields[5].Descriptor()
+	// wishlistitem.DefaultThumbnailURL holds the default value on creation for the thumbnail_url field.
+	wishlistitem.DefaultThumbnailURL = wishlistitemDescThumbnailURL.Default.(string)
+	// wishlistitemDescTargetPrice is the schema descriptor for target_price field.
+	wishlistitemDescTargetPrice := wishlistitemFields[8].Descriptor()
+	// wishlistitem.TargetPriceValidator is a validator for the "target_price" field. It is called by the builders before save.
+	wishlistitem.TargetPriceValidator = wishlistitemDescTargetPrice.Validators[0].(func(float64) error)
+	// wishlistitemDescCurrency is the schema descriptor for currency field.
+	wishlistitemDescCurrency := wishlistitemFields[9].Descriptor()
+	// wishlistitem.DefaultCurrency holds the default value on creation for the currency field.
+	wishlistitem.DefaultCurrency = wishlistitemDescCurrency.Default.(string)
+	// wishlistitemDescClaimedBy is the schema descriptor for claimed_by field.
+	wishlistitemDescClaimedBy := wishlistitemFields[10].Descriptor()
+	// wishlistitem.DefaultClaimedBy holds the default value on creation for the claimed_by field.
+	wishlistitem.DefaultClaimedBy = wishlistitemDescClaimedBy.Default.(string)
+	// wishlistitemDescClaimCode is the schema descriptor for claim_code field.
+	wishlistitemDescClaimCode := wishlistitemFields[11].Descriptor()
+	// wishlistitem.DefaultClaimCode holds the default value on creation for the claim_code field.
+	wishlistitem.DefaultClaimCode = wishlistitemDescClaimCode.Default.(string)
+	// wishlistitemDescCreatedAt is the schema descriptor for created_at field.
+	wishlistitemDescCreatedAt := wishlistitemFields[13].Descriptor()
+	// wishlistitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wishlistitem.DefaultCreatedAt = wishlistitemDescCreatedAt.Default.(func() time.Time)
+	// wishlistitemDescUpdatedAt is the schema descriptor for updated_at field.
+	wishlistitemDescUpdatedAt := wishlistitemFields[14].Descriptor()
+	// wishlistitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wishlistitem.DefaultUpdatedAt = wishlistitemDescUpdatedAt.Default.(func() time.Time)
+	// wishlistitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wishlistitem.UpdateDefaultUpdatedAt = wishlistitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// wishlistitemDescID is the schema descriptor for id field.
+	wishlistitemDescID := wishlistitemFields[0].Descriptor()
+	// wishlistitem.DefaultID holds the default value on creation for the id field.
+	wishlistitem.DefaultID = wishlistitemDescID.Default.(func() uuid.UUID)
 }
@@ -4,9 +4,11 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -19,21 +21,106 @@ type Book struct {
 func (Book) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.New()),
-		field.String("book_title").
-			NotEmpty(),
-		field.String("author").
-			NotEmpty(),
-		field.String("book_isbn").
-			Optional(),
-		field.String("thumbnail_url").
-			Optional(),
-		field.Int("status").
-			Default(0),
+		field.Enum("reading_status").
+			Values("unread", "reading", "finished", "paused", "abandoned").
+			Default("unread").
+			Comment("읽기 상태"),
+		field.Time("started_at").
+			Optional().
+			Nillable().
+			Comment("읽기 시작한 시간"),
+		field.Time("finished_at").
+			Optional().
+			Nillable().
+			Comment("다 읽은 시간"),
+		field.Int("current_page").
+			Default(0).
+			NonNegative().
+			Comment("현재 읽고 있는 페이지"),
+		field.Int("total_pages").
+			Default(0).
+			NonNegative().
+			Comment("전체 페이지 수 (0이면 알 수 없음)"),
+		field.String("title_override").
+			Optional().
+			Nillable().
+			Comment("사용자가 고친 제목 (null이면 카탈로그 값을 사용)"),
+		field.String("author_override").
+			Optional().
+			Nillable().
+			Comment("사용자가 고친 저자 (null이면 카탈로그 값을 사용)"),
+		field.String("publisher_override").
+			Optional().
+			Nillable().
+			Comment("사용자가 고친 출판사 (null이면 카탈로그 값을 사용)"),
+		field.String("published_date_override").
+			Optional().
+			Nillable().
+			Comment("사용자가 고친 출간일 (null이면 카탈로그 값을 사용)"),
+		field.String("thumbnail_url_override").
+			Optional().
+			Nillable().
+			Comment("사용자가 고친 표지 이미지 URL (null이면 카탈로그 값을 사용)"),
+		field.String("location_room").
+			Default("").
+			Comment("보관 위치: 방"),
+		field.String("location_bookcase").
+			Default("").
+			Comment("보관 위치: 책장"),
+		field.String("location_shelf").
+			Default("").
+			Comment("보관 위치: 책장의 칸"),
+		field.Enum("condition").
+			Values("new", "like_new", "good", "fair", "poor").
+			Optional().
+			Nillable().
+			Comment("실물 책의 상태"),
+		field.Enum("format").
+			Values("hardcover", "paperback", "ebook", "audiobook").
+			Optional().
+			Nillable().
+			Comment("책의 형태"),
+		field.Time("purchased_at").
+			Optional().
+			Nillable().
+			Comment("구입일"),
+		field.Float("price").
+			SchemaType(map[string]string{dialect.MySQL: "decimal(12,2)"}).
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("구입 가격"),
+		field.String("currency").
+			Default("").
+			Comment("구입 가격의 통화 (ISO 4217)"),
+		field.String("acquired_from").
+			Default("").
+			Comment("구입처 또는 입수 경로"),
+		field.Bool("live").
+			Optional().
+			Nillable().
+			Comment("서재에 있는 책이면 true, 휴지통에 있으면 null (같은 책의 중복 등록을 막는 유니크 인덱스용)"),
+		field.Int("version").
+			Default(1).
+			Positive().
+			Comment("낙관적 동시성 제어용 버전 (수정할 때마다 1씩 증가)"),
 		field.Time("created_at").
 			Default(time.Now()),
 		field.Time("updated_at").
 			Default(time.Now()).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("휴지통으로 옮긴 시간 (null이면 삭제되지 않음)"),
+		field.UUID("library_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("책을 함께 쓰는 공유 서재 (null이면 소유자 개인 서재)"),
+		field.Enum("visibility").
+			Values("inherit", "public", "private").
+			Default("inherit").
+			Comment("공개 프로필에서의 공개 범위 (inherit: 책장 설정을 따름)"),
 	}
 }
 
@@ -45,9 +132,45 @@ func (Book) Edges() []ent.Edge {
 			Unique().
 			Required().
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 제목, 저자, ISBN 등 서지 정보는 공유 카탈로그에 한 번만 저장합니다.
+		edge.From("catalog", BookCatalog.Type).
+			Ref("copies").
+			Unique(),
+		edge.From("library", Library.Type).
+			Ref("books").
+			Field("library_id").
+			Unique(),
 		edge.To("reviews", Review.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("bookmarks", Bookmark.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reading_sessions", ReadingSession.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("status_histories", BookStatusHistory.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("shelves", Shelf.Type).
+			Ref("books").
+			Through("shelf_books", ShelfBook.Type),
+		edge.From("tags", Tag.Type).
+			Ref("books"),
+		edge.To("notes", BookNote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("loans", Loan.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("borrow_requests", BorrowRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Book.
+func (Book) Indexes() []ent.Index {
+	return []ent.Index{
+		// 보관 기간이 지난 휴지통 항목 정리
+		index.Fields("deleted_at"),
+		// 사용자당 같은 카탈로그 항목(ISBN)의 책은 서재에 하나만 둘 수 있습니다.
+		// 휴지통의 책은 live가 null이므로 유니크 검사에서 제외됩니다.
+		index.Fields("live").
+			Edges("owner", "catalog").
+			Unique(),
 	}
 }
@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BookCatalog holds the schema definition for the BookCatalog entity.
+type BookCatalog struct {
+	ent.Schema
+}
+
+// Fields of the BookCatalog.
+func (BookCatalog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.String("isbn").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("정규화된 ISBN-13 (ISBN 없이 직접 입력한 책은 null)"),
+		field.String("title").
+			NotEmpty().
+			Comment("도서 제목"),
+		field.String("author").
+			NotEmpty().
+			Comment("저자"),
+		field.String("publisher").
+			Optional().
+			Comment("출판사"),
+		field.String("published_date").
+			Optional().
+			Comment("출간일 (제공자 형식 그대로 저장)"),
+		field.String("thumbnail_url").
+			Optional().
+			Comment("표지 이미지 URL"),
+		field.String("series_name").
+			Optional().
+			Comment("시리즈 이름 (시리즈가 아니면 빈 문자열)"),
+		field.Int("series_volume").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("시리즈 내 권 번호"),
+		field.Int("series_total_volumes").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("시리즈 전체 권 수 (알 수 없으면 null)"),
+		field.String("source").
+			Default("manual").
+			Comment("도서 정보 출처 (manual 또는 도서 정보 제공자 이름)"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BookCatalog.
+func (BookCatalog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("copies", Book.Type),
+		edge.To("reviews", Review.Type),
+	}
+}
+
+// Indexes of the BookCatalog.
+func (BookCatalog) Indexes() []ent.Index {
+	return []ent.Index{
+		// 시리즈별 묶음 조회
+		index.Fields("series_name"),
+	}
+}
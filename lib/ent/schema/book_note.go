@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BookNote holds the schema definition for the BookNote entity.
+// 사용자가 책에 남기는 메모, 하이라이트, 인용구입니다.
+type BookNote struct {
+	ent.Schema
+}
+
+// Fields of the BookNote.
+func (BookNote) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.Text("quote").
+			Optional().
+			Comment("인용하거나 하이라이트한 문장"),
+		field.Text("comment").
+			Optional().
+			Comment("개인 메모"),
+		field.Int("page").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("페이지 번호"),
+		field.String("location").
+			Optional().
+			Comment("전자책 위치, 챕터 등 페이지 외의 위치"),
+		field.String("color").
+			Optional().
+			Comment("하이라이트 색상"),
+		field.String("label").
+			Optional().
+			Comment("사용자 지정 라벨"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BookNote.
+func (BookNote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("book_notes").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("book", Book.Type).
+			Ref("notes").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BookStatusHistory holds the schema definition for the BookStatusHistory entity.
+type BookStatusHistory struct {
+	ent.Schema
+}
+
+// Fields of the BookStatusHistory.
+func (BookStatusHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.Enum("from_status").
+			Values("unread", "reading", "finished", "paused", "abandoned").
+			Optional().
+			Nillable().
+			Comment("변경 전 읽기 상태 (책 등록 시 null)"),
+		field.Enum("to_status").
+			Values("unread", "reading", "finished", "paused", "abandoned").
+			Comment("변경 후 읽기 상태"),
+		field.Time("changed_at").
+			Default(time.Now).
+			Immutable().
+			Comment("상태 변경 시간"),
+	}
+}
+
+// Edges of the BookStatusHistory.
+func (BookStatusHistory) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("book", Book.Type).
+			Ref("status_histories").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
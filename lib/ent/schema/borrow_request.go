@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BorrowRequest holds the schema definition for the BorrowRequest entity.
+// 서비스 사용자끼리 책을 빌려달라고 요청하고 주고받는 과정을 기록합니다.
+type BorrowRequest struct {
+	ent.Schema
+}
+
+// Fields of the BorrowRequest.
+func (BorrowRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.Enum("status").
+			Values("pending", "accepted", "declined", "canceled", "handed_over", "returned").
+			Default("pending").
+			Comment("요청 상태"),
+		field.Text("message").
+			Optional().
+			Comment("요청한 사람이 남긴 메시지"),
+		field.Text("response_message").
+			Optional().
+			Comment("책 주인이 수락/거절하며 남긴 메시지"),
+		field.Time("due_at").
+			Optional().
+			Nillable().
+			Comment("반납 예정일"),
+		field.Time("responded_at").
+			Optional().
+			Nillable().
+			Comment("수락/거절한 시간"),
+		field.Time("handed_over_at").
+			Optional().
+			Nillable().
+			Comment("책을 건네준 시간"),
+		field.Time("returned_at").
+			Optional().
+			Nillable().
+			Comment("책을 돌려받은 시간"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BorrowRequest.
+func (BorrowRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("requester", User.Type).
+			Ref("sent_borrow_requests").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("owner", User.Type).
+			Ref("received_borrow_requests").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("book", Book.Type).
+			Ref("borrow_requests").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 책을 건네주면 책 주인의 대여 기록이 만들어집니다.
+		edge.To("loan", Loan.Type).
+			Unique(),
+	}
+}
+
+// Indexes of the BorrowRequest.
+func (BorrowRequest) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+	}
+}
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// DataMigration holds the schema definition for the DataMigration entity.
+type DataMigration struct {
+	ent.Schema
+}
+
+// Fields of the DataMigration.
+func (DataMigration) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.String("name").
+			NotEmpty().
+			Unique().
+			Comment("적용된 데이터 마이그레이션 이름"),
+		field.Time("applied_at").
+			Default(time.Now).
+			Immutable().
+			Comment("적용 시간"),
+	}
+}
+
+// Edges of the DataMigration.
+func (DataMigration) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Library holds the schema definition for the Library entity.
+// 한 집에 사는 가족처럼 실물 서재를 함께 쓰는 사용자들이 책을 공유하는 공간입니다.
+type Library struct {
+	ent.Schema
+}
+
+// Fields of the Library.
+func (Library) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.String("name").
+			NotEmpty().
+			Comment("공유 서재 이름"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Library.
+func (Library) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("members", LibraryMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("invitations", LibraryInvitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		// 서재를 삭제해도 책은 각 소유자의 개인 서재에 남습니다.
+		edge.To("books", Book.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LibraryInvitation holds the schema definition for the LibraryInvitation entity.
+// 공유 서재 관리자가 이메일이나 닉네임으로 다른 사용자를 초대한 기록입니다.
+type LibraryInvitation struct {
+	ent.Schema
+}
+
+// Fields of the LibraryInvitation.
+func (LibraryInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.Enum("role").
+			Values("editor", "viewer").
+			Default("viewer").
+			Comment("초대를 수락하면 받을 역할"),
+		field.Enum("status").
+			Values("pending", "accepted", "declined", "canceled").
+			Default("pending").
+			Comment("초대 상태"),
+		field.Time("responded_at").
+			Optional().
+			Nillable().
+			Comment("수락/거절/취소한 시간"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the LibraryInvitation.
+func (LibraryInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("library", Library.Type).
+			Ref("invitations").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("inviter", User.Type).
+			Ref("sent_library_invitations").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("invitee", User.Type).
+			Ref("received_library_invitations").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the LibraryInvitation.
+func (LibraryInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		// 받은 초대 중 대기 중인 초대 조회
+		index.Fields("status").
+			Edges("invitee"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// LibraryMember holds the schema definition for the LibraryMember entity.
+// 공유 서재의 구성원과 역할입니다.
+type LibraryMember struct {
+	ent.Schema
+}
+
+// Fields of the LibraryMember.
+func (LibraryMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.Enum("role").
+			Values("owner", "editor", "viewer").
+			Default("viewer").
+			Comment("구성원 역할 (owner: 관리, editor: 책 수정, viewer: 보기만)"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now).
+			Comment("서재에 참여한 시간"),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the LibraryMember.
+func (LibraryMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("library", Library.Type).
+			Ref("members").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("user", User.Type).
+			Ref("library_memberships").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the LibraryMember.
+func (LibraryMember) Indexes() []ent.Index {
+	// 한 사용자는 같은 서재에 한 번만 참여할 수 있습니다.
+	return []ent.Index{
+		index.Edges("library", "user").
+			Unique(),
+	}
+}
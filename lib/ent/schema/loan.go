@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Loan holds the schema definition for the Loan entity.
+// 사용자가 다른 사람에게 빌려준 책의 기록입니다. 빌린 사람은 서비스 사용자가 아니어도 됩니다.
+type Loan struct {
+	ent.Schema
+}
+
+// Fields of the Loan.
+func (Loan) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.String("borrower_name").
+			NotEmpty().
+			Comment("빌린 사람 이름"),
+		field.String("borrower_contact").
+			Optional().
+			Comment("빌린 사람 연락처"),
+		field.Time("lent_at").
+			Comment("빌려준 날짜"),
+		field.Time("due_at").
+			Optional().
+			Nillable().
+			Comment("반납 예정일"),
+		field.Time("returned_at").
+			Optional().
+			Nillable().
+			Comment("반납한 날짜 (nil이면 대여 중)"),
+		field.Text("notes").
+			Optional().
+			Comment("메모"),
+		field.Time("reminder_sent_at").
+			Optional().
+			Nillable().
+			Comment("반납 예정 알림을 보낸 시간"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Loan.
+func (Loan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("loans").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("book", Book.Type).
+			Ref("loans").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Loan.
+func (Loan) Indexes() []ent.Index {
+	return []ent.Index{
+		// 반납 예정 알림 대상 조회
+		index.Fields("returned_at", "due_at"),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ReadingGoal holds the schema definition for the ReadingGoal entity.
+// 한 해 동안 읽을 책 권수 또는 쪽수 목표입니다. 진행 상황은 책의 상태 변경 기록으로 계산합니다.
+type ReadingGoal struct {
+	ent.Schema
+}
+
+// Fields of the ReadingGoal.
+func (ReadingGoal) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.Int("year").
+			Comment("목표 연도 (사용자 타임존 기준)"),
+		field.Enum("goal_type").
+			Values("books", "pages").
+			Comment("목표 단위 (books: 권수, pages: 쪽수)"),
+		field.Int("target").
+			Positive().
+			Comment("목표 권수 또는 쪽수"),
+		field.Bool("pace_reminder").
+			Default(true).
+			Comment("목표 속도보다 뒤처졌을 때 알림 여부"),
+		field.Time("reminder_sent_at").
+			Optional().
+			Nillable().
+			Comment("마지막으로 속도 알림을 보낸 시간"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ReadingGoal.
+func (ReadingGoal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("reading_goals").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the ReadingGoal.
+func (ReadingGoal) Indexes() []ent.Index {
+	return []ent.Index{
+		// 사용자는 연도별로 단위마다 목표를 하나만 가집니다.
+		index.Fields("year", "goal_type").
+			Edges("owner").
+			Unique(),
+	}
+}
@@ -32,6 +32,10 @@ func (ReadingReminder) Fields() []ent.Field {
 		field.String("message").
 			Default("책 읽을 시간이에요!").
 			Comment("알림 메시지"),
+		field.Int("version").
+			Default(1).
+			Positive().
+			Comment("낙관적 동시성 제어용 버전 (수정할 때마다 1씩 증가)"),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ReadingSession holds the schema definition for the ReadingSession entity.
+type ReadingSession struct {
+	ent.Schema
+}
+
+// Fields of the ReadingSession.
+func (ReadingSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.Int("start_page").
+			Default(0).
+			NonNegative().
+			Comment("세션 시작 페이지"),
+		field.Int("end_page").
+			Optional().
+			Nillable().
+			NonNegative().
+			Comment("세션 종료 페이지 (진행 중이면 null)"),
+		field.Int("pages_read").
+			Default(0).
+			NonNegative().
+			Comment("세션 동안 읽은 페이지 수"),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable().
+			Comment("세션 시작 시간"),
+		field.Time("ended_at").
+			Optional().
+			Nillable().
+			Comment("세션 종료 시간 (진행 중이면 null)"),
+	}
+}
+
+// Edges of the ReadingSession.
+func (ReadingSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("reading_sessions").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("book", Book.Type).
+			Ref("reading_sessions").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -34,12 +35,20 @@ func (Review) Fields() []ent.Field {
 		field.Bool("is_public").
 			Default(false).
 			Comment("Whether the review is public"),
+		field.Int("version").
+			Default(1).
+			Positive().
+			Comment("낙관적 동시성 제어용 버전 (수정할 때마다 1씩 증가)"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
 		field.Time("updated_at").
 			Default(time.Now).
 			UpdateDefault(time.Now),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("휴지통으로 옮긴 시간 (null이면 삭제되지 않음)"),
 	}
 }
 
@@ -55,5 +64,16 @@ func (Review) Edges() []ent.Edge {
 			Ref("reviews").
 			Unique().
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("catalog", BookCatalog.Type).
+			Ref("reviews").
+			Unique(),
+	}
+}
+
+// Indexes of the Review.
+func (Review) Indexes() []ent.Index {
+	return []ent.Index{
+		// 보관 기간이 지난 휴지통 항목 정리
+		index.Fields("deleted_at"),
 	}
 }
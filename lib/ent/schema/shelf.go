@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Shelf holds the schema definition for the Shelf entity.
+type Shelf struct {
+	ent.Schema
+}
+
+// Fields of the Shelf.
+func (Shelf) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.String("name").
+			NotEmpty().
+			Comment("책장 이름"),
+		field.Text("description").
+			Optional().
+			Comment("책장 설명"),
+		field.Enum("visibility").
+			Values("private", "public").
+			Default("private").
+			Comment("책장 공개 범위"),
+		field.Bool("hide_books").
+			Default(false).
+			Comment("이 책장에 담긴 책을 공개 프로필에서 숨김 (책의 공개 범위가 public이면 보임)"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Shelf.
+func (Shelf) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("shelves").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("books", Book.Type).
+			Through("shelf_books", ShelfBook.Type),
+	}
+}
+
+// Indexes of the Shelf.
+func (Shelf) Indexes() []ent.Index {
+	// 한 사용자 안에서 책장 이름은 중복될 수 없습니다.
+	return []ent.Index{
+		index.Fields("name").
+			Edges("owner").
+			Unique(),
+	}
+}
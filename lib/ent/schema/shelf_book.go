@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ShelfBook holds the schema definition for the ShelfBook entity.
+// 책장과 책의 다대다 관계이며, 책장 안에서의 순서를 함께 저장합니다.
+type ShelfBook struct {
+	ent.Schema
+}
+
+// Annotations of the ShelfBook.
+func (ShelfBook) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		field.ID("shelf_id", "book_id"),
+	}
+}
+
+// Fields of the ShelfBook.
+func (ShelfBook) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("shelf_id", uuid.UUID{}),
+		field.UUID("book_id", uuid.UUID{}),
+		field.Int("position").
+			Default(0).
+			Comment("책장 안에서의 순서 (작을수록 앞)"),
+		field.Time("added_at").
+			Immutable().
+			Default(time.Now).
+			Comment("책장에 추가한 시간"),
+	}
+}
+
+// Edges of the ShelfBook.
+func (ShelfBook) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("shelf", Shelf.Type).
+			Unique().
+			Required().
+			Field("shelf_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("book", Book.Type).
+			Unique().
+			Required().
+			Field("book_id").
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Tag holds the schema definition for the Tag entity.
+// 태그는 사용자별로 관리되며, 같은 이름의 태그를 여러 책에 붙일 수 있습니다.
+type Tag struct {
+	ent.Schema
+}
+
+// Fields of the Tag.
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.String("name").
+			NotEmpty().
+			Comment("태그 이름 (소문자로 정규화)"),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the Tag.
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("tags").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("books", Book.Type),
+	}
+}
+
+// Indexes of the Tag.
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Edges("owner").
+			Unique(),
+	}
+}
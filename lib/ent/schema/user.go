@@ -44,6 +44,11 @@ func (User) Fields() []ent.Field {
 		field.String("timezone").
 			Default("Asia/Seoul").
 			Comment("사용자 타임존"),
+		field.String("wishlist_share_token").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("위시리스트 공개 링크 토큰 (nil이면 공유하지 않음)"),
 		field.Time("created_at").
 			Immutable().
 			Default(time.Now).
@@ -65,5 +70,29 @@ func (User) Edges() []ent.Edge {
 			Annotations(entsql.OnDelete(entsql.Cascade)),
 		edge.To("reading_reminders", ReadingReminder.Type).
 			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reading_sessions", ReadingSession.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("shelves", Shelf.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("tags", Tag.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("book_notes", BookNote.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("loans", Loan.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sent_borrow_requests", BorrowRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("received_borrow_requests", BorrowRequest.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("wishlist_items", WishlistItem.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("library_memberships", LibraryMember.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("sent_library_invitations", LibraryInvitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("received_library_invitations", LibraryInvitation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("reading_goals", ReadingGoal.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
 	}
 }
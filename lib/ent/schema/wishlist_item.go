@@ -0,0 +1,85 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WishlistItem holds the schema definition for the WishlistItem entity.
+// 아직 갖고 있지 않지만 갖고 싶은 책입니다. 서재의 책(Book)과 따로 관리합니다.
+type WishlistItem struct {
+	ent.Schema
+}
+
+// Fields of the WishlistItem.
+func (WishlistItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.New()).
+			Default(uuid.New),
+		field.String("title").
+			NotEmpty(),
+		field.String("author").
+			Default(""),
+		field.String("isbn").
+			Optional().
+			Nillable().
+			Comment("ISBN-13 (하이픈 없이 정규화)"),
+		field.String("publisher").
+			Default(""),
+		field.String("thumbnail_url").
+			Default(""),
+		field.Enum("priority").
+			Values("low", "medium", "high").
+			Default("medium"),
+		field.Text("note").
+			Optional(),
+		field.Float("target_price").
+			SchemaType(map[string]string{dialect.MySQL: "decimal(12,2)"}).
+			Optional().
+			Nillable().
+			Min(0).
+			Comment("이 가격 이하라면 사고 싶은 가격"),
+		field.String("currency").
+			Default(""),
+		// 공유 목록에서 가족, 친구가 선물하겠다고 표시한 정보입니다. 주인에게는 보여주지 않습니다.
+		field.String("claimed_by").
+			Default(""),
+		field.String("claim_code").
+			Default("").
+			Comment("선물 표시를 취소할 때 확인하는 코드"),
+		field.Time("claimed_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WishlistItem.
+func (WishlistItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("owner", User.Type).
+			Ref("wishlist_items").
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the WishlistItem.
+func (WishlistItem) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("isbn"),
+	}
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// Shelf is the model entity for the Shelf schema.
+type Shelf struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// 책장 이름
+	Name string `json:"name,omitempty"`
+	// 책장 설명
+	Description string `json:"description,omitempty"`
+	// 책장 공개 범위
+	Visibility shelf.Visibility `json:"visibility,omitempty"`
+	// 이 책장에 담긴 책을 공개 프로필에서 숨김 (책의 공개 범위가 public이면 보임)
+	HideBooks bool `json:"hide_books,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShelfQuery when eager-loading is set.
+	Edges        ShelfEdges `json:"edges"`
+	user_shelves *uuid.UUID
+	selectValues sql.SelectValues
+}
+
+// ShelfEdges holds the relations/edges for other nodes in the graph.
+type ShelfEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// Books holds the value of the books edge.
+	Books []*Book `json:"books,omitempty"`
+	// ShelfBooks holds the value of the shelf_books edge.
+	ShelfBooks []*ShelfBook `json:"shelf_books,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShelfEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// BooksOrErr returns the Books value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShelfEdges) BooksOrErr() ([]*Book, error) {
+	if e.loadedTypes[1] {
+		return e.Books, nil
+	}
+	return nil, &NotLoadedError{edge: "books"}
+}
+
+// ShelfBooksOrErr returns the ShelfBooks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShelfEdges) ShelfBooksOrErr() ([]*ShelfBook, error) {
+	if e.loadedTypes[2] {
+		return e.ShelfBooks, nil
+	}
+	return nil, &NotLoadedError{edge: "shelf_books"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Shelf) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shelf.FieldHideBooks:
+			values[i] = new(sql.NullBool)
+		case shelf.FieldName, shelf.FieldDescription, shelf.FieldVisibility:
+			values[i] = new(sql.NullString)
+		case shelf.FieldCreatedAt, shelf.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case shelf.FieldID:
+			values[i] = new(uuid.UUID)
+		case shelf.ForeignKeys[0]: // user_shelves
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Shelf fields.
+func (_m *Shelf) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shelf.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case shelf.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case shelf.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case shelf.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				_m.Visibility = shelf.Visibility(value.String)
+			}
+		case shelf.FieldHideBooks:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field hide_books", values[i])
+			} else if value.Valid {
+				_m.HideBooks = value.Bool
+			}
+		case shelf.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case shelf.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case shelf.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_shelves", values[i])
+			} else if value.Valid {
+				_m.user_shelves = new(uuid.UUID)
+				*_m.user_shelves = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Shelf.
+// This includes values selected through modifiers, order, etc.
+func (_m *Shelf) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the Shelf entity.
+func (_m *Shelf) QueryOwner() *UserQuery {
+	return NewShelfClient(_m.config).QueryOwner(_m)
+}
+
+// QueryBooks queries the "books" edge of the Shelf entity.
+func (_m *Shelf) QueryBooks() *BookQuery {
+	return NewShelfClient(_m.config).QueryBooks(_m)
+}
+
+// QueryShelfBooks queries the "shelf_books" edge of the Shelf entity.
+func (_m *Shelf) QueryShelfBooks() *ShelfBookQuery {
+	return NewShelfClient(_m.config).QueryShelfBooks(_m)
+}
+
+// Update returns a builder for updating this Shelf.
+// Note that you need to call Shelf.Unwrap() before calling this method if this Shelf
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Shelf) Update() *ShelfUpdateOne {
+	return NewShelfClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Shelf entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Shelf) Unwrap() *Shelf {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Shelf is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Shelf) String() string {
+	var builder strings.Builder
+	builder.WriteString("Shelf(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Visibility))
+	builder.WriteString(", ")
+	builder.WriteString("hide_books=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HideBooks))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shelves is a parsable slice of Shelf.
+type Shelves []*Shelf
@@ -0,0 +1,689 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/google/uuid"
+)
+
+// ShelfUpdate is the builder for updating Shelf entities.
+type ShelfUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShelfMutation
+}
+
+// Where appends a list predicates to the ShelfUpdate builder.
+func (_u *ShelfUpdate) Where(ps ...predicate.Shelf) *ShelfUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ShelfUpdate) SetName(v string) *ShelfUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ShelfUpdate) SetNillableName(v *string) *ShelfUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ShelfUpdate) SetDescription(v string) *ShelfUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ShelfUpdate) SetNillableDescription(v *string) *ShelfUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ShelfUpdate) ClearDescription() *ShelfUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *ShelfUpdate) SetVisibility(v shelf.Visibility) *ShelfUpdate {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *ShelfUpdate) SetNillableVisibility(v *shelf.Visibility) *ShelfUpdate {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetHideBooks sets the "hide_books" field.
+func (_u *ShelfUpdate) SetHideBooks(v bool) *ShelfUpdate {
+	_u.mutation.SetHideBooks(v)
+	return _u
+}
+
+// SetNillableHideBooks sets the "hide_books" field if the given value is not nil.
+func (_u *ShelfUpdate) SetNillableHideBooks(v *bool) *ShelfUpdate {
+	if v != nil {
+		_u.SetHideBooks(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ShelfUpdate) SetUpdatedAt(v time.Time) *ShelfUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *ShelfUpdate) SetOwnerID(id uuid.UUID) *ShelfUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *ShelfUpdate) SetOwner(v *User) *ShelfUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by IDs.
+func (_u *ShelfUpdate) AddBookIDs(ids ...uuid.UUID) *ShelfUpdate {
+	_u.mutation.AddBookIDs(ids...)
+	return _u
+}
+
+// AddBooks adds the "books" edges to the Book entity.
+func (_u *ShelfUpdate) AddBooks(v ...*Book) *ShelfUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookIDs(ids...)
+}
+
+// Mutation returns the ShelfMutation object of the builder.
+func (_u *ShelfUpdate) Mutation() *ShelfMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *ShelfUpdate) ClearOwner() *ShelfUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBooks clears all "books" edges to the Book entity.
+func (_u *ShelfUpdate) ClearBooks() *ShelfUpdate {
+	_u.mutation.ClearBooks()
+	return _u
+}
+
+// RemoveBookIDs removes the "books" edge to Book entities by IDs.
+func (_u *ShelfUpdate) RemoveBookIDs(ids ...uuid.UUID) *ShelfUpdate {
+	_u.mutation.RemoveBookIDs(ids...)
+	return _u
+}
+
+// RemoveBooks removes "books" edges to Book entities.
+func (_u *ShelfUpdate) RemoveBooks(v ...*Book) *ShelfUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ShelfUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShelfUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ShelfUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShelfUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ShelfUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := shelf.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShelfUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := shelf.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Shelf.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := shelf.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Shelf.visibility": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Shelf.owner"`)
+	}
+	return nil
+}
+
+func (_u *ShelfUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shelf.Table, shelf.Columns, sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(shelf.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(shelf.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(shelf.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(shelf.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HideBooks(); ok {
+		_spec.SetField(shelf.FieldHideBooks, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(shelf.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shelf.OwnerTable,
+			Columns: []string{shelf.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shelf.OwnerTable,
+			Columns: []string{shelf.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   shelf.BooksTable,
+			Columns: shelf.BooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBooksIDs(); len(nodes) > 0 && !_u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   shelf.BooksTable,
+			Columns: shelf.BooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   shelf.BooksTable,
+			Columns: shelf.BooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shelf.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ShelfUpdateOne is the builder for updating a single Shelf entity.
+type ShelfUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShelfMutation
+}
+
+// SetName sets the "name" field.
+func (_u *ShelfUpdateOne) SetName(v string) *ShelfUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ShelfUpdateOne) SetNillableName(v *string) *ShelfUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ShelfUpdateOne) SetDescription(v string) *ShelfUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ShelfUpdateOne) SetNillableDescription(v *string) *ShelfUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ShelfUpdateOne) ClearDescription() *ShelfUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetVisibility sets the "visibility" field.
+func (_u *ShelfUpdateOne) SetVisibility(v shelf.Visibility) *ShelfUpdateOne {
+	_u.mutation.SetVisibility(v)
+	return _u
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (_u *ShelfUpdateOne) SetNillableVisibility(v *shelf.Visibility) *ShelfUpdateOne {
+	if v != nil {
+		_u.SetVisibility(*v)
+	}
+	return _u
+}
+
+// SetHideBooks sets the "hide_books" field.
+func (_u *ShelfUpdateOne) SetHideBooks(v bool) *ShelfUpdateOne {
+	_u.mutation.SetHideBooks(v)
+	return _u
+}
+
+// SetNillableHideBooks sets the "hide_books" field if the given value is not nil.
+func (_u *ShelfUpdateOne) SetNillableHideBooks(v *bool) *ShelfUpdateOne {
+	if v != nil {
+		_u.SetHideBooks(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ShelfUpdateOne) SetUpdatedAt(v time.Time) *ShelfUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *ShelfUpdateOne) SetOwnerID(id uuid.UUID) *ShelfUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *ShelfUpdateOne) SetOwner(v *User) *ShelfUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by IDs.
+func (_u *ShelfUpdateOne) AddBookIDs(ids ...uuid.UUID) *ShelfUpdateOne {
+	_u.mutation.AddBookIDs(ids...)
+	return _u
+}
+
+// AddBooks adds the "books" edges to the Book entity.
+func (_u *ShelfUpdateOne) AddBooks(v ...*Book) *ShelfUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookIDs(ids...)
+}
+
+// Mutation returns the ShelfMutation object of the builder.
+func (_u *ShelfUpdateOne) Mutation() *ShelfMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *ShelfUpdateOne) ClearOwner() *ShelfUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// ClearBooks clears all "books" edges to the Book entity.
+func (_u *ShelfUpdateOne) ClearBooks() *ShelfUpdateOne {
+	_u.mutation.ClearBooks()
+	return _u
+}
+
+// RemoveBookIDs removes the "books" edge to Book entities by IDs.
+func (_u *ShelfUpdateOne) RemoveBookIDs(ids ...uuid.UUID) *ShelfUpdateOne {
+	_u.mutation.RemoveBookIDs(ids...)
+	return _u
+}
+
+// RemoveBooks removes "books" edges to Book entities.
+func (_u *ShelfUpdateOne) RemoveBooks(v ...*Book) *ShelfUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookIDs(ids...)
+}
+
+// Where appends a list predicates to the ShelfUpdate builder.
+func (_u *ShelfUpdateOne) Where(ps ...predicate.Shelf) *ShelfUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ShelfUpdateOne) Select(field string, fields ...string) *ShelfUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Shelf entity.
+func (_u *ShelfUpdateOne) Save(ctx context.Context) (*Shelf, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShelfUpdateOne) SaveX(ctx context.Context) *Shelf {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ShelfUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShelfUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ShelfUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := shelf.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShelfUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := shelf.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Shelf.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Visibility(); ok {
+		if err := shelf.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Shelf.visibility": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Shelf.owner"`)
+	}
+	return nil
+}
+
+func (_u *ShelfUpdateOne) sqlSave(ctx context.Context) (_node *Shelf, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shelf.Table, shelf.Columns, sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Shelf.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shelf.FieldID)
+		for _, f := range fields {
+			if !shelf.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shelf.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(shelf.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(shelf.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(shelf.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Visibility(); ok {
+		_spec.SetField(shelf.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.HideBooks(); ok {
+		_spec.SetField(shelf.FieldHideBooks, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(shelf.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shelf.OwnerTable,
+			Columns: []string{shelf.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   shelf.OwnerTable,
+			Columns: []string{shelf.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   shelf.BooksTable,
+			Columns: shelf.BooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBooksIDs(); len(nodes) > 0 && !_u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   shelf.BooksTable,
+			Columns: shelf.BooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   shelf.BooksTable,
+			Columns: shelf.BooksPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &ShelfBookCreate{config: _u.config, mutation: newShelfBookMutation(_u.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Shelf{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shelf.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,179 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/google/uuid"
+)
+
+// ShelfBook is the model entity for the ShelfBook schema.
+type ShelfBook struct {
+	config `json:"-"`
+	// ShelfID holds the value of the "shelf_id" field.
+	ShelfID uuid.UUID `json:"shelf_id,omitempty"`
+	// BookID holds the value of the "book_id" field.
+	BookID uuid.UUID `json:"book_id,omitempty"`
+	// 책장 안에서의 순서 (작을수록 앞)
+	Position int `json:"position,omitempty"`
+	// 책장에 추가한 시간
+	AddedAt time.Time `json:"added_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShelfBookQuery when eager-loading is set.
+	Edges        ShelfBookEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShelfBookEdges holds the relations/edges for other nodes in the graph.
+type ShelfBookEdges struct {
+	// Shelf holds the value of the shelf edge.
+	Shelf *Shelf `json:"shelf,omitempty"`
+	// Book holds the value of the book edge.
+	Book *Book `json:"book,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// ShelfOrErr returns the Shelf value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShelfBookEdges) ShelfOrErr() (*Shelf, error) {
+	if e.Shelf != nil {
+		return e.Shelf, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: shelf.Label}
+	}
+	return nil, &NotLoadedError{edge: "shelf"}
+}
+
+// BookOrErr returns the Book value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShelfBookEdges) BookOrErr() (*Book, error) {
+	if e.Book != nil {
+		return e.Book, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: book.Label}
+	}
+	return nil, &NotLoadedError{edge: "book"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ShelfBook) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shelfbook.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case shelfbook.FieldAddedAt:
+			values[i] = new(sql.NullTime)
+		case shelfbook.FieldShelfID, shelfbook.FieldBookID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ShelfBook fields.
+func (_m *ShelfBook) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shelfbook.FieldShelfID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field shelf_id", values[i])
+			} else if value != nil {
+				_m.ShelfID = *value
+			}
+		case shelfbook.FieldBookID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field book_id", values[i])
+			} else if value != nil {
+				_m.BookID = *value
+			}
+		case shelfbook.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case shelfbook.FieldAddedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field added_at", values[i])
+			} else if value.Valid {
+				_m.AddedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ShelfBook.
+// This includes values selected through modifiers, order, etc.
+func (_m *ShelfBook) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryShelf queries the "shelf" edge of the ShelfBook entity.
+func (_m *ShelfBook) QueryShelf() *ShelfQuery {
+	return NewShelfBookClient(_m.config).QueryShelf(_m)
+}
+
+// QueryBook queries the "book" edge of the ShelfBook entity.
+func (_m *ShelfBook) QueryBook() *BookQuery {
+	return NewShelfBookClient(_m.config).QueryBook(_m)
+}
+
+// Update returns a builder for updating this ShelfBook.
+// Note that you need to call ShelfBook.Unwrap() before calling this method if this ShelfBook
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ShelfBook) Update() *ShelfBookUpdateOne {
+	return NewShelfBookClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ShelfBook entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ShelfBook) Unwrap() *ShelfBook {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ShelfBook is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ShelfBook) String() string {
+	var builder strings.Builder
+	builder.WriteString("ShelfBook(")
+	builder.WriteString("shelf_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShelfID))
+	builder.WriteString(", ")
+	builder.WriteString("book_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BookID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("added_at=")
+	builder.WriteString(_m.AddedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ShelfBooks is a parsable slice of ShelfBook.
+type ShelfBooks []*ShelfBook
@@ -0,0 +1,123 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shelfbook
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the shelfbook type in the database.
+	Label = "shelf_book"
+	// FieldShelfID holds the string denoting the shelf_id field in the database.
+	FieldShelfID = "shelf_id"
+	// FieldBookID holds the string denoting the book_id field in the database.
+	FieldBookID = "book_id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldAddedAt holds the string denoting the added_at field in the database.
+	FieldAddedAt = "added_at"
+	// EdgeShelf holds the string denoting the shelf edge name in mutations.
+	EdgeShelf = "shelf"
+	// EdgeBook holds the string denoting the book edge name in mutations.
+	EdgeBook = "book"
+	// ShelfFieldID holds the string denoting the ID field of the Shelf.
+	ShelfFieldID = "id"
+	// BookFieldID holds the string denoting the ID field of the Book.
+	BookFieldID = "id"
+	// Table holds the table name of the shelfbook in the database.
+	Table = "shelf_books"
+	// ShelfTable is the table that holds the shelf relation/edge.
+	ShelfTable = "shelf_books"
+	// ShelfInverseTable is the table name for the Shelf entity.
+	// It exists in this package in order to avoid circular dependency with the "shelf" package.
+	ShelfInverseTable = "shelves"
+	// ShelfColumn is the table column denoting the shelf relation/edge.
+	ShelfColumn = "shelf_id"
+	// BookTable is the table that holds the book relation/edge.
+	BookTable = "shelf_books"
+	// BookInverseTable is the table name for the Book entity.
+	// It exists in this package in order to avoid circular dependency with the "book" package.
+	BookInverseTable = "books"
+	// BookColumn is the table column denoting the book relation/edge.
+	BookColumn = "book_id"
+)
+
+// Columns holds all SQL columns for shelfbook fields.
+var Columns = []string{
+	FieldShelfID,
+	FieldBookID,
+	FieldPosition,
+	FieldAddedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPosition holds the default value on creation for the "position" field.
+	DefaultPosition int
+	// DefaultAddedAt holds the default value on creation for the "added_at" field.
+	DefaultAddedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the ShelfBook queries.
+type OrderOption func(*sql.Selector)
+
+// ByShelfID orders the results by the shelf_id field.
+func ByShelfID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShelfID, opts...).ToFunc()
+}
+
+// ByBookID orders the results by the book_id field.
+func ByBookID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBookID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByAddedAt orders the results by the added_at field.
+func ByAddedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddedAt, opts...).ToFunc()
+}
+
+// ByShelfField orders the results by shelf field.
+func ByShelfField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShelfStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBookField orders the results by book field.
+func ByBookField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBookStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newShelfStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, ShelfColumn),
+		sqlgraph.To(ShelfInverseTable, ShelfFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, ShelfTable, ShelfColumn),
+	)
+}
+func newBookStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, BookColumn),
+		sqlgraph.To(BookInverseTable, BookFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, BookTable, BookColumn),
+	)
+}
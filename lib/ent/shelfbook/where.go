@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package shelfbook
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ShelfID applies equality check predicate on the "shelf_id" field. It's identical to ShelfIDEQ.
+func ShelfID(v uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldEQ(FieldShelfID, v))
+}
+
+// BookID applies equality check predicate on the "book_id" field. It's identical to BookIDEQ.
+func BookID(v uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldEQ(FieldBookID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldEQ(FieldPosition, v))
+}
+
+// AddedAt applies equality check predicate on the "added_at" field. It's identical to AddedAtEQ.
+func AddedAt(v time.Time) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// ShelfIDEQ applies the EQ predicate on the "shelf_id" field.
+func ShelfIDEQ(v uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldEQ(FieldShelfID, v))
+}
+
+// ShelfIDNEQ applies the NEQ predicate on the "shelf_id" field.
+func ShelfIDNEQ(v uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldNEQ(FieldShelfID, v))
+}
+
+// ShelfIDIn applies the In predicate on the "shelf_id" field.
+func ShelfIDIn(vs ...uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldIn(FieldShelfID, vs...))
+}
+
+// ShelfIDNotIn applies the NotIn predicate on the "shelf_id" field.
+func ShelfIDNotIn(vs ...uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldNotIn(FieldShelfID, vs...))
+}
+
+// BookIDEQ applies the EQ predicate on the "book_id" field.
+func BookIDEQ(v uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldEQ(FieldBookID, v))
+}
+
+// BookIDNEQ applies the NEQ predicate on the "book_id" field.
+func BookIDNEQ(v uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldNEQ(FieldBookID, v))
+}
+
+// BookIDIn applies the In predicate on the "book_id" field.
+func BookIDIn(vs ...uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldIn(FieldBookID, vs...))
+}
+
+// BookIDNotIn applies the NotIn predicate on the "book_id" field.
+func BookIDNotIn(vs ...uuid.UUID) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldNotIn(FieldBookID, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldLTE(FieldPosition, v))
+}
+
+// AddedAtEQ applies the EQ predicate on the "added_at" field.
+func AddedAtEQ(v time.Time) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldEQ(FieldAddedAt, v))
+}
+
+// AddedAtNEQ applies the NEQ predicate on the "added_at" field.
+func AddedAtNEQ(v time.Time) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldNEQ(FieldAddedAt, v))
+}
+
+// AddedAtIn applies the In predicate on the "added_at" field.
+func AddedAtIn(vs ...time.Time) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldIn(FieldAddedAt, vs...))
+}
+
+// AddedAtNotIn applies the NotIn predicate on the "added_at" field.
+func AddedAtNotIn(vs ...time.Time) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldNotIn(FieldAddedAt, vs...))
+}
+
+// AddedAtGT applies the GT predicate on the "added_at" field.
+func AddedAtGT(v time.Time) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldGT(FieldAddedAt, v))
+}
+
+// AddedAtGTE applies the GTE predicate on the "added_at" field.
+func AddedAtGTE(v time.Time) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldGTE(FieldAddedAt, v))
+}
+
+// AddedAtLT applies the LT predicate on the "added_at" field.
+func AddedAtLT(v time.Time) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldLT(FieldAddedAt, v))
+}
+
+// AddedAtLTE applies the LTE predicate on the "added_at" field.
+func AddedAtLTE(v time.Time) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.FieldLTE(FieldAddedAt, v))
+}
+
+// HasShelf applies the HasEdge predicate on the "shelf" edge.
+func HasShelf() predicate.ShelfBook {
+	return predicate.ShelfBook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, ShelfColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, ShelfTable, ShelfColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShelfWith applies the HasEdge predicate on the "shelf" edge with a given conditions (other predicates).
+func HasShelfWith(preds ...predicate.Shelf) predicate.ShelfBook {
+	return predicate.ShelfBook(func(s *sql.Selector) {
+		step := newShelfStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBook applies the HasEdge predicate on the "book" edge.
+func HasBook() predicate.ShelfBook {
+	return predicate.ShelfBook(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, BookColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, BookTable, BookColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookWith applies the HasEdge predicate on the "book" edge with a given conditions (other predicates).
+func HasBookWith(preds ...predicate.Book) predicate.ShelfBook {
+	return predicate.ShelfBook(func(s *sql.Selector) {
+		step := newBookStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ShelfBook) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ShelfBook) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ShelfBook) predicate.ShelfBook {
+	return predicate.ShelfBook(sql.NotPredicates(p))
+}
@@ -0,0 +1,289 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/google/uuid"
+)
+
+// ShelfBookCreate is the builder for creating a ShelfBook entity.
+type ShelfBookCreate struct {
+	config
+	mutation *ShelfBookMutation
+	hooks    []Hook
+}
+
+// SetShelfID sets the "shelf_id" field.
+func (_c *ShelfBookCreate) SetShelfID(v uuid.UUID) *ShelfBookCreate {
+	_c.mutation.SetShelfID(v)
+	return _c
+}
+
+// SetBookID sets the "book_id" field.
+func (_c *ShelfBookCreate) SetBookID(v uuid.UUID) *ShelfBookCreate {
+	_c.mutation.SetBookID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *ShelfBookCreate) SetPosition(v int) *ShelfBookCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *ShelfBookCreate) SetNillablePosition(v *int) *ShelfBookCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetAddedAt sets the "added_at" field.
+func (_c *ShelfBookCreate) SetAddedAt(v time.Time) *ShelfBookCreate {
+	_c.mutation.SetAddedAt(v)
+	return _c
+}
+
+// SetNillableAddedAt sets the "added_at" field if the given value is not nil.
+func (_c *ShelfBookCreate) SetNillableAddedAt(v *time.Time) *ShelfBookCreate {
+	if v != nil {
+		_c.SetAddedAt(*v)
+	}
+	return _c
+}
+
+// SetShelf sets the "shelf" edge to the Shelf entity.
+func (_c *ShelfBookCreate) SetShelf(v *Shelf) *ShelfBookCreate {
+	return _c.SetShelfID(v.ID)
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_c *ShelfBookCreate) SetBook(v *Book) *ShelfBookCreate {
+	return _c.SetBookID(v.ID)
+}
+
+// Mutation returns the ShelfBookMutation object of the builder.
+func (_c *ShelfBookCreate) Mutation() *ShelfBookMutation {
+	return _c.mutation
+}
+
+// Save creates the ShelfBook in the database.
+func (_c *ShelfBookCreate) Save(ctx context.Context) (*ShelfBook, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ShelfBookCreate) SaveX(ctx context.Context) *ShelfBook {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShelfBookCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShelfBookCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ShelfBookCreate) defaults() {
+	if _, ok := _c.mutation.Position(); !ok {
+		v := shelfbook.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.AddedAt(); !ok {
+		v := shelfbook.DefaultAddedAt()
+		_c.mutation.SetAddedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ShelfBookCreate) check() error {
+	if _, ok := _c.mutation.ShelfID(); !ok {
+		return &ValidationError{Name: "shelf_id", err: errors.New(`ent: missing required field "ShelfBook.shelf_id"`)}
+	}
+	if _, ok := _c.mutation.BookID(); !ok {
+		return &ValidationError{Name: "book_id", err: errors.New(`ent: missing required field "ShelfBook.book_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ShelfBook.position"`)}
+	}
+	if _, ok := _c.mutation.AddedAt(); !ok {
+		return &ValidationError{Name: "added_at", err: errors.New(`ent: missing required field "ShelfBook.added_at"`)}
+	}
+	if len(_c.mutation.ShelfIDs()) == 0 {
+		return &ValidationError{Name: "shelf", err: errors.New(`ent: missing required edge "ShelfBook.shelf"`)}
+	}
+	if len(_c.mutation.BookIDs()) == 0 {
+		return &ValidationError{Name: "book", err: errors.New(`ent: missing required edge "ShelfBook.book"`)}
+	}
+	return nil
+}
+
+func (_c *ShelfBookCreate) sqlSave(ctx context.Context) (*ShelfBook, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (_c *ShelfBookCreate) createSpec() (*ShelfBook, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ShelfBook{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(shelfbook.Table, nil)
+	)
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(shelfbook.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.AddedAt(); ok {
+		_spec.SetField(shelfbook.FieldAddedAt, field.TypeTime, value)
+		_node.AddedAt = value
+	}
+	if nodes := _c.mutation.ShelfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.ShelfTable,
+			Columns: []string{shelfbook.ShelfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ShelfID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.BookTable,
+			Columns: []string{shelfbook.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BookID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ShelfBookCreateBulk is the builder for creating many ShelfBook entities in bulk.
+type ShelfBookCreateBulk struct {
+	config
+	err      error
+	builders []*ShelfBookCreate
+}
+
+// Save creates the ShelfBook entities in the database.
+func (_c *ShelfBookCreateBulk) Save(ctx context.Context) ([]*ShelfBook, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ShelfBook, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ShelfBookMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ShelfBookCreateBulk) SaveX(ctx context.Context) []*ShelfBook {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ShelfBookCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ShelfBookCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
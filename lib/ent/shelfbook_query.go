@@ -0,0 +1,604 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/google/uuid"
+)
+
+// ShelfBookQuery is the builder for querying ShelfBook entities.
+type ShelfBookQuery struct {
+	config
+	ctx        *QueryContext
+	order      []shelfbook.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ShelfBook
+	withShelf  *ShelfQuery
+	withBook   *BookQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ShelfBookQuery builder.
+func (_q *ShelfBookQuery) Where(ps ...predicate.ShelfBook) *ShelfBookQuery {
+	_q.predicates = append(_q.predicates, ps...)
+	return _q
+}
+
+// Limit the number of records to be returned by this query.
+func (_q *ShelfBookQuery) Limit(limit int) *ShelfBookQuery {
+	_q.ctx.Limit = &limit
+	return _q
+}
+
+// Offset to start from.
+func (_q *ShelfBookQuery) Offset(offset int) *ShelfBookQuery {
+	_q.ctx.Offset = &offset
+	return _q
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (_q *ShelfBookQuery) Unique(unique bool) *ShelfBookQuery {
+	_q.ctx.Unique = &unique
+	return _q
+}
+
+// Order specifies how the records should be ordered.
+func (_q *ShelfBookQuery) Order(o ...shelfbook.OrderOption) *ShelfBookQuery {
+	_q.order = append(_q.order, o...)
+	return _q
+}
+
+// QueryShelf chains the current query on the "shelf" edge.
+func (_q *ShelfBookQuery) QueryShelf() *ShelfQuery {
+	query := (&ShelfClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shelfbook.Table, shelfbook.ShelfColumn, selector),
+			sqlgraph.To(shelf.Table, shelf.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, shelfbook.ShelfTable, shelfbook.ShelfColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBook chains the current query on the "book" edge.
+func (_q *ShelfBookQuery) QueryBook() *BookQuery {
+	query := (&BookClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shelfbook.Table, shelfbook.BookColumn, selector),
+			sqlgraph.To(book.Table, book.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, shelfbook.BookTable, shelfbook.BookColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ShelfBook entity from the query.
+// Returns a *NotFoundError when no ShelfBook was found.
+func (_q *ShelfBookQuery) First(ctx context.Context) (*ShelfBook, error) {
+	nodes, err := _q.Limit(1).All(setContextOp(ctx, _q.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{shelfbook.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (_q *ShelfBookQuery) FirstX(ctx context.Context) *ShelfBook {
+	node, err := _q.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// Only returns a single ShelfBook entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ShelfBook entity is found.
+// Returns a *NotFoundError when no ShelfBook entities are found.
+func (_q *ShelfBookQuery) Only(ctx context.Context) (*ShelfBook, error) {
+	nodes, err := _q.Limit(2).All(setContextOp(ctx, _q.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{shelfbook.Label}
+	default:
+		return nil, &NotSingularError{shelfbook.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (_q *ShelfBookQuery) OnlyX(ctx context.Context) *ShelfBook {
+	node, err := _q.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// All executes the query and returns a list of ShelfBooks.
+func (_q *ShelfBookQuery) All(ctx context.Context) ([]*ShelfBook, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryAll)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ShelfBook, *ShelfBookQuery]()
+	return withInterceptors[[]*ShelfBook](ctx, _q, qr, _q.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (_q *ShelfBookQuery) AllX(ctx context.Context) []*ShelfBook {
+	nodes, err := _q.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Count returns the count of the given query.
+func (_q *ShelfBookQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryCount)
+	if err := _q.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, _q, querierCount[*ShelfBookQuery](), _q.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (_q *ShelfBookQuery) CountX(ctx context.Context) int {
+	count, err := _q.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (_q *ShelfBookQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, _q.ctx, ent.OpQueryExist)
+	switch _, err := _q.First(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (_q *ShelfBookQuery) ExistX(ctx context.Context) bool {
+	exist, err := _q.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ShelfBookQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (_q *ShelfBookQuery) Clone() *ShelfBookQuery {
+	if _q == nil {
+		return nil
+	}
+	return &ShelfBookQuery{
+		config:     _q.config,
+		ctx:        _q.ctx.Clone(),
+		order:      append([]shelfbook.OrderOption{}, _q.order...),
+		inters:     append([]Interceptor{}, _q.inters...),
+		predicates: append([]predicate.ShelfBook{}, _q.predicates...),
+		withShelf:  _q.withShelf.Clone(),
+		withBook:   _q.withBook.Clone(),
+		// clone intermediate query.
+		sql:  _q.sql.Clone(),
+		path: _q.path,
+	}
+}
+
+// WithShelf tells the query-builder to eager-load the nodes that are connected to
+// the "shelf" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ShelfBookQuery) WithShelf(opts ...func(*ShelfQuery)) *ShelfBookQuery {
+	query := (&ShelfClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShelf = query
+	return _q
+}
+
+// WithBook tells the query-builder to eager-load the nodes that are connected to
+// the "book" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ShelfBookQuery) WithBook(opts ...func(*BookQuery)) *ShelfBookQuery {
+	query := (&BookClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBook = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ShelfID uuid.UUID `json:"shelf_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ShelfBook.Query().
+//		GroupBy(shelfbook.FieldShelfID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *ShelfBookQuery) GroupBy(field string, fields ...string) *ShelfBookGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ShelfBookGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = shelfbook.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ShelfID uuid.UUID `json:"shelf_id,omitempty"`
+//	}
+//
+//	client.ShelfBook.Query().
+//		Select(shelfbook.FieldShelfID).
+//		Scan(ctx, &v)
+func (_q *ShelfBookQuery) Select(fields ...string) *ShelfBookSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &ShelfBookSelect{ShelfBookQuery: _q}
+	sbuild.label = shelfbook.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ShelfBookSelect configured with the given aggregations.
+func (_q *ShelfBookQuery) Aggregate(fns ...AggregateFunc) *ShelfBookSelect {
+	return _q.Select().Aggregate(fns...)
+}
+
+func (_q *ShelfBookQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range _q.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, _q); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range _q.ctx.Fields {
+		if !shelfbook.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if _q.path != nil {
+		prev, err := _q.path(ctx)
+		if err != nil {
+			return err
+		}
+		_q.sql = prev
+	}
+	return nil
+}
+
+func (_q *ShelfBookQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ShelfBook, error) {
+	var (
+		nodes       = []*ShelfBook{}
+		_spec       = _q.querySpec()
+		loadedTypes = [2]bool{
+			_q.withShelf != nil,
+			_q.withBook != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ShelfBook).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ShelfBook{config: _q.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, _q.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := _q.withShelf; query != nil {
+		if err := _q.loadShelf(ctx, query, nodes, nil,
+			func(n *ShelfBook, e *Shelf) { n.Edges.Shelf = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBook; query != nil {
+		if err := _q.loadBook(ctx, query, nodes, nil,
+			func(n *ShelfBook, e *Book) { n.Edges.Book = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (_q *ShelfBookQuery) loadShelf(ctx context.Context, query *ShelfQuery, nodes []*ShelfBook, init func(*ShelfBook), assign func(*ShelfBook, *Shelf)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ShelfBook)
+	for i := range nodes {
+		fk := nodes[i].ShelfID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(shelf.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "shelf_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *ShelfBookQuery) loadBook(ctx context.Context, query *BookQuery, nodes []*ShelfBook, init func(*ShelfBook), assign func(*ShelfBook, *Book)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*ShelfBook)
+	for i := range nodes {
+		fk := nodes[i].BookID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(book.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "book_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (_q *ShelfBookQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := _q.querySpec()
+	_spec.Unique = false
+	_spec.Node.Columns = nil
+	return sqlgraph.CountNodes(ctx, _q.driver, _spec)
+}
+
+func (_q *ShelfBookQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(shelfbook.Table, shelfbook.Columns, nil)
+	_spec.From = _q.sql
+	if unique := _q.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if _q.path != nil {
+		_spec.Unique = true
+	}
+	if fields := _q.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		for i := range fields {
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if _q.withShelf != nil {
+			_spec.Node.AddColumnOnce(shelfbook.FieldShelfID)
+		}
+		if _q.withBook != nil {
+			_spec.Node.AddColumnOnce(shelfbook.FieldBookID)
+		}
+	}
+	if ps := _q.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := _q.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (_q *ShelfBookQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(_q.driver.Dialect())
+	t1 := builder.Table(shelfbook.Table)
+	columns := _q.ctx.Fields
+	if len(columns) == 0 {
+		columns = shelfbook.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if _q.sql != nil {
+		selector = _q.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if _q.ctx.Unique != nil && *_q.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range _q.predicates {
+		p(selector)
+	}
+	for _, p := range _q.order {
+		p(selector)
+	}
+	if offset := _q.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := _q.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ShelfBookGroupBy is the group-by builder for ShelfBook entities.
+type ShelfBookGroupBy struct {
+	selector
+	build *ShelfBookQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (_g *ShelfBookGroupBy) Aggregate(fns ...AggregateFunc) *ShelfBookGroupBy {
+	_g.fns = append(_g.fns, fns...)
+	return _g
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_g *ShelfBookGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _g.build.ctx, ent.OpQueryGroupBy)
+	if err := _g.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShelfBookQuery, *ShelfBookGroupBy](ctx, _g.build, _g, _g.build.inters, v)
+}
+
+func (_g *ShelfBookGroupBy) sqlScan(ctx context.Context, root *ShelfBookQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(_g.fns))
+	for _, fn := range _g.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*_g.flds)+len(_g.fns))
+		for _, f := range *_g.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*_g.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _g.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ShelfBookSelect is the builder for selecting fields of ShelfBook entities.
+type ShelfBookSelect struct {
+	*ShelfBookQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (_s *ShelfBookSelect) Aggregate(fns ...AggregateFunc) *ShelfBookSelect {
+	_s.fns = append(_s.fns, fns...)
+	return _s
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (_s *ShelfBookSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, _s.ctx, ent.OpQuerySelect)
+	if err := _s.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ShelfBookQuery, *ShelfBookSelect](ctx, _s.ShelfBookQuery, _s, _s.inters, v)
+}
+
+func (_s *ShelfBookSelect) sqlScan(ctx context.Context, root *ShelfBookQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(_s.fns))
+	for _, fn := range _s.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*_s.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := _s.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,478 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelfbook"
+	"github.com/google/uuid"
+)
+
+// ShelfBookUpdate is the builder for updating ShelfBook entities.
+type ShelfBookUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShelfBookMutation
+}
+
+// Where appends a list predicates to the ShelfBookUpdate builder.
+func (_u *ShelfBookUpdate) Where(ps ...predicate.ShelfBook) *ShelfBookUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetShelfID sets the "shelf_id" field.
+func (_u *ShelfBookUpdate) SetShelfID(v uuid.UUID) *ShelfBookUpdate {
+	_u.mutation.SetShelfID(v)
+	return _u
+}
+
+// SetNillableShelfID sets the "shelf_id" field if the given value is not nil.
+func (_u *ShelfBookUpdate) SetNillableShelfID(v *uuid.UUID) *ShelfBookUpdate {
+	if v != nil {
+		_u.SetShelfID(*v)
+	}
+	return _u
+}
+
+// SetBookID sets the "book_id" field.
+func (_u *ShelfBookUpdate) SetBookID(v uuid.UUID) *ShelfBookUpdate {
+	_u.mutation.SetBookID(v)
+	return _u
+}
+
+// SetNillableBookID sets the "book_id" field if the given value is not nil.
+func (_u *ShelfBookUpdate) SetNillableBookID(v *uuid.UUID) *ShelfBookUpdate {
+	if v != nil {
+		_u.SetBookID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *ShelfBookUpdate) SetPosition(v int) *ShelfBookUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *ShelfBookUpdate) SetNillablePosition(v *int) *ShelfBookUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *ShelfBookUpdate) AddPosition(v int) *ShelfBookUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetShelf sets the "shelf" edge to the Shelf entity.
+func (_u *ShelfBookUpdate) SetShelf(v *Shelf) *ShelfBookUpdate {
+	return _u.SetShelfID(v.ID)
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *ShelfBookUpdate) SetBook(v *Book) *ShelfBookUpdate {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the ShelfBookMutation object of the builder.
+func (_u *ShelfBookUpdate) Mutation() *ShelfBookMutation {
+	return _u.mutation
+}
+
+// ClearShelf clears the "shelf" edge to the Shelf entity.
+func (_u *ShelfBookUpdate) ClearShelf() *ShelfBookUpdate {
+	_u.mutation.ClearShelf()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *ShelfBookUpdate) ClearBook() *ShelfBookUpdate {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ShelfBookUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShelfBookUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ShelfBookUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShelfBookUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShelfBookUpdate) check() error {
+	if _u.mutation.ShelfCleared() && len(_u.mutation.ShelfIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShelfBook.shelf"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShelfBook.book"`)
+	}
+	return nil
+}
+
+func (_u *ShelfBookUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shelfbook.Table, shelfbook.Columns, sqlgraph.NewFieldSpec(shelfbook.FieldShelfID, field.TypeUUID), sqlgraph.NewFieldSpec(shelfbook.FieldBookID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(shelfbook.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(shelfbook.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.ShelfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.ShelfTable,
+			Columns: []string{shelfbook.ShelfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShelfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.ShelfTable,
+			Columns: []string{shelfbook.ShelfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.BookTable,
+			Columns: []string{shelfbook.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.BookTable,
+			Columns: []string{shelfbook.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shelfbook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ShelfBookUpdateOne is the builder for updating a single ShelfBook entity.
+type ShelfBookUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShelfBookMutation
+}
+
+// SetShelfID sets the "shelf_id" field.
+func (_u *ShelfBookUpdateOne) SetShelfID(v uuid.UUID) *ShelfBookUpdateOne {
+	_u.mutation.SetShelfID(v)
+	return _u
+}
+
+// SetNillableShelfID sets the "shelf_id" field if the given value is not nil.
+func (_u *ShelfBookUpdateOne) SetNillableShelfID(v *uuid.UUID) *ShelfBookUpdateOne {
+	if v != nil {
+		_u.SetShelfID(*v)
+	}
+	return _u
+}
+
+// SetBookID sets the "book_id" field.
+func (_u *ShelfBookUpdateOne) SetBookID(v uuid.UUID) *ShelfBookUpdateOne {
+	_u.mutation.SetBookID(v)
+	return _u
+}
+
+// SetNillableBookID sets the "book_id" field if the given value is not nil.
+func (_u *ShelfBookUpdateOne) SetNillableBookID(v *uuid.UUID) *ShelfBookUpdateOne {
+	if v != nil {
+		_u.SetBookID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *ShelfBookUpdateOne) SetPosition(v int) *ShelfBookUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *ShelfBookUpdateOne) SetNillablePosition(v *int) *ShelfBookUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *ShelfBookUpdateOne) AddPosition(v int) *ShelfBookUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetShelf sets the "shelf" edge to the Shelf entity.
+func (_u *ShelfBookUpdateOne) SetShelf(v *Shelf) *ShelfBookUpdateOne {
+	return _u.SetShelfID(v.ID)
+}
+
+// SetBook sets the "book" edge to the Book entity.
+func (_u *ShelfBookUpdateOne) SetBook(v *Book) *ShelfBookUpdateOne {
+	return _u.SetBookID(v.ID)
+}
+
+// Mutation returns the ShelfBookMutation object of the builder.
+func (_u *ShelfBookUpdateOne) Mutation() *ShelfBookMutation {
+	return _u.mutation
+}
+
+// ClearShelf clears the "shelf" edge to the Shelf entity.
+func (_u *ShelfBookUpdateOne) ClearShelf() *ShelfBookUpdateOne {
+	_u.mutation.ClearShelf()
+	return _u
+}
+
+// ClearBook clears the "book" edge to the Book entity.
+func (_u *ShelfBookUpdateOne) ClearBook() *ShelfBookUpdateOne {
+	_u.mutation.ClearBook()
+	return _u
+}
+
+// Where appends a list predicates to the ShelfBookUpdate builder.
+func (_u *ShelfBookUpdateOne) Where(ps ...predicate.ShelfBook) *ShelfBookUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ShelfBookUpdateOne) Select(field string, fields ...string) *ShelfBookUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ShelfBook entity.
+func (_u *ShelfBookUpdateOne) Save(ctx context.Context) (*ShelfBook, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ShelfBookUpdateOne) SaveX(ctx context.Context) *ShelfBook {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ShelfBookUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ShelfBookUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ShelfBookUpdateOne) check() error {
+	if _u.mutation.ShelfCleared() && len(_u.mutation.ShelfIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShelfBook.shelf"`)
+	}
+	if _u.mutation.BookCleared() && len(_u.mutation.BookIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ShelfBook.book"`)
+	}
+	return nil
+}
+
+func (_u *ShelfBookUpdateOne) sqlSave(ctx context.Context) (_node *ShelfBook, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shelfbook.Table, shelfbook.Columns, sqlgraph.NewFieldSpec(shelfbook.FieldShelfID, field.TypeUUID), sqlgraph.NewFieldSpec(shelfbook.FieldBookID, field.TypeUUID))
+	if id, ok := _u.mutation.ShelfID(); !ok {
+		return nil, &ValidationError{Name: "shelf_id", err: errors.New(`ent: missing "ShelfBook.shelf_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := _u.mutation.BookID(); !ok {
+		return nil, &ValidationError{Name: "book_id", err: errors.New(`ent: missing "ShelfBook.book_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !shelfbook.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(shelfbook.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(shelfbook.FieldPosition, field.TypeInt, value)
+	}
+	if _u.mutation.ShelfCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.ShelfTable,
+			Columns: []string{shelfbook.ShelfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShelfIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.ShelfTable,
+			Columns: []string{shelfbook.ShelfColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.BookTable,
+			Columns: []string{shelfbook.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   shelfbook.BookTable,
+			Columns: []string{shelfbook.BookColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ShelfBook{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shelfbook.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
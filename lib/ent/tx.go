@@ -16,16 +16,46 @@ type Tx struct {
 	AdminAPIKey *AdminAPIKeyClient
 	// Book is the client for interacting with the Book builders.
 	Book *BookClient
+	// BookCatalog is the client for interacting with the BookCatalog builders.
+	BookCatalog *BookCatalogClient
+	// BookNote is the client for interacting with the BookNote builders.
+	BookNote *BookNoteClient
+	// BookStatusHistory is the client for interacting with the BookStatusHistory builders.
+	BookStatusHistory *BookStatusHistoryClient
 	// Bookmark is the client for interacting with the Bookmark builders.
 	Bookmark *BookmarkClient
+	// BorrowRequest is the client for interacting with the BorrowRequest builders.
+	BorrowRequest *BorrowRequestClient
+	// DataMigration is the client for interacting with the DataMigration builders.
+	DataMigration *DataMigrationClient
 	// EmailVerification is the client for interacting with the EmailVerification builders.
 	EmailVerification *EmailVerificationClient
+	// Library is the client for interacting with the Library builders.
+	Library *LibraryClient
+	// LibraryInvitation is the client for interacting with the LibraryInvitation builders.
+	LibraryInvitation *LibraryInvitationClient
+	// LibraryMember is the client for interacting with the LibraryMember builders.
+	LibraryMember *LibraryMemberClient
+	// Loan is the client for interacting with the Loan builders.
+	Loan *LoanClient
+	// ReadingGoal is the client for interacting with the ReadingGoal builders.
+	ReadingGoal *ReadingGoalClient
 	// ReadingReminder is the client for interacting with the ReadingReminder builders.
 	ReadingReminder *ReadingReminderClient
+	// ReadingSession is the client for interacting with the ReadingSession builders.
+	ReadingSession *ReadingSessionClient
 	// Review is the client for interacting with the Review builders.
 	Review *ReviewClient
+	// Shelf is the client for interacting with the Shelf builders.
+	Shelf *ShelfClient
+	// ShelfBook is the client for interacting with the ShelfBook builders.
+	ShelfBook *ShelfBookClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WishlistItem is the client for interacting with the WishlistItem builders.
+	WishlistItem *WishlistItemClient
 
 	// lazily loaded.
 	client     *Client
@@ -159,11 +189,26 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.AdminAPIKey = NewAdminAPIKeyClient(tx.config)
 	tx.Book = NewBookClient(tx.config)
+	tx.BookCatalog = NewBookCatalogClient(tx.config)
+	tx.BookNote = NewBookNoteClient(tx.config)
+	tx.BookStatusHistory = NewBookStatusHistoryClient(tx.config)
 	tx.Bookmark = NewBookmarkClient(tx.config)
+	tx.BorrowRequest = NewBorrowRequestClient(tx.config)
+	tx.DataMigration = NewDataMigrationClient(tx.config)
 	tx.EmailVerification = NewEmailVerificationClient(tx.config)
+	tx.Library = NewLibraryClient(tx.config)
+	tx.LibraryInvitation = NewLibraryInvitationClient(tx.config)
+	tx.LibraryMember = NewLibraryMemberClient(tx.config)
+	tx.Loan = NewLoanClient(tx.config)
+	tx.ReadingGoal = NewReadingGoalClient(tx.config)
 	tx.ReadingReminder = NewReadingReminderClient(tx.config)
+	tx.ReadingSession = NewReadingSessionClient(tx.config)
 	tx.Review = NewReviewClient(tx.config)
+	tx.Shelf = NewShelfClient(tx.config)
+	tx.ShelfBook = NewShelfBookClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WishlistItem = NewWishlistItemClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -34,6 +34,8 @@ type User struct {
 	FcmToken string `json:"fcm_token,omitempty"`
 	// 사용자 타임존
 	Timezone string `json:"timezone,omitempty"`
+	// 위시리스트 공개 링크 토큰 (nil이면 공유하지 않음)
+	WishlistShareToken *string `json:"wishlist_share_token,omitempty"`
 	// 사용자 생성 시간
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// 사용자 수정 시간
@@ -54,9 +56,33 @@ type UserEdges struct {
 	Bookmarks []*Bookmark `json:"bookmarks,omitempty"`
 	// ReadingReminders holds the value of the reading_reminders edge.
 	ReadingReminders []*ReadingReminder `json:"reading_reminders,omitempty"`
+	// ReadingSessions holds the value of the reading_sessions edge.
+	ReadingSessions []*ReadingSession `json:"reading_sessions,omitempty"`
+	// Shelves holds the value of the shelves edge.
+	Shelves []*Shelf `json:"shelves,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// BookNotes holds the value of the book_notes edge.
+	BookNotes []*BookNote `json:"book_notes,omitempty"`
+	// Loans holds the value of the loans edge.
+	Loans []*Loan `json:"loans,omitempty"`
+	// SentBorrowRequests holds the value of the sent_borrow_requests edge.
+	SentBorrowRequests []*BorrowRequest `json:"sent_borrow_requests,omitempty"`
+	// ReceivedBorrowRequests holds the value of the received_borrow_requests edge.
+	ReceivedBorrowRequests []*BorrowRequest `json:"received_borrow_requests,omitempty"`
+	// WishlistItems holds the value of the wishlist_items edge.
+	WishlistItems []*WishlistItem `json:"wishlist_items,omitempty"`
+	// LibraryMemberships holds the value of the library_memberships edge.
+	LibraryMemberships []*LibraryMember `json:"library_memberships,omitempty"`
+	// SentLibraryInvitations holds the value of the sent_library_invitations edge.
+	SentLibraryInvitations []*LibraryInvitation `json:"sent_library_invitations,omitempty"`
+	// ReceivedLibraryInvitations holds the value of the received_library_invitations edge.
+	ReceivedLibraryInvitations []*LibraryInvitation `json:"received_library_invitations,omitempty"`
+	// ReadingGoals holds the value of the reading_goals edge.
+	ReadingGoals []*ReadingGoal `json:"reading_goals,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [16]bool
 }
 
 // BooksOrErr returns the Books value or an error if the edge
@@ -95,6 +121,114 @@ func (e UserEdges) ReadingRemindersOrErr() ([]*ReadingReminder, error) {
 	return nil, &NotLoadedError{edge: "reading_reminders"}
 }
 
+// ReadingSessionsOrErr returns the ReadingSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReadingSessionsOrErr() ([]*ReadingSession, error) {
+	if e.loadedTypes[4] {
+		return e.ReadingSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "reading_sessions"}
+}
+
+// ShelvesOrErr returns the Shelves value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ShelvesOrErr() ([]*Shelf, error) {
+	if e.loadedTypes[5] {
+		return e.Shelves, nil
+	}
+	return nil, &NotLoadedError{edge: "shelves"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[6] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// BookNotesOrErr returns the BookNotes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BookNotesOrErr() ([]*BookNote, error) {
+	if e.loadedTypes[7] {
+		return e.BookNotes, nil
+	}
+	return nil, &NotLoadedError{edge: "book_notes"}
+}
+
+// LoansOrErr returns the Loans value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LoansOrErr() ([]*Loan, error) {
+	if e.loadedTypes[8] {
+		return e.Loans, nil
+	}
+	return nil, &NotLoadedError{edge: "loans"}
+}
+
+// SentBorrowRequestsOrErr returns the SentBorrowRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SentBorrowRequestsOrErr() ([]*BorrowRequest, error) {
+	if e.loadedTypes[9] {
+		return e.SentBorrowRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "sent_borrow_requests"}
+}
+
+// ReceivedBorrowRequestsOrErr returns the ReceivedBorrowRequests value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReceivedBorrowRequestsOrErr() ([]*BorrowRequest, error) {
+	if e.loadedTypes[10] {
+		return e.ReceivedBorrowRequests, nil
+	}
+	return nil, &NotLoadedError{edge: "received_borrow_requests"}
+}
+
+// WishlistItemsOrErr returns the WishlistItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WishlistItemsOrErr() ([]*WishlistItem, error) {
+	if e.loadedTypes[11] {
+		return e.WishlistItems, nil
+	}
+	return nil, &NotLoadedError{edge: "wishlist_items"}
+}
+
+// LibraryMembershipsOrErr returns the LibraryMemberships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) LibraryMembershipsOrErr() ([]*LibraryMember, error) {
+	if e.loadedTypes[12] {
+		return e.LibraryMemberships, nil
+	}
+	return nil, &NotLoadedError{edge: "library_memberships"}
+}
+
+// SentLibraryInvitationsOrErr returns the SentLibraryInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SentLibraryInvitationsOrErr() ([]*LibraryInvitation, error) {
+	if e.loadedTypes[13] {
+		return e.SentLibraryInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "sent_library_invitations"}
+}
+
+// ReceivedLibraryInvitationsOrErr returns the ReceivedLibraryInvitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReceivedLibraryInvitationsOrErr() ([]*LibraryInvitation, error) {
+	if e.loadedTypes[14] {
+		return e.ReceivedLibraryInvitations, nil
+	}
+	return nil, &NotLoadedError{edge: "received_library_invitations"}
+}
+
+// ReadingGoalsOrErr returns the ReadingGoals value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ReadingGoalsOrErr() ([]*ReadingGoal, error) {
+	if e.loadedTypes[15] {
+		return e.ReadingGoals, nil
+	}
+	return nil, &NotLoadedError{edge: "reading_goals"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -102,7 +236,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldIsPublished, user.FieldIsTermsAgreed, user.FieldIsPrivacyAgreed:
 			values[i] = new(sql.NullBool)
-		case user.FieldNickName, user.FieldEmail, user.FieldPassword, user.FieldFcmToken, user.FieldTimezone:
+		case user.FieldNickName, user.FieldEmail, user.FieldPassword, user.FieldFcmToken, user.FieldTimezone, user.FieldWishlistShareToken:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -177,6 +311,13 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Timezone = value.String
 			}
+		case user.FieldWishlistShareToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field wishlist_share_token", values[i])
+			} else if value.Valid {
+				_m.WishlistShareToken = new(string)
+				*_m.WishlistShareToken = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -222,6 +363,66 @@ func (_m *User) QueryReadingReminders() *ReadingReminderQuery {
 	return NewUserClient(_m.config).QueryReadingReminders(_m)
 }
 
+// QueryReadingSessions queries the "reading_sessions" edge of the User entity.
+func (_m *User) QueryReadingSessions() *ReadingSessionQuery {
+	return NewUserClient(_m.config).QueryReadingSessions(_m)
+}
+
+// QueryShelves queries the "shelves" edge of the User entity.
+func (_m *User) QueryShelves() *ShelfQuery {
+	return NewUserClient(_m.config).QueryShelves(_m)
+}
+
+// QueryTags queries the "tags" edge of the User entity.
+func (_m *User) QueryTags() *TagQuery {
+	return NewUserClient(_m.config).QueryTags(_m)
+}
+
+// QueryBookNotes queries the "book_notes" edge of the User entity.
+func (_m *User) QueryBookNotes() *BookNoteQuery {
+	return NewUserClient(_m.config).QueryBookNotes(_m)
+}
+
+// QueryLoans queries the "loans" edge of the User entity.
+func (_m *User) QueryLoans() *LoanQuery {
+	return NewUserClient(_m.config).QueryLoans(_m)
+}
+
+// QuerySentBorrowRequests queries the "sent_borrow_requests" edge of the User entity.
+func (_m *User) QuerySentBorrowRequests() *BorrowRequestQuery {
+	return NewUserClient(_m.config).QuerySentBorrowRequests(_m)
+}
+
+// QueryReceivedBorrowRequests queries the "received_borrow_requests" edge of the User entity.
+func (_m *User) QueryReceivedBorrowRequests() *BorrowRequestQuery {
+	return NewUserClient(_m.config).QueryReceivedBorrowRequests(_m)
+}
+
+// QueryWishlistItems queries the "wishlist_items" edge of the User entity.
+func (_m *User) QueryWishlistItems() *WishlistItemQuery {
+	return NewUserClient(_m.config).QueryWishlistItems(_m)
+}
+
+// QueryLibraryMemberships queries the "library_memberships" edge of the User entity.
+func (_m *User) QueryLibraryMemberships() *LibraryMemberQuery {
+	return NewUserClient(_m.config).QueryLibraryMemberships(_m)
+}
+
+// QuerySentLibraryInvitations queries the "sent_library_invitations" edge of the User entity.
+func (_m *User) QuerySentLibraryInvitations() *LibraryInvitationQuery {
+	return NewUserClient(_m.config).QuerySentLibraryInvitations(_m)
+}
+
+// QueryReceivedLibraryInvitations queries the "received_library_invitations" edge of the User entity.
+func (_m *User) QueryReceivedLibraryInvitations() *LibraryInvitationQuery {
+	return NewUserClient(_m.config).QueryReceivedLibraryInvitations(_m)
+}
+
+// QueryReadingGoals queries the "reading_goals" edge of the User entity.
+func (_m *User) QueryReadingGoals() *ReadingGoalQuery {
+	return NewUserClient(_m.config).QueryReadingGoals(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -269,6 +470,11 @@ func (_m *User) String() string {
 	builder.WriteString("timezone=")
 	builder.WriteString(_m.Timezone)
 	builder.WriteString(", ")
+	if v := _m.WishlistShareToken; v != nil {
+		builder.WriteString("wishlist_share_token=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
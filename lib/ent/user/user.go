@@ -30,6 +30,8 @@ const (
 	FieldFcmToken = "fcm_token"
 	// FieldTimezone holds the string denoting the timezone field in the database.
 	FieldTimezone = "timezone"
+	// FieldWishlistShareToken holds the string denoting the wishlist_share_token field in the database.
+	FieldWishlistShareToken = "wishlist_share_token"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -42,6 +44,30 @@ const (
 	EdgeBookmarks = "bookmarks"
 	// EdgeReadingReminders holds the string denoting the reading_reminders edge name in mutations.
 	EdgeReadingReminders = "reading_reminders"
+	// EdgeReadingSessions holds the string denoting the reading_sessions edge name in mutations.
+	EdgeReadingSessions = "reading_sessions"
+	// EdgeShelves holds the string denoting the shelves edge name in mutations.
+	EdgeShelves = "shelves"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeBookNotes holds the string denoting the book_notes edge name in mutations.
+	EdgeBookNotes = "book_notes"
+	// EdgeLoans holds the string denoting the loans edge name in mutations.
+	EdgeLoans = "loans"
+	// EdgeSentBorrowRequests holds the string denoting the sent_borrow_requests edge name in mutations.
+	EdgeSentBorrowRequests = "sent_borrow_requests"
+	// EdgeReceivedBorrowRequests holds the string denoting the received_borrow_requests edge name in mutations.
+	EdgeReceivedBorrowRequests = "received_borrow_requests"
+	// EdgeWishlistItems holds the string denoting the wishlist_items edge name in mutations.
+	EdgeWishlistItems = "wishlist_items"
+	// EdgeLibraryMemberships holds the string denoting the library_memberships edge name in mutations.
+	EdgeLibraryMemberships = "library_memberships"
+	// EdgeSentLibraryInvitations holds the string denoting the sent_library_invitations edge name in mutations.
+	EdgeSentLibraryInvitations = "sent_library_invitations"
+	// EdgeReceivedLibraryInvitations holds the string denoting the received_library_invitations edge name in mutations.
+	EdgeReceivedLibraryInvitations = "received_library_invitations"
+	// EdgeReadingGoals holds the string denoting the reading_goals edge name in mutations.
+	EdgeReadingGoals = "reading_goals"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// BooksTable is the table that holds the books relation/edge.
@@ -72,6 +98,90 @@ const (
 	ReadingRemindersInverseTable = "reading_reminders"
 	// ReadingRemindersColumn is the table column denoting the reading_reminders relation/edge.
 	ReadingRemindersColumn = "user_reading_reminders"
+	// ReadingSessionsTable is the table that holds the reading_sessions relation/edge.
+	ReadingSessionsTable = "reading_sessions"
+	// ReadingSessionsInverseTable is the table name for the ReadingSession entity.
+	// It exists in this package in order to avoid circular dependency with the "readingsession" package.
+	ReadingSessionsInverseTable = "reading_sessions"
+	// ReadingSessionsColumn is the table column denoting the reading_sessions relation/edge.
+	ReadingSessionsColumn = "user_reading_sessions"
+	// ShelvesTable is the table that holds the shelves relation/edge.
+	ShelvesTable = "shelves"
+	// ShelvesInverseTable is the table name for the Shelf entity.
+	// It exists in this package in order to avoid circular dependency with the "shelf" package.
+	ShelvesInverseTable = "shelves"
+	// ShelvesColumn is the table column denoting the shelves relation/edge.
+	ShelvesColumn = "user_shelves"
+	// TagsTable is the table that holds the tags relation/edge.
+	TagsTable = "tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// TagsColumn is the table column denoting the tags relation/edge.
+	TagsColumn = "user_tags"
+	// BookNotesTable is the table that holds the book_notes relation/edge.
+	BookNotesTable = "book_notes"
+	// BookNotesInverseTable is the table name for the BookNote entity.
+	// It exists in this package in order to avoid circular dependency with the "booknote" package.
+	BookNotesInverseTable = "book_notes"
+	// BookNotesColumn is the table column denoting the book_notes relation/edge.
+	BookNotesColumn = "user_book_notes"
+	// LoansTable is the table that holds the loans relation/edge.
+	LoansTable = "loans"
+	// LoansInverseTable is the table name for the Loan entity.
+	// It exists in this package in order to avoid circular dependency with the "loan" package.
+	LoansInverseTable = "loans"
+	// LoansColumn is the table column denoting the loans relation/edge.
+	LoansColumn = "user_loans"
+	// SentBorrowRequestsTable is the table that holds the sent_borrow_requests relation/edge.
+	SentBorrowRequestsTable = "borrow_requests"
+	// SentBorrowRequestsInverseTable is the table name for the BorrowRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "borrowrequest" package.
+	SentBorrowRequestsInverseTable = "borrow_requests"
+	// SentBorrowRequestsColumn is the table column denoting the sent_borrow_requests relation/edge.
+	SentBorrowRequestsColumn = "user_sent_borrow_requests"
+	// ReceivedBorrowRequestsTable is the table that holds the received_borrow_requests relation/edge.
+	ReceivedBorrowRequestsTable = "borrow_requests"
+	// ReceivedBorrowRequestsInverseTable is the table name for the BorrowRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "borrowrequest" package.
+	ReceivedBorrowRequestsInverseTable = "borrow_requests"
+	// ReceivedBorrowRequestsColumn is the table column denoting the received_borrow_requests relation/edge.
+	ReceivedBorrowRequestsColumn = "user_received_borrow_requests"
+	// WishlistItemsTable is the table that holds the wishlist_items relation/edge.
+	WishlistItemsTable = "wishlist_items"
+	// WishlistItemsInverseTable is the table name for the WishlistItem entity.
+	// It exists in this package in order to avoid circular dependency with the "wishlistitem" package.
+	WishlistItemsInverseTable = "wishlist_items"
+	// WishlistItemsColumn is the table column denoting the wishlist_items relation/edge.
+	WishlistItemsColumn = "user_wishlist_items"
+	// LibraryMembershipsTable is the table that holds the library_memberships relation/edge.
+	LibraryMembershipsTable = "library_members"
+	// LibraryMembershipsInverseTable is the table name for the LibraryMember entity.
+	// It exists in this package in order to avoid circular dependency with the "librarymember" package.
+	LibraryMembershipsInverseTable = "library_members"
+	// LibraryMembershipsColumn is the table column denoting the library_memberships relation/edge.
+	LibraryMembershipsColumn = "user_library_memberships"
+	// SentLibraryInvitationsTable is the table that holds the sent_library_invitations relation/edge.
+	SentLibraryInvitationsTable = "library_invitations"
+	// SentLibraryInvitationsInverseTable is the table name for the LibraryInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "libraryinvitation" package.
+	SentLibraryInvitationsInverseTable = "library_invitations"
+	// SentLibraryInvitationsColumn is the table column denoting the sent_library_invitations relation/edge.
+	SentLibraryInvitationsColumn = "user_sent_library_invitations"
+	// ReceivedLibraryInvitationsTable is the table that holds the received_library_invitations relation/edge.
+	ReceivedLibraryInvitationsTable = "library_invitations"
+	// ReceivedLibraryInvitationsInverseTable is the table name for the LibraryInvitation entity.
+	// It exists in this package in order to avoid circular dependency with the "libraryinvitation" package.
+	ReceivedLibraryInvitationsInverseTable = "library_invitations"
+	// ReceivedLibraryInvitationsColumn is the table column denoting the received_library_invitations relation/edge.
+	ReceivedLibraryInvitationsColumn = "user_received_library_invitations"
+	// ReadingGoalsTable is the table that holds the reading_goals relation/edge.
+	ReadingGoalsTable = "reading_goals"
+	// ReadingGoalsInverseTable is the table name for the ReadingGoal entity.
+	// It exists in this package in order to avoid circular dependency with the "readinggoal" package.
+	ReadingGoalsInverseTable = "reading_goals"
+	// ReadingGoalsColumn is the table column denoting the reading_goals relation/edge.
+	ReadingGoalsColumn = "user_reading_goals"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -85,6 +195,7 @@ var Columns = []string{
 	FieldIsPrivacyAgreed,
 	FieldFcmToken,
 	FieldTimezone,
+	FieldWishlistShareToken,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -166,6 +277,11 @@ func ByTimezone(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimezone, opts...).ToFunc()
 }
 
+// ByWishlistShareToken orders the results by the wishlist_share_token field.
+func ByWishlistShareToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWishlistShareToken, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -231,6 +347,174 @@ func ByReadingReminders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newReadingRemindersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByReadingSessionsCount orders the results by reading_sessions count.
+func ByReadingSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReadingSessionsStep(), opts...)
+	}
+}
+
+// ByReadingSessions orders the results by reading_sessions terms.
+func ByReadingSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReadingSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShelvesCount orders the results by shelves count.
+func ByShelvesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShelvesStep(), opts...)
+	}
+}
+
+// ByShelves orders the results by shelves terms.
+func ByShelves(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShelvesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBookNotesCount orders the results by book_notes count.
+func ByBookNotesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBookNotesStep(), opts...)
+	}
+}
+
+// ByBookNotes orders the results by book_notes terms.
+func ByBookNotes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBookNotesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLoansCount orders the results by loans count.
+func ByLoansCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLoansStep(), opts...)
+	}
+}
+
+// ByLoans orders the results by loans terms.
+func ByLoans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLoansStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySentBorrowRequestsCount orders the results by sent_borrow_requests count.
+func BySentBorrowRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSentBorrowRequestsStep(), opts...)
+	}
+}
+
+// BySentBorrowRequests orders the results by sent_borrow_requests terms.
+func BySentBorrowRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSentBorrowRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceivedBorrowRequestsCount orders the results by received_borrow_requests count.
+func ByReceivedBorrowRequestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceivedBorrowRequestsStep(), opts...)
+	}
+}
+
+// ByReceivedBorrowRequests orders the results by received_borrow_requests terms.
+func ByReceivedBorrowRequests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceivedBorrowRequestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWishlistItemsCount orders the results by wishlist_items count.
+func ByWishlistItemsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWishlistItemsStep(), opts...)
+	}
+}
+
+// ByWishlistItems orders the results by wishlist_items terms.
+func ByWishlistItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWishlistItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLibraryMembershipsCount orders the results by library_memberships count.
+func ByLibraryMembershipsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLibraryMembershipsStep(), opts...)
+	}
+}
+
+// ByLibraryMemberships orders the results by library_memberships terms.
+func ByLibraryMemberships(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLibraryMembershipsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySentLibraryInvitationsCount orders the results by sent_library_invitations count.
+func BySentLibraryInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSentLibraryInvitationsStep(), opts...)
+	}
+}
+
+// BySentLibraryInvitations orders the results by sent_library_invitations terms.
+func BySentLibraryInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSentLibraryInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReceivedLibraryInvitationsCount orders the results by received_library_invitations count.
+func ByReceivedLibraryInvitationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReceivedLibraryInvitationsStep(), opts...)
+	}
+}
+
+// ByReceivedLibraryInvitations orders the results by received_library_invitations terms.
+func ByReceivedLibraryInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReceivedLibraryInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReadingGoalsCount orders the results by reading_goals count.
+func ByReadingGoalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReadingGoalsStep(), opts...)
+	}
+}
+
+// ByReadingGoals orders the results by reading_goals terms.
+func ByReadingGoals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReadingGoalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newBooksStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -259,3 +543,87 @@ func newReadingRemindersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ReadingRemindersTable, ReadingRemindersColumn),
 	)
 }
+func newReadingSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReadingSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReadingSessionsTable, ReadingSessionsColumn),
+	)
+}
+func newShelvesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShelvesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShelvesTable, ShelvesColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+	)
+}
+func newBookNotesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BookNotesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BookNotesTable, BookNotesColumn),
+	)
+}
+func newLoansStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LoansInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LoansTable, LoansColumn),
+	)
+}
+func newSentBorrowRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SentBorrowRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SentBorrowRequestsTable, SentBorrowRequestsColumn),
+	)
+}
+func newReceivedBorrowRequestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceivedBorrowRequestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceivedBorrowRequestsTable, ReceivedBorrowRequestsColumn),
+	)
+}
+func newWishlistItemsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WishlistItemsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WishlistItemsTable, WishlistItemsColumn),
+	)
+}
+func newLibraryMembershipsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LibraryMembershipsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, LibraryMembershipsTable, LibraryMembershipsColumn),
+	)
+}
+func newSentLibraryInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SentLibraryInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SentLibraryInvitationsTable, SentLibraryInvitationsColumn),
+	)
+}
+func newReceivedLibraryInvitationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReceivedLibraryInvitationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReceivedLibraryInvitationsTable, ReceivedLibraryInvitationsColumn),
+	)
+}
+func newReadingGoalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReadingGoalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReadingGoalsTable, ReadingGoalsColumn),
+	)
+}
@@ -96,6 +96,11 @@ func Timezone(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldTimezone, v))
 }
 
+// WishlistShareToken applies equality check predicate on the "wishlist_share_token" field. It's identical to WishlistShareTokenEQ.
+func WishlistShareToken(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldWishlistShareToken, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -481,6 +486,81 @@ func TimezoneContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldTimezone, v))
 }
 
+// WishlistShareTokenEQ applies the EQ predicate on the "wishlist_share_token" field.
+func WishlistShareTokenEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenNEQ applies the NEQ predicate on the "wishlist_share_token" field.
+func WishlistShareTokenNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenIn applies the In predicate on the "wishlist_share_token" field.
+func WishlistShareTokenIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldWishlistShareToken, vs...))
+}
+
+// WishlistShareTokenNotIn applies the NotIn predicate on the "wishlist_share_token" field.
+func WishlistShareTokenNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldWishlistShareToken, vs...))
+}
+
+// WishlistShareTokenGT applies the GT predicate on the "wishlist_share_token" field.
+func WishlistShareTokenGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenGTE applies the GTE predicate on the "wishlist_share_token" field.
+func WishlistShareTokenGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenLT applies the LT predicate on the "wishlist_share_token" field.
+func WishlistShareTokenLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenLTE applies the LTE predicate on the "wishlist_share_token" field.
+func WishlistShareTokenLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenContains applies the Contains predicate on the "wishlist_share_token" field.
+func WishlistShareTokenContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenHasPrefix applies the HasPrefix predicate on the "wishlist_share_token" field.
+func WishlistShareTokenHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenHasSuffix applies the HasSuffix predicate on the "wishlist_share_token" field.
+func WishlistShareTokenHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenIsNil applies the IsNil predicate on the "wishlist_share_token" field.
+func WishlistShareTokenIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldWishlistShareToken))
+}
+
+// WishlistShareTokenNotNil applies the NotNil predicate on the "wishlist_share_token" field.
+func WishlistShareTokenNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldWishlistShareToken))
+}
+
+// WishlistShareTokenEqualFold applies the EqualFold predicate on the "wishlist_share_token" field.
+func WishlistShareTokenEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldWishlistShareToken, v))
+}
+
+// WishlistShareTokenContainsFold applies the ContainsFold predicate on the "wishlist_share_token" field.
+func WishlistShareTokenContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldWishlistShareToken, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -653,6 +733,282 @@ func HasReadingRemindersWith(preds ...predicate.ReadingReminder) predicate.User
 	})
 }
 
+// HasReadingSessions applies the HasEdge predicate on the "reading_sessions" edge.
+func HasReadingSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReadingSessionsTable, ReadingSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReadingSessionsWith applies the HasEdge predicate on the "reading_sessions" edge with a given conditions (other predicates).
+func HasReadingSessionsWith(preds ...predicate.ReadingSession) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newReadingSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShelves applies the HasEdge predicate on the "shelves" edge.
+func HasShelves() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShelvesTable, ShelvesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShelvesWith applies the HasEdge predicate on the "shelves" edge with a given conditions (other predicates).
+func HasShelvesWith(preds ...predicate.Shelf) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newShelvesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBookNotes applies the HasEdge predicate on the "book_notes" edge.
+func HasBookNotes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BookNotesTable, BookNotesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBookNotesWith applies the HasEdge predicate on the "book_notes" edge with a given conditions (other predicates).
+func HasBookNotesWith(preds ...predicate.BookNote) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBookNotesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLoans applies the HasEdge predicate on the "loans" edge.
+func HasLoans() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LoansTable, LoansColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLoansWith applies the HasEdge predicate on the "loans" edge with a given conditions (other predicates).
+func HasLoansWith(preds ...predicate.Loan) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLoansStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSentBorrowRequests applies the HasEdge predicate on the "sent_borrow_requests" edge.
+func HasSentBorrowRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SentBorrowRequestsTable, SentBorrowRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSentBorrowRequestsWith applies the HasEdge predicate on the "sent_borrow_requests" edge with a given conditions (other predicates).
+func HasSentBorrowRequestsWith(preds ...predicate.BorrowRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSentBorrowRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceivedBorrowRequests applies the HasEdge predicate on the "received_borrow_requests" edge.
+func HasReceivedBorrowRequests() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceivedBorrowRequestsTable, ReceivedBorrowRequestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceivedBorrowRequestsWith applies the HasEdge predicate on the "received_borrow_requests" edge with a given conditions (other predicates).
+func HasReceivedBorrowRequestsWith(preds ...predicate.BorrowRequest) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newReceivedBorrowRequestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWishlistItems applies the HasEdge predicate on the "wishlist_items" edge.
+func HasWishlistItems() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WishlistItemsTable, WishlistItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWishlistItemsWith applies the HasEdge predicate on the "wishlist_items" edge with a given conditions (other predicates).
+func HasWishlistItemsWith(preds ...predicate.WishlistItem) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newWishlistItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLibraryMemberships applies the HasEdge predicate on the "library_memberships" edge.
+func HasLibraryMemberships() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, LibraryMembershipsTable, LibraryMembershipsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLibraryMembershipsWith applies the HasEdge predicate on the "library_memberships" edge with a given conditions (other predicates).
+func HasLibraryMembershipsWith(preds ...predicate.LibraryMember) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newLibraryMembershipsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSentLibraryInvitations applies the HasEdge predicate on the "sent_library_invitations" edge.
+func HasSentLibraryInvitations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SentLibraryInvitationsTable, SentLibraryInvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSentLibraryInvitationsWith applies the HasEdge predicate on the "sent_library_invitations" edge with a given conditions (other predicates).
+func HasSentLibraryInvitationsWith(preds ...predicate.LibraryInvitation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSentLibraryInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReceivedLibraryInvitations applies the HasEdge predicate on the "received_library_invitations" edge.
+func HasReceivedLibraryInvitations() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReceivedLibraryInvitationsTable, ReceivedLibraryInvitationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReceivedLibraryInvitationsWith applies the HasEdge predicate on the "received_library_invitations" edge with a given conditions (other predicates).
+func HasReceivedLibraryInvitationsWith(preds ...predicate.LibraryInvitation) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newReceivedLibraryInvitationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReadingGoals applies the HasEdge predicate on the "reading_goals" edge.
+func HasReadingGoals() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReadingGoalsTable, ReadingGoalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReadingGoalsWith applies the HasEdge predicate on the "reading_goals" edge with a given conditions (other predicates).
+func HasReadingGoalsWith(preds ...predicate.ReadingGoal) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newReadingGoalsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
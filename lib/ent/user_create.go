@@ -12,9 +12,19 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingreminder"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
 	"github.com/google/uuid"
 )
 
@@ -121,6 +131,20 @@ func (_c *UserCreate) SetNillableTimezone(v *string) *UserCreate {
 	return _c
 }
 
+// SetWishlistShareToken sets the "wishlist_share_token" field.
+func (_c *UserCreate) SetWishlistShareToken(v string) *UserCreate {
+	_c.mutation.SetWishlistShareToken(v)
+	return _c
+}
+
+// SetNillableWishlistShareToken sets the "wishlist_share_token" field if the given value is not nil.
+func (_c *UserCreate) SetNillableWishlistShareToken(v *string) *UserCreate {
+	if v != nil {
+		_c.SetWishlistShareToken(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -215,6 +239,186 @@ func (_c *UserCreate) AddReadingReminders(v ...*ReadingReminder) *UserCreate {
 	return _c.AddReadingReminderIDs(ids...)
 }
 
+// AddReadingSessionIDs adds the "reading_sessions" edge to the ReadingSession entity by IDs.
+func (_c *UserCreate) AddReadingSessionIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddReadingSessionIDs(ids...)
+	return _c
+}
+
+// AddReadingSessions adds the "reading_sessions" edges to the ReadingSession entity.
+func (_c *UserCreate) AddReadingSessions(v ...*ReadingSession) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReadingSessionIDs(ids...)
+}
+
+// AddShelfIDs adds the "shelves" edge to the Shelf entity by IDs.
+func (_c *UserCreate) AddShelfIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddShelfIDs(ids...)
+	return _c
+}
+
+// AddShelves adds the "shelves" edges to the Shelf entity.
+func (_c *UserCreate) AddShelves(v ...*Shelf) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShelfIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *UserCreate) AddTagIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *UserCreate) AddTags(v ...*Tag) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddBookNoteIDs adds the "book_notes" edge to the BookNote entity by IDs.
+func (_c *UserCreate) AddBookNoteIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddBookNoteIDs(ids...)
+	return _c
+}
+
+// AddBookNotes adds the "book_notes" edges to the BookNote entity.
+func (_c *UserCreate) AddBookNotes(v ...*BookNote) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBookNoteIDs(ids...)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (_c *UserCreate) AddLoanIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddLoanIDs(ids...)
+	return _c
+}
+
+// AddLoans adds the "loans" edges to the Loan entity.
+func (_c *UserCreate) AddLoans(v ...*Loan) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLoanIDs(ids...)
+}
+
+// AddSentBorrowRequestIDs adds the "sent_borrow_requests" edge to the BorrowRequest entity by IDs.
+func (_c *UserCreate) AddSentBorrowRequestIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddSentBorrowRequestIDs(ids...)
+	return _c
+}
+
+// AddSentBorrowRequests adds the "sent_borrow_requests" edges to the BorrowRequest entity.
+func (_c *UserCreate) AddSentBorrowRequests(v ...*BorrowRequest) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSentBorrowRequestIDs(ids...)
+}
+
+// AddReceivedBorrowRequestIDs adds the "received_borrow_requests" edge to the BorrowRequest entity by IDs.
+func (_c *UserCreate) AddReceivedBorrowRequestIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddReceivedBorrowRequestIDs(ids...)
+	return _c
+}
+
+// AddReceivedBorrowRequests adds the "received_borrow_requests" edges to the BorrowRequest entity.
+func (_c *UserCreate) AddReceivedBorrowRequests(v ...*BorrowRequest) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReceivedBorrowRequestIDs(ids...)
+}
+
+// AddWishlistItemIDs adds the "wishlist_items" edge to the WishlistItem entity by IDs.
+func (_c *UserCreate) AddWishlistItemIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddWishlistItemIDs(ids...)
+	return _c
+}
+
+// AddWishlistItems adds the "wishlist_items" edges to the WishlistItem entity.
+func (_c *UserCreate) AddWishlistItems(v ...*WishlistItem) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWishlistItemIDs(ids...)
+}
+
+// AddLibraryMembershipIDs adds the "library_memberships" edge to the LibraryMember entity by IDs.
+func (_c *UserCreate) AddLibraryMembershipIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddLibraryMembershipIDs(ids...)
+	return _c
+}
+
+// AddLibraryMemberships adds the "library_memberships" edges to the LibraryMember entity.
+func (_c *UserCreate) AddLibraryMemberships(v ...*LibraryMember) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddLibraryMembershipIDs(ids...)
+}
+
+// AddSentLibraryInvitationIDs adds the "sent_library_invitations" edge to the LibraryInvitation entity by IDs.
+func (_c *UserCreate) AddSentLibraryInvitationIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddSentLibraryInvitationIDs(ids...)
+	return _c
+}
+
+// AddSentLibraryInvitations adds the "sent_library_invitations" edges to the LibraryInvitation entity.
+func (_c *UserCreate) AddSentLibraryInvitations(v ...*LibraryInvitation) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSentLibraryInvitationIDs(ids...)
+}
+
+// AddReceivedLibraryInvitationIDs adds the "received_library_invitations" edge to the LibraryInvitation entity by IDs.
+func (_c *UserCreate) AddReceivedLibraryInvitationIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddReceivedLibraryInvitationIDs(ids...)
+	return _c
+}
+
+// AddReceivedLibraryInvitations adds the "received_library_invitations" edges to the LibraryInvitation entity.
+func (_c *UserCreate) AddReceivedLibraryInvitations(v ...*LibraryInvitation) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReceivedLibraryInvitationIDs(ids...)
+}
+
+// AddReadingGoalIDs adds the "reading_goals" edge to the ReadingGoal entity by IDs.
+func (_c *UserCreate) AddReadingGoalIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddReadingGoalIDs(ids...)
+	return _c
+}
+
+// AddReadingGoals adds the "reading_goals" edges to the ReadingGoal entity.
+func (_c *UserCreate) AddReadingGoals(v ...*ReadingGoal) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddReadingGoalIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -379,6 +583,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldTimezone, field.TypeString, value)
 		_node.Timezone = value
 	}
+	if value, ok := _c.mutation.WishlistShareToken(); ok {
+		_spec.SetField(user.FieldWishlistShareToken, field.TypeString, value)
+		_node.WishlistShareToken = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -451,6 +659,198 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ReadingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingSessionsTable,
+			Columns: []string{user.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ShelvesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShelvesTable,
+			Columns: []string{user.ShelvesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BookNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookNotesTable,
+			Columns: []string{user.BookNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoansTable,
+			Columns: []string{user.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SentBorrowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentBorrowRequestsTable,
+			Columns: []string{user.SentBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReceivedBorrowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedBorrowRequestsTable,
+			Columns: []string{user.ReceivedBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WishlistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistItemsTable,
+			Columns: []string{user.WishlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LibraryMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LibraryMembershipsTable,
+			Columns: []string{user.LibraryMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(librarymember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SentLibraryInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentLibraryInvitationsTable,
+			Columns: []string{user.SentLibraryInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReceivedLibraryInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedLibraryInvitationsTable,
+			Columns: []string{user.ReceivedLibraryInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ReadingGoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingGoalsTable,
+			Columns: []string{user.ReadingGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -14,24 +14,46 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingreminder"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
 	"github.com/google/uuid"
 )
 
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx                  *QueryContext
-	order                []user.OrderOption
-	inters               []Interceptor
-	predicates           []predicate.User
-	withBooks            *BookQuery
-	withReviews          *ReviewQuery
-	withBookmarks        *BookmarkQuery
-	withReadingReminders *ReadingReminderQuery
+	ctx                            *QueryContext
+	order                          []user.OrderOption
+	inters                         []Interceptor
+	predicates                     []predicate.User
+	withBooks                      *BookQuery
+	withReviews                    *ReviewQuery
+	withBookmarks                  *BookmarkQuery
+	withReadingReminders           *ReadingReminderQuery
+	withReadingSessions            *ReadingSessionQuery
+	withShelves                    *ShelfQuery
+	withTags                       *TagQuery
+	withBookNotes                  *BookNoteQuery
+	withLoans                      *LoanQuery
+	withSentBorrowRequests         *BorrowRequestQuery
+	withReceivedBorrowRequests     *BorrowRequestQuery
+	withWishlistItems              *WishlistItemQuery
+	withLibraryMemberships         *LibraryMemberQuery
+	withSentLibraryInvitations     *LibraryInvitationQuery
+	withReceivedLibraryInvitations *LibraryInvitationQuery
+	withReadingGoals               *ReadingGoalQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -156,6 +178,270 @@ func (_q *UserQuery) QueryReadingReminders() *ReadingReminderQuery {
 	return query
 }
 
+// QueryReadingSessions chains the current query on the "reading_sessions" edge.
+func (_q *UserQuery) QueryReadingSessions() *ReadingSessionQuery {
+	query := (&ReadingSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(readingsession.Table, readingsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReadingSessionsTable, user.ReadingSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShelves chains the current query on the "shelves" edge.
+func (_q *UserQuery) QueryShelves() *ShelfQuery {
+	query := (&ShelfClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(shelf.Table, shelf.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShelvesTable, user.ShelvesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *UserQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.TagsTable, user.TagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBookNotes chains the current query on the "book_notes" edge.
+func (_q *UserQuery) QueryBookNotes() *BookNoteQuery {
+	query := (&BookNoteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(booknote.Table, booknote.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BookNotesTable, user.BookNotesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLoans chains the current query on the "loans" edge.
+func (_q *UserQuery) QueryLoans() *LoanQuery {
+	query := (&LoanClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(loan.Table, loan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LoansTable, user.LoansColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySentBorrowRequests chains the current query on the "sent_borrow_requests" edge.
+func (_q *UserQuery) QuerySentBorrowRequests() *BorrowRequestQuery {
+	query := (&BorrowRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(borrowrequest.Table, borrowrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentBorrowRequestsTable, user.SentBorrowRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReceivedBorrowRequests chains the current query on the "received_borrow_requests" edge.
+func (_q *UserQuery) QueryReceivedBorrowRequests() *BorrowRequestQuery {
+	query := (&BorrowRequestClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(borrowrequest.Table, borrowrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReceivedBorrowRequestsTable, user.ReceivedBorrowRequestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWishlistItems chains the current query on the "wishlist_items" edge.
+func (_q *UserQuery) QueryWishlistItems() *WishlistItemQuery {
+	query := (&WishlistItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(wishlistitem.Table, wishlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WishlistItemsTable, user.WishlistItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLibraryMemberships chains the current query on the "library_memberships" edge.
+func (_q *UserQuery) QueryLibraryMemberships() *LibraryMemberQuery {
+	query := (&LibraryMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(librarymember.Table, librarymember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LibraryMembershipsTable, user.LibraryMembershipsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySentLibraryInvitations chains the current query on the "sent_library_invitations" edge.
+func (_q *UserQuery) QuerySentLibraryInvitations() *LibraryInvitationQuery {
+	query := (&LibraryInvitationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(libraryinvitation.Table, libraryinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentLibraryInvitationsTable, user.SentLibraryInvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReceivedLibraryInvitations chains the current query on the "received_library_invitations" edge.
+func (_q *UserQuery) QueryReceivedLibraryInvitations() *LibraryInvitationQuery {
+	query := (&LibraryInvitationClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(libraryinvitation.Table, libraryinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReceivedLibraryInvitationsTable, user.ReceivedLibraryInvitationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReadingGoals chains the current query on the "reading_goals" edge.
+func (_q *UserQuery) QueryReadingGoals() *ReadingGoalQuery {
+	query := (&ReadingGoalClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(readinggoal.Table, readinggoal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ReadingGoalsTable, user.ReadingGoalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -343,15 +629,27 @@ func (_q *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:               _q.config,
-		ctx:                  _q.ctx.Clone(),
-		order:                append([]user.OrderOption{}, _q.order...),
-		inters:               append([]Interceptor{}, _q.inters...),
-		predicates:           append([]predicate.User{}, _q.predicates...),
-		withBooks:            _q.withBooks.Clone(),
-		withReviews:          _q.withReviews.Clone(),
-		withBookmarks:        _q.withBookmarks.Clone(),
-		withReadingReminders: _q.withReadingReminders.Clone(),
+		config:                         _q.config,
+		ctx:                            _q.ctx.Clone(),
+		order:                          append([]user.OrderOption{}, _q.order...),
+		inters:                         append([]Interceptor{}, _q.inters...),
+		predicates:                     append([]predicate.User{}, _q.predicates...),
+		withBooks:                      _q.withBooks.Clone(),
+		withReviews:                    _q.withReviews.Clone(),
+		withBookmarks:                  _q.withBookmarks.Clone(),
+		withReadingReminders:           _q.withReadingReminders.Clone(),
+		withReadingSessions:            _q.withReadingSessions.Clone(),
+		withShelves:                    _q.withShelves.Clone(),
+		withTags:                       _q.withTags.Clone(),
+		withBookNotes:                  _q.withBookNotes.Clone(),
+		withLoans:                      _q.withLoans.Clone(),
+		withSentBorrowRequests:         _q.withSentBorrowRequests.Clone(),
+		withReceivedBorrowRequests:     _q.withReceivedBorrowRequests.Clone(),
+		withWishlistItems:              _q.withWishlistItems.Clone(),
+		withLibraryMemberships:         _q.withLibraryMemberships.Clone(),
+		withSentLibraryInvitations:     _q.withSentLibraryInvitations.Clone(),
+		withReceivedLibraryInvitations: _q.withReceivedLibraryInvitations.Clone(),
+		withReadingGoals:               _q.withReadingGoals.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -402,52 +700,184 @@ func (_q *UserQuery) WithReadingReminders(opts ...func(*ReadingReminderQuery)) *
 	return _q
 }
 
-// GroupBy is used to group vertices by one or more fields/columns.
-// It is often used with aggregate functions, like: count, max, mean, min, sum.
-//
-// Example:
-//
-//	var v []struct {
-//		NickName string `json:"nick_name,omitempty"`
-//		Count int `json:"count,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		GroupBy(user.FieldNickName).
-//		Aggregate(ent.Count()).
-//		Scan(ctx, &v)
-func (_q *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
-	_q.ctx.Fields = append([]string{field}, fields...)
-	grbuild := &UserGroupBy{build: _q}
-	grbuild.flds = &_q.ctx.Fields
-	grbuild.label = user.Label
-	grbuild.scan = grbuild.Scan
-	return grbuild
-}
-
-// Select allows the selection one or more fields/columns for the given query,
-// instead of selecting all fields in the entity.
-//
-// Example:
-//
-//	var v []struct {
-//		NickName string `json:"nick_name,omitempty"`
-//	}
-//
-//	client.User.Query().
-//		Select(user.FieldNickName).
-//		Scan(ctx, &v)
-func (_q *UserQuery) Select(fields ...string) *UserSelect {
-	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
-	sbuild := &UserSelect{UserQuery: _q}
-	sbuild.label = user.Label
-	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
-	return sbuild
+// WithReadingSessions tells the query-builder to eager-load the nodes that are connected to
+// the "reading_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithReadingSessions(opts ...func(*ReadingSessionQuery)) *UserQuery {
+	query := (&ReadingSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReadingSessions = query
+	return _q
 }
 
-// Aggregate returns a UserSelect configured with the given aggregations.
-func (_q *UserQuery) Aggregate(fns ...AggregateFunc) *UserSelect {
-	return _q.Select().Aggregate(fns...)
+// WithShelves tells the query-builder to eager-load the nodes that are connected to
+// the "shelves" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithShelves(opts ...func(*ShelfQuery)) *UserQuery {
+	query := (&ShelfClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShelves = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithTags(opts ...func(*TagQuery)) *UserQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithBookNotes tells the query-builder to eager-load the nodes that are connected to
+// the "book_notes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithBookNotes(opts ...func(*BookNoteQuery)) *UserQuery {
+	query := (&BookNoteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBookNotes = query
+	return _q
+}
+
+// WithLoans tells the query-builder to eager-load the nodes that are connected to
+// the "loans" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLoans(opts ...func(*LoanQuery)) *UserQuery {
+	query := (&LoanClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLoans = query
+	return _q
+}
+
+// WithSentBorrowRequests tells the query-builder to eager-load the nodes that are connected to
+// the "sent_borrow_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSentBorrowRequests(opts ...func(*BorrowRequestQuery)) *UserQuery {
+	query := (&BorrowRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSentBorrowRequests = query
+	return _q
+}
+
+// WithReceivedBorrowRequests tells the query-builder to eager-load the nodes that are connected to
+// the "received_borrow_requests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithReceivedBorrowRequests(opts ...func(*BorrowRequestQuery)) *UserQuery {
+	query := (&BorrowRequestClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReceivedBorrowRequests = query
+	return _q
+}
+
+// WithWishlistItems tells the query-builder to eager-load the nodes that are connected to
+// the "wishlist_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithWishlistItems(opts ...func(*WishlistItemQuery)) *UserQuery {
+	query := (&WishlistItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWishlistItems = query
+	return _q
+}
+
+// WithLibraryMemberships tells the query-builder to eager-load the nodes that are connected to
+// the "library_memberships" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithLibraryMemberships(opts ...func(*LibraryMemberQuery)) *UserQuery {
+	query := (&LibraryMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLibraryMemberships = query
+	return _q
+}
+
+// WithSentLibraryInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "sent_library_invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSentLibraryInvitations(opts ...func(*LibraryInvitationQuery)) *UserQuery {
+	query := (&LibraryInvitationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSentLibraryInvitations = query
+	return _q
+}
+
+// WithReceivedLibraryInvitations tells the query-builder to eager-load the nodes that are connected to
+// the "received_library_invitations" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithReceivedLibraryInvitations(opts ...func(*LibraryInvitationQuery)) *UserQuery {
+	query := (&LibraryInvitationClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReceivedLibraryInvitations = query
+	return _q
+}
+
+// WithReadingGoals tells the query-builder to eager-load the nodes that are connected to
+// the "reading_goals" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithReadingGoals(opts ...func(*ReadingGoalQuery)) *UserQuery {
+	query := (&ReadingGoalClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withReadingGoals = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		NickName string `json:"nick_name,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		GroupBy(user.FieldNickName).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (_q *UserQuery) GroupBy(field string, fields ...string) *UserGroupBy {
+	_q.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &UserGroupBy{build: _q}
+	grbuild.flds = &_q.ctx.Fields
+	grbuild.label = user.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		NickName string `json:"nick_name,omitempty"`
+//	}
+//
+//	client.User.Query().
+//		Select(user.FieldNickName).
+//		Scan(ctx, &v)
+func (_q *UserQuery) Select(fields ...string) *UserSelect {
+	_q.ctx.Fields = append(_q.ctx.Fields, fields...)
+	sbuild := &UserSelect{UserQuery: _q}
+	sbuild.label = user.Label
+	sbuild.flds, sbuild.scan = &_q.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a UserSelect configured with the given aggregations.
+func (_q *UserQuery) Aggregate(fns ...AggregateFunc) *UserSelect {
+	return _q.Select().Aggregate(fns...)
 }
 
 func (_q *UserQuery) prepareQuery(ctx context.Context) error {
@@ -480,11 +910,23 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [16]bool{
 			_q.withBooks != nil,
 			_q.withReviews != nil,
 			_q.withBookmarks != nil,
 			_q.withReadingReminders != nil,
+			_q.withReadingSessions != nil,
+			_q.withShelves != nil,
+			_q.withTags != nil,
+			_q.withBookNotes != nil,
+			_q.withLoans != nil,
+			_q.withSentBorrowRequests != nil,
+			_q.withReceivedBorrowRequests != nil,
+			_q.withWishlistItems != nil,
+			_q.withLibraryMemberships != nil,
+			_q.withSentLibraryInvitations != nil,
+			_q.withReceivedLibraryInvitations != nil,
+			_q.withReadingGoals != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -533,6 +975,96 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withReadingSessions; query != nil {
+		if err := _q.loadReadingSessions(ctx, query, nodes,
+			func(n *User) { n.Edges.ReadingSessions = []*ReadingSession{} },
+			func(n *User, e *ReadingSession) { n.Edges.ReadingSessions = append(n.Edges.ReadingSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShelves; query != nil {
+		if err := _q.loadShelves(ctx, query, nodes,
+			func(n *User) { n.Edges.Shelves = []*Shelf{} },
+			func(n *User, e *Shelf) { n.Edges.Shelves = append(n.Edges.Shelves, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *User) { n.Edges.Tags = []*Tag{} },
+			func(n *User, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBookNotes; query != nil {
+		if err := _q.loadBookNotes(ctx, query, nodes,
+			func(n *User) { n.Edges.BookNotes = []*BookNote{} },
+			func(n *User, e *BookNote) { n.Edges.BookNotes = append(n.Edges.BookNotes, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLoans; query != nil {
+		if err := _q.loadLoans(ctx, query, nodes,
+			func(n *User) { n.Edges.Loans = []*Loan{} },
+			func(n *User, e *Loan) { n.Edges.Loans = append(n.Edges.Loans, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSentBorrowRequests; query != nil {
+		if err := _q.loadSentBorrowRequests(ctx, query, nodes,
+			func(n *User) { n.Edges.SentBorrowRequests = []*BorrowRequest{} },
+			func(n *User, e *BorrowRequest) { n.Edges.SentBorrowRequests = append(n.Edges.SentBorrowRequests, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReceivedBorrowRequests; query != nil {
+		if err := _q.loadReceivedBorrowRequests(ctx, query, nodes,
+			func(n *User) { n.Edges.ReceivedBorrowRequests = []*BorrowRequest{} },
+			func(n *User, e *BorrowRequest) {
+				n.Edges.ReceivedBorrowRequests = append(n.Edges.ReceivedBorrowRequests, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWishlistItems; query != nil {
+		if err := _q.loadWishlistItems(ctx, query, nodes,
+			func(n *User) { n.Edges.WishlistItems = []*WishlistItem{} },
+			func(n *User, e *WishlistItem) { n.Edges.WishlistItems = append(n.Edges.WishlistItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withLibraryMemberships; query != nil {
+		if err := _q.loadLibraryMemberships(ctx, query, nodes,
+			func(n *User) { n.Edges.LibraryMemberships = []*LibraryMember{} },
+			func(n *User, e *LibraryMember) { n.Edges.LibraryMemberships = append(n.Edges.LibraryMemberships, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSentLibraryInvitations; query != nil {
+		if err := _q.loadSentLibraryInvitations(ctx, query, nodes,
+			func(n *User) { n.Edges.SentLibraryInvitations = []*LibraryInvitation{} },
+			func(n *User, e *LibraryInvitation) {
+				n.Edges.SentLibraryInvitations = append(n.Edges.SentLibraryInvitations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReceivedLibraryInvitations; query != nil {
+		if err := _q.loadReceivedLibraryInvitations(ctx, query, nodes,
+			func(n *User) { n.Edges.ReceivedLibraryInvitations = []*LibraryInvitation{} },
+			func(n *User, e *LibraryInvitation) {
+				n.Edges.ReceivedLibraryInvitations = append(n.Edges.ReceivedLibraryInvitations, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withReadingGoals; query != nil {
+		if err := _q.loadReadingGoals(ctx, query, nodes,
+			func(n *User) { n.Edges.ReadingGoals = []*ReadingGoal{} },
+			func(n *User, e *ReadingGoal) { n.Edges.ReadingGoals = append(n.Edges.ReadingGoals, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -660,6 +1192,378 @@ func (_q *UserQuery) loadReadingReminders(ctx context.Context, query *ReadingRem
 	}
 	return nil
 }
+func (_q *UserQuery) loadReadingSessions(ctx context.Context, query *ReadingSessionQuery, nodes []*User, init func(*User), assign func(*User, *ReadingSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReadingSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ReadingSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_reading_sessions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_reading_sessions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_reading_sessions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadShelves(ctx context.Context, query *ShelfQuery, nodes []*User, init func(*User), assign func(*User, *Shelf)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Shelf(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ShelvesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_shelves
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_shelves" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_shelves" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*User, init func(*User), assign func(*User, *Tag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Tag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.TagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_tags
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_tags" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_tags" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadBookNotes(ctx context.Context, query *BookNoteQuery, nodes []*User, init func(*User), assign func(*User, *BookNote)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BookNote(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BookNotesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_book_notes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_book_notes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_book_notes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadLoans(ctx context.Context, query *LoanQuery, nodes []*User, init func(*User), assign func(*User, *Loan)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.Loan(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LoansColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_loans
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_loans" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_loans" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadSentBorrowRequests(ctx context.Context, query *BorrowRequestQuery, nodes []*User, init func(*User), assign func(*User, *BorrowRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BorrowRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SentBorrowRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_sent_borrow_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_sent_borrow_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_sent_borrow_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadReceivedBorrowRequests(ctx context.Context, query *BorrowRequestQuery, nodes []*User, init func(*User), assign func(*User, *BorrowRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.BorrowRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ReceivedBorrowRequestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_received_borrow_requests
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_received_borrow_requests" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_received_borrow_requests" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadWishlistItems(ctx context.Context, query *WishlistItemQuery, nodes []*User, init func(*User), assign func(*User, *WishlistItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.WishlistItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.WishlistItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_wishlist_items
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_wishlist_items" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_wishlist_items" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadLibraryMemberships(ctx context.Context, query *LibraryMemberQuery, nodes []*User, init func(*User), assign func(*User, *LibraryMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.LibraryMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.LibraryMembershipsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_library_memberships
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_library_memberships" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_library_memberships" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadSentLibraryInvitations(ctx context.Context, query *LibraryInvitationQuery, nodes []*User, init func(*User), assign func(*User, *LibraryInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.LibraryInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SentLibraryInvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_sent_library_invitations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_sent_library_invitations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_sent_library_invitations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadReceivedLibraryInvitations(ctx context.Context, query *LibraryInvitationQuery, nodes []*User, init func(*User), assign func(*User, *LibraryInvitation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.LibraryInvitation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ReceivedLibraryInvitationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_received_library_invitations
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_received_library_invitations" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_received_library_invitations" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadReadingGoals(ctx context.Context, query *ReadingGoalQuery, nodes []*User, init func(*User), assign func(*User, *ReadingGoal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.ReadingGoal(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ReadingGoalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_reading_goals
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_reading_goals" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_reading_goals" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -13,10 +13,20 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/book"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/bookmark"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/booknote"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/borrowrequest"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/libraryinvitation"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/librarymember"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/loan"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readinggoal"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingreminder"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/readingsession"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/review"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/shelf"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/tag"
 	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
 	"github.com/google/uuid"
 )
 
@@ -157,6 +167,26 @@ func (_u *UserUpdate) SetNillableTimezone(v *string) *UserUpdate {
 	return _u
 }
 
+// SetWishlistShareToken sets the "wishlist_share_token" field.
+func (_u *UserUpdate) SetWishlistShareToken(v string) *UserUpdate {
+	_u.mutation.SetWishlistShareToken(v)
+	return _u
+}
+
+// SetNillableWishlistShareToken sets the "wishlist_share_token" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableWishlistShareToken(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetWishlistShareToken(*v)
+	}
+	return _u
+}
+
+// ClearWishlistShareToken clears the value of the "wishlist_share_token" field.
+func (_u *UserUpdate) ClearWishlistShareToken() *UserUpdate {
+	_u.mutation.ClearWishlistShareToken()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -231,6 +261,186 @@ func (_u *UserUpdate) AddReadingReminders(v ...*ReadingReminder) *UserUpdate {
 	return _u.AddReadingReminderIDs(ids...)
 }
 
+// AddReadingSessionIDs adds the "reading_sessions" edge to the ReadingSession entity by IDs.
+func (_u *UserUpdate) AddReadingSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddReadingSessionIDs(ids...)
+	return _u
+}
+
+// AddReadingSessions adds the "reading_sessions" edges to the ReadingSession entity.
+func (_u *UserUpdate) AddReadingSessions(v ...*ReadingSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadingSessionIDs(ids...)
+}
+
+// AddShelfIDs adds the "shelves" edge to the Shelf entity by IDs.
+func (_u *UserUpdate) AddShelfIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddShelfIDs(ids...)
+	return _u
+}
+
+// AddShelves adds the "shelves" edges to the Shelf entity.
+func (_u *UserUpdate) AddShelves(v ...*Shelf) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShelfIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *UserUpdate) AddTagIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *UserUpdate) AddTags(v ...*Tag) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddBookNoteIDs adds the "book_notes" edge to the BookNote entity by IDs.
+func (_u *UserUpdate) AddBookNoteIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddBookNoteIDs(ids...)
+	return _u
+}
+
+// AddBookNotes adds the "book_notes" edges to the BookNote entity.
+func (_u *UserUpdate) AddBookNotes(v ...*BookNote) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookNoteIDs(ids...)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (_u *UserUpdate) AddLoanIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddLoanIDs(ids...)
+	return _u
+}
+
+// AddLoans adds the "loans" edges to the Loan entity.
+func (_u *UserUpdate) AddLoans(v ...*Loan) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLoanIDs(ids...)
+}
+
+// AddSentBorrowRequestIDs adds the "sent_borrow_requests" edge to the BorrowRequest entity by IDs.
+func (_u *UserUpdate) AddSentBorrowRequestIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddSentBorrowRequestIDs(ids...)
+	return _u
+}
+
+// AddSentBorrowRequests adds the "sent_borrow_requests" edges to the BorrowRequest entity.
+func (_u *UserUpdate) AddSentBorrowRequests(v ...*BorrowRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSentBorrowRequestIDs(ids...)
+}
+
+// AddReceivedBorrowRequestIDs adds the "received_borrow_requests" edge to the BorrowRequest entity by IDs.
+func (_u *UserUpdate) AddReceivedBorrowRequestIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddReceivedBorrowRequestIDs(ids...)
+	return _u
+}
+
+// AddReceivedBorrowRequests adds the "received_borrow_requests" edges to the BorrowRequest entity.
+func (_u *UserUpdate) AddReceivedBorrowRequests(v ...*BorrowRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceivedBorrowRequestIDs(ids...)
+}
+
+// AddWishlistItemIDs adds the "wishlist_items" edge to the WishlistItem entity by IDs.
+func (_u *UserUpdate) AddWishlistItemIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddWishlistItemIDs(ids...)
+	return _u
+}
+
+// AddWishlistItems adds the "wishlist_items" edges to the WishlistItem entity.
+func (_u *UserUpdate) AddWishlistItems(v ...*WishlistItem) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWishlistItemIDs(ids...)
+}
+
+// AddLibraryMembershipIDs adds the "library_memberships" edge to the LibraryMember entity by IDs.
+func (_u *UserUpdate) AddLibraryMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddLibraryMembershipIDs(ids...)
+	return _u
+}
+
+// AddLibraryMemberships adds the "library_memberships" edges to the LibraryMember entity.
+func (_u *UserUpdate) AddLibraryMemberships(v ...*LibraryMember) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLibraryMembershipIDs(ids...)
+}
+
+// AddSentLibraryInvitationIDs adds the "sent_library_invitations" edge to the LibraryInvitation entity by IDs.
+func (_u *UserUpdate) AddSentLibraryInvitationIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddSentLibraryInvitationIDs(ids...)
+	return _u
+}
+
+// AddSentLibraryInvitations adds the "sent_library_invitations" edges to the LibraryInvitation entity.
+func (_u *UserUpdate) AddSentLibraryInvitations(v ...*LibraryInvitation) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSentLibraryInvitationIDs(ids...)
+}
+
+// AddReceivedLibraryInvitationIDs adds the "received_library_invitations" edge to the LibraryInvitation entity by IDs.
+func (_u *UserUpdate) AddReceivedLibraryInvitationIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddReceivedLibraryInvitationIDs(ids...)
+	return _u
+}
+
+// AddReceivedLibraryInvitations adds the "received_library_invitations" edges to the LibraryInvitation entity.
+func (_u *UserUpdate) AddReceivedLibraryInvitations(v ...*LibraryInvitation) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceivedLibraryInvitationIDs(ids...)
+}
+
+// AddReadingGoalIDs adds the "reading_goals" edge to the ReadingGoal entity by IDs.
+func (_u *UserUpdate) AddReadingGoalIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddReadingGoalIDs(ids...)
+	return _u
+}
+
+// AddReadingGoals adds the "reading_goals" edges to the ReadingGoal entity.
+func (_u *UserUpdate) AddReadingGoals(v ...*ReadingGoal) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadingGoalIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -320,74 +530,326 @@ func (_u *UserUpdate) RemoveReadingReminders(v ...*ReadingReminder) *UserUpdate
 	return _u.RemoveReadingReminderIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearReadingSessions clears all "reading_sessions" edges to the ReadingSession entity.
+func (_u *UserUpdate) ClearReadingSessions() *UserUpdate {
+	_u.mutation.ClearReadingSessions()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *UserUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveReadingSessionIDs removes the "reading_sessions" edge to ReadingSession entities by IDs.
+func (_u *UserUpdate) RemoveReadingSessionIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveReadingSessionIDs(ids...)
+	return _u
+}
+
+// RemoveReadingSessions removes "reading_sessions" edges to ReadingSession entities.
+func (_u *UserUpdate) RemoveReadingSessions(v ...*ReadingSession) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemoveReadingSessionIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *UserUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearShelves clears all "shelves" edges to the Shelf entity.
+func (_u *UserUpdate) ClearShelves() *UserUpdate {
+	_u.mutation.ClearShelves()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *UserUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// RemoveShelfIDs removes the "shelves" edge to Shelf entities by IDs.
+func (_u *UserUpdate) RemoveShelfIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveShelfIDs(ids...)
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *UserUpdate) check() error {
-	if v, ok := _u.mutation.NickName(); ok {
-		if err := user.NickNameValidator(v); err != nil {
-			return &ValidationError{Name: "nick_name", err: fmt.Errorf(`ent: validator failed for field "User.nick_name": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
-		}
+// RemoveShelves removes "shelves" edges to Shelf entities.
+func (_u *UserUpdate) RemoveShelves(v ...*Shelf) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return nil
+	return _u.RemoveShelfIDs(ids...)
 }
 
-func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *UserUpdate) ClearTags() *UserUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *UserUpdate) RemoveTagIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *UserUpdate) RemoveTags(v ...*Tag) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearBookNotes clears all "book_notes" edges to the BookNote entity.
+func (_u *UserUpdate) ClearBookNotes() *UserUpdate {
+	_u.mutation.ClearBookNotes()
+	return _u
+}
+
+// RemoveBookNoteIDs removes the "book_notes" edge to BookNote entities by IDs.
+func (_u *UserUpdate) RemoveBookNoteIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveBookNoteIDs(ids...)
+	return _u
+}
+
+// RemoveBookNotes removes "book_notes" edges to BookNote entities.
+func (_u *UserUpdate) RemoveBookNotes(v ...*BookNote) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.NickName(); ok {
-		_spec.SetField(user.FieldNickName, field.TypeString, value)
+	return _u.RemoveBookNoteIDs(ids...)
+}
+
+// ClearLoans clears all "loans" edges to the Loan entity.
+func (_u *UserUpdate) ClearLoans() *UserUpdate {
+	_u.mutation.ClearLoans()
+	return _u
+}
+
+// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
+func (_u *UserUpdate) RemoveLoanIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveLoanIDs(ids...)
+	return _u
+}
+
+// RemoveLoans removes "loans" edges to Loan entities.
+func (_u *UserUpdate) RemoveLoans(v ...*Loan) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	return _u.RemoveLoanIDs(ids...)
+}
+
+// ClearSentBorrowRequests clears all "sent_borrow_requests" edges to the BorrowRequest entity.
+func (_u *UserUpdate) ClearSentBorrowRequests() *UserUpdate {
+	_u.mutation.ClearSentBorrowRequests()
+	return _u
+}
+
+// RemoveSentBorrowRequestIDs removes the "sent_borrow_requests" edge to BorrowRequest entities by IDs.
+func (_u *UserUpdate) RemoveSentBorrowRequestIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveSentBorrowRequestIDs(ids...)
+	return _u
+}
+
+// RemoveSentBorrowRequests removes "sent_borrow_requests" edges to BorrowRequest entities.
+func (_u *UserUpdate) RemoveSentBorrowRequests(v ...*BorrowRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.Password(); ok {
-		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	return _u.RemoveSentBorrowRequestIDs(ids...)
+}
+
+// ClearReceivedBorrowRequests clears all "received_borrow_requests" edges to the BorrowRequest entity.
+func (_u *UserUpdate) ClearReceivedBorrowRequests() *UserUpdate {
+	_u.mutation.ClearReceivedBorrowRequests()
+	return _u
+}
+
+// RemoveReceivedBorrowRequestIDs removes the "received_borrow_requests" edge to BorrowRequest entities by IDs.
+func (_u *UserUpdate) RemoveReceivedBorrowRequestIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveReceivedBorrowRequestIDs(ids...)
+	return _u
+}
+
+// RemoveReceivedBorrowRequests removes "received_borrow_requests" edges to BorrowRequest entities.
+func (_u *UserUpdate) RemoveReceivedBorrowRequests(v ...*BorrowRequest) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if _u.mutation.PasswordCleared() {
-		_spec.ClearField(user.FieldPassword, field.TypeString)
+	return _u.RemoveReceivedBorrowRequestIDs(ids...)
+}
+
+// ClearWishlistItems clears all "wishlist_items" edges to the WishlistItem entity.
+func (_u *UserUpdate) ClearWishlistItems() *UserUpdate {
+	_u.mutation.ClearWishlistItems()
+	return _u
+}
+
+// RemoveWishlistItemIDs removes the "wishlist_items" edge to WishlistItem entities by IDs.
+func (_u *UserUpdate) RemoveWishlistItemIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveWishlistItemIDs(ids...)
+	return _u
+}
+
+// RemoveWishlistItems removes "wishlist_items" edges to WishlistItem entities.
+func (_u *UserUpdate) RemoveWishlistItems(v ...*WishlistItem) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	if value, ok := _u.mutation.IsPublished(); ok {
-		_spec.SetField(user.FieldIsPublished, field.TypeBool, value)
+	return _u.RemoveWishlistItemIDs(ids...)
+}
+
+// ClearLibraryMemberships clears all "library_memberships" edges to the LibraryMember entity.
+func (_u *UserUpdate) ClearLibraryMemberships() *UserUpdate {
+	_u.mutation.ClearLibraryMemberships()
+	return _u
+}
+
+// RemoveLibraryMembershipIDs removes the "library_memberships" edge to LibraryMember entities by IDs.
+func (_u *UserUpdate) RemoveLibraryMembershipIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveLibraryMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveLibraryMemberships removes "library_memberships" edges to LibraryMember entities.
+func (_u *UserUpdate) RemoveLibraryMemberships(v ...*LibraryMember) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLibraryMembershipIDs(ids...)
+}
+
+// ClearSentLibraryInvitations clears all "sent_library_invitations" edges to the LibraryInvitation entity.
+func (_u *UserUpdate) ClearSentLibraryInvitations() *UserUpdate {
+	_u.mutation.ClearSentLibraryInvitations()
+	return _u
+}
+
+// RemoveSentLibraryInvitationIDs removes the "sent_library_invitations" edge to LibraryInvitation entities by IDs.
+func (_u *UserUpdate) RemoveSentLibraryInvitationIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveSentLibraryInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveSentLibraryInvitations removes "sent_library_invitations" edges to LibraryInvitation entities.
+func (_u *UserUpdate) RemoveSentLibraryInvitations(v ...*LibraryInvitation) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSentLibraryInvitationIDs(ids...)
+}
+
+// ClearReceivedLibraryInvitations clears all "received_library_invitations" edges to the LibraryInvitation entity.
+func (_u *UserUpdate) ClearReceivedLibraryInvitations() *UserUpdate {
+	_u.mutation.ClearReceivedLibraryInvitations()
+	return _u
+}
+
+// RemoveReceivedLibraryInvitationIDs removes the "received_library_invitations" edge to LibraryInvitation entities by IDs.
+func (_u *UserUpdate) RemoveReceivedLibraryInvitationIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveReceivedLibraryInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveReceivedLibraryInvitations removes "received_library_invitations" edges to LibraryInvitation entities.
+func (_u *UserUpdate) RemoveReceivedLibraryInvitations(v ...*LibraryInvitation) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceivedLibraryInvitationIDs(ids...)
+}
+
+// ClearReadingGoals clears all "reading_goals" edges to the ReadingGoal entity.
+func (_u *UserUpdate) ClearReadingGoals() *UserUpdate {
+	_u.mutation.ClearReadingGoals()
+	return _u
+}
+
+// RemoveReadingGoalIDs removes the "reading_goals" edge to ReadingGoal entities by IDs.
+func (_u *UserUpdate) RemoveReadingGoalIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveReadingGoalIDs(ids...)
+	return _u
+}
+
+// RemoveReadingGoals removes "reading_goals" edges to ReadingGoal entities.
+func (_u *UserUpdate) RemoveReadingGoals(v ...*ReadingGoal) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReadingGoalIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *UserUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUpdate) check() error {
+	if v, ok := _u.mutation.NickName(); ok {
+		if err := user.NickNameValidator(v); err != nil {
+			return &ValidationError{Name: "nick_name", err: fmt.Errorf(`ent: validator failed for field "User.nick_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NickName(); ok {
+		_spec.SetField(user.FieldNickName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if _u.mutation.PasswordCleared() {
+		_spec.ClearField(user.FieldPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsPublished(); ok {
+		_spec.SetField(user.FieldIsPublished, field.TypeBool, value)
 	}
 	if value, ok := _u.mutation.IsTermsAgreed(); ok {
 		_spec.SetField(user.FieldIsTermsAgreed, field.TypeBool, value)
@@ -404,6 +866,12 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Timezone(); ok {
 		_spec.SetField(user.FieldTimezone, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.WishlistShareToken(); ok {
+		_spec.SetField(user.FieldWishlistShareToken, field.TypeString, value)
+	}
+	if _u.mutation.WishlistShareTokenCleared() {
+		_spec.ClearField(user.FieldWishlistShareToken, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -587,452 +1055,1990 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.ReadingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingSessionsTable,
+			Columns: []string{user.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
 		}
-		return 0, err
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetNickName sets the "nick_name" field.
-func (_u *UserUpdateOne) SetNickName(v string) *UserUpdateOne {
-	_u.mutation.SetNickName(v)
-	return _u
-}
-
-// SetNillableNickName sets the "nick_name" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableNickName(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetNickName(*v)
+	if nodes := _u.mutation.RemovedReadingSessionsIDs(); len(nodes) > 0 && !_u.mutation.ReadingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingSessionsTable,
+			Columns: []string{user.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetEmail sets the "email" field.
-func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
-	_u.mutation.SetEmail(v)
-	return _u
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+	if nodes := _u.mutation.ReadingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingSessionsTable,
+			Columns: []string{user.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetPassword sets the "password" field.
-func (_u *UserUpdateOne) SetPassword(v string) *UserUpdateOne {
-	_u.mutation.SetPassword(v)
-	return _u
-}
-
-// SetNillablePassword sets the "password" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillablePassword(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetPassword(*v)
+	if _u.mutation.ShelvesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShelvesTable,
+			Columns: []string{user.ShelvesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearPassword clears the value of the "password" field.
-func (_u *UserUpdateOne) ClearPassword() *UserUpdateOne {
-	_u.mutation.ClearPassword()
-	return _u
-}
-
-// SetIsPublished sets the "is_published" field.
-func (_u *UserUpdateOne) SetIsPublished(v bool) *UserUpdateOne {
-	_u.mutation.SetIsPublished(v)
-	return _u
-}
-
-// SetNillableIsPublished sets the "is_published" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableIsPublished(v *bool) *UserUpdateOne {
-	if v != nil {
-		_u.SetIsPublished(*v)
+	if nodes := _u.mutation.RemovedShelvesIDs(); len(nodes) > 0 && !_u.mutation.ShelvesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShelvesTable,
+			Columns: []string{user.ShelvesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetIsTermsAgreed sets the "is_terms_agreed" field.
-func (_u *UserUpdateOne) SetIsTermsAgreed(v bool) *UserUpdateOne {
-	_u.mutation.SetIsTermsAgreed(v)
-	return _u
-}
-
-// SetNillableIsTermsAgreed sets the "is_terms_agreed" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableIsTermsAgreed(v *bool) *UserUpdateOne {
-	if v != nil {
-		_u.SetIsTermsAgreed(*v)
+	if nodes := _u.mutation.ShelvesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShelvesTable,
+			Columns: []string{user.ShelvesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetIsPrivacyAgreed sets the "is_privacy_agreed" field.
-func (_u *UserUpdateOne) SetIsPrivacyAgreed(v bool) *UserUpdateOne {
-	_u.mutation.SetIsPrivacyAgreed(v)
-	return _u
-}
-
-// SetNillableIsPrivacyAgreed sets the "is_privacy_agreed" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableIsPrivacyAgreed(v *bool) *UserUpdateOne {
-	if v != nil {
-		_u.SetIsPrivacyAgreed(*v)
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetFcmToken sets the "fcm_token" field.
-func (_u *UserUpdateOne) SetFcmToken(v string) *UserUpdateOne {
-	_u.mutation.SetFcmToken(v)
-	return _u
-}
-
-// SetNillableFcmToken sets the "fcm_token" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableFcmToken(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetFcmToken(*v)
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// ClearFcmToken clears the value of the "fcm_token" field.
-func (_u *UserUpdateOne) ClearFcmToken() *UserUpdateOne {
-	_u.mutation.ClearFcmToken()
-	return _u
-}
-
-// SetTimezone sets the "timezone" field.
-func (_u *UserUpdateOne) SetTimezone(v string) *UserUpdateOne {
-	_u.mutation.SetTimezone(v)
-	return _u
-}
-
-// SetNillableTimezone sets the "timezone" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableTimezone(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetTimezone(*v)
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
-	_u.mutation.SetUpdatedAt(v)
-	return _u
-}
-
-// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableUpdatedAt(v *time.Time) *UserUpdateOne {
-	if v != nil {
-		_u.SetUpdatedAt(*v)
+	if _u.mutation.BookNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookNotesTable,
+			Columns: []string{user.BookNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// AddBookIDs adds the "books" edge to the Book entity by IDs.
-func (_u *UserUpdateOne) AddBookIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.AddBookIDs(ids...)
-	return _u
-}
-
-// AddBooks adds the "books" edges to the Book entity.
-func (_u *UserUpdateOne) AddBooks(v ...*Book) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedBookNotesIDs(); len(nodes) > 0 && !_u.mutation.BookNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookNotesTable,
+			Columns: []string{user.BookNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddBookIDs(ids...)
-}
-
-// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
-func (_u *UserUpdateOne) AddReviewIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.AddReviewIDs(ids...)
-	return _u
-}
-
-// AddReviews adds the "reviews" edges to the Review entity.
-func (_u *UserUpdateOne) AddReviews(v ...*Review) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.BookNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookNotesTable,
+			Columns: []string{user.BookNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.AddReviewIDs(ids...)
-}
-
-// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
-func (_u *UserUpdateOne) AddBookmarkIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.AddBookmarkIDs(ids...)
-	return _u
-}
-
-// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
-func (_u *UserUpdateOne) AddBookmarks(v ...*Bookmark) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoansTable,
+			Columns: []string{user.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddBookmarkIDs(ids...)
-}
-
-// AddReadingReminderIDs adds the "reading_reminders" edge to the ReadingReminder entity by IDs.
-func (_u *UserUpdateOne) AddReadingReminderIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.AddReadingReminderIDs(ids...)
-	return _u
-}
-
-// AddReadingReminders adds the "reading_reminders" edges to the ReadingReminder entity.
-func (_u *UserUpdateOne) AddReadingReminders(v ...*ReadingReminder) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.RemovedLoansIDs(); len(nodes) > 0 && !_u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoansTable,
+			Columns: []string{user.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.AddReadingReminderIDs(ids...)
-}
-
-// Mutation returns the UserMutation object of the builder.
-func (_u *UserUpdateOne) Mutation() *UserMutation {
-	return _u.mutation
-}
-
-// ClearBooks clears all "books" edges to the Book entity.
-func (_u *UserUpdateOne) ClearBooks() *UserUpdateOne {
-	_u.mutation.ClearBooks()
-	return _u
-}
-
-// RemoveBookIDs removes the "books" edge to Book entities by IDs.
-func (_u *UserUpdateOne) RemoveBookIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveBookIDs(ids...)
-	return _u
-}
-
-// RemoveBooks removes "books" edges to Book entities.
-func (_u *UserUpdateOne) RemoveBooks(v ...*Book) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if nodes := _u.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoansTable,
+			Columns: []string{user.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return _u.RemoveBookIDs(ids...)
-}
-
-// ClearReviews clears all "reviews" edges to the Review entity.
-func (_u *UserUpdateOne) ClearReviews() *UserUpdateOne {
-	_u.mutation.ClearReviews()
-	return _u
-}
-
-// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
-func (_u *UserUpdateOne) RemoveReviewIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveReviewIDs(ids...)
-	return _u
-}
-
-// RemoveReviews removes "reviews" edges to Review entities.
-func (_u *UserUpdateOne) RemoveReviews(v ...*Review) *UserUpdateOne {
-	ids := make([]uuid.UUID, len(v))
-	for i := range v {
-		ids[i] = v[i].ID
+	if _u.mutation.SentBorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentBorrowRequestsTable,
+			Columns: []string{user.SentBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u.RemoveReviewIDs(ids...)
+	if nodes := _u.mutation.RemovedSentBorrowRequestsIDs(); len(nodes) > 0 && !_u.mutation.SentBorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentBorrowRequestsTable,
+			Columns: []string{user.SentBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SentBorrowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentBorrowRequestsTable,
+			Columns: []string{user.SentBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceivedBorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedBorrowRequestsTable,
+			Columns: []string{user.ReceivedBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceivedBorrowRequestsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedBorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedBorrowRequestsTable,
+			Columns: []string{user.ReceivedBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceivedBorrowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedBorrowRequestsTable,
+			Columns: []string{user.ReceivedBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WishlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistItemsTable,
+			Columns: []string{user.WishlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWishlistItemsIDs(); len(nodes) > 0 && !_u.mutation.WishlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistItemsTable,
+			Columns: []string{user.WishlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WishlistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistItemsTable,
+			Columns: []string{user.WishlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.LibraryMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LibraryMembershipsTable,
+			Columns: []string{user.LibraryMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(librarymember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedLibraryMembershipsIDs(); len(nodes) > 0 && !_u.mutation.LibraryMembershipsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LibraryMembershipsTable,
+			Columns: []string{user.LibraryMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(librarymember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LibraryMembershipsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LibraryMembershipsTable,
+			Columns: []string{user.LibraryMembershipsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(librarymember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SentLibraryInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentLibraryInvitationsTable,
+			Columns: []string{user.SentLibraryInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSentLibraryInvitationsIDs(); len(nodes) > 0 && !_u.mutation.SentLibraryInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentLibraryInvitationsTable,
+			Columns: []string{user.SentLibraryInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SentLibraryInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentLibraryInvitationsTable,
+			Columns: []string{user.SentLibraryInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReceivedLibraryInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedLibraryInvitationsTable,
+			Columns: []string{user.ReceivedLibraryInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReceivedLibraryInvitationsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedLibraryInvitationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedLibraryInvitationsTable,
+			Columns: []string{user.ReceivedLibraryInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReceivedLibraryInvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedLibraryInvitationsTable,
+			Columns: []string{user.ReceivedLibraryInvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReadingGoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingGoalsTable,
+			Columns: []string{user.ReadingGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReadingGoalsIDs(); len(nodes) > 0 && !_u.mutation.ReadingGoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingGoalsTable,
+			Columns: []string{user.ReadingGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReadingGoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingGoalsTable,
+			Columns: []string{user.ReadingGoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
 }
 
-// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
-func (_u *UserUpdateOne) ClearBookmarks() *UserUpdateOne {
-	_u.mutation.ClearBookmarks()
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetNickName sets the "nick_name" field.
+func (_u *UserUpdateOne) SetNickName(v string) *UserUpdateOne {
+	_u.mutation.SetNickName(v)
 	return _u
 }
 
-// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
-func (_u *UserUpdateOne) RemoveBookmarkIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveBookmarkIDs(ids...)
+// SetNillableNickName sets the "nick_name" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableNickName(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetNickName(*v)
+	}
 	return _u
 }
 
-// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
-func (_u *UserUpdateOne) RemoveBookmarks(v ...*Bookmark) *UserUpdateOne {
+// SetEmail sets the "email" field.
+func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetPassword sets the "password" field.
+func (_u *UserUpdateOne) SetPassword(v string) *UserUpdateOne {
+	_u.mutation.SetPassword(v)
+	return _u
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePassword(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPassword(*v)
+	}
+	return _u
+}
+
+// ClearPassword clears the value of the "password" field.
+func (_u *UserUpdateOne) ClearPassword() *UserUpdateOne {
+	_u.mutation.ClearPassword()
+	return _u
+}
+
+// SetIsPublished sets the "is_published" field.
+func (_u *UserUpdateOne) SetIsPublished(v bool) *UserUpdateOne {
+	_u.mutation.SetIsPublished(v)
+	return _u
+}
+
+// SetNillableIsPublished sets the "is_published" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableIsPublished(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetIsPublished(*v)
+	}
+	return _u
+}
+
+// SetIsTermsAgreed sets the "is_terms_agreed" field.
+func (_u *UserUpdateOne) SetIsTermsAgreed(v bool) *UserUpdateOne {
+	_u.mutation.SetIsTermsAgreed(v)
+	return _u
+}
+
+// SetNillableIsTermsAgreed sets the "is_terms_agreed" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableIsTermsAgreed(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetIsTermsAgreed(*v)
+	}
+	return _u
+}
+
+// SetIsPrivacyAgreed sets the "is_privacy_agreed" field.
+func (_u *UserUpdateOne) SetIsPrivacyAgreed(v bool) *UserUpdateOne {
+	_u.mutation.SetIsPrivacyAgreed(v)
+	return _u
+}
+
+// SetNillableIsPrivacyAgreed sets the "is_privacy_agreed" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableIsPrivacyAgreed(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetIsPrivacyAgreed(*v)
+	}
+	return _u
+}
+
+// SetFcmToken sets the "fcm_token" field.
+func (_u *UserUpdateOne) SetFcmToken(v string) *UserUpdateOne {
+	_u.mutation.SetFcmToken(v)
+	return _u
+}
+
+// SetNillableFcmToken sets the "fcm_token" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableFcmToken(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetFcmToken(*v)
+	}
+	return _u
+}
+
+// ClearFcmToken clears the value of the "fcm_token" field.
+func (_u *UserUpdateOne) ClearFcmToken() *UserUpdateOne {
+	_u.mutation.ClearFcmToken()
+	return _u
+}
+
+// SetTimezone sets the "timezone" field.
+func (_u *UserUpdateOne) SetTimezone(v string) *UserUpdateOne {
+	_u.mutation.SetTimezone(v)
+	return _u
+}
+
+// SetNillableTimezone sets the "timezone" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTimezone(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetTimezone(*v)
+	}
+	return _u
+}
+
+// SetWishlistShareToken sets the "wishlist_share_token" field.
+func (_u *UserUpdateOne) SetWishlistShareToken(v string) *UserUpdateOne {
+	_u.mutation.SetWishlistShareToken(v)
+	return _u
+}
+
+// SetNillableWishlistShareToken sets the "wishlist_share_token" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableWishlistShareToken(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetWishlistShareToken(*v)
+	}
+	return _u
+}
+
+// ClearWishlistShareToken clears the value of the "wishlist_share_token" field.
+func (_u *UserUpdateOne) ClearWishlistShareToken() *UserUpdateOne {
+	_u.mutation.ClearWishlistShareToken()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableUpdatedAt(v *time.Time) *UserUpdateOne {
+	if v != nil {
+		_u.SetUpdatedAt(*v)
+	}
+	return _u
+}
+
+// AddBookIDs adds the "books" edge to the Book entity by IDs.
+func (_u *UserUpdateOne) AddBookIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddBookIDs(ids...)
+	return _u
+}
+
+// AddBooks adds the "books" edges to the Book entity.
+func (_u *UserUpdateOne) AddBooks(v ...*Book) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBookmarkIDs(ids...)
+	return _u.AddBookIDs(ids...)
 }
 
-// ClearReadingReminders clears all "reading_reminders" edges to the ReadingReminder entity.
-func (_u *UserUpdateOne) ClearReadingReminders() *UserUpdateOne {
-	_u.mutation.ClearReadingReminders()
+// AddReviewIDs adds the "reviews" edge to the Review entity by IDs.
+func (_u *UserUpdateOne) AddReviewIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddReviewIDs(ids...)
 	return _u
 }
 
-// RemoveReadingReminderIDs removes the "reading_reminders" edge to ReadingReminder entities by IDs.
-func (_u *UserUpdateOne) RemoveReadingReminderIDs(ids ...uuid.UUID) *UserUpdateOne {
-	_u.mutation.RemoveReadingReminderIDs(ids...)
+// AddReviews adds the "reviews" edges to the Review entity.
+func (_u *UserUpdateOne) AddReviews(v ...*Review) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReviewIDs(ids...)
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the Bookmark entity by IDs.
+func (_u *UserUpdateOne) AddBookmarkIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddBookmarkIDs(ids...)
 	return _u
 }
 
-// RemoveReadingReminders removes "reading_reminders" edges to ReadingReminder entities.
-func (_u *UserUpdateOne) RemoveReadingReminders(v ...*ReadingReminder) *UserUpdateOne {
+// AddBookmarks adds the "bookmarks" edges to the Bookmark entity.
+func (_u *UserUpdateOne) AddBookmarks(v ...*Bookmark) *UserUpdateOne {
 	ids := make([]uuid.UUID, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveReadingReminderIDs(ids...)
+	return _u.AddBookmarkIDs(ids...)
 }
 
-// Where appends a list predicates to the UserUpdate builder.
-func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
-	_u.mutation.Where(ps...)
+// AddReadingReminderIDs adds the "reading_reminders" edge to the ReadingReminder entity by IDs.
+func (_u *UserUpdateOne) AddReadingReminderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddReadingReminderIDs(ids...)
 	return _u
 }
 
-// Select allows selecting one or more fields (columns) of the returned entity.
-// The default is selecting all fields defined in the entity schema.
-func (_u *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
-	_u.fields = append([]string{field}, fields...)
+// AddReadingReminders adds the "reading_reminders" edges to the ReadingReminder entity.
+func (_u *UserUpdateOne) AddReadingReminders(v ...*ReadingReminder) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadingReminderIDs(ids...)
+}
+
+// AddReadingSessionIDs adds the "reading_sessions" edge to the ReadingSession entity by IDs.
+func (_u *UserUpdateOne) AddReadingSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddReadingSessionIDs(ids...)
 	return _u
 }
 
-// Save executes the query and returns the updated User entity.
-func (_u *UserUpdateOne) Save(ctx context.Context) (*User, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// AddReadingSessions adds the "reading_sessions" edges to the ReadingSession entity.
+func (_u *UserUpdateOne) AddReadingSessions(v ...*ReadingSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadingSessionIDs(ids...)
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *UserUpdateOne) SaveX(ctx context.Context) *User {
-	node, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddShelfIDs adds the "shelves" edge to the Shelf entity by IDs.
+func (_u *UserUpdateOne) AddShelfIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddShelfIDs(ids...)
+	return _u
+}
+
+// AddShelves adds the "shelves" edges to the Shelf entity.
+func (_u *UserUpdateOne) AddShelves(v ...*Shelf) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return node
+	return _u.AddShelfIDs(ids...)
 }
 
-// Exec executes the query on the entity.
-func (_u *UserUpdateOne) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *UserUpdateOne) AddTagIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *UserUpdateOne) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *UserUpdateOne) AddTags(v ...*Tag) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.AddTagIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *UserUpdateOne) check() error {
-	if v, ok := _u.mutation.NickName(); ok {
-		if err := user.NickNameValidator(v); err != nil {
-			return &ValidationError{Name: "nick_name", err: fmt.Errorf(`ent: validator failed for field "User.nick_name": %w`, err)}
+// AddBookNoteIDs adds the "book_notes" edge to the BookNote entity by IDs.
+func (_u *UserUpdateOne) AddBookNoteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddBookNoteIDs(ids...)
+	return _u
+}
+
+// AddBookNotes adds the "book_notes" edges to the BookNote entity.
+func (_u *UserUpdateOne) AddBookNotes(v ...*BookNote) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBookNoteIDs(ids...)
+}
+
+// AddLoanIDs adds the "loans" edge to the Loan entity by IDs.
+func (_u *UserUpdateOne) AddLoanIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddLoanIDs(ids...)
+	return _u
+}
+
+// AddLoans adds the "loans" edges to the Loan entity.
+func (_u *UserUpdateOne) AddLoans(v ...*Loan) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLoanIDs(ids...)
+}
+
+// AddSentBorrowRequestIDs adds the "sent_borrow_requests" edge to the BorrowRequest entity by IDs.
+func (_u *UserUpdateOne) AddSentBorrowRequestIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddSentBorrowRequestIDs(ids...)
+	return _u
+}
+
+// AddSentBorrowRequests adds the "sent_borrow_requests" edges to the BorrowRequest entity.
+func (_u *UserUpdateOne) AddSentBorrowRequests(v ...*BorrowRequest) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSentBorrowRequestIDs(ids...)
+}
+
+// AddReceivedBorrowRequestIDs adds the "received_borrow_requests" edge to the BorrowRequest entity by IDs.
+func (_u *UserUpdateOne) AddReceivedBorrowRequestIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddReceivedBorrowRequestIDs(ids...)
+	return _u
+}
+
+// AddReceivedBorrowRequests adds the "received_borrow_requests" edges to the BorrowRequest entity.
+func (_u *UserUpdateOne) AddReceivedBorrowRequests(v ...*BorrowRequest) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceivedBorrowRequestIDs(ids...)
+}
+
+// AddWishlistItemIDs adds the "wishlist_items" edge to the WishlistItem entity by IDs.
+func (_u *UserUpdateOne) AddWishlistItemIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddWishlistItemIDs(ids...)
+	return _u
+}
+
+// AddWishlistItems adds the "wishlist_items" edges to the WishlistItem entity.
+func (_u *UserUpdateOne) AddWishlistItems(v ...*WishlistItem) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWishlistItemIDs(ids...)
+}
+
+// AddLibraryMembershipIDs adds the "library_memberships" edge to the LibraryMember entity by IDs.
+func (_u *UserUpdateOne) AddLibraryMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddLibraryMembershipIDs(ids...)
+	return _u
+}
+
+// AddLibraryMemberships adds the "library_memberships" edges to the LibraryMember entity.
+func (_u *UserUpdateOne) AddLibraryMemberships(v ...*LibraryMember) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddLibraryMembershipIDs(ids...)
+}
+
+// AddSentLibraryInvitationIDs adds the "sent_library_invitations" edge to the LibraryInvitation entity by IDs.
+func (_u *UserUpdateOne) AddSentLibraryInvitationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddSentLibraryInvitationIDs(ids...)
+	return _u
+}
+
+// AddSentLibraryInvitations adds the "sent_library_invitations" edges to the LibraryInvitation entity.
+func (_u *UserUpdateOne) AddSentLibraryInvitations(v ...*LibraryInvitation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSentLibraryInvitationIDs(ids...)
+}
+
+// AddReceivedLibraryInvitationIDs adds the "received_library_invitations" edge to the LibraryInvitation entity by IDs.
+func (_u *UserUpdateOne) AddReceivedLibraryInvitationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddReceivedLibraryInvitationIDs(ids...)
+	return _u
+}
+
+// AddReceivedLibraryInvitations adds the "received_library_invitations" edges to the LibraryInvitation entity.
+func (_u *UserUpdateOne) AddReceivedLibraryInvitations(v ...*LibraryInvitation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReceivedLibraryInvitationIDs(ids...)
+}
+
+// AddReadingGoalIDs adds the "reading_goals" edge to the ReadingGoal entity by IDs.
+func (_u *UserUpdateOne) AddReadingGoalIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddReadingGoalIDs(ids...)
+	return _u
+}
+
+// AddReadingGoals adds the "reading_goals" edges to the ReadingGoal entity.
+func (_u *UserUpdateOne) AddReadingGoals(v ...*ReadingGoal) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddReadingGoalIDs(ids...)
+}
+
+// Mutation returns the UserMutation object of the builder.
+func (_u *UserUpdateOne) Mutation() *UserMutation {
+	return _u.mutation
+}
+
+// ClearBooks clears all "books" edges to the Book entity.
+func (_u *UserUpdateOne) ClearBooks() *UserUpdateOne {
+	_u.mutation.ClearBooks()
+	return _u
+}
+
+// RemoveBookIDs removes the "books" edge to Book entities by IDs.
+func (_u *UserUpdateOne) RemoveBookIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveBookIDs(ids...)
+	return _u
+}
+
+// RemoveBooks removes "books" edges to Book entities.
+func (_u *UserUpdateOne) RemoveBooks(v ...*Book) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookIDs(ids...)
+}
+
+// ClearReviews clears all "reviews" edges to the Review entity.
+func (_u *UserUpdateOne) ClearReviews() *UserUpdateOne {
+	_u.mutation.ClearReviews()
+	return _u
+}
+
+// RemoveReviewIDs removes the "reviews" edge to Review entities by IDs.
+func (_u *UserUpdateOne) RemoveReviewIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveReviewIDs(ids...)
+	return _u
+}
+
+// RemoveReviews removes "reviews" edges to Review entities.
+func (_u *UserUpdateOne) RemoveReviews(v ...*Review) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReviewIDs(ids...)
+}
+
+// ClearBookmarks clears all "bookmarks" edges to the Bookmark entity.
+func (_u *UserUpdateOne) ClearBookmarks() *UserUpdateOne {
+	_u.mutation.ClearBookmarks()
+	return _u
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to Bookmark entities by IDs.
+func (_u *UserUpdateOne) RemoveBookmarkIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveBookmarkIDs(ids...)
+	return _u
+}
+
+// RemoveBookmarks removes "bookmarks" edges to Bookmark entities.
+func (_u *UserUpdateOne) RemoveBookmarks(v ...*Bookmark) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookmarkIDs(ids...)
+}
+
+// ClearReadingReminders clears all "reading_reminders" edges to the ReadingReminder entity.
+func (_u *UserUpdateOne) ClearReadingReminders() *UserUpdateOne {
+	_u.mutation.ClearReadingReminders()
+	return _u
+}
+
+// RemoveReadingReminderIDs removes the "reading_reminders" edge to ReadingReminder entities by IDs.
+func (_u *UserUpdateOne) RemoveReadingReminderIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveReadingReminderIDs(ids...)
+	return _u
+}
+
+// RemoveReadingReminders removes "reading_reminders" edges to ReadingReminder entities.
+func (_u *UserUpdateOne) RemoveReadingReminders(v ...*ReadingReminder) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReadingReminderIDs(ids...)
+}
+
+// ClearReadingSessions clears all "reading_sessions" edges to the ReadingSession entity.
+func (_u *UserUpdateOne) ClearReadingSessions() *UserUpdateOne {
+	_u.mutation.ClearReadingSessions()
+	return _u
+}
+
+// RemoveReadingSessionIDs removes the "reading_sessions" edge to ReadingSession entities by IDs.
+func (_u *UserUpdateOne) RemoveReadingSessionIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveReadingSessionIDs(ids...)
+	return _u
+}
+
+// RemoveReadingSessions removes "reading_sessions" edges to ReadingSession entities.
+func (_u *UserUpdateOne) RemoveReadingSessions(v ...*ReadingSession) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReadingSessionIDs(ids...)
+}
+
+// ClearShelves clears all "shelves" edges to the Shelf entity.
+func (_u *UserUpdateOne) ClearShelves() *UserUpdateOne {
+	_u.mutation.ClearShelves()
+	return _u
+}
+
+// RemoveShelfIDs removes the "shelves" edge to Shelf entities by IDs.
+func (_u *UserUpdateOne) RemoveShelfIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveShelfIDs(ids...)
+	return _u
+}
+
+// RemoveShelves removes "shelves" edges to Shelf entities.
+func (_u *UserUpdateOne) RemoveShelves(v ...*Shelf) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShelfIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *UserUpdateOne) ClearTags() *UserUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *UserUpdateOne) RemoveTagIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *UserUpdateOne) RemoveTags(v ...*Tag) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearBookNotes clears all "book_notes" edges to the BookNote entity.
+func (_u *UserUpdateOne) ClearBookNotes() *UserUpdateOne {
+	_u.mutation.ClearBookNotes()
+	return _u
+}
+
+// RemoveBookNoteIDs removes the "book_notes" edge to BookNote entities by IDs.
+func (_u *UserUpdateOne) RemoveBookNoteIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveBookNoteIDs(ids...)
+	return _u
+}
+
+// RemoveBookNotes removes "book_notes" edges to BookNote entities.
+func (_u *UserUpdateOne) RemoveBookNotes(v ...*BookNote) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBookNoteIDs(ids...)
+}
+
+// ClearLoans clears all "loans" edges to the Loan entity.
+func (_u *UserUpdateOne) ClearLoans() *UserUpdateOne {
+	_u.mutation.ClearLoans()
+	return _u
+}
+
+// RemoveLoanIDs removes the "loans" edge to Loan entities by IDs.
+func (_u *UserUpdateOne) RemoveLoanIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveLoanIDs(ids...)
+	return _u
+}
+
+// RemoveLoans removes "loans" edges to Loan entities.
+func (_u *UserUpdateOne) RemoveLoans(v ...*Loan) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLoanIDs(ids...)
+}
+
+// ClearSentBorrowRequests clears all "sent_borrow_requests" edges to the BorrowRequest entity.
+func (_u *UserUpdateOne) ClearSentBorrowRequests() *UserUpdateOne {
+	_u.mutation.ClearSentBorrowRequests()
+	return _u
+}
+
+// RemoveSentBorrowRequestIDs removes the "sent_borrow_requests" edge to BorrowRequest entities by IDs.
+func (_u *UserUpdateOne) RemoveSentBorrowRequestIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveSentBorrowRequestIDs(ids...)
+	return _u
+}
+
+// RemoveSentBorrowRequests removes "sent_borrow_requests" edges to BorrowRequest entities.
+func (_u *UserUpdateOne) RemoveSentBorrowRequests(v ...*BorrowRequest) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSentBorrowRequestIDs(ids...)
+}
+
+// ClearReceivedBorrowRequests clears all "received_borrow_requests" edges to the BorrowRequest entity.
+func (_u *UserUpdateOne) ClearReceivedBorrowRequests() *UserUpdateOne {
+	_u.mutation.ClearReceivedBorrowRequests()
+	return _u
+}
+
+// RemoveReceivedBorrowRequestIDs removes the "received_borrow_requests" edge to BorrowRequest entities by IDs.
+func (_u *UserUpdateOne) RemoveReceivedBorrowRequestIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveReceivedBorrowRequestIDs(ids...)
+	return _u
+}
+
+// RemoveReceivedBorrowRequests removes "received_borrow_requests" edges to BorrowRequest entities.
+func (_u *UserUpdateOne) RemoveReceivedBorrowRequests(v ...*BorrowRequest) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceivedBorrowRequestIDs(ids...)
+}
+
+// ClearWishlistItems clears all "wishlist_items" edges to the WishlistItem entity.
+func (_u *UserUpdateOne) ClearWishlistItems() *UserUpdateOne {
+	_u.mutation.ClearWishlistItems()
+	return _u
+}
+
+// RemoveWishlistItemIDs removes the "wishlist_items" edge to WishlistItem entities by IDs.
+func (_u *UserUpdateOne) RemoveWishlistItemIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveWishlistItemIDs(ids...)
+	return _u
+}
+
+// RemoveWishlistItems removes "wishlist_items" edges to WishlistItem entities.
+func (_u *UserUpdateOne) RemoveWishlistItems(v ...*WishlistItem) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWishlistItemIDs(ids...)
+}
+
+// ClearLibraryMemberships clears all "library_memberships" edges to the LibraryMember entity.
+func (_u *UserUpdateOne) ClearLibraryMemberships() *UserUpdateOne {
+	_u.mutation.ClearLibraryMemberships()
+	return _u
+}
+
+// RemoveLibraryMembershipIDs removes the "library_memberships" edge to LibraryMember entities by IDs.
+func (_u *UserUpdateOne) RemoveLibraryMembershipIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveLibraryMembershipIDs(ids...)
+	return _u
+}
+
+// RemoveLibraryMemberships removes "library_memberships" edges to LibraryMember entities.
+func (_u *UserUpdateOne) RemoveLibraryMemberships(v ...*LibraryMember) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLibraryMembershipIDs(ids...)
+}
+
+// ClearSentLibraryInvitations clears all "sent_library_invitations" edges to the LibraryInvitation entity.
+func (_u *UserUpdateOne) ClearSentLibraryInvitations() *UserUpdateOne {
+	_u.mutation.ClearSentLibraryInvitations()
+	return _u
+}
+
+// RemoveSentLibraryInvitationIDs removes the "sent_library_invitations" edge to LibraryInvitation entities by IDs.
+func (_u *UserUpdateOne) RemoveSentLibraryInvitationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveSentLibraryInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveSentLibraryInvitations removes "sent_library_invitations" edges to LibraryInvitation entities.
+func (_u *UserUpdateOne) RemoveSentLibraryInvitations(v ...*LibraryInvitation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSentLibraryInvitationIDs(ids...)
+}
+
+// ClearReceivedLibraryInvitations clears all "received_library_invitations" edges to the LibraryInvitation entity.
+func (_u *UserUpdateOne) ClearReceivedLibraryInvitations() *UserUpdateOne {
+	_u.mutation.ClearReceivedLibraryInvitations()
+	return _u
+}
+
+// RemoveReceivedLibraryInvitationIDs removes the "received_library_invitations" edge to LibraryInvitation entities by IDs.
+func (_u *UserUpdateOne) RemoveReceivedLibraryInvitationIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveReceivedLibraryInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveReceivedLibraryInvitations removes "received_library_invitations" edges to LibraryInvitation entities.
+func (_u *UserUpdateOne) RemoveReceivedLibraryInvitations(v ...*LibraryInvitation) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReceivedLibraryInvitationIDs(ids...)
+}
+
+// ClearReadingGoals clears all "reading_goals" edges to the ReadingGoal entity.
+func (_u *UserUpdateOne) ClearReadingGoals() *UserUpdateOne {
+	_u.mutation.ClearReadingGoals()
+	return _u
+}
+
+// RemoveReadingGoalIDs removes the "reading_goals" edge to ReadingGoal entities by IDs.
+func (_u *UserUpdateOne) RemoveReadingGoalIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveReadingGoalIDs(ids...)
+	return _u
+}
+
+// RemoveReadingGoals removes "reading_goals" edges to ReadingGoal entities.
+func (_u *UserUpdateOne) RemoveReadingGoals(v ...*ReadingGoal) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveReadingGoalIDs(ids...)
+}
+
+// Where appends a list predicates to the UserUpdate builder.
+func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *UserUpdateOne) Select(field string, fields ...string) *UserUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated User entity.
+func (_u *UserUpdateOne) Save(ctx context.Context) (*User, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *UserUpdateOne) SaveX(ctx context.Context) *User {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *UserUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *UserUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *UserUpdateOne) check() error {
+	if v, ok := _u.mutation.NickName(); ok {
+		if err := user.NickNameValidator(v); err != nil {
+			return &ValidationError{Name: "nick_name", err: fmt.Errorf(`ent: validator failed for field "User.nick_name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Email(); ok {
+		if err := user.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
+		for _, f := range fields {
+			if !user.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != user.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.NickName(); ok {
+		_spec.SetField(user.FieldNickName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Password(); ok {
+		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	}
+	if _u.mutation.PasswordCleared() {
+		_spec.ClearField(user.FieldPassword, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsPublished(); ok {
+		_spec.SetField(user.FieldIsPublished, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsTermsAgreed(); ok {
+		_spec.SetField(user.FieldIsTermsAgreed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.IsPrivacyAgreed(); ok {
+		_spec.SetField(user.FieldIsPrivacyAgreed, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FcmToken(); ok {
+		_spec.SetField(user.FieldFcmToken, field.TypeString, value)
+	}
+	if _u.mutation.FcmTokenCleared() {
+		_spec.ClearField(user.FieldFcmToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Timezone(); ok {
+		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.WishlistShareToken(); ok {
+		_spec.SetField(user.FieldWishlistShareToken, field.TypeString, value)
+	}
+	if _u.mutation.WishlistShareTokenCleared() {
+		_spec.ClearField(user.FieldWishlistShareToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BooksTable,
+			Columns: []string{user.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBooksIDs(); len(nodes) > 0 && !_u.mutation.BooksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BooksTable,
+			Columns: []string{user.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BooksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BooksTable,
+			Columns: []string{user.BooksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReviewsTable,
+			Columns: []string{user.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !_u.mutation.ReviewsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReviewsTable,
+			Columns: []string{user.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReviewsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReviewsTable,
+			Columns: []string{user.ReviewsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BookmarksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBookmarksIDs(); len(nodes) > 0 && !_u.mutation.BookmarksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BookmarksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookmarksTable,
+			Columns: []string{user.BookmarksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReadingRemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingRemindersTable,
+			Columns: []string{user.ReadingRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingreminder.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReadingRemindersIDs(); len(nodes) > 0 && !_u.mutation.ReadingRemindersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingRemindersTable,
+			Columns: []string{user.ReadingRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReadingRemindersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingRemindersTable,
+			Columns: []string{user.ReadingRemindersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingreminder.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ReadingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingSessionsTable,
+			Columns: []string{user.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedReadingSessionsIDs(); len(nodes) > 0 && !_u.mutation.ReadingSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingSessionsTable,
+			Columns: []string{user.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ReadingSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReadingSessionsTable,
+			Columns: []string{user.ReadingSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(readingsession.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ShelvesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShelvesTable,
+			Columns: []string{user.ShelvesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShelvesIDs(); len(nodes) > 0 && !_u.mutation.ShelvesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShelvesTable,
+			Columns: []string{user.ShelvesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ShelvesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShelvesTable,
+			Columns: []string{user.ShelvesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shelf.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.TagsTable,
+			Columns: []string{user.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeUUID),
+			},
 		}
-	}
-	if v, ok := _u.mutation.Email(); ok {
-		if err := user.EmailValidator(v); err != nil {
-			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "User.email": %w`, err)}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	return nil
-}
-
-func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
+	if _u.mutation.BookNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookNotesTable,
+			Columns: []string{user.BookNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec := sqlgraph.NewUpdateSpec(user.Table, user.Columns, sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID))
-	id, ok := _u.mutation.ID()
-	if !ok {
-		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "User.id" for update`)}
+	if nodes := _u.mutation.RemovedBookNotesIDs(); len(nodes) > 0 && !_u.mutation.BookNotesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookNotesTable,
+			Columns: []string{user.BookNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	_spec.Node.ID.Value = id
-	if fields := _u.fields; len(fields) > 0 {
-		_spec.Node.Columns = make([]string, 0, len(fields))
-		_spec.Node.Columns = append(_spec.Node.Columns, user.FieldID)
-		for _, f := range fields {
-			if !user.ValidColumn(f) {
-				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
-			}
-			if f != user.FieldID {
-				_spec.Node.Columns = append(_spec.Node.Columns, f)
-			}
+	if nodes := _u.mutation.BookNotesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BookNotesTable,
+			Columns: []string{user.BookNotesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(booknote.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
+	if _u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoansTable,
+			Columns: []string{user.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
 		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.NickName(); ok {
-		_spec.SetField(user.FieldNickName, field.TypeString, value)
+	if nodes := _u.mutation.RemovedLoansIDs(); len(nodes) > 0 && !_u.mutation.LoansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoansTable,
+			Columns: []string{user.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Email(); ok {
-		_spec.SetField(user.FieldEmail, field.TypeString, value)
+	if nodes := _u.mutation.LoansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.LoansTable,
+			Columns: []string{user.LoansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(loan.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.Password(); ok {
-		_spec.SetField(user.FieldPassword, field.TypeString, value)
+	if _u.mutation.SentBorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentBorrowRequestsTable,
+			Columns: []string{user.SentBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if _u.mutation.PasswordCleared() {
-		_spec.ClearField(user.FieldPassword, field.TypeString)
+	if nodes := _u.mutation.RemovedSentBorrowRequestsIDs(); len(nodes) > 0 && !_u.mutation.SentBorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentBorrowRequestsTable,
+			Columns: []string{user.SentBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.IsPublished(); ok {
-		_spec.SetField(user.FieldIsPublished, field.TypeBool, value)
+	if nodes := _u.mutation.SentBorrowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SentBorrowRequestsTable,
+			Columns: []string{user.SentBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if value, ok := _u.mutation.IsTermsAgreed(); ok {
-		_spec.SetField(user.FieldIsTermsAgreed, field.TypeBool, value)
+	if _u.mutation.ReceivedBorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedBorrowRequestsTable,
+			Columns: []string{user.ReceivedBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.IsPrivacyAgreed(); ok {
-		_spec.SetField(user.FieldIsPrivacyAgreed, field.TypeBool, value)
+	if nodes := _u.mutation.RemovedReceivedBorrowRequestsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedBorrowRequestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedBorrowRequestsTable,
+			Columns: []string{user.ReceivedBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.FcmToken(); ok {
-		_spec.SetField(user.FieldFcmToken, field.TypeString, value)
+	if nodes := _u.mutation.ReceivedBorrowRequestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ReceivedBorrowRequestsTable,
+			Columns: []string{user.ReceivedBorrowRequestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(borrowrequest.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.FcmTokenCleared() {
-		_spec.ClearField(user.FieldFcmToken, field.TypeString)
+	if _u.mutation.WishlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistItemsTable,
+			Columns: []string{user.WishlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.Timezone(); ok {
-		_spec.SetField(user.FieldTimezone, field.TypeString, value)
+	if nodes := _u.mutation.RemovedWishlistItemsIDs(); len(nodes) > 0 && !_u.mutation.WishlistItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistItemsTable,
+			Columns: []string{user.WishlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if value, ok := _u.mutation.UpdatedAt(); ok {
-		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
+	if nodes := _u.mutation.WishlistItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistItemsTable,
+			Columns: []string{user.WishlistItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BooksCleared() {
+	if _u.mutation.LibraryMembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BooksTable,
-			Columns: []string{user.BooksColumn},
+			Table:   user.LibraryMembershipsTable,
+			Columns: []string{user.LibraryMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(librarymember.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBooksIDs(); len(nodes) > 0 && !_u.mutation.BooksCleared() {
+	if nodes := _u.mutation.RemovedLibraryMembershipsIDs(); len(nodes) > 0 && !_u.mutation.LibraryMembershipsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BooksTable,
-			Columns: []string{user.BooksColumn},
+			Table:   user.LibraryMembershipsTable,
+			Columns: []string{user.LibraryMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(librarymember.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1040,15 +3046,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BooksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.LibraryMembershipsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BooksTable,
-			Columns: []string{user.BooksColumn},
+			Table:   user.LibraryMembershipsTable,
+			Columns: []string{user.LibraryMembershipsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(book.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(librarymember.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1056,28 +3062,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReviewsCleared() {
+	if _u.mutation.SentLibraryInvitationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReviewsTable,
-			Columns: []string{user.ReviewsColumn},
+			Table:   user.SentLibraryInvitationsTable,
+			Columns: []string{user.SentLibraryInvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReviewsIDs(); len(nodes) > 0 && !_u.mutation.ReviewsCleared() {
+	if nodes := _u.mutation.RemovedSentLibraryInvitationsIDs(); len(nodes) > 0 && !_u.mutation.SentLibraryInvitationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReviewsTable,
-			Columns: []string{user.ReviewsColumn},
+			Table:   user.SentLibraryInvitationsTable,
+			Columns: []string{user.SentLibraryInvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1085,15 +3091,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReviewsIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.SentLibraryInvitationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReviewsTable,
-			Columns: []string{user.ReviewsColumn},
+			Table:   user.SentLibraryInvitationsTable,
+			Columns: []string{user.SentLibraryInvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(review.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1101,28 +3107,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.BookmarksCleared() {
+	if _u.mutation.ReceivedLibraryInvitationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BookmarksTable,
-			Columns: []string{user.BookmarksColumn},
+			Table:   user.ReceivedLibraryInvitationsTable,
+			Columns: []string{user.ReceivedLibraryInvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedBookmarksIDs(); len(nodes) > 0 && !_u.mutation.BookmarksCleared() {
+	if nodes := _u.mutation.RemovedReceivedLibraryInvitationsIDs(); len(nodes) > 0 && !_u.mutation.ReceivedLibraryInvitationsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BookmarksTable,
-			Columns: []string{user.BookmarksColumn},
+			Table:   user.ReceivedLibraryInvitationsTable,
+			Columns: []string{user.ReceivedLibraryInvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1130,15 +3136,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.BookmarksIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReceivedLibraryInvitationsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.BookmarksTable,
-			Columns: []string{user.BookmarksColumn},
+			Table:   user.ReceivedLibraryInvitationsTable,
+			Columns: []string{user.ReceivedLibraryInvitationsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(bookmark.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(libraryinvitation.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1146,28 +3152,28 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _u.mutation.ReadingRemindersCleared() {
+	if _u.mutation.ReadingGoalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReadingRemindersTable,
-			Columns: []string{user.ReadingRemindersColumn},
+			Table:   user.ReadingGoalsTable,
+			Columns: []string{user.ReadingGoalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(readingreminder.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID),
 			},
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.RemovedReadingRemindersIDs(); len(nodes) > 0 && !_u.mutation.ReadingRemindersCleared() {
+	if nodes := _u.mutation.RemovedReadingGoalsIDs(); len(nodes) > 0 && !_u.mutation.ReadingGoalsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReadingRemindersTable,
-			Columns: []string{user.ReadingRemindersColumn},
+			Table:   user.ReadingGoalsTable,
+			Columns: []string{user.ReadingGoalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(readingreminder.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -1175,15 +3181,15 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	if nodes := _u.mutation.ReadingRemindersIDs(); len(nodes) > 0 {
+	if nodes := _u.mutation.ReadingGoalsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
 			Inverse: false,
-			Table:   user.ReadingRemindersTable,
-			Columns: []string{user.ReadingRemindersColumn},
+			Table:   user.ReadingGoalsTable,
+			Columns: []string{user.ReadingGoalsColumn},
 			Bidi:    false,
 			Target: &sqlgraph.EdgeTarget{
-				IDSpec: sqlgraph.NewFieldSpec(readingreminder.FieldID, field.TypeUUID),
+				IDSpec: sqlgraph.NewFieldSpec(readinggoal.FieldID, field.TypeUUID),
 			},
 		}
 		for _, k := range nodes {
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
+	"github.com/google/uuid"
+)
+
+// WishlistItem is the model entity for the WishlistItem schema.
+type WishlistItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Author holds the value of the "author" field.
+	Author string `json:"author,omitempty"`
+	// ISBN-13 (하이픈 없이 정규화)
+	Isbn *string `json:"isbn,omitempty"`
+	// Publisher holds the value of the "publisher" field.
+	Publisher string `json:"publisher,omitempty"`
+	// ThumbnailURL holds the value of the "thumbnail_url" field.
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// Priority holds the value of the "priority" field.
+	Priority wishlistitem.Priority `json:"priority,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// 이 가격 이하라면 사고 싶은 가격
+	TargetPrice *float64 `json:"target_price,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ClaimedBy holds the value of the "claimed_by" field.
+	ClaimedBy string `json:"claimed_by,omitempty"`
+	// 선물 표시를 취소할 때 확인하는 코드
+	ClaimCode string `json:"claim_code,omitempty"`
+	// ClaimedAt holds the value of the "claimed_at" field.
+	ClaimedAt *time.Time `json:"claimed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WishlistItemQuery when eager-loading is set.
+	Edges               WishlistItemEdges `json:"edges"`
+	user_wishlist_items *uuid.UUID
+	selectValues        sql.SelectValues
+}
+
+// WishlistItemEdges holds the relations/edges for other nodes in the graph.
+type WishlistItemEdges struct {
+	// Owner holds the value of the owner edge.
+	Owner *User `json:"owner,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OwnerOrErr returns the Owner value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WishlistItemEdges) OwnerOrErr() (*User, error) {
+	if e.Owner != nil {
+		return e.Owner, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "owner"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WishlistItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wishlistitem.FieldTargetPrice:
+			values[i] = new(sql.NullFloat64)
+		case wishlistitem.FieldTitle, wishlistitem.FieldAuthor, wishlistitem.FieldIsbn, wishlistitem.FieldPublisher, wishlistitem.FieldThumbnailURL, wishlistitem.FieldPriority, wishlistitem.FieldNote, wishlistitem.FieldCurrency, wishlistitem.FieldClaimedBy, wishlistitem.FieldClaimCode:
+			values[i] = new(sql.NullString)
+		case wishlistitem.FieldClaimedAt, wishlistitem.FieldCreatedAt, wishlistitem.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case wishlistitem.FieldID:
+			values[i] = new(uuid.UUID)
+		case wishlistitem.ForeignKeys[0]: // user_wishlist_items
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WishlistItem fields.
+func (_m *WishlistItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wishlistitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case wishlistitem.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case wishlistitem.FieldAuthor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field author", values[i])
+			} else if value.Valid {
+				_m.Author = value.String
+			}
+		case wishlistitem.FieldIsbn:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field isbn", values[i])
+			} else if value.Valid {
+				_m.Isbn = new(string)
+				*_m.Isbn = value.String
+			}
+		case wishlistitem.FieldPublisher:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field publisher", values[i])
+			} else if value.Valid {
+				_m.Publisher = value.String
+			}
+		case wishlistitem.FieldThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+			} else if value.Valid {
+				_m.ThumbnailURL = value.String
+			}
+		case wishlistitem.FieldPriority:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field priority", values[i])
+			} else if value.Valid {
+				_m.Priority = wishlistitem.Priority(value.String)
+			}
+		case wishlistitem.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case wishlistitem.FieldTargetPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_price", values[i])
+			} else if value.Valid {
+				_m.TargetPrice = new(float64)
+				*_m.TargetPrice = value.Float64
+			}
+		case wishlistitem.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case wishlistitem.FieldClaimedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_by", values[i])
+			} else if value.Valid {
+				_m.ClaimedBy = value.String
+			}
+		case wishlistitem.FieldClaimCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_code", values[i])
+			} else if value.Valid {
+				_m.ClaimCode = value.String
+			}
+		case wishlistitem.FieldClaimedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field claimed_at", values[i])
+			} else if value.Valid {
+				_m.ClaimedAt = new(time.Time)
+				*_m.ClaimedAt = value.Time
+			}
+		case wishlistitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case wishlistitem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case wishlistitem.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_wishlist_items", values[i])
+			} else if value.Valid {
+				_m.user_wishlist_items = new(uuid.UUID)
+				*_m.user_wishlist_items = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WishlistItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *WishlistItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOwner queries the "owner" edge of the WishlistItem entity.
+func (_m *WishlistItem) QueryOwner() *UserQuery {
+	return NewWishlistItemClient(_m.config).QueryOwner(_m)
+}
+
+// Update returns a builder for updating this WishlistItem.
+// Note that you need to call WishlistItem.Unwrap() before calling this method if this WishlistItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WishlistItem) Update() *WishlistItemUpdateOne {
+	return NewWishlistItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WishlistItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WishlistItem) Unwrap() *WishlistItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WishlistItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WishlistItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("WishlistItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("author=")
+	builder.WriteString(_m.Author)
+	builder.WriteString(", ")
+	if v := _m.Isbn; v != nil {
+		builder.WriteString("isbn=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("publisher=")
+	builder.WriteString(_m.Publisher)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_url=")
+	builder.WriteString(_m.ThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("priority=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Priority))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	if v := _m.TargetPrice; v != nil {
+		builder.WriteString("target_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("claimed_by=")
+	builder.WriteString(_m.ClaimedBy)
+	builder.WriteString(", ")
+	builder.WriteString("claim_code=")
+	builder.WriteString(_m.ClaimCode)
+	builder.WriteString(", ")
+	if v := _m.ClaimedAt; v != nil {
+		builder.WriteString("claimed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WishlistItems is a parsable slice of WishlistItem.
+type WishlistItems []*WishlistItem
@@ -0,0 +1,965 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wishlistitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldID, id))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldTitle, v))
+}
+
+// Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
+func Author(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldAuthor, v))
+}
+
+// Isbn applies equality check predicate on the "isbn" field. It's identical to IsbnEQ.
+func Isbn(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldIsbn, v))
+}
+
+// Publisher applies equality check predicate on the "publisher" field. It's identical to PublisherEQ.
+func Publisher(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldPublisher, v))
+}
+
+// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
+func ThumbnailURL(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldNote, v))
+}
+
+// TargetPrice applies equality check predicate on the "target_price" field. It's identical to TargetPriceEQ.
+func TargetPrice(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldTargetPrice, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ClaimedBy applies equality check predicate on the "claimed_by" field. It's identical to ClaimedByEQ.
+func ClaimedBy(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldClaimedBy, v))
+}
+
+// ClaimCode applies equality check predicate on the "claim_code" field. It's identical to ClaimCodeEQ.
+func ClaimCode(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldClaimCode, v))
+}
+
+// ClaimedAt applies equality check predicate on the "claimed_at" field. It's identical to ClaimedAtEQ.
+func ClaimedAt(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// AuthorEQ applies the EQ predicate on the "author" field.
+func AuthorEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldAuthor, v))
+}
+
+// AuthorNEQ applies the NEQ predicate on the "author" field.
+func AuthorNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldAuthor, v))
+}
+
+// AuthorIn applies the In predicate on the "author" field.
+func AuthorIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldAuthor, vs...))
+}
+
+// AuthorNotIn applies the NotIn predicate on the "author" field.
+func AuthorNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldAuthor, vs...))
+}
+
+// AuthorGT applies the GT predicate on the "author" field.
+func AuthorGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldAuthor, v))
+}
+
+// AuthorGTE applies the GTE predicate on the "author" field.
+func AuthorGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldAuthor, v))
+}
+
+// AuthorLT applies the LT predicate on the "author" field.
+func AuthorLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldAuthor, v))
+}
+
+// AuthorLTE applies the LTE predicate on the "author" field.
+func AuthorLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldAuthor, v))
+}
+
+// AuthorContains applies the Contains predicate on the "author" field.
+func AuthorContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldAuthor, v))
+}
+
+// AuthorHasPrefix applies the HasPrefix predicate on the "author" field.
+func AuthorHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldAuthor, v))
+}
+
+// AuthorHasSuffix applies the HasSuffix predicate on the "author" field.
+func AuthorHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldAuthor, v))
+}
+
+// AuthorEqualFold applies the EqualFold predicate on the "author" field.
+func AuthorEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldAuthor, v))
+}
+
+// AuthorContainsFold applies the ContainsFold predicate on the "author" field.
+func AuthorContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldAuthor, v))
+}
+
+// IsbnEQ applies the EQ predicate on the "isbn" field.
+func IsbnEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldIsbn, v))
+}
+
+// IsbnNEQ applies the NEQ predicate on the "isbn" field.
+func IsbnNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldIsbn, v))
+}
+
+// IsbnIn applies the In predicate on the "isbn" field.
+func IsbnIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldIsbn, vs...))
+}
+
+// IsbnNotIn applies the NotIn predicate on the "isbn" field.
+func IsbnNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldIsbn, vs...))
+}
+
+// IsbnGT applies the GT predicate on the "isbn" field.
+func IsbnGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldIsbn, v))
+}
+
+// IsbnGTE applies the GTE predicate on the "isbn" field.
+func IsbnGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldIsbn, v))
+}
+
+// IsbnLT applies the LT predicate on the "isbn" field.
+func IsbnLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldIsbn, v))
+}
+
+// IsbnLTE applies the LTE predicate on the "isbn" field.
+func IsbnLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldIsbn, v))
+}
+
+// IsbnContains applies the Contains predicate on the "isbn" field.
+func IsbnContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldIsbn, v))
+}
+
+// IsbnHasPrefix applies the HasPrefix predicate on the "isbn" field.
+func IsbnHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldIsbn, v))
+}
+
+// IsbnHasSuffix applies the HasSuffix predicate on the "isbn" field.
+func IsbnHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldIsbn, v))
+}
+
+// IsbnIsNil applies the IsNil predicate on the "isbn" field.
+func IsbnIsNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIsNull(FieldIsbn))
+}
+
+// IsbnNotNil applies the NotNil predicate on the "isbn" field.
+func IsbnNotNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotNull(FieldIsbn))
+}
+
+// IsbnEqualFold applies the EqualFold predicate on the "isbn" field.
+func IsbnEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldIsbn, v))
+}
+
+// IsbnContainsFold applies the ContainsFold predicate on the "isbn" field.
+func IsbnContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldIsbn, v))
+}
+
+// PublisherEQ applies the EQ predicate on the "publisher" field.
+func PublisherEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldPublisher, v))
+}
+
+// PublisherNEQ applies the NEQ predicate on the "publisher" field.
+func PublisherNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldPublisher, v))
+}
+
+// PublisherIn applies the In predicate on the "publisher" field.
+func PublisherIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldPublisher, vs...))
+}
+
+// PublisherNotIn applies the NotIn predicate on the "publisher" field.
+func PublisherNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldPublisher, vs...))
+}
+
+// PublisherGT applies the GT predicate on the "publisher" field.
+func PublisherGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldPublisher, v))
+}
+
+// PublisherGTE applies the GTE predicate on the "publisher" field.
+func PublisherGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldPublisher, v))
+}
+
+// PublisherLT applies the LT predicate on the "publisher" field.
+func PublisherLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldPublisher, v))
+}
+
+// PublisherLTE applies the LTE predicate on the "publisher" field.
+func PublisherLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldPublisher, v))
+}
+
+// PublisherContains applies the Contains predicate on the "publisher" field.
+func PublisherContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldPublisher, v))
+}
+
+// PublisherHasPrefix applies the HasPrefix predicate on the "publisher" field.
+func PublisherHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldPublisher, v))
+}
+
+// PublisherHasSuffix applies the HasSuffix predicate on the "publisher" field.
+func PublisherHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldPublisher, v))
+}
+
+// PublisherEqualFold applies the EqualFold predicate on the "publisher" field.
+func PublisherEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldPublisher, v))
+}
+
+// PublisherContainsFold applies the ContainsFold predicate on the "publisher" field.
+func PublisherContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldPublisher, v))
+}
+
+// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
+func ThumbnailURLEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
+func ThumbnailURLNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
+func ThumbnailURLIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
+func ThumbnailURLNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
+func ThumbnailURLGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
+func ThumbnailURLGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
+func ThumbnailURLLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
+func ThumbnailURLLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
+func ThumbnailURLContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
+func ThumbnailURLEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
+func ThumbnailURLContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldThumbnailURL, v))
+}
+
+// PriorityEQ applies the EQ predicate on the "priority" field.
+func PriorityEQ(v Priority) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldPriority, v))
+}
+
+// PriorityNEQ applies the NEQ predicate on the "priority" field.
+func PriorityNEQ(v Priority) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldPriority, v))
+}
+
+// PriorityIn applies the In predicate on the "priority" field.
+func PriorityIn(vs ...Priority) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldPriority, vs...))
+}
+
+// PriorityNotIn applies the NotIn predicate on the "priority" field.
+func PriorityNotIn(vs ...Priority) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldPriority, vs...))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldNote, v))
+}
+
+// TargetPriceEQ applies the EQ predicate on the "target_price" field.
+func TargetPriceEQ(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldTargetPrice, v))
+}
+
+// TargetPriceNEQ applies the NEQ predicate on the "target_price" field.
+func TargetPriceNEQ(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldTargetPrice, v))
+}
+
+// TargetPriceIn applies the In predicate on the "target_price" field.
+func TargetPriceIn(vs ...float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldTargetPrice, vs...))
+}
+
+// TargetPriceNotIn applies the NotIn predicate on the "target_price" field.
+func TargetPriceNotIn(vs ...float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldTargetPrice, vs...))
+}
+
+// TargetPriceGT applies the GT predicate on the "target_price" field.
+func TargetPriceGT(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldTargetPrice, v))
+}
+
+// TargetPriceGTE applies the GTE predicate on the "target_price" field.
+func TargetPriceGTE(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldTargetPrice, v))
+}
+
+// TargetPriceLT applies the LT predicate on the "target_price" field.
+func TargetPriceLT(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldTargetPrice, v))
+}
+
+// TargetPriceLTE applies the LTE predicate on the "target_price" field.
+func TargetPriceLTE(v float64) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldTargetPrice, v))
+}
+
+// TargetPriceIsNil applies the IsNil predicate on the "target_price" field.
+func TargetPriceIsNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIsNull(FieldTargetPrice))
+}
+
+// TargetPriceNotNil applies the NotNil predicate on the "target_price" field.
+func TargetPriceNotNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotNull(FieldTargetPrice))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ClaimedByEQ applies the EQ predicate on the "claimed_by" field.
+func ClaimedByEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldClaimedBy, v))
+}
+
+// ClaimedByNEQ applies the NEQ predicate on the "claimed_by" field.
+func ClaimedByNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldClaimedBy, v))
+}
+
+// ClaimedByIn applies the In predicate on the "claimed_by" field.
+func ClaimedByIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldClaimedBy, vs...))
+}
+
+// ClaimedByNotIn applies the NotIn predicate on the "claimed_by" field.
+func ClaimedByNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldClaimedBy, vs...))
+}
+
+// ClaimedByGT applies the GT predicate on the "claimed_by" field.
+func ClaimedByGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldClaimedBy, v))
+}
+
+// ClaimedByGTE applies the GTE predicate on the "claimed_by" field.
+func ClaimedByGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldClaimedBy, v))
+}
+
+// ClaimedByLT applies the LT predicate on the "claimed_by" field.
+func ClaimedByLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldClaimedBy, v))
+}
+
+// ClaimedByLTE applies the LTE predicate on the "claimed_by" field.
+func ClaimedByLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldClaimedBy, v))
+}
+
+// ClaimedByContains applies the Contains predicate on the "claimed_by" field.
+func ClaimedByContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldClaimedBy, v))
+}
+
+// ClaimedByHasPrefix applies the HasPrefix predicate on the "claimed_by" field.
+func ClaimedByHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldClaimedBy, v))
+}
+
+// ClaimedByHasSuffix applies the HasSuffix predicate on the "claimed_by" field.
+func ClaimedByHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldClaimedBy, v))
+}
+
+// ClaimedByEqualFold applies the EqualFold predicate on the "claimed_by" field.
+func ClaimedByEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldClaimedBy, v))
+}
+
+// ClaimedByContainsFold applies the ContainsFold predicate on the "claimed_by" field.
+func ClaimedByContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldClaimedBy, v))
+}
+
+// ClaimCodeEQ applies the EQ predicate on the "claim_code" field.
+func ClaimCodeEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldClaimCode, v))
+}
+
+// ClaimCodeNEQ applies the NEQ predicate on the "claim_code" field.
+func ClaimCodeNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldClaimCode, v))
+}
+
+// ClaimCodeIn applies the In predicate on the "claim_code" field.
+func ClaimCodeIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldClaimCode, vs...))
+}
+
+// ClaimCodeNotIn applies the NotIn predicate on the "claim_code" field.
+func ClaimCodeNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldClaimCode, vs...))
+}
+
+// ClaimCodeGT applies the GT predicate on the "claim_code" field.
+func ClaimCodeGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldClaimCode, v))
+}
+
+// ClaimCodeGTE applies the GTE predicate on the "claim_code" field.
+func ClaimCodeGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldClaimCode, v))
+}
+
+// ClaimCodeLT applies the LT predicate on the "claim_code" field.
+func ClaimCodeLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldClaimCode, v))
+}
+
+// ClaimCodeLTE applies the LTE predicate on the "claim_code" field.
+func ClaimCodeLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldClaimCode, v))
+}
+
+// ClaimCodeContains applies the Contains predicate on the "claim_code" field.
+func ClaimCodeContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldClaimCode, v))
+}
+
+// ClaimCodeHasPrefix applies the HasPrefix predicate on the "claim_code" field.
+func ClaimCodeHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldClaimCode, v))
+}
+
+// ClaimCodeHasSuffix applies the HasSuffix predicate on the "claim_code" field.
+func ClaimCodeHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldClaimCode, v))
+}
+
+// ClaimCodeEqualFold applies the EqualFold predicate on the "claim_code" field.
+func ClaimCodeEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldClaimCode, v))
+}
+
+// ClaimCodeContainsFold applies the ContainsFold predicate on the "claim_code" field.
+func ClaimCodeContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldClaimCode, v))
+}
+
+// ClaimedAtEQ applies the EQ predicate on the "claimed_at" field.
+func ClaimedAtEQ(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtNEQ applies the NEQ predicate on the "claimed_at" field.
+func ClaimedAtNEQ(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldClaimedAt, v))
+}
+
+// ClaimedAtIn applies the In predicate on the "claimed_at" field.
+func ClaimedAtIn(vs ...time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtNotIn applies the NotIn predicate on the "claimed_at" field.
+func ClaimedAtNotIn(vs ...time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldClaimedAt, vs...))
+}
+
+// ClaimedAtGT applies the GT predicate on the "claimed_at" field.
+func ClaimedAtGT(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldClaimedAt, v))
+}
+
+// ClaimedAtGTE applies the GTE predicate on the "claimed_at" field.
+func ClaimedAtGTE(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtLT applies the LT predicate on the "claimed_at" field.
+func ClaimedAtLT(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldClaimedAt, v))
+}
+
+// ClaimedAtLTE applies the LTE predicate on the "claimed_at" field.
+func ClaimedAtLTE(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldClaimedAt, v))
+}
+
+// ClaimedAtIsNil applies the IsNil predicate on the "claimed_at" field.
+func ClaimedAtIsNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIsNull(FieldClaimedAt))
+}
+
+// ClaimedAtNotNil applies the NotNil predicate on the "claimed_at" field.
+func ClaimedAtNotNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotNull(FieldClaimedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOwner applies the HasEdge predicate on the "owner" edge.
+func HasOwner() predicate.WishlistItem {
+	return predicate.WishlistItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OwnerTable, OwnerColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOwnerWith applies the HasEdge predicate on the "owner" edge with a given conditions (other predicates).
+func HasOwnerWith(preds ...predicate.User) predicate.WishlistItem {
+	return predicate.WishlistItem(func(s *sql.Selector) {
+		step := newOwnerStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WishlistItem) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WishlistItem) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WishlistItem) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.NotPredicates(p))
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
+	"github.com/google/uuid"
+)
+
+// WishlistItemCreate is the builder for creating a WishlistItem entity.
+type WishlistItemCreate struct {
+	config
+	mutation *WishlistItemMutation
+	hooks    []Hook
+}
+
+// SetTitle sets the "title" field.
+func (_c *WishlistItemCreate) SetTitle(v string) *WishlistItemCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetAuthor sets the "author" field.
+func (_c *WishlistItemCreate) SetAuthor(v string) *WishlistItemCreate {
+	_c.mutation.SetAuthor(v)
+	return _c
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableAuthor(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetAuthor(*v)
+	}
+	return _c
+}
+
+// SetIsbn sets the "isbn" field.
+func (_c *WishlistItemCreate) SetIsbn(v string) *WishlistItemCreate {
+	_c.mutation.SetIsbn(v)
+	return _c
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableIsbn(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetIsbn(*v)
+	}
+	return _c
+}
+
+// SetPublisher sets the "publisher" field.
+func (_c *WishlistItemCreate) SetPublisher(v string) *WishlistItemCreate {
+	_c.mutation.SetPublisher(v)
+	return _c
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillablePublisher(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetPublisher(*v)
+	}
+	return _c
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_c *WishlistItemCreate) SetThumbnailURL(v string) *WishlistItemCreate {
+	_c.mutation.SetThumbnailURL(v)
+	return _c
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableThumbnailURL(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetThumbnailURL(*v)
+	}
+	return _c
+}
+
+// SetPriority sets the "priority" field.
+func (_c *WishlistItemCreate) SetPriority(v wishlistitem.Priority) *WishlistItemCreate {
+	_c.mutation.SetPriority(v)
+	return _c
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillablePriority(v *wishlistitem.Priority) *WishlistItemCreate {
+	if v != nil {
+		_c.SetPriority(*v)
+	}
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *WishlistItemCreate) SetNote(v string) *WishlistItemCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableNote(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetTargetPrice sets the "target_price" field.
+func (_c *WishlistItemCreate) SetTargetPrice(v float64) *WishlistItemCreate {
+	_c.mutation.SetTargetPrice(v)
+	return _c
+}
+
+// SetNillableTargetPrice sets the "target_price" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableTargetPrice(v *float64) *WishlistItemCreate {
+	if v != nil {
+		_c.SetTargetPrice(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *WishlistItemCreate) SetCurrency(v string) *WishlistItemCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableCurrency(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetClaimedBy sets the "claimed_by" field.
+func (_c *WishlistItemCreate) SetClaimedBy(v string) *WishlistItemCreate {
+	_c.mutation.SetClaimedBy(v)
+	return _c
+}
+
+// SetNillableClaimedBy sets the "claimed_by" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableClaimedBy(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetClaimedBy(*v)
+	}
+	return _c
+}
+
+// SetClaimCode sets the "claim_code" field.
+func (_c *WishlistItemCreate) SetClaimCode(v string) *WishlistItemCreate {
+	_c.mutation.SetClaimCode(v)
+	return _c
+}
+
+// SetNillableClaimCode sets the "claim_code" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableClaimCode(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetClaimCode(*v)
+	}
+	return _c
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_c *WishlistItemCreate) SetClaimedAt(v time.Time) *WishlistItemCreate {
+	_c.mutation.SetClaimedAt(v)
+	return _c
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableClaimedAt(v *time.Time) *WishlistItemCreate {
+	if v != nil {
+		_c.SetClaimedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WishlistItemCreate) SetCreatedAt(v time.Time) *WishlistItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableCreatedAt(v *time.Time) *WishlistItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WishlistItemCreate) SetUpdatedAt(v time.Time) *WishlistItemCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableUpdatedAt(v *time.Time) *WishlistItemCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WishlistItemCreate) SetID(v uuid.UUID) *WishlistItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableID(v *uuid.UUID) *WishlistItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_c *WishlistItemCreate) SetOwnerID(id uuid.UUID) *WishlistItemCreate {
+	_c.mutation.SetOwnerID(id)
+	return _c
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_c *WishlistItemCreate) SetOwner(v *User) *WishlistItemCreate {
+	return _c.SetOwnerID(v.ID)
+}
+
+// Mutation returns the WishlistItemMutation object of the builder.
+func (_c *WishlistItemCreate) Mutation() *WishlistItemMutation {
+	return _c.mutation
+}
+
+// Save creates the WishlistItem in the database.
+func (_c *WishlistItemCreate) Save(ctx context.Context) (*WishlistItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WishlistItemCreate) SaveX(ctx context.Context) *WishlistItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WishlistItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WishlistItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WishlistItemCreate) defaults() {
+	if _, ok := _c.mutation.Author(); !ok {
+		v := wishlistitem.DefaultAuthor
+		_c.mutation.SetAuthor(v)
+	}
+	if _, ok := _c.mutation.Publisher(); !ok {
+		v := wishlistitem.DefaultPublisher
+		_c.mutation.SetPublisher(v)
+	}
+	if _, ok := _c.mutation.ThumbnailURL(); !ok {
+		v := wishlistitem.DefaultThumbnailURL
+		_c.mutation.SetThumbnailURL(v)
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		v := wishlistitem.DefaultPriority
+		_c.mutation.SetPriority(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := wishlistitem.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.ClaimedBy(); !ok {
+		v := wishlistitem.DefaultClaimedBy
+		_c.mutation.SetClaimedBy(v)
+	}
+	if _, ok := _c.mutation.ClaimCode(); !ok {
+		v := wishlistitem.DefaultClaimCode
+		_c.mutation.SetClaimCode(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wishlistitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := wishlistitem.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := wishlistitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WishlistItemCreate) check() error {
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "WishlistItem.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := wishlistitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "WishlistItem.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Author(); !ok {
+		return &ValidationError{Name: "author", err: errors.New(`ent: missing required field "WishlistItem.author"`)}
+	}
+	if _, ok := _c.mutation.Publisher(); !ok {
+		return &ValidationError{Name: "publisher", err: errors.New(`ent: missing required field "WishlistItem.publisher"`)}
+	}
+	if _, ok := _c.mutation.ThumbnailURL(); !ok {
+		return &ValidationError{Name: "thumbnail_url", err: errors.New(`ent: missing required field "WishlistItem.thumbnail_url"`)}
+	}
+	if _, ok := _c.mutation.Priority(); !ok {
+		return &ValidationError{Name: "priority", err: errors.New(`ent: missing required field "WishlistItem.priority"`)}
+	}
+	if v, ok := _c.mutation.Priority(); ok {
+		if err := wishlistitem.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "WishlistItem.priority": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.TargetPrice(); ok {
+		if err := wishlistitem.TargetPriceValidator(v); err != nil {
+			return &ValidationError{Name: "target_price", err: fmt.Errorf(`ent: validator failed for field "WishlistItem.target_price": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "WishlistItem.currency"`)}
+	}
+	if _, ok := _c.mutation.ClaimedBy(); !ok {
+		return &ValidationError{Name: "claimed_by", err: errors.New(`ent: missing required field "WishlistItem.claimed_by"`)}
+	}
+	if _, ok := _c.mutation.ClaimCode(); !ok {
+		return &ValidationError{Name: "claim_code", err: errors.New(`ent: missing required field "WishlistItem.claim_code"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WishlistItem.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WishlistItem.updated_at"`)}
+	}
+	if len(_c.mutation.OwnerIDs()) == 0 {
+		return &ValidationError{Name: "owner", err: errors.New(`ent: missing required edge "WishlistItem.owner"`)}
+	}
+	return nil
+}
+
+func (_c *WishlistItemCreate) sqlSave(ctx context.Context) (*WishlistItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WishlistItemCreate) createSpec() (*WishlistItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WishlistItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wishlistitem.Table, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(wishlistitem.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Author(); ok {
+		_spec.SetField(wishlistitem.FieldAuthor, field.TypeString, value)
+		_node.Author = value
+	}
+	if value, ok := _c.mutation.Isbn(); ok {
+		_spec.SetField(wishlistitem.FieldIsbn, field.TypeString, value)
+		_node.Isbn = &value
+	}
+	if value, ok := _c.mutation.Publisher(); ok {
+		_spec.SetField(wishlistitem.FieldPublisher, field.TypeString, value)
+		_node.Publisher = value
+	}
+	if value, ok := _c.mutation.ThumbnailURL(); ok {
+		_spec.SetField(wishlistitem.FieldThumbnailURL, field.TypeString, value)
+		_node.ThumbnailURL = value
+	}
+	if value, ok := _c.mutation.Priority(); ok {
+		_spec.SetField(wishlistitem.FieldPriority, field.TypeEnum, value)
+		_node.Priority = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(wishlistitem.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.TargetPrice(); ok {
+		_spec.SetField(wishlistitem.FieldTargetPrice, field.TypeFloat64, value)
+		_node.TargetPrice = &value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(wishlistitem.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ClaimedBy(); ok {
+		_spec.SetField(wishlistitem.FieldClaimedBy, field.TypeString, value)
+		_node.ClaimedBy = value
+	}
+	if value, ok := _c.mutation.ClaimCode(); ok {
+		_spec.SetField(wishlistitem.FieldClaimCode, field.TypeString, value)
+		_node.ClaimCode = value
+	}
+	if value, ok := _c.mutation.ClaimedAt(); ok {
+		_spec.SetField(wishlistitem.FieldClaimedAt, field.TypeTime, value)
+		_node.ClaimedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wishlistitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(wishlistitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.OwnerTable,
+			Columns: []string{wishlistitem.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_wishlist_items = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WishlistItemCreateBulk is the builder for creating many WishlistItem entities in bulk.
+type WishlistItemCreateBulk struct {
+	config
+	err      error
+	builders []*WishlistItemCreate
+}
+
+// Save creates the WishlistItem entities in the database.
+func (_c *WishlistItemCreateBulk) Save(ctx context.Context) ([]*WishlistItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WishlistItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WishlistItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WishlistItemCreateBulk) SaveX(ctx context.Context) []*WishlistItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WishlistItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WishlistItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
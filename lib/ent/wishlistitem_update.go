@@ -0,0 +1,858 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/user"
+	"github.com/dev-hyunsang/my-own-library-backend/lib/ent/wishlistitem"
+	"github.com/google/uuid"
+)
+
+// WishlistItemUpdate is the builder for updating WishlistItem entities.
+type WishlistItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WishlistItemMutation
+}
+
+// Where appends a list predicates to the WishlistItemUpdate builder.
+func (_u *WishlistItemUpdate) Where(ps ...predicate.WishlistItem) *WishlistItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *WishlistItemUpdate) SetTitle(v string) *WishlistItemUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableTitle(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *WishlistItemUpdate) SetAuthor(v string) *WishlistItemUpdate {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableAuthor(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// SetIsbn sets the "isbn" field.
+func (_u *WishlistItemUpdate) SetIsbn(v string) *WishlistItemUpdate {
+	_u.mutation.SetIsbn(v)
+	return _u
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableIsbn(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetIsbn(*v)
+	}
+	return _u
+}
+
+// ClearIsbn clears the value of the "isbn" field.
+func (_u *WishlistItemUpdate) ClearIsbn() *WishlistItemUpdate {
+	_u.mutation.ClearIsbn()
+	return _u
+}
+
+// SetPublisher sets the "publisher" field.
+func (_u *WishlistItemUpdate) SetPublisher(v string) *WishlistItemUpdate {
+	_u.mutation.SetPublisher(v)
+	return _u
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillablePublisher(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetPublisher(*v)
+	}
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *WishlistItemUpdate) SetThumbnailURL(v string) *WishlistItemUpdate {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableThumbnailURL(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *WishlistItemUpdate) SetPriority(v wishlistitem.Priority) *WishlistItemUpdate {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillablePriority(v *wishlistitem.Priority) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WishlistItemUpdate) SetNote(v string) *WishlistItemUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableNote(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *WishlistItemUpdate) ClearNote() *WishlistItemUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetTargetPrice sets the "target_price" field.
+func (_u *WishlistItemUpdate) SetTargetPrice(v float64) *WishlistItemUpdate {
+	_u.mutation.ResetTargetPrice()
+	_u.mutation.SetTargetPrice(v)
+	return _u
+}
+
+// SetNillableTargetPrice sets the "target_price" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableTargetPrice(v *float64) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetTargetPrice(*v)
+	}
+	return _u
+}
+
+// AddTargetPrice adds value to the "target_price" field.
+func (_u *WishlistItemUpdate) AddTargetPrice(v float64) *WishlistItemUpdate {
+	_u.mutation.AddTargetPrice(v)
+	return _u
+}
+
+// ClearTargetPrice clears the value of the "target_price" field.
+func (_u *WishlistItemUpdate) ClearTargetPrice() *WishlistItemUpdate {
+	_u.mutation.ClearTargetPrice()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *WishlistItemUpdate) SetCurrency(v string) *WishlistItemUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableCurrency(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetClaimedBy sets the "claimed_by" field.
+func (_u *WishlistItemUpdate) SetClaimedBy(v string) *WishlistItemUpdate {
+	_u.mutation.SetClaimedBy(v)
+	return _u
+}
+
+// SetNillableClaimedBy sets the "claimed_by" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableClaimedBy(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetClaimedBy(*v)
+	}
+	return _u
+}
+
+// SetClaimCode sets the "claim_code" field.
+func (_u *WishlistItemUpdate) SetClaimCode(v string) *WishlistItemUpdate {
+	_u.mutation.SetClaimCode(v)
+	return _u
+}
+
+// SetNillableClaimCode sets the "claim_code" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableClaimCode(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetClaimCode(*v)
+	}
+	return _u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_u *WishlistItemUpdate) SetClaimedAt(v time.Time) *WishlistItemUpdate {
+	_u.mutation.SetClaimedAt(v)
+	return _u
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableClaimedAt(v *time.Time) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetClaimedAt(*v)
+	}
+	return _u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (_u *WishlistItemUpdate) ClearClaimedAt() *WishlistItemUpdate {
+	_u.mutation.ClearClaimedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WishlistItemUpdate) SetUpdatedAt(v time.Time) *WishlistItemUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *WishlistItemUpdate) SetOwnerID(id uuid.UUID) *WishlistItemUpdate {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *WishlistItemUpdate) SetOwner(v *User) *WishlistItemUpdate {
+	return _u.SetOwnerID(v.ID)
+}
+
+// Mutation returns the WishlistItemMutation object of the builder.
+func (_u *WishlistItemUpdate) Mutation() *WishlistItemMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *WishlistItemUpdate) ClearOwner() *WishlistItemUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WishlistItemUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WishlistItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WishlistItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WishlistItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WishlistItemUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wishlistitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WishlistItemUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := wishlistitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "WishlistItem.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := wishlistitem.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "WishlistItem.priority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetPrice(); ok {
+		if err := wishlistitem.TargetPriceValidator(v); err != nil {
+			return &ValidationError{Name: "target_price", err: fmt.Errorf(`ent: validator failed for field "WishlistItem.target_price": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WishlistItem.owner"`)
+	}
+	return nil
+}
+
+func (_u *WishlistItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(wishlistitem.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(wishlistitem.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Isbn(); ok {
+		_spec.SetField(wishlistitem.FieldIsbn, field.TypeString, value)
+	}
+	if _u.mutation.IsbnCleared() {
+		_spec.ClearField(wishlistitem.FieldIsbn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Publisher(); ok {
+		_spec.SetField(wishlistitem.FieldPublisher, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(wishlistitem.FieldThumbnailURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(wishlistitem.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(wishlistitem.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(wishlistitem.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.TargetPrice(); ok {
+		_spec.SetField(wishlistitem.FieldTargetPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetPrice(); ok {
+		_spec.AddField(wishlistitem.FieldTargetPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.TargetPriceCleared() {
+		_spec.ClearField(wishlistitem.FieldTargetPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(wishlistitem.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimedBy(); ok {
+		_spec.SetField(wishlistitem.FieldClaimedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimCode(); ok {
+		_spec.SetField(wishlistitem.FieldClaimCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimedAt(); ok {
+		_spec.SetField(wishlistitem.FieldClaimedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedAtCleared() {
+		_spec.ClearField(wishlistitem.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wishlistitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.OwnerTable,
+			Columns: []string{wishlistitem.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.OwnerTable,
+			Columns: []string{wishlistitem.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WishlistItemUpdateOne is the builder for updating a single WishlistItem entity.
+type WishlistItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WishlistItemMutation
+}
+
+// SetTitle sets the "title" field.
+func (_u *WishlistItemUpdateOne) SetTitle(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableTitle(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetAuthor sets the "author" field.
+func (_u *WishlistItemUpdateOne) SetAuthor(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetAuthor(v)
+	return _u
+}
+
+// SetNillableAuthor sets the "author" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableAuthor(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetAuthor(*v)
+	}
+	return _u
+}
+
+// SetIsbn sets the "isbn" field.
+func (_u *WishlistItemUpdateOne) SetIsbn(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetIsbn(v)
+	return _u
+}
+
+// SetNillableIsbn sets the "isbn" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableIsbn(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetIsbn(*v)
+	}
+	return _u
+}
+
+// ClearIsbn clears the value of the "isbn" field.
+func (_u *WishlistItemUpdateOne) ClearIsbn() *WishlistItemUpdateOne {
+	_u.mutation.ClearIsbn()
+	return _u
+}
+
+// SetPublisher sets the "publisher" field.
+func (_u *WishlistItemUpdateOne) SetPublisher(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetPublisher(v)
+	return _u
+}
+
+// SetNillablePublisher sets the "publisher" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillablePublisher(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetPublisher(*v)
+	}
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *WishlistItemUpdateOne) SetThumbnailURL(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableThumbnailURL(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// SetPriority sets the "priority" field.
+func (_u *WishlistItemUpdateOne) SetPriority(v wishlistitem.Priority) *WishlistItemUpdateOne {
+	_u.mutation.SetPriority(v)
+	return _u
+}
+
+// SetNillablePriority sets the "priority" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillablePriority(v *wishlistitem.Priority) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetPriority(*v)
+	}
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WishlistItemUpdateOne) SetNote(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableNote(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *WishlistItemUpdateOne) ClearNote() *WishlistItemUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetTargetPrice sets the "target_price" field.
+func (_u *WishlistItemUpdateOne) SetTargetPrice(v float64) *WishlistItemUpdateOne {
+	_u.mutation.ResetTargetPrice()
+	_u.mutation.SetTargetPrice(v)
+	return _u
+}
+
+// SetNillableTargetPrice sets the "target_price" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableTargetPrice(v *float64) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetTargetPrice(*v)
+	}
+	return _u
+}
+
+// AddTargetPrice adds value to the "target_price" field.
+func (_u *WishlistItemUpdateOne) AddTargetPrice(v float64) *WishlistItemUpdateOne {
+	_u.mutation.AddTargetPrice(v)
+	return _u
+}
+
+// ClearTargetPrice clears the value of the "target_price" field.
+func (_u *WishlistItemUpdateOne) ClearTargetPrice() *WishlistItemUpdateOne {
+	_u.mutation.ClearTargetPrice()
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *WishlistItemUpdateOne) SetCurrency(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableCurrency(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetClaimedBy sets the "claimed_by" field.
+func (_u *WishlistItemUpdateOne) SetClaimedBy(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetClaimedBy(v)
+	return _u
+}
+
+// SetNillableClaimedBy sets the "claimed_by" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableClaimedBy(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetClaimedBy(*v)
+	}
+	return _u
+}
+
+// SetClaimCode sets the "claim_code" field.
+func (_u *WishlistItemUpdateOne) SetClaimCode(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetClaimCode(v)
+	return _u
+}
+
+// SetNillableClaimCode sets the "claim_code" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableClaimCode(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetClaimCode(*v)
+	}
+	return _u
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (_u *WishlistItemUpdateOne) SetClaimedAt(v time.Time) *WishlistItemUpdateOne {
+	_u.mutation.SetClaimedAt(v)
+	return _u
+}
+
+// SetNillableClaimedAt sets the "claimed_at" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableClaimedAt(v *time.Time) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetClaimedAt(*v)
+	}
+	return _u
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (_u *WishlistItemUpdateOne) ClearClaimedAt() *WishlistItemUpdateOne {
+	_u.mutation.ClearClaimedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WishlistItemUpdateOne) SetUpdatedAt(v time.Time) *WishlistItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (_u *WishlistItemUpdateOne) SetOwnerID(id uuid.UUID) *WishlistItemUpdateOne {
+	_u.mutation.SetOwnerID(id)
+	return _u
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (_u *WishlistItemUpdateOne) SetOwner(v *User) *WishlistItemUpdateOne {
+	return _u.SetOwnerID(v.ID)
+}
+
+// Mutation returns the WishlistItemMutation object of the builder.
+func (_u *WishlistItemUpdateOne) Mutation() *WishlistItemMutation {
+	return _u.mutation
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (_u *WishlistItemUpdateOne) ClearOwner() *WishlistItemUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// Where appends a list predicates to the WishlistItemUpdate builder.
+func (_u *WishlistItemUpdateOne) Where(ps ...predicate.WishlistItem) *WishlistItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WishlistItemUpdateOne) Select(field string, fields ...string) *WishlistItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WishlistItem entity.
+func (_u *WishlistItemUpdateOne) Save(ctx context.Context) (*WishlistItem, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WishlistItemUpdateOne) SaveX(ctx context.Context) *WishlistItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WishlistItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WishlistItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WishlistItemUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wishlistitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WishlistItemUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := wishlistitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "WishlistItem.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Priority(); ok {
+		if err := wishlistitem.PriorityValidator(v); err != nil {
+			return &ValidationError{Name: "priority", err: fmt.Errorf(`ent: validator failed for field "WishlistItem.priority": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetPrice(); ok {
+		if err := wishlistitem.TargetPriceValidator(v); err != nil {
+			return &ValidationError{Name: "target_price", err: fmt.Errorf(`ent: validator failed for field "WishlistItem.target_price": %w`, err)}
+		}
+	}
+	if _u.mutation.OwnerCleared() && len(_u.mutation.OwnerIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WishlistItem.owner"`)
+	}
+	return nil
+}
+
+func (_u *WishlistItemUpdateOne) sqlSave(ctx context.Context) (_node *WishlistItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WishlistItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wishlistitem.FieldID)
+		for _, f := range fields {
+			if !wishlistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wishlistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(wishlistitem.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Author(); ok {
+		_spec.SetField(wishlistitem.FieldAuthor, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Isbn(); ok {
+		_spec.SetField(wishlistitem.FieldIsbn, field.TypeString, value)
+	}
+	if _u.mutation.IsbnCleared() {
+		_spec.ClearField(wishlistitem.FieldIsbn, field.TypeString)
+	}
+	if value, ok := _u.mutation.Publisher(); ok {
+		_spec.SetField(wishlistitem.FieldPublisher, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(wishlistitem.FieldThumbnailURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Priority(); ok {
+		_spec.SetField(wishlistitem.FieldPriority, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(wishlistitem.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(wishlistitem.FieldNote, field.TypeString)
+	}
+	if value, ok := _u.mutation.TargetPrice(); ok {
+		_spec.SetField(wishlistitem.FieldTargetPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetPrice(); ok {
+		_spec.AddField(wishlistitem.FieldTargetPrice, field.TypeFloat64, value)
+	}
+	if _u.mutation.TargetPriceCleared() {
+		_spec.ClearField(wishlistitem.FieldTargetPrice, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(wishlistitem.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimedBy(); ok {
+		_spec.SetField(wishlistitem.FieldClaimedBy, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimCode(); ok {
+		_spec.SetField(wishlistitem.FieldClaimCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClaimedAt(); ok {
+		_spec.SetField(wishlistitem.FieldClaimedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClaimedAtCleared() {
+		_spec.ClearField(wishlistitem.FieldClaimedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wishlistitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.OwnerTable,
+			Columns: []string{wishlistitem.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.OwnerTable,
+			Columns: []string{wishlistitem.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WishlistItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}